@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/app"
+	apiUseCase "github.com/altuslabsxyz/alert-bridge/internal/usecase/api"
+)
+
+// importStateCommand copies the firing alerts and active silences of a
+// running instance into the storage of the configured one, such as before
+// switching from memory to SQLite or MySQL storage. Run it while the new
+// instance is stopped, then cut over.
+func importStateCommand(args []string, configPath string) error {
+	fs := flag.NewFlagSet("import-state", flag.ContinueOnError)
+	from := fs.String("from", "", "GET /-/state URL of the running instance, or a file saved from it")
+	token := fs.String("token", "", "API token with the admin scope, for -from URLs")
+	dryRun := fs.Bool("dry-run", false, "report what would be imported without saving it")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *from == "" {
+		return fmt.Errorf("-from is required")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	export, err := readStateExport(ctx, *from, *token)
+	if err != nil {
+		return err
+	}
+
+	application, err := app.New(configPath)
+	if err != nil {
+		return fmt.Errorf("failed to initialize application: %w", err)
+	}
+	defer application.Shutdown()
+
+	result, err := application.ImportState(ctx, export, *dryRun)
+	if err != nil {
+		return err
+	}
+
+	verb := "imported"
+	if *dryRun {
+		verb = "would import"
+	}
+	fmt.Printf("%s %d alerts (%d ack events, %d timeline events) and %d silences; %d alerts and %d silences already stored\n",
+		verb, result.Alerts, result.AckEvents, result.AlertEvents, result.Silences, result.SkippedAlerts, result.SkippedSilences)
+	return nil
+}
+
+// readStateExport reads an export from an instance's /-/state URL or a file.
+func readStateExport(ctx context.Context, from, token string) (*apiUseCase.StateExport, error) {
+	var body io.ReadCloser
+	if strings.HasPrefix(from, "http://") || strings.HasPrefix(from, "https://") {
+		ctx, cancel := context.WithTimeout(ctx, time.Minute)
+		defer cancel()
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, from, nil)
+		if err != nil {
+			return nil, err
+		}
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("fetching state: %w", err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("fetching state: %s", resp.Status)
+		}
+		body = resp.Body
+	} else {
+		f, err := os.Open(from)
+		if err != nil {
+			return nil, err
+		}
+		body = f
+	}
+	defer body.Close()
+
+	var export apiUseCase.StateExport
+	if err := json.NewDecoder(body).Decode(&export); err != nil {
+		return nil, fmt.Errorf("decoding state: %w", err)
+	}
+	return &export, nil
+}
@@ -2,6 +2,8 @@ package main
 
 import (
 	"context"
+	"flag"
+	"fmt"
 	"log"
 	"os"
 	"os/signal"
@@ -11,24 +13,73 @@ import (
 )
 
 func main() {
-	configPath := os.Getenv("CONFIG_PATH")
+	configFlag := flag.String("config", "", "config file path (default $CONFIG_PATH or config/config.yaml)")
+	flag.Parse()
+
+	configPath := *configFlag
+	if configPath == "" {
+		configPath = os.Getenv("CONFIG_PATH")
+	}
 	if configPath == "" {
 		configPath = "config/config.yaml"
 	}
 
-	application, err := app.New(configPath)
-	if err != nil {
-		log.Fatalf("failed to initialize application: %v", err)
+	// alert-bridge [-config path] service install|uninstall|start|stop
+	if flag.Arg(0) == "service" {
+		if err := serviceCommand(flag.Args()[1:], configPath); err != nil {
+			log.Fatalf("service: %v", err)
+		}
+		return
+	}
+
+	// alert-bridge [-config path] relink [-since 168h] [-dry-run]
+	if flag.Arg(0) == "relink" {
+		if err := relinkCommand(flag.Args()[1:], configPath); err != nil {
+			log.Fatalf("relink: %v", err)
+		}
+		return
+	}
+
+	// alert-bridge [-config path] import-state -from <url|file> [-token t] [-dry-run]
+	if flag.Arg(0) == "import-state" {
+		if err := importStateCommand(flag.Args()[1:], configPath); err != nil {
+			log.Fatalf("import-state: %v", err)
+		}
+		return
 	}
 
-	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	// Started by the Windows service manager
+	if isService() {
+		if err := runService(configPath); err != nil {
+			log.Fatalf("service: %v", err)
+		}
+		return
+	}
+
+	// SIGTERM also covers closing the console window or logging off on
+	// Windows, where Go delivers those events as SIGTERM
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
+	if err := run(ctx, configPath); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// run starts the application and shuts it down gracefully once ctx is
+// cancelled.
+func run(ctx context.Context, configPath string) error {
+	application, err := app.New(configPath)
+	if err != nil {
+		return fmt.Errorf("failed to initialize application: %w", err)
+	}
+
 	if err := application.Start(ctx); err != nil {
-		log.Fatalf("server error: %v", err)
+		return fmt.Errorf("server error: %w", err)
 	}
 
 	if err := application.Shutdown(); err != nil {
-		log.Fatalf("shutdown error: %v", err)
+		return fmt.Errorf("shutdown error: %w", err)
 	}
+	return nil
 }
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/app"
+)
+
+// relinkCommand restores the Slack message and PagerDuty incident
+// references of alerts that lost them, such as after restoring the database
+// from a backup. Run it while the server is stopped or idle.
+func relinkCommand(args []string, configPath string) error {
+	fs := flag.NewFlagSet("relink", flag.ContinueOnError)
+	since := fs.Duration("since", 7*24*time.Hour, "repair alerts firing or changed within this period")
+	dryRun := fs.Bool("dry-run", false, "report the links without saving them")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	application, err := app.New(configPath)
+	if err != nil {
+		return fmt.Errorf("failed to initialize application: %w", err)
+	}
+	defer application.Shutdown()
+
+	output, err := application.Relink(ctx, time.Now().Add(-*since), *dryRun)
+	if err != nil {
+		return err
+	}
+
+	for _, relink := range output.Relinked {
+		fmt.Printf("%s\t%s\t%s\t%s\n", relink.AlertID, relink.AlertName, relink.System, relink.Reference)
+	}
+	verb := "relinked"
+	if *dryRun {
+		verb = "would relink"
+	}
+	fmt.Printf("%d alerts missing references, %s %d, %d not found\n",
+		output.Scanned, verb, len(output.Relinked), output.Unmatched)
+	return nil
+}
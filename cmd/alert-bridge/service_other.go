@@ -0,0 +1,19 @@
+//go:build !windows
+
+package main
+
+import "errors"
+
+var errServiceUnsupported = errors.New("service commands are only supported on Windows; use systemd or another supervisor")
+
+func isService() bool {
+	return false
+}
+
+func runService(configPath string) error {
+	return errServiceUnsupported
+}
+
+func serviceCommand(args []string, configPath string) error {
+	return errServiceUnsupported
+}
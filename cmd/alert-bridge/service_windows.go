@@ -0,0 +1,230 @@
+//go:build windows
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"golang.org/x/sys/windows/svc"
+	"golang.org/x/sys/windows/svc/eventlog"
+	"golang.org/x/sys/windows/svc/mgr"
+)
+
+const (
+	serviceName        = "alert-bridge"
+	serviceDisplayName = "Alert Bridge"
+	serviceDescription = "Forwards alerts to Slack, PagerDuty and other notifiers and syncs acknowledgments."
+
+	// serviceStopTimeout bounds how long "service stop" waits, and is the
+	// hint given to the service manager while the application shuts down.
+	serviceStopTimeout = 30 * time.Second
+)
+
+// isService reports whether the process was started by the service manager.
+func isService() bool {
+	ok, err := svc.IsWindowsService()
+	return err == nil && ok
+}
+
+// runService runs the application under the service manager until the
+// service is stopped or Windows shuts down. Failures are written to the
+// Application event log, since a service has no console.
+func runService(configPath string) error {
+	elog, err := eventlog.Open(serviceName)
+	if err != nil {
+		return fmt.Errorf("opening event log: %w", err)
+	}
+	defer elog.Close()
+
+	handler := &serviceHandler{configPath: configPath, elog: elog}
+	if err := svc.Run(serviceName, handler); err != nil {
+		_ = elog.Error(1, fmt.Sprintf("service failed: %v", err))
+		return err
+	}
+	return nil
+}
+
+// serviceHandler implements svc.Handler.
+type serviceHandler struct {
+	configPath string
+	elog       *eventlog.Log
+}
+
+// Execute runs the application and translates stop and shutdown requests
+// into a graceful shutdown, the same as SIGTERM elsewhere.
+func (h *serviceHandler) Execute(args []string, requests <-chan svc.ChangeRequest, status chan<- svc.Status) (bool, uint32) {
+	const accepted = svc.AcceptStop | svc.AcceptShutdown
+
+	status <- svc.Status{State: svc.StartPending}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		done <- run(ctx, h.configPath)
+	}()
+
+	status <- svc.Status{State: svc.Running, Accepts: accepted}
+	_ = h.elog.Info(1, "alert-bridge started")
+
+	for {
+		select {
+		case err := <-done:
+			// Stopped without a request, e.g. a config error at startup
+			return h.exit(err)
+		case req := <-requests:
+			switch req.Cmd {
+			case svc.Interrogate:
+				status <- req.CurrentStatus
+			case svc.Stop, svc.Shutdown:
+				status <- svc.Status{State: svc.StopPending, WaitHint: uint32(serviceStopTimeout / time.Millisecond)}
+				cancel()
+				return h.exit(<-done)
+			}
+		}
+	}
+}
+
+// exit logs how the application ended and returns the service exit code.
+func (h *serviceHandler) exit(err error) (bool, uint32) {
+	if err != nil {
+		_ = h.elog.Error(1, err.Error())
+		return true, 1
+	}
+	_ = h.elog.Info(1, "alert-bridge stopped")
+	return false, 0
+}
+
+// serviceCommand installs, removes, starts or stops the Windows service.
+func serviceCommand(args []string, configPath string) error {
+	if len(args) != 1 {
+		return errors.New("usage: alert-bridge [-config path] service install|uninstall|start|stop")
+	}
+
+	m, err := mgr.Connect()
+	if err != nil {
+		return fmt.Errorf("connecting to service manager: %w", err)
+	}
+	defer m.Disconnect()
+
+	switch args[0] {
+	case "install":
+		return installService(m, configPath)
+	case "uninstall":
+		return uninstallService(m)
+	case "start":
+		return startService(m)
+	case "stop":
+		return stopService(m)
+	default:
+		return fmt.Errorf("unknown service command %q", args[0])
+	}
+}
+
+// installService registers the service to start automatically with the
+// current executable and config file, restarting it if it fails.
+func installService(m *mgr.Mgr, configPath string) error {
+	exe, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("locating executable: %w", err)
+	}
+	// Services start in the system directory, so relative paths would break
+	configPath, err = filepath.Abs(configPath)
+	if err != nil {
+		return fmt.Errorf("resolving config path: %w", err)
+	}
+	if _, err := os.Stat(configPath); err != nil {
+		return fmt.Errorf("config file: %w", err)
+	}
+
+	if s, err := m.OpenService(serviceName); err == nil {
+		s.Close()
+		return fmt.Errorf("service %s already exists", serviceName)
+	}
+
+	s, err := m.CreateService(serviceName, exe, mgr.Config{
+		DisplayName: serviceDisplayName,
+		Description: serviceDescription,
+		StartType:   mgr.StartAutomatic,
+	}, "-config", configPath)
+	if err != nil {
+		return fmt.Errorf("creating service: %w", err)
+	}
+	defer s.Close()
+
+	// Restart after a crash or a failed start, resetting the count daily
+	restart := mgr.RecoveryAction{Type: mgr.ServiceRestart, Delay: 10 * time.Second}
+	if err := s.SetRecoveryActions([]mgr.RecoveryAction{restart, restart, restart}, 24*60*60); err != nil {
+		return fmt.Errorf("setting recovery actions: %w", err)
+	}
+
+	if err := eventlog.InstallAsEventCreate(serviceName, eventlog.Error|eventlog.Warning|eventlog.Info); err != nil {
+		_ = s.Delete()
+		return fmt.Errorf("registering event log source: %w", err)
+	}
+
+	fmt.Printf("service %s installed (config %s)\n", serviceName, configPath)
+	return nil
+}
+
+func uninstallService(m *mgr.Mgr) error {
+	s, err := m.OpenService(serviceName)
+	if err != nil {
+		return fmt.Errorf("service %s is not installed", serviceName)
+	}
+	defer s.Close()
+
+	if err := s.Delete(); err != nil {
+		return fmt.Errorf("deleting service: %w", err)
+	}
+	if err := eventlog.Remove(serviceName); err != nil {
+		return fmt.Errorf("removing event log source: %w", err)
+	}
+
+	fmt.Printf("service %s uninstalled\n", serviceName)
+	return nil
+}
+
+func startService(m *mgr.Mgr) error {
+	s, err := m.OpenService(serviceName)
+	if err != nil {
+		return fmt.Errorf("service %s is not installed", serviceName)
+	}
+	defer s.Close()
+
+	if err := s.Start(); err != nil {
+		return fmt.Errorf("starting service: %w", err)
+	}
+	return nil
+}
+
+// stopService requests a stop and waits for the graceful shutdown.
+func stopService(m *mgr.Mgr) error {
+	s, err := m.OpenService(serviceName)
+	if err != nil {
+		return fmt.Errorf("service %s is not installed", serviceName)
+	}
+	defer s.Close()
+
+	status, err := s.Control(svc.Stop)
+	if err != nil {
+		return fmt.Errorf("stopping service: %w", err)
+	}
+
+	deadline := time.Now().Add(serviceStopTimeout)
+	for status.State != svc.Stopped {
+		if time.Now().After(deadline) {
+			return fmt.Errorf("service %s did not stop within %s", serviceName, serviceStopTimeout)
+		}
+		time.Sleep(300 * time.Millisecond)
+		if status, err = s.Query(); err != nil {
+			return fmt.Errorf("querying service: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// AlertResponse is the JSON representation of an alert in API responses.
+type AlertResponse struct {
+	ID           string               `json:"id"`
+	Fingerprint  string               `json:"fingerprint"`
+	Name         string               `json:"name"`
+	Instance     string               `json:"instance,omitempty"`
+	Target       string               `json:"target,omitempty"`
+	Source       string               `json:"source,omitempty"`
+	Summary      string               `json:"summary,omitempty"`
+	Description  string               `json:"description,omitempty"`
+	Severity     string               `json:"severity"`
+	State        string               `json:"state"`
+	Labels       map[string]string    `json:"labels,omitempty"`
+	Annotations  map[string]string    `json:"annotations,omitempty"`
+	Tags         []string             `json:"tags,omitempty"`
+	CustomFields map[string]string    `json:"custom_fields,omitempty"`
+	Notes        []AlertNoteResponse  `json:"notes,omitempty"`
+	History      []AlertStateResponse `json:"history,omitempty"`
+	FiredAt      time.Time            `json:"fired_at"`
+	AckedAt      *time.Time           `json:"acked_at,omitempty"`
+	AckedBy      string               `json:"acked_by,omitempty"`
+	AssignedTo   string               `json:"assigned_to,omitempty"`
+	AssignedAt   *time.Time           `json:"assigned_at,omitempty"`
+	ResolvedAt   *time.Time           `json:"resolved_at,omitempty"`
+}
+
+// NewAlertResponse converts an alert to its API representation.
+func NewAlertResponse(alert *entity.Alert) AlertResponse {
+	return AlertResponse{
+		ID:           alert.ID,
+		Fingerprint:  alert.Fingerprint,
+		Name:         alert.Name,
+		Instance:     alert.Instance,
+		Target:       alert.Target,
+		Source:       alert.Source,
+		Summary:      alert.Summary,
+		Description:  alert.Description,
+		Severity:     string(alert.Severity),
+		State:        string(alert.State),
+		Labels:       alert.Labels,
+		Annotations:  alert.Annotations,
+		Tags:         alert.Tags,
+		CustomFields: alert.CustomFields,
+		Notes:        newAlertNoteResponses(alert.Notes),
+		History:      newAlertStateResponses(alert.History),
+		FiredAt:      alert.FiredAt,
+		AckedAt:      alert.AckedAt,
+		AckedBy:      alert.AckedBy,
+		AssignedTo:   alert.AssignedTo,
+		AssignedAt:   alert.AssignedAt,
+		ResolvedAt:   alert.ResolvedAt,
+	}
+}
+
+// AlertNoteResponse is a responder note in API responses.
+type AlertNoteResponse struct {
+	At   time.Time `json:"at"`
+	By   string    `json:"by,omitempty"`
+	Text string    `json:"text"`
+}
+
+func newAlertNoteResponses(notes []entity.AlertNote) []AlertNoteResponse {
+	if len(notes) == 0 {
+		return nil
+	}
+	out := make([]AlertNoteResponse, len(notes))
+	for i, n := range notes {
+		out[i] = AlertNoteResponse{At: n.At, By: n.By, Text: n.Text}
+	}
+	return out
+}
+
+// AlertStateResponse is a state or severity change in an alert's history.
+type AlertStateResponse struct {
+	State    string    `json:"state"`
+	Severity string    `json:"severity"`
+	At       time.Time `json:"at"`
+	Actor    string    `json:"actor,omitempty"`
+}
+
+func newAlertStateResponses(history []entity.AlertTransition) []AlertStateResponse {
+	if len(history) == 0 {
+		return nil
+	}
+	out := make([]AlertStateResponse, len(history))
+	for i, t := range history {
+		out[i] = AlertStateResponse{State: string(t.State), Severity: string(t.Severity), At: t.At, Actor: t.By}
+	}
+	return out
+}
+
+// AlertSnapshotResponse is an alert as it stood at a past instant. State,
+// severity and ack fields are the values at that time; Alert holds the
+// current record.
+type AlertSnapshotResponse struct {
+	State    string        `json:"state"`
+	Severity string        `json:"severity"`
+	AckedBy  string        `json:"acked_by,omitempty"`
+	AckedAt  *time.Time    `json:"acked_at,omitempty"`
+	Alert    AlertResponse `json:"alert"`
+}
+
+// AlertActionRequest is the body of POST /api/v1/alerts/{id}/ack, /resolve
+// and /notes. User is required; Text is required for notes.
+type AlertActionRequest struct {
+	User      string `json:"user"`
+	Email     string `json:"email,omitempty"`
+	Note      string `json:"note,omitempty"`       // ack and resolve only
+	Duration  string `json:"duration,omitempty"`   // ack only, e.g. "30m"
+	RootCause string `json:"root_cause,omitempty"` // resolve only
+	Text      string `json:"text,omitempty"`       // notes only
+}
+
+// ResolveSourceRequest is the body of POST /api/v1/sources/{source}/resolve.
+// User is required; Labels narrows the alerts resolved.
+type ResolveSourceRequest struct {
+	User   string            `json:"user"`
+	Note   string            `json:"note,omitempty"`
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// CreateAlertRequest is the body of POST /api/v1/alerts. User and Name are
+// required; Severity defaults to warning.
+type CreateAlertRequest struct {
+	User        string            `json:"user"`
+	Name        string            `json:"name"`
+	Severity    string            `json:"severity,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+}
+
+// NewAlertSnapshotResponse converts a snapshot to its API representation.
+func NewAlertSnapshotResponse(snap *entity.AlertSnapshot) AlertSnapshotResponse {
+	return AlertSnapshotResponse{
+		State:    string(snap.State),
+		Severity: string(snap.Severity),
+		AckedBy:  snap.AckedBy,
+		AckedAt:  snap.AckedAt,
+		Alert:    NewAlertResponse(snap.Alert),
+	}
+}
+
+// AlertEventResponse is an entry of an alert timeline in API responses.
+type AlertEventResponse struct {
+	Type   string    `json:"type"`
+	At     time.Time `json:"at"`
+	By     string    `json:"by,omitempty"`
+	Detail string    `json:"detail,omitempty"`
+}
+
+// AlertTimelineResponse is the response body for
+// GET /api/v1/alerts/{id}/timeline.
+type AlertTimelineResponse struct {
+	AlertID string               `json:"alert_id"`
+	Events  []AlertEventResponse `json:"events"`
+}
+
+// NewAlertTimelineResponse converts an alert's events, oldest first, to
+// their API representation.
+func NewAlertTimelineResponse(alertID string, events []*entity.AlertEvent) AlertTimelineResponse {
+	resp := AlertTimelineResponse{
+		AlertID: alertID,
+		Events:  make([]AlertEventResponse, 0, len(events)),
+	}
+	for _, e := range events {
+		resp.Events = append(resp.Events, AlertEventResponse{
+			Type:   string(e.Type),
+			At:     e.CreatedAt,
+			By:     e.By,
+			Detail: e.Detail,
+		})
+	}
+	return resp
+}
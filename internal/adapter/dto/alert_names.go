@@ -0,0 +1,35 @@
+package dto
+
+// AlertNameMap renames alerts to a canonical name so the same condition
+// reported under different names by different sources is deduplicated,
+// routed and counted as one. The nil map renames nothing.
+type AlertNameMap map[string]string
+
+// NewAlertNameMap builds the map from config, which lists the aliases of
+// each canonical name. The config is assumed to be validated.
+func NewAlertNameMap(canonical map[string][]string) AlertNameMap {
+	if len(canonical) == 0 {
+		return nil
+	}
+	m := make(AlertNameMap)
+	for name, aliases := range canonical {
+		for _, alias := range aliases {
+			m[alias] = name
+		}
+	}
+	return m
+}
+
+// Apply renames input if its name is an alias. The alertname label is
+// rewritten too, so label-based fingerprints and routing see the canonical
+// name. Must run before the fingerprint strategy.
+func (m AlertNameMap) Apply(input *ProcessAlertInput) {
+	canonical, ok := m[input.Name]
+	if !ok {
+		return
+	}
+	if input.Labels != nil && input.Labels["alertname"] == input.Name {
+		input.Labels["alertname"] = canonical
+	}
+	input.Name = canonical
+}
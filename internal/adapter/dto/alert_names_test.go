@@ -0,0 +1,56 @@
+package dto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/config"
+)
+
+func TestAlertNameMap_Apply(t *testing.T) {
+	names := NewAlertNameMap(map[string][]string{
+		"PodCrashLoop": {"KubePodCrashLooping", "PodCrashLoopBackOff"},
+	})
+
+	newInput := func(name string) ProcessAlertInput {
+		return ProcessAlertInput{
+			Name:   name,
+			Labels: map[string]string{"alertname": name, "pod": "api-7f9c"},
+		}
+	}
+
+	t.Run("renames aliases", func(t *testing.T) {
+		input := newInput("KubePodCrashLooping")
+		names.Apply(&input)
+		assert.Equal(t, "PodCrashLoop", input.Name)
+		assert.Equal(t, "PodCrashLoop", input.Labels["alertname"])
+	})
+
+	t.Run("leaves other names alone", func(t *testing.T) {
+		input := newInput("HighLatency")
+		names.Apply(&input)
+		assert.Equal(t, "HighLatency", input.Name)
+		assert.Equal(t, "HighLatency", input.Labels["alertname"])
+	})
+
+	t.Run("aliases share a labels fingerprint", func(t *testing.T) {
+		strategy := NewFingerprintStrategy(config.FingerprintConfig{
+			Strategy:  FingerprintLabels,
+			LabelKeys: []string{"alertname", "pod"},
+		})
+		a := newInput("KubePodCrashLooping")
+		b := newInput("PodCrashLoopBackOff")
+		for _, input := range []*ProcessAlertInput{&a, &b} {
+			names.Apply(input)
+			strategy.Apply(input)
+		}
+		assert.Equal(t, a.Fingerprint, b.Fingerprint)
+	})
+
+	t.Run("nil map", func(t *testing.T) {
+		input := newInput("KubePodCrashLooping")
+		AlertNameMap(nil).Apply(&input)
+		assert.Equal(t, "KubePodCrashLooping", input.Name)
+	})
+}
@@ -0,0 +1,91 @@
+package dto
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// AlertSearchUsage describes the /alerts filters, for replies to malformed
+// searches.
+const AlertSearchUsage = "Usage: `/alerts [state:active|acked|resolved|firing|all] [severity:critical|warning|info] [label:name=value] [text:words]`"
+
+// ParseAlertSearch parses the command text for /alerts command.
+// Usage: /alerts [state:...] [severity:...] [label:name=value ...] [text:...]
+// Examples:
+//   - /alerts                                     - Firing alerts
+//   - /alerts state:active severity:critical      - Unacknowledged critical alerts
+//   - /alerts label:chain=axelar text:cpu         - Firing axelar alerts mentioning cpu
+//   - /alerts state:all disk full                 - Any alert mentioning "disk full"
+//
+// state and label may be repeated. Terms without a filter prefix are part of
+// the text. Without a state filter, firing (active or acknowledged) alerts
+// are searched.
+func ParseAlertSearch(text string) (entity.AlertQuery, error) {
+	query := entity.AlertQuery{}
+	var words []string
+	stateGiven := false
+
+	for _, term := range strings.Fields(text) {
+		key, value, ok := strings.Cut(term, ":")
+		if !ok {
+			words = append(words, term)
+			continue
+		}
+
+		switch strings.ToLower(key) {
+		case "state":
+			stateGiven = true
+			switch strings.ToLower(value) {
+			case "active":
+				query.States = append(query.States, entity.StateActive)
+			case "acked", "acknowledged":
+				query.States = append(query.States, entity.StateAcked)
+			case "resolved":
+				query.States = append(query.States, entity.StateResolved)
+			case "firing":
+				query.States = append(query.States, entity.StateActive, entity.StateAcked)
+			case "all", "any":
+			default:
+				return entity.AlertQuery{}, fmt.Errorf("unknown state %q", value)
+			}
+		case "severity":
+			switch strings.ToLower(value) {
+			case "critical", "crit":
+				query.Severity = entity.SeverityCritical
+			case "warning", "warn":
+				query.Severity = entity.SeverityWarning
+			case "info":
+				query.Severity = entity.SeverityInfo
+			default:
+				return entity.AlertQuery{}, fmt.Errorf("unknown severity %q", value)
+			}
+		case "label":
+			name, labelValue, ok := strings.Cut(value, "=")
+			if !ok || name == "" {
+				return entity.AlertQuery{}, fmt.Errorf("label filter must be label:name=value, got %q", term)
+			}
+			if query.Labels == nil {
+				query.Labels = make(map[string]string)
+			}
+			query.Labels[name] = labelValue
+		case "text":
+			words = append(words, value)
+		default:
+			// Not a filter, e.g. "error:timeout"
+			words = append(words, term)
+		}
+	}
+
+	if !stateGiven {
+		query.States = []entity.AlertState{entity.StateActive, entity.StateAcked}
+	}
+	query.Text = strings.TrimSpace(strings.Join(words, " "))
+	return query, nil
+}
+
+// ParseAlertSearch parses the command text of an /alerts command.
+func (d *SlackCommandDTO) ParseAlertSearch() (entity.AlertQuery, error) {
+	return ParseAlertSearch(d.Text)
+}
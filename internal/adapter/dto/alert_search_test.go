@@ -0,0 +1,63 @@
+package dto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+func TestParseAlertSearch(t *testing.T) {
+	firing := []entity.AlertState{entity.StateActive, entity.StateAcked}
+
+	tests := []struct {
+		name string
+		text string
+		want entity.AlertQuery
+	}{
+		{
+			name: "empty searches firing alerts",
+			text: "",
+			want: entity.AlertQuery{States: firing},
+		},
+		{
+			name: "all filters",
+			text: "state:active severity:critical label:chain=axelar text:cpu",
+			want: entity.AlertQuery{
+				States:   []entity.AlertState{entity.StateActive},
+				Severity: entity.SeverityCritical,
+				Labels:   map[string]string{"chain": "axelar"},
+				Text:     "cpu",
+			},
+		},
+		{
+			name: "repeated filters and bare words",
+			text: "state:acked state:resolved label:env=prod label:team=db disk full",
+			want: entity.AlertQuery{
+				States: []entity.AlertState{entity.StateAcked, entity.StateResolved},
+				Labels: map[string]string{"env": "prod", "team": "db"},
+				Text:   "disk full",
+			},
+		},
+		{
+			name: "state all and unknown prefix",
+			text: "STATE:all error:timeout",
+			want: entity.AlertQuery{Text: "error:timeout"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := ParseAlertSearch(tt.text)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, query)
+		})
+	}
+
+	for _, text := range []string{"state:open", "severity:high", "label:chain", "label:=x"} {
+		_, err := ParseAlertSearch(text)
+		assert.Error(t, err, text)
+	}
+}
@@ -1,6 +1,7 @@
 package dto
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
@@ -32,6 +33,32 @@ type AlertmanagerAlert struct {
 	Fingerprint  string            `json:"fingerprint"`
 }
 
+// Validate checks the webhook against the payload limits. Returns
+// ErrPayloadLimit if it carries too many alerts, labels or annotations.
+func (w *AlertmanagerWebhook) Validate() error {
+	if len(w.Alerts) > MaxWebhookAlerts {
+		return fmt.Errorf("%w: %d alerts, at most %d are accepted", ErrPayloadLimit, len(w.Alerts), MaxWebhookAlerts)
+	}
+	if err := checkLabelCount("groupLabels", w.GroupLabels); err != nil {
+		return err
+	}
+	if err := checkLabelCount("commonLabels", w.CommonLabels); err != nil {
+		return err
+	}
+	if err := checkLabelCount("commonAnnotations", w.CommonAnnotations); err != nil {
+		return err
+	}
+	for i, alert := range w.Alerts {
+		if err := checkLabelCount(fmt.Sprintf("alerts[%d].labels", i), alert.Labels); err != nil {
+			return err
+		}
+		if err := checkLabelCount(fmt.Sprintf("alerts[%d].annotations", i), alert.Annotations); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // ProcessAlertInput represents the input for processing an alert.
 type ProcessAlertInput struct {
 	Fingerprint string
@@ -45,6 +72,10 @@ type ProcessAlertInput struct {
 	Labels      map[string]string
 	Annotations map[string]string
 	FiredAt     time.Time
+	EndsAt      time.Time // Zero if the source did not report an end time
+	GroupKey    string    // Alertmanager groupKey of the carrying webhook
+	ReceivedAt  time.Time // When the carrying webhook arrived; zero means now
+	Source      string    // Integration that sent the alert, e.g. "grafana"
 }
 
 // ToProcessAlertInput converts an AlertmanagerAlert to ProcessAlertInput.
@@ -61,18 +92,60 @@ func ToProcessAlertInput(alert AlertmanagerAlert) ProcessAlertInput {
 		Labels:      alert.Labels,
 		Annotations: alert.Annotations,
 		FiredAt:     alert.StartsAt,
+		EndsAt:      alert.EndsAt,
+	}
+}
+
+// ToProcessAlertInput converts an alert carried by this webhook to ProcessAlertInput.
+// Group-level labels and annotations are merged into the alert so that labels
+// Alertmanager only reports at the group level (routing, ownership) are kept.
+// Per-alert values always take precedence.
+func (w *AlertmanagerWebhook) ToProcessAlertInput(alert AlertmanagerAlert) ProcessAlertInput {
+	alert.Labels = mergeMissing(alert.Labels, w.GroupLabels, w.CommonLabels)
+	alert.Annotations = mergeMissing(alert.Annotations, w.CommonAnnotations)
+
+	input := ToProcessAlertInput(alert)
+	input.GroupKey = w.GroupKey
+	return input
+}
+
+// mergeMissing returns a copy of base with keys from each fallback added
+// where base does not already define them.
+func mergeMissing(base map[string]string, fallbacks ...map[string]string) map[string]string {
+	merged := make(map[string]string, len(base))
+	for k, v := range base {
+		merged[k] = v
 	}
+	for _, fallback := range fallbacks {
+		for k, v := range fallback {
+			if _, ok := merged[k]; !ok {
+				merged[k] = v
+			}
+		}
+	}
+	return merged
 }
 
 // mapSeverity converts Alertmanager severity label to entity.AlertSeverity.
 func mapSeverity(severity string) entity.AlertSeverity {
+	if mapped, ok := builtinSeverity(severity); ok {
+		return mapped
+	}
+	return entity.SeverityInfo
+}
+
+// builtinSeverity maps the severity label values known without
+// configuration.
+func builtinSeverity(severity string) (entity.AlertSeverity, bool) {
 	switch severity {
 	case "critical", "page":
-		return entity.SeverityCritical
+		return entity.SeverityCritical, true
 	case "warning", "warn":
-		return entity.SeverityWarning
+		return entity.SeverityWarning, true
+	case "info":
+		return entity.SeverityInfo, true
 	default:
-		return entity.SeverityInfo
+		return "", false
 	}
 }
 
@@ -93,10 +166,41 @@ type ProcessAlertOutput struct {
 	IsSilenced          bool
 	NotificationsSent   []string
 	NotificationsFailed []NotificationError
+
+	// Deliveries describe the new notifications sent. Updates of existing
+	// notifications are not listed.
+	Deliveries []NotificationDelivery
+}
+
+// ProcessAlertGroupInput describes a whole Alertmanager webhook after its
+// alerts have been processed individually.
+type ProcessAlertGroupInput struct {
+	GroupKey        string
+	Status          string // Group status: "firing" or "resolved"
+	TruncatedAlerts int
+	Fingerprints    []string // Fingerprints present in the payload
+	AlertIDs        []string // Alerts created or matched while processing the payload
+}
+
+// ProcessAlertGroupOutput represents the result of group-level processing.
+type ProcessAlertGroupOutput struct {
+	// ResolvedAlertIDs are alerts resolved because their group resolved
+	// without them being listed (typically due to truncation).
+	ResolvedAlertIDs []string
+
+	// TruncationReported is true if a truncation warning was posted.
+	TruncationReported bool
 }
 
 // NotificationError represents a failed notification attempt.
 type NotificationError struct {
 	NotifierName string
 	Error        error
+	Retriable    bool // The notification may succeed when sent again
+}
+
+// NotificationDelivery represents a notification a notifier sent.
+type NotificationDelivery struct {
+	NotifierName string
+	Result       entity.NotifyResult
 }
@@ -0,0 +1,91 @@
+package dto
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlertmanagerWebhook_ToProcessAlertInput_MergesGroupContext(t *testing.T) {
+	webhook := AlertmanagerWebhook{
+		GroupKey:          "{}:{alertname=\"HighCPU\"}",
+		GroupLabels:       map[string]string{"alertname": "HighCPU"},
+		CommonLabels:      map[string]string{"team": "infra", "severity": "warning"},
+		CommonAnnotations: map[string]string{"runbook_url": "https://runbooks/cpu", "summary": "group summary"},
+	}
+	alert := AlertmanagerAlert{
+		Status:      "firing",
+		Labels:      map[string]string{"instance": "server-1", "severity": "critical"},
+		Annotations: map[string]string{"summary": "High CPU on server-1"},
+		Fingerprint: "abc123",
+	}
+
+	input := webhook.ToProcessAlertInput(alert)
+
+	assert.Equal(t, "HighCPU", input.Name)
+	assert.Equal(t, "infra", input.Labels["team"])
+	assert.Equal(t, "https://runbooks/cpu", input.Annotations["runbook_url"])
+	assert.Equal(t, webhook.GroupKey, input.GroupKey)
+
+	// Per-alert values win over group-level ones
+	assert.Equal(t, "critical", input.Labels["severity"])
+	assert.Equal(t, "High CPU on server-1", input.Summary)
+
+	// The payload's own maps are left untouched
+	assert.NotContains(t, alert.Labels, "team")
+}
+
+func TestAlertmanagerWebhook_Validate(t *testing.T) {
+	labels := func(n int) map[string]string {
+		m := make(map[string]string, n)
+		for i := range n {
+			m[fmt.Sprintf("l%d", i)] = "v"
+		}
+		return m
+	}
+
+	valid := AlertmanagerWebhook{
+		CommonLabels: labels(MaxAlertLabels),
+		Alerts:       make([]AlertmanagerAlert, MaxWebhookAlerts),
+	}
+	assert.NoError(t, valid.Validate())
+
+	tests := map[string]AlertmanagerWebhook{
+		"too many alerts":       {Alerts: make([]AlertmanagerAlert, MaxWebhookAlerts+1)},
+		"too many labels":       {Alerts: []AlertmanagerAlert{{}, {Labels: labels(MaxAlertLabels + 1)}}},
+		"too many annotations":  {Alerts: []AlertmanagerAlert{{Annotations: labels(MaxAlertLabels + 1)}}},
+		"too many group labels": {GroupLabels: labels(MaxAlertLabels + 1)},
+	}
+	for name, webhook := range tests {
+		assert.ErrorIs(t, webhook.Validate(), ErrPayloadLimit, name)
+	}
+}
+
+func FuzzAlertmanagerWebhook(f *testing.F) {
+	f.Add([]byte(`{"status":"firing","groupKey":"{}:{}","commonLabels":{"team":"infra"},"alerts":[{"status":"firing","labels":{"alertname":"HighCPU","severity":"critical"},"annotations":{"summary":"s"},"startsAt":"2026-01-02T03:04:05Z","fingerprint":"abc"}]}`))
+	f.Add([]byte(`{"alerts":[{"labels":null,"annotations":null}],"groupLabels":null}`))
+	f.Add([]byte(`{"alerts":[{}, {"status":"resolved","endsAt":"0001-01-01T00:00:00Z"}]}`))
+	f.Add([]byte(`{"truncatedAlerts":-1,"alerts":null}`))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var webhook AlertmanagerWebhook
+		if err := json.Unmarshal(data, &webhook); err != nil {
+			return
+		}
+		if err := webhook.Validate(); err != nil {
+			if !errors.Is(err, ErrPayloadLimit) {
+				t.Fatalf("Validate returned %v, want ErrPayloadLimit", err)
+			}
+			return
+		}
+		for _, alert := range webhook.Alerts {
+			input := webhook.ToProcessAlertInput(alert)
+			if input.Name != input.Labels["alertname"] {
+				t.Fatalf("name %q does not match alertname label %q", input.Name, input.Labels["alertname"])
+			}
+		}
+	})
+}
@@ -0,0 +1,74 @@
+package dto
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// BatchItemResult is the outcome of one NDJSON line posted to the batch
+// ingestion endpoint. Results are streamed back in input order.
+type BatchItemResult struct {
+	Line       int    `json:"line"`
+	Status     string `json:"status"` // processed, failed, invalid, or rejected
+	AlertID    string `json:"alert_id,omitempty"`
+	IsNew      bool   `json:"is_new,omitempty"`
+	IsSilenced bool   `json:"is_silenced,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// Batch item statuses.
+const (
+	BatchItemProcessed = "processed"
+	BatchItemFailed    = "failed"   // valid alert that could not be processed; retryable
+	BatchItemInvalid   = "invalid"  // line could not be parsed; not retryable
+	BatchItemRejected  = "rejected" // line was not read (item limit reached)
+)
+
+// BatchSummary is the final line of a batch ingestion response.
+type BatchSummary struct {
+	Summary BatchCounts `json:"summary"`
+}
+
+// BatchCounts totals the per-line results of a batch.
+type BatchCounts struct {
+	Processed int  `json:"processed"`
+	Failed    int  `json:"failed"`
+	Invalid   int  `json:"invalid"`
+	Truncated bool `json:"truncated,omitempty"` // stopped at the item limit
+}
+
+// ParseBatchLine decodes one NDJSON line in the Alertmanager alert format.
+// The alertname label is required. Status defaults to firing, and a missing
+// fingerprint is derived from the labels.
+func ParseBatchLine(line []byte) (ProcessAlertInput, error) {
+	var alert AlertmanagerAlert
+	if err := json.Unmarshal(line, &alert); err != nil {
+		return ProcessAlertInput{}, fmt.Errorf("invalid JSON: %w", err)
+	}
+	return parseAlertmanagerAlert(alert)
+}
+
+// parseAlertmanagerAlert validates and converts an alert posted on its own
+// rather than in an Alertmanager webhook.
+func parseAlertmanagerAlert(alert AlertmanagerAlert) (ProcessAlertInput, error) {
+	if alert.Labels["alertname"] == "" {
+		return ProcessAlertInput{}, errors.New("labels.alertname is required")
+	}
+	switch alert.Status {
+	case "":
+		alert.Status = "firing"
+	case "firing", "resolved":
+	default:
+		return ProcessAlertInput{}, fmt.Errorf("status must be firing or resolved, got %q", alert.Status)
+	}
+	if alert.Fingerprint == "" {
+		alert.Fingerprint = labelsFingerprint(alert.Labels)
+	}
+	if alert.StartsAt.IsZero() {
+		alert.StartsAt = time.Now().UTC()
+	}
+
+	return ToProcessAlertInput(alert), nil
+}
@@ -0,0 +1,48 @@
+package dto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+func TestParseBatchLine(t *testing.T) {
+	t.Run("defaults status and fingerprint", func(t *testing.T) {
+		input, err := ParseBatchLine([]byte(`{"labels":{"alertname":"DiskFull","severity":"critical","instance":"db-1"},"annotations":{"summary":"disk at 95%"}}`))
+		require.NoError(t, err)
+
+		assert.Equal(t, "DiskFull", input.Name)
+		assert.Equal(t, "firing", input.Status)
+		assert.Equal(t, entity.SeverityCritical, input.Severity)
+		assert.Equal(t, "disk at 95%", input.Summary)
+		assert.NotEmpty(t, input.Fingerprint)
+		assert.False(t, input.FiredAt.IsZero())
+
+		again, err := ParseBatchLine([]byte(`{"labels":{"instance":"db-1","severity":"critical","alertname":"DiskFull"}}`))
+		require.NoError(t, err)
+		assert.Equal(t, input.Fingerprint, again.Fingerprint)
+	})
+
+	t.Run("keeps explicit fields", func(t *testing.T) {
+		input, err := ParseBatchLine([]byte(`{"status":"resolved","fingerprint":"abc","labels":{"alertname":"X"},"startsAt":"2026-01-02T03:04:05Z"}`))
+		require.NoError(t, err)
+
+		assert.Equal(t, "resolved", input.Status)
+		assert.Equal(t, "abc", input.Fingerprint)
+		assert.Equal(t, 2026, input.FiredAt.Year())
+	})
+
+	for name, line := range map[string]string{
+		"malformed JSON":    `{"labels":`,
+		"missing alertname": `{"labels":{"severity":"critical"}}`,
+		"unknown status":    `{"status":"pending","labels":{"alertname":"X"}}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseBatchLine([]byte(line))
+			assert.Error(t, err)
+		})
+	}
+}
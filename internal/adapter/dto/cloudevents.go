@@ -0,0 +1,36 @@
+package dto
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/cloudevents"
+)
+
+// ParseCloudEvent converts a CloudEvent whose data is an alert in the
+// Alertmanager alert format, as the bridge emits them. The event subject
+// stands in for a missing alertname label, a missing status is resolved for
+// event types ending in ".resolved" and firing otherwise, and the event time
+// stands in for a missing startsAt.
+func ParseCloudEvent(event cloudevents.Event) (ProcessAlertInput, error) {
+	var alert AlertmanagerAlert
+	if err := json.Unmarshal(event.Data, &alert); err != nil {
+		return ProcessAlertInput{}, fmt.Errorf("invalid data: %w", err)
+	}
+
+	if alert.Labels == nil {
+		alert.Labels = make(map[string]string)
+	}
+	if alert.Labels["alertname"] == "" {
+		alert.Labels["alertname"] = event.Subject
+	}
+	if alert.Status == "" && strings.HasSuffix(event.Type, ".resolved") {
+		alert.Status = "resolved"
+	}
+	if alert.StartsAt.IsZero() {
+		alert.StartsAt = event.Time
+	}
+
+	return parseAlertmanagerAlert(alert)
+}
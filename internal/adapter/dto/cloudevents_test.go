@@ -0,0 +1,60 @@
+package dto
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/cloudevents"
+)
+
+func TestParseCloudEvent(t *testing.T) {
+	t.Run("round trips emitted events", func(t *testing.T) {
+		a := entity.NewAlert("fp-1", "HighCPU", "web-1", "", "CPU above 90%", entity.SeverityCritical)
+		a.Labels["severity"] = "critical"
+		a.Annotations["summary"] = "CPU above 90%"
+		a.Resolve(a.FiredAt.Add(time.Minute))
+
+		event, err := cloudevents.NewClient("alert-bridge").NewEvent(a)
+		require.NoError(t, err)
+
+		input, err := ParseCloudEvent(event)
+		require.NoError(t, err)
+		assert.Equal(t, "HighCPU", input.Name)
+		assert.Equal(t, "resolved", input.Status)
+		assert.Equal(t, "fp-1", input.Fingerprint)
+		assert.Equal(t, entity.SeverityCritical, input.Severity)
+		assert.Equal(t, "CPU above 90%", input.Summary)
+		assert.True(t, a.FiredAt.Equal(input.FiredAt))
+	})
+
+	t.Run("falls back to event attributes", func(t *testing.T) {
+		at := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+		input, err := ParseCloudEvent(cloudevents.Event{
+			Type:    "com.example.check.resolved",
+			Subject: "DiskFull",
+			Time:    at,
+			Data:    json.RawMessage(`{"labels":{"instance":"db-1"}}`),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "DiskFull", input.Name)
+		assert.Equal(t, "resolved", input.Status)
+		assert.Equal(t, at, input.FiredAt)
+		assert.NotEmpty(t, input.Fingerprint)
+	})
+
+	for name, data := range map[string]string{
+		"not an object":     `"firing"`,
+		"missing alertname": `{"labels":{"severity":"critical"}}`,
+		"unknown status":    `{"status":"pending","labels":{"alertname":"X"}}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseCloudEvent(cloudevents.Event{Type: "t", Data: json.RawMessage(data)})
+			assert.Error(t, err)
+		})
+	}
+}
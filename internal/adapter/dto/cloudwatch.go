@@ -0,0 +1,140 @@
+package dto
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// CloudWatch alarm states.
+const (
+	CloudWatchStateAlarm            = "ALARM"
+	CloudWatchStateOK               = "OK"
+	CloudWatchStateInsufficientData = "INSUFFICIENT_DATA"
+)
+
+// cloudWatchTimeLayout is the StateChangeTime format, e.g. "2026-01-02T15:04:05.000+0000".
+const cloudWatchTimeLayout = "2006-01-02T15:04:05.000-0700"
+
+// CloudWatchAlarm represents a CloudWatch alarm state change, delivered as
+// the Message of an SNS notification.
+// See: https://docs.aws.amazon.com/AmazonCloudWatch/latest/monitoring/AlarmThatSendsEmail.html
+type CloudWatchAlarm struct {
+	AlarmName        string             `json:"AlarmName"`
+	AlarmDescription string             `json:"AlarmDescription"`
+	AWSAccountID     string             `json:"AWSAccountId"`
+	NewStateValue    string             `json:"NewStateValue"`
+	NewStateReason   string             `json:"NewStateReason"`
+	StateChangeTime  string             `json:"StateChangeTime"`
+	Region           string             `json:"Region"` // Display name, e.g. "US East (N. Virginia)"
+	AlarmArn         string             `json:"AlarmArn"`
+	OldStateValue    string             `json:"OldStateValue"`
+	Trigger          *CloudWatchTrigger `json:"Trigger"` // Nil for composite alarms
+}
+
+// CloudWatchTrigger describes the metric a CloudWatch alarm evaluates.
+type CloudWatchTrigger struct {
+	MetricName         string                `json:"MetricName"`
+	Namespace          string                `json:"Namespace"`
+	Statistic          string                `json:"Statistic"`
+	Dimensions         []CloudWatchDimension `json:"Dimensions"`
+	ComparisonOperator string                `json:"ComparisonOperator"`
+	Threshold          float64               `json:"Threshold"`
+}
+
+// CloudWatchDimension is a single metric dimension.
+type CloudWatchDimension struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// RegionCode returns the region code (e.g. "us-east-1") from the alarm ARN.
+func (a *CloudWatchAlarm) RegionCode() string {
+	// arn:aws:cloudwatch:<region>:<account>:alarm:<name>
+	parts := strings.SplitN(a.AlarmArn, ":", 6)
+	if len(parts) < 6 {
+		return ""
+	}
+	return parts[3]
+}
+
+// ToProcessAlertInput converts the alarm to ProcessAlertInput.
+// ALARM maps to firing and OK to resolved. Returns false for other states
+// (INSUFFICIENT_DATA), which carry no actionable signal.
+// CloudWatch has no notion of severity, so severity is supplied by the caller.
+func (a *CloudWatchAlarm) ToProcessAlertInput(severity entity.AlertSeverity) (ProcessAlertInput, bool) {
+	var status string
+	switch a.NewStateValue {
+	case CloudWatchStateAlarm:
+		status = "firing"
+	case CloudWatchStateOK:
+		status = "resolved"
+	default:
+		return ProcessAlertInput{}, false
+	}
+
+	region := a.RegionCode()
+	labels := map[string]string{
+		"alertname":      a.AlarmName,
+		"aws_account_id": a.AWSAccountID,
+		"region":         region,
+		"severity":       string(severity),
+	}
+
+	var instance, target string
+	if a.Trigger != nil {
+		setIfMissing(labels, "namespace", a.Trigger.Namespace)
+		setIfMissing(labels, "metric_name", a.Trigger.MetricName)
+		for _, d := range a.Trigger.Dimensions {
+			setIfMissing(labels, d.Name, d.Value)
+		}
+		if len(a.Trigger.Dimensions) > 0 {
+			instance = a.Trigger.Dimensions[0].Value
+		}
+		target = a.Trigger.Namespace
+	}
+
+	annotations := map[string]string{
+		"description": a.NewStateReason,
+	}
+	setIfMissing(annotations, "alarm_arn", a.AlarmArn)
+	if region != "" && a.AlarmName != "" {
+		annotations["console_url"] = fmt.Sprintf(
+			"https://%s.console.aws.amazon.com/cloudwatch/home?region=%s#alarmsV2:alarm/%s",
+			region, region, url.PathEscape(a.AlarmName),
+		)
+	}
+
+	summary := a.AlarmDescription
+	if summary == "" {
+		summary = a.NewStateReason
+	}
+
+	input := ProcessAlertInput{
+		// The alarm ARN identifies the alarm across state changes
+		Fingerprint: labelsFingerprint(map[string]string{"alarm_arn": a.AlarmArn}),
+		Name:        a.AlarmName,
+		Instance:    instance,
+		Target:      target,
+		Summary:     summary,
+		Description: a.NewStateReason,
+		Severity:    severity,
+		Status:      status,
+		Labels:      labels,
+		Annotations: annotations,
+	}
+
+	changedAt, err := time.Parse(cloudWatchTimeLayout, a.StateChangeTime)
+	if err != nil {
+		changedAt = time.Now()
+	}
+	input.FiredAt = changedAt.UTC()
+	if status == "resolved" {
+		input.EndsAt = changedAt.UTC()
+	}
+
+	return input, true
+}
@@ -0,0 +1,64 @@
+package dto
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+const cloudWatchAlarmJSON = `{
+  "AlarmName": "HighCPU",
+  "AlarmDescription": "CPU above 80% on web tier",
+  "AWSAccountId": "123456789012",
+  "NewStateValue": "ALARM",
+  "NewStateReason": "Threshold Crossed: 1 datapoint [91.5] was greater than the threshold (80.0).",
+  "StateChangeTime": "2026-01-02T15:04:05.000+0000",
+  "Region": "US East (N. Virginia)",
+  "AlarmArn": "arn:aws:cloudwatch:us-east-1:123456789012:alarm:HighCPU",
+  "OldStateValue": "OK",
+  "Trigger": {
+    "MetricName": "CPUUtilization",
+    "Namespace": "AWS/EC2",
+    "Statistic": "AVERAGE",
+    "Dimensions": [{"name": "InstanceId", "value": "i-0abc123"}],
+    "ComparisonOperator": "GreaterThanThreshold",
+    "Threshold": 80.0
+  }
+}`
+
+func TestCloudWatchAlarm_ToProcessAlertInput(t *testing.T) {
+	var alarm CloudWatchAlarm
+	require.NoError(t, json.Unmarshal([]byte(cloudWatchAlarmJSON), &alarm))
+
+	input, ok := alarm.ToProcessAlertInput(entity.SeverityWarning)
+	require.True(t, ok)
+
+	assert.Equal(t, "firing", input.Status)
+	assert.Equal(t, "HighCPU", input.Name)
+	assert.Equal(t, "i-0abc123", input.Instance)
+	assert.Equal(t, "AWS/EC2", input.Target)
+	assert.Equal(t, "CPU above 80% on web tier", input.Summary)
+	assert.Equal(t, entity.SeverityWarning, input.Severity)
+	assert.Equal(t, "us-east-1", input.Labels["region"])
+	assert.Equal(t, "i-0abc123", input.Labels["InstanceId"])
+	assert.Equal(t, time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC), input.FiredAt)
+	assert.True(t, input.EndsAt.IsZero())
+	assert.Contains(t, input.Annotations["console_url"], "region=us-east-1")
+
+	// The OK notification for the same alarm resolves the same alert
+	alarm.NewStateValue = CloudWatchStateOK
+	resolved, ok := alarm.ToProcessAlertInput(entity.SeverityWarning)
+	require.True(t, ok)
+	assert.Equal(t, "resolved", resolved.Status)
+	assert.Equal(t, input.Fingerprint, resolved.Fingerprint)
+	assert.Equal(t, resolved.FiredAt, resolved.EndsAt)
+
+	alarm.NewStateValue = CloudWatchStateInsufficientData
+	_, ok = alarm.ToProcessAlertInput(entity.SeverityWarning)
+	assert.False(t, ok)
+}
@@ -0,0 +1,55 @@
+package dto
+
+import (
+	"errors"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// ReplayDeadLettersRequest is the body of POST /api/v1/dead-letters/replay.
+// Exactly one of IDs and All must be set.
+type ReplayDeadLettersRequest struct {
+	IDs  []string `json:"ids"`
+	All  bool     `json:"all"`
+	User string   `json:"user"`
+}
+
+// Validate checks that the request names what to replay.
+func (r *ReplayDeadLettersRequest) Validate() error {
+	if r.All && len(r.IDs) > 0 {
+		return errors.New("ids and all are mutually exclusive")
+	}
+	if !r.All && len(r.IDs) == 0 {
+		return errors.New("ids or all is required")
+	}
+	return nil
+}
+
+// DeadLetterResponse is the JSON representation of a dead-lettered
+// notification in API responses.
+type DeadLetterResponse struct {
+	ID        string    `json:"id"`
+	AlertID   string    `json:"alert_id"`
+	Notifier  string    `json:"notifier"`
+	Action    string    `json:"action"`
+	Attempts  int       `json:"attempts"`
+	LastError string    `json:"last_error"`
+	CreatedAt time.Time `json:"created_at"`
+	FailedAt  time.Time `json:"failed_at"`
+}
+
+// NewDeadLetterResponse converts a dead-lettered retry to its API
+// representation.
+func NewDeadLetterResponse(retry *entity.NotificationRetry) DeadLetterResponse {
+	return DeadLetterResponse{
+		ID:        retry.ID,
+		AlertID:   retry.AlertID,
+		Notifier:  retry.Notifier,
+		Action:    string(retry.Action),
+		Attempts:  retry.Attempts,
+		LastError: retry.LastError,
+		CreatedAt: retry.CreatedAt,
+		FailedAt:  retry.UpdatedAt,
+	}
+}
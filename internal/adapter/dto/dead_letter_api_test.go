@@ -0,0 +1,31 @@
+package dto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplayDeadLettersRequest_Validate(t *testing.T) {
+	assert.NoError(t, (&ReplayDeadLettersRequest{IDs: []string{"a1/slack/notify"}}).Validate())
+	assert.NoError(t, (&ReplayDeadLettersRequest{All: true}).Validate())
+
+	assert.Error(t, (&ReplayDeadLettersRequest{}).Validate())
+	assert.Error(t, (&ReplayDeadLettersRequest{IDs: []string{"a1/slack/notify"}, All: true}).Validate())
+}
+
+func TestParseDeadLetterRequest(t *testing.T) {
+	for text, want := range map[string]DeadLetterRequest{
+		"":                            {Action: DeadLetterActionList},
+		"list":                        {Action: DeadLetterActionList},
+		"replay a1/slack/notify":      {Action: DeadLetterActionReplay, ID: "a1/slack/notify"},
+		"replay ALL":                  {Action: DeadLetterActionReplay, All: true},
+		"discard a1/pagerduty/update": {Action: DeadLetterActionDiscard, ID: "a1/pagerduty/update"},
+	} {
+		t.Run(text, func(t *testing.T) {
+			cmd := &SlackCommandDTO{Text: text, UserID: "U1", UserName: "alice"}
+			want.UserID, want.UserName = "U1", "alice"
+			assert.Equal(t, &want, cmd.ParseDeadLetterRequest())
+		})
+	}
+}
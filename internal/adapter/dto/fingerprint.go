@@ -0,0 +1,48 @@
+package dto
+
+import (
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/config"
+)
+
+// Fingerprint strategies.
+const (
+	FingerprintUpstream  = "upstream"
+	FingerprintLabels    = "labels"
+	FingerprintAllLabels = "all_labels"
+)
+
+// FingerprintStrategy recomputes the fingerprint of a source's alerts. The
+// zero value keeps the fingerprint the DTO produced.
+type FingerprintStrategy struct {
+	strategy  string
+	labelKeys []string
+}
+
+// NewFingerprintStrategy builds a strategy from a source's fingerprinting
+// config. The config is assumed to be validated.
+func NewFingerprintStrategy(cfg config.FingerprintConfig) FingerprintStrategy {
+	return FingerprintStrategy{
+		strategy:  cfg.Strategy,
+		labelKeys: cfg.LabelKeys,
+	}
+}
+
+// Apply sets input.Fingerprint according to the strategy. With the upstream
+// strategy a missing fingerprint is derived from all labels, so alerts are
+// never stored without one.
+func (s FingerprintStrategy) Apply(input *ProcessAlertInput) {
+	switch s.strategy {
+	case FingerprintLabels:
+		selected := make(map[string]string, len(s.labelKeys))
+		for _, key := range s.labelKeys {
+			selected[key] = input.Labels[key]
+		}
+		input.Fingerprint = labelsFingerprint(selected)
+	case FingerprintAllLabels:
+		input.Fingerprint = labelsFingerprint(input.Labels)
+	default:
+		if input.Fingerprint == "" {
+			input.Fingerprint = labelsFingerprint(input.Labels)
+		}
+	}
+}
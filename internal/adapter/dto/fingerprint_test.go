@@ -0,0 +1,75 @@
+package dto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/config"
+)
+
+func TestFingerprintStrategy_Apply(t *testing.T) {
+	newInput := func(fingerprint, pod string) ProcessAlertInput {
+		return ProcessAlertInput{
+			Fingerprint: fingerprint,
+			Labels: map[string]string{
+				"alertname": "HighLatency",
+				"service":   "api",
+				"pod":       pod,
+			},
+		}
+	}
+
+	t.Run("upstream keeps sender fingerprint", func(t *testing.T) {
+		input := newInput("abc123", "api-7f9c")
+		NewFingerprintStrategy(config.FingerprintConfig{}).Apply(&input)
+		assert.Equal(t, "abc123", input.Fingerprint)
+	})
+
+	t.Run("upstream falls back to all labels", func(t *testing.T) {
+		input := newInput("", "api-7f9c")
+		NewFingerprintStrategy(config.FingerprintConfig{Strategy: "upstream"}).Apply(&input)
+		assert.Equal(t, labelsFingerprint(input.Labels), input.Fingerprint)
+	})
+
+	t.Run("labels ignores other labels and upstream", func(t *testing.T) {
+		strategy := NewFingerprintStrategy(config.FingerprintConfig{
+			Strategy:  "labels",
+			LabelKeys: []string{"alertname", "service"},
+		})
+		a := newInput("unstable-1", "api-7f9c")
+		b := newInput("unstable-2", "api-2b1d")
+		strategy.Apply(&a)
+		strategy.Apply(&b)
+
+		assert.Equal(t, a.Fingerprint, b.Fingerprint)
+		assert.NotEqual(t, "unstable-1", a.Fingerprint)
+	})
+
+	t.Run("labels distinguishes missing keys", func(t *testing.T) {
+		strategy := NewFingerprintStrategy(config.FingerprintConfig{
+			Strategy:  "labels",
+			LabelKeys: []string{"alertname", "region"},
+		})
+		a := newInput("", "api-7f9c")
+		b := newInput("", "api-7f9c")
+		b.Labels["region"] = "eu-west-1"
+		strategy.Apply(&a)
+		strategy.Apply(&b)
+
+		assert.NotEqual(t, a.Fingerprint, b.Fingerprint)
+	})
+
+	t.Run("all labels ignores upstream", func(t *testing.T) {
+		strategy := NewFingerprintStrategy(config.FingerprintConfig{Strategy: "all_labels"})
+		a := newInput("unstable-1", "api-7f9c")
+		b := newInput("unstable-2", "api-7f9c")
+		c := newInput("unstable-3", "api-2b1d")
+		strategy.Apply(&a)
+		strategy.Apply(&b)
+		strategy.Apply(&c)
+
+		assert.Equal(t, a.Fingerprint, b.Fingerprint)
+		assert.NotEqual(t, a.Fingerprint, c.Fingerprint)
+	})
+}
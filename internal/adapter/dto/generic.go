@@ -0,0 +1,215 @@
+package dto
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/config"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/jsonmap"
+)
+
+// GenericMapping maps decoded JSON payloads of a generic webhook source to
+// ProcessAlertInput, using the expressions configured for the source.
+type GenericMapping struct {
+	alerts      *jsonmap.Expr
+	fingerprint *jsonmap.Expr
+	name        *jsonmap.Expr
+	status      *jsonmap.Expr
+	severity    *jsonmap.Expr
+	instance    *jsonmap.Expr
+	target      *jsonmap.Expr
+	summary     *jsonmap.Expr
+	description *jsonmap.Expr
+	firedAt     *jsonmap.Expr
+	labels      map[string]*jsonmap.Expr
+	annotations map[string]*jsonmap.Expr
+
+	severityMap map[string]entity.AlertSeverity
+	resolved    map[string]bool
+}
+
+// NewGenericMapping compiles the expressions of a generic webhook source.
+func NewGenericMapping(cfg config.GenericWebhookConfig) (*GenericMapping, error) {
+	m := &GenericMapping{
+		labels:      make(map[string]*jsonmap.Expr, len(cfg.Mapping.Labels)),
+		annotations: make(map[string]*jsonmap.Expr, len(cfg.Mapping.Annotations)),
+		severityMap: make(map[string]entity.AlertSeverity, len(cfg.Mapping.SeverityMap)),
+		resolved:    make(map[string]bool, len(cfg.Mapping.ResolvedValues)),
+	}
+
+	compile := func(field, src string) (*jsonmap.Expr, error) {
+		if src == "" {
+			return nil, nil
+		}
+		e, err := jsonmap.Compile(src)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", field, err)
+		}
+		return e, nil
+	}
+
+	var err error
+	if m.alerts, err = compile("alerts", cfg.Alerts); err != nil {
+		return nil, err
+	}
+	fields := []struct {
+		name string
+		src  string
+		dst  **jsonmap.Expr
+	}{
+		{"fingerprint", cfg.Mapping.Fingerprint, &m.fingerprint},
+		{"name", cfg.Mapping.Name, &m.name},
+		{"status", cfg.Mapping.Status, &m.status},
+		{"severity", cfg.Mapping.Severity, &m.severity},
+		{"instance", cfg.Mapping.Instance, &m.instance},
+		{"target", cfg.Mapping.Target, &m.target},
+		{"summary", cfg.Mapping.Summary, &m.summary},
+		{"description", cfg.Mapping.Description, &m.description},
+		{"fired_at", cfg.Mapping.FiredAt, &m.firedAt},
+	}
+	for _, f := range fields {
+		if *f.dst, err = compile(f.name, f.src); err != nil {
+			return nil, err
+		}
+	}
+	if m.name == nil {
+		return nil, fmt.Errorf("name: expression is required")
+	}
+
+	for k, src := range cfg.Mapping.Labels {
+		if m.labels[k], err = compile("labels."+k, src); err != nil {
+			return nil, err
+		}
+	}
+	for k, src := range cfg.Mapping.Annotations {
+		if m.annotations[k], err = compile("annotations."+k, src); err != nil {
+			return nil, err
+		}
+	}
+	for from, to := range cfg.Mapping.SeverityMap {
+		m.severityMap[strings.ToLower(from)] = entity.AlertSeverity(to)
+	}
+	for _, v := range cfg.Mapping.ResolvedValues {
+		m.resolved[strings.ToLower(v)] = true
+	}
+
+	return m, nil
+}
+
+// Map converts a decoded payload to alert inputs: one per element of the
+// alerts array if configured, otherwise one for the whole payload.
+func (m *GenericMapping) Map(doc any) ([]ProcessAlertInput, error) {
+	items := []any{doc}
+	if m.alerts != nil {
+		arr, ok := m.alerts.Select(doc).([]any)
+		if !ok {
+			return nil, fmt.Errorf("alerts: %s is not an array", m.alerts)
+		}
+		items = arr
+	}
+
+	inputs := make([]ProcessAlertInput, 0, len(items))
+	for i, item := range items {
+		input, err := m.mapOne(item)
+		if err != nil {
+			return nil, fmt.Errorf("alert %d: %w", i, err)
+		}
+		inputs = append(inputs, input)
+	}
+	return inputs, nil
+}
+
+// mapOne maps a single alert object.
+func (m *GenericMapping) mapOne(doc any) (ProcessAlertInput, error) {
+	var evalErr error
+	eval := func(e *jsonmap.Expr) string {
+		if e == nil || evalErr != nil {
+			return ""
+		}
+		s, err := e.Eval(doc)
+		if err != nil {
+			evalErr = err
+		}
+		return strings.TrimSpace(s)
+	}
+
+	name := eval(m.name)
+	severity := m.mapSeverity(eval(m.severity))
+
+	labels := make(map[string]string, len(m.labels)+2)
+	for k, e := range m.labels {
+		setIfMissing(labels, k, eval(e))
+	}
+	labels["alertname"] = name
+	labels["severity"] = string(severity)
+
+	annotations := make(map[string]string, len(m.annotations))
+	for k, e := range m.annotations {
+		setIfMissing(annotations, k, eval(e))
+	}
+
+	input := ProcessAlertInput{
+		Fingerprint: eval(m.fingerprint),
+		Name:        name,
+		Instance:    eval(m.instance),
+		Target:      eval(m.target),
+		Summary:     eval(m.summary),
+		Description: eval(m.description),
+		Severity:    severity,
+		Status:      "firing",
+		Labels:      labels,
+		Annotations: annotations,
+		FiredAt:     parseGenericTime(eval(m.firedAt)),
+	}
+	if evalErr != nil {
+		return ProcessAlertInput{}, evalErr
+	}
+	if name == "" {
+		return ProcessAlertInput{}, fmt.Errorf("name evaluated to empty string")
+	}
+
+	setIfMissing(annotations, "summary", input.Summary)
+	setIfMissing(annotations, "description", input.Description)
+
+	if input.Fingerprint == "" {
+		input.Fingerprint = labelsFingerprint(labels)
+	}
+	if m.resolved[strings.ToLower(eval(m.status))] {
+		input.Status = "resolved"
+		input.EndsAt = time.Now().UTC()
+	}
+
+	return input, nil
+}
+
+// mapSeverity applies the configured severity map, falling back to the
+// standard severity names.
+func (m *GenericMapping) mapSeverity(value string) entity.AlertSeverity {
+	value = strings.ToLower(value)
+	if severity, ok := m.severityMap[value]; ok {
+		return severity
+	}
+	return mapSeverity(value)
+}
+
+// parseGenericTime parses RFC 3339 or Unix seconds/milliseconds, falling
+// back to the current time.
+func parseGenericTime(s string) time.Time {
+	if s == "" {
+		return time.Now().UTC()
+	}
+	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		return t.UTC()
+	}
+	if n, err := strconv.ParseFloat(s, 64); err == nil && n > 0 {
+		// Values this large are milliseconds (seconds would be past year 33000)
+		if n > 1e12 {
+			return time.UnixMilli(int64(n)).UTC()
+		}
+		return time.Unix(int64(n), 0).UTC()
+	}
+	return time.Now().UTC()
+}
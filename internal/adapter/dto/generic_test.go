@@ -0,0 +1,129 @@
+package dto
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/config"
+)
+
+const genericPayloadJSON = `{
+  "events": [
+    {
+      "id": "chk-1",
+      "check": {"name": "api-latency", "host": "api-1"},
+      "state": "DOWN",
+      "priority": "P1",
+      "message": "p99 above 2s",
+      "ts": 1767366245,
+      "env": "prod"
+    },
+    {
+      "id": "chk-2",
+      "check": {"name": "disk", "host": "db-1"},
+      "state": "UP",
+      "priority": "P3",
+      "ts": "2026-01-02T15:04:05Z",
+      "env": "prod"
+    }
+  ]
+}`
+
+func genericTestConfig() config.GenericWebhookConfig {
+	return config.GenericWebhookConfig{
+		Name:   "checks",
+		Alerts: "$.events",
+		Mapping: config.GenericMappingConfig{
+			Fingerprint:    "$.id",
+			Name:           "$.check.name",
+			Status:         "$.state",
+			ResolvedValues: []string{"up"},
+			Severity:       "$.priority",
+			SeverityMap:    map[string]string{"P1": "critical", "P2": "warning"},
+			Instance:       "$.check.host",
+			Summary:        "{{.check.name}} on {{.check.host}}",
+			Description:    "$.message",
+			FiredAt:        "$.ts",
+			Labels:         map[string]string{"env": "$.env", "team": "sre"},
+		},
+	}
+}
+
+func decodeGeneric(t *testing.T, s string) any {
+	t.Helper()
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+	var doc any
+	require.NoError(t, dec.Decode(&doc))
+	return doc
+}
+
+func TestGenericMapping_Map(t *testing.T) {
+	mapping, err := NewGenericMapping(genericTestConfig())
+	require.NoError(t, err)
+
+	inputs, err := mapping.Map(decodeGeneric(t, genericPayloadJSON))
+	require.NoError(t, err)
+	require.Len(t, inputs, 2)
+
+	firing := inputs[0]
+	assert.Equal(t, "chk-1", firing.Fingerprint)
+	assert.Equal(t, "api-latency", firing.Name)
+	assert.Equal(t, "firing", firing.Status)
+	assert.Equal(t, entity.SeverityCritical, firing.Severity)
+	assert.Equal(t, "api-1", firing.Instance)
+	assert.Equal(t, "api-latency on api-1", firing.Summary)
+	assert.Equal(t, "p99 above 2s", firing.Description)
+	assert.Equal(t, time.Unix(1767366245, 0).UTC(), firing.FiredAt)
+	assert.Equal(t, "prod", firing.Labels["env"])
+	assert.Equal(t, "sre", firing.Labels["team"])
+	assert.Equal(t, "api-latency", firing.Labels["alertname"])
+
+	resolved := inputs[1]
+	assert.Equal(t, "resolved", resolved.Status)
+	assert.Equal(t, entity.SeverityInfo, resolved.Severity)
+	assert.False(t, resolved.EndsAt.IsZero())
+	assert.Equal(t, time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC), resolved.FiredAt)
+}
+
+func TestGenericMapping_SinglePayloadAndDefaultFingerprint(t *testing.T) {
+	cfg := genericTestConfig()
+	cfg.Alerts = ""
+	cfg.Mapping.Fingerprint = ""
+	mapping, err := NewGenericMapping(cfg)
+	require.NoError(t, err)
+
+	doc := decodeGeneric(t, `{"check": {"name": "api", "host": "api-1"}, "env": "prod"}`)
+	first, err := mapping.Map(doc)
+	require.NoError(t, err)
+	require.Len(t, first, 1)
+	assert.NotEmpty(t, first[0].Fingerprint)
+	assert.Equal(t, "firing", first[0].Status)
+
+	// Stable across deliveries
+	second, err := mapping.Map(doc)
+	require.NoError(t, err)
+	assert.Equal(t, first[0].Fingerprint, second[0].Fingerprint)
+}
+
+func TestGenericMapping_Errors(t *testing.T) {
+	mapping, err := NewGenericMapping(genericTestConfig())
+	require.NoError(t, err)
+
+	_, err = mapping.Map(decodeGeneric(t, `{"events": {"id": "x"}}`))
+	assert.Error(t, err)
+
+	_, err = mapping.Map(decodeGeneric(t, `{"events": [{"id": "x"}]}`))
+	assert.Error(t, err, "empty name")
+
+	cfg := genericTestConfig()
+	cfg.Mapping.Name = ""
+	_, err = NewGenericMapping(cfg)
+	assert.Error(t, err)
+}
@@ -0,0 +1,122 @@
+package dto
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strings"
+	"time"
+)
+
+// GrafanaWebhook represents the webhook payload from Grafana unified alerting.
+// See: https://grafana.com/docs/grafana/latest/alerting/configure-notifications/manage-contact-points/integrations/webhook-notifier/
+type GrafanaWebhook struct {
+	Receiver          string            `json:"receiver"`
+	Status            string            `json:"status"` // "firing" or "resolved"
+	OrgID             int64             `json:"orgId"`
+	Alerts            []GrafanaAlert    `json:"alerts"`
+	GroupLabels       map[string]string `json:"groupLabels"`
+	CommonLabels      map[string]string `json:"commonLabels"`
+	CommonAnnotations map[string]string `json:"commonAnnotations"`
+	ExternalURL       string            `json:"externalURL"`
+	Version           string            `json:"version"`
+	GroupKey          string            `json:"groupKey"`
+	TruncatedAlerts   int               `json:"truncatedAlerts"`
+	Title             string            `json:"title"`
+	State             string            `json:"state"`
+	Message           string            `json:"message"`
+}
+
+// GrafanaAlert represents a single alert in the Grafana webhook payload.
+type GrafanaAlert struct {
+	Status       string            `json:"status"` // "firing" or "resolved"
+	Labels       map[string]string `json:"labels"`
+	Annotations  map[string]string `json:"annotations"`
+	StartsAt     time.Time         `json:"startsAt"`
+	EndsAt       time.Time         `json:"endsAt"`
+	GeneratorURL string            `json:"generatorURL"`
+	Fingerprint  string            `json:"fingerprint"`
+	SilenceURL   string            `json:"silenceURL"`
+	DashboardURL string            `json:"dashboardURL"`
+	PanelURL     string            `json:"panelURL"`
+	ValueString  string            `json:"valueString"`
+}
+
+// ToProcessAlertInput converts an alert carried by this webhook to ProcessAlertInput.
+// Group-level labels and annotations are merged in the same way as for
+// Alertmanager, and Grafana-internal labels (prefixed "__") are dropped.
+func (w *GrafanaWebhook) ToProcessAlertInput(alert GrafanaAlert) ProcessAlertInput {
+	labels := mergeMissing(alert.Labels, w.GroupLabels, w.CommonLabels)
+	for k := range labels {
+		if strings.HasPrefix(k, "__") {
+			delete(labels, k)
+		}
+	}
+
+	annotations := mergeMissing(alert.Annotations, w.CommonAnnotations)
+	setIfMissing(annotations, "dashboard_url", alert.DashboardURL)
+	setIfMissing(annotations, "panel_url", alert.PanelURL)
+	setIfMissing(annotations, "silence_url", alert.SilenceURL)
+	setIfMissing(annotations, "value", alert.ValueString)
+
+	name := labels["alertname"]
+	if name == "" {
+		name = w.Title
+	}
+
+	// Grafana alerts often lack a job label; the folder is the next best grouping
+	target := labels["job"]
+	if target == "" {
+		target = labels["grafana_folder"]
+	}
+
+	fingerprint := alert.Fingerprint
+	if fingerprint == "" {
+		fingerprint = labelsFingerprint(labels)
+	}
+
+	return ProcessAlertInput{
+		Fingerprint: fingerprint,
+		Name:        name,
+		Instance:    labels["instance"],
+		Target:      target,
+		Summary:     annotations["summary"],
+		Description: annotations["description"],
+		Severity:    mapSeverity(labels["severity"]),
+		Status:      alert.Status,
+		Labels:      labels,
+		Annotations: annotations,
+		FiredAt:     alert.StartsAt,
+		EndsAt:      alert.EndsAt,
+		GroupKey:    w.GroupKey,
+	}
+}
+
+// setIfMissing sets m[key] to value if value is non-empty and key is unset.
+func setIfMissing(m map[string]string, key, value string) {
+	if value == "" {
+		return
+	}
+	if _, ok := m[key]; !ok {
+		m[key] = value
+	}
+}
+
+// labelsFingerprint derives a stable fingerprint from a label set, for senders
+// (older Grafana versions) that do not include one.
+func labelsFingerprint(labels map[string]string) string {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	h := sha256.New()
+	for _, k := range keys {
+		h.Write([]byte(k))
+		h.Write([]byte{0})
+		h.Write([]byte(labels[k]))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
@@ -0,0 +1,68 @@
+package dto
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+const grafanaPayload = `{
+  "receiver": "alert-bridge",
+  "status": "firing",
+  "orgId": 1,
+  "groupKey": "{}/{}:{alertname=\"HighLatency\"}",
+  "truncatedAlerts": 0,
+  "title": "[FIRING:1] HighLatency",
+  "commonLabels": {"alertname": "HighLatency", "team": "api"},
+  "alerts": [
+    {
+      "status": "firing",
+      "labels": {
+        "alertname": "HighLatency",
+        "severity": "critical",
+        "grafana_folder": "Backend",
+        "__alert_rule_uid__": "abc"
+      },
+      "annotations": {"summary": "p99 latency above 2s"},
+      "startsAt": "2025-01-15T10:00:00Z",
+      "endsAt": "0001-01-01T00:00:00Z",
+      "fingerprint": "3c1d2f0e9a7b",
+      "dashboardURL": "https://grafana.example.com/d/xyz",
+      "valueString": "[ var='A' value=2.4 ]"
+    }
+  ]
+}`
+
+func TestGrafanaWebhook_ToProcessAlertInput(t *testing.T) {
+	var payload GrafanaWebhook
+	require.NoError(t, json.Unmarshal([]byte(grafanaPayload), &payload))
+	require.Len(t, payload.Alerts, 1)
+
+	input := payload.ToProcessAlertInput(payload.Alerts[0])
+
+	assert.Equal(t, "3c1d2f0e9a7b", input.Fingerprint)
+	assert.Equal(t, "HighLatency", input.Name)
+	assert.Equal(t, entity.SeverityCritical, input.Severity)
+	assert.Equal(t, "Backend", input.Target)
+	assert.Equal(t, "p99 latency above 2s", input.Summary)
+	assert.Equal(t, "api", input.Labels["team"])
+	assert.NotContains(t, input.Labels, "__alert_rule_uid__")
+	assert.Equal(t, "https://grafana.example.com/d/xyz", input.Annotations["dashboard_url"])
+	assert.Equal(t, payload.GroupKey, input.GroupKey)
+	assert.True(t, input.EndsAt.IsZero())
+}
+
+func TestGrafanaWebhook_FingerprintFallback(t *testing.T) {
+	payload := GrafanaWebhook{}
+	alert := GrafanaAlert{Status: "firing", Labels: map[string]string{"alertname": "A", "instance": "x"}}
+
+	first := payload.ToProcessAlertInput(alert)
+	second := payload.ToProcessAlertInput(alert)
+
+	assert.NotEmpty(t, first.Fingerprint)
+	assert.Equal(t, first.Fingerprint, second.Fingerprint)
+}
@@ -0,0 +1,82 @@
+package dto
+
+import "encoding/json"
+
+// FireHydrantWebhook represents a FireHydrant incident webhook.
+// See: https://docs.firehydrant.com/docs/webhooks
+type FireHydrantWebhook struct {
+	Data  FireHydrantWebhookData  `json:"data"`
+	Event FireHydrantWebhookEvent `json:"event"`
+}
+
+// FireHydrantWebhookData carries the incident the webhook is about.
+type FireHydrantWebhookData struct {
+	Incident *FireHydrantIncident `json:"incident"`
+}
+
+// FireHydrantWebhookEvent describes what happened, e.g. operation
+// "UPDATED" of resource type "incident".
+type FireHydrantWebhookEvent struct {
+	Operation    string `json:"operation"`
+	ResourceType string `json:"resource_type"`
+}
+
+// FireHydrantIncident is the incident object of a webhook.
+type FireHydrantIncident struct {
+	ID               string `json:"id"`
+	Name             string `json:"name"`
+	CurrentMilestone string `json:"current_milestone"`
+}
+
+// IncidentIOWebhook represents an incident.io webhook. The payload is an
+// object keyed by the event type, e.g.
+// {"event_type": "public_incident.incident_updated_v2",
+// "public_incident.incident_updated_v2": {...}}.
+// See: https://api-docs.incident.io/tag/Webhooks
+type IncidentIOWebhook struct {
+	EventType string
+	Incident  *IncidentIOIncident
+}
+
+// incident.io event types whose object is the incident.
+const (
+	IncidentIOIncidentCreated = "public_incident.incident_created_v2"
+	IncidentIOIncidentUpdated = "public_incident.incident_updated_v2"
+)
+
+// IncidentIOIncident is the incident object of a webhook.
+type IncidentIOIncident struct {
+	ID             string                   `json:"id"`
+	Name           string                   `json:"name"`
+	IncidentStatus IncidentIOIncidentStatus `json:"incident_status"`
+}
+
+// IncidentIOIncidentStatus is the incident's status. Category is one of
+// "triage", "declined", "merged", "canceled", "live", "learning", "closed".
+type IncidentIOIncidentStatus struct {
+	Name     string `json:"name"`
+	Category string `json:"category"`
+}
+
+// UnmarshalJSON decodes the incident of incident events; other events
+// leave Incident nil.
+func (w *IncidentIOWebhook) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*w = IncidentIOWebhook{}
+	if eventType, ok := raw["event_type"]; ok {
+		if err := json.Unmarshal(eventType, &w.EventType); err != nil {
+			return err
+		}
+	}
+	switch w.EventType {
+	case IncidentIOIncidentCreated, IncidentIOIncidentUpdated:
+		if object, ok := raw[w.EventType]; ok {
+			w.Incident = &IncidentIOIncident{}
+			return json.Unmarshal(object, w.Incident)
+		}
+	}
+	return nil
+}
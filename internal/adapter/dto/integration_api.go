@@ -0,0 +1,34 @@
+package dto
+
+import (
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// IntegrationResponse is the JSON representation of a configured
+// integration in API responses.
+type IntegrationResponse struct {
+	Name         string                          `json:"name"`
+	Type         string                          `json:"type"`
+	Capabilities IntegrationCapabilitiesResponse `json:"capabilities"`
+}
+
+// IntegrationCapabilitiesResponse lists what an integration supports.
+type IntegrationCapabilitiesResponse struct {
+	SupportsAck      bool `json:"supports_ack"`
+	SupportsUpdate   bool `json:"supports_update"`
+	SupportsMentions bool `json:"supports_mentions"`
+}
+
+// NewIntegrationResponse converts an integration of the given type
+// (notifier, syncer or ingestor) to its API representation.
+func NewIntegrationResponse(name, integrationType string, capabilities entity.NotifierCapabilities) IntegrationResponse {
+	return IntegrationResponse{
+		Name: name,
+		Type: integrationType,
+		Capabilities: IntegrationCapabilitiesResponse{
+			SupportsAck:      capabilities.Ack,
+			SupportsUpdate:   capabilities.Update,
+			SupportsMentions: capabilities.Mentions,
+		},
+	}
+}
@@ -0,0 +1,34 @@
+package dto
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Limits on decoded webhook payloads. They are far above what Alertmanager
+// and PagerDuty send, and keep a malformed or hostile payload from making
+// the handlers allocate or process without bound.
+const (
+	// MaxWebhookAlerts bounds the alerts of one Alertmanager webhook.
+	MaxWebhookAlerts = 1000
+
+	// MaxAlertLabels bounds the labels, and separately the annotations, of
+	// one alert or alert group.
+	MaxAlertLabels = 256
+
+	// MaxPagerDutyMessages bounds the messages of one PagerDuty webhook.
+	MaxPagerDutyMessages = 100
+)
+
+// ErrPayloadLimit indicates a webhook payload that exceeds one of the
+// limits above.
+var ErrPayloadLimit = errors.New("payload exceeds limit")
+
+// checkLabelCount returns ErrPayloadLimit if labels has more than
+// MaxAlertLabels entries.
+func checkLabelCount(field string, labels map[string]string) error {
+	if len(labels) > MaxAlertLabels {
+		return fmt.Errorf("%w: %s has %d entries, at most %d are accepted", ErrPayloadLimit, field, len(labels), MaxAlertLabels)
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package dto
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// ManualAlertUsage describes /alert-create, for replies to malformed
+// commands.
+const ManualAlertUsage = "Usage: `/alert-create [critical|warning|info] <name> [label=value ...] [description]`"
+
+// ManualAlertRequest is an alert raised with /alert-create.
+type ManualAlertRequest struct {
+	Name        string
+	Severity    entity.AlertSeverity // "" for the default
+	Description string
+	Labels      map[string]string
+	UserID      string
+	UserName    string
+}
+
+// ParseManualAlertRequest parses the command text of an /alert-create command.
+// Usage: /alert-create [severity] <name> [label=value ...] [description]
+// Examples:
+//   - /alert-create critical CheckoutDown EU customers cannot pay
+//   - /alert-create warning SlowReplication team=db instance=db-2 lag grows
+//   - /alert-create BridgeStuck
+//
+// Labels directly follow the name; everything after them is the
+// description.
+func (d *SlackCommandDTO) ParseManualAlertRequest() (*ManualAlertRequest, error) {
+	req := &ManualAlertRequest{
+		UserID:   d.UserID,
+		UserName: d.UserName,
+	}
+
+	fields := strings.Fields(d.Text)
+	if len(fields) > 0 {
+		switch strings.ToLower(fields[0]) {
+		case "critical", "crit":
+			req.Severity = entity.SeverityCritical
+			fields = fields[1:]
+		case "warning", "warn":
+			req.Severity = entity.SeverityWarning
+			fields = fields[1:]
+		case "info":
+			req.Severity = entity.SeverityInfo
+			fields = fields[1:]
+		}
+	}
+	if len(fields) == 0 {
+		return nil, errors.New("alert name is required")
+	}
+	req.Name = fields[0]
+	fields = fields[1:]
+
+	for len(fields) > 0 {
+		key, value, ok := strings.Cut(fields[0], "=")
+		if !ok || key == "" {
+			break
+		}
+		if req.Labels == nil {
+			req.Labels = make(map[string]string)
+		}
+		req.Labels[key] = value
+		fields = fields[1:]
+	}
+	req.Description = strings.Join(fields, " ")
+	return req, nil
+}
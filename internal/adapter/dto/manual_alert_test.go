@@ -0,0 +1,59 @@
+package dto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+func TestParseManualAlertRequest(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want ManualAlertRequest
+	}{
+		{
+			name: "name only",
+			text: "BridgeStuck",
+			want: ManualAlertRequest{Name: "BridgeStuck"},
+		},
+		{
+			name: "severity and description",
+			text: "CRIT CheckoutDown EU customers cannot pay",
+			want: ManualAlertRequest{
+				Name:        "CheckoutDown",
+				Severity:    entity.SeverityCritical,
+				Description: "EU customers cannot pay",
+			},
+		},
+		{
+			name: "labels before description",
+			text: "warning SlowReplication team=db instance=db-2 lag grows, see x=y",
+			want: ManualAlertRequest{
+				Name:        "SlowReplication",
+				Severity:    entity.SeverityWarning,
+				Labels:      map[string]string{"team": "db", "instance": "db-2"},
+				Description: "lag grows, see x=y",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &SlackCommandDTO{Text: tt.text, UserID: "U1", UserName: "alice"}
+			req, err := cmd.ParseManualAlertRequest()
+			require.NoError(t, err)
+			tt.want.UserID = "U1"
+			tt.want.UserName = "alice"
+			assert.Equal(t, tt.want, *req)
+		})
+	}
+
+	for _, text := range []string{"", "  ", "critical"} {
+		_, err := (&SlackCommandDTO{Text: text}).ParseManualAlertRequest()
+		assert.Error(t, err, text)
+	}
+}
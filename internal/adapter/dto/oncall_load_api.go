@@ -0,0 +1,70 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// OnCallLoadReportResponse is the JSON representation of a monthly on-call
+// load report in API responses.
+type OnCallLoadReportResponse struct {
+	Month       string                     `json:"month"`
+	PeriodStart time.Time                  `json:"period_start"`
+	PeriodEnd   time.Time                  `json:"period_end"`
+	People      []PersonOnCallLoadResponse `json:"people"`
+	Teams       []TeamOnCallLoadResponse   `json:"teams"`
+}
+
+// PersonOnCallLoadResponse is one person's load.
+type PersonOnCallLoadResponse struct {
+	Name           string `json:"name"`
+	SlackUserID    string `json:"slack_user_id,omitempty"`
+	Pages          int    `json:"pages"`
+	Acks           int    `json:"acks"`
+	AfterHoursAcks int    `json:"after_hours_acks"`
+}
+
+// TeamOnCallLoadResponse is one team's load and its breakdown per person.
+type TeamOnCallLoadResponse struct {
+	Team           string                     `json:"team"`
+	Pages          int                        `json:"pages"`
+	Acks           int                        `json:"acks"`
+	AfterHoursAcks int                        `json:"after_hours_acks"`
+	People         []PersonOnCallLoadResponse `json:"people"`
+}
+
+// NewOnCallLoadReportResponse converts a report to its API representation.
+func NewOnCallLoadReportResponse(report *entity.OnCallLoadReport) OnCallLoadReportResponse {
+	resp := OnCallLoadReportResponse{
+		Month:       report.Month,
+		PeriodStart: report.PeriodStart,
+		PeriodEnd:   report.PeriodEnd,
+		People:      newPersonOnCallLoadResponses(report.People),
+		Teams:       make([]TeamOnCallLoadResponse, 0, len(report.Teams)),
+	}
+	for _, team := range report.Teams {
+		resp.Teams = append(resp.Teams, TeamOnCallLoadResponse{
+			Team:           team.Team,
+			Pages:          team.Pages,
+			Acks:           team.Acks,
+			AfterHoursAcks: team.AfterHoursAcks,
+			People:         newPersonOnCallLoadResponses(team.People),
+		})
+	}
+	return resp
+}
+
+func newPersonOnCallLoadResponses(people []*entity.PersonOnCallLoad) []PersonOnCallLoadResponse {
+	resp := make([]PersonOnCallLoadResponse, 0, len(people))
+	for _, person := range people {
+		resp = append(resp, PersonOnCallLoadResponse{
+			Name:           person.Name,
+			SlackUserID:    person.SlackUserID,
+			Pages:          person.Pages,
+			Acks:           person.Acks,
+			AfterHoursAcks: person.AfterHoursAcks,
+		})
+	}
+	return resp
+}
@@ -1,6 +1,7 @@
 package dto
 
 import (
+	"fmt"
 	"time"
 )
 
@@ -10,6 +11,15 @@ type PagerDutyWebhookV3 struct {
 	Messages []PagerDutyWebhookMessage `json:"messages"`
 }
 
+// Validate checks the webhook against the payload limits. Returns
+// ErrPayloadLimit if it carries too many messages.
+func (w *PagerDutyWebhookV3) Validate() error {
+	if len(w.Messages) > MaxPagerDutyMessages {
+		return fmt.Errorf("%w: %d messages, at most %d are accepted", ErrPayloadLimit, len(w.Messages), MaxPagerDutyMessages)
+	}
+	return nil
+}
+
 // PagerDutyWebhookMessage represents a single message in the webhook payload.
 type PagerDutyWebhookMessage struct {
 	ID        string                `json:"id"`
@@ -107,6 +117,12 @@ type HandlePagerDutyWebhookInput struct {
 	UserID        string
 	Status        string
 	ResolveReason string
+
+	// Assignee is the first user the incident is assigned to after a
+	// reassignment or escalation.
+	AssigneeID    string
+	AssigneeEmail string
+	AssigneeName  string
 }
 
 // HandlePagerDutyWebhookOutput represents the result of handling a PagerDuty webhook.
@@ -123,6 +139,7 @@ func IsSupportedEventType(eventType string) bool {
 		"incident.resolved":       true,
 		"incident.unacknowledged": true,
 		"incident.reassigned":     true,
+		"incident.escalated":      true,
 	}
 	return supportedTypes[eventType]
 }
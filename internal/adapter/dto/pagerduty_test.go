@@ -0,0 +1,39 @@
+package dto
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPagerDutyWebhookV3_Validate(t *testing.T) {
+	valid := PagerDutyWebhookV3{Messages: make([]PagerDutyWebhookMessage, MaxPagerDutyMessages)}
+	assert.NoError(t, valid.Validate())
+
+	tooMany := PagerDutyWebhookV3{Messages: make([]PagerDutyWebhookMessage, MaxPagerDutyMessages+1)}
+	assert.ErrorIs(t, tooMany.Validate(), ErrPayloadLimit)
+}
+
+func FuzzPagerDutyWebhookV3(f *testing.F) {
+	f.Add([]byte(`{"messages":[{"id":"m1","event":{"event_type":"incident.acknowledged","agent":{"id":"U1","email":"a@example.com"},"data":{"id":"P1","incident_key":"dedup-1","status":"acknowledged","acknowledgers":[{"acknowledger":{"id":"U1","summary":"alice"}}]}}}]}`))
+	f.Add([]byte(`{"messages":[{"event":{"agent":null,"data":{"last_status_change_by":null,"acknowledgers":[]}}}]}`))
+	f.Add([]byte(`{"messages":null}`))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var webhook PagerDutyWebhookV3
+		if err := json.Unmarshal(data, &webhook); err != nil {
+			return
+		}
+		if err := webhook.Validate(); err != nil {
+			if !errors.Is(err, ErrPayloadLimit) {
+				t.Fatalf("Validate returned %v, want ErrPayloadLimit", err)
+			}
+			return
+		}
+		for _, msg := range webhook.Messages {
+			IsSupportedEventType(msg.Event.EventType)
+		}
+	})
+}
@@ -0,0 +1,66 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// ParsePeriod parses a report period like "24h", "7d" or "4w".
+// Returns 0 for invalid formats.
+func ParsePeriod(s string) time.Duration {
+	return parseDuration(s)
+}
+
+// ResponseTimeReportResponse is the JSON representation of a response time
+// report in API responses. Durations are in seconds.
+type ResponseTimeReportResponse struct {
+	PeriodStart time.Time               `json:"period_start"`
+	PeriodEnd   time.Time               `json:"period_end"`
+	Overall     ResponseTimesResponse   `json:"overall"`
+	ByName      []ResponseTimesResponse `json:"by_name"`
+	ByTeam      []ResponseTimesResponse `json:"by_team"`
+	BySeverity  []ResponseTimesResponse `json:"by_severity"`
+}
+
+// ResponseTimesResponse is the response times of all alerts, or of one
+// alert name, team or severity.
+type ResponseTimesResponse struct {
+	Key         string  `json:"key,omitempty"`
+	Fired       int     `json:"fired"`
+	Acked       int     `json:"acked"`
+	Resolved    int     `json:"resolved"`
+	MTTASeconds float64 `json:"mtta_seconds"`
+	MTTRSeconds float64 `json:"mttr_seconds"`
+}
+
+// NewResponseTimeReportResponse converts a report to its API representation.
+func NewResponseTimeReportResponse(report *entity.ResponseTimeReport) ResponseTimeReportResponse {
+	return ResponseTimeReportResponse{
+		PeriodStart: report.PeriodStart,
+		PeriodEnd:   report.PeriodEnd,
+		Overall:     newResponseTimesResponse("", report.Overall),
+		ByName:      newResponseTimeGroupResponses(report.ByName),
+		ByTeam:      newResponseTimeGroupResponses(report.ByTeam),
+		BySeverity:  newResponseTimeGroupResponses(report.BySeverity),
+	}
+}
+
+func newResponseTimeGroupResponses(groups []*entity.ResponseTimeGroup) []ResponseTimesResponse {
+	resp := make([]ResponseTimesResponse, 0, len(groups))
+	for _, group := range groups {
+		resp = append(resp, newResponseTimesResponse(group.Key, group.ResponseTimes))
+	}
+	return resp
+}
+
+func newResponseTimesResponse(key string, times entity.ResponseTimes) ResponseTimesResponse {
+	return ResponseTimesResponse{
+		Key:         key,
+		Fired:       times.Fired,
+		Acked:       times.Acked,
+		Resolved:    times.Resolved,
+		MTTASeconds: times.MTTA.Seconds(),
+		MTTRSeconds: times.MTTR.Seconds(),
+	}
+}
@@ -0,0 +1,214 @@
+package dto
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// Sentry webhook resources, sent in the Sentry-Hook-Resource header.
+const (
+	SentryResourceIssue      = "issue"
+	SentryResourceEventAlert = "event_alert"
+)
+
+// SentryWebhook represents a Sentry integration platform webhook.
+// See: https://docs.sentry.io/organization/integrations/integration-platform/webhooks/
+type SentryWebhook struct {
+	Action string     `json:"action"`
+	Data   SentryData `json:"data"`
+}
+
+// SentryData carries either an issue (resource "issue") or an event that
+// triggered an issue alert rule (resource "event_alert").
+type SentryData struct {
+	Issue         *SentryIssue `json:"issue"`
+	Event         *SentryEvent `json:"event"`
+	TriggeredRule string       `json:"triggered_rule"`
+}
+
+// SentryIssue is the issue object of an issue webhook.
+type SentryIssue struct {
+	ID        json.Number   `json:"id"`
+	ShortID   string        `json:"shortId"`
+	Title     string        `json:"title"`
+	Culprit   string        `json:"culprit"`
+	Level     string        `json:"level"`
+	Status    string        `json:"status"`
+	Substatus string        `json:"substatus"`
+	Permalink string        `json:"permalink"`
+	WebURL    string        `json:"web_url"`
+	FirstSeen time.Time     `json:"firstSeen"`
+	LastSeen  time.Time     `json:"lastSeen"`
+	Project   SentryProject `json:"project"`
+}
+
+// SentryProject identifies the project an issue belongs to.
+type SentryProject struct {
+	ID   json.Number `json:"id"`
+	Slug string      `json:"slug"`
+	Name string      `json:"name"`
+}
+
+// SentryEvent is the event object of an issue alert webhook.
+type SentryEvent struct {
+	EventID     string      `json:"event_id"`
+	IssueID     json.Number `json:"issue_id"`
+	Title       string      `json:"title"`
+	Culprit     string      `json:"culprit"`
+	Level       string      `json:"level"`
+	Environment string      `json:"environment"`
+	Release     string      `json:"release"`
+	URL         string      `json:"url"` // API URL: .../projects/<org>/<project>/events/<id>/
+	WebURL      string      `json:"web_url"`
+	IssueURL    string      `json:"issue_url"`
+	Datetime    time.Time   `json:"datetime"`
+	Tags        [][]string  `json:"tags"` // [key, value] pairs
+}
+
+// ProjectSlug extracts the project slug from the event's API URL.
+func (e *SentryEvent) ProjectSlug() string {
+	_, rest, ok := strings.Cut(e.URL, "/projects/")
+	if !ok {
+		return ""
+	}
+	parts := strings.Split(rest, "/")
+	if len(parts) < 2 {
+		return ""
+	}
+	return parts[1]
+}
+
+// Tag returns the value of the event tag with the given key.
+func (e *SentryEvent) Tag(key string) string {
+	for _, kv := range e.Tags {
+		if len(kv) == 2 && kv[0] == key {
+			return kv[1]
+		}
+	}
+	return ""
+}
+
+// ToProcessAlertInput converts the webhook to ProcessAlertInput.
+// New issues, regressions (issue "unresolved") and triggered issue alerts
+// map to firing; resolved issues map to resolved. Returns false for
+// resources and actions that carry no alert state (assigned, archived, ...).
+func (w *SentryWebhook) ToProcessAlertInput(resource string) (ProcessAlertInput, bool) {
+	switch resource {
+	case SentryResourceIssue:
+		if w.Data.Issue == nil {
+			return ProcessAlertInput{}, false
+		}
+		return w.issueInput(w.Data.Issue)
+	case SentryResourceEventAlert:
+		if w.Data.Event == nil || w.Action != "triggered" {
+			return ProcessAlertInput{}, false
+		}
+		return w.eventInput(w.Data.Event), true
+	default:
+		return ProcessAlertInput{}, false
+	}
+}
+
+// issueInput maps an issue webhook.
+func (w *SentryWebhook) issueInput(issue *SentryIssue) (ProcessAlertInput, bool) {
+	input := newSentryInput(issue.ID.String(), issue.Title, issue.Culprit, issue.Level, issue.Project.Slug, "")
+	setIfMissing(input.Labels, "short_id", issue.ShortID)
+
+	link := issue.WebURL
+	if link == "" {
+		link = issue.Permalink
+	}
+	setIfMissing(input.Annotations, "sentry_url", link)
+
+	switch w.Action {
+	case "created":
+		input.Status = "firing"
+		input.FiredAt = orNow(issue.FirstSeen)
+	case "unresolved":
+		// Sentry reopens an issue when it regresses
+		input.Status = "firing"
+		input.FiredAt = orNow(issue.LastSeen)
+		setIfMissing(input.Annotations, "regression", issue.Substatus)
+	case "resolved":
+		input.Status = "resolved"
+		input.FiredAt = orNow(issue.FirstSeen)
+		input.EndsAt = time.Now().UTC()
+	default:
+		return ProcessAlertInput{}, false
+	}
+
+	return input, true
+}
+
+// eventInput maps an issue alert webhook.
+func (w *SentryWebhook) eventInput(event *SentryEvent) ProcessAlertInput {
+	environment := event.Environment
+	if environment == "" {
+		environment = event.Tag("environment")
+	}
+
+	input := newSentryInput(event.IssueID.String(), event.Title, event.Culprit, event.Level, event.ProjectSlug(), environment)
+	setIfMissing(input.Labels, "release", event.Release)
+	setIfMissing(input.Annotations, "sentry_url", event.WebURL)
+	setIfMissing(input.Annotations, "triggered_rule", w.Data.TriggeredRule)
+
+	input.Status = "firing"
+	input.FiredAt = orNow(event.Datetime)
+	return input
+}
+
+// newSentryInput builds the fields shared by both resources.
+// The issue ID identifies the alert, so issue and alert webhooks for the
+// same issue (and its later resolution) update the same alert.
+func newSentryInput(issueID, title, culprit, level, project, environment string) ProcessAlertInput {
+	severity := mapSentryLevel(level)
+
+	labels := map[string]string{
+		"alertname":       title,
+		"severity":        string(severity),
+		"sentry_issue_id": issueID,
+	}
+	setIfMissing(labels, "project", project)
+	setIfMissing(labels, "environment", environment)
+	setIfMissing(labels, "level", level)
+
+	annotations := map[string]string{
+		"summary": title,
+	}
+	setIfMissing(annotations, "description", culprit)
+
+	return ProcessAlertInput{
+		Fingerprint: labelsFingerprint(map[string]string{"sentry_issue_id": issueID}),
+		Name:        title,
+		Instance:    environment,
+		Target:      project,
+		Summary:     title,
+		Description: culprit,
+		Severity:    severity,
+		Labels:      labels,
+		Annotations: annotations,
+	}
+}
+
+// mapSentryLevel maps a Sentry event level to an alert severity.
+func mapSentryLevel(level string) entity.AlertSeverity {
+	switch level {
+	case "fatal":
+		return entity.SeverityCritical
+	case "error", "warning":
+		return entity.SeverityWarning
+	default:
+		return entity.SeverityInfo
+	}
+}
+
+// orNow returns t in UTC, or the current time if t is zero.
+func orNow(t time.Time) time.Time {
+	if t.IsZero() {
+		return time.Now().UTC()
+	}
+	return t.UTC()
+}
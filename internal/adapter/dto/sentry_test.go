@@ -0,0 +1,126 @@
+package dto
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+const sentryIssueJSON = `{
+  "action": "%s",
+  "data": {
+    "issue": {
+      "id": "1170820242",
+      "shortId": "CHECKOUT-4Z",
+      "title": "TypeError: Cannot read property 'total' of undefined",
+      "culprit": "app/cart.js in computeTotal",
+      "level": "error",
+      "status": "unresolved",
+      "substatus": "regressed",
+      "web_url": "https://sentry.io/organizations/acme/issues/1170820242/",
+      "firstSeen": "2026-01-02T15:04:05.123000Z",
+      "lastSeen": "2026-01-09T08:00:00.000000Z",
+      "project": {"id": "42", "slug": "checkout", "name": "Checkout"}
+    }
+  }
+}`
+
+const sentryEventAlertJSON = `{
+  "action": "triggered",
+  "data": {
+    "event": {
+      "event_id": "e4874d664c3540c1a32eab185f12c5ab",
+      "issue_id": 1170820242,
+      "title": "TypeError: Cannot read property 'total' of undefined",
+      "culprit": "app/cart.js in computeTotal",
+      "level": "fatal",
+      "release": "checkout@2.3.1",
+      "url": "https://sentry.io/api/0/projects/acme/checkout/events/e4874d664c3540c1a32eab185f12c5ab/",
+      "web_url": "https://sentry.io/organizations/acme/issues/1170820242/events/e4874d664c3540c1a32eab185f12c5ab/",
+      "datetime": "2026-01-02T15:04:05.476000Z",
+      "tags": [["environment", "production"], ["browser", "Chrome 120"]]
+    },
+    "triggered_rule": "Checkout errors"
+  }
+}`
+
+func decodeSentry(t *testing.T, payload string) SentryWebhook {
+	t.Helper()
+	var webhook SentryWebhook
+	require.NoError(t, json.Unmarshal([]byte(payload), &webhook))
+	return webhook
+}
+
+func TestSentryWebhook_IssueCreated(t *testing.T) {
+	webhook := decodeSentry(t, fmt.Sprintf(sentryIssueJSON, "created"))
+
+	input, ok := webhook.ToProcessAlertInput(SentryResourceIssue)
+	require.True(t, ok)
+
+	assert.Equal(t, "firing", input.Status)
+	assert.Equal(t, entity.SeverityWarning, input.Severity)
+	assert.Equal(t, "TypeError: Cannot read property 'total' of undefined", input.Name)
+	assert.Equal(t, "checkout", input.Target)
+	assert.Equal(t, "checkout", input.Labels["project"])
+	assert.Equal(t, "CHECKOUT-4Z", input.Labels["short_id"])
+	assert.Equal(t, "https://sentry.io/organizations/acme/issues/1170820242/", input.Annotations["sentry_url"])
+	assert.Equal(t, time.Date(2026, 1, 2, 15, 4, 5, 123000000, time.UTC), input.FiredAt)
+	assert.True(t, input.EndsAt.IsZero())
+}
+
+func TestSentryWebhook_IssueRegressionAndResolve(t *testing.T) {
+	regressed := decodeSentry(t, fmt.Sprintf(sentryIssueJSON, "unresolved"))
+	input, ok := regressed.ToProcessAlertInput(SentryResourceIssue)
+	require.True(t, ok)
+	assert.Equal(t, "firing", input.Status)
+	assert.Equal(t, "regressed", input.Annotations["regression"])
+	assert.Equal(t, time.Date(2026, 1, 9, 8, 0, 0, 0, time.UTC), input.FiredAt)
+
+	resolved := decodeSentry(t, fmt.Sprintf(sentryIssueJSON, "resolved"))
+	resolvedInput, ok := resolved.ToProcessAlertInput(SentryResourceIssue)
+	require.True(t, ok)
+	assert.Equal(t, "resolved", resolvedInput.Status)
+	assert.False(t, resolvedInput.EndsAt.IsZero())
+
+	// Both updates address the same alert
+	assert.Equal(t, input.Fingerprint, resolvedInput.Fingerprint)
+}
+
+func TestSentryWebhook_IgnoredActions(t *testing.T) {
+	for _, action := range []string{"assigned", "archived", "ignored"} {
+		webhook := decodeSentry(t, fmt.Sprintf(sentryIssueJSON, action))
+		_, ok := webhook.ToProcessAlertInput(SentryResourceIssue)
+		assert.False(t, ok, action)
+	}
+
+	webhook := decodeSentry(t, fmt.Sprintf(sentryIssueJSON, "created"))
+	_, ok := webhook.ToProcessAlertInput("installation")
+	assert.False(t, ok)
+}
+
+func TestSentryWebhook_EventAlert(t *testing.T) {
+	webhook := decodeSentry(t, sentryEventAlertJSON)
+
+	input, ok := webhook.ToProcessAlertInput(SentryResourceEventAlert)
+	require.True(t, ok)
+
+	assert.Equal(t, "firing", input.Status)
+	assert.Equal(t, entity.SeverityCritical, input.Severity)
+	assert.Equal(t, "checkout", input.Labels["project"])
+	assert.Equal(t, "production", input.Labels["environment"])
+	assert.Equal(t, "production", input.Instance)
+	assert.Equal(t, "checkout@2.3.1", input.Labels["release"])
+	assert.Equal(t, "Checkout errors", input.Annotations["triggered_rule"])
+
+	// Matches the issue webhook for the same issue
+	issue := decodeSentry(t, fmt.Sprintf(sentryIssueJSON, "resolved"))
+	issueInput, ok := issue.ToProcessAlertInput(SentryResourceIssue)
+	require.True(t, ok)
+	assert.Equal(t, issueInput.Fingerprint, input.Fingerprint)
+}
@@ -0,0 +1,71 @@
+package dto
+
+import (
+	"strings"
+	"sync/atomic"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/config"
+)
+
+// SeverityMap maps the severity label values of incoming alerts to alert
+// severities per source, ahead of the built-in mapping. It is safe for
+// concurrent use and replaced as a whole on config reload. The nil map
+// leaves severities as the source's DTO mapped them.
+type SeverityMap struct {
+	table atomic.Pointer[severityTable]
+}
+
+// severityTable is one loaded severity_mapping config.
+type severityTable struct {
+	sources  map[string]map[string]entity.AlertSeverity
+	fallback entity.AlertSeverity
+}
+
+// NewSeverityMap builds the map from config. The config is assumed to be
+// validated.
+func NewSeverityMap(cfg config.SeverityMappingConfig) *SeverityMap {
+	m := &SeverityMap{}
+	m.Reload(cfg)
+	return m
+}
+
+// Reload replaces the mapping with cfg.
+func (m *SeverityMap) Reload(cfg config.SeverityMappingConfig) {
+	t := &severityTable{
+		sources:  make(map[string]map[string]entity.AlertSeverity, len(cfg.Sources)),
+		fallback: entity.SeverityInfo,
+	}
+	if cfg.Default != "" {
+		t.fallback = entity.AlertSeverity(cfg.Default)
+	}
+	for source, values := range cfg.Sources {
+		mapped := make(map[string]entity.AlertSeverity, len(values))
+		for from, to := range values {
+			mapped[strings.ToLower(from)] = entity.AlertSeverity(to)
+		}
+		t.sources[source] = mapped
+	}
+	m.table.Store(t)
+}
+
+// Apply sets the severity of input from its severity label, as sent by
+// source: the source's mapping first, then the built-in names, then the
+// default.
+func (m *SeverityMap) Apply(source string, input *ProcessAlertInput) {
+	if m == nil {
+		return
+	}
+	input.Severity = m.table.Load().severity(source, input.Labels["severity"])
+}
+
+// severity maps one severity label value.
+func (t *severityTable) severity(source, value string) entity.AlertSeverity {
+	if mapped, ok := t.sources[source][strings.ToLower(value)]; ok {
+		return mapped
+	}
+	if mapped, ok := builtinSeverity(value); ok {
+		return mapped
+	}
+	return t.fallback
+}
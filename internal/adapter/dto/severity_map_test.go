@@ -0,0 +1,46 @@
+package dto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/config"
+)
+
+func TestSeverityMap_Apply(t *testing.T) {
+	severities := NewSeverityMap(config.SeverityMappingConfig{
+		Sources: map[string]map[string]string{
+			"alertmanager": {"P1": "critical", "p2": "warning", "warning": "info"},
+		},
+		Default: "warning",
+	})
+
+	apply := func(m *SeverityMap, source, value string) entity.AlertSeverity {
+		input := ProcessAlertInput{
+			Severity: mapSeverity(value),
+			Labels:   map[string]string{"severity": value},
+		}
+		m.Apply(source, &input)
+		return input.Severity
+	}
+
+	// Source values are case-insensitive and override the built-in names
+	assert.Equal(t, entity.SeverityCritical, apply(severities, "alertmanager", "p1"))
+	assert.Equal(t, entity.SeverityWarning, apply(severities, "alertmanager", "P2"))
+	assert.Equal(t, entity.SeverityInfo, apply(severities, "alertmanager", "warning"))
+
+	// Other values use the built-in names, then the default
+	assert.Equal(t, entity.SeverityCritical, apply(severities, "alertmanager", "page"))
+	assert.Equal(t, entity.SeverityInfo, apply(severities, "grafana", "info"))
+	assert.Equal(t, entity.SeverityWarning, apply(severities, "grafana", "p1"))
+	assert.Equal(t, entity.SeverityWarning, apply(severities, "grafana", ""))
+
+	severities.Reload(config.SeverityMappingConfig{})
+	assert.Equal(t, entity.SeverityInfo, apply(severities, "alertmanager", "p1"))
+
+	// The nil map keeps the DTO's severity
+	assert.Equal(t, entity.SeverityCritical, apply(nil, "alertmanager", "critical"))
+	assert.Equal(t, entity.SeverityInfo, apply(nil, "alertmanager", "p1"))
+}
@@ -0,0 +1,145 @@
+package dto
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// SilenceMatcher matches alerts by the value of label name. Type is "="
+// (the default), "!=", "=~" or "!~"; regular expressions must match the
+// whole value.
+type SilenceMatcher struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+	Type  string `json:"type,omitempty"`
+}
+
+// CreateSilenceRequest is the body of POST /api/v1/silences.
+type CreateSilenceRequest struct {
+	Matchers       []SilenceMatcher `json:"matchers"`
+	Duration       string           `json:"duration"`
+	Reason         string           `json:"reason"`
+	CreatedBy      string           `json:"created_by"`
+	CreatedByEmail string           `json:"created_by_email"`
+
+	// Recurrence optionally limits the silence to a repeating window
+	// during Duration, e.g. "FREQ=DAILY;BYHOUR=2;DURATION=2h".
+	Recurrence string `json:"recurrence,omitempty"`
+}
+
+// Validate checks the request and returns its label matchers and its parsed
+// duration. Like Alertmanager, at least one matcher must not match an empty
+// value, so that a silence cannot match every alert.
+func (r *CreateSilenceRequest) Validate() ([]entity.LabelMatcher, time.Duration, error) {
+	if strings.TrimSpace(r.CreatedBy) == "" {
+		return nil, 0, errors.New("created_by is required")
+	}
+	if len(r.Matchers) == 0 {
+		return nil, 0, errors.New("at least one matcher is required")
+	}
+
+	matchers := make([]entity.LabelMatcher, 0, len(r.Matchers))
+	equal := make(map[string]bool, len(r.Matchers))
+	selective := false
+	for i, m := range r.Matchers {
+		if m.Name == "" {
+			return nil, 0, fmt.Errorf("matchers[%d].name is required", i)
+		}
+		matchType := entity.MatchType(m.Type)
+		if matchType == "" {
+			matchType = entity.MatchEqual
+		}
+		if matchType == entity.MatchEqual {
+			if equal[m.Name] {
+				return nil, 0, fmt.Errorf("matchers[%d].name %q is duplicated", i, m.Name)
+			}
+			equal[m.Name] = true
+		}
+
+		matcher, err := entity.NewLabelMatcher(m.Name, matchType, m.Value)
+		if err != nil {
+			return nil, 0, fmt.Errorf("matchers[%d]: %w", i, err)
+		}
+		selective = selective || !matcher.MatchesEmpty()
+		matchers = append(matchers, matcher)
+	}
+	if !selective {
+		return nil, 0, errors.New("at least one matcher must not match an empty label value")
+	}
+
+	d, err := time.ParseDuration(r.Duration)
+	if err != nil || d <= 0 {
+		return nil, 0, errors.New("duration must be a positive Go duration, e.g. 2h")
+	}
+	return matchers, d, nil
+}
+
+// ParseRecurrence returns the request's recurrence, or nil if it has none.
+func (r *CreateSilenceRequest) ParseRecurrence() (*entity.SilenceRecurrence, error) {
+	if strings.TrimSpace(r.Recurrence) == "" {
+		return nil, nil
+	}
+	return entity.ParseSilenceRecurrence(r.Recurrence)
+}
+
+// SilenceResponse is the JSON representation of a silence in API responses.
+// Silences created from Slack may target an alert, instance or fingerprint
+// instead of matchers.
+type SilenceResponse struct {
+	ID             string           `json:"id"`
+	Matchers       []SilenceMatcher `json:"matchers"`
+	AlertID        string           `json:"alert_id,omitempty"`
+	Instance       string           `json:"instance,omitempty"`
+	Fingerprint    string           `json:"fingerprint,omitempty"`
+	StartsAt       time.Time        `json:"starts_at"`
+	EndsAt         time.Time        `json:"ends_at"`
+	Recurrence     string           `json:"recurrence,omitempty"`
+	CreatedBy      string           `json:"created_by"`
+	CreatedByEmail string           `json:"created_by_email,omitempty"`
+	Reason         string           `json:"reason,omitempty"`
+	Source         string           `json:"source"`
+	CreatedAt      time.Time        `json:"created_at"`
+}
+
+// NewSilenceResponse converts a silence to its API representation. Matchers
+// are sorted by name; the type of equality matchers is left out.
+func NewSilenceResponse(silence *entity.SilenceMark) SilenceResponse {
+	labelMatchers := silence.LabelMatchers()
+	matchers := make([]SilenceMatcher, 0, len(labelMatchers))
+	for _, m := range labelMatchers {
+		matcher := SilenceMatcher{Name: m.Name, Value: m.Value}
+		if m.Type != entity.MatchEqual {
+			matcher.Type = string(m.Type)
+		}
+		matchers = append(matchers, matcher)
+	}
+	sort.SliceStable(matchers, func(i, j int) bool {
+		return matchers[i].Name < matchers[j].Name
+	})
+
+	var recurrence string
+	if silence.Recurrence != nil {
+		recurrence = silence.Recurrence.String()
+	}
+
+	return SilenceResponse{
+		ID:             silence.ID,
+		Matchers:       matchers,
+		AlertID:        silence.AlertID,
+		Instance:       silence.Instance,
+		Fingerprint:    silence.Fingerprint,
+		StartsAt:       silence.StartAt,
+		EndsAt:         silence.EndAt,
+		Recurrence:     recurrence,
+		CreatedBy:      silence.CreatedBy,
+		CreatedByEmail: silence.CreatedByEmail,
+		Reason:         silence.Reason,
+		Source:         string(silence.Source),
+		CreatedAt:      silence.CreatedAt,
+	}
+}
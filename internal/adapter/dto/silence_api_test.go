@@ -0,0 +1,85 @@
+package dto
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+func TestCreateSilenceRequest_Validate(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		req := CreateSilenceRequest{
+			Matchers: []SilenceMatcher{
+				{Name: "service", Value: "payments"},
+				{Name: "env", Value: "prod"},
+			},
+			Duration:  "45m",
+			CreatedBy: "deploy-bot",
+		}
+		matchers, d, err := req.Validate()
+		require.NoError(t, err)
+
+		require.Len(t, matchers, 2)
+		assert.Equal(t, `service="payments"`, matchers[0].String())
+		assert.Equal(t, `env="prod"`, matchers[1].String())
+		assert.Equal(t, 45*time.Minute, d)
+	})
+
+	t.Run("regex and negative matchers", func(t *testing.T) {
+		req := CreateSilenceRequest{
+			Matchers: []SilenceMatcher{
+				{Name: "service", Value: "pay.*", Type: "=~"},
+				{Name: "env", Value: "prod", Type: "!="},
+				{Name: "env", Value: "dev", Type: "!="},
+			},
+			Duration:  "1h",
+			CreatedBy: "deploy-bot",
+		}
+		matchers, _, err := req.Validate()
+		require.NoError(t, err)
+
+		require.Len(t, matchers, 3)
+		assert.Equal(t, entity.MatchRegexp, matchers[0].Type)
+		assert.True(t, matchers[0].Matches(map[string]string{"service": "payments"}))
+		assert.False(t, matchers[0].Matches(map[string]string{"service": "ledger-payments"}))
+	})
+
+	for name, req := range map[string]CreateSilenceRequest{
+		"missing creator":   {Matchers: []SilenceMatcher{{Name: "env", Value: "prod"}}, Duration: "1h"},
+		"no matchers":       {Duration: "1h", CreatedBy: "bot"},
+		"empty name":        {Matchers: []SilenceMatcher{{Value: "prod"}}, Duration: "1h", CreatedBy: "bot"},
+		"duplicate name":    {Matchers: []SilenceMatcher{{Name: "env", Value: "a"}, {Name: "env", Value: "b"}}, Duration: "1h", CreatedBy: "bot"},
+		"missing duration":  {Matchers: []SilenceMatcher{{Name: "env", Value: "prod"}}, CreatedBy: "bot"},
+		"negative duration": {Matchers: []SilenceMatcher{{Name: "env", Value: "prod"}}, Duration: "-5m", CreatedBy: "bot"},
+		"invalid regex":     {Matchers: []SilenceMatcher{{Name: "env", Value: "(prod", Type: "=~"}}, Duration: "1h", CreatedBy: "bot"},
+		"unknown type":      {Matchers: []SilenceMatcher{{Name: "env", Value: "prod", Type: "=="}}, Duration: "1h", CreatedBy: "bot"},
+		"matches empty":     {Matchers: []SilenceMatcher{{Name: "env", Value: "prod", Type: "!="}}, Duration: "1h", CreatedBy: "bot"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, _, err := req.Validate()
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestCreateSilenceRequest_ParseRecurrence(t *testing.T) {
+	req := CreateSilenceRequest{Recurrence: "FREQ=DAILY;BYHOUR=2;DURATION=2h"}
+	recurrence, err := req.ParseRecurrence()
+	require.NoError(t, err)
+	require.NotNil(t, recurrence)
+
+	assert.True(t, recurrence.Covers(time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)))
+	assert.False(t, recurrence.Covers(time.Date(2026, 1, 2, 4, 0, 0, 0, time.UTC)))
+	assert.Equal(t, "FREQ=DAILY;BYHOUR=2;BYMINUTE=0;DURATION=2h", recurrence.String())
+
+	recurrence, err = (&CreateSilenceRequest{}).ParseRecurrence()
+	require.NoError(t, err)
+	assert.Nil(t, recurrence)
+
+	_, err = (&CreateSilenceRequest{Recurrence: "FREQ=WEEKLY;DURATION=1h"}).ParseRecurrence()
+	assert.Error(t, err)
+}
@@ -30,6 +30,11 @@ type SlackInteractionInput struct {
 	// MessageTS is the timestamp of the message.
 	MessageTS string
 
+	// MessageAlertID is the alert ID from the message metadata. Empty for
+	// messages without it, such as those posted before alert messages
+	// carried metadata.
+	MessageAlertID string
+
 	// Value is the action value (e.g., duration for silence).
 	Value string
 
@@ -1,6 +1,7 @@
 package dto
 
 import (
+	"math"
 	"regexp"
 	"strconv"
 	"strings"
@@ -28,6 +29,47 @@ type SilenceRequest struct {
 	UserID    string
 	UserName  string
 	TriggerID string // For opening modals
+	ChannelID string // Where a modal submission is confirmed
+}
+
+// ViewAction represents the action to perform on saved views.
+type ViewAction string
+
+const (
+	ViewActionList   ViewAction = "list"
+	ViewActionSave   ViewAction = "save"
+	ViewActionDelete ViewAction = "delete"
+)
+
+// ViewRequest represents a request to manage saved views.
+type ViewRequest struct {
+	Action   ViewAction
+	Name     string
+	Shared   bool              // Shared with the whole team instead of personal
+	Severity string            // "critical", "warning", "info", or "" for any
+	State    string            // "active", "acknowledged", or "" for any
+	Labels   map[string]string // Label selectors (key=value pairs)
+	UserID   string
+	UserName string
+}
+
+// DeadLetterAction represents the action to perform on dead-lettered
+// notifications.
+type DeadLetterAction string
+
+const (
+	DeadLetterActionList    DeadLetterAction = "list"
+	DeadLetterActionReplay  DeadLetterAction = "replay"
+	DeadLetterActionDiscard DeadLetterAction = "discard"
+)
+
+// DeadLetterRequest represents a request to manage dead-lettered notifications.
+type DeadLetterRequest struct {
+	Action   DeadLetterAction
+	ID       string // For replay and discard
+	All      bool   // Replay every dead letter
+	UserID   string
+	UserName string
 }
 
 // SlackCommandDTO represents a parsed Slack slash command.
@@ -48,15 +90,51 @@ type SlackCommandDTO struct {
 func (dto *SlackCommandDTO) ParsedArgs() map[string]string {
 	args := make(map[string]string)
 
-	if dto.Text != "" {
-		// For simple commands like /alert-status critical
-		// treat the text as the severity filter
-		args["severity"] = dto.Text
+	// For simple commands like /alert-status critical treat the text as the
+	// severity filter; key=value terms are custom field filters.
+	var terms []string
+	for _, term := range strings.Fields(dto.Text) {
+		if !strings.Contains(term, "=") {
+			terms = append(terms, term)
+		}
+	}
+	if len(terms) > 0 {
+		args["severity"] = strings.Join(terms, " ")
 	}
 
 	return args
 }
 
+// FieldFilters extracts custom field filters (name=value terms) from command text.
+// Returns nil if there are none.
+func (dto *SlackCommandDTO) FieldFilters() map[string]string {
+	var filters map[string]string
+	for _, term := range strings.Fields(dto.Text) {
+		name, value, ok := strings.Cut(term, "=")
+		if !ok || name == "" || name == viewFilterKey {
+			continue
+		}
+		if filters == nil {
+			filters = make(map[string]string)
+		}
+		filters[name] = value
+	}
+	return filters
+}
+
+// viewFilterKey selects a saved view in /alert-status (view=<name>).
+const viewFilterKey = "view"
+
+// ViewName returns the saved view requested with view=<name>, or "".
+func (dto *SlackCommandDTO) ViewName() string {
+	for _, term := range strings.Fields(dto.Text) {
+		if name, value, ok := strings.Cut(term, "="); ok && name == viewFilterKey {
+			return strings.ToLower(value)
+		}
+	}
+	return ""
+}
+
 // SeverityFilter extracts the severity filter from command text.
 // Returns the severity or empty string for all severities.
 func (dto *SlackCommandDTO) SeverityFilter() string {
@@ -87,30 +165,9 @@ func (dto *SlackCommandDTO) PeriodFilter() time.Duration {
 		return 0
 	}
 
-	// Parse period format (e.g., "1h", "24h", "7d", "1w")
-	matches := periodRegex.FindStringSubmatch(text)
-	if matches == nil {
-		return 0 // Invalid format, show all
-	}
-
-	value, err := strconv.Atoi(matches[1])
-	if err != nil || value <= 0 {
-		return 0
-	}
-
-	unit := matches[2]
-	switch unit {
-	case "m":
-		return time.Duration(value) * time.Minute
-	case "h":
-		return time.Duration(value) * time.Hour
-	case "d":
-		return time.Duration(value) * 24 * time.Hour
-	case "w":
-		return time.Duration(value) * 7 * 24 * time.Hour
-	default:
-		return 0
-	}
+	// Parse period format (e.g., "1h", "24h", "7d", "1w"); invalid formats
+	// show all
+	return parseDuration(text)
 }
 
 // PeriodDescription returns a human-readable description of the period filter.
@@ -139,6 +196,7 @@ func (dto *SlackCommandDTO) PeriodDescription() string {
 // Usage: /silence [create|list|delete] [options]
 // Examples:
 //   - /silence create               - Opens modal to create a silence
+//   - /silence create 4h            - Opens the modal with 4 hours preselected
 //   - /silence list                 - List all active silences
 //   - /silence delete <id>          - Delete a silence by ID
 func (d *SlackCommandDTO) ParseSilenceRequest() *SilenceRequest {
@@ -148,6 +206,7 @@ func (d *SlackCommandDTO) ParseSilenceRequest() *SilenceRequest {
 		UserID:    d.UserID,
 		UserName:  d.UserName,
 		TriggerID: d.TriggerID,
+		ChannelID: d.ChannelID,
 		Action:    SilenceActionList, // Default action
 		Duration:  1 * time.Hour,     // Default duration
 		Matchers:  make(map[string]string),
@@ -161,8 +220,14 @@ func (d *SlackCommandDTO) ParseSilenceRequest() *SilenceRequest {
 	action := strings.ToLower(parts[0])
 	switch action {
 	case "create":
-		// Open modal for creating silence with label selection
+		// Open modal for creating silence with label selection, with an
+		// optional duration preselected
 		req.Action = SilenceActionOpenModal
+		if len(parts) >= 2 {
+			if dur := parseDuration(parts[1]); dur > 0 {
+				req.Duration = dur
+			}
+		}
 	case "list":
 		req.Action = SilenceActionList
 	case "delete":
@@ -181,28 +246,138 @@ func (d *SlackCommandDTO) ParseSilenceRequest() *SilenceRequest {
 	return req
 }
 
-// parseDuration parses duration strings like "1h", "30m", "7d", "1w"
+// parseDuration parses duration strings like "1h", "30m", "7d", "1w".
+// Returns 0 for invalid formats and for values too large to represent.
 func parseDuration(s string) time.Duration {
 	matches := periodRegex.FindStringSubmatch(strings.ToLower(s))
 	if matches == nil {
 		return 0
 	}
 
-	value, err := strconv.Atoi(matches[1])
+	value, err := strconv.ParseInt(matches[1], 10, 64)
 	if err != nil || value <= 0 {
 		return 0
 	}
 
+	var unit time.Duration
 	switch matches[2] {
 	case "m":
-		return time.Duration(value) * time.Minute
+		unit = time.Minute
 	case "h":
-		return time.Duration(value) * time.Hour
+		unit = time.Hour
 	case "d":
-		return time.Duration(value) * 24 * time.Hour
+		unit = 24 * time.Hour
 	case "w":
-		return time.Duration(value) * 7 * 24 * time.Hour
+		unit = 7 * 24 * time.Hour
 	default:
 		return 0
 	}
+	if value > int64(math.MaxInt64/unit) {
+		return 0
+	}
+	return time.Duration(value) * unit
+}
+
+// ParseViewRequest parses the command text for /alert-view command.
+// Usage: /alert-view [list|save|delete] [options]
+// Examples:
+//   - /alert-view                                        - List visible views
+//   - /alert-view save payments-prod critical team=payments - Save a personal view
+//   - /alert-view save oncall shared acked                - Save a view shared with the team
+//   - /alert-view delete payments-prod                    - Delete a personal view
+func (d *SlackCommandDTO) ParseViewRequest() *ViewRequest {
+	parts := strings.Fields(d.Text)
+
+	req := &ViewRequest{
+		Action:   ViewActionList,
+		UserID:   d.UserID,
+		UserName: d.UserName,
+	}
+
+	if len(parts) == 0 {
+		return req
+	}
+
+	switch strings.ToLower(parts[0]) {
+	case "save":
+		req.Action = ViewActionSave
+	case "delete":
+		req.Action = ViewActionDelete
+	default:
+		return req
+	}
+
+	if len(parts) >= 2 {
+		req.Name = strings.ToLower(parts[1])
+	}
+
+	for _, term := range parts[min(len(parts), 2):] {
+		if key, value, ok := strings.Cut(term, "="); ok {
+			if key == "" {
+				continue
+			}
+			if req.Labels == nil {
+				req.Labels = make(map[string]string)
+			}
+			req.Labels[key] = value
+			continue
+		}
+
+		switch strings.ToLower(term) {
+		case "shared":
+			req.Shared = true
+		case "critical", "crit":
+			req.Severity = "critical"
+		case "warning", "warn":
+			req.Severity = "warning"
+		case "info":
+			req.Severity = "info"
+		case "active":
+			req.State = "active"
+		case "acked", "acknowledged":
+			req.State = "acknowledged"
+		}
+	}
+
+	return req
+}
+
+// ParseDeadLetterRequest parses the command text for /alert-deadletters command.
+// Usage: /alert-deadletters [list|replay|discard] [id|all]
+// Examples:
+//   - /alert-deadletters                   - List dead-lettered notifications
+//   - /alert-deadletters replay <id>       - Requeue one notification
+//   - /alert-deadletters replay all        - Requeue every notification
+//   - /alert-deadletters discard <id>      - Drop a notification without sending it
+func (d *SlackCommandDTO) ParseDeadLetterRequest() *DeadLetterRequest {
+	parts := strings.Fields(d.Text)
+
+	req := &DeadLetterRequest{
+		Action:   DeadLetterActionList,
+		UserID:   d.UserID,
+		UserName: d.UserName,
+	}
+
+	if len(parts) == 0 {
+		return req
+	}
+
+	switch strings.ToLower(parts[0]) {
+	case "replay", "retry":
+		req.Action = DeadLetterActionReplay
+	case "discard", "delete":
+		req.Action = DeadLetterActionDiscard
+	default:
+		return req
+	}
+
+	if len(parts) >= 2 {
+		if req.Action == DeadLetterActionReplay && strings.EqualFold(parts[1], "all") {
+			req.All = true
+		} else {
+			req.ID = parts[1]
+		}
+	}
+
+	return req
 }
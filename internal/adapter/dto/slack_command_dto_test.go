@@ -0,0 +1,77 @@
+package dto
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDuration(t *testing.T) {
+	tests := map[string]time.Duration{
+		"30m":                   30 * time.Minute,
+		"2H":                    2 * time.Hour,
+		"7d":                    7 * 24 * time.Hour,
+		"1w":                    7 * 24 * time.Hour,
+		"0h":                    0,
+		"1y":                    0,
+		"h":                     0,
+		"15250w":                15250 * 7 * 24 * time.Hour,
+		"15251w":                0, // beyond time.Duration
+		"99999999999999999999m": 0,
+	}
+	for text, want := range tests {
+		assert.Equal(t, want, parseDuration(text), text)
+	}
+}
+
+func FuzzSlackCommandDTO(f *testing.F) {
+	for _, text := range []string{
+		"",
+		"critical team=infra view=mine",
+		"create 2h",
+		"delete",
+		"save payments-prod shared critical team=payments =x",
+		"replay all",
+		"state:all severity:info label:a=b text:c",
+		"warning SlowReplication team=db lag grows",
+		"9223372036854775807w",
+	} {
+		f.Add(text)
+	}
+
+	f.Fuzz(func(t *testing.T, text string) {
+		cmd := &SlackCommandDTO{Text: text}
+		cmd.ParsedArgs()
+		cmd.FieldFilters()
+		cmd.ViewName()
+		cmd.SeverityFilter()
+		if cmd.PeriodFilter() < 0 {
+			t.Fatalf("negative period for %q", text)
+		}
+		cmd.PeriodDescription()
+		if req := cmd.ParseSilenceRequest(); req.Duration <= 0 {
+			t.Fatalf("non-positive silence duration for %q", text)
+		}
+		cmd.ParseViewRequest()
+		cmd.ParseDeadLetterRequest()
+		cmd.ParseAlertSearch()
+		cmd.ParseManualAlertRequest()
+	})
+}
+
+func TestParseSilenceRequest_OpenModal(t *testing.T) {
+	cmd := &SlackCommandDTO{Text: "create 4h", ChannelID: "C123", TriggerID: "T1"}
+	req := cmd.ParseSilenceRequest()
+	assert.Equal(t, SilenceActionOpenModal, req.Action)
+	assert.Equal(t, 4*time.Hour, req.Duration)
+	assert.Equal(t, "C123", req.ChannelID)
+
+	req = (&SlackCommandDTO{Text: "create soon"}).ParseSilenceRequest()
+	assert.Equal(t, SilenceActionOpenModal, req.Action)
+	assert.Equal(t, time.Hour, req.Duration)
+
+	req = (&SlackCommandDTO{Text: "30m"}).ParseSilenceRequest()
+	assert.Equal(t, SilenceActionOpenModal, req.Action)
+	assert.Equal(t, 30*time.Minute, req.Duration)
+}
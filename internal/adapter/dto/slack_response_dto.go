@@ -8,6 +8,9 @@ type SlackResponseDTO struct {
 	Text         string             `json:"text"`                  // Plain text fallback
 	Blocks       []slack.Block      `json:"blocks,omitempty"`      // Block Kit blocks
 	Attachments  []slack.Attachment `json:"attachments,omitempty"` // Legacy attachments
+
+	// ReplaceOriginal replaces the message an interaction came from
+	ReplaceOriginal bool `json:"replace_original,omitempty"`
 }
 
 // NewEphemeralResponse creates an ephemeral response (visible only to command invoker).
@@ -0,0 +1,22 @@
+package dto
+
+// TeamsInteractionInput represents an action taken from a Teams alert card.
+type TeamsInteractionInput struct {
+	// Action is the action to perform (e.g., "ack").
+	Action string
+
+	// AlertID is the alert the action applies to.
+	AlertID string
+
+	// UserName is the name entered on the confirmation page.
+	UserName string
+}
+
+// TeamsInteractionOutput represents the result of handling a Teams action.
+type TeamsInteractionOutput struct {
+	// Success indicates if the action was handled successfully.
+	Success bool
+
+	// Message is a human-readable result shown to the user.
+	Message string
+}
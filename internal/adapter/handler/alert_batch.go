@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/adapter/dto"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/observability"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
+)
+
+// alertBatch processes the alerts of one Alertmanager-style webhook payload
+// (Alertmanager, Grafana) and then applies group-level semantics.
+type alertBatch struct {
+	source       string
+	processAlert *alert.ProcessAlertUseCase
+	logger       alert.Logger
+	metrics      *observability.Metrics
+	fingerprint  dto.FingerprintStrategy
+	names        dto.AlertNameMap
+	severities   *dto.SeverityMap
+}
+
+// process runs the inputs through the use case, then the group step.
+// groupInput carries the payload's group fields; fingerprints and alert IDs
+// are filled in here. Returns the processed and failed counts.
+func (b alertBatch) process(ctx context.Context, receivedAt time.Time, inputs []dto.ProcessAlertInput, groupInput dto.ProcessAlertGroupInput) (processed, failed int) {
+	for i := range inputs {
+		input := &inputs[i]
+		b.severities.Apply(b.source, input)
+		b.names.Apply(input)
+		b.fingerprint.Apply(input)
+		groupInput.Fingerprints = append(groupInput.Fingerprints, input.Fingerprint)
+		input.ReceivedAt = receivedAt
+		input.Source = b.source
+	}
+
+	outputs, errs := b.processAlert.ExecuteAll(ctx, inputs)
+	for i, input := range inputs {
+		output, err := outputs[i], errs[i]
+		if err != nil {
+			b.logger.Error("failed to process alert",
+				"source", b.source,
+				"fingerprint", input.Fingerprint,
+				"status", input.Status,
+				"error", err,
+			)
+			failed++
+			continue
+		}
+
+		processed++
+		if output.AlertID != "" {
+			groupInput.AlertIDs = append(groupInput.AlertIDs, output.AlertID)
+		}
+		if b.metrics != nil && len(output.NotificationsSent) > 0 {
+			b.metrics.RecordIngestToNotify(ctx, b.source, time.Since(receivedAt))
+		}
+		b.logger.Info("alert processed",
+			"source", b.source,
+			"alertID", output.AlertID,
+			"fingerprint", input.Fingerprint,
+			"status", input.Status,
+			"isNew", output.IsNew,
+			"isSilenced", output.IsSilenced,
+			"notificationsSent", output.NotificationsSent,
+		)
+	}
+
+	// Apply group-level semantics (grouped resolution, truncation warnings)
+	groupOutput, err := b.processAlert.ProcessGroup(ctx, groupInput)
+	if err != nil {
+		b.logger.Error("failed to process alert group",
+			"source", b.source,
+			"groupKey", groupInput.GroupKey,
+			"error", err,
+		)
+	} else {
+		processed += len(groupOutput.ResolvedAlertIDs)
+	}
+
+	return processed, failed
+}
@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/adapter/dto"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/logger"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
+)
+
+// AlertHistoryHandler answers point-in-time alert state queries.
+type AlertHistoryHandler struct {
+	queryActiveAt *alert.QueryActiveAtUseCase
+	logger        logger.Logger
+}
+
+// NewAlertHistoryHandler creates a new alert history handler.
+func NewAlertHistoryHandler(queryActiveAt *alert.QueryActiveAtUseCase, logger logger.Logger) *AlertHistoryHandler {
+	return &AlertHistoryHandler{
+		queryActiveAt: queryActiveAt,
+		logger:        logger,
+	}
+}
+
+// alertsActiveAtResponse is the response body for GET /api/v1/alerts/active-at.
+type alertsActiveAtResponse struct {
+	At     time.Time                   `json:"at"`
+	Count  int                         `json:"count"`
+	Alerts []dto.AlertSnapshotResponse `json:"alerts"`
+}
+
+// ServeHTTP handles GET /api/v1/alerts/active-at?time=<RFC3339>&severity=<severity>.
+func (h *AlertHistoryHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	at, err := time.Parse(time.RFC3339, query.Get("time"))
+	if err != nil {
+		writeAPIError(w, http.StatusBadRequest, "time must be an RFC 3339 timestamp, e.g. 2026-01-02T03:12:00Z")
+		return
+	}
+
+	input := alert.QueryActiveAtInput{At: at.UTC()}
+	if v := query.Get("severity"); v != "" {
+		switch severity := entity.AlertSeverity(v); severity {
+		case entity.SeverityCritical, entity.SeverityWarning, entity.SeverityInfo:
+			input.Severity = severity
+		default:
+			writeAPIError(w, http.StatusBadRequest, "severity must be critical, warning, or info")
+			return
+		}
+	}
+
+	output, err := h.queryActiveAt.Execute(r.Context(), input)
+	if err != nil {
+		h.logger.Error("querying alerts active at time", "time", input.At, "error", err)
+		writeAPIError(w, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	resp := alertsActiveAtResponse{
+		At:     output.At,
+		Count:  len(output.Snapshots),
+		Alerts: make([]dto.AlertSnapshotResponse, 0, len(output.Snapshots)),
+	}
+	for _, snap := range output.Snapshots {
+		resp.Alerts = append(resp.Alerts, dto.NewAlertSnapshotResponse(snap))
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
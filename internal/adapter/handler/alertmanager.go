@@ -1,17 +1,29 @@
 package handler
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
+	"time"
 
 	"github.com/altuslabsxyz/alert-bridge/internal/adapter/dto"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/observability"
 	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
 )
 
+// sourceAlertmanager labels ingestion metrics recorded by this handler.
+const sourceAlertmanager = "alertmanager"
+
 // AlertmanagerHandler handles Alertmanager webhook requests.
 type AlertmanagerHandler struct {
 	processAlert *alert.ProcessAlertUseCase
 	logger       alert.Logger
+	metrics      *observability.Metrics
+	fingerprint  dto.FingerprintStrategy
+	names        dto.AlertNameMap
+	severities   *dto.SeverityMap
+	queue        *IngestQueue
 }
 
 // NewAlertmanagerHandler creates a new handler.
@@ -22,6 +34,32 @@ func NewAlertmanagerHandler(processAlert *alert.ProcessAlertUseCase, logger aler
 	}
 }
 
+// SetMetrics enables per-source ingestion metrics.
+func (h *AlertmanagerHandler) SetMetrics(metrics *observability.Metrics) {
+	h.metrics = metrics
+}
+
+// SetFingerprintStrategy overrides how alert fingerprints are computed.
+func (h *AlertmanagerHandler) SetFingerprintStrategy(strategy dto.FingerprintStrategy) {
+	h.fingerprint = strategy
+}
+
+// SetAlertNames sets the map used to rename alerts to canonical names.
+func (h *AlertmanagerHandler) SetAlertNames(names dto.AlertNameMap) {
+	h.names = names
+}
+
+// SetSeverityMap sets the configured mapping of severity label values.
+func (h *AlertmanagerHandler) SetSeverityMap(severities *dto.SeverityMap) {
+	h.severities = severities
+}
+
+// SetQueue processes payloads in the background, answering 202 once they
+// are queued.
+func (h *AlertmanagerHandler) SetQueue(queue *IngestQueue) {
+	h.queue = queue
+}
+
 // ServeHTTP handles POST /webhook/alertmanager
 func (h *AlertmanagerHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
@@ -29,43 +67,79 @@ func (h *AlertmanagerHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	receivedAt := time.Now()
+	ctx := r.Context()
+
 	var payload dto.AlertmanagerWebhook
 	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
 		h.logger.Error("failed to decode alertmanager payload",
 			"error", err,
 		)
+		if h.metrics != nil {
+			h.metrics.RecordWebhookParseFailure(ctx, sourceAlertmanager)
+		}
 		http.Error(w, "invalid payload", http.StatusBadRequest)
 		return
 	}
+	if err := payload.Validate(); err != nil {
+		h.logger.Warn("rejected alertmanager payload",
+			"error", err,
+		)
+		if h.metrics != nil {
+			h.metrics.RecordWebhookParseFailure(ctx, sourceAlertmanager)
+		}
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
 
-	ctx := r.Context()
-	var processed, failed int
+	if h.metrics != nil {
+		h.metrics.RecordWebhookPayload(ctx, sourceAlertmanager, len(payload.Alerts), payload.TruncatedAlerts)
+	}
 
-	// Process each alert in the payload
+	inputs := make([]dto.ProcessAlertInput, 0, len(payload.Alerts))
 	for _, alertData := range payload.Alerts {
-		input := dto.ToProcessAlertInput(alertData)
-
-		output, err := h.processAlert.Execute(ctx, input)
-		if err != nil {
-			h.logger.Error("failed to process alert",
-				"fingerprint", alertData.Fingerprint,
-				"status", alertData.Status,
-				"error", err,
-			)
-			failed++
-			continue
-		}
+		inputs = append(inputs, payload.ToProcessAlertInput(alertData))
+	}
 
-		processed++
-		h.logger.Info("alert processed",
-			"alertID", output.AlertID,
-			"fingerprint", alertData.Fingerprint,
-			"status", alertData.Status,
-			"isNew", output.IsNew,
-			"isSilenced", output.IsSilenced,
-			"notificationsSent", output.NotificationsSent,
-		)
+	batch := alertBatch{
+		source:       sourceAlertmanager,
+		processAlert: h.processAlert,
+		logger:       h.logger,
+		metrics:      h.metrics,
+		fingerprint:  h.fingerprint,
+		names:        h.names,
+		severities:   h.severities,
 	}
+	groupInput := dto.ProcessAlertGroupInput{
+		GroupKey:        payload.GroupKey,
+		Status:          payload.Status,
+		TruncatedAlerts: payload.TruncatedAlerts,
+	}
+
+	if h.queue != nil {
+		err := h.queue.Enqueue(ctx, sourceAlertmanager, payload.GroupKey, func(ctx context.Context) {
+			batch.process(ctx, receivedAt, inputs, groupInput)
+		})
+		switch {
+		case err == nil:
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusAccepted)
+			json.NewEncoder(w).Encode(map[string]any{
+				"status":    "accepted",
+				"queued":    len(inputs),
+				"truncated": payload.TruncatedAlerts,
+			})
+			return
+		case errors.Is(err, errIngestQueueFull):
+			// Alertmanager retries 5xx responses
+			w.Header().Set("Retry-After", "5")
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		// Not running yet or any more: process in the request
+	}
+
+	processed, failed := batch.process(ctx, receivedAt, inputs, groupInput)
 
 	// Return success response
 	w.Header().Set("Content-Type", "application/json")
@@ -74,5 +148,6 @@ func (h *AlertmanagerHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		"status":    "ok",
 		"processed": processed,
 		"failed":    failed,
+		"truncated": payload.TruncatedAlerts,
 	})
 }
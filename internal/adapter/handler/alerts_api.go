@@ -0,0 +1,368 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/adapter/dto"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/logger"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/api"
+)
+
+const (
+	// defaultAlertListLimit applies when no limit is given.
+	defaultAlertListLimit = 100
+
+	// maxAlertListLimit caps the limit parameter.
+	maxAlertListLimit = 1000
+
+	// defaultResolvedLookback is how far back resolved alerts are listed
+	// when no since parameter is given.
+	defaultResolvedLookback = 24 * time.Hour
+
+	// maxAPIBodySize bounds action request bodies.
+	maxAPIBodySize = 64 * 1024
+)
+
+// AlertsAPIHandler serves the /api/v1/alerts REST endpoints.
+type AlertsAPIHandler struct {
+	manageAlerts *api.ManageAlertsUseCase
+	createAlert  *api.CreateAlertUseCase
+	logger       logger.Logger
+}
+
+// NewAlertsAPIHandler creates a new alerts API handler.
+func NewAlertsAPIHandler(manageAlerts *api.ManageAlertsUseCase, createAlert *api.CreateAlertUseCase, logger logger.Logger) *AlertsAPIHandler {
+	return &AlertsAPIHandler{
+		manageAlerts: manageAlerts,
+		createAlert:  createAlert,
+		logger:       logger,
+	}
+}
+
+// alertListResponse is the response body for GET /api/v1/alerts.
+type alertListResponse struct {
+	Count  int                 `json:"count"`
+	Alerts []dto.AlertResponse `json:"alerts"`
+}
+
+// resolveSourceResponse is the response body for
+// POST /api/v1/sources/{source}/resolve.
+type resolveSourceResponse struct {
+	Source string              `json:"source"`
+	Count  int                 `json:"count"`
+	Alerts []dto.AlertResponse `json:"alerts"`
+}
+
+// apiErrorResponse is the body of API error responses.
+type apiErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// List handles GET /api/v1/alerts?state=&severity=&label=k=v&field=k=v&since=&limit=.
+// state may be repeated or comma-separated; label and field may be repeated.
+func (h *AlertsAPIHandler) List(w http.ResponseWriter, r *http.Request) {
+	input, err := parseListAlertsQuery(r)
+	if err != nil {
+		writeAPIError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	alerts, err := h.manageAlerts.List(r.Context(), input)
+	if err != nil {
+		h.writeActionError(w, "listing alerts", err)
+		return
+	}
+
+	resp := alertListResponse{
+		Count:  len(alerts),
+		Alerts: make([]dto.AlertResponse, 0, len(alerts)),
+	}
+	for _, a := range alerts {
+		resp.Alerts = append(resp.Alerts, dto.NewAlertResponse(a))
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// Create handles POST /api/v1/alerts, raising an alert by hand.
+func (h *AlertsAPIHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var req dto.CreateAlertRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxAPIBodySize)).Decode(&req); err != nil {
+		writeAPIError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+	req.User = strings.TrimSpace(req.User)
+	if req.User == "" {
+		writeAPIError(w, http.StatusBadRequest, "user is required")
+		return
+	}
+
+	out, err := h.createAlert.Execute(r.Context(), api.CreateAlertInput{
+		Name:        req.Name,
+		Severity:    entity.AlertSeverity(req.Severity),
+		Description: req.Description,
+		Labels:      req.Labels,
+		By:          req.User,
+	})
+	if err != nil {
+		h.writeActionError(w, "creating alert", err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, dto.NewAlertResponse(out.Alert))
+}
+
+// Get handles GET /api/v1/alerts/{id}.
+func (h *AlertsAPIHandler) Get(w http.ResponseWriter, r *http.Request) {
+	a, err := h.manageAlerts.Get(r.Context(), r.PathValue("id"))
+	if err != nil {
+		h.writeActionError(w, "getting alert", err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewAlertResponse(a))
+}
+
+// Timeline handles GET /api/v1/alerts/{id}/timeline.
+func (h *AlertsAPIHandler) Timeline(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	events, err := h.manageAlerts.Timeline(r.Context(), id)
+	if err != nil {
+		h.writeActionError(w, "getting alert timeline", err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewAlertTimelineResponse(id, events))
+}
+
+// Restore handles POST /api/v1/alerts/{id}/restore, bringing back a deleted
+// alert.
+func (h *AlertsAPIHandler) Restore(w http.ResponseWriter, r *http.Request) {
+	a, err := h.manageAlerts.Restore(r.Context(), r.PathValue("id"))
+	if err != nil {
+		h.writeActionError(w, "restoring alert", err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewAlertResponse(a))
+}
+
+// ResolveSource handles POST /api/v1/sources/{source}/resolve, resolving
+// all firing alerts of a decommissioned source.
+func (h *AlertsAPIHandler) ResolveSource(w http.ResponseWriter, r *http.Request) {
+	var req dto.ResolveSourceRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxAPIBodySize)).Decode(&req); err != nil {
+		writeAPIError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+	req.User = strings.TrimSpace(req.User)
+	if req.User == "" {
+		writeAPIError(w, http.StatusBadRequest, "user is required")
+		return
+	}
+
+	source := r.PathValue("source")
+	alerts, err := h.manageAlerts.ResolveSource(r.Context(), api.ResolveSourceInput{
+		Source: source,
+		Labels: req.Labels,
+		By:     req.User,
+		Note:   req.Note,
+	})
+	if err != nil {
+		h.writeActionError(w, "resolving alerts of source", err)
+		return
+	}
+
+	resp := resolveSourceResponse{
+		Source: source,
+		Count:  len(alerts),
+		Alerts: make([]dto.AlertResponse, 0, len(alerts)),
+	}
+	for _, a := range alerts {
+		resp.Alerts = append(resp.Alerts, dto.NewAlertResponse(a))
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// Ack handles POST /api/v1/alerts/{id}/ack.
+func (h *AlertsAPIHandler) Ack(w http.ResponseWriter, r *http.Request) {
+	req, ok := decodeActionRequest(w, r)
+	if !ok {
+		return
+	}
+
+	input := api.AckAlertInput{
+		AlertID:   r.PathValue("id"),
+		UserName:  req.User,
+		UserEmail: req.Email,
+		Note:      req.Note,
+	}
+	if req.Duration != "" {
+		d, err := time.ParseDuration(req.Duration)
+		if err != nil || d <= 0 {
+			writeAPIError(w, http.StatusBadRequest, "duration must be a positive Go duration, e.g. 30m")
+			return
+		}
+		input.Duration = &d
+	}
+
+	a, err := h.manageAlerts.Acknowledge(r.Context(), input)
+	if err != nil {
+		h.writeActionError(w, "acknowledging alert", err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewAlertResponse(a))
+}
+
+// Resolve handles POST /api/v1/alerts/{id}/resolve.
+func (h *AlertsAPIHandler) Resolve(w http.ResponseWriter, r *http.Request) {
+	req, ok := decodeActionRequest(w, r)
+	if !ok {
+		return
+	}
+
+	a, err := h.manageAlerts.Resolve(r.Context(), api.ResolveAlertInput{
+		AlertID:   r.PathValue("id"),
+		By:        req.User,
+		RootCause: strings.TrimSpace(req.RootCause),
+		Note:      strings.TrimSpace(req.Note),
+	})
+	if err != nil {
+		h.writeActionError(w, "resolving alert", err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewAlertResponse(a))
+}
+
+// AddNote handles POST /api/v1/alerts/{id}/notes.
+func (h *AlertsAPIHandler) AddNote(w http.ResponseWriter, r *http.Request) {
+	req, ok := decodeActionRequest(w, r)
+	if !ok {
+		return
+	}
+
+	a, err := h.manageAlerts.AddNote(r.Context(), api.AddNoteInput{
+		AlertID: r.PathValue("id"),
+		By:      req.User,
+		Text:    req.Text,
+	})
+	if err != nil {
+		h.writeActionError(w, "adding note", err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, dto.NewAlertResponse(a))
+}
+
+// writeActionError maps use case errors to status codes.
+func (h *AlertsAPIHandler) writeActionError(w http.ResponseWriter, action string, err error) {
+	switch {
+	case entity.IsNotFound(err):
+		writeAPIError(w, http.StatusNotFound, err.Error())
+	case entity.IsConflict(err):
+		writeAPIError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, entity.ErrInvalidNote), errors.Is(err, entity.ErrInvalidAlert):
+		writeAPIError(w, http.StatusBadRequest, err.Error())
+	default:
+		h.logger.Error(action, "error", err)
+		writeAPIError(w, http.StatusInternalServerError, "internal server error")
+	}
+}
+
+// parseListAlertsQuery converts list query parameters to use case input.
+func parseListAlertsQuery(r *http.Request) (api.ListAlertsInput, error) {
+	query := r.URL.Query()
+	input := api.ListAlertsInput{Limit: defaultAlertListLimit}
+
+	for _, v := range query["state"] {
+		for _, s := range strings.Split(v, ",") {
+			switch state := entity.AlertState(strings.TrimSpace(s)); state {
+			case entity.StateActive, entity.StateAcked, entity.StateResolved:
+				input.States = append(input.States, state)
+			default:
+				return input, errors.New("state must be active, acknowledged, or resolved")
+			}
+		}
+	}
+
+	if v := query.Get("severity"); v != "" {
+		switch severity := entity.AlertSeverity(v); severity {
+		case entity.SeverityCritical, entity.SeverityWarning, entity.SeverityInfo:
+			input.Severity = severity
+		default:
+			return input, errors.New("severity must be critical, warning, or info")
+		}
+	}
+
+	for _, v := range query["label"] {
+		key, value, ok := strings.Cut(v, "=")
+		if !ok || key == "" {
+			return input, errors.New("label must be key=value")
+		}
+		if input.Labels == nil {
+			input.Labels = make(map[string]string)
+		}
+		input.Labels[key] = value
+	}
+
+	for _, v := range query["field"] {
+		name, value, ok := strings.Cut(v, "=")
+		if !ok || name == "" {
+			return input, errors.New("field must be name=value")
+		}
+		if input.Fields == nil {
+			input.Fields = make(map[string]string)
+		}
+		input.Fields[name] = value
+	}
+
+	input.Since = time.Now().UTC().Add(-defaultResolvedLookback)
+	if v := query.Get("since"); v != "" {
+		since, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return input, errors.New("since must be an RFC 3339 timestamp")
+		}
+		input.Since = since.UTC()
+	}
+
+	if v := query.Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > maxAlertListLimit {
+			return input, errors.New("limit must be between 1 and 1000")
+		}
+		input.Limit = n
+	}
+
+	input.View = query.Get("view")
+	input.ViewOwner = query.Get("view_owner")
+
+	return input, nil
+}
+
+// decodeActionRequest reads an action body and requires a user. On failure
+// it writes the response and returns false.
+func decodeActionRequest(w http.ResponseWriter, r *http.Request) (dto.AlertActionRequest, bool) {
+	var req dto.AlertActionRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxAPIBodySize)).Decode(&req); err != nil {
+		writeAPIError(w, http.StatusBadRequest, "invalid JSON body")
+		return req, false
+	}
+	req.User = strings.TrimSpace(req.User)
+	if req.User == "" {
+		writeAPIError(w, http.StatusBadRequest, "user is required")
+		return req, false
+	}
+	return req, true
+}
+
+// writeJSON writes v as a JSON response with the given status.
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeAPIError writes a JSON error response.
+func writeAPIError(w http.ResponseWriter, status int, msg string) {
+	writeJSON(w, status, apiErrorResponse{Error: msg})
+}
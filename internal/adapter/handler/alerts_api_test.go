@@ -0,0 +1,211 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/adapter/dto"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/memory"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/api"
+)
+
+func TestAlertsAPIHandler_Get(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewAlertRepository()
+	h := NewAlertsAPIHandler(api.NewManageAlertsUseCase(repo, nil, nil, nopLogger{}), nil, nopLogger{})
+
+	firedAt := time.Date(2026, 1, 2, 3, 1, 0, 0, time.UTC)
+	alert := entity.NewAlert("fp-1", "HighCPU", "api-1", "", "CPU above 90%", entity.SeverityWarning)
+	alert.FiredAt = firedAt
+	alert.ChangeSeverity(entity.SeverityCritical, firedAt.Add(time.Minute))
+	if err := alert.Acknowledge("alice", firedAt.Add(4*time.Minute)); err != nil {
+		t.Fatal(err)
+	}
+	alert.Resolve(firedAt.Add(39 * time.Minute))
+	if err := repo.Save(ctx, alert); err != nil {
+		t.Fatal(err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/alerts/"+alert.ID, nil)
+	req.SetPathValue("id", alert.ID)
+	rec := httptest.NewRecorder()
+	h.Get(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body %s", rec.Code, rec.Body)
+	}
+
+	var got dto.AlertResponse
+	if err := json.NewDecoder(rec.Body).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	want := []dto.AlertStateResponse{
+		{State: "active", Severity: "critical", At: firedAt.Add(time.Minute)},
+		{State: "acknowledged", Severity: "critical", At: firedAt.Add(4 * time.Minute), Actor: "alice"},
+		{State: "resolved", Severity: "critical", At: firedAt.Add(39 * time.Minute)},
+	}
+	if !reflect.DeepEqual(got.History, want) {
+		t.Errorf("history = %+v, want %+v", got.History, want)
+	}
+
+	// Unknown alerts are not found
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/alerts/unknown", nil)
+	req.SetPathValue("id", "unknown")
+	rec = httptest.NewRecorder()
+	h.Get(rec, req)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("status = %d, want 404", rec.Code)
+	}
+}
+
+func TestAlertsAPIHandler_List(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewAlertRepository()
+	h := NewAlertsAPIHandler(api.NewManageAlertsUseCase(repo, nil, nil, nopLogger{}), nil, nopLogger{})
+
+	now := time.Now().UTC()
+	newAlert := func(fingerprint, customer string, firedAgo time.Duration) *entity.Alert {
+		alert := entity.NewAlert(fingerprint, "HighCPU", "api-1", "", "CPU above 90%", entity.SeverityCritical)
+		alert.CustomFields = map[string]string{"customer": customer}
+		alert.FiredAt = now.Add(-firedAgo)
+		if err := repo.Save(ctx, alert); err != nil {
+			t.Fatal(err)
+		}
+		return alert
+	}
+	acme := newAlert("fp-1", "acme", time.Hour)
+	newAlert("fp-2", "globex", 2*time.Hour)
+	resolved := newAlert("fp-3", "acme", 3*time.Hour)
+	resolved.Resolve(now.Add(-time.Hour))
+	if err := repo.Update(ctx, resolved); err != nil {
+		t.Fatal(err)
+	}
+	old := newAlert("fp-4", "acme", 72*time.Hour)
+	old.Resolve(now.Add(-48 * time.Hour))
+	if err := repo.Update(ctx, old); err != nil {
+		t.Fatal(err)
+	}
+
+	list := func(query string) (int, alertListResponse) {
+		rec := httptest.NewRecorder()
+		h.List(rec, httptest.NewRequest(http.MethodGet, "/api/v1/alerts?"+query, nil))
+		var resp alertListResponse
+		if rec.Code == http.StatusOK {
+			if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return rec.Code, resp
+	}
+	ids := func(resp alertListResponse) []string {
+		var ids []string
+		for _, a := range resp.Alerts {
+			ids = append(ids, a.ID)
+		}
+		return ids
+	}
+
+	// Firing alerts by default, with their custom fields
+	code, resp := list("field=customer=acme")
+	if code != http.StatusOK || !reflect.DeepEqual(ids(resp), []string{acme.ID}) {
+		t.Fatalf("field=customer=acme: %d %v", code, ids(resp))
+	}
+	if got := resp.Alerts[0].CustomFields; !reflect.DeepEqual(got, map[string]string{"customer": "acme"}) {
+		t.Errorf("custom_fields = %v", got)
+	}
+
+	// Resolved alerts within the default lookback
+	if _, resp := list("state=active,resolved&field=customer=acme"); !reflect.DeepEqual(ids(resp), []string{acme.ID, resolved.ID}) {
+		t.Errorf("state=active,resolved: %v", ids(resp))
+	}
+
+	if code, _ := list("field=customer"); code != http.StatusBadRequest {
+		t.Errorf("field without value: status = %d, want 400", code)
+	}
+}
+
+func TestAlertsAPIHandler_ListView(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewAlertRepository()
+	views := memory.NewSavedViewRepository()
+	manageAlerts := api.NewManageAlertsUseCase(repo, nil, nil, nopLogger{})
+	manageAlerts.SetSavedViewRepository(views)
+	h := NewAlertsAPIHandler(manageAlerts, nil, nopLogger{})
+
+	newAlert := func(fingerprint, team string, severity entity.AlertSeverity) *entity.Alert {
+		alert := entity.NewAlert(fingerprint, "HighCPU", "api-1", "", "CPU above 90%", severity)
+		alert.Labels = map[string]string{"team": team}
+		if err := repo.Save(ctx, alert); err != nil {
+			t.Fatal(err)
+		}
+		return alert
+	}
+	payments := newAlert("fp-1", "payments", entity.SeverityCritical)
+	newAlert("fp-2", "payments", entity.SeverityWarning)
+	newAlert("fp-3", "infra", entity.SeverityCritical)
+	acked := newAlert("fp-4", "payments", entity.SeverityCritical)
+	if err := acked.Acknowledge("alice", time.Now().UTC()); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.Update(ctx, acked); err != nil {
+		t.Fatal(err)
+	}
+
+	saveView := func(name, owner string, severity entity.AlertSeverity, state entity.AlertState, team string) {
+		view, err := entity.NewSavedView(name, owner, "alice")
+		if err != nil {
+			t.Fatal(err)
+		}
+		view.Severity = severity
+		view.State = state
+		view.Labels["team"] = team
+		if err := views.Save(ctx, view); err != nil {
+			t.Fatal(err)
+		}
+	}
+	saveView("payments-critical", "", entity.SeverityCritical, entity.StateActive, "payments")
+	saveView("payments-critical", "U123", "", entity.StateAcked, "payments")
+
+	list := func(query string) (int, []string) {
+		rec := httptest.NewRecorder()
+		h.List(rec, httptest.NewRequest(http.MethodGet, "/api/v1/alerts?"+query, nil))
+		var resp alertListResponse
+		var ids []string
+		if rec.Code == http.StatusOK {
+			if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+				t.Fatal(err)
+			}
+			for _, a := range resp.Alerts {
+				ids = append(ids, a.ID)
+			}
+		}
+		return rec.Code, ids
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		code  int
+		want  []string
+	}{
+		{"shared view", "view=payments-critical", http.StatusOK, []string{payments.ID}},
+		{"own view takes precedence", "view=payments-critical&view_owner=U123", http.StatusOK, []string{acked.ID}},
+		{"other owner sees the shared view", "view=payments-critical&view_owner=U456", http.StatusOK, []string{payments.ID}},
+		{"limit counts matching alerts", "view=payments-critical&limit=1", http.StatusOK, []string{payments.ID}},
+		{"conflicting parameters", "view=payments-critical&severity=warning", http.StatusOK, nil},
+		{"unknown view", "view=missing", http.StatusNotFound, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, ids := list(tt.query)
+			if code != tt.code || !reflect.DeepEqual(ids, tt.want) {
+				t.Errorf("%s: %d %v, want %d %v", tt.query, code, ids, tt.code, tt.want)
+			}
+		})
+	}
+}
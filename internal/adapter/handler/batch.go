@@ -0,0 +1,297 @@
+package handler
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/adapter/dto"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/observability"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
+)
+
+// sourceBatch labels ingestion metrics recorded by this handler.
+const sourceBatch = "batch"
+
+// batchFlushEvery is how many results are buffered before flushing the
+// response, so producers see progress on long streams.
+const batchFlushEvery = 50
+
+// batchIdleTimeout is how long a producer may take to send each line, and
+// a response write may take, once the token is accepted. It replaces the
+// server's read and write timeouts, which cover the whole request.
+const batchIdleTimeout = time.Minute
+
+// errLineTooLong reports a line longer than the configured limit.
+var errLineTooLong = errors.New("line too long")
+
+// BatchIngestHandler ingests NDJSON streams of alerts, one Alertmanager-style
+// alert object per line. Lines are parsed and processed one at a time, so
+// memory use is bounded by the line limit rather than the body size.
+type BatchIngestHandler struct {
+	processAlert *alert.ProcessAlertUseCase
+	token        string
+	maxLineBytes int
+	maxItems     int
+	logger       alert.Logger
+	metrics      *observability.Metrics
+	fingerprint  dto.FingerprintStrategy
+	names        dto.AlertNameMap
+	severities   *dto.SeverityMap
+}
+
+// NewBatchIngestHandler creates a new handler. Requests must carry token as
+// a bearer token.
+func NewBatchIngestHandler(processAlert *alert.ProcessAlertUseCase, token string, maxLineBytes, maxItems int, logger alert.Logger) *BatchIngestHandler {
+	return &BatchIngestHandler{
+		processAlert: processAlert,
+		token:        token,
+		maxLineBytes: maxLineBytes,
+		maxItems:     maxItems,
+		logger:       logger,
+	}
+}
+
+// SetMetrics enables per-source ingestion metrics.
+func (h *BatchIngestHandler) SetMetrics(metrics *observability.Metrics) {
+	h.metrics = metrics
+}
+
+// SetFingerprintStrategy overrides how alert fingerprints are computed.
+func (h *BatchIngestHandler) SetFingerprintStrategy(strategy dto.FingerprintStrategy) {
+	h.fingerprint = strategy
+}
+
+// SetAlertNames sets the map used to rename alerts to canonical names.
+func (h *BatchIngestHandler) SetAlertNames(names dto.AlertNameMap) {
+	h.names = names
+}
+
+// SetSeverityMap sets the configured mapping of severity label values.
+func (h *BatchIngestHandler) SetSeverityMap(severities *dto.SeverityMap) {
+	h.severities = severities
+}
+
+// ServeHTTP handles POST /webhook/batch. The response is an NDJSON stream
+// with one result per input line followed by a summary line.
+func (h *BatchIngestHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	provided, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || subtle.ConstantTimeCompare([]byte(provided), []byte(h.token)) != 1 {
+		h.logger.Warn("invalid batch ingestion credentials",
+			"remote_addr", r.RemoteAddr,
+		)
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	receivedAt := time.Now()
+	ctx := r.Context()
+
+	// Results are written while the body is still being read, which HTTP/1
+	// only allows in full-duplex mode; HTTP/2 always is. The server's read
+	// and write timeouts cover the whole request and would cut off large
+	// streams, so authenticated producers get a deadline per line instead.
+	rc := http.NewResponseController(w)
+	if err := rc.EnableFullDuplex(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		h.logger.Error("enabling full-duplex batch response", "error", err)
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return
+	}
+	if err := extendBatchDeadlines(rc); err != nil {
+		h.logger.Error("setting batch deadlines", "error", err)
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(http.StatusOK)
+	enc := json.NewEncoder(w)
+
+	reader := bufio.NewReaderSize(r.Body, h.maxLineBytes)
+	var counts dto.BatchCounts
+	lineNo, items := 0, 0
+
+	for {
+		if err := extendBatchDeadlines(rc); err != nil {
+			h.logger.Error("setting batch deadlines",
+				"line", lineNo+1,
+				"error", err,
+			)
+			break
+		}
+
+		line, err := readBatchLine(reader)
+		if err == io.EOF {
+			break
+		}
+		lineNo++
+
+		if err != nil && !errors.Is(err, errLineTooLong) {
+			// Body read failed (client went away); nothing more to report
+			h.logger.Error("reading batch body",
+				"line", lineNo,
+				"error", err,
+			)
+			break
+		}
+		if err == nil && len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+
+		if items == h.maxItems {
+			counts.Truncated = true
+			enc.Encode(dto.BatchItemResult{
+				Line:   lineNo,
+				Status: dto.BatchItemRejected,
+				Error:  fmt.Sprintf("item limit of %d reached; remaining lines were not read", h.maxItems),
+			})
+			break
+		}
+		items++
+
+		result := h.processLine(r, receivedAt, lineNo, line, err)
+
+		// Processing may have taken a while; give the write its own time
+		if err := extendBatchDeadlines(rc); err != nil {
+			h.logger.Error("setting batch deadlines",
+				"line", lineNo,
+				"error", err,
+			)
+			break
+		}
+		switch result.Status {
+		case dto.BatchItemProcessed:
+			counts.Processed++
+		case dto.BatchItemFailed:
+			counts.Failed++
+		case dto.BatchItemInvalid:
+			counts.Invalid++
+		}
+		enc.Encode(result)
+
+		if items%batchFlushEvery == 0 {
+			rc.Flush()
+		}
+	}
+
+	enc.Encode(dto.BatchSummary{Summary: counts})
+	rc.Flush()
+
+	if h.metrics != nil {
+		h.metrics.RecordWebhookPayload(ctx, sourceBatch, items, 0)
+	}
+	h.logger.Info("batch ingested",
+		"lines", lineNo,
+		"processed", counts.Processed,
+		"failed", counts.Failed,
+		"invalid", counts.Invalid,
+		"truncated", counts.Truncated,
+		"duration", time.Since(receivedAt),
+	)
+}
+
+// processLine parses and processes one line. readErr is errLineTooLong if
+// the line was cut off.
+func (h *BatchIngestHandler) processLine(r *http.Request, receivedAt time.Time, lineNo int, line []byte, readErr error) dto.BatchItemResult {
+	ctx := r.Context()
+	result := dto.BatchItemResult{Line: lineNo}
+
+	if readErr != nil {
+		result.Status = dto.BatchItemInvalid
+		result.Error = fmt.Sprintf("line exceeds %d bytes", h.maxLineBytes)
+		h.recordParseFailure(r)
+		return result
+	}
+
+	input, err := dto.ParseBatchLine(line)
+	if err != nil {
+		result.Status = dto.BatchItemInvalid
+		result.Error = err.Error()
+		h.recordParseFailure(r)
+		return result
+	}
+	h.severities.Apply(sourceBatch, &input)
+	h.names.Apply(&input)
+	h.fingerprint.Apply(&input)
+	input.ReceivedAt = receivedAt
+	input.Source = sourceBatch
+
+	output, err := h.processAlert.Execute(ctx, input)
+	if err != nil {
+		h.logger.Error("failed to process alert",
+			"source", sourceBatch,
+			"line", lineNo,
+			"fingerprint", input.Fingerprint,
+			"error", err,
+		)
+		result.Status = dto.BatchItemFailed
+		result.Error = "processing failed"
+		return result
+	}
+
+	if h.metrics != nil && len(output.NotificationsSent) > 0 {
+		h.metrics.RecordIngestToNotify(ctx, sourceBatch, time.Since(receivedAt))
+	}
+	result.Status = dto.BatchItemProcessed
+	result.AlertID = output.AlertID
+	result.IsNew = output.IsNew
+	result.IsSilenced = output.IsSilenced
+	return result
+}
+
+func (h *BatchIngestHandler) recordParseFailure(r *http.Request) {
+	if h.metrics != nil {
+		h.metrics.RecordWebhookParseFailure(r.Context(), sourceBatch)
+	}
+}
+
+// extendBatchDeadlines moves the connection's read and write deadlines
+// batchIdleTimeout ahead. Connections without deadlines, such as test
+// recorders, are left as they are.
+func extendBatchDeadlines(rc *http.ResponseController) error {
+	deadline := time.Now().Add(batchIdleTimeout)
+	if err := rc.SetReadDeadline(deadline); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return fmt.Errorf("setting read deadline: %w", err)
+	}
+	if err := rc.SetWriteDeadline(deadline); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return fmt.Errorf("setting write deadline: %w", err)
+	}
+	return nil
+}
+
+// readBatchLine returns the next line without its newline. A line longer
+// than the reader's buffer is consumed and reported as errLineTooLong, so a
+// single oversized item does not abort the stream. Returns io.EOF when the
+// body is exhausted.
+func readBatchLine(br *bufio.Reader) ([]byte, error) {
+	line, err := br.ReadSlice('\n')
+	switch {
+	case err == nil:
+		return bytes.TrimSuffix(line, []byte("\n")), nil
+	case errors.Is(err, bufio.ErrBufferFull):
+		for errors.Is(err, bufio.ErrBufferFull) {
+			_, err = br.ReadSlice('\n')
+		}
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		return nil, errLineTooLong
+	case err == io.EOF && len(line) > 0:
+		// Final line without a trailing newline
+		return line, nil
+	default:
+		return nil, err
+	}
+}
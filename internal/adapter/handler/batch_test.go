@@ -0,0 +1,177 @@
+package handler
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/adapter/dto"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/memory"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
+)
+
+// brokenAlertRepository fails to save the alert with a given fingerprint.
+type brokenAlertRepository struct {
+	*memory.AlertRepository
+	fingerprint string
+}
+
+func (r *brokenAlertRepository) Save(ctx context.Context, alert *entity.Alert) error {
+	if alert.Fingerprint == r.fingerprint {
+		return errors.New("database unavailable")
+	}
+	return r.AlertRepository.Save(ctx, alert)
+}
+
+func TestBatchIngestHandler(t *testing.T) {
+	repo := &brokenAlertRepository{AlertRepository: memory.NewAlertRepository(), fingerprint: "fp-broken"}
+	processAlert := alert.NewProcessAlertUseCase(repo, memory.NewSilenceRepository(), nil, nopLogger{}, nil)
+
+	// A real server, so the connection's deadlines are set rather than skipped
+	newServer := func(maxItems int) *httptest.Server {
+		srv := httptest.NewServer(NewBatchIngestHandler(processAlert, "secret", 256, maxItems, nopLogger{}))
+		t.Cleanup(srv.Close)
+		return srv
+	}
+	post := func(srv *httptest.Server, body string) ([]dto.BatchItemResult, dto.BatchCounts) {
+		t.Helper()
+		req, err := http.NewRequest(http.MethodPost, srv.URL, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Authorization", "Bearer secret")
+		resp, err := srv.Client().Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("status = %d, want 200", resp.StatusCode)
+		}
+
+		var results []dto.BatchItemResult
+		var summary dto.BatchSummary
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			if strings.HasPrefix(scanner.Text(), `{"summary"`) {
+				if err := json.Unmarshal(scanner.Bytes(), &summary); err != nil {
+					t.Fatal(err)
+				}
+				continue
+			}
+			var result dto.BatchItemResult
+			if err := json.Unmarshal(scanner.Bytes(), &result); err != nil {
+				t.Fatal(err)
+			}
+			results = append(results, result)
+		}
+		if err := scanner.Err(); err != nil {
+			t.Fatal(err)
+		}
+		return results, summary.Summary
+	}
+	line := func(fingerprint string) string {
+		return `{"labels":{"alertname":"HighCPU","instance":"web-1"},"fingerprint":"` + fingerprint + `"}`
+	}
+
+	t.Run("per-line results", func(t *testing.T) {
+		body := strings.Join([]string{
+			line("fp-1"),
+			"",
+			`{"labels":`,
+			`{"labels":{"instance":"web-1"}}`,
+			`{"labels":{"alertname":"HighCPU"},"annotations":{"description":"` + strings.Repeat("x", 300) + `"}}`,
+			line("fp-broken"),
+			line("fp-1"),
+		}, "\n")
+		results, counts := post(newServer(100), body)
+
+		type outcome struct {
+			Line   int
+			Status string
+			IsNew  bool
+		}
+		var got []outcome
+		for _, r := range results {
+			got = append(got, outcome{r.Line, r.Status, r.IsNew})
+		}
+		want := []outcome{
+			{1, dto.BatchItemProcessed, true},
+			{3, dto.BatchItemInvalid, false},
+			{4, dto.BatchItemInvalid, false},
+			{5, dto.BatchItemInvalid, false},
+			{6, dto.BatchItemFailed, false},
+			{7, dto.BatchItemProcessed, false},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("results = %+v, want %+v", got, want)
+		}
+
+		if results[0].AlertID == "" || results[0].AlertID != results[5].AlertID {
+			t.Errorf("alert IDs = %q and %q, want the same alert", results[0].AlertID, results[5].AlertID)
+		}
+		if !strings.HasPrefix(results[1].Error, "invalid JSON") {
+			t.Errorf("malformed line error = %q", results[1].Error)
+		}
+		if results[2].Error != "labels.alertname is required" {
+			t.Errorf("missing alertname error = %q", results[2].Error)
+		}
+		if results[3].Error != "line exceeds 256 bytes" {
+			t.Errorf("over-long line error = %q", results[3].Error)
+		}
+		if results[4].Error != "processing failed" {
+			t.Errorf("failed line error = %q", results[4].Error)
+		}
+		if want := (dto.BatchCounts{Processed: 2, Failed: 1, Invalid: 3}); counts != want {
+			t.Errorf("summary = %+v, want %+v", counts, want)
+		}
+	})
+
+	t.Run("max items", func(t *testing.T) {
+		body := strings.Join([]string{line("fp-2"), line("fp-3"), line("fp-4"), line("fp-5")}, "\n")
+		results, counts := post(newServer(2), body)
+
+		if len(results) != 3 {
+			t.Fatalf("got %d results, want 3: %+v", len(results), results)
+		}
+		last := results[2]
+		if last.Line != 3 || last.Status != dto.BatchItemRejected || last.Error != "item limit of 2 reached; remaining lines were not read" {
+			t.Errorf("last result = %+v, want line 3 rejected", last)
+		}
+		if want := (dto.BatchCounts{Processed: 2, Truncated: true}); counts != want {
+			t.Errorf("summary = %+v, want %+v", counts, want)
+		}
+
+		found, err := repo.FindByFingerprint(context.Background(), "fp-4")
+		if err != nil || len(found) != 0 {
+			t.Errorf("alert past the limit was stored: %v, %v", found, err)
+		}
+	})
+
+	t.Run("auth failure", func(t *testing.T) {
+		h := NewBatchIngestHandler(processAlert, "secret", 256, 100, nopLogger{})
+		for _, auth := range []string{"", "Bearer wrong", "secret"} {
+			req := httptest.NewRequest(http.MethodPost, "/webhook/batch", strings.NewReader(line("fp-6")))
+			if auth != "" {
+				req.Header.Set("Authorization", auth)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			if rec.Code != http.StatusUnauthorized {
+				t.Errorf("Authorization %q: status = %d, want 401", auth, rec.Code)
+			}
+		}
+
+		found, err := repo.FindByFingerprint(context.Background(), "fp-6")
+		if err != nil || len(found) != 0 {
+			t.Errorf("unauthorized alert was stored: %v, %v", found, err)
+		}
+	})
+}
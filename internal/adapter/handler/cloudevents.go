@@ -0,0 +1,149 @@
+package handler
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/adapter/dto"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/cloudevents"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/observability"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
+)
+
+// sourceCloudEvents labels ingestion metrics recorded by this handler.
+const sourceCloudEvents = "cloudevents"
+
+// CloudEventsHandler ingests alerts sent as CloudEvents in binary,
+// structured or batched content mode, such as by an event mesh or another
+// bridge emitting to this one.
+type CloudEventsHandler struct {
+	processAlert *alert.ProcessAlertUseCase
+	token        string
+	maxEvents    int
+	logger       alert.Logger
+	metrics      *observability.Metrics
+	fingerprint  dto.FingerprintStrategy
+	names        dto.AlertNameMap
+	severities   *dto.SeverityMap
+}
+
+// NewCloudEventsHandler creates a new handler. Requests must carry token as
+// a bearer token; batches of more than maxEvents are rejected.
+func NewCloudEventsHandler(processAlert *alert.ProcessAlertUseCase, token string, maxEvents int, logger alert.Logger) *CloudEventsHandler {
+	return &CloudEventsHandler{
+		processAlert: processAlert,
+		token:        token,
+		maxEvents:    maxEvents,
+		logger:       logger,
+	}
+}
+
+// SetMetrics enables per-source ingestion metrics.
+func (h *CloudEventsHandler) SetMetrics(metrics *observability.Metrics) {
+	h.metrics = metrics
+}
+
+// SetFingerprintStrategy overrides how alert fingerprints are computed.
+func (h *CloudEventsHandler) SetFingerprintStrategy(strategy dto.FingerprintStrategy) {
+	h.fingerprint = strategy
+}
+
+// SetAlertNames sets the map used to rename alerts to canonical names.
+func (h *CloudEventsHandler) SetAlertNames(names dto.AlertNameMap) {
+	h.names = names
+}
+
+// SetSeverityMap sets the configured mapping of severity label values.
+func (h *CloudEventsHandler) SetSeverityMap(severities *dto.SeverityMap) {
+	h.severities = severities
+}
+
+// ServeHTTP handles POST /webhook/cloudevents. Every event is validated
+// before any is processed, so a rejected batch can be fixed and resent as
+// a whole.
+func (h *CloudEventsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	provided, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || subtle.ConstantTimeCompare([]byte(provided), []byte(h.token)) != 1 {
+		h.logger.Warn("invalid cloudevents ingestion credentials",
+			"remote_addr", r.RemoteAddr,
+		)
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	receivedAt := time.Now()
+	ctx := r.Context()
+
+	events, err := cloudevents.ReadRequest(r)
+	if err != nil {
+		h.logger.Error("failed to read cloudevents", "error", err)
+		h.recordParseFailure(r)
+		http.Error(w, "invalid payload: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if len(events) > h.maxEvents {
+		h.recordParseFailure(r)
+		http.Error(w, fmt.Sprintf("batch of %d events exceeds the limit of %d", len(events), h.maxEvents), http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	inputs := make([]dto.ProcessAlertInput, 0, len(events))
+	for i, event := range events {
+		input, err := h.parse(event)
+		if err != nil {
+			h.logger.Error("invalid cloudevent",
+				"index", i,
+				"id", event.ID,
+				"source", event.Source,
+				"error", err,
+			)
+			h.recordParseFailure(r)
+			http.Error(w, fmt.Sprintf("event %d (%s): %v", i, event.ID, err), http.StatusBadRequest)
+			return
+		}
+		inputs = append(inputs, input)
+	}
+
+	if h.metrics != nil {
+		h.metrics.RecordWebhookPayload(ctx, sourceCloudEvents, len(inputs), 0)
+	}
+
+	batch := alertBatch{
+		source:       sourceCloudEvents,
+		processAlert: h.processAlert,
+		logger:       h.logger,
+		metrics:      h.metrics,
+		fingerprint:  h.fingerprint,
+		names:        h.names,
+		severities:   h.severities,
+	}
+	processed, failed := batch.process(ctx, receivedAt, inputs, dto.ProcessAlertGroupInput{})
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"status":    "ok",
+		"processed": processed,
+		"failed":    failed,
+	})
+}
+
+// parse validates an event and converts its data.
+func (h *CloudEventsHandler) parse(event cloudevents.Event) (dto.ProcessAlertInput, error) {
+	if err := event.Validate(); err != nil {
+		return dto.ProcessAlertInput{}, err
+	}
+	return dto.ParseCloudEvent(event)
+}
+
+func (h *CloudEventsHandler) recordParseFailure(r *http.Request) {
+	if h.metrics != nil {
+		h.metrics.RecordWebhookParseFailure(r.Context(), sourceCloudEvents)
+	}
+}
@@ -0,0 +1,223 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/adapter/dto"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/observability"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/sns"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
+)
+
+// sourceCloudWatch labels ingestion metrics recorded by this handler.
+const sourceCloudWatch = "cloudwatch"
+
+// maxSNSBodySize bounds SNS request bodies; SNS messages are at most 256 KiB.
+const maxSNSBodySize = 512 * 1024
+
+// SNSVerifier verifies SNS message signatures and confirms subscriptions.
+type SNSVerifier interface {
+	Verify(ctx context.Context, msg *sns.Message) error
+	ConfirmSubscription(ctx context.Context, msg *sns.Message) error
+}
+
+// CloudWatchHandler handles CloudWatch alarm notifications delivered by Amazon SNS.
+type CloudWatchHandler struct {
+	processAlert *alert.ProcessAlertUseCase
+	verifier     SNSVerifier
+	severity     entity.AlertSeverity
+	topicARNs    []string
+	logger       alert.Logger
+	metrics      *observability.Metrics
+	fingerprint  dto.FingerprintStrategy
+	names        dto.AlertNameMap
+}
+
+// NewCloudWatchHandler creates a new handler.
+// severity is applied to every alarm; topicARNs, if non-empty, restricts
+// which SNS topics are accepted.
+func NewCloudWatchHandler(
+	processAlert *alert.ProcessAlertUseCase,
+	verifier SNSVerifier,
+	severity entity.AlertSeverity,
+	topicARNs []string,
+	logger alert.Logger,
+) *CloudWatchHandler {
+	return &CloudWatchHandler{
+		processAlert: processAlert,
+		verifier:     verifier,
+		severity:     severity,
+		topicARNs:    topicARNs,
+		logger:       logger,
+	}
+}
+
+// SetMetrics enables per-source ingestion metrics.
+func (h *CloudWatchHandler) SetMetrics(metrics *observability.Metrics) {
+	h.metrics = metrics
+}
+
+// SetFingerprintStrategy overrides how alert fingerprints are computed.
+func (h *CloudWatchHandler) SetFingerprintStrategy(strategy dto.FingerprintStrategy) {
+	h.fingerprint = strategy
+}
+
+// SetAlertNames sets the map used to rename alerts to canonical names.
+func (h *CloudWatchHandler) SetAlertNames(names dto.AlertNameMap) {
+	h.names = names
+}
+
+// ServeHTTP handles POST /webhook/cloudwatch
+func (h *CloudWatchHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	receivedAt := time.Now()
+	ctx := r.Context()
+
+	// SNS posts JSON with Content-Type text/plain, so decode regardless of header
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxSNSBodySize))
+	if err != nil {
+		http.Error(w, "failed to read body", http.StatusBadRequest)
+		return
+	}
+
+	var msg sns.Message
+	if err := json.Unmarshal(body, &msg); err != nil {
+		h.logger.Error("failed to decode SNS message", "error", err)
+		h.recordParseFailure(ctx)
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	if len(h.topicARNs) > 0 && !slices.Contains(h.topicARNs, msg.TopicArn) {
+		h.logger.Warn("rejected SNS message from unknown topic", "topicArn", msg.TopicArn)
+		http.Error(w, "topic not allowed", http.StatusForbidden)
+		return
+	}
+
+	if err := h.verifier.Verify(ctx, &msg); err != nil {
+		h.logger.Warn("SNS signature verification failed",
+			"topicArn", msg.TopicArn,
+			"messageID", msg.MessageID,
+			"error", err,
+		)
+		http.Error(w, "invalid signature", http.StatusForbidden)
+		return
+	}
+
+	switch msg.Type {
+	case sns.TypeSubscriptionConfirmation:
+		h.confirmSubscription(ctx, w, &msg)
+	case sns.TypeUnsubscribeConfirmation:
+		h.logger.Info("SNS subscription removed", "topicArn", msg.TopicArn)
+		w.WriteHeader(http.StatusOK)
+	case sns.TypeNotification:
+		h.handleNotification(ctx, w, &msg, receivedAt)
+	default:
+		h.logger.Warn("unhandled SNS message type", "type", msg.Type)
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// confirmSubscription completes the SNS subscription handshake.
+func (h *CloudWatchHandler) confirmSubscription(ctx context.Context, w http.ResponseWriter, msg *sns.Message) {
+	if err := h.verifier.ConfirmSubscription(ctx, msg); err != nil {
+		h.logger.Error("failed to confirm SNS subscription",
+			"topicArn", msg.TopicArn,
+			"error", err,
+		)
+		// A 5xx makes SNS retry the confirmation
+		http.Error(w, "subscription confirmation failed", http.StatusBadGateway)
+		return
+	}
+
+	h.logger.Info("SNS subscription confirmed", "topicArn", msg.TopicArn)
+	w.WriteHeader(http.StatusOK)
+}
+
+// handleNotification processes a CloudWatch alarm state change.
+func (h *CloudWatchHandler) handleNotification(ctx context.Context, w http.ResponseWriter, msg *sns.Message, receivedAt time.Time) {
+	var alarm dto.CloudWatchAlarm
+	if err := json.Unmarshal([]byte(msg.Message), &alarm); err != nil || alarm.AlarmArn == "" {
+		h.logger.Error("SNS notification is not a CloudWatch alarm",
+			"topicArn", msg.TopicArn,
+			"messageID", msg.MessageID,
+			"error", err,
+		)
+		h.recordParseFailure(ctx)
+		// Not retryable; acknowledge so SNS does not redeliver
+		writeCloudWatchResponse(w, 0, 0)
+		return
+	}
+
+	input, ok := alarm.ToProcessAlertInput(h.severity)
+	if !ok {
+		h.logger.Debug("ignoring CloudWatch alarm state",
+			"alarm", alarm.AlarmName,
+			"state", alarm.NewStateValue,
+		)
+		writeCloudWatchResponse(w, 0, 0)
+		return
+	}
+
+	if h.metrics != nil {
+		h.metrics.RecordWebhookPayload(ctx, sourceCloudWatch, 1, 0)
+	}
+
+	h.names.Apply(&input)
+	h.fingerprint.Apply(&input)
+	input.ReceivedAt = receivedAt
+	input.Source = sourceCloudWatch
+	output, err := h.processAlert.Execute(ctx, input)
+	if err != nil {
+		h.logger.Error("failed to process alert",
+			"source", sourceCloudWatch,
+			"alarm", alarm.AlarmName,
+			"status", input.Status,
+			"error", err,
+		)
+		// Let SNS retry with its delivery policy
+		http.Error(w, "failed to process alarm", http.StatusInternalServerError)
+		return
+	}
+
+	if h.metrics != nil && len(output.NotificationsSent) > 0 {
+		h.metrics.RecordIngestToNotify(ctx, sourceCloudWatch, time.Since(receivedAt))
+	}
+	h.logger.Info("alert processed",
+		"source", sourceCloudWatch,
+		"alertID", output.AlertID,
+		"alarm", alarm.AlarmName,
+		"status", input.Status,
+		"isNew", output.IsNew,
+		"isSilenced", output.IsSilenced,
+		"notificationsSent", output.NotificationsSent,
+	)
+
+	writeCloudWatchResponse(w, 1, 0)
+}
+
+func (h *CloudWatchHandler) recordParseFailure(ctx context.Context) {
+	if h.metrics != nil {
+		h.metrics.RecordWebhookParseFailure(ctx, sourceCloudWatch)
+	}
+}
+
+func writeCloudWatchResponse(w http.ResponseWriter, processed, failed int) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]any{
+		"status":    "ok",
+		"processed": processed,
+		"failed":    failed,
+	})
+}
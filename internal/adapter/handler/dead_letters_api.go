@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/adapter/dto"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/logger"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/api"
+)
+
+// DeadLettersAPIHandler serves the /api/v1/dead-letters REST endpoints.
+type DeadLettersAPIHandler struct {
+	manageDeadLetters *api.ManageDeadLettersUseCase
+	logger            logger.Logger
+}
+
+// NewDeadLettersAPIHandler creates a new dead letters API handler.
+func NewDeadLettersAPIHandler(manageDeadLetters *api.ManageDeadLettersUseCase, logger logger.Logger) *DeadLettersAPIHandler {
+	return &DeadLettersAPIHandler{
+		manageDeadLetters: manageDeadLetters,
+		logger:            logger,
+	}
+}
+
+// deadLetterListResponse is the response body for GET /api/v1/dead-letters.
+type deadLetterListResponse struct {
+	Count       int                      `json:"count"`
+	DeadLetters []dto.DeadLetterResponse `json:"dead_letters"`
+}
+
+// replayResponse is the response body for POST /api/v1/dead-letters/replay.
+type replayResponse struct {
+	Replayed int      `json:"replayed"`
+	NotFound []string `json:"not_found,omitempty"`
+}
+
+// List handles GET /api/v1/dead-letters.
+func (h *DeadLettersAPIHandler) List(w http.ResponseWriter, r *http.Request) {
+	deadLetters, err := h.manageDeadLetters.List(r.Context())
+	if err != nil {
+		h.logger.Error("listing dead letters", "error", err)
+		writeAPIError(w, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	resp := deadLetterListResponse{
+		Count:       len(deadLetters),
+		DeadLetters: make([]dto.DeadLetterResponse, 0, len(deadLetters)),
+	}
+	for _, d := range deadLetters {
+		resp.DeadLetters = append(resp.DeadLetters, dto.NewDeadLetterResponse(d))
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// Replay handles POST /api/v1/dead-letters/replay. IDs are taken from the
+// body rather than the path because they contain slashes. Unknown IDs are
+// reported back; the request fails with 404 only if none were found.
+func (h *DeadLettersAPIHandler) Replay(w http.ResponseWriter, r *http.Request) {
+	var req dto.ReplayDeadLettersRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxAPIBodySize)).Decode(&req); err != nil {
+		writeAPIError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+	if err := req.Validate(); err != nil {
+		writeAPIError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	by := req.User
+	if by == "" {
+		by = "api"
+	}
+
+	if req.All {
+		n, err := h.manageDeadLetters.ReplayAll(r.Context(), by)
+		if err != nil {
+			h.logger.Error("replaying dead letters", "error", err)
+			writeAPIError(w, http.StatusInternalServerError, "internal server error")
+			return
+		}
+		writeJSON(w, http.StatusAccepted, replayResponse{Replayed: n})
+		return
+	}
+
+	var resp replayResponse
+	for _, id := range req.IDs {
+		_, err := h.manageDeadLetters.Replay(r.Context(), id, by)
+		switch {
+		case err == nil:
+			resp.Replayed++
+		case entity.IsNotFound(err):
+			resp.NotFound = append(resp.NotFound, id)
+		default:
+			h.logger.Error("replaying dead letter", "retryID", id, "error", err)
+			writeAPIError(w, http.StatusInternalServerError, "internal server error")
+			return
+		}
+	}
+	if resp.Replayed == 0 {
+		writeAPIError(w, http.StatusNotFound, entity.ErrNotificationRetryNotFound.Error())
+		return
+	}
+	writeJSON(w, http.StatusAccepted, resp)
+}
+
+// Delete handles DELETE /api/v1/dead-letters/{id...}.
+func (h *DeadLettersAPIHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	if err := h.manageDeadLetters.Discard(r.Context(), r.PathValue("id"), "api"); err != nil {
+		if entity.IsNotFound(err) {
+			writeAPIError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		h.logger.Error("discarding dead letter", "error", err)
+		writeAPIError(w, http.StatusInternalServerError, "internal server error")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
+)
+
+// DeliverySLOHandler reports compliance with delivery latency objectives.
+type DeliverySLOHandler struct {
+	tracker *alert.DeliverySLOTracker
+}
+
+// NewDeliverySLOHandler creates a new delivery SLO handler.
+func NewDeliverySLOHandler(tracker *alert.DeliverySLOTracker) *DeliverySLOHandler {
+	return &DeliverySLOHandler{tracker: tracker}
+}
+
+// deliverySLOResponse is the response body for GET /-/slo.
+type deliverySLOResponse struct {
+	Objectives []alert.DeliveryObjectiveStatus `json:"objectives"`
+}
+
+// ServeHTTP handles GET /-/slo.
+func (h *DeliverySLOHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(deliverySLOResponse{Objectives: h.tracker.Status()})
+}
@@ -0,0 +1,140 @@
+package handler
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/adapter/dto"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/observability"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
+)
+
+// sourceGenericPrefix prefixes the source name in ingestion metrics,
+// e.g. "generic:uptime".
+const sourceGenericPrefix = "generic:"
+
+// GenericSource is one configured generic webhook source.
+type GenericSource struct {
+	Name    string
+	Token   string // Optional bearer token
+	Mapping *dto.GenericMapping
+
+	Fingerprint dto.FingerprintStrategy
+}
+
+// GenericWebhookHandler handles JSON webhooks from sources mapped in config.
+type GenericWebhookHandler struct {
+	sources      map[string]GenericSource
+	processAlert *alert.ProcessAlertUseCase
+	logger       alert.Logger
+	metrics      *observability.Metrics
+	names        dto.AlertNameMap
+}
+
+// NewGenericWebhookHandler creates a new handler for the given sources.
+func NewGenericWebhookHandler(processAlert *alert.ProcessAlertUseCase, sources []GenericSource, logger alert.Logger) *GenericWebhookHandler {
+	bySource := make(map[string]GenericSource, len(sources))
+	for _, s := range sources {
+		bySource[s.Name] = s
+	}
+	return &GenericWebhookHandler{
+		sources:      bySource,
+		processAlert: processAlert,
+		logger:       logger,
+	}
+}
+
+// SetMetrics enables per-source ingestion metrics.
+func (h *GenericWebhookHandler) SetMetrics(metrics *observability.Metrics) {
+	h.metrics = metrics
+}
+
+// SetAlertNames sets the map used to rename alerts to canonical names.
+func (h *GenericWebhookHandler) SetAlertNames(names dto.AlertNameMap) {
+	h.names = names
+}
+
+// ServeHTTP handles POST /webhook/generic/{name}
+func (h *GenericWebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	source, ok := h.sources[r.PathValue("name")]
+	if !ok {
+		http.Error(w, "unknown source", http.StatusNotFound)
+		return
+	}
+
+	if source.Token != "" {
+		provided, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(provided), []byte(source.Token)) != 1 {
+			h.logger.Warn("invalid generic webhook credentials",
+				"source", source.Name,
+				"remote_addr", r.RemoteAddr,
+			)
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+	}
+
+	receivedAt := time.Now()
+	ctx := r.Context()
+	metricSource := sourceGenericPrefix + source.Name
+
+	dec := json.NewDecoder(r.Body)
+	dec.UseNumber()
+	var payload any
+	if err := dec.Decode(&payload); err != nil {
+		h.logger.Error("failed to decode generic payload",
+			"source", source.Name,
+			"error", err,
+		)
+		h.recordParseFailure(r, metricSource)
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	inputs, err := source.Mapping.Map(payload)
+	if err != nil {
+		h.logger.Error("failed to map generic payload",
+			"source", source.Name,
+			"error", err,
+		)
+		h.recordParseFailure(r, metricSource)
+		http.Error(w, "payload does not match mapping: "+err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	if h.metrics != nil {
+		h.metrics.RecordWebhookPayload(ctx, metricSource, len(inputs), 0)
+	}
+
+	batch := alertBatch{
+		source:       metricSource,
+		processAlert: h.processAlert,
+		logger:       h.logger,
+		metrics:      h.metrics,
+		fingerprint:  source.Fingerprint,
+		names:        h.names,
+	}
+	processed, failed := batch.process(ctx, receivedAt, inputs, dto.ProcessAlertGroupInput{})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]any{
+		"status":    "ok",
+		"processed": processed,
+		"failed":    failed,
+	})
+}
+
+func (h *GenericWebhookHandler) recordParseFailure(r *http.Request, source string) {
+	if h.metrics != nil {
+		h.metrics.RecordWebhookParseFailure(r.Context(), source)
+	}
+}
@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/adapter/dto"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/observability"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
+)
+
+// sourceGrafana labels ingestion metrics recorded by this handler.
+const sourceGrafana = "grafana"
+
+// GrafanaHandler handles Grafana unified alerting webhook requests.
+type GrafanaHandler struct {
+	processAlert *alert.ProcessAlertUseCase
+	logger       alert.Logger
+	metrics      *observability.Metrics
+	fingerprint  dto.FingerprintStrategy
+	names        dto.AlertNameMap
+	severities   *dto.SeverityMap
+}
+
+// NewGrafanaHandler creates a new handler.
+func NewGrafanaHandler(processAlert *alert.ProcessAlertUseCase, logger alert.Logger) *GrafanaHandler {
+	return &GrafanaHandler{
+		processAlert: processAlert,
+		logger:       logger,
+	}
+}
+
+// SetMetrics enables per-source ingestion metrics.
+func (h *GrafanaHandler) SetMetrics(metrics *observability.Metrics) {
+	h.metrics = metrics
+}
+
+// SetFingerprintStrategy overrides how alert fingerprints are computed.
+func (h *GrafanaHandler) SetFingerprintStrategy(strategy dto.FingerprintStrategy) {
+	h.fingerprint = strategy
+}
+
+// SetAlertNames sets the map used to rename alerts to canonical names.
+func (h *GrafanaHandler) SetAlertNames(names dto.AlertNameMap) {
+	h.names = names
+}
+
+// SetSeverityMap sets the configured mapping of severity label values.
+func (h *GrafanaHandler) SetSeverityMap(severities *dto.SeverityMap) {
+	h.severities = severities
+}
+
+// ServeHTTP handles POST /webhook/grafana
+func (h *GrafanaHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	receivedAt := time.Now()
+	ctx := r.Context()
+
+	var payload dto.GrafanaWebhook
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		h.logger.Error("failed to decode grafana payload",
+			"error", err,
+		)
+		if h.metrics != nil {
+			h.metrics.RecordWebhookParseFailure(ctx, sourceGrafana)
+		}
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	if h.metrics != nil {
+		h.metrics.RecordWebhookPayload(ctx, sourceGrafana, len(payload.Alerts), payload.TruncatedAlerts)
+	}
+
+	inputs := make([]dto.ProcessAlertInput, 0, len(payload.Alerts))
+	for _, alertData := range payload.Alerts {
+		inputs = append(inputs, payload.ToProcessAlertInput(alertData))
+	}
+
+	batch := alertBatch{
+		source:       sourceGrafana,
+		processAlert: h.processAlert,
+		logger:       h.logger,
+		metrics:      h.metrics,
+		fingerprint:  h.fingerprint,
+		names:        h.names,
+		severities:   h.severities,
+	}
+	processed, failed := batch.process(ctx, receivedAt, inputs, dto.ProcessAlertGroupInput{
+		GroupKey:        payload.GroupKey,
+		Status:          payload.Status,
+		TruncatedAlerts: payload.TruncatedAlerts,
+	})
+
+	// Return success response
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]any{
+		"status":    "ok",
+		"processed": processed,
+		"failed":    failed,
+		"truncated": payload.TruncatedAlerts,
+	})
+}
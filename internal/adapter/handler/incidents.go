@@ -0,0 +1,175 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/adapter/dto"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/firehydrant"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/incidentio"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/incident"
+)
+
+// IncidentsHandler links alerts to incidents of incident management tools
+// and receives the tools' webhooks, acknowledging and resolving the linked
+// alerts as their incidents progress.
+type IncidentsHandler struct {
+	sync   *incident.SyncUseCase
+	logger alert.Logger
+}
+
+// NewIncidentsHandler creates a new handler.
+func NewIncidentsHandler(sync *incident.SyncUseCase, logger alert.Logger) *IncidentsHandler {
+	return &IncidentsHandler{sync: sync, logger: logger}
+}
+
+// incidentLinkRequest is the body of PUT /api/v1/alerts/{id}/incidents/{tool}.
+type incidentLinkRequest struct {
+	IncidentID string `json:"incident_id"`
+	User       string `json:"user"`
+}
+
+// incidentUpdateResponse is the response body of the tools' webhooks.
+type incidentUpdateResponse struct {
+	Status string   `json:"status"`
+	Alerts []string `json:"alerts"`
+}
+
+// Link handles PUT /api/v1/alerts/{id}/incidents/{tool}, linking the alert
+// to an incident of the tool so that its events are posted to the
+// incident's timeline.
+func (h *IncidentsHandler) Link(w http.ResponseWriter, r *http.Request) {
+	var req incidentLinkRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxAPIBodySize)).Decode(&req); err != nil {
+		writeAPIError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+
+	a, err := h.sync.Link(r.Context(), incident.LinkInput{
+		AlertID:    r.PathValue("id"),
+		Tool:       r.PathValue("tool"),
+		IncidentID: strings.TrimSpace(req.IncidentID),
+		By:         strings.TrimSpace(req.User),
+	})
+	if err != nil {
+		h.writeLinkError(w, "linking alert to incident", err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewAlertResponse(a))
+}
+
+// Unlink handles DELETE /api/v1/alerts/{id}/incidents/{tool}?user=.
+func (h *IncidentsHandler) Unlink(w http.ResponseWriter, r *http.Request) {
+	a, err := h.sync.Unlink(r.Context(), r.PathValue("id"), r.PathValue("tool"), r.URL.Query().Get("user"))
+	if err != nil {
+		h.writeLinkError(w, "unlinking alert from incident", err)
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewAlertResponse(a))
+}
+
+// writeLinkError maps use case errors to status codes.
+func (h *IncidentsHandler) writeLinkError(w http.ResponseWriter, action string, err error) {
+	switch {
+	case errors.Is(err, incident.ErrUnknownTool), entity.IsNotFound(err):
+		writeAPIError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, incident.ErrMissingIncidentID):
+		writeAPIError(w, http.StatusBadRequest, err.Error())
+	default:
+		h.logger.Error(action, "error", err)
+		writeAPIError(w, http.StatusInternalServerError, "internal server error")
+	}
+}
+
+// FireHydrantWebhook handles POST /webhook/firehydrant. Incidents reaching
+// a working milestone acknowledge their linked alerts; resolved and later
+// milestones resolve them.
+func (h *IncidentsHandler) FireHydrantWebhook(w http.ResponseWriter, r *http.Request) {
+	var payload dto.FireHydrantWebhook
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		h.logger.Error("failed to decode firehydrant payload", "error", err)
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+	if payload.Data.Incident == nil {
+		writeJSON(w, http.StatusOK, incidentUpdateResponse{Status: "ignored", Alerts: []string{}})
+		return
+	}
+
+	milestone := payload.Data.Incident.CurrentMilestone
+	h.handleUpdate(w, r, incident.UpdateInput{
+		Tool:       firehydrant.Name,
+		IncidentID: payload.Data.Incident.ID,
+		Action:     fireHydrantAction(milestone),
+		Status:     milestone,
+	})
+}
+
+// IncidentIOWebhook handles POST /webhook/incidentio. Live incidents
+// acknowledge their linked alerts; incidents in learning or closed resolve
+// them.
+func (h *IncidentsHandler) IncidentIOWebhook(w http.ResponseWriter, r *http.Request) {
+	var payload dto.IncidentIOWebhook
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		h.logger.Error("failed to decode incidentio payload", "error", err)
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+	if payload.Incident == nil {
+		h.logger.Debug("ignoring incidentio webhook", "eventType", payload.EventType)
+		writeJSON(w, http.StatusOK, incidentUpdateResponse{Status: "ignored", Alerts: []string{}})
+		return
+	}
+
+	category := payload.Incident.IncidentStatus.Category
+	h.handleUpdate(w, r, incident.UpdateInput{
+		Tool:       incidentio.Name,
+		IncidentID: payload.Incident.ID,
+		Action:     incidentIOAction(category),
+		Status:     category,
+	})
+}
+
+// handleUpdate applies an incident update and writes the response.
+func (h *IncidentsHandler) handleUpdate(w http.ResponseWriter, r *http.Request, input incident.UpdateInput) {
+	output, err := h.sync.HandleUpdate(r.Context(), input)
+	if err != nil {
+		h.logger.Error("failed to handle incident update",
+			"tool", input.Tool,
+			"incidentID", input.IncidentID,
+			"error", err,
+		)
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, http.StatusOK, incidentUpdateResponse{Status: "ok", Alerts: output.AlertIDs})
+}
+
+// fireHydrantAction maps a FireHydrant milestone to what it does to linked
+// alerts.
+func fireHydrantAction(milestone string) incident.Action {
+	switch milestone {
+	case "acknowledged", "investigating", "identified", "mitigated":
+		return incident.ActionAcknowledge
+	case "resolved", "retrospective_started", "retrospective_completed", "postmortem_started", "postmortem_completed", "closed":
+		return incident.ActionResolve
+	}
+	return incident.ActionNone
+}
+
+// incidentIOAction maps an incident.io status category to what it does to
+// linked alerts. Triage, declined, merged and canceled incidents change
+// nothing.
+func incidentIOAction(category string) incident.Action {
+	switch category {
+	case "live":
+		return incident.ActionAcknowledge
+	case "learning", "closed":
+		return incident.ActionResolve
+	}
+	return incident.ActionNone
+}
@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/adapter/dto"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/incident"
+)
+
+func TestIncidentToolActions(t *testing.T) {
+	fireHydrant := map[string]incident.Action{
+		"started":       incident.ActionNone,
+		"investigating": incident.ActionAcknowledge,
+		"mitigated":     incident.ActionAcknowledge,
+		"resolved":      incident.ActionResolve,
+		"closed":        incident.ActionResolve,
+	}
+	for milestone, want := range fireHydrant {
+		if got := fireHydrantAction(milestone); got != want {
+			t.Errorf("fireHydrantAction(%q) = %q, want %q", milestone, got, want)
+		}
+	}
+
+	incidentIO := map[string]incident.Action{
+		"triage":   incident.ActionNone,
+		"declined": incident.ActionNone,
+		"live":     incident.ActionAcknowledge,
+		"learning": incident.ActionResolve,
+		"closed":   incident.ActionResolve,
+	}
+	for category, want := range incidentIO {
+		if got := incidentIOAction(category); got != want {
+			t.Errorf("incidentIOAction(%q) = %q, want %q", category, got, want)
+		}
+	}
+}
+
+func TestIncidentIOWebhookDecode(t *testing.T) {
+	var payload dto.IncidentIOWebhook
+	body := `{
+		"event_type": "public_incident.incident_updated_v2",
+		"public_incident.incident_updated_v2": {
+			"id": "01HX",
+			"name": "Checkout down",
+			"incident_status": {"name": "Fixing", "category": "live"}
+		}
+	}`
+	if err := json.Unmarshal([]byte(body), &payload); err != nil {
+		t.Fatal(err)
+	}
+	if payload.Incident == nil || payload.Incident.ID != "01HX" || payload.Incident.IncidentStatus.Category != "live" {
+		t.Errorf("incident = %+v", payload.Incident)
+	}
+
+	// Other events carry no incident
+	if err := json.Unmarshal([]byte(`{"event_type": "public_incident.follow_up_created_v1"}`), &payload); err != nil {
+		t.Fatal(err)
+	}
+	if payload.Incident != nil {
+		t.Errorf("incident = %+v, want nil", payload.Incident)
+	}
+}
@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"hash/fnv"
+	"sync"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/observability"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
+)
+
+// Errors of IngestQueue.Enqueue.
+var (
+	errIngestQueueFull    = errors.New("webhook processing queue full")
+	errIngestQueueStopped = errors.New("webhook processing queue not running")
+)
+
+// IngestQueue processes webhook payloads in the background on a fixed pool
+// of workers, so senders are answered before slow notifier calls are made.
+// Payloads with the same key, such as an Alertmanager group key, go to the
+// same worker and are processed in the order they were accepted.
+type IngestQueue struct {
+	queueSize int
+	logger    alert.Logger
+	metrics   *observability.Metrics
+
+	mu      sync.RWMutex
+	running bool
+	workers []chan ingestJob
+	wg      sync.WaitGroup
+}
+
+type ingestJob struct {
+	ctx     context.Context
+	source  string
+	process func(ctx context.Context)
+}
+
+// NewIngestQueue creates a queue of workers workers, each holding up to
+// queueSize waiting payloads. metrics may be nil.
+func NewIngestQueue(workers, queueSize int, logger alert.Logger, metrics *observability.Metrics) *IngestQueue {
+	q := &IngestQueue{
+		queueSize: queueSize,
+		logger:    logger,
+		metrics:   metrics,
+		workers:   make([]chan ingestJob, workers),
+	}
+	for i := range q.workers {
+		q.workers[i] = make(chan ingestJob, queueSize)
+	}
+	return q
+}
+
+// Run starts the workers and blocks until ctx is done. Payloads already
+// accepted are still processed; Wait blocks until they are.
+func (q *IngestQueue) Run(ctx context.Context) {
+	q.mu.Lock()
+	for _, jobs := range q.workers {
+		q.wg.Add(1)
+		go q.work(jobs)
+	}
+	q.running = true
+	q.mu.Unlock()
+
+	<-ctx.Done()
+
+	q.mu.Lock()
+	q.running = false
+	for _, jobs := range q.workers {
+		close(jobs)
+	}
+	q.mu.Unlock()
+}
+
+// Wait blocks until the payloads accepted before shutdown are processed,
+// or ctx is done.
+func (q *IngestQueue) Wait(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		q.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (q *IngestQueue) work(jobs <-chan ingestJob) {
+	defer q.wg.Done()
+	for job := range jobs {
+		if q.metrics != nil {
+			q.metrics.RecordWebhookQueued(job.ctx, job.source, -1)
+		}
+		job.process(job.ctx)
+	}
+}
+
+// Enqueue accepts a payload of source for the worker of key. process runs
+// with ctx, without its cancellation, since the request has ended by then.
+// Fails with errIngestQueueFull if the worker's queue is full, and with
+// errIngestQueueStopped before Run and after shutdown.
+func (q *IngestQueue) Enqueue(ctx context.Context, source, key string, process func(ctx context.Context)) error {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	if !q.running {
+		return errIngestQueueStopped
+	}
+
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	jobs := q.workers[h.Sum32()%uint32(len(q.workers))]
+
+	if q.metrics != nil {
+		q.metrics.RecordWebhookQueued(ctx, source, 1)
+	}
+	select {
+	case jobs <- ingestJob{ctx: context.WithoutCancel(ctx), source: source, process: process}:
+		return nil
+	default:
+		if q.metrics != nil {
+			q.metrics.RecordWebhookQueued(ctx, source, -1)
+			q.metrics.RecordWebhookQueueRejected(ctx, source)
+		}
+		q.logger.Warn("webhook processing queue full",
+			"source", source,
+			"queueSize", q.queueSize,
+		)
+		return errIngestQueueFull
+	}
+}
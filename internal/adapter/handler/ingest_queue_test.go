@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/memory"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
+)
+
+func TestAlertmanagerHandler_Queue(t *testing.T) {
+	alerts := memory.NewAlertRepository()
+	processAlert := alert.NewProcessAlertUseCase(alerts, memory.NewSilenceRepository(), nil, nopLogger{}, nil)
+	h := NewAlertmanagerHandler(processAlert, nopLogger{})
+	queue := NewIngestQueue(1, 1, nopLogger{}, nil)
+	h.SetQueue(queue)
+
+	post := func(fingerprint string) *httptest.ResponseRecorder {
+		body := `{"version":"4","status":"firing","groupKey":"{}:{alertname=\"HighCPU\"}","alerts":[` +
+			`{"status":"firing","labels":{"alertname":"HighCPU","instance":"web-1"},"fingerprint":"` + fingerprint + `","startsAt":"2026-01-05T09:00:00Z"}]}`
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/webhook/alertmanager", strings.NewReader(body)))
+		return rec
+	}
+	stored := func(fingerprint string) bool {
+		found, err := alerts.FindByFingerprint(context.Background(), fingerprint)
+		return err == nil && len(found) == 1
+	}
+
+	// Processed in the request until the queue runs
+	if rec := post("fp-1"); rec.Code != http.StatusOK || !stored("fp-1") {
+		t.Fatalf("status = %d before the queue runs, want 200 and the alert stored", rec.Code)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	go func() {
+		queue.Run(ctx)
+		close(stopped)
+	}()
+
+	// Occupy the only worker and fill its queue
+	release := make(chan struct{})
+	started := make(chan struct{})
+	for queue.Enqueue(ctx, sourceAlertmanager, "", func(context.Context) { close(started); <-release }) != nil {
+		time.Sleep(time.Millisecond)
+	}
+	<-started
+	if err := queue.Enqueue(ctx, sourceAlertmanager, "", func(context.Context) {}); err != nil {
+		t.Fatalf("Enqueue() = %v, want room for one payload", err)
+	}
+
+	rec := post("fp-2")
+	if rec.Code != http.StatusServiceUnavailable || rec.Header().Get("Retry-After") == "" {
+		t.Errorf("status = %d with a full queue, want 503 with Retry-After", rec.Code)
+	}
+
+	close(release)
+	rec = post("fp-3")
+	for rec.Code == http.StatusServiceUnavailable {
+		time.Sleep(time.Millisecond)
+		rec = post("fp-3")
+	}
+	if rec.Code != http.StatusAccepted || !strings.Contains(rec.Body.String(), `"queued":1`) {
+		t.Errorf("response = %d %s, want 202 with the queued count", rec.Code, rec.Body)
+	}
+
+	// Accepted payloads are processed before shutdown completes
+	cancel()
+	<-stopped
+	if err := queue.Wait(context.Background()); err != nil {
+		t.Fatalf("Wait() = %v", err)
+	}
+	if !stored("fp-3") || stored("fp-2") {
+		t.Errorf("stored fp-3 = %v, fp-2 = %v; want only the accepted payload processed", stored("fp-3"), stored("fp-2"))
+	}
+	if err := queue.Enqueue(ctx, sourceAlertmanager, "", func(context.Context) {}); err != errIngestQueueStopped {
+		t.Errorf("Enqueue() after shutdown = %v, want errIngestQueueStopped", err)
+	}
+}
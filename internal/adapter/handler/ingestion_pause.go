@@ -0,0 +1,199 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/observability"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
+)
+
+// Ways a paused source's webhooks are turned away.
+const (
+	// PauseReject answers 503 so the sender retries later.
+	PauseReject = "reject"
+
+	// PauseDrop answers 200 and discards the payload, for senders that
+	// would otherwise queue and replay the bogus alerts.
+	PauseDrop = "drop"
+)
+
+// sourceGeneric pauses every generic webhook source at once.
+const sourceGeneric = "generic"
+
+// IngestionPause is a paused source.
+type IngestionPause struct {
+	Source   string    `json:"source"`
+	Mode     string    `json:"mode"`
+	Reason   string    `json:"reason,omitempty"`
+	PausedAt time.Time `json:"paused_at"`
+}
+
+// IngestionPauseHandler pauses and resumes ingestion per source, such as
+// while an upstream misconfiguration sends thousands of bogus alerts, and
+// gates the webhook endpoints accordingly. Pauses are kept in memory and
+// end on restart.
+type IngestionPauseHandler struct {
+	mu      sync.RWMutex
+	paused  map[string]IngestionPause
+	logger  alert.Logger
+	metrics *observability.Metrics
+}
+
+// NewIngestionPauseHandler creates a handler with no source paused.
+func NewIngestionPauseHandler(logger alert.Logger) *IngestionPauseHandler {
+	return &IngestionPauseHandler{
+		paused: make(map[string]IngestionPause),
+		logger: logger,
+	}
+}
+
+// SetMetrics enables counting of turned away requests.
+func (h *IngestionPauseHandler) SetMetrics(metrics *observability.Metrics) {
+	h.metrics = metrics
+}
+
+// Gate wraps the webhook endpoint of source so that its requests are turned
+// away while the source is paused. For generic webhooks, source is
+// "generic" and the source's own name is taken from the {name} path value,
+// so either can be paused.
+func (h *IngestionPauseHandler) Gate(source string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pause, ok := h.lookup(source, r)
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if h.metrics != nil {
+			h.metrics.RecordWebhookPaused(r.Context(), pause.Source, pause.Mode)
+		}
+		if pause.Mode == PauseDrop {
+			writeJSON(w, http.StatusOK, map[string]any{
+				"status": "dropped",
+				"source": pause.Source,
+			})
+			return
+		}
+		w.Header().Set("Retry-After", "60")
+		writeAPIError(w, http.StatusServiceUnavailable, "ingestion from "+pause.Source+" is paused")
+	})
+}
+
+// lookup returns the pause that applies to a request of source.
+func (h *IngestionPauseHandler) lookup(source string, r *http.Request) (IngestionPause, bool) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	if len(h.paused) == 0 {
+		return IngestionPause{}, false
+	}
+	if pause, ok := h.paused[source]; ok {
+		return pause, true
+	}
+	if source == sourceGeneric {
+		pause, ok := h.paused[sourceGenericPrefix+r.PathValue("name")]
+		return pause, ok
+	}
+	return IngestionPause{}, false
+}
+
+// pauseRequest is the body of POST /-/ingestion/{source}/pause.
+type pauseRequest struct {
+	Mode   string `json:"mode"`
+	Reason string `json:"reason"`
+}
+
+// ingestionPausesResponse is the response body for GET /-/ingestion.
+type ingestionPausesResponse struct {
+	Paused []IngestionPause `json:"paused"`
+}
+
+// List handles GET /-/ingestion, listing the paused sources.
+func (h *IngestionPauseHandler) List(w http.ResponseWriter, r *http.Request) {
+	h.mu.RLock()
+	resp := ingestionPausesResponse{Paused: make([]IngestionPause, 0, len(h.paused))}
+	for _, pause := range h.paused {
+		resp.Paused = append(resp.Paused, pause)
+	}
+	h.mu.RUnlock()
+
+	sort.Slice(resp.Paused, func(i, j int) bool { return resp.Paused[i].Source < resp.Paused[j].Source })
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// Pause handles POST /-/ingestion/{source}/pause. The mode defaults to
+// reject. Pausing a paused source changes its mode and reason.
+func (h *IngestionPauseHandler) Pause(w http.ResponseWriter, r *http.Request) {
+	source := r.PathValue("source")
+	if !isIngestionSource(source) {
+		writeAPIError(w, http.StatusBadRequest, "unknown source "+source)
+		return
+	}
+
+	var req pauseRequest
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxAPIBodySize)).Decode(&req); err != nil {
+			writeAPIError(w, http.StatusBadRequest, "invalid JSON body")
+			return
+		}
+	}
+	switch req.Mode {
+	case "":
+		req.Mode = PauseReject
+	case PauseReject, PauseDrop:
+	default:
+		writeAPIError(w, http.StatusBadRequest, "mode must be reject or drop")
+		return
+	}
+
+	pause := IngestionPause{
+		Source:   source,
+		Mode:     req.Mode,
+		Reason:   req.Reason,
+		PausedAt: time.Now().UTC(),
+	}
+	h.mu.Lock()
+	h.paused[source] = pause
+	h.mu.Unlock()
+
+	h.logger.Warn("ingestion paused",
+		"source", source,
+		"mode", pause.Mode,
+		"reason", pause.Reason,
+	)
+	writeJSON(w, http.StatusOK, pause)
+}
+
+// Resume handles POST /-/ingestion/{source}/resume, answering 404 if the
+// source is not paused.
+func (h *IngestionPauseHandler) Resume(w http.ResponseWriter, r *http.Request) {
+	source := r.PathValue("source")
+
+	h.mu.Lock()
+	_, ok := h.paused[source]
+	delete(h.paused, source)
+	h.mu.Unlock()
+
+	if !ok {
+		writeAPIError(w, http.StatusNotFound, "ingestion from "+source+" is not paused")
+		return
+	}
+	h.logger.Info("ingestion resumed", "source", source)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// isIngestionSource reports whether source names a webhook source: one of
+// the built-in ones, "generic" for all generic webhooks, or
+// "generic:<name>" for one of them.
+func isIngestionSource(source string) bool {
+	switch source {
+	case sourceAlertmanager, sourceGrafana, sourceCloudWatch, sourceSentry, sourceBatch, sourceCloudEvents, sourceGeneric:
+		return true
+	}
+	name, ok := strings.CutPrefix(source, sourceGenericPrefix)
+	return ok && name != ""
+}
@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...any) {}
+func (nopLogger) Info(string, ...any)  {}
+func (nopLogger) Warn(string, ...any)  {}
+func (nopLogger) Error(string, ...any) {}
+
+func TestIngestionPauseHandler(t *testing.T) {
+	h := NewIngestionPauseHandler(nopLogger{})
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /-/ingestion/{source}/pause", h.Pause)
+	mux.HandleFunc("POST /-/ingestion/{source}/resume", h.Resume)
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusAccepted) })
+	mux.Handle("/webhook/grafana", h.Gate(sourceGrafana, ok))
+	mux.Handle("/webhook/alertmanager", h.Gate(sourceAlertmanager, ok))
+	mux.Handle("/webhook/generic/{name}", h.Gate(sourceGeneric, ok))
+
+	do := func(path, body string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, path, strings.NewReader(body)))
+		return rec
+	}
+
+	if rec := do("/-/ingestion/grafana/pause", `{"reason": "bogus rule"}`); rec.Code != http.StatusOK {
+		t.Fatalf("pause status = %d, body %s", rec.Code, rec.Body)
+	}
+	rec := do("/webhook/grafana", "{}")
+	if rec.Code != http.StatusServiceUnavailable || rec.Header().Get("Retry-After") == "" {
+		t.Errorf("paused webhook status = %d, want 503 with Retry-After", rec.Code)
+	}
+	if rec := do("/webhook/alertmanager", "{}"); rec.Code != http.StatusAccepted {
+		t.Errorf("other source status = %d, want 202", rec.Code)
+	}
+
+	// Dropped requests are answered as accepted
+	do("/-/ingestion/generic:jenkins/pause", `{"mode": "drop"}`)
+	if rec := do("/webhook/generic/jenkins", "{}"); rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), "dropped") {
+		t.Errorf("dropped webhook = %d %s, want 200 dropped", rec.Code, rec.Body)
+	}
+	if rec := do("/webhook/generic/ci", "{}"); rec.Code != http.StatusAccepted {
+		t.Errorf("other generic source status = %d, want 202", rec.Code)
+	}
+
+	// Pausing "generic" pauses every generic source
+	do("/-/ingestion/generic/pause", "")
+	if rec := do("/webhook/generic/ci", "{}"); rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("generic source status = %d, want 503", rec.Code)
+	}
+
+	if rec := do("/-/ingestion/grafana/resume", ""); rec.Code != http.StatusNoContent {
+		t.Errorf("resume status = %d, want 204", rec.Code)
+	}
+	if rec := do("/webhook/grafana", "{}"); rec.Code != http.StatusAccepted {
+		t.Errorf("resumed webhook status = %d, want 202", rec.Code)
+	}
+	if rec := do("/-/ingestion/grafana/resume", ""); rec.Code != http.StatusNotFound {
+		t.Errorf("resume of unpaused source status = %d, want 404", rec.Code)
+	}
+
+	for _, body := range []string{`{"mode": "slow"}`, `not json`} {
+		if rec := do("/-/ingestion/grafana/pause", body); rec.Code != http.StatusBadRequest {
+			t.Errorf("pause with %s status = %d, want 400", body, rec.Code)
+		}
+	}
+	if rec := do("/-/ingestion/pagerduty/pause", ""); rec.Code != http.StatusBadRequest {
+		t.Errorf("pause of unknown source status = %d, want 400", rec.Code)
+	}
+}
@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/adapter/dto"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/api"
+)
+
+// IntegrationsAPIHandler serves the /api/v1/integrations REST endpoint.
+type IntegrationsAPIHandler struct {
+	listIntegrations *api.ListIntegrationsUseCase
+}
+
+// NewIntegrationsAPIHandler creates a new integrations API handler.
+func NewIntegrationsAPIHandler(listIntegrations *api.ListIntegrationsUseCase) *IntegrationsAPIHandler {
+	return &IntegrationsAPIHandler{listIntegrations: listIntegrations}
+}
+
+// integrationListResponse is the response body for GET /api/v1/integrations.
+type integrationListResponse struct {
+	Count        int                       `json:"count"`
+	Integrations []dto.IntegrationResponse `json:"integrations"`
+}
+
+// List handles GET /api/v1/integrations.
+func (h *IntegrationsAPIHandler) List(w http.ResponseWriter, r *http.Request) {
+	integrations := h.listIntegrations.List()
+
+	resp := integrationListResponse{
+		Count:        len(integrations),
+		Integrations: make([]dto.IntegrationResponse, 0, len(integrations)),
+	}
+	for _, i := range integrations {
+		resp.Integrations = append(resp.Integrations, dto.NewIntegrationResponse(i.Name, string(i.Kind), i.Capabilities))
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
@@ -2,8 +2,6 @@ package handler
 
 import (
 	"net/http"
-
-	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 // MetricsHandler serves Prometheus metrics.
@@ -11,10 +9,11 @@ type MetricsHandler struct {
 	handler http.Handler
 }
 
-// NewMetricsHandler creates a new metrics handler.
-func NewMetricsHandler() *MetricsHandler {
+// NewMetricsHandler creates a new metrics handler that serves the
+// exposition handler from observability.Telemetry.
+func NewMetricsHandler(handler http.Handler) *MetricsHandler {
 	return &MetricsHandler{
-		handler: promhttp.Handler(),
+		handler: handler,
 	}
 }
 
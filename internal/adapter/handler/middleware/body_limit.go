@@ -0,0 +1,16 @@
+package middleware
+
+import "net/http"
+
+// LimitBody creates middleware that fails reads of request bodies larger
+// than maxBytes with *http.MaxBytesError, so that signature checks and
+// handlers that read a whole body cannot be made to buffer without bound.
+// Handlers report such reads as bad requests.
+func LimitBody(maxBytes int64) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			r.Body = http.MaxBytesReader(w, r.Body, maxBytes)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
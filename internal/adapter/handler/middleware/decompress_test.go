@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecompress(t *testing.T) {
+	payload := []byte(`{"alerts":[{"status":"firing"}]}`)
+
+	var gz bytes.Buffer
+	gw := gzip.NewWriter(&gz)
+	gw.Write(payload)
+	gw.Close()
+
+	var zl bytes.Buffer
+	zw := zlib.NewWriter(&zl)
+	zw.Write(payload)
+	zw.Close()
+
+	// echo returns the body the handler sees, or 400 if it cannot be read
+	echo := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			http.Error(w, "too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		assert.Empty(t, r.Header.Get("Content-Encoding"))
+		w.Write(body)
+	})
+	handler := Decompress(1024, slog.Default())(echo)
+
+	tests := []struct {
+		name       string
+		encoding   string
+		body       []byte
+		wantStatus int
+		wantBody   string
+	}{
+		{"plain", "", payload, http.StatusOK, string(payload)},
+		{"gzip", "gzip", gz.Bytes(), http.StatusOK, string(payload)},
+		{"x-gzip", "x-gzip", gz.Bytes(), http.StatusOK, string(payload)},
+		{"deflate", "deflate", zl.Bytes(), http.StatusOK, string(payload)},
+		{"corrupt gzip", "gzip", []byte("not gzip"), http.StatusBadRequest, ""},
+		{"unsupported", "br", payload, http.StatusUnsupportedMediaType, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/webhook/alertmanager", bytes.NewReader(tt.body))
+			if tt.encoding != "" {
+				req.Header.Set("Content-Encoding", tt.encoding)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			require.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantBody != "" {
+				assert.Equal(t, tt.wantBody, rec.Body.String())
+			}
+		})
+	}
+
+	t.Run("decompression limit", func(t *testing.T) {
+		var bomb bytes.Buffer
+		bw := gzip.NewWriter(&bomb)
+		bw.Write([]byte(strings.Repeat("a", 1<<18)))
+		bw.Close()
+		require.Less(t, bomb.Len(), 1024, "compressed body should fit the limit")
+
+		req := httptest.NewRequest(http.MethodPost, "/webhook/alertmanager", &bomb)
+		req.Header.Set("Content-Encoding", "gzip")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	})
+}
@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"log/slog"
+	"net/http"
+	"strings"
+)
+
+// GrafanaAuth creates middleware for Grafana webhook authentication.
+// If token is empty, authentication is skipped.
+//
+// Expected header format: Authorization: Bearer <token>
+func GrafanaAuth(token string, logger *slog.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if token == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			provided, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !ok || subtle.ConstantTimeCompare([]byte(provided), []byte(token)) != 1 {
+				logger.Warn("invalid grafana webhook credentials",
+					"remote_addr", r.RemoteAddr,
+					"path", r.URL.Path,
+				)
+				http.Error(w, "unauthorized", http.StatusUnauthorized)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package middleware
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFireHydrantAuth(t *testing.T) {
+	body := `{"data":{"incident":{"id":"fh-1"}}}`
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte(body))
+	valid := hex.EncodeToString(mac.Sum(nil))
+
+	h := FireHydrantAuth("secret", slog.New(slog.NewTextHandler(io.Discard, nil)))(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got, _ := io.ReadAll(r.Body)
+			assert.Equal(t, body, string(got))
+		}))
+
+	for signature, want := range map[string]int{valid: http.StatusOK, "": http.StatusUnauthorized, "bad": http.StatusUnauthorized} {
+		req := httptest.NewRequest(http.MethodPost, "/webhook/firehydrant", strings.NewReader(body))
+		req.Header.Set("fh-signature", signature)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		assert.Equal(t, want, rec.Code, "signature %q", signature)
+	}
+}
+
+func TestIncidentIOAuth(t *testing.T) {
+	key := []byte("incident-io-key")
+	secret := "whsec_" + base64.StdEncoding.EncodeToString(key)
+	body := `{"event_type":"public_incident.incident_updated_v2"}`
+
+	sign := func(id string, at time.Time) http.Header {
+		timestamp := strconv.FormatInt(at.Unix(), 10)
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte(id + "." + timestamp + "." + body))
+		header := http.Header{}
+		header.Set("webhook-id", id)
+		header.Set("webhook-timestamp", timestamp)
+		header.Set("webhook-signature", "v1,b2xk v1,"+base64.StdEncoding.EncodeToString(mac.Sum(nil)))
+		return header
+	}
+
+	h := IncidentIOAuth(secret, slog.New(slog.NewTextHandler(io.Discard, nil)))(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	tampered := sign("msg_1", time.Now())
+	tampered.Set("webhook-id", "msg_2")
+
+	tests := []struct {
+		name   string
+		header http.Header
+		want   int
+	}{
+		{"valid", sign("msg_1", time.Now()), http.StatusOK},
+		{"missing", http.Header{}, http.StatusUnauthorized},
+		{"expired", sign("msg_1", time.Now().Add(-time.Hour)), http.StatusUnauthorized},
+		{"tampered", tampered, http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/webhook/incidentio", strings.NewReader(body))
+			req.Header = tt.header
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			assert.Equal(t, tt.want, rec.Code)
+		})
+	}
+}
@@ -38,3 +38,23 @@ func Observability(metrics *observability.Metrics) func(http.Handler) http.Handl
 		})
 	}
 }
+
+// WebhookAuthFailures counts requests to a webhook endpoint that were
+// rejected with 401 or 403, whether by an auth middleware or by a handler
+// that verifies its own signatures or tokens.
+func WebhookAuthFailures(source string, metrics *observability.Metrics) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			rw := &responseWriter{
+				ResponseWriter: w,
+				statusCode:     http.StatusOK,
+			}
+
+			next.ServeHTTP(rw, r)
+
+			if rw.statusCode == http.StatusUnauthorized || rw.statusCode == http.StatusForbidden {
+				metrics.RecordWebhookAuthFailure(r.Context(), source)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log/slog"
+	"net/http"
+)
+
+// SentryAuth creates middleware for Sentry integration webhook verification.
+// Sentry signs the raw body with the integration's client secret:
+// https://docs.sentry.io/organization/integrations/integration-platform/webhooks/#verifying-the-signature
+//
+// Expected header format: Sentry-Hook-Signature: <hex HMAC-SHA256>
+func SentryAuth(clientSecret string, logger *slog.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				logger.Error("failed to read request body", "error", err)
+				http.Error(w, "failed to read body", http.StatusBadRequest)
+				return
+			}
+			r.Body.Close()
+
+			mac := hmac.New(sha256.New, []byte(clientSecret))
+			mac.Write(body)
+			expected := hex.EncodeToString(mac.Sum(nil))
+
+			signature := r.Header.Get("Sentry-Hook-Signature")
+			if signature == "" || !hmac.Equal([]byte(signature), []byte(expected)) {
+				logger.Warn("sentry webhook signature validation failed",
+					"remote_addr", r.RemoteAddr,
+					"missing", signature == "",
+				)
+				http.Error(w, "invalid signature", http.StatusUnauthorized)
+				return
+			}
+
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			next.ServeHTTP(w, r)
+		})
+	}
+}
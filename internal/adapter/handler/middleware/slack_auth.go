@@ -5,6 +5,7 @@ import (
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
@@ -13,10 +14,22 @@ import (
 	"time"
 )
 
+// SlackTimestampTolerance is how far a Slack request timestamp may be from
+// the local clock, per Slack's recommendation.
+const SlackTimestampTolerance = 5 * time.Minute
+
 // SlackAuth creates middleware for Slack webhook signature verification.
 // Implements the Slack signature verification protocol:
 // https://api.slack.com/authentication/verifying-requests-from-slack
-func SlackAuth(signingSecret string, logger *slog.Logger) func(http.Handler) http.Handler {
+//
+// tolerance returns the accepted timestamp distance for each request, so it
+// can be widened while the host clock is known to be skewed. Nil uses
+// SlackTimestampTolerance.
+func SlackAuth(signingSecret string, tolerance func() time.Duration, logger *slog.Logger) func(http.Handler) http.Handler {
+	if tolerance == nil {
+		tolerance = func() time.Duration { return SlackTimestampTolerance }
+	}
+
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			// Skip auth for GET requests (e.g., listing available commands)
@@ -43,8 +56,14 @@ func SlackAuth(signingSecret string, logger *slog.Logger) func(http.Handler) htt
 			r.Body.Close()
 
 			// Verify signature
-			if err := verifySlackSignature(r.Header, body, signingSecret); err != nil {
-				logger.Warn("invalid slack signature", "error", err)
+			if err := verifySlackSignature(r.Header, body, signingSecret, tolerance()); err != nil {
+				if errors.Is(err, errSlackTimestampSkew) {
+					// A valid signature with a stale timestamp usually means the
+					// host clock drifted; every Slack request will fail until fixed
+					logger.Error("slack request timestamp outside tolerance; check the host clock (NTP)", "error", err)
+				} else {
+					logger.Warn("invalid slack signature", "error", err)
+				}
 				http.Error(w, "invalid signature", http.StatusUnauthorized)
 				return
 			}
@@ -57,8 +76,14 @@ func SlackAuth(signingSecret string, logger *slog.Logger) func(http.Handler) htt
 	}
 }
 
-// verifySlackSignature verifies the Slack request signature.
-func verifySlackSignature(header http.Header, body []byte, signingSecret string) error {
+// errSlackTimestampSkew reports a correctly signed request whose timestamp
+// is too far from the local clock.
+var errSlackTimestampSkew = errors.New("timestamp outside tolerance")
+
+// verifySlackSignature verifies the Slack request signature. The signature
+// is checked before the timestamp, so that a timestamp error on a correctly
+// signed request points at the clock rather than at a forgery.
+func verifySlackSignature(header http.Header, body []byte, signingSecret string, tolerance time.Duration) error {
 	timestamp := header.Get("X-Slack-Request-Timestamp")
 	signature := header.Get("X-Slack-Signature")
 
@@ -66,17 +91,11 @@ func verifySlackSignature(header http.Header, body []byte, signingSecret string)
 		return fmt.Errorf("missing timestamp or signature headers")
 	}
 
-	// Check timestamp is recent (within 5 minutes)
 	ts, err := strconv.ParseInt(timestamp, 10, 64)
 	if err != nil {
 		return fmt.Errorf("invalid timestamp: %w", err)
 	}
 
-	now := time.Now().Unix()
-	if abs(now-ts) > 60*5 {
-		return fmt.Errorf("timestamp too old (request age: %d seconds)", abs(now-ts))
-	}
-
 	// Compute expected signature
 	// Format: v0:{timestamp}:{body}
 	sigBaseString := fmt.Sprintf("v0:%s:%s", timestamp, string(body))
@@ -89,6 +108,12 @@ func verifySlackSignature(header http.Header, body []byte, signingSecret string)
 		return fmt.Errorf("signature mismatch")
 	}
 
+	// Check timestamp is recent to prevent replays
+	age := time.Now().Unix() - ts
+	if time.Duration(abs(age))*time.Second > tolerance {
+		return fmt.Errorf("%w (request age: %d seconds, tolerance: %s)", errSlackTimestampSkew, age, tolerance)
+	}
+
 	return nil
 }
 
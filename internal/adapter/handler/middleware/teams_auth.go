@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"crypto/hmac"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/teams"
+)
+
+// TeamsAuth creates middleware that verifies signed Teams action links.
+// Links are generated by teams.ActionSigner and carry action, alert_id,
+// expires and sig parameters (query string for GET, form body for POST).
+func TeamsAuth(signingSecret string, logger *slog.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if err := r.ParseForm(); err != nil {
+				http.Error(w, "invalid request", http.StatusBadRequest)
+				return
+			}
+
+			if err := verifyTeamsSignature(r, signingSecret); err != nil {
+				logger.Warn("invalid teams action signature", "error", err)
+				http.Error(w, "invalid or expired link", http.StatusUnauthorized)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// verifyTeamsSignature checks the link signature and expiry.
+func verifyTeamsSignature(r *http.Request, signingSecret string) error {
+	action := r.Form.Get("action")
+	alertID := r.Form.Get("alert_id")
+	expires := r.Form.Get("expires")
+	signature := r.Form.Get("sig")
+
+	if action == "" || alertID == "" || expires == "" || signature == "" {
+		return fmt.Errorf("missing signature parameters")
+	}
+
+	exp, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid expires: %w", err)
+	}
+	if time.Now().Unix() > exp {
+		return fmt.Errorf("link expired")
+	}
+
+	expectedSig := teams.SignAction([]byte(signingSecret), action, alertID, expires)
+
+	// Constant-time comparison to prevent timing attacks
+	if !hmac.Equal([]byte(signature), []byte(expectedSig)) {
+		return fmt.Errorf("signature mismatch")
+	}
+
+	return nil
+}
@@ -9,12 +9,13 @@ import (
 
 // Timeout creates middleware that sets a timeout for request processing.
 // If the request exceeds the timeout, it returns 504 Gateway Timeout.
-// Excludes /metrics, /health, and /ready endpoints from timeout.
+// Excludes /metrics, /health, and /ready endpoints from timeout, as well as
+// /webhook/batch, whose duration follows the size of the streamed body.
 func Timeout(timeout time.Duration, logger *slog.Logger) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			// Skip timeout for observability and health endpoints
-			if r.URL.Path == "/metrics" || r.URL.Path == "/health" || r.URL.Path == "/ready" || r.URL.Path == "/" {
+			// Skip timeout for observability, health and streaming endpoints
+			if r.URL.Path == "/metrics" || r.URL.Path == "/health" || r.URL.Path == "/ready" || r.URL.Path == "/" || r.URL.Path == "/webhook/batch" {
 				next.ServeHTTP(w, r)
 				return
 			}
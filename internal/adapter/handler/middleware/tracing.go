@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/observability"
+)
+
+// Tracing starts a server span for each request, continuing the caller's
+// trace when a traceparent header is present. It must wrap the ServeMux
+// directly: the span is renamed to the method and matched route pattern
+// (e.g. "POST /api/v1/alerts/{id}/ack") after the mux has run.
+func Tracing() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+			ctx, span := observability.Tracer().Start(ctx, r.Method,
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(
+					attribute.String("http.request.method", r.Method),
+					attribute.String("url.path", r.URL.Path),
+					attribute.String("request.id", GetRequestID(r.Context())),
+				),
+			)
+			defer span.End()
+
+			rw := &responseWriter{
+				ResponseWriter: w,
+				statusCode:     http.StatusOK,
+			}
+			r = r.WithContext(ctx)
+			next.ServeHTTP(rw, r)
+
+			if r.Pattern != "" {
+				name := r.Pattern
+				if !strings.Contains(name, " ") {
+					name = r.Method + " " + name
+				}
+				span.SetName(name)
+				span.SetAttributes(attribute.String("http.route", r.Pattern))
+			}
+			span.SetAttributes(attribute.Int("http.response.status_code", rw.statusCode))
+			if rw.statusCode >= http.StatusInternalServerError {
+				span.SetStatus(codes.Error, http.StatusText(rw.statusCode))
+			}
+		})
+	}
+}
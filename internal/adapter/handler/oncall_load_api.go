@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/adapter/dto"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/logger"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/report"
+)
+
+// OnCallLoadAPIHandler serves the monthly on-call load report.
+type OnCallLoadAPIHandler struct {
+	onCallLoad *report.OnCallLoadUseCase
+	logger     logger.Logger
+}
+
+// NewOnCallLoadAPIHandler creates a new on-call load API handler.
+func NewOnCallLoadAPIHandler(onCallLoad *report.OnCallLoadUseCase, logger logger.Logger) *OnCallLoadAPIHandler {
+	return &OnCallLoadAPIHandler{
+		onCallLoad: onCallLoad,
+		logger:     logger,
+	}
+}
+
+// ServeHTTP handles GET /api/v1/reports/oncall?month=<YYYY-MM>.
+func (h *OnCallLoadAPIHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	month := r.URL.Query().Get("month")
+	result, err := h.onCallLoad.Build(r.Context(), month)
+	if errors.Is(err, report.ErrInvalidMonth) {
+		writeAPIError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err != nil {
+		h.logger.Error("building on-call load report", "month", month, "error", err)
+		writeAPIError(w, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dto.NewOnCallLoadReportResponse(result))
+}
@@ -6,15 +6,20 @@ import (
 	"net/http"
 
 	"github.com/altuslabsxyz/alert-bridge/internal/adapter/dto"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/observability"
 	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
 	pdUseCase "github.com/altuslabsxyz/alert-bridge/internal/usecase/pagerduty"
 )
 
+// sourcePagerDuty labels ingestion metrics recorded by this handler.
+const sourcePagerDuty = "pagerduty"
+
 // PagerDutyWebhookHandler handles PagerDuty V3 webhook events.
 // NOTE: Signature verification is handled by middleware.PagerDutyAuth middleware.
 type PagerDutyWebhookHandler struct {
 	handleWebhook *pdUseCase.HandleWebhookUseCase
 	logger        alert.Logger
+	metrics       *observability.Metrics
 }
 
 // NewPagerDutyWebhookHandler creates a new PagerDuty webhook handler.
@@ -28,6 +33,11 @@ func NewPagerDutyWebhookHandler(
 	}
 }
 
+// SetMetrics enables per-source ingestion metrics.
+func (h *PagerDutyWebhookHandler) SetMetrics(metrics *observability.Metrics) {
+	h.metrics = metrics
+}
+
 // ServeHTTP handles POST /webhook/pagerduty
 func (h *PagerDutyWebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
@@ -47,11 +57,25 @@ func (h *PagerDutyWebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 	var payload dto.PagerDutyWebhookV3
 	if err := json.Unmarshal(body, &payload); err != nil {
 		h.logger.Error("failed to parse PagerDuty webhook payload", "error", err)
+		if h.metrics != nil {
+			h.metrics.RecordWebhookParseFailure(r.Context(), sourcePagerDuty)
+		}
 		http.Error(w, "invalid payload", http.StatusBadRequest)
 		return
 	}
+	if err := payload.Validate(); err != nil {
+		h.logger.Warn("rejected PagerDuty webhook payload", "error", err)
+		if h.metrics != nil {
+			h.metrics.RecordWebhookParseFailure(r.Context(), sourcePagerDuty)
+		}
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
 
 	ctx := r.Context()
+	if h.metrics != nil {
+		h.metrics.RecordWebhookPayload(ctx, sourcePagerDuty, len(payload.Messages), 0)
+	}
 	var processed, skipped int
 
 	// Process each message
@@ -95,6 +119,13 @@ func (h *PagerDutyWebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 			input.UserName = acker.Summary
 		}
 
+		if len(event.Data.Assignees) > 0 {
+			assignee := event.Data.Assignees[0]
+			input.AssigneeID = assignee.ID
+			input.AssigneeEmail = assignee.Email
+			input.AssigneeName = assignee.Summary
+		}
+
 		// Execute use case
 		output, err := h.handleWebhook.Execute(ctx, input)
 		if err != nil {
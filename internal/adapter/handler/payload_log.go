@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/payloadlog"
+)
+
+// PayloadLogHandler serves recently recorded outbound notification payloads.
+type PayloadLogHandler struct {
+	recorder *payloadlog.Recorder
+}
+
+// NewPayloadLogHandler creates a new payload log handler.
+func NewPayloadLogHandler(recorder *payloadlog.Recorder) *PayloadLogHandler {
+	return &PayloadLogHandler{recorder: recorder}
+}
+
+// payloadLogResponse is the response body for GET /-/payloads.
+type payloadLogResponse struct {
+	Notifiers []string           `json:"notifiers"`
+	Entries   []payloadlog.Entry `json:"entries"`
+}
+
+// ServeHTTP handles GET /-/payloads?notifier=<name>&limit=<n>.
+func (h *PayloadLogHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	limit := 0
+	if v := r.URL.Query().Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			http.Error(w, "limit must be a non-negative integer", http.StatusBadRequest)
+			return
+		}
+		limit = n
+	}
+
+	resp := payloadLogResponse{
+		Notifiers: h.recorder.Notifiers(),
+		Entries:   h.recorder.Entries(r.URL.Query().Get("notifier"), limit),
+	}
+	if resp.Notifiers == nil {
+		resp.Notifiers = []string{}
+	}
+	if resp.Entries == nil {
+		resp.Entries = []payloadlog.Entry{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
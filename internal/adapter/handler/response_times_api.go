@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/adapter/dto"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/logger"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/report"
+)
+
+// defaultResponseTimesPeriod is the period reported when none is given.
+const defaultResponseTimesPeriod = 7 * 24 * time.Hour
+
+// ResponseTimesAPIHandler serves the MTTA/MTTR report.
+type ResponseTimesAPIHandler struct {
+	responseTimes *report.ResponseTimesUseCase
+	logger        logger.Logger
+}
+
+// NewResponseTimesAPIHandler creates a new response times API handler.
+func NewResponseTimesAPIHandler(responseTimes *report.ResponseTimesUseCase, logger logger.Logger) *ResponseTimesAPIHandler {
+	return &ResponseTimesAPIHandler{
+		responseTimes: responseTimes,
+		logger:        logger,
+	}
+}
+
+// ServeHTTP handles GET /api/v1/reports/response-times?period=<24h|7d|4w>.
+func (h *ResponseTimesAPIHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	period := defaultResponseTimesPeriod
+	if v := r.URL.Query().Get("period"); v != "" {
+		period = dto.ParsePeriod(v)
+	}
+
+	result, err := h.responseTimes.Build(r.Context(), period)
+	if errors.Is(err, report.ErrInvalidPeriod) {
+		writeAPIError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err != nil {
+		h.logger.Error("building response times report", "period", period, "error", err)
+		writeAPIError(w, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, dto.NewResponseTimeReportResponse(result))
+}
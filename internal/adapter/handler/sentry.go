@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/adapter/dto"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/observability"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
+)
+
+// sourceSentry labels ingestion metrics recorded by this handler.
+const sourceSentry = "sentry"
+
+// SentryHandler handles Sentry integration platform webhooks (issue and
+// issue alert resources).
+type SentryHandler struct {
+	processAlert *alert.ProcessAlertUseCase
+	logger       alert.Logger
+	metrics      *observability.Metrics
+	fingerprint  dto.FingerprintStrategy
+	names        dto.AlertNameMap
+}
+
+// NewSentryHandler creates a new handler.
+func NewSentryHandler(processAlert *alert.ProcessAlertUseCase, logger alert.Logger) *SentryHandler {
+	return &SentryHandler{
+		processAlert: processAlert,
+		logger:       logger,
+	}
+}
+
+// SetMetrics enables per-source ingestion metrics.
+func (h *SentryHandler) SetMetrics(metrics *observability.Metrics) {
+	h.metrics = metrics
+}
+
+// SetFingerprintStrategy overrides how alert fingerprints are computed.
+func (h *SentryHandler) SetFingerprintStrategy(strategy dto.FingerprintStrategy) {
+	h.fingerprint = strategy
+}
+
+// SetAlertNames sets the map used to rename alerts to canonical names.
+func (h *SentryHandler) SetAlertNames(names dto.AlertNameMap) {
+	h.names = names
+}
+
+// ServeHTTP handles POST /webhook/sentry
+func (h *SentryHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	receivedAt := time.Now()
+	ctx := r.Context()
+	resource := r.Header.Get("Sentry-Hook-Resource")
+
+	var payload dto.SentryWebhook
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		h.logger.Error("failed to decode sentry payload",
+			"resource", resource,
+			"error", err,
+		)
+		if h.metrics != nil {
+			h.metrics.RecordWebhookParseFailure(ctx, sourceSentry)
+		}
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	input, ok := payload.ToProcessAlertInput(resource)
+	if !ok {
+		// Installation events, assignments etc. are acknowledged and dropped
+		h.logger.Debug("ignoring sentry webhook",
+			"resource", resource,
+			"action", payload.Action,
+		)
+		writeSentryResponse(w, 0, 0)
+		return
+	}
+
+	if h.metrics != nil {
+		h.metrics.RecordWebhookPayload(ctx, sourceSentry, 1, 0)
+	}
+
+	h.names.Apply(&input)
+	h.fingerprint.Apply(&input)
+	input.ReceivedAt = receivedAt
+	input.Source = sourceSentry
+	output, err := h.processAlert.Execute(ctx, input)
+	if err != nil {
+		h.logger.Error("failed to process alert",
+			"source", sourceSentry,
+			"issueID", input.Labels["sentry_issue_id"],
+			"status", input.Status,
+			"error", err,
+		)
+		// Sentry does not retry, but a 5xx shows up in the integration's request log
+		http.Error(w, "failed to process alert", http.StatusInternalServerError)
+		return
+	}
+
+	if h.metrics != nil && len(output.NotificationsSent) > 0 {
+		h.metrics.RecordIngestToNotify(ctx, sourceSentry, time.Since(receivedAt))
+	}
+	h.logger.Info("alert processed",
+		"source", sourceSentry,
+		"alertID", output.AlertID,
+		"issueID", input.Labels["sentry_issue_id"],
+		"action", payload.Action,
+		"status", input.Status,
+		"isNew", output.IsNew,
+		"isSilenced", output.IsSilenced,
+		"notificationsSent", output.NotificationsSent,
+	)
+
+	writeSentryResponse(w, 1, 0)
+}
+
+func writeSentryResponse(w http.ResponseWriter, processed, failed int) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]any{
+		"status":    "ok",
+		"processed": processed,
+		"failed":    failed,
+	})
+}
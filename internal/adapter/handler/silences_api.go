@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/adapter/dto"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/logger"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/api"
+)
+
+// SilencesAPIHandler serves the /api/v1/silences REST endpoints.
+type SilencesAPIHandler struct {
+	manageSilences *api.ManageSilencesUseCase
+	logger         logger.Logger
+}
+
+// NewSilencesAPIHandler creates a new silences API handler.
+func NewSilencesAPIHandler(manageSilences *api.ManageSilencesUseCase, logger logger.Logger) *SilencesAPIHandler {
+	return &SilencesAPIHandler{
+		manageSilences: manageSilences,
+		logger:         logger,
+	}
+}
+
+// silenceListResponse is the response body for GET /api/v1/silences.
+type silenceListResponse struct {
+	Count    int                   `json:"count"`
+	Silences []dto.SilenceResponse `json:"silences"`
+}
+
+// Create handles POST /api/v1/silences.
+func (h *SilencesAPIHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var req dto.CreateSilenceRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxAPIBodySize)).Decode(&req); err != nil {
+		writeAPIError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+	matchers, duration, err := req.Validate()
+	if err != nil {
+		writeAPIError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	recurrence, err := req.ParseRecurrence()
+	if err != nil {
+		writeAPIError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	silence, err := h.manageSilences.Create(r.Context(), api.CreateSilenceInput{
+		Matchers:       matchers,
+		Duration:       duration,
+		Reason:         req.Reason,
+		CreatedBy:      req.CreatedBy,
+		CreatedByEmail: req.CreatedByEmail,
+		Recurrence:     recurrence,
+	})
+	if err != nil {
+		h.logger.Error("creating silence", "error", err)
+		writeAPIError(w, http.StatusInternalServerError, "internal server error")
+		return
+	}
+	writeJSON(w, http.StatusCreated, dto.NewSilenceResponse(silence))
+}
+
+// List handles GET /api/v1/silences. Only active silences are returned.
+func (h *SilencesAPIHandler) List(w http.ResponseWriter, r *http.Request) {
+	silences, err := h.manageSilences.List(r.Context())
+	if err != nil {
+		h.logger.Error("listing silences", "error", err)
+		writeAPIError(w, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	resp := silenceListResponse{
+		Count:    len(silences),
+		Silences: make([]dto.SilenceResponse, 0, len(silences)),
+	}
+	for _, s := range silences {
+		resp.Silences = append(resp.Silences, dto.NewSilenceResponse(s))
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// Delete handles DELETE /api/v1/silences/{id}.
+func (h *SilencesAPIHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	if err := h.manageSilences.Delete(r.Context(), r.PathValue("id")); err != nil {
+		if entity.IsNotFound(err) {
+			writeAPIError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		h.logger.Error("deleting silence", "error", err)
+		writeAPIError(w, http.StatusInternalServerError, "internal server error")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Restore handles POST /api/v1/silences/{id}/restore, bringing back a
+// deleted silence.
+func (h *SilencesAPIHandler) Restore(w http.ResponseWriter, r *http.Request) {
+	silence, err := h.manageSilences.Restore(r.Context(), r.PathValue("id"))
+	if err != nil {
+		if entity.IsNotFound(err) {
+			writeAPIError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		h.logger.Error("restoring silence", "error", err)
+		writeAPIError(w, http.StatusInternalServerError, "internal server error")
+		return
+	}
+	writeJSON(w, http.StatusOK, dto.NewSilenceResponse(silence))
+}
@@ -3,6 +3,7 @@ package handler
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -11,15 +12,23 @@ import (
 	"github.com/slack-go/slack"
 
 	"github.com/altuslabsxyz/alert-bridge/internal/adapter/dto"
+	"github.com/altuslabsxyz/alert-bridge/internal/adapter/presenter"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	slackInfra "github.com/altuslabsxyz/alert-bridge/internal/infrastructure/slack"
 	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
 	slackUseCase "github.com/altuslabsxyz/alert-bridge/internal/usecase/slack"
 )
 
+// sourceSlackInteraction labels queue metrics recorded for button clicks.
+const sourceSlackInteraction = "slack_interaction"
+
 // SlackInteractionHandler handles Slack interactive component callbacks.
 // NOTE: Signature verification is handled by middleware.SlackAuth middleware.
 type SlackInteractionHandler struct {
 	handleInteraction *slackUseCase.HandleInteractionUseCase
+	commands          *SlackCommandsHandler
 	logger            alert.Logger
+	queue             *IngestQueue
 }
 
 // NewSlackInteractionHandler creates a new Slack interaction handler.
@@ -33,6 +42,18 @@ func NewSlackInteractionHandler(
 	}
 }
 
+// SetCommandsHandler routes the /alerts pagination buttons to the slash
+// commands handler that rendered the results.
+func (h *SlackInteractionHandler) SetCommandsHandler(commands *SlackCommandsHandler) {
+	h.commands = commands
+}
+
+// SetQueue handles button clicks in the background, acknowledging them
+// once they are queued.
+func (h *SlackInteractionHandler) SetQueue(queue *IngestQueue) {
+	h.queue = queue
+}
+
 // ServeHTTP handles POST /webhook/slack/interaction
 func (h *SlackInteractionHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
@@ -60,25 +81,35 @@ func (h *SlackInteractionHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	ctx := r.Context()
+	response := h.HandleCallback(r.Context(), &payload)
+	if response == nil {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(response)
+}
 
+// HandleCallback handles an interaction received over HTTP or Socket Mode.
+// It returns the response to acknowledge it with, or nil for an empty
+// acknowledgment.
+func (h *SlackInteractionHandler) HandleCallback(ctx context.Context, payload *slack.InteractionCallback) any {
 	// Route based on interaction type
 	switch payload.Type {
 	case slack.InteractionTypeViewSubmission:
-		h.handleViewSubmission(ctx, w, &payload)
-		return
+		return h.handleViewSubmission(ctx, payload)
 	case slack.InteractionTypeBlockActions:
-		h.handleBlockActions(ctx, &payload)
+		h.dispatchBlockActions(ctx, payload)
 	default:
 		h.logger.Warn("unhandled interaction type", "type", payload.Type)
 	}
-
-	// Acknowledge the interaction immediately
-	w.WriteHeader(http.StatusOK)
+	return nil
 }
 
-// handleViewSubmission handles modal form submissions.
-func (h *SlackInteractionHandler) handleViewSubmission(ctx context.Context, w http.ResponseWriter, payload *slack.InteractionCallback) {
+// handleViewSubmission handles modal form submissions. It returns the
+// validation errors to show in the modal, or nil to close it.
+func (h *SlackInteractionHandler) handleViewSubmission(ctx context.Context, payload *slack.InteractionCallback) any {
 	callbackID := payload.View.CallbackID
 
 	h.logger.Info("handling view submission",
@@ -94,17 +125,21 @@ func (h *SlackInteractionHandler) handleViewSubmission(ctx context.Context, w ht
 			"error", err,
 		)
 
-		// Return validation error to Slack
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusOK)
-		errorResponse := map[string]interface{}{
+		// Return validation error to Slack, attached to the modal's main input
+		errorBlock := slackInfra.SilenceBlockDuration
+		if callbackID == slackInfra.TagModalCallbackID {
+			errorBlock = slackInfra.TagBlockTags
+		} else if callbackID == slackInfra.ResolveModalCallbackID {
+			errorBlock = slackInfra.ResolveBlockRootCause
+		} else if errors.Is(err, entity.ErrInvalidLabelMatcher) {
+			errorBlock = slackInfra.SilenceBlockExpressions
+		}
+		return map[string]interface{}{
 			"response_action": "errors",
 			"errors": map[string]string{
-				"silence_duration": err.Error(),
+				errorBlock: err.Error(),
 			},
 		}
-		json.NewEncoder(w).Encode(errorResponse)
-		return
 	}
 
 	h.logger.Info("modal submission handled",
@@ -115,12 +150,45 @@ func (h *SlackInteractionHandler) handleViewSubmission(ctx context.Context, w ht
 	)
 
 	// Acknowledge successful submission (close modal)
-	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+// dispatchBlockActions queues block actions, if a queue is set, or else
+// handles them in the request. Actions on the same message are queued for
+// the same worker, so they are handled in the order they were clicked.
+func (h *SlackInteractionHandler) dispatchBlockActions(ctx context.Context, payload *slack.InteractionCallback) {
+	if h.queue != nil {
+		queued := *payload
+		key := payload.Channel.ID + ":" + payload.Message.Timestamp
+		err := h.queue.Enqueue(ctx, sourceSlackInteraction, key, func(ctx context.Context) {
+			h.handleBlockActions(ctx, &queued)
+		})
+		switch {
+		case err == nil:
+			return
+		case errors.Is(err, errIngestQueueFull):
+			h.respond(payload.ResponseURL, dto.NewEphemeralResponse(
+				":warning: Too many actions are being handled right now. Please try again in a moment."))
+			return
+		}
+		// Not running yet or any more: handle in the request
+	}
+	h.handleBlockActions(ctx, payload)
 }
 
 // handleBlockActions handles button clicks and other block actions.
 func (h *SlackInteractionHandler) handleBlockActions(ctx context.Context, payload *slack.InteractionCallback) {
 	for _, action := range payload.ActionCallback.BlockActions {
+		if h.commands != nil && presenter.IsAlertSearchPageAction(action.ActionID) {
+			if err := h.commands.HandleAlertSearchPage(ctx, payload.ResponseURL, action.Value); err != nil {
+				h.logger.Error("failed to page alert search",
+					"userID", payload.User.ID,
+					"error", err,
+				)
+			}
+			continue
+		}
+
 		input := dto.SlackInteractionInput{
 			ActionID:    action.ActionID,
 			AlertID:     action.Value,
@@ -132,10 +200,33 @@ func (h *SlackInteractionHandler) handleBlockActions(ctx context.Context, payloa
 			TriggerID:   payload.TriggerID,
 		}
 
+		if identity, ok := slackInfra.ParseAlertMetadata(payload.Message.Metadata); ok {
+			input.MessageAlertID = identity.AlertID
+		}
+
 		// Get value from static select if present
 		if action.SelectedOption.Value != "" {
 			input.Value = action.SelectedOption.Value
 		}
+		// or the user picked in a users select
+		if action.SelectedUser != "" {
+			input.Value = action.SelectedUser
+		}
+
+		// Show the action is under way, until the use case updates the
+		// message, so that users do not click again
+		pending := false
+		if status := pendingStatus(action.ActionID); status != "" {
+			blocks, ok := slackInfra.BuildPendingMessage(payload.Message.Blocks.BlockSet, action.ActionID,
+				fmt.Sprintf("%s (<@%s>)", status, payload.User.ID))
+			if ok {
+				pending = h.respond(payload.ResponseURL, &dto.SlackResponseDTO{
+					Text:            payload.Message.Text,
+					Blocks:          blocks,
+					ReplaceOriginal: true,
+				})
+			}
+		}
 
 		output, err := h.handleInteraction.Execute(ctx, input)
 		if err != nil {
@@ -144,6 +235,16 @@ func (h *SlackInteractionHandler) handleBlockActions(ctx context.Context, payloa
 				"userID", payload.User.ID,
 				"error", err,
 			)
+			// Bring the buttons back, and tell the user why nothing happened
+			if pending {
+				h.respond(payload.ResponseURL, &dto.SlackResponseDTO{
+					Text:            payload.Message.Text,
+					Blocks:          payload.Message.Blocks.BlockSet,
+					ReplaceOriginal: true,
+				})
+			}
+			h.respond(payload.ResponseURL, dto.NewEphemeralResponse(
+				fmt.Sprintf(":warning: That did not work: %s", err)))
 			// Continue processing other actions
 			continue
 		}
@@ -157,6 +258,34 @@ func (h *SlackInteractionHandler) handleBlockActions(ctx context.Context, payloa
 	}
 }
 
+// respond posts a response to the response_url of an interaction, and
+// reports whether it was posted.
+func (h *SlackInteractionHandler) respond(responseURL string, response *dto.SlackResponseDTO) bool {
+	if responseURL == "" {
+		return false
+	}
+	if err := postResponse(responseURL, response); err != nil {
+		h.logger.Warn("failed to respond to interaction", "error", err)
+		return false
+	}
+	return true
+}
+
+// pendingStatus returns the status an alert message shows while the action
+// clicked on it runs, or "" for actions that open a modal or reply apart.
+func pendingStatus(actionID string) string {
+	actionType, _, _ := strings.Cut(actionID, "_")
+	switch actionType {
+	case "ack":
+		return "Acknowledging…"
+	case "silence":
+		return "Silencing…"
+	case "assign", "assignuser":
+		return "Assigning…"
+	}
+	return ""
+}
+
 // SlackEventsHandler handles Slack Events API requests (URL verification, etc.).
 // NOTE: Signature verification is handled by middleware.SlackAuth middleware.
 type SlackEventsHandler struct {
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/altuslabsxyz/alert-bridge/internal/adapter/dto"
 	"github.com/altuslabsxyz/alert-bridge/internal/adapter/presenter"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
 	slackUseCase "github.com/altuslabsxyz/alert-bridge/internal/usecase/slack"
 )
 
@@ -31,11 +33,35 @@ var slackCommands = []SlackCommandInfo{
 	{
 		Command:          "/alert-status",
 		Description:      "Check current alert status",
-		UsageHint:        "[critical|warning|info]",
+		UsageHint:        "[critical|warning|info] [field=value ...] [view=name]",
 		RequestURL:       "/webhook/slack/commands",
 		ShouldEscape:     false,
 		AutocompleteHint: "Filter alerts by severity level",
 	},
+	{
+		Command:          "/alerts",
+		Description:      "Search alerts",
+		UsageHint:        "[state:active|acked|resolved|all] [severity:level] [label:name=value] [text:words]",
+		RequestURL:       "/webhook/slack/commands",
+		ShouldEscape:     false,
+		AutocompleteHint: "state:active severity:critical label:chain=axelar text:cpu",
+	},
+	{
+		Command:          "/alert-create",
+		Description:      "Raise an alert by hand",
+		UsageHint:        "[critical|warning|info] <name> [label=value ...] [description]",
+		RequestURL:       "/webhook/slack/commands",
+		ShouldEscape:     false,
+		AutocompleteHint: "critical CheckoutDown team=payments EU customers cannot pay",
+	},
+	{
+		Command:          "/alert-view",
+		Description:      "Manage saved alert views",
+		UsageHint:        "[list|save|delete] [name] [shared] [severity] [state] [label=value ...]",
+		RequestURL:       "/webhook/slack/commands",
+		ShouldEscape:     false,
+		AutocompleteHint: "save payments-prod critical team=payments, list, delete <name>",
+	},
 	{
 		Command:          "/summary",
 		Description:      "Get alert summary statistics",
@@ -52,6 +78,14 @@ var slackCommands = []SlackCommandInfo{
 		ShouldEscape:     false,
 		AutocompleteHint: "create 1h, list, delete <id>",
 	},
+	{
+		Command:          "/alert-deadletters",
+		Description:      "List and replay notifications that failed to deliver",
+		UsageHint:        "[list|replay|discard] [id|all]",
+		RequestURL:       "/webhook/slack/commands",
+		ShouldEscape:     false,
+		AutocompleteHint: "list, replay <id>, replay all, discard <id>",
+	},
 }
 
 // SlackCommandsHandler handles Slack slash command webhooks (HTTP Mode).
@@ -59,6 +93,10 @@ type SlackCommandsHandler struct {
 	queryAlertStatus *slackUseCase.QueryAlertStatusUseCase
 	summarizeAlerts  *slackUseCase.SummarizeAlertsUseCase
 	manageSilence    *slackUseCase.ManageSilenceUseCase
+	manageViews      *slackUseCase.ManageViewsUseCase
+	deadLetters      *slackUseCase.ManageDeadLettersUseCase
+	searchAlerts     *slackUseCase.SearchAlertsUseCase
+	raiseAlert       *slackUseCase.RaiseAlertUseCase
 	formatter        *presenter.SlackAlertFormatter
 	logger           *slog.Logger
 }
@@ -68,17 +106,35 @@ func NewSlackCommandsHandler(
 	queryAlertStatus *slackUseCase.QueryAlertStatusUseCase,
 	summarizeAlerts *slackUseCase.SummarizeAlertsUseCase,
 	manageSilence *slackUseCase.ManageSilenceUseCase,
+	manageViews *slackUseCase.ManageViewsUseCase,
 	logger *slog.Logger,
 ) *SlackCommandsHandler {
 	return &SlackCommandsHandler{
 		queryAlertStatus: queryAlertStatus,
 		summarizeAlerts:  summarizeAlerts,
 		manageSilence:    manageSilence,
+		manageViews:      manageViews,
 		formatter:        presenter.NewSlackAlertFormatter(),
 		logger:           logger,
 	}
 }
 
+// SetDeadLettersUseCase enables /alert-deadletters. Without it the command
+// reports that the retry queue is disabled.
+func (h *SlackCommandsHandler) SetDeadLettersUseCase(uc *slackUseCase.ManageDeadLettersUseCase) {
+	h.deadLetters = uc
+}
+
+// SetSearchAlertsUseCase enables /alerts.
+func (h *SlackCommandsHandler) SetSearchAlertsUseCase(uc *slackUseCase.SearchAlertsUseCase) {
+	h.searchAlerts = uc
+}
+
+// SetRaiseAlertUseCase enables /alert-create.
+func (h *SlackCommandsHandler) SetRaiseAlertUseCase(uc *slackUseCase.RaiseAlertUseCase) {
+	h.raiseAlert = uc
+}
+
 // ServeHTTP implements http.Handler interface.
 func (h *SlackCommandsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	switch r.Method {
@@ -113,8 +169,6 @@ func (h *SlackCommandsHandler) HandleListCommands(w http.ResponseWriter, r *http
 // HandleSlashCommand handles POST /webhook/slack/commands requests.
 // Slack sends slash commands as application/x-www-form-urlencoded.
 func (h *SlackCommandsHandler) HandleSlashCommand(w http.ResponseWriter, r *http.Request) {
-	startTime := time.Now()
-
 	// Parse slash command from request
 	cmd, err := slack.SlashCommandParse(r)
 	if err != nil {
@@ -123,6 +177,20 @@ func (h *SlackCommandsHandler) HandleSlashCommand(w http.ResponseWriter, r *http
 		return
 	}
 
+	response := h.HandleCommand(cmd)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		h.logger.Error("failed to encode immediate response", "error", err.Error())
+	}
+}
+
+// HandleCommand starts processing a slash command, received over HTTP or
+// Socket Mode, and returns the immediate acknowledgment. The result is sent
+// to the command's response_url once ready.
+func (h *SlackCommandsHandler) HandleCommand(cmd slack.SlashCommand) *dto.SlackResponseDTO {
+	startTime := time.Now()
+
 	// Convert to DTO
 	cmdDTO := &dto.SlackCommandDTO{
 		Command:     cmd.Command,
@@ -142,24 +210,19 @@ func (h *SlackCommandsHandler) HandleSlashCommand(w http.ResponseWriter, r *http
 		"channel_id", cmdDTO.ChannelID,
 		"text", cmdDTO.Text)
 
-	// Send immediate acknowledgment (Slack requires response within 3 seconds)
-	immediateResponse := dto.NewEphemeralResponse("Fetching alert status...")
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	if err := json.NewEncoder(w).Encode(immediateResponse); err != nil {
-		h.logger.Error("failed to encode immediate response", "error", err.Error())
-		return
-	}
-
 	// Process command asynchronously and send delayed response.
-	// Use a new background context instead of r.Context() because the request context
-	// is cancelled when the HTTP response is sent, which would cancel ongoing database queries.
-	// Slack allows up to 30 minutes for delayed responses via response_url.
+	// Use a new background context instead of the request context because it
+	// is cancelled when the acknowledgment is sent, which would cancel ongoing
+	// database queries. Slack allows up to 30 minutes for delayed responses
+	// via response_url.
 	asyncCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	go func() {
 		defer cancel()
 		h.processCommand(asyncCtx, cmdDTO, startTime)
 	}()
+
+	// Immediate acknowledgment (Slack requires a response within 3 seconds)
+	return dto.NewEphemeralResponse("Fetching alert status...")
 }
 
 // processCommand processes the command and sends delayed response via response_url.
@@ -168,10 +231,18 @@ func (h *SlackCommandsHandler) processCommand(ctx context.Context, cmd *dto.Slac
 	switch cmd.Command {
 	case "/alert-status":
 		h.handleAlertStatus(ctx, cmd, startTime)
+	case "/alerts":
+		h.handleAlerts(ctx, cmd, startTime)
+	case "/alert-create":
+		h.handleAlertCreate(ctx, cmd, startTime)
 	case "/summary":
 		h.handleSummary(ctx, cmd, startTime)
 	case "/silence":
 		h.handleSilence(ctx, cmd, startTime)
+	case "/alert-view":
+		h.handleViews(ctx, cmd, startTime)
+	case "/alert-deadletters":
+		h.handleDeadLetters(ctx, cmd, startTime)
 	default:
 		h.logger.Warn("unhandled slash command", "command", cmd.Command)
 		h.sendDelayedResponse(cmd.ResponseURL, dto.NewEphemeralResponse("Unknown command"))
@@ -183,8 +254,24 @@ func (h *SlackCommandsHandler) handleAlertStatus(ctx context.Context, cmd *dto.S
 	// Extract severity filter from command text
 	severity := cmd.SeverityFilter()
 
-	// Query alerts
-	alerts, err := h.queryAlertStatus.Execute(ctx, severity)
+	// Query alerts, optionally narrowed by custom field filters (name=value).
+	// A saved view (view=name) replaces the severity filter with its own criteria.
+	var alerts []*entity.Alert
+	var err error
+	if viewName := cmd.ViewName(); viewName != "" {
+		var view *entity.SavedView
+		view, alerts, err = h.queryAlertStatus.ExecuteView(ctx, cmd.UserID, viewName, cmd.FieldFilters())
+		if errors.Is(err, entity.ErrSavedViewNotFound) {
+			h.sendDelayedResponse(cmd.ResponseURL,
+				dto.NewEphemeralResponse(fmt.Sprintf("View `%s` not found. Use `/alert-view` to list saved views.", viewName)))
+			return
+		}
+		if view != nil {
+			severity = string(view.Severity)
+		}
+	} else {
+		alerts, err = h.queryAlertStatus.ExecuteFiltered(ctx, severity, cmd.FieldFilters())
+	}
 	if err != nil {
 		h.logger.Error("failed to query alert status",
 			"error", err.Error(),
@@ -219,6 +306,125 @@ func (h *SlackCommandsHandler) handleAlertStatus(ctx context.Context, cmd *dto.S
 		"sla_met", elapsed < 2*time.Second)
 }
 
+// handleAlerts handles /alerts command.
+// Usage: /alerts [state:...] [severity:...] [label:name=value ...] [text:...]
+// Examples:
+//   - /alerts                                         - Firing alerts
+//   - /alerts state:active severity:critical          - Unacknowledged critical alerts
+//   - /alerts label:chain=axelar text:cpu             - Firing axelar alerts mentioning cpu
+func (h *SlackCommandsHandler) handleAlerts(ctx context.Context, cmd *dto.SlackCommandDTO, startTime time.Time) {
+	if h.searchAlerts == nil {
+		h.sendDelayedResponse(cmd.ResponseURL, dto.NewEphemeralResponse("Alert search is not enabled."))
+		return
+	}
+
+	query, err := cmd.ParseAlertSearch()
+	if err != nil {
+		h.sendDelayedResponse(cmd.ResponseURL,
+			dto.NewEphemeralResponse(fmt.Sprintf("Invalid search: %v\n%s", err, dto.AlertSearchUsage)))
+		return
+	}
+
+	response, err := h.AlertSearchPage(ctx, query, cmd.Text, 0)
+	if err != nil {
+		h.logger.Error("failed to search alerts",
+			"error", err.Error(),
+			"user_id", cmd.UserID,
+			"text", cmd.Text)
+
+		h.sendDelayedResponse(cmd.ResponseURL,
+			dto.NewEphemeralResponse("Failed to search alerts. Please try again later."))
+		return
+	}
+
+	h.sendDelayedResponse(cmd.ResponseURL, response)
+
+	elapsed := time.Since(startTime)
+	h.logger.Info("slash command processed",
+		"command", cmd.Command,
+		"user_id", cmd.UserID,
+		"text", cmd.Text,
+		"response_time_ms", elapsed.Milliseconds(),
+		"sla_met", elapsed < 2*time.Second)
+}
+
+// AlertSearchPage runs the search text, parsed into query, and formats the
+// page of results starting at offset.
+func (h *SlackCommandsHandler) AlertSearchPage(ctx context.Context, query entity.AlertQuery, text string, offset int) (*dto.SlackResponseDTO, error) {
+	result, err := h.searchAlerts.Execute(ctx, query, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := h.formatter.FormatAlertSearch(result, query.Severity, text)
+	return dto.NewEphemeralWithBlocks(fmt.Sprintf("Found %d alert(s)", result.Total), blocks), nil
+}
+
+// HandleAlertSearchPage replaces an /alerts response with the page a
+// pagination button selects.
+func (h *SlackCommandsHandler) HandleAlertSearchPage(ctx context.Context, responseURL, value string) error {
+	if h.searchAlerts == nil {
+		return errors.New("alert search is not enabled")
+	}
+
+	offset, text, err := presenter.ParseAlertSearchPageValue(value)
+	if err != nil {
+		return err
+	}
+	query, err := dto.ParseAlertSearch(text)
+	if err != nil {
+		return err
+	}
+
+	response, err := h.AlertSearchPage(ctx, query, text, offset)
+	if err != nil {
+		return err
+	}
+	response.ReplaceOriginal = true
+	return postResponse(responseURL, response)
+}
+
+// handleAlertCreate handles /alert-create command.
+// Usage: /alert-create [critical|warning|info] <name> [label=value ...] [description]
+// Examples:
+//   - /alert-create critical CheckoutDown EU customers cannot pay
+//   - /alert-create SlowReplication team=db lag keeps growing
+func (h *SlackCommandsHandler) handleAlertCreate(ctx context.Context, cmd *dto.SlackCommandDTO, startTime time.Time) {
+	if h.raiseAlert == nil {
+		h.sendDelayedResponse(cmd.ResponseURL, dto.NewEphemeralResponse("Raising alerts is not enabled."))
+		return
+	}
+
+	req, err := cmd.ParseManualAlertRequest()
+	if err != nil {
+		h.sendDelayedResponse(cmd.ResponseURL,
+			dto.NewEphemeralResponse(fmt.Sprintf("Invalid alert: %v\n%s", err, dto.ManualAlertUsage)))
+		return
+	}
+
+	result, err := h.raiseAlert.Execute(ctx, req)
+	if err != nil {
+		h.logger.Error("failed to raise alert",
+			"error", err.Error(),
+			"user_id", cmd.UserID,
+			"name", req.Name)
+
+		h.sendDelayedResponse(cmd.ResponseURL,
+			dto.NewEphemeralResponse(fmt.Sprintf("Failed to raise alert: %v", err)))
+		return
+	}
+
+	h.sendDelayedResponse(cmd.ResponseURL, dto.NewEphemeralResponse(result.Message))
+
+	elapsed := time.Since(startTime)
+	h.logger.Info("slash command processed",
+		"command", cmd.Command,
+		"user_id", cmd.UserID,
+		"alert_id", result.Alert.ID,
+		"response_time_ms", elapsed.Milliseconds(),
+		"sla_met", elapsed < 2*time.Second)
+}
+
 // handleSummary handles /summary command.
 // Usage: /summary [period]
 // Period examples: 1h, 24h, 7d, 1w, today, week, all
@@ -314,6 +520,78 @@ func (h *SlackCommandsHandler) handleSilence(ctx context.Context, cmd *dto.Slack
 		"sla_met", elapsed < 2*time.Second)
 }
 
+// handleViews handles /alert-view command.
+// Usage: /alert-view [list|save|delete] [options]
+// Examples:
+//   - /alert-view                                  - List your views and shared views
+//   - /alert-view save payments-prod team=payments - Save a personal view
+//   - /alert-view delete payments-prod             - Delete a personal view
+func (h *SlackCommandsHandler) handleViews(ctx context.Context, cmd *dto.SlackCommandDTO, startTime time.Time) {
+	req := cmd.ParseViewRequest()
+
+	result, err := h.manageViews.Execute(ctx, req)
+	if err != nil {
+		h.logger.Error("failed to manage views",
+			"error", err.Error(),
+			"user_id", cmd.UserID,
+			"action", req.Action)
+
+		h.sendDelayedResponse(cmd.ResponseURL,
+			dto.NewEphemeralResponse(fmt.Sprintf("Failed to %s view: %v", req.Action, err)))
+		return
+	}
+
+	blocks := h.formatter.FormatViewResult(result, cmd.UserID)
+	h.sendDelayedResponse(cmd.ResponseURL, dto.NewEphemeralWithBlocks(result.Message, blocks))
+
+	elapsed := time.Since(startTime)
+	h.logger.Info("slash command processed",
+		"command", cmd.Command,
+		"user_id", cmd.UserID,
+		"action", req.Action,
+		"response_time_ms", elapsed.Milliseconds(),
+		"sla_met", elapsed < 2*time.Second)
+}
+
+// handleDeadLetters handles /alert-deadletters command.
+// Usage: /alert-deadletters [list|replay|discard] [id|all]
+// Examples:
+//   - /alert-deadletters              - List dead-lettered notifications
+//   - /alert-deadletters replay all   - Requeue every dead-lettered notification
+//   - /alert-deadletters discard <id> - Drop one without delivering it
+func (h *SlackCommandsHandler) handleDeadLetters(ctx context.Context, cmd *dto.SlackCommandDTO, startTime time.Time) {
+	if h.deadLetters == nil {
+		h.sendDelayedResponse(cmd.ResponseURL,
+			dto.NewEphemeralResponse("The notification retry queue is not enabled."))
+		return
+	}
+
+	req := cmd.ParseDeadLetterRequest()
+
+	result, err := h.deadLetters.Execute(ctx, req)
+	if err != nil {
+		h.logger.Error("failed to manage dead letters",
+			"error", err.Error(),
+			"user_id", cmd.UserID,
+			"action", req.Action)
+
+		h.sendDelayedResponse(cmd.ResponseURL,
+			dto.NewEphemeralResponse(fmt.Sprintf("Failed to %s dead letters: %v", req.Action, err)))
+		return
+	}
+
+	blocks := h.formatter.FormatDeadLetterResult(result)
+	h.sendDelayedResponse(cmd.ResponseURL, dto.NewEphemeralWithBlocks(result.Message, blocks))
+
+	elapsed := time.Since(startTime)
+	h.logger.Info("slash command processed",
+		"command", cmd.Command,
+		"user_id", cmd.UserID,
+		"action", req.Action,
+		"response_time_ms", elapsed.Milliseconds(),
+		"sla_met", elapsed < 2*time.Second)
+}
+
 // sendDelayedResponse sends a delayed response to Slack via response_url.
 func (h *SlackCommandsHandler) sendDelayedResponse(responseURL string, response *dto.SlackResponseDTO) {
 	if responseURL == "" {
@@ -321,27 +599,31 @@ func (h *SlackCommandsHandler) sendDelayedResponse(responseURL string, response
 		return
 	}
 
+	if err := postResponse(responseURL, response); err != nil {
+		h.logger.Error("failed to send delayed response", "error", err.Error())
+		return
+	}
+
+	h.logger.Debug("delayed response sent successfully")
+}
+
+// postResponse posts a message to a Slack response_url.
+func postResponse(responseURL string, response *dto.SlackResponseDTO) error {
 	// Marshal response to JSON
 	jsonData, err := json.Marshal(response)
 	if err != nil {
-		h.logger.Error("failed to marshal delayed response", "error", err.Error())
-		return
+		return fmt.Errorf("marshaling response: %w", err)
 	}
 
 	// POST to response_url
 	resp, err := http.Post(responseURL, "application/json", bytes.NewBuffer(jsonData))
 	if err != nil {
-		h.logger.Error("failed to send delayed response", "error", err.Error())
-		return
+		return fmt.Errorf("posting response: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		h.logger.Error("delayed response failed",
-			"status_code", resp.StatusCode,
-			"status", resp.Status)
-		return
+		return fmt.Errorf("response_url returned %s", resp.Status)
 	}
-
-	h.logger.Debug("delayed response sent successfully")
+	return nil
 }
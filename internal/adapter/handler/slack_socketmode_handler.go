@@ -1,6 +1,8 @@
 package handler
 
 import (
+	"context"
+
 	slackSDK "github.com/slack-go/slack"
 	"github.com/slack-go/slack/slackevents"
 	"github.com/slack-go/slack/socketmode"
@@ -8,77 +10,59 @@ import (
 	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/slack"
 )
 
-// SocketModeHandler wraps the Socket Mode client and routes events to use cases.
+// SocketModeHandler routes Socket Mode events to the same handlers as the
+// /webhook/slack/* endpoints, so that commands and interactions behave
+// alike without a public endpoint.
 type SocketModeHandler struct {
-	client *slack.SocketModeClient
-	logger slack.Logger
+	commands     *SlackCommandsHandler
+	interactions *SlackInteractionHandler
+	logger       slack.Logger
 }
 
 // NewSocketModeHandler creates a new Socket Mode handler.
-func NewSocketModeHandler(client *slack.SocketModeClient, logger slack.Logger) *SocketModeHandler {
+func NewSocketModeHandler(commands *SlackCommandsHandler, interactions *SlackInteractionHandler, logger slack.Logger) *SocketModeHandler {
 	return &SocketModeHandler{
-		client: client,
-		logger: logger,
+		commands:     commands,
+		interactions: interactions,
+		logger:       logger,
 	}
 }
 
-// HandleEvent handles Events API events.
-func (h *SocketModeHandler) HandleEvent(evt *socketmode.Event) error {
-	h.logger.Debug("Handling Events API event", "type", evt.Type)
+// Register makes the handler receive the client's commands, interactions
+// and events.
+func (h *SocketModeHandler) Register(client *slack.SocketModeClient) {
+	client.SetCommandHandler(h)
+	client.SetInteractionHandler(h)
+	client.SetEventHandler(h)
+}
 
+// HandleEvent handles Events API events. None are acted on yet, as over
+// HTTP, so they are only logged.
+func (h *SocketModeHandler) HandleEvent(evt *socketmode.Event) error {
 	eventsAPI, ok := evt.Data.(slackevents.EventsAPIEvent)
 	if !ok {
 		h.logger.Error("Failed to cast to EventsAPIEvent")
 		return nil
 	}
 
-	// Route to specific event handlers based on inner event type
-	h.logger.Info("Events API event received", "inner_type", eventsAPI.InnerEvent.Type)
-
-	// TODO: Implement specific event handlers
-	// For now, just log the event
-	switch eventsAPI.InnerEvent.Type {
-	case "app_mention":
-		h.logger.Info("App mention event received")
-	default:
-		h.logger.Debug("Unhandled inner event type", "type", eventsAPI.InnerEvent.Type)
-	}
-
+	h.logger.Debug("Events API event received", "inner_type", eventsAPI.InnerEvent.Type)
 	return nil
 }
 
 // HandleCommand handles slash command events.
-func (h *SocketModeHandler) HandleCommand(cmd *slackSDK.SlashCommand) error {
-	h.logger.Info("Handling slash command",
-		"command", cmd.Command,
-		"user", cmd.UserID,
-		"channel", cmd.ChannelID)
-
-	// TODO: Route to command use cases
-	// For now, just log the command
-	h.logger.Debug("Slash command details",
-		"text", cmd.Text,
-		"response_url", cmd.ResponseURL)
-
-	return nil
+func (h *SocketModeHandler) HandleCommand(_ context.Context, cmd *slackSDK.SlashCommand) (any, error) {
+	if h.commands == nil {
+		h.logger.Warn("slash command received but commands are not enabled", "command", cmd.Command)
+		return nil, nil
+	}
+	return h.commands.HandleCommand(*cmd), nil
 }
 
 // HandleInteraction handles interactive component events.
-func (h *SocketModeHandler) HandleInteraction(callback *slackSDK.InteractionCallback) error {
-	h.logger.Info("Handling interaction",
-		"type", callback.Type,
-		"user", callback.User.ID,
-		"channel", callback.Channel.ID)
-
-	// TODO: Route to interaction use cases
-	// For now, just log the interaction
-	if len(callback.ActionCallback.BlockActions) > 0 {
-		action := callback.ActionCallback.BlockActions[0]
-		h.logger.Debug("Block action details",
-			"action_id", action.ActionID,
-			"block_id", action.BlockID,
-			"value", action.Value)
+func (h *SocketModeHandler) HandleInteraction(ctx context.Context, callback *slackSDK.InteractionCallback) (any, error) {
+	if h.interactions == nil {
+		h.logger.Warn("interaction received but interactions are not enabled", "type", callback.Type)
+		return nil, nil
 	}
-
-	return nil
+	return h.interactions.HandleCallback(ctx, callback), nil
 }
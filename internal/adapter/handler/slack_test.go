@@ -0,0 +1,98 @@
+package handler
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/slack-go/slack"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/memory"
+	slackUseCase "github.com/altuslabsxyz/alert-bridge/internal/usecase/slack"
+)
+
+type fakeSlackClient struct{}
+
+func (fakeSlackClient) GetUserEmail(context.Context, string) (string, error) {
+	return "alice@example.com", nil
+}
+func (fakeSlackClient) GetUserInfo(context.Context, string) (*slack.User, error) {
+	return &slack.User{}, nil
+}
+func (fakeSlackClient) UpdateMessage(context.Context, string, *entity.Alert) error { return nil }
+func (fakeSlackClient) OpenModal(context.Context, string, slack.ModalViewRequest) error {
+	return nil
+}
+func (fakeSlackClient) PostEphemeral(context.Context, string, string, string) error { return nil }
+func (fakeSlackClient) PostThreadReply(context.Context, string, string) error       { return nil }
+
+func TestSlackInteractionHandler_Queue(t *testing.T) {
+	responses := make(chan string, 10)
+	responseURL := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		responses <- string(body)
+	}))
+	defer responseURL.Close()
+
+	interactions := slackUseCase.NewHandleInteractionUseCase(
+		memory.NewAlertRepository(), memory.NewSilenceRepository(), nil, fakeSlackClient{}, nopLogger{})
+	h := NewSlackInteractionHandler(interactions, nopLogger{})
+	queue := NewIngestQueue(1, 1, nopLogger{}, nil)
+	h.SetQueue(queue)
+
+	// History is not enabled, so the click fails
+	click := &slack.InteractionCallback{
+		Type:        slack.InteractionTypeBlockActions,
+		ResponseURL: responseURL.URL,
+		User:        slack.User{ID: "U1", Name: "alice"},
+		ActionCallback: slack.ActionCallbacks{BlockActions: []*slack.BlockAction{
+			{ActionID: "history_a1", Value: "a1"},
+		}},
+	}
+	receive := func() string {
+		select {
+		case body := <-responses:
+			return body
+		case <-time.After(5 * time.Second):
+			t.Fatal("no response posted")
+			return ""
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go queue.Run(ctx)
+
+	// Acknowledged at once, with the failure posted from the worker
+	for queue.Enqueue(ctx, sourceSlackInteraction, "", func(context.Context) {}) != nil {
+		time.Sleep(time.Millisecond)
+	}
+	if response := h.HandleCallback(ctx, click); response != nil {
+		t.Errorf("HandleCallback() = %v, want an empty acknowledgment", response)
+	}
+	if body := receive(); !strings.Contains(body, "alert history is not enabled") || !strings.Contains(body, `"ephemeral"`) {
+		t.Errorf("response = %s, want an ephemeral error", body)
+	}
+
+	// Occupy the only worker and fill its queue
+	release := make(chan struct{})
+	started := make(chan struct{})
+	if err := queue.Enqueue(ctx, sourceSlackInteraction, "", func(context.Context) { close(started); <-release }); err != nil {
+		t.Fatal(err)
+	}
+	<-started
+	if err := queue.Enqueue(ctx, sourceSlackInteraction, "", func(context.Context) {}); err != nil {
+		t.Fatalf("Enqueue() = %v, want room for one click", err)
+	}
+
+	h.HandleCallback(ctx, click)
+	if body := receive(); !strings.Contains(body, "try again") {
+		t.Errorf("response = %s, want the user asked to try again", body)
+	}
+	close(release)
+}
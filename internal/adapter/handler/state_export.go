@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/api"
+)
+
+// StateExportHandler exports the live state of the instance, for importing
+// into another storage backend with the import-state command.
+type StateExportHandler struct {
+	transferState *api.TransferStateUseCase
+	logger        alert.Logger
+}
+
+// NewStateExportHandler creates a new state export handler.
+func NewStateExportHandler(transferState *api.TransferStateUseCase, logger alert.Logger) *StateExportHandler {
+	return &StateExportHandler{transferState: transferState, logger: logger}
+}
+
+// ServeHTTP handles GET /-/state.
+func (h *StateExportHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	export, err := h.transferState.Export(r.Context())
+	if err != nil {
+		h.logger.Error("failed to export state", "error", err)
+		writeAPIError(w, http.StatusInternalServerError, "failed to export state")
+		return
+	}
+	writeJSON(w, http.StatusOK, export)
+}
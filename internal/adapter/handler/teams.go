@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"html/template"
+	"net/http"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/adapter/dto"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
+	teamsUseCase "github.com/altuslabsxyz/alert-bridge/internal/usecase/teams"
+)
+
+// teamsPage renders the confirmation and result pages for Teams card actions.
+// GET only shows a confirmation form so link previews cannot trigger an ack.
+var teamsPage = template.Must(template.New("teams").Parse(`<!DOCTYPE html>
+<html><head><meta charset="utf-8"><title>Alert Bridge</title></head>
+<body style="font-family: sans-serif; max-width: 32rem; margin: 3rem auto;">
+{{if .Confirm}}
+<form method="post">
+<p>Acknowledge this alert?</p>
+<input type="hidden" name="action" value="{{.Action}}">
+<input type="hidden" name="alert_id" value="{{.AlertID}}">
+<input type="hidden" name="expires" value="{{.Expires}}">
+<input type="hidden" name="sig" value="{{.Sig}}">
+<p><label>Your name <input type="text" name="user_name" maxlength="64" required></label></p>
+<p><small>The name is not verified and is recorded as such.</small></p>
+<button type="submit">Acknowledge</button>
+</form>
+{{else}}
+<p>{{.Message}}</p>
+{{end}}
+</body></html>`))
+
+// teamsPageData holds values rendered by teamsPage.
+type teamsPageData struct {
+	Confirm bool
+	Action  string
+	AlertID string
+	Expires string
+	Sig     string
+	Message string
+}
+
+// TeamsInteractionHandler handles actions from Teams alert cards.
+// NOTE: Link signature verification is handled by middleware.TeamsAuth middleware.
+type TeamsInteractionHandler struct {
+	handleInteraction *teamsUseCase.HandleInteractionUseCase
+	logger            alert.Logger
+}
+
+// NewTeamsInteractionHandler creates a new Teams interaction handler.
+func NewTeamsInteractionHandler(
+	handleInteraction *teamsUseCase.HandleInteractionUseCase,
+	logger alert.Logger,
+) *TeamsInteractionHandler {
+	return &TeamsInteractionHandler{
+		handleInteraction: handleInteraction,
+		logger:            logger,
+	}
+}
+
+// ServeHTTP handles GET and POST /webhook/teams
+func (h *TeamsInteractionHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid form data", http.StatusBadRequest)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		h.render(w, http.StatusOK, teamsPageData{
+			Confirm: true,
+			Action:  r.Form.Get("action"),
+			AlertID: r.Form.Get("alert_id"),
+			Expires: r.Form.Get("expires"),
+			Sig:     r.Form.Get("sig"),
+		})
+	case http.MethodPost:
+		h.handleAction(w, r)
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// handleAction executes the confirmed card action.
+func (h *TeamsInteractionHandler) handleAction(w http.ResponseWriter, r *http.Request) {
+	input := dto.TeamsInteractionInput{
+		Action:   r.PostForm.Get("action"),
+		AlertID:  r.PostForm.Get("alert_id"),
+		UserName: r.PostForm.Get("user_name"),
+	}
+
+	h.logger.Info("received Teams interaction",
+		"action", input.Action,
+		"alertID", input.AlertID,
+		"user", input.UserName,
+	)
+
+	output, err := h.handleInteraction.Execute(r.Context(), input)
+	if err != nil {
+		h.logger.Error("failed to handle Teams interaction",
+			"action", input.Action,
+			"alertID", input.AlertID,
+			"error", err,
+		)
+		h.render(w, http.StatusInternalServerError, teamsPageData{
+			Message: "Failed to process the action. Please try again.",
+		})
+		return
+	}
+
+	h.render(w, http.StatusOK, teamsPageData{Message: output.Message})
+}
+
+// render writes a teamsPage response.
+func (h *TeamsInteractionHandler) render(w http.ResponseWriter, status int, data teamsPageData) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	if err := teamsPage.Execute(w, data); err != nil {
+		h.logger.Error("failed to render Teams page", "error", err)
+	}
+}
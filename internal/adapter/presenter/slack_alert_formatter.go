@@ -2,8 +2,11 @@ package presenter
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/altuslabsxyz/alert-bridge/internal/adapter/dto"
 	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
 	slackInfra "github.com/altuslabsxyz/alert-bridge/internal/infrastructure/slack"
 	slackUseCase "github.com/altuslabsxyz/alert-bridge/internal/usecase/slack"
@@ -85,6 +88,85 @@ func (f *SlackAlertFormatter) FormatAlertStatus(alerts []*entity.Alert, severity
 	return blocks
 }
 
+// Action IDs of the /alerts pagination buttons. Their value is the page's
+// offset and the search text, see AlertSearchPageValue.
+const (
+	AlertSearchPreviousActionID = "alertsearch_previous"
+	AlertSearchNextActionID     = "alertsearch_next"
+)
+
+// IsAlertSearchPageAction reports whether actionID is an /alerts
+// pagination button.
+func IsAlertSearchPageAction(actionID string) bool {
+	return actionID == AlertSearchPreviousActionID || actionID == AlertSearchNextActionID
+}
+
+// AlertSearchPageValue encodes the page at offset of the search text as a
+// button value.
+func AlertSearchPageValue(offset int, text string) string {
+	return strconv.Itoa(offset) + " " + text
+}
+
+// ParseAlertSearchPageValue decodes a value made by AlertSearchPageValue.
+func ParseAlertSearchPageValue(value string) (offset int, text string, err error) {
+	offsetText, text, _ := strings.Cut(value, " ")
+	offset, err = strconv.Atoi(offsetText)
+	if err != nil || offset < 0 {
+		return 0, "", fmt.Errorf("invalid alert search page %q", value)
+	}
+	return offset, text, nil
+}
+
+// FormatAlertSearch formats a page of /alerts results with the alert status
+// layout, followed by Previous and Next buttons when there are more pages.
+// text is the search as typed, carried by the buttons.
+func (f *SlackAlertFormatter) FormatAlertSearch(result *slackUseCase.AlertSearchResult, severity entity.AlertSeverity, text string) []slack.Block {
+	blocks := f.FormatAlertStatus(result.Alerts, string(severity))
+
+	// The status summary counts only this page; report the whole search
+	search := "all alerts"
+	if text = strings.TrimSpace(text); text != "" {
+		search = fmt.Sprintf("`%s`", text)
+	}
+	summaryText := fmt.Sprintf("No alerts match %s", search)
+	if result.Total > 0 {
+		summaryText = fmt.Sprintf("Showing %d–%d of %d alert(s) matching %s",
+			result.Offset+1, result.Offset+len(result.Alerts), result.Total, search)
+	}
+	blocks[1] = slack.NewSectionBlock(
+		slack.NewTextBlockObject(slack.MarkdownType, summaryText, false, false),
+		nil, nil,
+	)
+	if len(result.Alerts) == 0 {
+		// Drop the "No active alerts" section, which would contradict searches
+		// of resolved alerts
+		blocks = append(blocks[:3], blocks[4:]...)
+	}
+
+	var buttons []slack.BlockElement
+	if result.HasPrevious() {
+		buttons = append(buttons, slack.NewButtonBlockElement(
+			AlertSearchPreviousActionID,
+			AlertSearchPageValue(max(result.Offset-slackUseCase.AlertSearchPageSize, 0), text),
+			slack.NewTextBlockObject(slack.PlainTextType, "◀ Previous", true, false),
+		))
+	}
+	if result.HasNext() {
+		buttons = append(buttons, slack.NewButtonBlockElement(
+			AlertSearchNextActionID,
+			AlertSearchPageValue(result.Offset+len(result.Alerts), text),
+			slack.NewTextBlockObject(slack.PlainTextType, "Next ▶", true, false),
+		))
+	}
+	if len(buttons) > 0 {
+		// Keep the footer last
+		footer := blocks[len(blocks)-1]
+		blocks = append(blocks[:len(blocks)-1], slack.NewActionBlock("alert_search_pages", buttons...), footer)
+	}
+
+	return blocks
+}
+
 // formatAlert formats a single alert into a Slack section block.
 func (f *SlackAlertFormatter) formatAlert(alert *entity.Alert) *slack.SectionBlock {
 	// Severity indicator
@@ -119,6 +201,25 @@ func (f *SlackAlertFormatter) formatAlert(alert *entity.Alert) *slack.SectionBlo
 	}
 	details = append(details, fmt.Sprintf("State: %s", stateText))
 
+	if alert.IsAssigned() {
+		details = append(details, fmt.Sprintf("Assignee: %s", formatAssignee(alert)))
+	}
+
+	if len(alert.Tags) > 0 {
+		details = append(details, fmt.Sprintf("Tags: %s", strings.Join(alert.Tags, ", ")))
+	}
+
+	// Severity changes, with how long the alert held the previous severity
+	since := alert.FiredAt
+	for _, t := range alert.History {
+		if !t.IsSeverityChange() {
+			continue
+		}
+		details = append(details, fmt.Sprintf("%s → %s after %s",
+			t.PreviousSeverity, t.Severity, f.formatDuration(t.At.Sub(since))))
+		since = t.At
+	}
+
 	text += fmt.Sprintf("_%s_", f.joinDetails(details))
 
 	return slack.NewSectionBlock(
@@ -127,6 +228,15 @@ func (f *SlackAlertFormatter) formatAlert(alert *entity.Alert) *slack.SectionBlo
 	)
 }
 
+// formatAssignee mentions the alert's assignee when their Slack user ID is
+// known, and names them otherwise.
+func formatAssignee(alert *entity.Alert) string {
+	if alert.AssigneeSlackID != "" {
+		return fmt.Sprintf("<@%s>", alert.AssigneeSlackID)
+	}
+	return alert.AssignedTo
+}
+
 // getSeverityMarker returns a circle emoji for the severity level.
 func (f *SlackAlertFormatter) getSeverityMarker(severity entity.AlertSeverity) string {
 	switch severity {
@@ -254,6 +364,28 @@ func (f *SlackAlertFormatter) FormatAlertSummary(summary *entity.AlertSummary, p
 		}
 	}
 
+	// Top responder tags (top 5)
+	if len(summary.AlertsByTag) > 0 {
+		tagBreakdown := f.formatTopInstances(summary.AlertsByTag, 5)
+		blocks = append(blocks, slack.NewSectionBlock(
+			slack.NewTextBlockObject(slack.MarkdownType, "*Top Tags:*\n"+tagBreakdown, false, false),
+			nil, nil,
+		))
+	}
+
+	// Assignees (top 5), with the alerts nobody owns
+	if len(summary.AlertsByAssignee) > 0 || summary.UnassignedAlerts > 0 {
+		assigneeText := "*Assignees:*\n"
+		if len(summary.AlertsByAssignee) > 0 {
+			assigneeText += f.formatTopInstances(summary.AlertsByAssignee, 5)
+		}
+		assigneeText += fmt.Sprintf("Unassigned: %d", summary.UnassignedAlerts)
+		blocks = append(blocks, slack.NewSectionBlock(
+			slack.NewTextBlockObject(slack.MarkdownType, assigneeText, false, false),
+			nil, nil,
+		))
+	}
+
 	blocks = append(blocks, slack.NewDividerBlock())
 
 	// Top acknowledgers section
@@ -273,6 +405,14 @@ func (f *SlackAlertFormatter) FormatAlertSummary(summary *entity.AlertSummary, p
 		))
 	}
 
+	// Response times over the period, resolved alerts included
+	if rt := summary.ResponseTimes; rt != nil && (rt.Overall.Acked > 0 || rt.Overall.Resolved > 0) {
+		blocks = append(blocks, slack.NewDividerBlock(), slack.NewSectionBlock(
+			slack.NewTextBlockObject(slack.MarkdownType, f.formatResponseTimes(rt), false, false),
+			nil, nil,
+		))
+	}
+
 	// Footer context - uses Slack date formatting for automatic timezone/locale conversion
 	footerText := fmt.Sprintf("Summary generated: %s", slackInfra.FormatSlackTime(time.Now(), slackInfra.SlackDateShort))
 	blocks = append(blocks, slack.NewContextBlock(
@@ -283,7 +423,27 @@ func (f *SlackAlertFormatter) FormatAlertSummary(summary *entity.AlertSummary, p
 	return blocks
 }
 
-// formatTopInstances formats the top N instances by alert count.
+// formatResponseTimes formats overall and per-severity MTTA and MTTR.
+func (f *SlackAlertFormatter) formatResponseTimes(rt *entity.ResponseTimeReport) string {
+	text := fmt.Sprintf("*Response Times* (since %s):\n", slackInfra.FormatSlackTime(rt.PeriodStart, slackInfra.SlackDateShort))
+	text += fmt.Sprintf("MTTA: %s (%d acked) | MTTR: %s (%d resolved)",
+		f.formatMean(rt.Overall.MTTA), rt.Overall.Acked, f.formatMean(rt.Overall.MTTR), rt.Overall.Resolved)
+	for _, group := range rt.BySeverity {
+		text += fmt.Sprintf("\n%s: MTTA %s | MTTR %s",
+			f.formatSeverity(group.Key), f.formatMean(group.MTTA), f.formatMean(group.MTTR))
+	}
+	return text
+}
+
+// formatMean formats a mean duration, or "n/a" when there was nothing to average.
+func (f *SlackAlertFormatter) formatMean(d time.Duration) string {
+	if d == 0 {
+		return "n/a"
+	}
+	return f.formatDuration(d)
+}
+
+// formatTopInstances formats the top N entries (instances, tags, assignees) by alert count.
 func (f *SlackAlertFormatter) formatTopInstances(instances map[string]int, limit int) string {
 	// Convert to slice for sorting
 	type instanceCount struct {
@@ -377,6 +537,98 @@ func (f *SlackAlertFormatter) FormatSilenceResult(result *slackUseCase.SilenceRe
 	return blocks
 }
 
+// FormatViewResult formats the result of a saved view operation as Slack blocks.
+// userID is the requesting user, used to mark which listed views are theirs.
+func (f *SlackAlertFormatter) FormatViewResult(result *slackUseCase.ViewResult, userID string) []slack.Block {
+	blocks := []slack.Block{
+		slack.NewHeaderBlock(
+			slack.NewTextBlockObject(slack.PlainTextType, "Saved Views", false, false),
+		),
+		slack.NewSectionBlock(
+			slack.NewTextBlockObject(slack.MarkdownType, result.Message, false, false),
+			nil, nil,
+		),
+	}
+
+	if result.Action != dto.ViewActionList {
+		return blocks
+	}
+
+	if len(result.Views) == 0 {
+		blocks = append(blocks, slack.NewSectionBlock(
+			slack.NewTextBlockObject(slack.MarkdownType,
+				"_No saved views. Create one with_ `/alert-view save <name> [severity] [state] [label=value ...]`",
+				false, false),
+			nil, nil,
+		))
+		return blocks
+	}
+
+	blocks = append(blocks, slack.NewDividerBlock())
+
+	var lines []string
+	for _, view := range result.Views {
+		scope := "shared"
+		if view.Owner == userID && !view.IsShared() {
+			scope = "personal"
+		}
+		lines = append(lines, fmt.Sprintf("• `%s` (%s): %s", view.Name, scope, view.Describe()))
+	}
+	blocks = append(blocks, slack.NewSectionBlock(
+		slack.NewTextBlockObject(slack.MarkdownType, strings.Join(lines, "\n"), false, false),
+		nil, nil,
+	))
+	blocks = append(blocks, slack.NewContextBlock("",
+		slack.NewTextBlockObject(slack.MarkdownType, "Use a view with `/alert-status view=<name>`", false, false),
+	))
+
+	return blocks
+}
+
+// FormatDeadLetterResult formats the result of a dead letter operation as
+// Slack blocks. At most 10 dead letters are listed.
+func (f *SlackAlertFormatter) FormatDeadLetterResult(result *slackUseCase.DeadLetterResult) []slack.Block {
+	blocks := []slack.Block{
+		slack.NewHeaderBlock(
+			slack.NewTextBlockObject(slack.PlainTextType, "Dead-Lettered Notifications", false, false),
+		),
+		slack.NewSectionBlock(
+			slack.NewTextBlockObject(slack.MarkdownType, result.Message, false, false),
+			nil, nil,
+		),
+	}
+
+	if result.Action != dto.DeadLetterActionList || len(result.DeadLetters) == 0 {
+		return blocks
+	}
+
+	blocks = append(blocks, slack.NewDividerBlock())
+	for i, retry := range result.DeadLetters {
+		if i == 10 {
+			blocks = append(blocks, slack.NewContextBlock("",
+				slack.NewTextBlockObject(slack.MarkdownType,
+					fmt.Sprintf("_...and %d more_", len(result.DeadLetters)-10), false, false),
+			))
+			break
+		}
+		text := fmt.Sprintf("ID: `%s`\nAttempts: %d, last failed %s\nError: %s",
+			retry.ID,
+			retry.Attempts,
+			slackInfra.FormatSlackTime(retry.UpdatedAt, slackInfra.SlackDateShort),
+			retry.LastError,
+		)
+		blocks = append(blocks, slack.NewSectionBlock(
+			slack.NewTextBlockObject(slack.MarkdownType, text, false, false),
+			nil, nil,
+		))
+	}
+	blocks = append(blocks, slack.NewContextBlock("",
+		slack.NewTextBlockObject(slack.MarkdownType, "Requeue with `/alert-deadletters replay <id|all>`", false, false),
+	))
+
+	return blocks
+}
+
 // formatSilenceDetails formats a single silence into a Slack section block.
 func (f *SlackAlertFormatter) formatSilenceDetails(silence *entity.SilenceMark, prefix string) *slack.SectionBlock {
 	text := ""
@@ -390,6 +642,14 @@ func (f *SlackAlertFormatter) formatSilenceDetails(silence *entity.SilenceMark,
 		text += fmt.Sprintf("Reason: %s\n", silence.Reason)
 	}
 
+	if matchers := silence.LabelMatchers(); len(matchers) > 0 {
+		formatted := make([]string, len(matchers))
+		for i, m := range matchers {
+			formatted[i] = "`" + m.String() + "`"
+		}
+		text += fmt.Sprintf("Matchers: %s\n", strings.Join(formatted, ", "))
+	}
+
 	// Duration info
 	remaining := silence.RemainingDuration()
 	if remaining > 0 {
@@ -5,9 +5,14 @@ import (
 	"io"
 	"time"
 
+	"github.com/altuslabsxyz/alert-bridge/internal/adapter/dto"
+	"github.com/altuslabsxyz/alert-bridge/internal/adapter/handler"
 	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
 	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/config"
 	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/observability"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/buffered"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/firingcache"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/schedule"
 	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/server"
 )
 
@@ -16,6 +21,12 @@ type dbPinger interface {
 	Ping(ctx context.Context) error
 }
 
+// dbCheckpointer is implemented by storage with a write-ahead log to fold
+// back into the database file on shutdown.
+type dbCheckpointer interface {
+	Checkpoint(ctx context.Context) error
+}
+
 // Application holds all application dependencies and lifecycle
 type Application struct {
 	config        *config.Config
@@ -24,12 +35,21 @@ type Application struct {
 	telemetry     *observability.Telemetry
 
 	// Storage
-	alertRepo    repository.AlertRepository
-	ackEventRepo repository.AckEventRepository
-	silenceRepo  repository.SilenceRepository
-	txManager    repository.TransactionManager
-	dbCloser     io.Closer           // For cleanup
-	dbPinger     dbPinger            // For readiness checks
+	alertRepo      repository.AlertRepository
+	ackEventRepo   repository.AckEventRepository
+	alertEventRepo repository.AlertEventRepository
+	silenceRepo    repository.SilenceRepository
+	savedViewRepo  repository.SavedViewRepository
+	retryRepo      repository.NotificationRetryRepository
+	txManager      repository.TransactionManager
+	dbCloser       io.Closer // For cleanup
+	dbPinger       dbPinger  // For readiness checks
+
+	// Holds writes while storage is unavailable (nil unless enabled)
+	storageBuffer *buffered.Buffer
+
+	// Fingerprints of firing alerts (nil unless enabled)
+	firingCache *firingcache.AlertRepository
 
 	// Infrastructure clients
 	clients *Clients
@@ -40,6 +60,18 @@ type Application struct {
 	// HTTP layer
 	handlers *server.Handlers
 	server   *server.Server
+
+	// Background processing of Alertmanager webhooks (nil unless enabled)
+	ingestQueue *handler.IngestQueue
+
+	// Background handling of Slack button clicks (nil unless enabled)
+	interactionQueue *handler.IngestQueue
+
+	// Severity mapping of ingestion handlers, updated on config reload
+	severities *dto.SeverityMap
+
+	// Background jobs (nil when no reports are configured)
+	scheduler *schedule.Scheduler
 }
 
 // New creates a new Application instance
@@ -57,18 +89,102 @@ func New(configPath string) (*Application, error) {
 func (app *Application) Start(ctx context.Context) error {
 	app.logger.Get().Info("starting alert-bridge",
 		"port", app.config.Server.Port,
+		"features", compiledFeatures(),
 	)
 
+	if app.storageBuffer != nil {
+		go app.storageBuffer.Run(ctx)
+	}
+	if app.ingestQueue != nil {
+		go app.ingestQueue.Run(ctx)
+	}
+	if app.interactionQueue != nil {
+		go app.interactionQueue.Run(ctx)
+	}
+	if app.scheduler != nil {
+		go app.scheduler.Run(ctx)
+	}
+	if app.useCases.RetryQueue != nil {
+		go app.useCases.RetryQueue.Run(ctx)
+	}
+	if app.useCases.Escalation != nil {
+		go app.useCases.Escalation.Run(ctx)
+	}
+	if app.useCases.MessageLifecycle != nil {
+		go app.useCases.MessageLifecycle.Run(ctx)
+	}
+	if app.useCases.StaleAlerts != nil {
+		go app.useCases.StaleAlerts.Run(ctx)
+	}
+	if app.useCases.Resend != nil {
+		go app.useCases.Resend.Run(ctx)
+	}
+	if app.useCases.Shards != nil {
+		go app.useCases.Shards.Run(ctx)
+	}
+	if app.useCases.Retention != nil {
+		go app.useCases.Retention.Run(ctx)
+	}
+	go app.useCases.Silences.Run(ctx)
+	if app.clients.ClockSkew != nil {
+		go app.clients.ClockSkew.Run(ctx)
+	}
+	if app.clients.PagerDutyQueue != nil {
+		go app.clients.PagerDutyQueue.Run(ctx)
+	}
+	if app.clients.NotificationLimiter != nil {
+		go app.clients.NotificationLimiter.Run(ctx)
+	}
+	if app.config.Server.Systemd.Enabled {
+		app.startSystemdNotify(ctx)
+	}
+
 	return app.server.Run(ctx)
 }
 
-// Shutdown gracefully stops the application
+// storageFlushTimeout bounds writing back held writes on shutdown.
+const storageFlushTimeout = 10 * time.Second
+
+// Shutdown gracefully stops the application once Start has returned, by
+// which time the HTTP server no longer accepts webhooks. Work already
+// accepted is drained within server.drain_timeout, writes held while
+// storage was unavailable are flushed, events still held for retry are
+// handed to the persistent retry queue, and storage is checkpointed and
+// closed.
 func (app *Application) Shutdown() error {
-	app.logger.Get().Info("shutting down alert-bridge")
+	app.logger.Get().Info("shutting down alert-bridge",
+		"drainTimeout", app.config.Server.DrainTimeout,
+	)
+
+	app.drain()
+
+	// Write back what was held while storage was unavailable, now that
+	// drained work has stopped adding to it
+	if app.storageBuffer != nil && app.storageBuffer.Status().Degraded {
+		flushCtx, cancel := context.WithTimeout(context.Background(), storageFlushTimeout)
+		if err := app.storageBuffer.Flush(flushCtx); err != nil {
+			app.logger.Get().Error("storage still unavailable on shutdown; buffered writes are lost",
+				"buffered", app.storageBuffer.Status().Buffered,
+				"error", err,
+			)
+		}
+		cancel()
+	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
+	// Keep throttled PagerDuty events across the restart
+	if app.clients != nil && app.clients.PagerDutyQueue != nil {
+		if queued := app.clients.PagerDutyQueue.Len(); queued > 0 {
+			flushed := app.clients.PagerDutyQueue.Flush(ctx)
+			app.logger.Get().Info("handed queued pagerduty events to the retry queue",
+				"queued", queued,
+				"flushed", flushed,
+			)
+		}
+	}
+
 	// Shutdown telemetry
 	if app.telemetry != nil {
 		if err := app.telemetry.Shutdown(ctx); err != nil {
@@ -76,6 +192,18 @@ func (app *Application) Shutdown() error {
 		}
 	}
 
+	// Release pooled outbound connections
+	if app.clients != nil && app.clients.HTTP != nil {
+		app.clients.HTTP.CloseIdleConnections()
+	}
+
+	// Fold the write-ahead log into the database file
+	if checkpointer, ok := app.dbCloser.(dbCheckpointer); ok {
+		if err := checkpointer.Checkpoint(ctx); err != nil {
+			app.logger.Get().Warn("failed to checkpoint database", "error", err)
+		}
+	}
+
 	// Close database
 	if app.dbCloser != nil {
 		if err := app.dbCloser.Close(); err != nil {
@@ -87,3 +215,31 @@ func (app *Application) Shutdown() error {
 	app.logger.Get().Info("alert-bridge stopped")
 	return nil
 }
+
+// drain waits for webhook payloads and Slack clicks already accepted, and
+// the alert work queued on shards, to finish while storage is still open.
+// Whatever is left after server.drain_timeout is abandoned.
+func (app *Application) drain() {
+	ctx, cancel := context.WithTimeout(context.Background(), app.config.Server.DrainTimeout)
+	defer cancel()
+
+	start := time.Now()
+	if app.ingestQueue != nil {
+		if err := app.ingestQueue.Wait(ctx); err != nil {
+			app.logger.Get().Error("queued webhook payloads left unprocessed", "error", err)
+		}
+	}
+	if app.interactionQueue != nil {
+		if err := app.interactionQueue.Wait(ctx); err != nil {
+			app.logger.Get().Error("queued Slack interactions left unhandled", "error", err)
+		}
+	}
+	if app.useCases != nil && app.useCases.Shards != nil {
+		if err := app.useCases.Shards.Wait(ctx); err != nil {
+			app.logger.Get().Error("queued alert work left unprocessed", "error", err)
+		}
+	}
+	if ctx.Err() == nil {
+		app.logger.Get().Info("in-flight work drained", "duration", time.Since(start).Round(time.Millisecond))
+	}
+}
@@ -40,12 +40,17 @@ func (app *Application) bootstrap(configPath string) error {
 		return fmt.Errorf("initializing use cases: %w", err)
 	}
 
-	// 8. Initialize HTTP handlers
+	// 8. Initialize scheduled reports
+	if err := app.initializeReports(); err != nil {
+		return fmt.Errorf("initializing reports: %w", err)
+	}
+
+	// 9. Initialize HTTP handlers
 	if err := app.initializeHandlers(); err != nil {
 		return fmt.Errorf("initializing handlers: %w", err)
 	}
 
-	// 9. Setup HTTP server
+	// 10. Setup HTTP server
 	if err := app.setupServer(); err != nil {
 		return fmt.Errorf("setting up server: %w", err)
 	}
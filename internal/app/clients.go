@@ -1,8 +1,26 @@
 package app
 
 import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/alertmanager"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/clockskew"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/cloudevents"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/cloudmeta"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/config"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/email"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/enrichment"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/firehydrant"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/httpclient"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/incidentio"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/notifytemplate"
 	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/pagerduty"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/payloadlog"
 	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/slack"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/teams"
 	"github.com/altuslabsxyz/alert-bridge/internal/usecase/ack"
 	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
 )
@@ -13,6 +31,59 @@ type Clients struct {
 	Syncers   []ack.AckSyncer
 	Slack     *slack.Client
 	PagerDuty *pagerduty.Client
+	Teams     *teams.Client
+	Email     *email.Client
+
+	// SlackActions are the custom buttons on alert messages; nil when none
+	// are configured.
+	SlackActions *slack.CustomActions
+
+	// Canary mirrors a sample of alerts to the shadow channel; nil when
+	// disabled.
+	Canary *alert.CanaryNotifier
+
+	// CanaryTemplates are the shadow channel's templates, reloaded with
+	// the config; nil when the canary is disabled.
+	CanaryTemplates *notifytemplate.Set
+
+	// SlackReposter re-posts deleted Slack messages; nil when disabled.
+	SlackReposter *alert.RepostingNotifier
+
+	// PagerDutyQueue holds throttled PagerDuty events; nil when disabled.
+	PagerDutyQueue *alert.PagerDutySendQueue
+
+	// NotificationLimiter caps new alerts per notifier; nil when disabled.
+	NotificationLimiter *alert.NotificationLimiter
+
+	// HTTP provides the pooled HTTP clients of the integrations.
+	HTTP *httpclient.Factory
+
+	// PayloadLog records outbound payloads; nil when disabled.
+	PayloadLog *payloadlog.Recorder
+
+	// CloudMetadata enriches alerts with instance metadata; nil when disabled.
+	CloudMetadata *cloudmeta.Enricher
+
+	// Enrichment calls the enrichment webhooks; nil when none are configured.
+	Enrichment *enrichment.Enricher
+
+	// FireHydrant and IncidentIO post alert events to the timelines of
+	// linked incidents; nil when disabled.
+	FireHydrant *firehydrant.Client
+	IncidentIO  *incidentio.Client
+
+	// ClockSkew checks the host clock for drift; nil when disabled.
+	ClockSkew *clockskew.Checker
+
+	// Users maps people between Slack, PagerDuty and email; nil when
+	// disabled.
+	Users *ack.UserDirectory
+
+	// Alertmanager mirrors Slack silences; nil when disabled.
+	Alertmanager *alertmanager.Client
+
+	// Templates customize notification bodies, reloaded with the config.
+	Templates *notifytemplate.Set
 }
 
 func (app *Application) initializeClients() error {
@@ -24,6 +95,28 @@ func (app *Application) initializeClients() error {
 	logger := &slogAdapter{logger: app.logger.Get()}
 	retryPolicy := alert.DefaultRetryPolicy()
 
+	outbound := app.config.OutboundHTTP
+	app.clients.HTTP = httpclient.NewFactory(httpclient.Config{
+		MaxIdleConns:        outbound.MaxIdleConns,
+		MaxIdleConnsPerHost: outbound.MaxIdleConnsPerHost,
+		IdleConnTimeout:     outbound.IdleConnTimeout,
+		DisableHTTP2:        outbound.DisableHTTP2,
+		DNSCacheTTL:         outbound.DNSCacheTTL,
+	}, app.telemetry.Metrics)
+
+	templates, err := notifytemplate.Load(app.config.Templates.Dir, app.config.Templates.Inline())
+	if err != nil {
+		return fmt.Errorf("loading notification templates: %w", err)
+	}
+	app.clients.Templates = templates
+
+	if app.config.IsPayloadLogEnabled() {
+		app.clients.PayloadLog = payloadlog.NewRecorder(app.config.PayloadLog.Size, app.config.Secrets()...)
+		app.logger.Get().Info("outbound payload recording enabled",
+			"size", app.config.PayloadLog.Size,
+		)
+	}
+
 	if app.config.IsSlackEnabled() {
 		app.clients.Slack = slack.NewClient(
 			app.config.Slack.BotToken,
@@ -31,17 +124,105 @@ func (app *Application) initializeClients() error {
 			app.config.Alerting.SilenceDurations,
 			app.config.Slack.APIURL, // Optional: for E2E testing
 		)
+		app.clients.Slack.SetHTTPClient(app.clients.HTTP.Client("slack", 30*time.Second))
+		app.clients.Slack.SetRecorder(app.clients.PayloadLog)
+		app.clients.Slack.SetTemplates(app.clients.Templates)
+		if len(app.config.Slack.Channels) > 0 {
+			app.clients.Slack.SetLabelChannels(app.config.Slack.ChannelLabel, app.config.Slack.Channels)
+		}
+		if app.config.Slack.FallbackTemplate != "" {
+			if err := app.clients.Slack.SetFallbackTemplate(app.config.Slack.FallbackTemplate); err != nil {
+				return err
+			}
+		}
+		if len(app.config.Slack.Actions) > 0 {
+			actions, err := newSlackActions(app.config.Slack.Actions)
+			if err != nil {
+				return err
+			}
+			app.clients.SlackActions = slack.NewCustomActions(actions, app.clients.HTTP.Client("slack-actions", 30*time.Second))
+			app.clients.Slack.SetCustomActions(app.clients.SlackActions)
+			app.logger.Get().Info("slack custom actions enabled",
+				"actions", len(actions),
+			)
+		}
+		if app.config.IsSlackRateLimitEnabled() {
+			limit := app.config.Slack.RateLimit
+			app.clients.Slack.SetRateLimiter(slack.NewChannelRateLimiter(limit.PerSecond, limit.Burst, app.telemetry.Metrics))
+			app.logger.Get().Info("slack channel rate limit enabled",
+				"per_second", limit.PerSecond,
+				"burst", limit.Burst,
+			)
+		}
+
+		if app.config.Slack.DuplicateCheckWindow > 0 {
+			app.clients.Slack.SetDuplicateCheck(app.config.Slack.DuplicateCheckWindow)
+		}
 
 		// Wrap with retry logic
-		retryableSlack := alert.NewRetryableNotifier(app.clients.Slack, retryPolicy, logger, app.telemetry.Metrics)
-		app.clients.Notifiers = append(app.clients.Notifiers, retryableSlack)
+		var slackNotifier alert.Notifier = alert.NewRetryableNotifier(app.clients.Slack, retryPolicy, logger, app.telemetry.Metrics)
+		if app.config.Slack.RepostDeletedMessages {
+			app.clients.SlackReposter = alert.NewRepostingNotifier(slackNotifier, app.alertRepo, logger)
+			slackNotifier = app.clients.SlackReposter
+		}
+		app.clients.Notifiers = append(app.clients.Notifiers, slackNotifier)
 
 		app.logger.Get().Info("Slack integration enabled",
 			"channel", app.config.Slack.ChannelID,
+			"channelLabel", app.config.Slack.ChannelLabel,
+			"labelChannels", len(app.config.Slack.Channels),
+			"repostDeletedMessages", app.config.Slack.RepostDeletedMessages,
+			"duplicateCheckWindow", app.config.Slack.DuplicateCheckWindow,
 		)
+
+		// Shadow channel for candidate settings; best effort, so no retries
+		if app.config.IsCanaryEnabled() {
+			shadow := slack.NewClient(
+				app.config.Slack.BotToken,
+				app.config.Canary.SlackChannelID,
+				app.config.Canary.SilenceDurations,
+				app.config.Slack.APIURL,
+			)
+			shadow.SetHTTPClient(app.clients.HTTP.Client("slack", 30*time.Second))
+			// Buttons in the shadow channel would act on the live alerts
+			shadow.SetReadOnly(true)
+
+			shadowTemplates, err := notifytemplate.Load(app.config.Templates.Dir, app.config.Canary.Templates(app.config.Templates))
+			if err != nil {
+				return fmt.Errorf("loading canary templates: %w", err)
+			}
+			app.clients.CanaryTemplates = shadowTemplates
+			shadow.SetTemplates(shadowTemplates)
+
+			fallback := app.config.Canary.FallbackTemplate
+			if fallback == "" {
+				fallback = app.config.Slack.FallbackTemplate
+			}
+			if fallback != "" {
+				if err := shadow.SetFallbackTemplate(fallback); err != nil {
+					return err
+				}
+			}
+
+			app.clients.Canary = alert.NewCanaryNotifier(shadow, app.config.Canary.Percent, app.config.Canary.Label)
+			app.clients.Notifiers = append(app.clients.Notifiers, app.clients.Canary)
+
+			app.logger.Get().Info("Slack canary enabled",
+				"channel", app.config.Canary.SlackChannelID,
+				"percent", app.config.Canary.Percent,
+				"label", app.config.Canary.Label,
+				"candidateRoute", app.config.Canary.Route != nil,
+			)
+		}
 	}
 
 	if app.config.IsPagerDutyEnabled() {
+		links := &pagerDutyLinks{
+			slack:        app.clients.Slack,
+			dashboardURL: app.config.PagerDuty.DashboardURL,
+			logger:       logger,
+		}
+
 		app.clients.PagerDuty = pagerduty.NewClient(
 			app.config.PagerDuty.APIToken,
 			app.config.PagerDuty.RoutingKey,
@@ -50,14 +231,301 @@ func (app *Application) initializeClients() error {
 			app.config.PagerDuty.DefaultSeverity,
 			app.config.PagerDuty.APIURL, // Optional: for E2E testing
 		)
+		app.clients.PagerDuty.SetHTTPClient(app.clients.HTTP.Client("pagerduty", 30*time.Second))
+		app.clients.PagerDuty.SetRecorder(app.clients.PayloadLog)
+		app.clients.PagerDuty.SetTemplates(app.clients.Templates)
+		app.clients.PagerDuty.SetLinkProvider(links)
 
 		// Wrap with retry logic
-		retryablePagerDuty := alert.NewRetryableNotifier(app.clients.PagerDuty, retryPolicy, logger, app.telemetry.Metrics)
-		app.clients.Notifiers = append(app.clients.Notifiers, retryablePagerDuty)
-		app.clients.Syncers = append(app.clients.Syncers, app.clients.PagerDuty)
+		var pagerDutyNotifier alert.Notifier = alert.NewRetryableNotifier(app.clients.PagerDuty, retryPolicy, logger, app.telemetry.Metrics)
+		var pagerDutySyncer ack.AckSyncer = app.clients.PagerDuty
+		if app.config.IsPagerDutySendQueueEnabled() {
+			queue := app.config.PagerDuty.SendQueue
+			app.clients.PagerDutyQueue = alert.NewPagerDutySendQueue(pagerDutyNotifier, app.clients.PagerDuty, app.alertRepo, alert.PagerDutyQueuePolicy{
+				Backoff:   queue.Backoff,
+				MaxAge:    queue.MaxAge,
+				ShedBelow: entity.AlertSeverity(queue.ShedBelow),
+			}, logger)
+			pagerDutyNotifier = app.clients.PagerDutyQueue
+			pagerDutySyncer = app.clients.PagerDutyQueue
+		}
+		app.clients.Notifiers = append(app.clients.Notifiers, pagerDutyNotifier)
+		app.clients.Syncers = append(app.clients.Syncers, pagerDutySyncer)
+
+		app.logger.Get().Info("PagerDuty integration enabled",
+			"sendQueue", app.config.IsPagerDutySendQueueEnabled(),
+		)
+
+		// Services that are only paged by escalation policies
+		if app.config.IsEscalationEnabled() {
+			for _, target := range app.config.Escalation.Targets {
+				client := pagerduty.NewClient(
+					app.config.PagerDuty.APIToken,
+					target.PagerDutyRoutingKey,
+					app.config.PagerDuty.ServiceID,
+					app.config.PagerDuty.FromEmail,
+					app.config.PagerDuty.DefaultSeverity,
+					app.config.PagerDuty.APIURL,
+				)
+				client.SetName(target.Name)
+				client.SetHTTPClient(app.clients.HTTP.Client("pagerduty", 30*time.Second))
+				client.SetRecorder(app.clients.PayloadLog)
+				client.SetTemplates(app.clients.Templates)
+				client.SetLinkProvider(links)
+
+				retryable := alert.NewRetryableNotifier(client, retryPolicy, logger, app.telemetry.Metrics)
+				app.clients.Notifiers = append(app.clients.Notifiers, alert.NewEscalationOnlyNotifier(retryable))
+				app.clients.Syncers = append(app.clients.Syncers, client)
+			}
+		}
+	}
+
+	// Optional integrations, which builds can leave out
+	if err := app.initIntegration("teams", app.config.IsTeamsEnabled(), retryPolicy, logger); err != nil {
+		return err
+	}
+	if err := app.initIntegration("email", app.config.IsEmailEnabled(), retryPolicy, logger); err != nil {
+		return err
+	}
+
+	if app.config.IsCloudEventsEmitEnabled() {
+		sinks := make([]cloudevents.Sink, 0, len(app.config.CloudEvents.Sinks))
+		names := make([]string, 0, len(app.config.CloudEvents.Sinks))
+		for _, cfg := range app.config.CloudEvents.Sinks {
+			sinks = append(sinks, cloudevents.Sink{
+				Name:    cfg.Name,
+				URL:     cfg.URL,
+				Mode:    cfg.Mode,
+				Headers: cfg.Headers,
+			})
+			names = append(names, cfg.Name)
+		}
+		client := cloudevents.NewClient(app.config.CloudEvents.Source, sinks...)
+		client.SetHTTPClient(app.clients.HTTP.Client("cloudevents", 30*time.Second))
+		client.SetRecorder(app.clients.PayloadLog)
+		app.clients.Notifiers = append(app.clients.Notifiers, alert.NewRetryableNotifier(client, retryPolicy, logger, app.telemetry.Metrics))
+		app.logger.Get().Info("CloudEvents emission enabled", "sinks", names)
+	}
+
+	if app.config.IsCloudMetadataEnabled() {
+		app.clients.CloudMetadata = app.newCloudMetadataEnricher()
+	}
 
-		app.logger.Get().Info("PagerDuty integration enabled")
+	if app.config.IsEnrichmentEnabled() {
+		app.clients.Enrichment = app.newEnrichmentWebhooks()
+	}
+
+	if app.config.IsFireHydrantEnabled() {
+		app.clients.FireHydrant = firehydrant.NewClient(app.config.FireHydrant.APIURL, app.config.FireHydrant.APIToken)
+		app.clients.FireHydrant.SetHTTPClient(app.clients.HTTP.Client("firehydrant", 30*time.Second))
+		app.logger.Get().Info("FireHydrant integration enabled", "events", app.config.FireHydrant.Events)
+	}
+	if app.config.IsIncidentIOEnabled() {
+		app.clients.IncidentIO = incidentio.NewClient(app.config.IncidentIO.APIURL, app.config.IncidentIO.APIToken)
+		app.clients.IncidentIO.SetHTTPClient(app.clients.HTTP.Client("incidentio", 30*time.Second))
+		app.logger.Get().Info("incident.io integration enabled", "events", app.config.IncidentIO.Events)
+	}
+
+	if app.config.IsUserDirectoryEnabled() {
+		app.clients.Users = app.newUserDirectory(logger)
+	}
+
+	if app.config.IsAlertmanagerSilenceSyncEnabled() {
+		cfg := app.config.Alertmanager.SilenceSync
+		app.clients.Alertmanager = alertmanager.NewClient(cfg.URL, cfg.Username, cfg.Password)
+		app.clients.Alertmanager.SetHTTPClient(app.clients.HTTP.Client("alertmanager", cfg.Timeout))
+		app.logger.Get().Info("alertmanager silence sync enabled",
+			"url", cfg.URL,
+		)
+	}
+
+	if app.config.IsClockSkewEnabled() {
+		cfg := app.config.ClockSkew
+		app.clients.ClockSkew = clockskew.NewChecker(clockskew.Config{
+			URL:            cfg.URL,
+			Interval:       cfg.Interval,
+			WarnThreshold:  cfg.WarnThreshold,
+			WidenTolerance: cfg.WidenTolerance,
+			MaxTolerance:   cfg.MaxTolerance,
+		}, app.clients.HTTP.Client("clockskew", 10*time.Second), app.logger.Get())
+		app.logger.Get().Info("clock skew detection enabled",
+			"url", cfg.URL,
+			"interval", cfg.Interval,
+			"widenTolerance", cfg.WidenTolerance,
+		)
+	}
+
+	if app.config.IsNotificationLimitsEnabled() {
+		app.limitNotifiers()
+	}
+
+	if channelID := app.config.Storage.Degradation.MetaAlertChannelID; app.storageBuffer != nil && channelID != "" && app.clients.Slack != nil {
+		app.storageBuffer.SetMetaAlertPoster(app.clients.Slack, channelID)
 	}
 
 	return nil
 }
+
+// limitNotifiers wraps the notifiers listed in notification_limits, once
+// every notifier is set up.
+func (app *Application) limitNotifiers() {
+	cfg := app.config.NotificationLimits
+	limiter := alert.NewNotificationLimiter(cfg.SummaryInterval, &slogAdapter{logger: app.logger.Get()}, app.telemetry.Metrics)
+	if app.clients.Slack != nil {
+		channelID := cfg.SummaryChannelID
+		if channelID == "" {
+			channelID = app.config.Slack.ChannelID
+		}
+		limiter.SetSummaryPoster(app.clients.Slack, channelID)
+	}
+
+	for i, notifier := range app.clients.Notifiers {
+		limit, ok := cfg.Notifiers[notifier.Name()]
+		if !ok {
+			continue
+		}
+		app.clients.Notifiers[i] = limiter.Wrap(notifier, alert.NotificationLimit{
+			PerSecond: limit.PerSecond,
+			Burst:     limit.Burst,
+			Budget:    limit.Budget,
+			Window:    limit.Window,
+		})
+		app.logger.Get().Info("notification limit enabled",
+			"notifier", notifier.Name(),
+			"perSecond", limit.PerSecond,
+			"burst", limit.Burst,
+			"budget", limit.Budget,
+			"window", limit.Window,
+		)
+	}
+	app.clients.NotificationLimiter = limiter
+}
+
+// newUserDirectory creates the directory of the configured users, looking
+// others up in the enabled integrations, and lets the Slack and PagerDuty
+// clients name users from it.
+func (app *Application) newUserDirectory(logger alert.Logger) *ack.UserDirectory {
+	cfg := app.config.Users
+	users := make([]entity.UserIdentity, len(cfg.Directory))
+	for i, user := range cfg.Directory {
+		users[i] = entity.UserIdentity{
+			Email:           user.Email,
+			Name:            user.Name,
+			SlackUserID:     user.SlackUserID,
+			PagerDutyUserID: user.PagerDutyUserID,
+		}
+	}
+
+	directory := ack.NewUserDirectory(users, cfg.Lookup.CacheTTL, logger)
+	if app.clients.Slack != nil {
+		if cfg.Lookup.Slack {
+			directory.SetSlackLookup(app.clients.Slack)
+		}
+		app.clients.Slack.SetUserDirectory(directory)
+	}
+	if app.clients.PagerDuty != nil {
+		if cfg.Lookup.PagerDuty {
+			directory.SetPagerDutyLookup(app.clients.PagerDuty)
+		}
+		app.clients.PagerDuty.SetUserDirectory(directory)
+	}
+
+	app.logger.Get().Info("user directory enabled",
+		"users", len(users),
+		"slackLookup", cfg.Lookup.Slack,
+		"pagerdutyLookup", cfg.Lookup.PagerDuty,
+		"cacheTTL", cfg.Lookup.CacheTTL,
+	)
+	return directory
+}
+
+// newCloudMetadataEnricher creates the enricher with the enabled providers,
+// AWS first.
+func (app *Application) newCloudMetadataEnricher() *cloudmeta.Enricher {
+	cfg := app.config.CloudMetadata
+	var providers []cloudmeta.Provider
+	if cfg.AWS.Enabled {
+		providers = append(providers, cloudmeta.NewAWSProvider(cloudmeta.AWSConfig{
+			Region:          cfg.AWS.Region,
+			AccessKeyID:     cfg.AWS.AccessKeyID,
+			SecretAccessKey: cfg.AWS.SecretAccessKey,
+			SessionToken:    cfg.AWS.SessionToken,
+			Timeout:         cfg.Timeout,
+		}))
+	}
+	if cfg.GCP.Enabled {
+		providers = append(providers, cloudmeta.NewGCPProvider(cloudmeta.GCPConfig{
+			Project: cfg.GCP.Project,
+			Timeout: cfg.Timeout,
+		}))
+	}
+
+	app.logger.Get().Info("cloud metadata enrichment enabled",
+		"label", cfg.Label,
+		"aws", cfg.AWS.Enabled,
+		"gcp", cfg.GCP.Enabled,
+	)
+	return cloudmeta.NewEnricher(cloudmeta.Config{
+		Label:            cfg.Label,
+		LabelPrefix:      cfg.LabelPrefix,
+		CacheTTL:         cfg.CacheTTL,
+		NegativeCacheTTL: cfg.NegativeCacheTTL,
+	}, providers...)
+}
+
+// newEnrichmentWebhooks creates the enricher calling the configured
+// webhooks. Each request is bounded by the webhook's own timeout.
+func (app *Application) newEnrichmentWebhooks() *enrichment.Enricher {
+	webhooks := make([]enrichment.Webhook, 0, len(app.config.Enrichment.Webhooks))
+	names := make([]string, 0, len(app.config.Enrichment.Webhooks))
+	for _, cfg := range app.config.Enrichment.Webhooks {
+		webhooks = append(webhooks, enrichment.Webhook{
+			Name:        cfg.Name,
+			URL:         cfg.URL,
+			Headers:     cfg.Headers,
+			Timeout:     cfg.Timeout,
+			Match:       cfg.Match,
+			MaxFailures: cfg.MaxFailures,
+			Cooldown:    cfg.Cooldown,
+		})
+		names = append(names, cfg.Name)
+	}
+
+	app.logger.Get().Info("enrichment webhooks enabled", "webhooks", names)
+	return enrichment.NewEnricher(app.clients.HTTP.Client("enrichment", 0), webhooks...)
+}
+
+// newSlackActions converts the custom Slack actions, parsing their URL
+// templates and anchoring match_re patterns to the whole label value.
+func newSlackActions(configs []config.SlackActionConfig) ([]slack.CustomAction, error) {
+	actions := make([]slack.CustomAction, 0, len(configs))
+	for _, cfg := range configs {
+		action := slack.CustomAction{
+			Name:    cfg.Name,
+			Label:   cfg.Label,
+			Style:   cfg.Style,
+			Webhook: cfg.Webhook,
+			Confirm: cfg.Confirm,
+			Match:   cfg.Match,
+		}
+		if cfg.URL != "" {
+			tmpl, err := slack.ParseCustomActionURL(cfg.URL)
+			if err != nil {
+				return nil, fmt.Errorf("parsing url of slack action %q: %w", cfg.Name, err)
+			}
+			action.URL = tmpl
+		}
+		if len(cfg.MatchRE) > 0 {
+			action.MatchRE = make(map[string]*regexp.Regexp, len(cfg.MatchRE))
+			for name, pattern := range cfg.MatchRE {
+				re, err := regexp.Compile("^(?:" + pattern + ")$")
+				if err != nil {
+					return nil, fmt.Errorf("compiling match_re for slack action %q: %w", cfg.Name, err)
+				}
+				action.MatchRE[name] = re
+			}
+		}
+		actions = append(actions, action)
+	}
+	return actions, nil
+}
@@ -0,0 +1,48 @@
+//go:build !noemail
+
+package app
+
+import (
+	"fmt"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/email"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
+)
+
+func init() {
+	registerIntegration("email", initEmail)
+}
+
+func initEmail(app *Application, retryPolicy alert.RetryPolicy, logger alert.Logger) error {
+	recipients := make(map[entity.AlertSeverity][]string, len(app.config.Email.Recipients))
+	for severity, addrs := range app.config.Email.Recipients {
+		recipients[entity.AlertSeverity(severity)] = addrs
+	}
+
+	emailClient, err := email.NewClient(email.Config{
+		Host:              app.config.Email.SMTPHost,
+		Port:              app.config.Email.SMTPPort,
+		Username:          app.config.Email.Username,
+		Password:          app.config.Email.Password,
+		From:              app.config.Email.From,
+		Recipients:        recipients,
+		DefaultRecipients: app.config.Email.DefaultRecipients,
+		Timeout:           app.config.Email.Timeout,
+	})
+	if err != nil {
+		return fmt.Errorf("creating email client: %w", err)
+	}
+	emailClient.SetRecorder(app.clients.PayloadLog)
+	emailClient.SetTemplates(app.clients.Templates)
+	app.clients.Email = emailClient
+
+	// Wrap with retry logic
+	retryableEmail := alert.NewRetryableNotifier(app.clients.Email, retryPolicy, logger, app.telemetry.Metrics)
+	app.clients.Notifiers = append(app.clients.Notifiers, retryableEmail)
+
+	app.logger.Get().Info("Email integration enabled",
+		"smtp_host", app.config.Email.SMTPHost,
+	)
+	return nil
+}
@@ -0,0 +1,39 @@
+//go:build !noteams
+
+package app
+
+import (
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/teams"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
+)
+
+func init() {
+	registerIntegration("teams", initTeams)
+}
+
+func initTeams(app *Application, retryPolicy alert.RetryPolicy, logger alert.Logger) error {
+	// Ack buttons need a public URL to link back to
+	var signer *teams.ActionSigner
+	if app.config.Teams.PublicURL != "" {
+		signer = teams.NewActionSigner(
+			app.config.Teams.PublicURL,
+			app.config.Teams.SigningSecret,
+			app.config.Teams.ActionLinkTTL,
+		)
+	}
+	app.clients.Teams = teams.NewClient(app.config.Teams.WebhookURL, signer)
+	app.clients.Teams.SetHTTPClient(app.clients.HTTP.Client("teams", 10*time.Second))
+	app.clients.Teams.SetRecorder(app.clients.PayloadLog)
+
+	// Wrap with retry logic
+	retryableTeams := alert.NewRetryableNotifier(app.clients.Teams, retryPolicy, logger, app.telemetry.Metrics)
+	app.clients.Notifiers = append(app.clients.Notifiers, retryableTeams)
+	app.clients.Syncers = append(app.clients.Syncers, app.clients.Teams)
+
+	app.logger.Get().Info("Teams integration enabled",
+		"ack_buttons", signer != nil,
+	)
+	return nil
+}
@@ -2,6 +2,7 @@ package app
 
 import (
 	"fmt"
+	"log/slog"
 
 	"github.com/spf13/viper"
 
@@ -29,12 +30,29 @@ func (app *Application) setupConfigManager(configPath string) error {
 
 	// Setup reload callback for logger
 	app.configManager.SetReloadCallback(func(newCfg *config.Config) {
-		newLogger := createLogger(newCfg.Logging.Level, newCfg.Logging.Format)
+		newLogger := createLogger(newCfg.Logging.Level, newCfg.Logging.Format, newCfg.Secrets())
 		app.logger.Set(newLogger)
+		slog.SetDefault(newLogger)
 		app.logger.Get().Info("logger reloaded",
 			"level", newCfg.Logging.Level,
 			"format", newCfg.Logging.Format,
 		)
+
+		// Clients and handlers are created after the config manager
+		if app.severities != nil {
+			app.severities.Reload(newCfg.SeverityMapping)
+		}
+		if app.clients != nil && app.clients.Templates != nil {
+			// Also picks up edits to the files of the templates directory
+			if err := app.clients.Templates.Reload(newCfg.Templates.Dir, newCfg.Templates.Inline()); err != nil {
+				app.logger.Get().Error("failed to reload notification templates", "error", err)
+			}
+		}
+		if app.clients != nil && app.clients.CanaryTemplates != nil {
+			if err := app.clients.CanaryTemplates.Reload(newCfg.Templates.Dir, newCfg.Canary.Templates(newCfg.Templates)); err != nil {
+				app.logger.Get().Error("failed to reload canary templates", "error", err)
+			}
+		}
 	})
 
 	return nil
@@ -2,11 +2,19 @@ package app
 
 import (
 	"fmt"
+	"time"
 
+	"github.com/altuslabsxyz/alert-bridge/internal/adapter/dto"
 	"github.com/altuslabsxyz/alert-bridge/internal/adapter/handler"
+	"github.com/altuslabsxyz/alert-bridge/internal/adapter/handler/middleware"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
 	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/server"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/sns"
+	apiUseCase "github.com/altuslabsxyz/alert-bridge/internal/usecase/api"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/incident"
 	pdUseCase "github.com/altuslabsxyz/alert-bridge/internal/usecase/pagerduty"
 	slackUseCase "github.com/altuslabsxyz/alert-bridge/internal/usecase/slack"
+	teamsUseCase "github.com/altuslabsxyz/alert-bridge/internal/usecase/teams"
 )
 
 func (app *Application) initializeHandlers() error {
@@ -22,14 +30,157 @@ func (app *Application) initializeHandlers() error {
 		Health:  handler.NewHealthHandler(),
 		Ready:   readyHandler,
 		Reload:  handler.NewReloadHandler(app.configManager, logger),
-		Metrics: handler.NewMetricsHandler(),
+		Metrics: handler.NewMetricsHandler(app.telemetry.Handler()),
 	}
 
+	// REST alert API
+	manageAlertsUC := apiUseCase.NewManageAlertsUseCase(
+		app.alertRepo,
+		app.useCases.SyncAck,
+		app.clients.Notifiers,
+		logger,
+	)
+	manageAlertsUC.SetTimeline(app.useCases.Timeline)
+	manageAlertsUC.SetSavedViewRepository(app.savedViewRepo)
+	createAlertUC := apiUseCase.NewCreateAlertUseCase(app.useCases.ProcessAlert, app.alertRepo, logger)
+	app.handlers.AlertsAPI = handler.NewAlertsAPIHandler(manageAlertsUC, createAlertUC, logger)
+	app.handlers.AlertHistory = handler.NewAlertHistoryHandler(app.useCases.QueryActiveAt, logger)
+	manageSilencesUC := apiUseCase.NewManageSilencesUseCase(app.silenceRepo, logger)
+	manageSilencesUC.SetSilenceSync(app.useCases.SilenceSync)
+	app.handlers.SilencesAPI = handler.NewSilencesAPIHandler(manageSilencesUC, logger)
+
+	app.handlers.OnCallLoadAPI = handler.NewOnCallLoadAPIHandler(app.useCases.OnCallLoad, logger)
+	app.handlers.ResponseTimesAPI = handler.NewResponseTimesAPIHandler(app.useCases.ResponseTimes, logger)
+
+	// Dead-lettered notifications, recoverable once the retry queue is on
+	var manageDeadLettersUC *apiUseCase.ManageDeadLettersUseCase
+	if app.useCases.RetryQueue != nil {
+		manageDeadLettersUC = apiUseCase.NewManageDeadLettersUseCase(app.retryRepo, logger)
+		app.handlers.DeadLettersAPI = handler.NewDeadLettersAPIHandler(manageDeadLettersUC, logger)
+	}
+
+	// Outbound payload inspection
+	if app.clients.PayloadLog != nil {
+		app.handlers.PayloadLog = handler.NewPayloadLogHandler(app.clients.PayloadLog)
+	}
+
+	// Delivery SLO compliance
+	if app.useCases.DeliverySLO != nil {
+		app.handlers.DeliverySLO = handler.NewDeliverySLOHandler(app.useCases.DeliverySLO)
+	}
+
+	// Live state export, for switching storage backends
+	app.handlers.StateExport = handler.NewStateExportHandler(app.newTransferStateUseCase(), logger)
+
+	// Admin switch to pause ingestion from a misbehaving source
+	app.handlers.IngestionPause = handler.NewIngestionPauseHandler(logger)
+	app.handlers.IngestionPause.SetMetrics(app.telemetry.Metrics)
+
+	// Ingestion handlers rename alert aliases to their canonical names
+	alertNames := dto.NewAlertNameMap(app.config.AlertNames)
+	app.severities = dto.NewSeverityMap(app.config.SeverityMapping)
+
 	// Alertmanager handler
 	app.handlers.Alertmanager = handler.NewAlertmanagerHandler(
 		app.useCases.ProcessAlert,
 		logger,
 	)
+	app.handlers.Alertmanager.SetMetrics(app.telemetry.Metrics)
+	app.handlers.Alertmanager.SetFingerprintStrategy(dto.NewFingerprintStrategy(app.config.Alertmanager.Fingerprinting))
+	app.handlers.Alertmanager.SetAlertNames(alertNames)
+	app.handlers.Alertmanager.SetSeverityMap(app.severities)
+	if async := app.config.Alertmanager.Async; async.Enabled {
+		app.ingestQueue = handler.NewIngestQueue(async.Workers, async.QueueSize, logger, app.telemetry.Metrics)
+		app.handlers.Alertmanager.SetQueue(app.ingestQueue)
+		app.logger.Get().Info("asynchronous alertmanager ingestion enabled",
+			"workers", async.Workers,
+			"queueSize", async.QueueSize,
+		)
+	}
+
+	// Grafana handler
+	app.handlers.Grafana = handler.NewGrafanaHandler(
+		app.useCases.ProcessAlert,
+		logger,
+	)
+	app.handlers.Grafana.SetMetrics(app.telemetry.Metrics)
+	app.handlers.Grafana.SetFingerprintStrategy(dto.NewFingerprintStrategy(app.config.Grafana.Fingerprinting))
+	app.handlers.Grafana.SetAlertNames(alertNames)
+	app.handlers.Grafana.SetSeverityMap(app.severities)
+
+	// CloudWatch handler (if enabled)
+	if app.config.IsCloudWatchEnabled() {
+		app.handlers.CloudWatch = handler.NewCloudWatchHandler(
+			app.useCases.ProcessAlert,
+			sns.NewVerifier(),
+			entity.AlertSeverity(app.config.CloudWatch.Severity),
+			app.config.CloudWatch.TopicARNs,
+			logger,
+		)
+		app.handlers.CloudWatch.SetMetrics(app.telemetry.Metrics)
+		app.handlers.CloudWatch.SetFingerprintStrategy(dto.NewFingerprintStrategy(app.config.CloudWatch.Fingerprinting))
+		app.handlers.CloudWatch.SetAlertNames(alertNames)
+	}
+
+	// Generic JSON webhook sources (if configured)
+	if len(app.config.GenericWebhooks) > 0 {
+		sources := make([]handler.GenericSource, 0, len(app.config.GenericWebhooks))
+		for _, cfg := range app.config.GenericWebhooks {
+			mapping, err := dto.NewGenericMapping(cfg)
+			if err != nil {
+				return fmt.Errorf("generic webhook %q: %w", cfg.Name, err)
+			}
+			sources = append(sources, handler.GenericSource{
+				Name:        cfg.Name,
+				Token:       cfg.Token,
+				Mapping:     mapping,
+				Fingerprint: dto.NewFingerprintStrategy(cfg.Fingerprinting),
+			})
+		}
+		app.handlers.Generic = handler.NewGenericWebhookHandler(app.useCases.ProcessAlert, sources, logger)
+		app.handlers.Generic.SetMetrics(app.telemetry.Metrics)
+		app.handlers.Generic.SetAlertNames(alertNames)
+	}
+
+	// NDJSON batch ingestion (if enabled)
+	if app.config.IsBatchIngestEnabled() {
+		app.handlers.BatchIngest = handler.NewBatchIngestHandler(
+			app.useCases.ProcessAlert,
+			app.config.BatchIngest.Token,
+			app.config.BatchIngest.MaxLineBytes,
+			app.config.BatchIngest.MaxItems,
+			logger,
+		)
+		app.handlers.BatchIngest.SetMetrics(app.telemetry.Metrics)
+		app.handlers.BatchIngest.SetFingerprintStrategy(dto.NewFingerprintStrategy(app.config.BatchIngest.Fingerprinting))
+		app.handlers.BatchIngest.SetAlertNames(alertNames)
+		app.handlers.BatchIngest.SetSeverityMap(app.severities)
+	}
+
+	// CloudEvents ingestion (if enabled)
+	if app.config.IsCloudEventsIngestEnabled() {
+		app.handlers.CloudEvents = handler.NewCloudEventsHandler(
+			app.useCases.ProcessAlert,
+			app.config.CloudEvents.Ingest.Token,
+			app.config.CloudEvents.Ingest.MaxEvents,
+			logger,
+		)
+		app.handlers.CloudEvents.SetMetrics(app.telemetry.Metrics)
+		app.handlers.CloudEvents.SetFingerprintStrategy(dto.NewFingerprintStrategy(app.config.CloudEvents.Ingest.Fingerprinting))
+		app.handlers.CloudEvents.SetAlertNames(alertNames)
+		app.handlers.CloudEvents.SetSeverityMap(app.severities)
+	}
+
+	// Sentry handler (if enabled)
+	if app.config.IsSentryEnabled() {
+		app.handlers.Sentry = handler.NewSentryHandler(
+			app.useCases.ProcessAlert,
+			logger,
+		)
+		app.handlers.Sentry.SetMetrics(app.telemetry.Metrics)
+		app.handlers.Sentry.SetFingerprintStrategy(dto.NewFingerprintStrategy(app.config.Sentry.Fingerprinting))
+		app.handlers.Sentry.SetAlertNames(alertNames)
+	}
 
 	// Slack handlers (if enabled)
 	if app.config.IsSlackEnabled() {
@@ -38,19 +189,30 @@ func (app *Application) initializeHandlers() error {
 		)
 		summarizeAlertsUC := slackUseCase.NewSummarizeAlertsUseCase(
 			app.alertRepo,
+			app.useCases.ResponseTimes,
 		)
 		manageSilenceUC := slackUseCase.NewManageSilenceUseCase(
 			app.silenceRepo,
 			app.alertRepo,
 			app.clients.Slack,
 		)
+		manageSilenceUC.SetSilenceSync(app.useCases.SilenceSync)
+
+		queryAlertStatusUC.SetSavedViewRepository(app.savedViewRepo)
+		manageViewsUC := slackUseCase.NewManageViewsUseCase(app.savedViewRepo)
 
 		app.handlers.SlackCommands = handler.NewSlackCommandsHandler(
 			queryAlertStatusUC,
 			summarizeAlertsUC,
 			manageSilenceUC,
+			manageViewsUC,
 			app.logger.Get(),
 		)
+		app.handlers.SlackCommands.SetSearchAlertsUseCase(slackUseCase.NewSearchAlertsUseCase(app.alertRepo))
+		app.handlers.SlackCommands.SetRaiseAlertUseCase(slackUseCase.NewRaiseAlertUseCase(createAlertUC))
+		if manageDeadLettersUC != nil {
+			app.handlers.SlackCommands.SetDeadLettersUseCase(slackUseCase.NewManageDeadLettersUseCase(manageDeadLettersUC))
+		}
 
 		handleSlackInteractionUC := slackUseCase.NewHandleInteractionUseCase(
 			app.alertRepo,
@@ -59,10 +221,27 @@ func (app *Application) initializeHandlers() error {
 			app.clients.Slack,
 			logger,
 		)
+		handleSlackInteractionUC.SetTagAlertUseCase(app.useCases.TagAlert)
+		handleSlackInteractionUC.SetAssignAlertUseCase(app.useCases.AssignAlert)
+		handleSlackInteractionUC.SetResolveUseCase(manageAlertsUC)
+		handleSlackInteractionUC.SetTimeline(app.useCases.Timeline)
+		handleSlackInteractionUC.SetSilenceSync(app.useCases.SilenceSync)
+		if app.clients.SlackActions != nil {
+			handleSlackInteractionUC.SetCustomActions(app.clients.SlackActions)
+		}
 		app.handlers.SlackInteraction = handler.NewSlackInteractionHandler(
 			handleSlackInteractionUC,
 			logger,
 		)
+		app.handlers.SlackInteraction.SetCommandsHandler(app.handlers.SlackCommands)
+		if async := app.config.Slack.AsyncInteractions; async.Enabled {
+			app.interactionQueue = handler.NewIngestQueue(async.Workers, async.QueueSize, logger, app.telemetry.Metrics)
+			app.handlers.SlackInteraction.SetQueue(app.interactionQueue)
+			app.logger.Get().Info("asynchronous Slack interactions enabled",
+				"workers", async.Workers,
+				"queueSize", async.QueueSize,
+			)
+		}
 		app.handlers.SlackEvents = handler.NewSlackEventsHandler(
 			logger,
 		)
@@ -70,29 +249,112 @@ func (app *Application) initializeHandlers() error {
 
 	// PagerDuty handler (if enabled)
 	if app.config.IsPagerDutyEnabled() {
+		var slackUpdater pdUseCase.MessageUpdater = app.clients.Slack
+		if app.clients.SlackReposter != nil {
+			slackUpdater = app.clients.SlackReposter
+		}
 		handlePDWebhookUC := pdUseCase.NewHandleWebhookUseCase(
 			app.alertRepo,
 			app.useCases.SyncAck,
-			app.clients.Slack,
+			slackUpdater,
 			logger,
 		)
+		handlePDWebhookUC.SetTimeline(app.useCases.Timeline)
+		handlePDWebhookUC.SetUserDirectory(app.clients.Users)
 		app.handlers.PagerDutyWebhook = handler.NewPagerDutyWebhookHandler(
 			handlePDWebhookUC,
 			logger,
 		)
+		app.handlers.PagerDutyWebhook.SetMetrics(app.telemetry.Metrics)
 	}
 
+	// Teams handler (only needed when cards carry ack links)
+	if app.config.IsTeamsEnabled() && app.config.Teams.PublicURL != "" {
+		handleTeamsInteractionUC := teamsUseCase.NewHandleInteractionUseCase(
+			app.useCases.SyncAck,
+			app.clients.Teams,
+			logger,
+		)
+		app.handlers.TeamsInteraction = handler.NewTeamsInteractionHandler(
+			handleTeamsInteractionUC,
+			logger,
+		)
+	}
+
+	// Incident management tools (if any is enabled)
+	if app.useCases.Incidents != nil {
+		syncIncidentsUC := incident.NewSyncUseCase(
+			app.alertRepo,
+			app.useCases.SyncAck,
+			app.clients.Notifiers,
+			app.useCases.Incidents,
+			logger,
+		)
+		syncIncidentsUC.SetTimeline(app.useCases.Timeline)
+		app.handlers.Incidents = handler.NewIncidentsHandler(syncIncidentsUC, logger)
+	}
+
+	app.handlers.IntegrationsAPI = handler.NewIntegrationsAPIHandler(app.listIntegrations())
+
 	return nil
 }
 
+// listIntegrations describes the notifiers, syncers and ingestion handlers
+// set up above.
+func (app *Application) listIntegrations() *apiUseCase.ListIntegrationsUseCase {
+	uc := apiUseCase.NewListIntegrationsUseCase(app.clients.Notifiers, app.clients.Syncers)
+
+	ingestors := []struct {
+		name    string
+		enabled bool
+		acks    bool
+	}{
+		{"alertmanager", app.handlers.Alertmanager != nil, false},
+		{"grafana", app.handlers.Grafana != nil, false},
+		{"cloudwatch", app.handlers.CloudWatch != nil, false},
+		{"sentry", app.handlers.Sentry != nil, false},
+		{"batch", app.handlers.BatchIngest != nil, false},
+		{"cloudevents", app.handlers.CloudEvents != nil, false},
+		{"slack", app.handlers.SlackInteraction != nil, true},
+		{"pagerduty", app.handlers.PagerDutyWebhook != nil, true},
+		{"teams", app.handlers.TeamsInteraction != nil, true},
+		{"firehydrant", app.clients.FireHydrant != nil && app.config.FireHydrant.WebhookSecret != "", true},
+		{"incidentio", app.clients.IncidentIO != nil && app.config.IncidentIO.WebhookSecret != "", true},
+	}
+	for _, ingestor := range ingestors {
+		if ingestor.enabled {
+			uc.AddIngestor(ingestor.name, ingestor.acks)
+		}
+	}
+	if app.handlers.Generic != nil {
+		for _, cfg := range app.config.GenericWebhooks {
+			uc.AddIngestor("generic/"+cfg.Name, false)
+		}
+	}
+	return uc
+}
+
 func (app *Application) setupServer() error {
 	routerConfig := &server.RouterConfig{
 		ConfigManager:             app.configManager, // Enable hot-reload
 		AlertmanagerWebhookSecret: app.config.Alertmanager.WebhookSecret,
+		GrafanaWebhookToken:       app.config.Grafana.WebhookToken,
+		SentryClientSecret:        app.config.Sentry.ClientSecret,
 		SlackSigningSecret:        app.config.Slack.SigningSecret,
 		PagerDutyWebhookSecret:    app.config.PagerDuty.WebhookSecret,
+		TeamsSigningSecret:        app.config.Teams.SigningSecret,
+		FireHydrantWebhookSecret:  app.config.FireHydrant.WebhookSecret,
+		IncidentIOWebhookSecret:   app.config.IncidentIO.WebhookSecret,
+		APIAuth:                   app.config.APIAuth,
 		RequestTimeout:            app.config.Server.RequestTimeout,
+		MaxDecompressedBodyBytes:  app.config.Server.MaxDecompressedBodyBytes,
 		Metrics:                   app.telemetry.Metrics,
+		SlackSocketMode:           app.config.IsSlackSocketMode(),
+	}
+	if checker := app.clients.ClockSkew; checker != nil {
+		routerConfig.SlackTimestampTolerance = func() time.Duration {
+			return checker.Tolerance(middleware.SlackTimestampTolerance)
+		}
 	}
 	router := server.NewRouterWithConfig(app.handlers, app.logger.Get(), routerConfig)
 	srv, err := server.New(*app.config, router, app.logger.Get())
@@ -100,11 +362,20 @@ func (app *Application) setupServer() error {
 		return fmt.Errorf("failed to create server: %w", err)
 	}
 
+	// Slash commands and interactions arrive over the Socket Mode connection
+	if client := srv.SocketModeClient(); client != nil {
+		handler.NewSocketModeHandler(
+			app.handlers.SlackCommands,
+			app.handlers.SlackInteraction,
+			&slogAdapter{logger: app.logger.Get()},
+		).Register(client)
+	}
+
 	// Configure health check to report Slack status
 	if app.config.IsSlackEnabled() && app.handlers.Health != nil {
 		app.handlers.Health.SetSlackStatus(
 			true,
-			app.config.Slack.SocketMode.Enabled,
+			app.config.IsSlackSocketMode(),
 			srv.SocketModeClient(),
 		)
 	}
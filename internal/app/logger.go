@@ -4,6 +4,8 @@ import (
 	"log/slog"
 	"os"
 	"sync/atomic"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/logging"
 )
 
 // AtomicLogger provides thread-safe logger access for hot reload
@@ -30,12 +32,16 @@ func (al *AtomicLogger) Set(logger *slog.Logger) {
 
 // setupLogger creates the initial logger
 func (app *Application) setupLogger() error {
-	logger := createLogger(app.config.Logging.Level, app.config.Logging.Format)
+	logger := createLogger(app.config.Logging.Level, app.config.Logging.Format, app.config.Secrets())
 	app.logger = NewAtomicLogger(logger)
+	// Route the standard log package, used by some clients, through the
+	// same redaction
+	slog.SetDefault(logger)
 	return nil
 }
 
-func createLogger(level, format string) *slog.Logger {
+// createLogger builds a logger that redacts secrets from every record.
+func createLogger(level, format string, secrets []string) *slog.Logger {
 	var logLevel slog.Level
 	switch level {
 	case "debug":
@@ -59,5 +65,5 @@ func createLogger(level, format string) *slog.Logger {
 		handler = slog.NewTextHandler(os.Stdout, opts)
 	}
 
-	return slog.New(handler)
+	return slog.New(logging.NewRedactingHandler(handler, secrets...))
 }
@@ -0,0 +1,45 @@
+package app
+
+import (
+	"context"
+	"strings"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/pagerduty"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/slack"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
+)
+
+// pagerDutyLinks links PagerDuty incidents to the alert's Slack message and
+// dashboard page, so responders on mobile can jump to the discussion.
+type pagerDutyLinks struct {
+	slack        *slack.Client
+	dashboardURL string
+	logger       alert.Logger
+}
+
+// AlertLinks returns the alert's links. The Slack link is left out when the
+// alert has not been posted to Slack, e.g. when routed to PagerDuty only.
+func (p *pagerDutyLinks) AlertLinks(ctx context.Context, a *entity.Alert) []pagerduty.Link {
+	var links []pagerduty.Link
+
+	if messageID := a.GetExternalReference("slack"); p.slack != nil && messageID != "" {
+		permalink, err := p.slack.Permalink(ctx, messageID)
+		if err != nil {
+			p.logger.Warn("failed to get slack permalink for pagerduty link",
+				"alertID", a.ID,
+				"messageID", messageID,
+				"error", err,
+			)
+		} else {
+			links = append(links, pagerduty.Link{Href: permalink, Text: "Slack thread"})
+		}
+	}
+
+	if p.dashboardURL != "" {
+		href := strings.NewReplacer("{alert_id}", a.ID, "{fingerprint}", a.Fingerprint).Replace(p.dashboardURL)
+		links = append(links, pagerduty.Link{Href: href, Text: "alert-bridge"})
+	}
+
+	return links
+}
@@ -0,0 +1,70 @@
+package app
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
+)
+
+// Optional storage backends and integrations register themselves from files
+// that a build tag excludes (nomysql, noredis, nosqlite, noteams, noemail),
+// so a minimal binary does not link their drivers. Enabling one that was
+// compiled out fails at startup with an error naming the tag.
+
+// storageBackend sets up the application's repositories and returns what
+// closes them, if anything.
+type storageBackend func(app *Application) (io.Closer, error)
+
+// integration creates the clients of a notifier integration and adds them
+// to app.clients.
+type integration func(app *Application, retryPolicy alert.RetryPolicy, logger alert.Logger) error
+
+var (
+	storageBackends = map[string]storageBackend{}
+	integrations    = map[string]integration{}
+)
+
+func registerStorageBackend(name string, backend storageBackend) {
+	storageBackends[name] = backend
+}
+
+func registerIntegration(name string, init integration) {
+	integrations[name] = init
+}
+
+// initIntegration creates the named integration's clients if enabled.
+func (app *Application) initIntegration(name string, enabled bool, retryPolicy alert.RetryPolicy, logger alert.Logger) error {
+	if !enabled {
+		return nil
+	}
+	init, ok := integrations[name]
+	if !ok {
+		return notCompiledError(name, name)
+	}
+	return init(app, retryPolicy, logger)
+}
+
+// notCompiledError reports configuration that needs a feature left out of
+// this binary.
+func notCompiledError(setting, feature string) error {
+	return fmt.Errorf("%s is configured but this binary was built without it (build tag no%s); use a full build or disable it",
+		setting, feature)
+}
+
+// compiledFeatures lists the optional storage backends and integrations
+// built into this binary.
+func compiledFeatures() []string {
+	var features []string
+	for _, name := range []string{"sqlite", "mysql", "redis"} {
+		if _, ok := storageBackends[name]; ok {
+			features = append(features, name)
+		}
+	}
+	for _, name := range []string{"teams", "email"} {
+		if _, ok := integrations[name]; ok {
+			features = append(features, name)
+		}
+	}
+	return features
+}
@@ -0,0 +1,59 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/slack"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
+)
+
+// slackMessageFinder adapts the Slack client to the alert.MessageFinder
+// interface.
+type slackMessageFinder struct {
+	client *slack.Client
+}
+
+// FindAlertMessages lists the alert messages posted since the given time.
+func (f *slackMessageFinder) FindAlertMessages(ctx context.Context, since time.Time) ([]alert.PostedMessage, error) {
+	messages, err := f.client.FindAlertMessages(ctx, since)
+	if err != nil {
+		return nil, err
+	}
+
+	posted := make([]alert.PostedMessage, len(messages))
+	for i, msg := range messages {
+		posted[i] = alert.PostedMessage{
+			MessageID: msg.MessageID,
+			AlertID:   msg.AlertID,
+			AlertName: msg.AlertName,
+			PostedAt:  msg.PostedAt,
+		}
+	}
+	return posted, nil
+}
+
+// Relink restores the lost Slack and PagerDuty references of alerts firing
+// or changed since the given time. PagerDuty incidents are only looked up
+// when an API token is configured.
+func (app *Application) Relink(ctx context.Context, since time.Time, dryRun bool) (*alert.RelinkOutput, error) {
+	if app.config.Storage.Type == "memory" || app.config.Storage.Type == "" {
+		return nil, fmt.Errorf("relink needs persistent storage, alerts in memory storage are lost on restart")
+	}
+
+	var messages alert.MessageFinder
+	if app.clients.Slack != nil {
+		messages = &slackMessageFinder{client: app.clients.Slack}
+	}
+	var incidents alert.IncidentFinder
+	if app.clients.PagerDuty != nil && app.config.PagerDuty.APIToken != "" {
+		incidents = app.clients.PagerDuty
+	}
+	if messages == nil && incidents == nil {
+		return nil, fmt.Errorf("neither slack nor a pagerduty api token is configured")
+	}
+
+	relink := alert.NewRelinkReferencesUseCase(app.alertRepo, messages, incidents)
+	return relink.Execute(ctx, alert.RelinkInput{Since: since, DryRun: dryRun})
+}
@@ -0,0 +1,176 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/config"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/email"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/schedule"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/slack"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/report"
+)
+
+// SlackReportPublisher posts reports to a fixed Slack channel.
+type SlackReportPublisher struct {
+	client    *slack.Client
+	channelID string
+}
+
+// PublishReport posts the report to the configured channel.
+func (p *SlackReportPublisher) PublishReport(ctx context.Context, r *entity.AlertReport) error {
+	return p.client.PostReport(ctx, p.channelID, r)
+}
+
+// Name returns the publisher identifier.
+func (p *SlackReportPublisher) Name() string {
+	return "slack"
+}
+
+// EmailReportPublisher emails reports to a fixed recipient list.
+type EmailReportPublisher struct {
+	client     *email.Client
+	recipients []string
+}
+
+// PublishReport emails the report to the configured recipients.
+func (p *EmailReportPublisher) PublishReport(ctx context.Context, r *entity.AlertReport) error {
+	return p.client.SendReport(ctx, p.recipients, r)
+}
+
+// Name returns the publisher identifier.
+func (p *EmailReportPublisher) Name() string {
+	return "email"
+}
+
+// initializeReports builds the report scheduler from config.
+// Leaves app.scheduler nil when no reports, on-call messages or digests are
+// configured.
+func (app *Application) initializeReports() error {
+	var jobs []schedule.Job
+	if len(app.config.Reports) > 0 {
+		sendReport := report.NewSendReportUseCase(app.alertRepo, app.savedViewRepo)
+		for _, cfg := range app.config.Reports {
+			job, err := app.newReportJob(sendReport, cfg)
+			if err != nil {
+				return fmt.Errorf("report %q: %w", cfg.Name, err)
+			}
+			jobs = append(jobs, job)
+		}
+	}
+
+	if app.config.OnCallReport.SlackDM && app.clients.Slack != nil {
+		job, err := app.newOnCallLoadJob()
+		if err != nil {
+			return fmt.Errorf("oncall report: %w", err)
+		}
+		jobs = append(jobs, job)
+	}
+
+	if app.config.ResponseTimeReport.SlackChannel != "" && app.clients.Slack != nil {
+		job, err := app.newResponseTimesJob()
+		if err != nil {
+			return fmt.Errorf("response time report: %w", err)
+		}
+		jobs = append(jobs, job)
+	}
+
+	if len(jobs) == 0 {
+		return nil
+	}
+
+	app.scheduler = schedule.NewScheduler(jobs, app.logger.Get())
+
+	app.logger.Get().Info("scheduled reports enabled", "reportCount", len(jobs))
+	return nil
+}
+
+// newOnCallLoadJob builds the job sending everyone their previous month's
+// on-call load.
+func (app *Application) newOnCallLoadJob() (schedule.Job, error) {
+	cron, err := schedule.ParseCron(app.config.OnCallReport.DMSchedule)
+	if err != nil {
+		return schedule.Job{}, err
+	}
+	loc, err := time.LoadLocation(app.config.OnCallReport.Timezone)
+	if err != nil {
+		return schedule.Job{}, fmt.Errorf("loading timezone: %w", err)
+	}
+
+	return schedule.Job{
+		Name:     "oncall-load",
+		Schedule: cron,
+		Location: loc,
+		Run:      app.useCases.OnCallLoad.SendDirectMessages,
+	}, nil
+}
+
+// newResponseTimesJob builds the job posting the response times digest.
+func (app *Application) newResponseTimesJob() (schedule.Job, error) {
+	cfg := app.config.ResponseTimeReport
+	cron, err := schedule.ParseCron(cfg.Schedule)
+	if err != nil {
+		return schedule.Job{}, err
+	}
+	loc, err := time.LoadLocation(cfg.Timezone)
+	if err != nil {
+		return schedule.Job{}, fmt.Errorf("loading timezone: %w", err)
+	}
+
+	return schedule.Job{
+		Name:     "response-times",
+		Schedule: cron,
+		Location: loc,
+		Run: func(ctx context.Context) error {
+			return app.useCases.ResponseTimes.SendDigest(ctx, cfg.SlackChannel, cfg.Period)
+		},
+	}, nil
+}
+
+// newReportJob builds the scheduled job for one configured report.
+func (app *Application) newReportJob(sendReport *report.SendReportUseCase, cfg config.ReportConfig) (schedule.Job, error) {
+	cron, err := schedule.ParseCron(cfg.Schedule)
+	if err != nil {
+		return schedule.Job{}, err
+	}
+	loc, err := time.LoadLocation(cfg.Timezone)
+	if err != nil {
+		return schedule.Job{}, fmt.Errorf("loading timezone: %w", err)
+	}
+
+	var publishers []report.Publisher
+	if cfg.SlackChannel != "" && app.clients.Slack != nil {
+		publishers = append(publishers, &SlackReportPublisher{
+			client:    app.clients.Slack,
+			channelID: cfg.SlackChannel,
+		})
+	}
+	if len(cfg.EmailRecipients) > 0 && app.clients.Email != nil {
+		publishers = append(publishers, &EmailReportPublisher{
+			client:     app.clients.Email,
+			recipients: cfg.EmailRecipients,
+		})
+	}
+	if len(publishers) == 0 {
+		return schedule.Job{}, fmt.Errorf("no enabled destination")
+	}
+
+	input := report.SendReportInput{
+		Name:       cfg.Name,
+		ViewName:   cfg.View,
+		Period:     cfg.Period,
+		Publishers: publishers,
+	}
+
+	return schedule.Job{
+		Name:     "report:" + cfg.Name,
+		Schedule: cron,
+		Location: loc,
+		Run: func(ctx context.Context) error {
+			_, err := sendReport.Execute(ctx, input)
+			return err
+		},
+	}, nil
+}
@@ -0,0 +1,28 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	apiUseCase "github.com/altuslabsxyz/alert-bridge/internal/usecase/api"
+)
+
+func (app *Application) newTransferStateUseCase() *apiUseCase.TransferStateUseCase {
+	return apiUseCase.NewTransferStateUseCase(
+		app.alertRepo,
+		app.ackEventRepo,
+		app.alertEventRepo,
+		app.silenceRepo,
+		&slogAdapter{logger: app.logger.Get()},
+	)
+}
+
+// ImportState saves the state exported by another instance, from its
+// GET /-/state endpoint, into this instance's storage. Alerts and silences
+// already stored are skipped.
+func (app *Application) ImportState(ctx context.Context, export *apiUseCase.StateExport, dryRun bool) (*apiUseCase.StateImportResult, error) {
+	if app.config.Storage.Type == "memory" || app.config.Storage.Type == "" {
+		return nil, fmt.Errorf("import-state needs persistent storage, state imported into memory storage is lost on exit")
+	}
+	return app.newTransferStateUseCase().Import(ctx, export, dryRun)
+}
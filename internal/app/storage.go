@@ -6,71 +6,134 @@ import (
 	"io"
 
 	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/config"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/buffered"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/fieldcrypt"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/firingcache"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/instrumented"
 	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/memory"
-	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/mysql"
-	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/sqlite"
 )
 
 func (app *Application) initializeStorage() error {
 	var closer io.Closer
 
-	switch app.config.Storage.Type {
-	case "mysql":
-		repos, db, err := mysql.NewRepositories(&app.config.Storage.MySQL)
-		if err != nil {
-			return fmt.Errorf("mysql init: %w", err)
-		}
-		app.alertRepo = repos.Alert
-		app.ackEventRepo = repos.AckEvent
-		app.silenceRepo = repos.Silence
-		app.txManager = db // MySQL DB implements TransactionManager
-		app.dbPinger = db  // MySQL DB implements dbPinger for readiness checks
-		closer = db
-
-		app.logger.Get().Info("MySQL storage initialized",
-			"host", app.config.Storage.MySQL.Primary.Host,
-			"database", app.config.Storage.MySQL.Primary.Database,
-		)
-
-	case "sqlite":
-		db, err := sqlite.NewDB(app.config.Storage.SQLite.Path)
-		if err != nil {
-			return fmt.Errorf("sqlite init: %w", err)
-		}
-
-		if err := db.Migrate(context.Background()); err != nil {
-			db.Close()
-			return fmt.Errorf("sqlite migration: %w", err)
-		}
-
-		repos := sqlite.NewRepositories(db)
-		app.alertRepo = repos.Alert
-		app.ackEventRepo = repos.AckEvent
-		app.silenceRepo = repos.Silence
-		app.txManager = db // SQLite DB implements TransactionManager
-		app.dbPinger = db  // SQLite DB implements dbPinger for readiness checks
-		closer = db
-
-		app.logger.Get().Info("SQLite storage initialized",
-			"path", app.config.Storage.SQLite.Path,
-		)
-
+	switch storageType := app.config.Storage.Type; storageType {
 	case "memory", "":
 		app.alertRepo = memory.NewAlertRepository()
 		app.ackEventRepo = memory.NewAckEventRepository()
+		app.alertEventRepo = memory.NewAlertEventRepository()
 		app.silenceRepo = memory.NewSilenceRepository()
+		app.savedViewRepo = memory.NewSavedViewRepository()
+		app.retryRepo = memory.NewNotificationRetryRepository()
 		app.txManager = &noOpTransactionManager{} // No-op for in-memory
 
 		app.logger.Get().Info("in-memory storage initialized")
 
 	default:
-		return fmt.Errorf("unknown storage type: %s", app.config.Storage.Type)
+		backend, ok := storageBackends[storageType]
+		if !ok {
+			if config.ValidateStorageType(storageType) == nil {
+				return notCompiledError("storage type "+storageType, storageType)
+			}
+			return fmt.Errorf("unknown storage type: %s", storageType)
+		}
+		var err error
+		if closer, err = backend(app); err != nil {
+			return err
+		}
 	}
 
 	app.dbCloser = closer
+	app.instrumentStorage()
+	if app.config.IsStorageDegradationEnabled() {
+		app.bufferStorage()
+	}
+	if app.config.IsFiringCacheEnabled() {
+		cache := firingcache.NewAlertRepository(app.alertRepo, app.config.Storage.FiringCache.TTL)
+		app.alertRepo = cache
+		app.firingCache = cache
+		app.logger.Get().Info("firing fingerprint cache enabled",
+			"ttl", app.config.Storage.FiringCache.TTL,
+		)
+	}
 	return nil
 }
 
+// bufferStorage wraps the alert, event and ack repositories so writes that
+// fail because the backend is unavailable are retried or held in memory,
+// per storage.degradation.order.
+func (app *Application) bufferStorage() {
+	cfg := app.config.Storage.Degradation
+	buffer := buffered.NewBuffer(buffered.Config{
+		Order:         cfg.Order,
+		RetryBackoff:  cfg.RetryBackoff,
+		MaxBuffered:   cfg.MaxBuffered,
+		FlushInterval: cfg.FlushInterval,
+	}, app.alertRepo, app.alertEventRepo, app.ackEventRepo, app.logger.Get())
+	if app.telemetry != nil {
+		buffer.SetMetrics(app.telemetry.Metrics)
+	}
+
+	app.alertRepo = buffered.NewAlertRepository(app.alertRepo, buffer)
+	app.alertEventRepo = buffered.NewAlertEventRepository(app.alertEventRepo, buffer)
+	app.ackEventRepo = buffered.NewAckEventRepository(app.ackEventRepo, buffer)
+	app.txManager = buffered.NewTransactionManager(app.txManager, buffer)
+	if app.dbPinger != nil {
+		app.dbPinger = &bufferedPinger{next: app.dbPinger, buffer: buffer}
+	}
+	app.storageBuffer = buffer
+
+	app.logger.Get().Info("storage degradation enabled",
+		"order", cfg.Order,
+		"maxBuffered", cfg.MaxBuffered,
+		"flushInterval", cfg.FlushInterval,
+	)
+}
+
+// bufferedPinger reports the database ready while it is down but writes
+// can still be held, so probes and the watchdog do not drop the buffer.
+type bufferedPinger struct {
+	next   dbPinger
+	buffer *buffered.Buffer
+}
+
+func (p *bufferedPinger) Ping(ctx context.Context) error {
+	err := p.next.Ping(ctx)
+	if err != nil && p.buffer.Accepting() {
+		return nil
+	}
+	return err
+}
+
+// newFieldCipher creates the cipher of the SQL backends' note fields; nil
+// when encryption is disabled.
+func (app *Application) newFieldCipher() (*fieldcrypt.Cipher, error) {
+	key := app.config.Storage.Encryption.Key
+	if key == "" {
+		return nil, nil
+	}
+	cipher, err := fieldcrypt.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("storage encryption: %w", err)
+	}
+	return cipher, nil
+}
+
+// instrumentStorage wraps the repositories so every storage operation is
+// traced, counted and timed, whichever backend is configured.
+func (app *Application) instrumentStorage() {
+	if app.telemetry == nil || app.telemetry.Metrics == nil {
+		return
+	}
+	metrics := app.telemetry.Metrics
+	app.alertRepo = instrumented.NewAlertRepository(app.alertRepo, metrics)
+	app.ackEventRepo = instrumented.NewAckEventRepository(app.ackEventRepo, metrics)
+	app.alertEventRepo = instrumented.NewAlertEventRepository(app.alertEventRepo, metrics)
+	app.silenceRepo = instrumented.NewSilenceRepository(app.silenceRepo, metrics)
+	app.savedViewRepo = instrumented.NewSavedViewRepository(app.savedViewRepo, metrics)
+	app.retryRepo = instrumented.NewNotificationRetryRepository(app.retryRepo, metrics)
+}
+
 // noOpTransactionManager is a no-op implementation for in-memory storage.
 type noOpTransactionManager struct{}
 
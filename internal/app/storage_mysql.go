@@ -0,0 +1,44 @@
+//go:build !nomysql
+
+package app
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/mysql"
+)
+
+func init() {
+	registerStorageBackend("mysql", newMySQLStorage)
+}
+
+func newMySQLStorage(app *Application) (io.Closer, error) {
+	cipher, err := app.newFieldCipher()
+	if err != nil {
+		return nil, err
+	}
+
+	repos, db, err := mysql.NewRepositories(&app.config.Storage.MySQL)
+	if err != nil {
+		return nil, fmt.Errorf("mysql init: %w", err)
+	}
+	db.SetFieldCipher(cipher)
+	app.alertRepo = repos.Alert
+	app.ackEventRepo = repos.AckEvent
+	app.alertEventRepo = repos.AlertEvent
+	app.silenceRepo = repos.Silence
+	app.savedViewRepo = repos.SavedView
+	app.retryRepo = repos.Retry
+	app.txManager = db // MySQL DB implements TransactionManager
+	app.dbPinger = db  // MySQL DB implements dbPinger for readiness checks
+	db.StartReplicaHealthCheck(app.logger.Get())
+
+	app.logger.Get().Info("MySQL storage initialized",
+		"host", app.config.Storage.MySQL.Primary.Host,
+		"database", app.config.Storage.MySQL.Primary.Database,
+		"replica", app.config.Storage.MySQL.Replica.Enabled,
+		"encryption", cipher != nil,
+	)
+	return db, nil
+}
@@ -0,0 +1,35 @@
+//go:build !noredis
+
+package app
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/redis"
+)
+
+func init() {
+	registerStorageBackend("redis", newRedisStorage)
+}
+
+func newRedisStorage(app *Application) (io.Closer, error) {
+	repos, client, err := redis.NewRepositories(&app.config.Storage.Redis)
+	if err != nil {
+		return nil, fmt.Errorf("redis init: %w", err)
+	}
+	app.alertRepo = repos.Alert
+	app.ackEventRepo = repos.AckEvent
+	app.alertEventRepo = repos.AlertEvent
+	app.silenceRepo = repos.Silence
+	app.savedViewRepo = repos.SavedView
+	app.retryRepo = repos.Retry
+	app.txManager = &noOpTransactionManager{} // Writes are atomic per repository call
+	app.dbPinger = client
+
+	app.logger.Get().Info("Redis storage initialized",
+		"addr", app.config.Storage.Redis.Addr,
+		"resolved_ttl", app.config.Storage.Redis.ResolvedTTL,
+	)
+	return client, nil
+}
@@ -0,0 +1,58 @@
+//go:build !nosqlite
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/sqlite"
+)
+
+func init() {
+	registerStorageBackend("sqlite", newSQLiteStorage)
+}
+
+func newSQLiteStorage(app *Application) (io.Closer, error) {
+	db, err := sqlite.NewDB(app.config.Storage.SQLite.Path)
+	if err != nil {
+		return nil, fmt.Errorf("sqlite init: %w", err)
+	}
+
+	if err := db.Migrate(context.Background()); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("sqlite migration: %w", err)
+	}
+
+	if size := app.config.Storage.SQLite.ReadPoolSize; size > 0 {
+		if err := db.OpenReadPool(size); err != nil {
+			db.Close()
+			return nil, fmt.Errorf("sqlite read pool: %w", err)
+		}
+	}
+
+	cipher, err := app.newFieldCipher()
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	db.SetFieldCipher(cipher)
+
+	repos := sqlite.NewRepositories(db)
+	app.alertRepo = repos.Alert
+	app.ackEventRepo = repos.AckEvent
+	app.alertEventRepo = repos.AlertEvent
+	app.silenceRepo = repos.Silence
+	app.savedViewRepo = repos.SavedView
+	app.retryRepo = repos.Retry
+	app.txManager = db // SQLite DB implements TransactionManager
+	app.dbPinger = db  // SQLite DB implements dbPinger for readiness checks
+
+	app.logger.Get().Info("SQLite storage initialized",
+		"path", app.config.Storage.SQLite.Path,
+		"readPoolSize", app.config.Storage.SQLite.ReadPoolSize,
+		"encryption", cipher != nil,
+	)
+	return db, nil
+}
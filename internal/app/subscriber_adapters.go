@@ -20,7 +20,7 @@ func NewSlackSubscriberNotifierAdapter(client *slack.Client) *SlackSubscriberNot
 }
 
 // Notify sends an alert to Slack.
-func (a *SlackSubscriberNotifierAdapter) Notify(ctx context.Context, alertEntity *entity.Alert) (string, error) {
+func (a *SlackSubscriberNotifierAdapter) Notify(ctx context.Context, alertEntity *entity.Alert) (entity.NotifyResult, error) {
 	return a.client.Notify(ctx, alertEntity)
 }
 
@@ -35,7 +35,7 @@ func (a *SlackSubscriberNotifierAdapter) Name() string {
 }
 
 // NotifyWithMentions sends an alert to Slack with @mentions for the given user IDs.
-func (a *SlackSubscriberNotifierAdapter) NotifyWithMentions(ctx context.Context, alertEntity *entity.Alert, slackUserIDs []string) (string, error) {
+func (a *SlackSubscriberNotifierAdapter) NotifyWithMentions(ctx context.Context, alertEntity *entity.Alert, slackUserIDs []string) (entity.NotifyResult, error) {
 	return a.client.NotifyWithMentions(ctx, alertEntity, slackUserIDs)
 }
 
@@ -50,7 +50,7 @@ func NewPagerDutySubscriberNotifierAdapter(client *pagerduty.Client) *PagerDutyS
 }
 
 // Notify sends an alert to PagerDuty.
-func (a *PagerDutySubscriberNotifierAdapter) Notify(ctx context.Context, alertEntity *entity.Alert) (string, error) {
+func (a *PagerDutySubscriberNotifierAdapter) Notify(ctx context.Context, alertEntity *entity.Alert) (entity.NotifyResult, error) {
 	return a.client.Notify(ctx, alertEntity)
 }
 
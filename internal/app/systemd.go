@@ -0,0 +1,71 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/systemd"
+)
+
+// startSystemdNotify reports readiness to systemd once the HTTP listener
+// is up and, when the unit sets WatchdogSec, keeps the watchdog fed while
+// the application stays healthy.
+func (app *Application) startSystemdNotify(ctx context.Context) {
+	logger := app.logger.Get()
+
+	timeout, err := systemd.WatchdogInterval()
+	if err != nil {
+		logger.Warn("ignoring systemd watchdog setting", "error", err)
+	}
+
+	app.server.OnListening(func() {
+		sent, err := systemd.Notify(systemd.StateReady)
+		if err != nil {
+			logger.Warn("failed to notify systemd of readiness", "error", err)
+			return
+		}
+		if !sent {
+			logger.Debug("systemd notification enabled but NOTIFY_SOCKET is not set")
+			return
+		}
+		logger.Info("notified systemd of readiness", "watchdog", timeout)
+
+		if timeout > 0 {
+			go systemd.RunWatchdog(ctx, timeout, app.watchdogCheck, logger)
+		}
+
+		// Shutdown begins when ctx is cancelled
+		go func() {
+			<-ctx.Done()
+			if _, err := systemd.Notify(systemd.StateStopping); err != nil {
+				logger.Warn("failed to notify systemd of shutdown", "error", err)
+			}
+		}()
+	})
+}
+
+// watchdogCheck verifies that the HTTP server still answers health checks
+// and that the database responds.
+func (app *Application) watchdogCheck(ctx context.Context) error {
+	url := fmt.Sprintf("http://127.0.0.1:%d/health", app.config.Server.Port)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("health endpoint: %w", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("health endpoint returned %d", resp.StatusCode)
+	}
+
+	if app.dbPinger != nil {
+		if err := app.dbPinger.Ping(ctx); err != nil {
+			return fmt.Errorf("database: %w", err)
+		}
+	}
+	return nil
+}
@@ -6,7 +6,17 @@ import (
 
 // setupTelemetry initializes OpenTelemetry tracing and metrics.
 func (app *Application) setupTelemetry() error {
-	telemetry, err := observability.NewTelemetry("alert-bridge", "v1.0.0")
+	var tracing observability.TracingOptions
+	if app.config.IsTracingEnabled() {
+		tracing = observability.TracingOptions{
+			Endpoint:    app.config.Observability.Tracing.Endpoint,
+			Headers:     app.config.Observability.Tracing.Headers,
+			SampleRatio: app.config.Observability.Tracing.SampleRatio,
+			Timeout:     app.config.Observability.Tracing.Timeout,
+		}
+	}
+
+	telemetry, err := observability.NewTelemetry("alert-bridge", "v1.0.0", tracing)
 	if err != nil {
 		return err
 	}
@@ -16,7 +26,8 @@ func (app *Application) setupTelemetry() error {
 	app.logger.Get().Info("telemetry initialized",
 		"service", "alert-bridge",
 		"metrics_enabled", true,
-		"tracing_enabled", false, // NoOp tracer for now
+		"tracing_enabled", app.config.IsTracingEnabled(),
+		"tracing_endpoint", tracing.Endpoint,
 	)
 
 	return nil
@@ -1,18 +1,79 @@
 package app
 
 import (
+	"fmt"
 	"log/slog"
+	"regexp"
+	"time"
 
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
 	"github.com/altuslabsxyz/alert-bridge/internal/domain/service"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/config"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/objectstore"
 	"github.com/altuslabsxyz/alert-bridge/internal/usecase/ack"
 	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/report"
 )
 
 // UseCases holds all business logic use cases
 type UseCases struct {
 	ProcessAlert      *alert.ProcessAlertUseCase
 	SyncAck           *ack.SyncAckUseCase
+	TagAlert          *alert.TagAlertUseCase
+	AssignAlert       *alert.AssignAlertUseCase
+	QueryActiveAt     *alert.QueryActiveAtUseCase
 	SubscriberMatcher *service.SubscriberMatcher
+
+	// Timeline records and serves alert events.
+	Timeline *alert.Timeline
+
+	// Incidents posts alert events to linked incidents of incident
+	// management tools; nil when none is enabled.
+	Incidents *alert.IncidentTimelines
+
+	// DeliverySLO tracks delivery latency objectives; nil when disabled.
+	DeliverySLO *alert.DeliverySLOTracker
+
+	// RetryQueue retries failed notifications; nil when disabled.
+	RetryQueue *alert.NotificationRetryQueue
+
+	// Escalation escalates unacknowledged alerts; nil when disabled.
+	Escalation *alert.EscalationEngine
+
+	// MessageLifecycle cleans up resolved alert messages and mirrors them to
+	// the history channel; nil when neither is configured.
+	MessageLifecycle *alert.MessageLifecycleManager
+
+	// StaleAlerts resolves alerts Alertmanager stopped sending; nil when
+	// disabled.
+	StaleAlerts *alert.StaleAlertReaper
+
+	// Shards processes alerts per fingerprint in parallel; nil when
+	// disabled.
+	Shards *alert.ShardPool
+
+	// Retention purges old alerts, ack events and silences; nil when
+	// disabled.
+	Retention *alert.RetentionJanitor
+
+	// Resend reminds responders of unacknowledged alerts; nil when
+	// disabled.
+	Resend *alert.ResendScheduler
+
+	// Silences records silence metrics and reminds creators of silences
+	// about to expire.
+	Silences *alert.SilenceMonitor
+
+	// SilenceSync mirrors Slack silences in Alertmanager; nil when
+	// disabled.
+	SilenceSync *alert.SilenceSync
+
+	// OnCallLoad reports pages and acks per person and team by month.
+	OnCallLoad *report.OnCallLoadUseCase
+
+	// ResponseTimes reports MTTA and MTTR per alert name, team and
+	// severity.
+	ResponseTimes *report.ResponseTimesUseCase
 }
 
 func (app *Application) initializeUseCases() error {
@@ -26,14 +87,155 @@ func (app *Application) initializeUseCases() error {
 		app.telemetry.Metrics,
 	)
 
+	// Alert timeline
+	timeline := alert.NewTimeline(app.alertEventRepo, logger)
+	if app.clients.Slack != nil && len(app.config.Slack.ThreadReplies) > 0 {
+		events := alertEventTypes(app.config.Slack.ThreadReplies)
+		timeline.SetThreadReplies(alert.NewThreadReplies(app.alertRepo, app.clients.Slack, events, logger))
+	}
+	incidents := app.newIncidentTimelines(logger)
+	timeline.SetIncidentTimelines(incidents)
+	processAlertUseCase.SetTimeline(timeline)
+	if app.clients.PagerDutyQueue != nil {
+		app.clients.PagerDutyQueue.SetTimeline(timeline)
+	}
+
+	// Slack thread replies for truncation warnings
+	if app.clients.Slack != nil {
+		processAlertUseCase.SetThreadNotifier(app.clients.Slack)
+	}
+
+	// Typed custom fields, if any schema is configured
+	if len(app.config.CustomFields.Tenants) > 0 {
+		processAlertUseCase.SetCustomFieldExtractor(service.NewCustomFieldExtractor(app.config.CustomFields))
+	}
+
+	// Persistent retries of failed notifications
+	var retryQueue *alert.NotificationRetryQueue
+	if app.config.IsRetryQueueEnabled() {
+		retryQueue = app.newRetryQueue(logger)
+		retryQueue.SetTimeline(timeline)
+		processAlertUseCase.SetNotificationRetrier(retryQueue)
+		if app.clients.PagerDutyQueue != nil {
+			app.clients.PagerDutyQueue.SetNotificationRetrier(retryQueue)
+		}
+	}
+
+	// Reminders of unacknowledged alerts
+	var resend *alert.ResendScheduler
+	if app.config.Alerting.Resend.Enabled && app.clients.Slack != nil {
+		resend = app.newResendScheduler(logger)
+	}
+
+	// Label-based routing of alerts to notifiers
+	var tree *alert.RoutingTree
+	if app.config.IsRoutingEnabled() {
+		var err error
+		tree, err = app.newRoutingTree()
+		if err != nil {
+			return err
+		}
+		processAlertUseCase.SetRoutingTree(tree)
+		if retryQueue != nil {
+			retryQueue.SetRoutingTree(tree)
+		}
+		if app.clients.SlackReposter != nil {
+			app.clients.SlackReposter.SetRoutingTree(tree)
+		}
+		if resend != nil {
+			resend.SetRoutingTree(tree)
+		}
+	}
+
+	// The canary mirrors what the candidate routing tree, or else the live
+	// one, delivers to Slack
+	if app.clients.Canary != nil {
+		canaryTree := tree
+		if route := app.config.Canary.Route; route != nil {
+			root, err := newRoute(*route)
+			if err != nil {
+				return err
+			}
+			if root.Name == "" {
+				root.Name = "canary"
+			}
+			canaryTree = alert.NewRoutingTree(root)
+		}
+		app.clients.Canary.SetRoutingTree(canaryTree)
+	}
+
+	// Escalation of unacknowledged alerts
+	var escalation *alert.EscalationEngine
+	if app.config.IsEscalationEnabled() {
+		escalation = app.newEscalationEngine(logger)
+		escalation.SetTimeline(timeline)
+	}
+
+	// Cleanup and history mirroring of resolved alert messages
+	var messageLifecycle *alert.MessageLifecycleManager
+	if app.clients.Slack != nil && (len(app.config.Slack.Lifecycle) > 0 || app.config.Slack.HistoryChannelID != "") {
+		messageLifecycle = app.newMessageLifecycleManager(logger)
+	}
+
+	// Cloud instance metadata labels
+	if app.clients.CloudMetadata != nil {
+		processAlertUseCase.SetLabelEnricher(app.clients.CloudMetadata)
+	}
+
+	// Enrichment webhooks, e.g. service ownership from a CMDB
+	if app.clients.Enrichment != nil {
+		processAlertUseCase.SetAlertEnricher(app.clients.Enrichment)
+	}
+
+	// Per-fingerprint processing shards
+	var shards *alert.ShardPool
+	if sharding := app.config.Alerting.Sharding; sharding.Enabled {
+		shards = alert.NewShardPool(sharding.Shards, sharding.QueueSize, app.telemetry.Metrics)
+		processAlertUseCase.SetShardPool(shards)
+		app.logger.Get().Info("alert processing shards enabled",
+			"shards", sharding.Shards,
+			"queueSize", sharding.QueueSize,
+		)
+	}
+
+	// Stages of new alerts
+	if stages := app.config.Alerting.Pipeline; len(stages) > 0 {
+		processAlertUseCase.SetPipeline(stages)
+		app.logger.Get().Info("alert pipeline configured",
+			"stages", stages,
+		)
+	}
+
+	// Duplicates of firing alerts recognized without storage
+	if app.firingCache != nil {
+		processAlertUseCase.SetFiringCache(app.firingCache)
+	}
+
+	// Concurrent alerts of a payload and parallel notifier calls
+	if parallel := app.config.Alerting.Parallel; parallel.Enabled {
+		processAlertUseCase.SetConcurrency(parallel.Concurrency)
+		app.logger.Get().Info("parallel alert processing enabled",
+			"concurrency", parallel.Concurrency,
+		)
+	}
+
+	// Delivery latency objectives
+	var deliverySLO *alert.DeliverySLOTracker
+	if app.config.IsDeliverySLOEnabled() {
+		deliverySLO = app.newDeliverySLOTracker(logger)
+		processAlertUseCase.SetDeliverySLOTracker(deliverySLO)
+	}
+
 	// Initialize subscriber matcher if subscribers are configured
 	var subscriberMatcher *service.SubscriberMatcher
 	if len(app.config.Subscribers) > 0 {
 		subscriberMatcher = service.NewSubscriberMatcher(app.config.GetEnabledSubscribers())
+		subscriberMatcher.SetRotations(app.config.OnCall)
 		processAlertUseCase.SetSubscriberMatcher(subscriberMatcher)
 
 		app.logger.Get().Info("subscriber matching enabled",
 			"subscriberCount", len(app.config.GetEnabledSubscribers()),
+			"rotationCount", len(app.config.OnCall),
 		)
 
 		// Set up subscriber-aware notifiers
@@ -48,22 +250,390 @@ func (app *Application) initializeUseCases() error {
 		}
 	}
 
+	// Auto-resolution of alerts whose resolve webhook was missed
+	var staleAlerts *alert.StaleAlertReaper
+	if app.config.Alerting.StaleAfter > 0 {
+		staleAlerts = alert.NewStaleAlertReaper(processAlertUseCase, app.config.Alerting.StaleAfter, staleAlertSweepInterval, logger)
+		app.logger.Get().Info("stale alert auto-resolution enabled",
+			"staleAfter", app.config.Alerting.StaleAfter,
+		)
+	}
+
+	// Purging of data past its retention period
+	var retention *alert.RetentionJanitor
+	if cfg := app.config.Storage.Retention; cfg.Enabled {
+		retention = alert.NewRetentionJanitor(app.alertRepo, app.ackEventRepo, app.silenceRepo, alert.RetentionPolicy{
+			ResolvedAlerts:  cfg.ResolvedAlerts,
+			AckEvents:       cfg.AckEvents,
+			ExpiredSilences: cfg.ExpiredSilences,
+		}, cfg.Interval, logger)
+		retention.SetMetrics(app.telemetry.Metrics)
+		if archive := cfg.Archive; archive.Enabled {
+			retention.SetArchive(objectstore.NewClient(objectstore.Config{
+				Endpoint:        archive.Endpoint,
+				Region:          archive.Region,
+				Bucket:          archive.Bucket,
+				Prefix:          archive.Prefix,
+				AccessKeyID:     archive.AccessKeyID,
+				SecretAccessKey: archive.SecretAccessKey,
+				SessionToken:    archive.SessionToken,
+				PathStyle:       archive.PathStyle,
+			}, app.clients.HTTP.Client("archive", archive.Timeout)), app.alertEventRepo)
+			app.logger.Get().Info("alert archiving enabled",
+				"endpoint", archive.Endpoint,
+				"bucket", archive.Bucket,
+			)
+		}
+		app.logger.Get().Info("data retention enabled",
+			"resolvedAlerts", cfg.ResolvedAlerts,
+			"ackEvents", cfg.AckEvents,
+			"expiredSilences", cfg.ExpiredSilences,
+		)
+	}
+
+	silences := alert.NewSilenceMonitor(app.silenceRepo, silenceCheckInterval, logger)
+	silences.SetMetrics(app.telemetry.Metrics)
+	if app.config.Alerting.SilenceReminder.Enabled && app.clients.Slack != nil {
+		silences.SetReminder(app.clients.Slack, app.config.Alerting.SilenceReminder.Before)
+		app.logger.Get().Info("silence expiry reminders enabled",
+			"before", app.config.Alerting.SilenceReminder.Before,
+		)
+	}
+
+	syncAck := ack.NewSyncAckUseCase(
+		app.alertRepo,
+		app.ackEventRepo,
+		app.txManager,
+		app.clients.Syncers,
+		logger,
+		app.telemetry.Metrics,
+	)
+	syncAck.SetTimeline(timeline)
+	syncAck.SetUserDirectory(app.clients.Users)
+
+	assignAlert := alert.NewAssignAlertUseCase(app.alertRepo, logger)
+	assignAlert.SetTimeline(timeline)
+
+	var silenceSync *alert.SilenceSync
+	if app.clients.Alertmanager != nil {
+		silenceSync = alert.NewSilenceSync(app.clients.Alertmanager, app.alertRepo, logger)
+	}
+
+	onCallLoad, err := app.newOnCallLoadUseCase(subscriberMatcher)
+	if err != nil {
+		return err
+	}
+
+	responseTimes := report.NewResponseTimesUseCase(app.alertRepo, app.config.ResponseTimeReport.TeamLabel)
+	if app.config.ResponseTimeReport.SlackChannel != "" && app.clients.Slack != nil {
+		responseTimes.SetSender(app.clients.Slack)
+	}
+
 	app.useCases = &UseCases{
-		ProcessAlert: processAlertUseCase,
-		SyncAck: ack.NewSyncAckUseCase(
-			app.alertRepo,
-			app.ackEventRepo,
-			app.txManager,
-			app.clients.Syncers,
-			logger,
-			app.telemetry.Metrics,
-		),
+		ProcessAlert:      processAlertUseCase,
+		SyncAck:           syncAck,
+		TagAlert:          alert.NewTagAlertUseCase(app.alertRepo, logger),
+		AssignAlert:       assignAlert,
+		QueryActiveAt:     alert.NewQueryActiveAtUseCase(app.alertRepo, app.ackEventRepo),
 		SubscriberMatcher: subscriberMatcher,
+		Timeline:          timeline,
+		Incidents:         incidents,
+		DeliverySLO:       deliverySLO,
+		RetryQueue:        retryQueue,
+		Escalation:        escalation,
+		MessageLifecycle:  messageLifecycle,
+		StaleAlerts:       staleAlerts,
+		Shards:            shards,
+		Retention:         retention,
+		Resend:            resend,
+		Silences:          silences,
+		SilenceSync:       silenceSync,
+		OnCallLoad:        onCallLoad,
+		ResponseTimes:     responseTimes,
 	}
 
 	return nil
 }
 
+// newIncidentTimelines sets up posting to the enabled incident management
+// tools, each with its configured events. Returns nil when none is enabled.
+func (app *Application) newIncidentTimelines(logger alert.Logger) *alert.IncidentTimelines {
+	if app.clients.FireHydrant == nil && app.clients.IncidentIO == nil {
+		return nil
+	}
+	incidents := alert.NewIncidentTimelines(app.alertRepo, logger)
+	if app.clients.FireHydrant != nil {
+		incidents.AddTool(app.clients.FireHydrant, alertEventTypes(app.config.FireHydrant.Events))
+	}
+	if app.clients.IncidentIO != nil {
+		incidents.AddTool(app.clients.IncidentIO, alertEventTypes(app.config.IncidentIO.Events))
+	}
+	return incidents
+}
+
+// alertEventTypes converts configured event names.
+func alertEventTypes(names []string) []entity.AlertEventType {
+	events := make([]entity.AlertEventType, 0, len(names))
+	for _, name := range names {
+		events = append(events, entity.AlertEventType(name))
+	}
+	return events
+}
+
+// newDeliverySLOTracker builds the tracker from config, posting breach
+// notices to the meta-alert channel when one is configured.
+func (app *Application) newDeliverySLOTracker(logger alert.Logger) *alert.DeliverySLOTracker {
+	cfg := app.config.DeliverySLO
+	objectives := make([]alert.DeliveryObjective, 0, len(cfg.Objectives))
+	for _, obj := range cfg.Objectives {
+		objectives = append(objectives, alert.DeliveryObjective{
+			Name:     obj.Name,
+			Notifier: obj.Notifier,
+			Severity: entity.AlertSeverity(obj.Severity),
+			Latency:  obj.Latency,
+			Target:   obj.Target,
+		})
+	}
+
+	tracker := alert.NewDeliverySLOTracker(objectives, cfg.Window, cfg.MinSamples, logger)
+	if cfg.MetaAlertChannelID != "" && app.clients.Slack != nil {
+		tracker.SetMetaAlertPoster(app.clients.Slack, cfg.MetaAlertChannelID)
+	}
+
+	app.logger.Get().Info("delivery SLO tracking enabled",
+		"objectives", len(objectives),
+		"window", cfg.Window,
+		"metaAlertChannel", cfg.MetaAlertChannelID,
+	)
+	return tracker
+}
+
+// newRoutingTree builds the routing tree from config.
+func (app *Application) newRoutingTree() (*alert.RoutingTree, error) {
+	root, err := newRoute(app.config.Routing.Route)
+	if err != nil {
+		return nil, err
+	}
+	if root.Name == "" {
+		root.Name = "root"
+	}
+
+	app.logger.Get().Info("alert routing enabled",
+		"routes", len(app.config.Routing.Route.Routes),
+		"notifiers", root.Notifiers,
+	)
+	return alert.NewRoutingTree(root), nil
+}
+
+// newRoute converts a route and its children, anchoring match_re patterns
+// to the whole label value.
+func newRoute(cfg config.RouteConfig) (alert.Route, error) {
+	route := alert.Route{
+		Name:           cfg.Name,
+		Match:          cfg.Match,
+		MinSeverity:    entity.AlertSeverity(cfg.MinSeverity),
+		Notifiers:      cfg.Notifiers,
+		SlackChannelID: cfg.SlackChannelID,
+		Continue:       cfg.Continue,
+		Pipeline:       cfg.Pipeline,
+	}
+	if len(cfg.MatchRE) > 0 {
+		route.MatchRE = make(map[string]*regexp.Regexp, len(cfg.MatchRE))
+		for name, pattern := range cfg.MatchRE {
+			re, err := regexp.Compile("^(?:" + pattern + ")$")
+			if err != nil {
+				return alert.Route{}, fmt.Errorf("compiling match_re for route %q: %w", cfg.Name, err)
+			}
+			route.MatchRE[name] = re
+		}
+	}
+	for _, child := range cfg.Routes {
+		childRoute, err := newRoute(child)
+		if err != nil {
+			return alert.Route{}, err
+		}
+		route.Routes = append(route.Routes, childRoute)
+	}
+	return route, nil
+}
+
+// newRetryQueue builds the notification retry queue from config.
+func (app *Application) newRetryQueue(logger alert.Logger) *alert.NotificationRetryQueue {
+	cfg := app.config.RetryQueue
+	policy := alert.RetryQueuePolicy{
+		RetryPolicy: alert.RetryPolicy{
+			MaxAttempts:     cfg.MaxAttempts,
+			InitialInterval: cfg.InitialBackoff,
+			MaxInterval:     cfg.MaxBackoff,
+			Multiplier:      2.0,
+			JitterFactor:    0.1,
+		},
+		Workers:      cfg.Workers,
+		PollInterval: cfg.PollInterval,
+	}
+
+	app.logger.Get().Info("notification retry queue enabled",
+		"workers", cfg.Workers,
+		"maxAttempts", cfg.MaxAttempts,
+		"storage", app.config.Storage.Type,
+	)
+	return alert.NewNotificationRetryQueue(app.retryRepo, app.alertRepo, app.clients.Notifiers, policy, logger, app.telemetry.Metrics)
+}
+
+// newEscalationEngine builds the escalation engine from config, announcing
+// escalations in the alert's Slack thread when Slack is enabled.
+func (app *Application) newEscalationEngine(logger alert.Logger) *alert.EscalationEngine {
+	cfg := app.config.Escalation
+	policies := make([]alert.EscalationPolicy, 0, len(cfg.Policies))
+	for _, p := range cfg.Policies {
+		steps := make([]alert.EscalationStep, 0, len(p.Steps))
+		for _, step := range p.Steps {
+			steps = append(steps, alert.EscalationStep{
+				After:     step.After,
+				Notifiers: step.Notify,
+			})
+		}
+		policies = append(policies, alert.EscalationPolicy{
+			Name:     p.Name,
+			Severity: entity.AlertSeverity(p.Severity),
+			Labels:   p.Labels,
+			Steps:    steps,
+		})
+	}
+
+	engine := alert.NewEscalationEngine(app.alertRepo, app.silenceRepo, app.clients.Notifiers, policies, cfg.Interval, logger, app.telemetry.Metrics)
+	if app.clients.Slack != nil {
+		engine.SetThreadNotifier(app.clients.Slack)
+	}
+
+	app.logger.Get().Info("escalation enabled",
+		"policies", len(policies),
+		"targets", len(cfg.Targets),
+		"interval", cfg.Interval,
+	)
+	return engine
+}
+
+// resendSweepInterval is how often active alerts are checked for a due
+// reminder.
+const resendSweepInterval = time.Minute
+
+// newOnCallLoadUseCase builds the on-call load report from config. Slack
+// direct messages are sent when enabled.
+func (app *Application) newOnCallLoadUseCase(matcher *service.SubscriberMatcher) (*report.OnCallLoadUseCase, error) {
+	cfg := app.config.OnCallReport
+	loc, err := time.LoadLocation(cfg.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("oncall report: loading timezone: %w", err)
+	}
+	start, end, err := cfg.ParseWorkingHours()
+	if err != nil {
+		return nil, fmt.Errorf("oncall report: %w", err)
+	}
+
+	onCallLoad := report.NewOnCallLoadUseCase(app.alertRepo, app.ackEventRepo, report.OnCallLoadPolicy{
+		TeamLabel:    cfg.TeamLabel,
+		Location:     loc,
+		WorkdayStart: start,
+		WorkdayEnd:   end,
+	})
+	if matcher != nil {
+		onCallLoad.SetSubscriberMatcher(matcher)
+	}
+	if cfg.SlackDM && app.clients.Slack != nil {
+		onCallLoad.SetSender(app.clients.Slack)
+	}
+	return onCallLoad, nil
+}
+
+// newResendScheduler builds the resend scheduler from config. Each severity
+// uses alerting.resend_interval and the resend action and mention unless
+// overridden.
+func (app *Application) newResendScheduler(logger alert.Logger) *alert.ResendScheduler {
+	cfg := app.config.Alerting.Resend
+	policies := make(map[entity.AlertSeverity]alert.ResendPolicy)
+	for _, severity := range []entity.AlertSeverity{entity.SeverityCritical, entity.SeverityWarning, entity.SeverityInfo} {
+		policy := alert.ResendPolicy{
+			Interval: app.config.Alerting.ResendInterval,
+			Action:   alert.ResendAction(cfg.Action),
+			Mention:  slackMention(cfg.Mention),
+		}
+		if override, ok := cfg.Severities[string(severity)]; ok {
+			if override.Interval > 0 {
+				policy.Interval = override.Interval
+			}
+			if override.Action != "" {
+				policy.Action = alert.ResendAction(override.Action)
+			}
+			if override.Mention != "" {
+				policy.Mention = slackMention(override.Mention)
+			}
+		}
+		policies[severity] = policy
+	}
+
+	// The notifier with retries, as used on ingestion
+	var notifier alert.Notifier = app.clients.Slack
+	for _, n := range app.clients.Notifiers {
+		if n.Name() == "slack" {
+			notifier = n
+		}
+	}
+
+	app.logger.Get().Info("resend of unacknowledged alerts enabled",
+		"interval", app.config.Alerting.ResendInterval,
+		"action", cfg.Action,
+		"severityOverrides", len(cfg.Severities),
+	)
+	return alert.NewResendScheduler(app.alertRepo, app.silenceRepo, notifier, app.clients.Slack, policies, resendSweepInterval, logger)
+}
+
+// slackMention turns the @here, @channel and @everyone shorthands into
+// Slack's mention syntax. Other values are used as is.
+func slackMention(mention string) string {
+	switch mention {
+	case "@here", "@channel", "@everyone":
+		return "<!" + mention[1:] + ">"
+	}
+	return mention
+}
+
+// messageLifecycleInterval is how often resolved alert messages are checked
+// against their channel's lifecycle policy.
+const messageLifecycleInterval = time.Minute
+
+// staleAlertSweepInterval is how often firing alerts are checked for
+// staleness.
+const staleAlertSweepInterval = time.Minute
+
+// silenceCheckInterval is how often silences are counted and checked for
+// due expiry reminders.
+const silenceCheckInterval = time.Minute
+
+// newMessageLifecycleManager builds the Slack message lifecycle manager and
+// history mirror from config.
+func (app *Application) newMessageLifecycleManager(logger alert.Logger) *alert.MessageLifecycleManager {
+	policies := make(map[string]alert.MessageLifecyclePolicy, len(app.config.Slack.Lifecycle))
+	for channelID, cfg := range app.config.Slack.Lifecycle {
+		policies[channelID] = alert.MessageLifecyclePolicy{
+			Action:           alert.MessageLifecycleAction(cfg.Action),
+			After:            cfg.After,
+			HistoryChannelID: cfg.HistoryChannelID,
+		}
+	}
+
+	manager := alert.NewMessageLifecycleManager(app.alertRepo, app.clients.Slack, policies, messageLifecycleInterval, logger)
+	if app.config.Slack.HistoryChannelID != "" {
+		manager.SetHistoryChannel(app.config.Slack.HistoryChannelID)
+	}
+
+	app.logger.Get().Info("slack message lifecycle enabled",
+		"channels", len(policies),
+		"historyChannel", app.config.Slack.HistoryChannelID,
+	)
+	return manager
+}
+
 // slogAdapter adapts slog.Logger to usecase Logger interface
 type slogAdapter struct {
 	logger *slog.Logger
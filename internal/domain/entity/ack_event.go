@@ -13,6 +13,11 @@ const (
 	AckSourceSlack     AckSource = "slack"
 	AckSourcePagerDuty AckSource = "pagerduty"
 	AckSourceAPI       AckSource = "api"
+	AckSourceTeams     AckSource = "teams"
+
+	// Acknowledgments from incidents the alert is linked to
+	AckSourceFireHydrant AckSource = "firehydrant"
+	AckSourceIncidentIO  AckSource = "incidentio"
 )
 
 // AckEvent represents an acknowledgment action on an alert.
@@ -1,6 +1,9 @@
 package entity
 
 import (
+	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -24,6 +27,31 @@ const (
 	StateResolved AlertState = "resolved"
 )
 
+// AlertTransition records a change of an alert's severity or state.
+// Previous values let consumers compute how long the alert spent in each
+// severity (e.g. warning for 2h before becoming critical).
+type AlertTransition struct {
+	At               time.Time
+	PreviousSeverity AlertSeverity
+	Severity         AlertSeverity
+	PreviousState    AlertState
+	State            AlertState
+	// By identifies who caused the transition, if a person did.
+	By string
+}
+
+// IsSeverityChange returns true if the transition changed the severity.
+func (t AlertTransition) IsSeverityChange() bool {
+	return t.PreviousSeverity != t.Severity
+}
+
+// AlertNote is a free-text comment a responder attached to an alert.
+type AlertNote struct {
+	At   time.Time
+	By   string
+	Text string
+}
+
 // Alert represents a monitored event that requires attention.
 // This is the core domain entity - pure business logic, no infrastructure dependencies.
 type Alert struct {
@@ -42,6 +70,11 @@ type Alert struct {
 	// Target is the monitored target (e.g., endpoint URL, service name).
 	Target string
 
+	// Source is the integration that raised the alert, e.g. "alertmanager",
+	// "grafana" or "generic:<name>". Empty for alerts stored before it was
+	// tracked.
+	Source string
+
 	// Summary is a brief description of the alert condition.
 	Summary string
 
@@ -60,19 +93,59 @@ type Alert struct {
 	// Annotations provide additional contextual information.
 	Annotations map[string]string
 
-	// ExternalReferences stores integration-specific message/incident IDs.
-	// Keys: "slack", "pagerduty", "discord", etc.
-	ExternalReferences map[string]string
+	// ExternalReferences link the alert to messages and incidents in
+	// external systems such as "slack" and "pagerduty".
+	ExternalReferences ExternalReferences
+
+	// GroupKey is the Alertmanager groupKey of the webhook that last carried this alert.
+	// Empty for alerts that did not come from Alertmanager.
+	GroupKey string
+
+	// CustomFields holds values extracted by the tenant's custom field schema,
+	// keyed by field name. Values are validated and normalized on ingest
+	// (numbers in canonical decimal form).
+	CustomFields map[string]string
+
+	// Tags are free-form labels attached by responders (e.g. "network",
+	// "vendor-issue"). Unlike Labels they are editable after ingest.
+	// Kept normalized: lowercase, unique, sorted.
+	Tags []string
+
+	// Notes are responder comments, oldest first.
+	Notes []AlertNote
+
+	// History records severity and state transitions, oldest first.
+	// The initial severity and state at FiredAt are not included.
+	History []AlertTransition
 
 	// FiredAt is when the alert first fired.
 	FiredAt time.Time
 
+	// LastSeenAt is when the source last sent the alert as firing. Zero for
+	// alerts stored before it was tracked.
+	LastSeenAt time.Time
+
+	// EndsAt is when the source last said the alert would end unless sent
+	// again, such as Alertmanager's endsAt (optional).
+	EndsAt *time.Time
+
 	// AckedAt is when the alert was acknowledged.
 	AckedAt *time.Time
 
 	// AckedBy identifies who acknowledged the alert.
 	AckedBy string
 
+	// AssignedTo names who owns the alert. Unlike AckedBy, which records
+	// who first responded, it can be changed while the alert is open.
+	AssignedTo string
+
+	// AssigneeSlackID is the assignee's Slack user ID, used to mention them
+	// (optional).
+	AssigneeSlackID string
+
+	// AssignedAt is when the alert was last assigned.
+	AssignedAt *time.Time
+
 	// ResolvedAt is when the alert was resolved.
 	ResolvedAt *time.Time
 
@@ -87,21 +160,40 @@ type Alert struct {
 func NewAlert(fingerprint, name, instance, target, summary string, severity AlertSeverity) *Alert {
 	now := time.Now().UTC()
 	return &Alert{
-		ID:                 uuid.New().String(),
-		Fingerprint:        fingerprint,
-		Name:               name,
-		Instance:           instance,
-		Target:             target,
-		Summary:            summary,
-		Severity:           severity,
-		State:              StateActive,
-		Labels:             make(map[string]string),
-		Annotations:        make(map[string]string),
-		ExternalReferences: make(map[string]string),
-		FiredAt:            now,
-		CreatedAt:          now,
-		UpdatedAt:          now,
+		ID:          uuid.New().String(),
+		Fingerprint: fingerprint,
+		Name:        name,
+		Instance:    instance,
+		Target:      target,
+		Summary:     summary,
+		Severity:    severity,
+		State:       StateActive,
+		Labels:      make(map[string]string),
+		Annotations: make(map[string]string),
+		FiredAt:     now,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+// Seen records that the source sent the alert as firing at the given time,
+// with the end time it reported, if any.
+func (a *Alert) Seen(at, endsAt time.Time) {
+	a.LastSeenAt = at
+	a.EndsAt = nil
+	if !endsAt.IsZero() {
+		endsAt = endsAt.UTC()
+		a.EndsAt = &endsAt
+	}
+}
+
+// LastSeen returns when the source last sent the alert, falling back to
+// when it fired.
+func (a *Alert) LastSeen() time.Time {
+	if a.LastSeenAt.IsZero() {
+		return a.FiredAt
 	}
+	return a.LastSeenAt
 }
 
 // Acknowledge marks the alert as acknowledged.
@@ -115,6 +207,7 @@ func (a *Alert) Acknowledge(by string, at time.Time) error {
 		return ErrAlertAlreadyAcked
 	}
 
+	a.recordTransition(a.Severity, StateAcked, by, at)
 	a.State = StateAcked
 	a.AckedAt = &at
 	a.AckedBy = by
@@ -122,13 +215,125 @@ func (a *Alert) Acknowledge(by string, at time.Time) error {
 	return nil
 }
 
+// Unacknowledge returns an acknowledged alert to active, such as when its
+// PagerDuty incident's acknowledgment times out.
+// Returns ErrAlertAlreadyResolved if the alert is already resolved.
+// Returns ErrInvalidAlertState if the alert is not acknowledged.
+func (a *Alert) Unacknowledge(by string, at time.Time) error {
+	if a.State == StateResolved {
+		return ErrAlertAlreadyResolved
+	}
+	if a.State != StateAcked {
+		return ErrInvalidAlertState
+	}
+
+	a.recordTransition(a.Severity, StateActive, by, at)
+	a.State = StateActive
+	a.AckedAt = nil
+	a.AckedBy = ""
+	a.UpdatedAt = at
+	return nil
+}
+
+// Assign makes name the owner of the alert, with their Slack user ID if
+// known. Returns ErrAlertAlreadyResolved if the alert is already resolved.
+func (a *Alert) Assign(name, slackID string, at time.Time) error {
+	if a.State == StateResolved {
+		return ErrAlertAlreadyResolved
+	}
+
+	a.AssignedTo = name
+	a.AssigneeSlackID = slackID
+	a.AssignedAt = &at
+	a.UpdatedAt = at
+	return nil
+}
+
+// IsAssigned returns true if someone owns the alert.
+func (a *Alert) IsAssigned() bool {
+	return a.AssignedTo != ""
+}
+
 // Resolve marks the alert as resolved.
 func (a *Alert) Resolve(at time.Time) {
+	a.ResolveBy("", at)
+}
+
+// ResolveBy marks the alert as resolved by a person, such as through the API
+// or PagerDuty, rather than by its source.
+func (a *Alert) ResolveBy(by string, at time.Time) {
+	a.recordTransition(a.Severity, StateResolved, by, at)
 	a.State = StateResolved
 	a.ResolvedAt = &at
 	a.UpdatedAt = at
 }
 
+// ResolvedBy returns who resolved the alert, or an empty string if its
+// source resolved it or it is not resolved.
+func (a *Alert) ResolvedBy() string {
+	for i := len(a.History) - 1; i >= 0; i-- {
+		if a.History[i].State == StateResolved {
+			return a.History[i].By
+		}
+	}
+	return ""
+}
+
+// ResolutionCategory classifies how an alert was resolved.
+type ResolutionCategory string
+
+const (
+	// ResolutionAuto means the source resolved the alert before anyone
+	// acknowledged it.
+	ResolutionAuto ResolutionCategory = "auto"
+
+	// ResolutionAfterAck means the source resolved the alert after it was
+	// acknowledged.
+	ResolutionAfterAck ResolutionCategory = "after_ack"
+
+	// ResolutionManual means a person resolved the alert.
+	ResolutionManual ResolutionCategory = "manual"
+)
+
+// ResolutionCategory returns how the alert was resolved, or an empty
+// category if it is not resolved.
+func (a *Alert) ResolutionCategory() ResolutionCategory {
+	switch {
+	case !a.IsResolved():
+		return ""
+	case a.ResolvedBy() != "":
+		return ResolutionManual
+	case a.AckedAt != nil:
+		return ResolutionAfterAck
+	default:
+		return ResolutionAuto
+	}
+}
+
+// ChangeSeverity updates the alert's severity.
+// Returns false if the severity is unchanged.
+func (a *Alert) ChangeSeverity(severity AlertSeverity, at time.Time) bool {
+	if severity == a.Severity {
+		return false
+	}
+	a.recordTransition(severity, a.State, "", at)
+	a.Severity = severity
+	a.UpdatedAt = at
+	return true
+}
+
+// recordTransition appends a history entry from the current severity and state.
+func (a *Alert) recordTransition(severity AlertSeverity, state AlertState, by string, at time.Time) {
+	a.History = append(a.History, AlertTransition{
+		At:               at,
+		PreviousSeverity: a.Severity,
+		Severity:         severity,
+		PreviousState:    a.State,
+		State:            state,
+		By:               by,
+	})
+}
+
 // IsActive returns true if the alert is in active state.
 func (a *Alert) IsActive() bool {
 	return a.State == StateActive
@@ -165,21 +370,45 @@ func (a *Alert) AddAnnotation(key, value string) {
 	a.Annotations[key] = value
 }
 
-// SetExternalReference sets an external system reference ID.
+// SetExternalReference sets the primary reference ID of an external system.
 func (a *Alert) SetExternalReference(system, referenceID string) {
-	if a.ExternalReferences == nil {
-		a.ExternalReferences = make(map[string]string)
+	ref, ok := a.ExternalReferences.Get(system, "")
+	if !ok {
+		ref = ExternalReference{System: system, CreatedAt: time.Now().UTC()}
+	}
+	ref.ReferenceID = referenceID
+	a.PutExternalReference(ref)
+}
+
+// PutExternalReference adds a reference, replacing the one of the same
+// system and key.
+func (a *Alert) PutExternalReference(ref ExternalReference) {
+	if ref.CreatedAt.IsZero() {
+		ref.CreatedAt = time.Now().UTC()
 	}
-	a.ExternalReferences[system] = referenceID
+	a.ExternalReferences = a.ExternalReferences.With(ref)
 	a.UpdatedAt = time.Now().UTC()
 }
 
-// GetExternalReference returns the external reference ID for a system.
-func (a *Alert) GetExternalReference(system string) string {
-	if a.ExternalReferences == nil {
-		return ""
+// ClearExternalReference removes the primary reference of a system, such as
+// a message that no longer exists.
+func (a *Alert) ClearExternalReference(system string) {
+	if _, ok := a.ExternalReferences.Get(system, ""); !ok {
+		return
 	}
-	return a.ExternalReferences[system]
+	a.ExternalReferences = a.ExternalReferences.Without(system, "")
+	a.UpdatedAt = time.Now().UTC()
+}
+
+// GetExternalReference returns the primary reference ID of a system.
+func (a *Alert) GetExternalReference(system string) string {
+	ref, _ := a.ExternalReferences.Get(system, "")
+	return ref.ReferenceID
+}
+
+// ExternalReferencesOf returns the references of a system, primary first.
+func (a *Alert) ExternalReferencesOf(system string) ExternalReferences {
+	return a.ExternalReferences.Of(system)
 }
 
 // HasExternalReference checks if an external reference exists for a system.
@@ -187,6 +416,74 @@ func (a *Alert) HasExternalReference(system string) bool {
 	return a.GetExternalReference(system) != ""
 }
 
+// GetCustomField returns the value of a custom field, or empty string if not set.
+func (a *Alert) GetCustomField(name string) string {
+	if a.CustomFields == nil {
+		return ""
+	}
+	return a.CustomFields[name]
+}
+
+// maxTagLength bounds tag length so tags stay usable as filters and in stats.
+const maxTagLength = 50
+
+// SetTags replaces the alert's tags with the normalized form of tags.
+// Returns ErrInvalidTag if any tag is empty after trimming, too long, or
+// contains characters other than letters, digits, '-', '_' and '.'.
+func (a *Alert) SetTags(tags []string, at time.Time) error {
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if !isValidTag(tag) {
+			return fmt.Errorf("%w: %q", ErrInvalidTag, tag)
+		}
+		if !slices.Contains(normalized, tag) {
+			normalized = append(normalized, tag)
+		}
+	}
+	slices.Sort(normalized)
+
+	a.Tags = normalized
+	a.UpdatedAt = at
+	return nil
+}
+
+// HasTag returns true if the alert carries the given tag.
+func (a *Alert) HasTag(tag string) bool {
+	return slices.Contains(a.Tags, strings.ToLower(strings.TrimSpace(tag)))
+}
+
+// isValidTag reports whether tag is a non-empty, normalized tag.
+func isValidTag(tag string) bool {
+	if tag == "" || len(tag) > maxTagLength {
+		return false
+	}
+	for _, r := range tag {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '-', r == '_', r == '.':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// maxNoteLength bounds note length so notes fit in a Slack thread reply.
+const maxNoteLength = 2000
+
+// AddNote appends a responder note.
+// Returns ErrInvalidNote if text is empty after trimming or too long.
+func (a *Alert) AddNote(by, text string, at time.Time) error {
+	text = strings.TrimSpace(text)
+	if text == "" || len(text) > maxNoteLength {
+		return fmt.Errorf("%w: must be 1-%d characters", ErrInvalidNote, maxNoteLength)
+	}
+
+	a.Notes = append(a.Notes, AlertNote{At: at, By: by, Text: text})
+	a.UpdatedAt = at
+	return nil
+}
+
 // GetLabel returns the value of a label, or empty string if not found.
 func (a *Alert) GetLabel(key string) string {
 	if a.Labels == nil {
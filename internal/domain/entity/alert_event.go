@@ -0,0 +1,61 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AlertEventType identifies what happened to an alert.
+type AlertEventType string
+
+const (
+	AlertEventFired              AlertEventType = "fired"
+	AlertEventSeverityChanged    AlertEventType = "severity_changed"
+	AlertEventAcknowledged       AlertEventType = "acknowledged"
+	AlertEventUnacknowledged     AlertEventType = "unacknowledged"
+	AlertEventResolved           AlertEventType = "resolved"
+	AlertEventNotified           AlertEventType = "notified"
+	AlertEventNotificationFailed AlertEventType = "notification_failed"
+	AlertEventNotificationShed   AlertEventType = "notification_shed"
+	AlertEventNoteAdded          AlertEventType = "note_added"
+	AlertEventSilenced           AlertEventType = "silenced"
+	AlertEventAssigned           AlertEventType = "assigned"
+	AlertEventEscalated          AlertEventType = "escalated"
+)
+
+// AlertEvent is one entry of an alert's timeline: a state transition, a
+// notification, an acknowledgment, a note or a silence.
+// This is an immutable value object; the timeline is append-only.
+type AlertEvent struct {
+	// ID is the unique identifier for this event.
+	ID string
+
+	// AlertID references the alert the event happened to.
+	AlertID string
+
+	// Type identifies what happened.
+	Type AlertEventType
+
+	// By is who caused the event, empty when alert-bridge or the alert
+	// source did.
+	By string
+
+	// Detail describes the event, such as the notifier or the note text.
+	Detail string
+
+	// CreatedAt is when the event happened.
+	CreatedAt time.Time
+}
+
+// NewAlertEvent creates a new timeline event for an alert.
+func NewAlertEvent(alertID string, eventType AlertEventType, by, detail string) *AlertEvent {
+	return &AlertEvent{
+		ID:        uuid.New().String(),
+		AlertID:   alertID,
+		Type:      eventType,
+		By:        by,
+		Detail:    detail,
+		CreatedAt: time.Now().UTC(),
+	}
+}
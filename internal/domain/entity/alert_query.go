@@ -0,0 +1,104 @@
+package entity
+
+import (
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+// AlertQuery selects alerts by state, severity, labels, custom fields and
+// text, a page at a time. Zero-valued criteria match every alert.
+type AlertQuery struct {
+	// States lists the accepted states. Empty accepts any state.
+	States []AlertState
+
+	// Severity is the required severity, or empty for any.
+	Severity AlertSeverity
+
+	// Labels are label values an alert must all have.
+	Labels map[string]string
+
+	// Fields are custom field values an alert must all have.
+	Fields map[string]string
+
+	// ResolvedSince leaves out alerts resolved before it. Zero accepts any
+	// resolution time.
+	ResolvedSince time.Time
+
+	// Text must appear, case-insensitively, in the alert's name, summary,
+	// instance or target.
+	Text string
+
+	// Offset skips that many matches, newest fired first. It applies only
+	// with a Limit.
+	Offset int
+
+	// Limit caps the matches returned, or 0 for all of them.
+	Limit int
+}
+
+// Matches reports whether alert meets every criterion of the query.
+// Offset and Limit are not considered.
+func (q AlertQuery) Matches(alert *Alert) bool {
+	if len(q.States) > 0 && !slices.Contains(q.States, alert.State) {
+		return false
+	}
+	if q.Severity != "" && alert.Severity != q.Severity {
+		return false
+	}
+	for name, value := range q.Labels {
+		if alert.GetLabel(name) != value {
+			return false
+		}
+	}
+	for name, value := range q.Fields {
+		if alert.GetCustomField(name) != value {
+			return false
+		}
+	}
+	if !q.ResolvedSince.IsZero() && alert.ResolvedAt != nil && alert.ResolvedAt.Before(q.ResolvedSince) {
+		return false
+	}
+	if q.Text != "" {
+		text := strings.ToLower(q.Text)
+		for _, field := range []string{alert.Name, alert.Summary, alert.Instance, alert.Target} {
+			if strings.Contains(strings.ToLower(field), text) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// Page sorts matching alerts newest fired first, breaking ties by ID, and
+// returns the page selected by Offset and Limit.
+func (q AlertQuery) Page(alerts []*Alert) []*Alert {
+	sort.Slice(alerts, func(i, j int) bool {
+		if !alerts[i].FiredAt.Equal(alerts[j].FiredAt) {
+			return alerts[i].FiredAt.After(alerts[j].FiredAt)
+		}
+		return alerts[i].ID < alerts[j].ID
+	})
+	if q.Limit <= 0 {
+		return alerts
+	}
+	start := min(max(q.Offset, 0), len(alerts))
+	end := min(start+q.Limit, len(alerts))
+	return alerts[start:end]
+}
+
+// OnlyFiring reports whether the query only accepts firing states, so that
+// resolved alerts need not be searched.
+func (q AlertQuery) OnlyFiring() bool {
+	if len(q.States) == 0 {
+		return false
+	}
+	for _, state := range q.States {
+		if state == StateResolved {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,56 @@
+package entity
+
+import "time"
+
+// AlertReport is a periodic summary of the alerts matching a saved view.
+type AlertReport struct {
+	// Name identifies the report (from configuration).
+	Name string
+
+	// View is the saved view the report was rendered from.
+	View *SavedView
+
+	// PeriodStart and PeriodEnd bound the reporting window.
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+
+	// Open summarizes alerts still firing at the end of the period.
+	Open *AlertSummary
+
+	// NewCount is the number of alerts that fired during the period.
+	NewCount int
+
+	// ResolvedCount is the number of alerts resolved during the period.
+	ResolvedCount int
+
+	// AckedCount is the number of alerts acknowledged during the period.
+	AckedCount int
+
+	// MTTA is the mean time from firing to acknowledgment for alerts acked
+	// during the period. Zero when nothing was acknowledged.
+	MTTA time.Duration
+
+	// MTTR is the mean time from firing to resolution for alerts resolved
+	// during the period. Zero when nothing was resolved.
+	MTTR time.Duration
+
+	// NewBySeverity maps severity to the number of alerts that fired during the period.
+	NewBySeverity map[AlertSeverity]int
+}
+
+// NewAlertReport creates an empty report for the given window.
+func NewAlertReport(name string, view *SavedView, start, end time.Time) *AlertReport {
+	return &AlertReport{
+		Name:          name,
+		View:          view,
+		PeriodStart:   start,
+		PeriodEnd:     end,
+		Open:          NewAlertSummary(),
+		NewBySeverity: make(map[AlertSeverity]int),
+	}
+}
+
+// Period returns the length of the reporting window.
+func (r *AlertReport) Period() time.Duration {
+	return r.PeriodEnd.Sub(r.PeriodStart)
+}
@@ -0,0 +1,69 @@
+package entity
+
+import "time"
+
+// AlertSnapshot is an alert as it stood at a past instant, reconstructed by
+// replaying its history.
+type AlertSnapshot struct {
+	Alert *Alert
+
+	// At is the instant the snapshot describes.
+	At time.Time
+
+	// State and Severity are the values in effect at At.
+	State    AlertState
+	Severity AlertSeverity
+
+	// AckedBy and AckedAt are set when the alert was acknowledged at At.
+	AckedBy string
+	AckedAt *time.Time
+}
+
+// SnapshotAt replays the alert's history up to t.
+// Returns false if the alert had not fired yet at t.
+func (a *Alert) SnapshotAt(t time.Time) (*AlertSnapshot, bool) {
+	if t.Before(a.FiredAt) {
+		return nil, false
+	}
+
+	snap := &AlertSnapshot{Alert: a, At: t, State: StateActive, Severity: a.Severity}
+
+	if len(a.History) == 0 {
+		// Records from before history was kept: fall back to the timestamps
+		switch {
+		case a.ResolvedAt != nil && !t.Before(*a.ResolvedAt):
+			snap.State = StateResolved
+		case a.AckedAt != nil && !t.Before(*a.AckedAt):
+			snap.State = StateAcked
+			snap.AckedBy = a.AckedBy
+			snap.AckedAt = a.AckedAt
+		}
+		return snap, true
+	}
+
+	snap.State = a.History[0].PreviousState
+	snap.Severity = a.History[0].PreviousSeverity
+	for _, tr := range a.History {
+		if tr.At.After(t) {
+			break
+		}
+		if tr.State == StateAcked && tr.PreviousState != StateAcked {
+			at := tr.At
+			snap.AckedBy = tr.By
+			snap.AckedAt = &at
+		}
+		snap.State = tr.State
+		snap.Severity = tr.Severity
+	}
+	if snap.State != StateAcked {
+		snap.AckedBy = ""
+		snap.AckedAt = nil
+	}
+
+	return snap, true
+}
+
+// IsFiring returns true if the alert was active or acknowledged at At.
+func (s *AlertSnapshot) IsFiring() bool {
+	return s.State != StateResolved
+}
@@ -14,8 +14,22 @@ type AlertSummary struct {
 	// AlertsByInstance maps instance name to count.
 	AlertsByInstance map[string]int
 
+	// AlertsByTag maps responder tag to count.
+	AlertsByTag map[string]int
+
+	// AlertsByAssignee maps assignee to count.
+	AlertsByAssignee map[string]int
+
+	// UnassignedAlerts counts alerts nobody is assigned to.
+	UnassignedAlerts int
+
 	// TopAcknowledgers lists users who acknowledged the most alerts.
 	TopAcknowledgers []UserAckCount
+
+	// ResponseTimes covers the alerts fired, acknowledged and resolved
+	// during the summary's period, resolved ones included. Nil when not
+	// computed.
+	ResponseTimes *ResponseTimeReport
 }
 
 // UserAckCount represents acknowledgment count for a user.
@@ -36,6 +50,8 @@ func NewAlertSummary() *AlertSummary {
 		AlertsBySeverity: make(map[AlertSeverity]int),
 		AlertsByState:    make(map[AlertState]int),
 		AlertsByInstance: make(map[string]int),
+		AlertsByTag:      make(map[string]int),
+		AlertsByAssignee: make(map[string]int),
 		TopAcknowledgers: []UserAckCount{},
 	}
 }
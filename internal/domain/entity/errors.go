@@ -27,11 +27,39 @@ var (
 
 	// ErrInvalidSilenceDuration indicates an invalid silence duration was provided.
 	ErrInvalidSilenceDuration = errors.New("invalid silence duration")
+
+	// ErrInvalidSilenceRecurrence indicates a malformed recurring silence schedule.
+	ErrInvalidSilenceRecurrence = errors.New("invalid silence recurrence")
+
+	// ErrInvalidLabelMatcher indicates a malformed silence label matcher.
+	ErrInvalidLabelMatcher = errors.New("invalid label matcher")
+
+	// ErrInvalidTag indicates a responder tag with disallowed characters or length.
+	ErrInvalidTag = errors.New("invalid tag")
+
+	// ErrInvalidNote indicates an empty or overlong alert note.
+	ErrInvalidNote = errors.New("invalid note")
+
+	// ErrInvalidAlert indicates a manually raised alert without a name or
+	// with an unknown severity.
+	ErrInvalidAlert = errors.New("invalid alert")
+
+	// ErrSavedViewNotFound indicates the requested saved view does not exist.
+	ErrSavedViewNotFound = errors.New("saved view not found")
+
+	// ErrNotificationRetryNotFound indicates the requested queued notification does not exist.
+	ErrNotificationRetryNotFound = errors.New("notification retry not found")
+
+	// ErrInvalidViewName indicates a saved view name with disallowed characters or length.
+	ErrInvalidViewName = errors.New("invalid view name")
 )
 
 // IsNotFound checks if the error indicates a not-found condition.
 func IsNotFound(err error) bool {
-	return errors.Is(err, ErrAlertNotFound) || errors.Is(err, ErrSilenceNotFound)
+	return errors.Is(err, ErrAlertNotFound) ||
+		errors.Is(err, ErrSilenceNotFound) ||
+		errors.Is(err, ErrSavedViewNotFound) ||
+		errors.Is(err, ErrNotificationRetryNotFound)
 }
 
 // IsConflict checks if the error indicates a conflict condition.
@@ -0,0 +1,196 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// externalReferencesVersion is the version of the stored form of
+// ExternalReferences. Version 1 was a flat map of system to reference ID.
+const externalReferencesVersion = 2
+
+// ExternalReference links an alert to a notification or incident in an
+// external system.
+type ExternalReference struct {
+	// System is the notifier or integration, such as "slack" or "pagerduty".
+	System string
+
+	// Key tells apart several references of one system, such as the posts of
+	// a notifier that sends to more than one channel. Empty for the primary
+	// reference.
+	Key string
+
+	// ReferenceID identifies the notification in the system, such as
+	// "channel:timestamp" for Slack or the dedup key for PagerDuty.
+	ReferenceID string
+
+	// Permalink is a URL of the notification, if known.
+	Permalink string
+
+	// Channel is where the notification went, such as a Slack channel ID.
+	Channel string
+
+	// CreatedAt is when the reference was recorded.
+	CreatedAt time.Time
+}
+
+// NewExternalReference returns the primary reference of a system for a sent
+// notification.
+func NewExternalReference(system string, result NotifyResult) ExternalReference {
+	createdAt := result.DeliveredAt
+	if createdAt.IsZero() {
+		createdAt = time.Now().UTC()
+	}
+	return ExternalReference{
+		System:      system,
+		ReferenceID: result.ReferenceID,
+		Permalink:   result.Permalink,
+		Channel:     result.Channel,
+		CreatedAt:   createdAt,
+	}
+}
+
+// ExternalReferences are the references of an alert, at most one per system
+// and key.
+type ExternalReferences []ExternalReference
+
+// Get returns the reference of a system with the given key.
+func (r ExternalReferences) Get(system, key string) (ExternalReference, bool) {
+	for _, ref := range r {
+		if ref.System == system && ref.Key == key {
+			return ref, true
+		}
+	}
+	return ExternalReference{}, false
+}
+
+// Of returns the references of a system, primary first.
+func (r ExternalReferences) Of(system string) ExternalReferences {
+	var refs ExternalReferences
+	for _, ref := range r {
+		if ref.System == system {
+			refs = append(refs, ref)
+		}
+	}
+	sort.SliceStable(refs, func(i, j int) bool { return refs[i].Key < refs[j].Key })
+	return refs
+}
+
+// Contains reports whether a system has a reference with the given ID,
+// under any key.
+func (r ExternalReferences) Contains(system, referenceID string) bool {
+	for _, ref := range r {
+		if ref.System == system && ref.ReferenceID == referenceID {
+			return true
+		}
+	}
+	return false
+}
+
+// With returns the references with ref added, replacing the reference of
+// the same system and key. The receiver is not modified, so copies of an
+// alert do not share changes.
+func (r ExternalReferences) With(ref ExternalReference) ExternalReferences {
+	refs := make(ExternalReferences, 0, len(r)+1)
+	replaced := false
+	for _, existing := range r {
+		if existing.System == ref.System && existing.Key == ref.Key {
+			existing, replaced = ref, true
+		}
+		refs = append(refs, existing)
+	}
+	if !replaced {
+		refs = append(refs, ref)
+	}
+	return refs
+}
+
+// Without returns the references without the one of system and key. The
+// receiver is not modified.
+func (r ExternalReferences) Without(system, key string) ExternalReferences {
+	refs := make(ExternalReferences, 0, len(r))
+	for _, ref := range r {
+		if ref.System != system || ref.Key != key {
+			refs = append(refs, ref)
+		}
+	}
+	return refs
+}
+
+type externalReferenceJSON struct {
+	System      string    `json:"system"`
+	Key         string    `json:"key,omitempty"`
+	ReferenceID string    `json:"reference_id"`
+	Permalink   string    `json:"permalink,omitempty"`
+	Channel     string    `json:"channel,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type externalReferencesJSON struct {
+	Version    int                     `json:"version"`
+	References []externalReferenceJSON `json:"references"`
+}
+
+// MarshalJSON stores the references with the version of their format, so
+// older stored forms can be told apart.
+func (r ExternalReferences) MarshalJSON() ([]byte, error) {
+	stored := externalReferencesJSON{
+		Version:    externalReferencesVersion,
+		References: make([]externalReferenceJSON, 0, len(r)),
+	}
+	for _, ref := range r {
+		stored.References = append(stored.References, externalReferenceJSON(ref))
+	}
+	return json.Marshal(stored)
+}
+
+// UnmarshalJSON restores stored references, including the flat map of
+// system to reference ID of version 1.
+func (r *ExternalReferences) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if fields == nil {
+		*r = nil
+		return nil
+	}
+
+	var version int
+	if raw, ok := fields["version"]; !ok || json.Unmarshal(raw, &version) != nil {
+		// Version 1: a "version" system holds a string, not a number
+		return r.unmarshalFlat(data)
+	}
+	if version != externalReferencesVersion {
+		return fmt.Errorf("unsupported external references version %d", version)
+	}
+
+	var stored externalReferencesJSON
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return err
+	}
+	refs := make(ExternalReferences, 0, len(stored.References))
+	for _, ref := range stored.References {
+		refs = append(refs, ExternalReference(ref))
+	}
+	*r = refs
+	return nil
+}
+
+func (r *ExternalReferences) unmarshalFlat(data []byte) error {
+	var flat map[string]string
+	if err := json.Unmarshal(data, &flat); err != nil {
+		return err
+	}
+	refs := make(ExternalReferences, 0, len(flat))
+	for system, referenceID := range flat {
+		if referenceID != "" {
+			refs = append(refs, ExternalReference{System: system, ReferenceID: referenceID})
+		}
+	}
+	sort.Slice(refs, func(i, j int) bool { return refs[i].System < refs[j].System })
+	*r = refs
+	return nil
+}
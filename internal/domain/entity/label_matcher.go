@@ -0,0 +1,137 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// MatchType is how a LabelMatcher compares a label value.
+type MatchType string
+
+const (
+	// MatchEqual matches the exact value.
+	MatchEqual MatchType = "="
+
+	// MatchNotEqual matches any other value.
+	MatchNotEqual MatchType = "!="
+
+	// MatchRegexp matches values the regular expression fully matches.
+	MatchRegexp MatchType = "=~"
+
+	// MatchNotRegexp matches values the regular expression does not fully match.
+	MatchNotRegexp MatchType = "!~"
+)
+
+// LabelMatcher matches one alert label, written like label=value,
+// label!=value, label=~regex or label!~regex. A missing label matches as an
+// empty value, so env!=prod also matches alerts without an env label.
+type LabelMatcher struct {
+	Name  string
+	Type  MatchType
+	Value string
+
+	// re is the anchored Value of regex matchers.
+	re *regexp.Regexp
+}
+
+// NewLabelMatcher creates a matcher, compiling the regular expression of
+// regex matchers. Errors wrap ErrInvalidLabelMatcher.
+func NewLabelMatcher(name string, matchType MatchType, value string) (LabelMatcher, error) {
+	m := LabelMatcher{Name: name, Type: matchType, Value: value}
+	if name == "" {
+		return LabelMatcher{}, fmt.Errorf("%w: label name is required", ErrInvalidLabelMatcher)
+	}
+
+	switch matchType {
+	case MatchEqual, MatchNotEqual:
+	case MatchRegexp, MatchNotRegexp:
+		re, err := regexp.Compile("^(?:" + value + ")$")
+		if err != nil {
+			return LabelMatcher{}, fmt.Errorf("%w: %s: %v", ErrInvalidLabelMatcher, name, err)
+		}
+		m.re = re
+	default:
+		return LabelMatcher{}, fmt.Errorf("%w: unknown match type %q", ErrInvalidLabelMatcher, matchType)
+	}
+	return m, nil
+}
+
+// ParseLabelMatcher parses a matcher such as service=~"pay.*". The value may
+// be double-quoted.
+func ParseLabelMatcher(s string) (LabelMatcher, error) {
+	i := strings.IndexAny(s, "=!")
+	if i < 0 {
+		return LabelMatcher{}, fmt.Errorf("%w: %q has no operator", ErrInvalidLabelMatcher, s)
+	}
+
+	name, rest := strings.TrimSpace(s[:i]), s[i:]
+	matchType := MatchEqual
+	for _, t := range []MatchType{MatchRegexp, MatchNotRegexp, MatchNotEqual} {
+		if strings.HasPrefix(rest, string(t)) {
+			matchType = t
+			break
+		}
+	}
+	if matchType == MatchEqual && !strings.HasPrefix(rest, "=") {
+		return LabelMatcher{}, fmt.Errorf("%w: %q has no operator", ErrInvalidLabelMatcher, s)
+	}
+
+	value := strings.TrimSpace(rest[len(matchType):])
+	if len(value) >= 2 && strings.HasPrefix(value, `"`) && strings.HasSuffix(value, `"`) {
+		value = value[1 : len(value)-1]
+	}
+	return NewLabelMatcher(name, matchType, value)
+}
+
+// Matches reports whether the labels satisfy the matcher.
+func (m LabelMatcher) Matches(labels map[string]string) bool {
+	value := labels[m.Name]
+	switch m.Type {
+	case MatchNotEqual:
+		return value != m.Value
+	case MatchRegexp:
+		return m.re != nil && m.re.MatchString(value)
+	case MatchNotRegexp:
+		return m.re != nil && !m.re.MatchString(value)
+	default:
+		return value == m.Value
+	}
+}
+
+// MatchesEmpty reports whether the matcher matches alerts without the
+// label. A silence made only of such matchers would match almost every alert.
+func (m LabelMatcher) MatchesEmpty() bool {
+	return m.Matches(nil)
+}
+
+// String returns the matcher in its label!~"value" form.
+func (m LabelMatcher) String() string {
+	return fmt.Sprintf("%s%s%q", m.Name, m.Type, m.Value)
+}
+
+type labelMatcherJSON struct {
+	Name  string    `json:"name"`
+	Type  MatchType `json:"type"`
+	Value string    `json:"value"`
+}
+
+// MarshalJSON stores the matcher as its name, type and value.
+func (m LabelMatcher) MarshalJSON() ([]byte, error) {
+	return json.Marshal(labelMatcherJSON{Name: m.Name, Type: m.Type, Value: m.Value})
+}
+
+// UnmarshalJSON restores a stored matcher, compiling its regular expression.
+func (m *LabelMatcher) UnmarshalJSON(data []byte) error {
+	var stored labelMatcherJSON
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return err
+	}
+	parsed, err := NewLabelMatcher(stored.Name, stored.Type, stored.Value)
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
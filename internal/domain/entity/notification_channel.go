@@ -0,0 +1,18 @@
+package entity
+
+import "context"
+
+type notificationChannelKey struct{}
+
+// WithNotificationChannel returns a context asking the notifier to deliver
+// to channel instead of its configured one (e.g. a routing override).
+func WithNotificationChannel(ctx context.Context, channel string) context.Context {
+	return context.WithValue(ctx, notificationChannelKey{}, channel)
+}
+
+// NotificationChannelFromContext returns the channel override in ctx, or an
+// empty string if there is none.
+func NotificationChannelFromContext(ctx context.Context) string {
+	channel, _ := ctx.Value(notificationChannelKey{}).(string)
+	return channel
+}
@@ -0,0 +1,113 @@
+package entity
+
+import "time"
+
+// NotificationAction is the notifier call a retry repeats.
+type NotificationAction string
+
+const (
+	// NotificationActionNotify posts the initial notification.
+	NotificationActionNotify NotificationAction = "notify"
+	// NotificationActionUpdate updates an existing notification.
+	NotificationActionUpdate NotificationAction = "update"
+)
+
+// RetryStatus is the lifecycle state of a queued notification.
+type RetryStatus string
+
+const (
+	// RetryStatusPending retries are attempted again at NextAttemptAt.
+	RetryStatusPending RetryStatus = "pending"
+	// RetryStatusDead retries exhausted their attempts or failed
+	// permanently, and stay in the dead-letter store until removed.
+	RetryStatusDead RetryStatus = "dead"
+)
+
+// NotificationRetry is a failed notifier call queued for another attempt.
+// Retries carry no alert content: each attempt sends the alert's state at
+// that time.
+type NotificationRetry struct {
+	// ID identifies the retry. There is at most one retry per alert,
+	// notifier and action, so a newer failure replaces an older one.
+	ID string
+
+	AlertID  string
+	Notifier string
+	Action   NotificationAction
+	Status   RetryStatus
+
+	// Attempts counts the failed calls, including the original one.
+	Attempts  int
+	LastError string
+
+	NextAttemptAt time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// NewNotificationRetry queues a notifier call that failed once with lastErr.
+func NewNotificationRetry(alertID, notifier string, action NotificationAction, lastErr string, nextAttemptAt time.Time) *NotificationRetry {
+	now := time.Now().UTC()
+	return &NotificationRetry{
+		ID:            NotificationRetryID(alertID, notifier, action),
+		AlertID:       alertID,
+		Notifier:      notifier,
+		Action:        action,
+		Status:        RetryStatusPending,
+		Attempts:      1,
+		LastError:     lastErr,
+		NextAttemptAt: nextAttemptAt.UTC(),
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+}
+
+// NotificationRetryID returns the ID of the retry for an alert, notifier
+// and action.
+func NotificationRetryID(alertID, notifier string, action NotificationAction) string {
+	return alertID + "/" + notifier + "/" + string(action)
+}
+
+// RecordFailure counts another failed attempt and schedules the next one.
+func (r *NotificationRetry) RecordFailure(lastErr string, nextAttemptAt time.Time) {
+	r.Attempts++
+	r.LastError = lastErr
+	r.NextAttemptAt = nextAttemptAt.UTC()
+	r.UpdatedAt = time.Now().UTC()
+}
+
+// Defer schedules the next attempt without counting a failed one, such as
+// while the notifier is known to be down.
+func (r *NotificationRetry) Defer(lastErr string, nextAttemptAt time.Time) {
+	r.LastError = lastErr
+	r.NextAttemptAt = nextAttemptAt.UTC()
+	r.UpdatedAt = time.Now().UTC()
+}
+
+// MarkDead moves the retry to the dead-letter store after a final failure.
+func (r *NotificationRetry) MarkDead(lastErr string) {
+	r.Attempts++
+	r.LastError = lastErr
+	r.Status = RetryStatusDead
+	r.UpdatedAt = time.Now().UTC()
+}
+
+// Requeue moves a dead-lettered retry back to the queue, due at now, with
+// a fresh set of attempts. The last error is kept until the next attempt.
+func (r *NotificationRetry) Requeue(now time.Time) {
+	r.Status = RetryStatusPending
+	r.Attempts = 0
+	r.NextAttemptAt = now.UTC()
+	r.UpdatedAt = time.Now().UTC()
+}
+
+// IsDead returns true if the retry will not be attempted again.
+func (r *NotificationRetry) IsDead() bool {
+	return r.Status == RetryStatusDead
+}
+
+// Copy returns a copy of the retry.
+func (r *NotificationRetry) Copy() *NotificationRetry {
+	c := *r
+	return &c
+}
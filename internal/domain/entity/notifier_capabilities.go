@@ -0,0 +1,16 @@
+package entity
+
+// NotifierCapabilities describes what a notifier supports beyond posting
+// alerts.
+type NotifierCapabilities struct {
+	// Update is true if later state changes edit the posted notification,
+	// rather than sending a new one.
+	Update bool
+
+	// Mentions is true if the notifier mentions matching subscribers.
+	Mentions bool
+
+	// Ack is true if responders can acknowledge alerts from the
+	// notification.
+	Ack bool
+}
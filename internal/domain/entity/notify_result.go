@@ -0,0 +1,27 @@
+package entity
+
+import "time"
+
+// NotifyResult describes a notification a notifier sent, or failed to send.
+type NotifyResult struct {
+	// ReferenceID identifies the notification in its channel for later
+	// updates, such as "channel:timestamp" for Slack or the dedup key for
+	// PagerDuty. Empty if the notification cannot be updated.
+	ReferenceID string
+
+	// Permalink is a URL of the notification, if known without an extra
+	// call.
+	Permalink string
+
+	// Channel is where the notification went, such as a Slack channel ID
+	// or email recipients.
+	Channel string
+
+	// DeliveredAt is when the channel accepted the notification. Zero if it
+	// was not sent.
+	DeliveredAt time.Time
+
+	// Retriable is true if a failed notification may succeed when sent
+	// again, such as after a timeout or rate limit.
+	Retriable bool
+}
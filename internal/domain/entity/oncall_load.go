@@ -0,0 +1,162 @@
+package entity
+
+import (
+	"sort"
+	"time"
+)
+
+// OnCallLoad counts the pages and acknowledgments of a person or team.
+type OnCallLoad struct {
+	// Pages is the number of alerts that fired for the person or team.
+	Pages int
+
+	// Acks is the number of alerts acknowledged.
+	Acks int
+
+	// AfterHoursAcks is the number of Acks made outside working hours.
+	AfterHoursAcks int
+}
+
+// PersonOnCallLoad is one person's load. A person is a subscriber, or for
+// acknowledgers who are not subscribers, their email or display name.
+type PersonOnCallLoad struct {
+	OnCallLoad
+
+	// Name is the subscriber name, email or display name.
+	Name string
+
+	// SlackUserID is the person's Slack user ID, when known.
+	SlackUserID string
+}
+
+// TeamOnCallLoad is one team's load and its breakdown per person.
+type TeamOnCallLoad struct {
+	OnCallLoad
+
+	// Team is the value of the team label on the team's alerts.
+	Team string
+
+	// People are the team's members with pages or acks, by name.
+	People []*PersonOnCallLoad
+}
+
+// OnCallLoadReport is the on-call load of every person and team for one
+// calendar month.
+type OnCallLoadReport struct {
+	// Month is the reported month, e.g. "2026-09".
+	Month string
+
+	// PeriodStart and PeriodEnd bound the month in the report's time zone.
+	// PeriodEnd is the start of the next month, or now for the current one.
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+
+	// People have pages or acks in the month, by name.
+	People []*PersonOnCallLoad
+
+	// Teams have pages or acks in the month, by name.
+	Teams []*TeamOnCallLoad
+
+	people map[string]*PersonOnCallLoad
+	teams  map[string]*TeamOnCallLoad
+	team   map[string]map[string]*PersonOnCallLoad
+}
+
+// NewOnCallLoadReport creates an empty report for the month starting at start.
+func NewOnCallLoadReport(start, end time.Time) *OnCallLoadReport {
+	return &OnCallLoadReport{
+		Month:       start.Format("2006-01"),
+		PeriodStart: start,
+		PeriodEnd:   end,
+		people:      make(map[string]*PersonOnCallLoad),
+		teams:       make(map[string]*TeamOnCallLoad),
+		team:        make(map[string]map[string]*PersonOnCallLoad),
+	}
+}
+
+// AddPage counts a page for the person, and for the team unless team is empty.
+func (r *OnCallLoadReport) AddPage(team, name, slackUserID string) {
+	r.person(name, slackUserID).Pages++
+	if team != "" {
+		r.teamLoad(team).Pages++
+		r.teamPerson(team, name, slackUserID).Pages++
+	}
+}
+
+// AddTeamPage counts a page for the team that no person received.
+func (r *OnCallLoadReport) AddTeamPage(team string) {
+	if team != "" {
+		r.teamLoad(team).Pages++
+	}
+}
+
+// AddAck counts an acknowledgment by the person, and for the team unless
+// team is empty.
+func (r *OnCallLoadReport) AddAck(team, name, slackUserID string, afterHours bool) {
+	loads := []*OnCallLoad{&r.person(name, slackUserID).OnCallLoad}
+	if team != "" {
+		loads = append(loads, &r.teamLoad(team).OnCallLoad, &r.teamPerson(team, name, slackUserID).OnCallLoad)
+	}
+	for _, load := range loads {
+		load.Acks++
+		if afterHours {
+			load.AfterHoursAcks++
+		}
+	}
+}
+
+// Finish sorts People and Teams by name.
+func (r *OnCallLoadReport) Finish() {
+	r.People = sortedPeople(r.people)
+	r.Teams = make([]*TeamOnCallLoad, 0, len(r.teams))
+	for name, team := range r.teams {
+		team.People = sortedPeople(r.team[name])
+		r.Teams = append(r.Teams, team)
+	}
+	sort.Slice(r.Teams, func(i, j int) bool { return r.Teams[i].Team < r.Teams[j].Team })
+}
+
+// Person returns the load of the named person, or nil if they have none.
+func (r *OnCallLoadReport) Person(name string) *PersonOnCallLoad {
+	return r.people[name]
+}
+
+func (r *OnCallLoadReport) person(name, slackUserID string) *PersonOnCallLoad {
+	return personIn(r.people, name, slackUserID)
+}
+
+func (r *OnCallLoadReport) teamLoad(team string) *TeamOnCallLoad {
+	load, ok := r.teams[team]
+	if !ok {
+		load = &TeamOnCallLoad{Team: team}
+		r.teams[team] = load
+		r.team[team] = make(map[string]*PersonOnCallLoad)
+	}
+	return load
+}
+
+func (r *OnCallLoadReport) teamPerson(team, name, slackUserID string) *PersonOnCallLoad {
+	r.teamLoad(team)
+	return personIn(r.team[team], name, slackUserID)
+}
+
+func personIn(people map[string]*PersonOnCallLoad, name, slackUserID string) *PersonOnCallLoad {
+	load, ok := people[name]
+	if !ok {
+		load = &PersonOnCallLoad{Name: name}
+		people[name] = load
+	}
+	if load.SlackUserID == "" {
+		load.SlackUserID = slackUserID
+	}
+	return load
+}
+
+func sortedPeople(people map[string]*PersonOnCallLoad) []*PersonOnCallLoad {
+	sorted := make([]*PersonOnCallLoad, 0, len(people))
+	for _, load := range people {
+		sorted = append(sorted, load)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+	return sorted
+}
@@ -0,0 +1,145 @@
+package entity
+
+import (
+	"sort"
+	"time"
+)
+
+// ResponseTimes counts the alerts fired, acknowledged and resolved in a
+// period, and how long acknowledging and resolving them took.
+type ResponseTimes struct {
+	// Fired is the number of alerts that fired in the period.
+	Fired int
+
+	// Acked is the number of alerts acknowledged in the period.
+	Acked int
+
+	// Resolved is the number of alerts resolved in the period.
+	Resolved int
+
+	// MTTA is the mean time from firing to acknowledgment of the Acked
+	// alerts, or zero if there were none.
+	MTTA time.Duration
+
+	// MTTR is the mean time from firing to resolution of the Resolved
+	// alerts, or zero if there were none.
+	MTTR time.Duration
+
+	ackTotal     time.Duration
+	resolveTotal time.Duration
+}
+
+func (t *ResponseTimes) finish() {
+	if t.Acked > 0 {
+		t.MTTA = t.ackTotal / time.Duration(t.Acked)
+	}
+	if t.Resolved > 0 {
+		t.MTTR = t.resolveTotal / time.Duration(t.Resolved)
+	}
+}
+
+// ResponseTimeGroup is the response times of the alerts sharing an alert
+// name, team or severity.
+type ResponseTimeGroup struct {
+	ResponseTimes
+
+	// Key is the alert name, team or severity of the group.
+	Key string
+}
+
+// ResponseTimeReport is the response times of all alerts over a period and
+// their breakdown per alert name, team and severity.
+type ResponseTimeReport struct {
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+
+	// Overall covers every alert.
+	Overall ResponseTimes
+
+	// ByName, ByTeam and BySeverity are sorted by key. Alerts without a
+	// team label are left out of ByTeam.
+	ByName     []*ResponseTimeGroup
+	ByTeam     []*ResponseTimeGroup
+	BySeverity []*ResponseTimeGroup
+
+	byName     map[string]*ResponseTimeGroup
+	byTeam     map[string]*ResponseTimeGroup
+	bySeverity map[string]*ResponseTimeGroup
+}
+
+// NewResponseTimeReport creates an empty report for the period from start
+// to end.
+func NewResponseTimeReport(start, end time.Time) *ResponseTimeReport {
+	return &ResponseTimeReport{
+		PeriodStart: start,
+		PeriodEnd:   end,
+		byName:      make(map[string]*ResponseTimeGroup),
+		byTeam:      make(map[string]*ResponseTimeGroup),
+		bySeverity:  make(map[string]*ResponseTimeGroup),
+	}
+}
+
+// Add counts the alert's firing, acknowledgment and resolution, each if it
+// happened within the period.
+func (r *ResponseTimeReport) Add(alert *Alert, team string) {
+	fired := inPeriod(alert.FiredAt, r.PeriodStart, r.PeriodEnd)
+	acked := alert.AckedAt != nil && inPeriod(*alert.AckedAt, r.PeriodStart, r.PeriodEnd)
+	resolved := alert.ResolvedAt != nil && inPeriod(*alert.ResolvedAt, r.PeriodStart, r.PeriodEnd)
+	if !fired && !acked && !resolved {
+		return
+	}
+
+	times := []*ResponseTimes{
+		&r.Overall,
+		&groupIn(r.byName, alert.Name).ResponseTimes,
+		&groupIn(r.bySeverity, string(alert.Severity)).ResponseTimes,
+	}
+	if team != "" {
+		times = append(times, &groupIn(r.byTeam, team).ResponseTimes)
+	}
+	for _, t := range times {
+		if fired {
+			t.Fired++
+		}
+		if acked {
+			t.Acked++
+			t.ackTotal += alert.AckedAt.Sub(alert.FiredAt)
+		}
+		if resolved {
+			t.Resolved++
+			t.resolveTotal += alert.ResolvedAt.Sub(alert.FiredAt)
+		}
+	}
+}
+
+// Finish computes the means and sorts the groups by key.
+func (r *ResponseTimeReport) Finish() {
+	r.Overall.finish()
+	r.ByName = sortedGroups(r.byName)
+	r.ByTeam = sortedGroups(r.byTeam)
+	r.BySeverity = sortedGroups(r.bySeverity)
+}
+
+func groupIn(groups map[string]*ResponseTimeGroup, key string) *ResponseTimeGroup {
+	group, ok := groups[key]
+	if !ok {
+		group = &ResponseTimeGroup{Key: key}
+		groups[key] = group
+	}
+	return group
+}
+
+func sortedGroups(groups map[string]*ResponseTimeGroup) []*ResponseTimeGroup {
+	sorted := make([]*ResponseTimeGroup, 0, len(groups))
+	for _, group := range groups {
+		group.finish()
+		sorted = append(sorted, group)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Key < sorted[j].Key })
+	return sorted
+}
+
+// inPeriod reports whether t falls within [start, end].
+func inPeriod(t, start, end time.Time) bool {
+	return !t.Before(start) && !t.After(end)
+}
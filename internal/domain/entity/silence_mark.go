@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"sort"
 	"time"
 
 	"github.com/google/uuid"
@@ -26,12 +27,20 @@ type SilenceMark struct {
 	// Supports partial matching - alert must have all specified labels.
 	Labels map[string]string
 
+	// Matchers match labels by inequality or regular expression (optional).
+	// Alerts must satisfy all of them as well as Labels.
+	Matchers []LabelMatcher
+
 	// StartAt is when the silence starts.
 	StartAt time.Time
 
 	// EndAt is when the silence expires.
 	EndAt time.Time
 
+	// Recurrence limits the silence to a repeating window between StartAt
+	// and EndAt (optional).
+	Recurrence *SilenceRecurrence
+
 	// CreatedBy identifies who created the silence.
 	CreatedBy string
 
@@ -105,6 +114,41 @@ func (s *SilenceMark) WithMatchers(matchers map[string]string) *SilenceMark {
 	return s
 }
 
+// WithLabelMatchers adds label matchers to the silence. Equality matchers
+// are kept in Labels.
+func (s *SilenceMark) WithLabelMatchers(matchers []LabelMatcher) *SilenceMark {
+	for _, m := range matchers {
+		if m.Type == MatchEqual {
+			s.WithLabel(m.Name, m.Value)
+		} else {
+			s.Matchers = append(s.Matchers, m)
+		}
+	}
+	return s
+}
+
+// LabelMatchers returns all label matchers of the silence, equality
+// matchers first, sorted by label name.
+func (s *SilenceMark) LabelMatchers() []LabelMatcher {
+	names := make([]string, 0, len(s.Labels))
+	for name := range s.Labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	matchers := make([]LabelMatcher, 0, len(s.Labels)+len(s.Matchers))
+	for _, name := range names {
+		matchers = append(matchers, LabelMatcher{Name: name, Type: MatchEqual, Value: s.Labels[name]})
+	}
+	return append(matchers, s.Matchers...)
+}
+
+// WithRecurrence limits the silence to a repeating window.
+func (s *SilenceMark) WithRecurrence(recurrence *SilenceRecurrence) *SilenceMark {
+	s.Recurrence = recurrence
+	return s
+}
+
 // WithReason sets the reason for the silence.
 func (s *SilenceMark) WithReason(reason string) *SilenceMark {
 	s.Reason = reason
@@ -117,6 +161,15 @@ func (s *SilenceMark) IsActive() bool {
 	return now.After(s.StartAt) && now.Before(s.EndAt)
 }
 
+// IsSilencing returns true if the silence is active and, for a recurring
+// silence, inside one of its windows.
+func (s *SilenceMark) IsSilencing() bool {
+	if !s.IsActive() {
+		return false
+	}
+	return s.Recurrence == nil || s.Recurrence.Covers(time.Now())
+}
+
 // IsExpired returns true if the silence has expired.
 func (s *SilenceMark) IsExpired() bool {
 	return time.Now().UTC().After(s.EndAt)
@@ -153,8 +206,8 @@ func (s *SilenceMark) Extend(duration time.Duration) error {
 
 // MatchesAlert checks if this silence applies to the given alert.
 func (s *SilenceMark) MatchesAlert(alert *Alert) bool {
-	// Check if silence is active
-	if !s.IsActive() {
+	// Check if silence is active, and inside its window if recurring
+	if !s.IsSilencing() {
 		return false
 	}
 
@@ -171,26 +224,29 @@ func (s *SilenceMark) MatchesAlert(alert *Alert) bool {
 	// Check instance match
 	if s.Instance != "" && s.Instance == alert.Instance {
 		// If labels are specified, all must match
-		if len(s.Labels) > 0 {
-			return s.matchesLabels(alert.Labels)
-		}
-		return true
+		return s.matchesLabels(alert.Labels)
 	}
 
 	// Check label-only match (instance not specified)
-	if s.AlertID == "" && s.Fingerprint == "" && s.Instance == "" && len(s.Labels) > 0 {
+	if s.AlertID == "" && s.Fingerprint == "" && s.Instance == "" && (len(s.Labels) > 0 || len(s.Matchers) > 0) {
 		return s.matchesLabels(alert.Labels)
 	}
 
 	return false
 }
 
-// matchesLabels checks if all silence labels are present in the alert labels.
+// matchesLabels checks if all silence labels are present in the alert labels
+// and all matchers are satisfied.
 func (s *SilenceMark) matchesLabels(alertLabels map[string]string) bool {
 	for key, value := range s.Labels {
 		if alertLabels[key] != value {
 			return false
 		}
 	}
+	for _, m := range s.Matchers {
+		if !m.Matches(alertLabels) {
+			return false
+		}
+	}
 	return true
 }
@@ -0,0 +1,175 @@
+package entity
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SilenceRecurrence limits a silence to a window that repeats daily or on
+// some weekdays, such as every night 02:00-04:00 UTC for backup jobs. It is
+// written in an RRULE-like form:
+//
+//	FREQ=DAILY;BYHOUR=2;BYMINUTE=0;DURATION=2h
+//	FREQ=WEEKLY;BYDAY=SA,SU;BYHOUR=22;DURATION=6h;TZID=Europe/Berlin
+//
+// A window that starts on one day may end on the next.
+type SilenceRecurrence struct {
+	// Weekdays the window starts on; empty means every day.
+	Weekdays []time.Weekday
+
+	Hour     int
+	Minute   int
+	Duration time.Duration
+
+	// Location is the time zone of Hour and Minute. Defaults to UTC.
+	Location *time.Location
+}
+
+var rruleWeekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// ParseSilenceRecurrence parses an RRULE-like recurrence. FREQ and DURATION
+// are required, and WEEKLY recurrences need BYDAY. Errors wrap
+// ErrInvalidSilenceRecurrence.
+func ParseSilenceRecurrence(rule string) (*SilenceRecurrence, error) {
+	r := &SilenceRecurrence{Location: time.UTC}
+	var freq string
+
+	for _, part := range strings.Split(rule, ";") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return nil, fmt.Errorf("%w: %q is not KEY=VALUE", ErrInvalidSilenceRecurrence, part)
+		}
+		var err error
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			freq = strings.ToUpper(value)
+		case "BYDAY":
+			for _, day := range strings.Split(value, ",") {
+				weekday, ok := rruleWeekdays[strings.ToUpper(day)]
+				if !ok {
+					return nil, fmt.Errorf("%w: unknown day %q", ErrInvalidSilenceRecurrence, day)
+				}
+				r.Weekdays = append(r.Weekdays, weekday)
+			}
+		case "BYHOUR":
+			r.Hour, err = parseRecurrenceField(value, 23)
+		case "BYMINUTE":
+			r.Minute, err = parseRecurrenceField(value, 59)
+		case "DURATION":
+			r.Duration, err = time.ParseDuration(value)
+		case "TZID":
+			r.Location, err = time.LoadLocation(value)
+		default:
+			return nil, fmt.Errorf("%w: unknown key %q", ErrInvalidSilenceRecurrence, key)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidSilenceRecurrence, key, err)
+		}
+	}
+
+	switch {
+	case freq != "DAILY" && freq != "WEEKLY":
+		return nil, fmt.Errorf("%w: FREQ must be DAILY or WEEKLY", ErrInvalidSilenceRecurrence)
+	case freq == "WEEKLY" && len(r.Weekdays) == 0:
+		return nil, fmt.Errorf("%w: WEEKLY requires BYDAY", ErrInvalidSilenceRecurrence)
+	case r.Duration <= 0 || r.Duration > 24*time.Hour:
+		return nil, fmt.Errorf("%w: DURATION must be between 0 and 24h", ErrInvalidSilenceRecurrence)
+	}
+	return r, nil
+}
+
+func parseRecurrenceField(value string, maxValue int) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 || n > maxValue {
+		return 0, fmt.Errorf("must be between 0 and %d", maxValue)
+	}
+	return n, nil
+}
+
+// String returns the recurrence in its RRULE-like form.
+func (r *SilenceRecurrence) String() string {
+	freq := "DAILY"
+	var days string
+	if len(r.Weekdays) > 0 {
+		freq = "WEEKLY"
+		names := make([]string, len(r.Weekdays))
+		for i, weekday := range r.Weekdays {
+			names[i] = strings.ToUpper(weekday.String()[:2])
+		}
+		days = ";BYDAY=" + strings.Join(names, ",")
+	}
+
+	// 2h rather than 2h0m0s
+	duration := r.Duration.String()
+	if strings.HasSuffix(duration, "m0s") {
+		duration = strings.TrimSuffix(duration, "0s")
+	}
+	if strings.HasSuffix(duration, "h0m") {
+		duration = strings.TrimSuffix(duration, "0m")
+	}
+
+	rule := fmt.Sprintf("FREQ=%s%s;BYHOUR=%d;BYMINUTE=%d;DURATION=%s", freq, days, r.Hour, r.Minute, duration)
+	if r.Location != nil && r.Location != time.UTC {
+		rule += ";TZID=" + r.Location.String()
+	}
+	return rule
+}
+
+// MarshalText stores the recurrence in its RRULE-like form.
+func (r *SilenceRecurrence) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText parses the RRULE-like form.
+func (r *SilenceRecurrence) UnmarshalText(text []byte) error {
+	parsed, err := ParseSilenceRecurrence(string(text))
+	if err != nil {
+		return err
+	}
+	*r = *parsed
+	return nil
+}
+
+// Covers reports whether t falls in a window, which started either on t's
+// day or on the day before.
+func (r *SilenceRecurrence) Covers(t time.Time) bool {
+	loc := r.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	t = t.In(loc)
+
+	for _, daysBack := range []int{0, 1} {
+		day := t.AddDate(0, 0, -daysBack)
+		if !r.startsOn(day.Weekday()) {
+			continue
+		}
+		start := time.Date(day.Year(), day.Month(), day.Day(), r.Hour, r.Minute, 0, 0, loc)
+		if !t.Before(start) && t.Before(start.Add(r.Duration)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *SilenceRecurrence) startsOn(weekday time.Weekday) bool {
+	if len(r.Weekdays) == 0 {
+		return true
+	}
+	for _, w := range r.Weekdays {
+		if w == weekday {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,30 @@
+package entity
+
+// UserIdentity is one person's identity across the integrations. Fields
+// are empty when unknown.
+type UserIdentity struct {
+	// Email is the normalized email for cross-platform correlation.
+	Email string
+
+	// Name is the display name of the user.
+	Name string
+
+	SlackUserID     string
+	PagerDutyUserID string
+}
+
+// Merge fills the empty fields of u from other.
+func (u *UserIdentity) Merge(other UserIdentity) {
+	if u.Email == "" {
+		u.Email = other.Email
+	}
+	if u.Name == "" {
+		u.Name = other.Name
+	}
+	if u.SlackUserID == "" {
+		u.SlackUserID = other.SlackUserID
+	}
+	if u.PagerDutyUserID == "" {
+		u.PagerDutyUserID = other.PagerDutyUserID
+	}
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
 )
@@ -41,9 +42,41 @@ type AlertRepository interface {
 	// FindFiring returns all firing alerts (active or acknowledged).
 	FindFiring(ctx context.Context) ([]*entity.Alert, error)
 
-	// Delete removes an alert by ID.
+	// FindChangedSince returns alerts that are still firing, or that fired or
+	// resolved at or after since. Used to build periodic reports.
+	FindChangedSince(ctx context.Context, since time.Time) ([]*entity.Alert, error)
+
+	// FindActiveAt returns alerts that had fired by at and were not resolved
+	// before it. Callers replay each alert's history for its state at that time.
+	FindActiveAt(ctx context.Context, at time.Time) ([]*entity.Alert, error)
+
+	// Search returns the page of alerts matching query, newest fired first,
+	// and the total number of matches.
+	Search(ctx context.Context, query entity.AlertQuery) ([]*entity.Alert, int, error)
+
+	// Delete soft-deletes an alert by ID. Deleted alerts are left out of
+	// every other method until restored.
 	// Returns ErrAlertNotFound if the alert doesn't exist.
 	Delete(ctx context.Context, id string) error
+
+	// Restore brings back a soft-deleted alert.
+	// Returns ErrAlertNotFound if no deleted alert has the ID.
+	Restore(ctx context.Context, id string) error
+
+	// FindResolvedBefore returns up to limit of the alerts
+	// DeleteResolvedBefore would remove for the given time, oldest resolved
+	// first.
+	FindResolvedBefore(ctx context.Context, before time.Time, limit int) ([]*entity.Alert, error)
+
+	// DeleteResolvedBefore permanently removes alerts resolved before the
+	// given time, soft-deleted or not, with their timelines.
+	// Returns the number of deleted alerts.
+	DeleteResolvedBefore(ctx context.Context, before time.Time) (int, error)
+
+	// DeleteResolvedByIDs permanently removes the alerts with the given IDs,
+	// like DeleteResolvedBefore, unless they fired again since.
+	// Returns the number of deleted alerts.
+	DeleteResolvedByIDs(ctx context.Context, ids []string, before time.Time) (int, error)
 }
 
 // AckEventRepository stores acknowledgment events for audit trail.
@@ -67,6 +100,11 @@ type AckEventRepository interface {
 	// Limit specifies the maximum number of users to return.
 	// Returns empty slice if no acknowledgments found.
 	GetTopAcknowledgers(ctx context.Context, limit int) ([]*entity.UserAckCount, error)
+
+	// DeleteBefore permanently removes ack events created before the given
+	// time.
+	// Returns the number of deleted events.
+	DeleteBefore(ctx context.Context, before time.Time) (int, error)
 }
 
 // SilenceRepository stores silence/snooze rules.
@@ -97,11 +135,69 @@ type SilenceRepository interface {
 	// Returns ErrSilenceNotFound if the silence doesn't exist.
 	Update(ctx context.Context, silence *entity.SilenceMark) error
 
-	// Delete removes a silence by ID.
+	// Delete soft-deletes a silence by ID. Deleted silences are left out of
+	// every other method until restored.
 	// Returns ErrSilenceNotFound if the silence doesn't exist.
 	Delete(ctx context.Context, id string) error
 
-	// DeleteExpired removes all expired silences.
+	// Restore brings back a soft-deleted silence.
+	// Returns ErrSilenceNotFound if no deleted silence has the ID.
+	Restore(ctx context.Context, id string) error
+
+	// DeleteExpired permanently removes silences that expired before the
+	// given time, including soft-deleted ones.
 	// Returns the number of deleted silences.
-	DeleteExpired(ctx context.Context) (int, error)
+	DeleteExpired(ctx context.Context, before time.Time) (int, error)
+}
+
+// SavedViewRepository stores named alert filters.
+// Names are unique per owner; shared views have an empty owner.
+type SavedViewRepository interface {
+	// Save creates the view, or replaces the criteria of the existing view
+	// with the same owner and name (keeping its ID and CreatedAt).
+	Save(ctx context.Context, view *entity.SavedView) error
+
+	// FindByName returns the view named name that is visible to owner.
+	// The owner's own view takes precedence over a shared view of the same name.
+	// Returns nil, nil if not found.
+	FindByName(ctx context.Context, owner, name string) (*entity.SavedView, error)
+
+	// FindVisible returns the owner's views and all shared views, ordered by name.
+	FindVisible(ctx context.Context, owner string) ([]*entity.SavedView, error)
+
+	// Delete removes the view with the given owner and name.
+	// Returns ErrSavedViewNotFound if the view doesn't exist.
+	Delete(ctx context.Context, owner, name string) error
+}
+
+// NotificationRetryRepository persists failed notifications awaiting
+// another attempt, and those that were given up on (the dead-letter store).
+type NotificationRetryRepository interface {
+	// Save creates the retry, or replaces the retry with the same ID.
+	Save(ctx context.Context, retry *entity.NotificationRetry) error
+
+	// FindDue returns up to limit pending retries whose NextAttemptAt is not
+	// after now, earliest first.
+	FindDue(ctx context.Context, now time.Time, limit int) ([]*entity.NotificationRetry, error)
+
+	// FindByID retrieves a retry by ID.
+	// Returns nil, nil if the retry doesn't exist.
+	FindByID(ctx context.Context, id string) (*entity.NotificationRetry, error)
+
+	// FindDead returns the dead-lettered retries, most recently failed first.
+	FindDead(ctx context.Context) ([]*entity.NotificationRetry, error)
+
+	// Delete removes a retry by ID.
+	// Returns ErrNotificationRetryNotFound if the retry doesn't exist.
+	Delete(ctx context.Context, id string) error
+}
+
+// AlertEventRepository persists alert timelines.
+// Events are append-only; they are never updated.
+type AlertEventRepository interface {
+	// Save persists a new event.
+	Save(ctx context.Context, event *entity.AlertEvent) error
+
+	// FindByAlertID retrieves all events for an alert, oldest first.
+	FindByAlertID(ctx context.Context, alertID string) ([]*entity.AlertEvent, error)
 }
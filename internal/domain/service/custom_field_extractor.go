@@ -0,0 +1,90 @@
+package service
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/config"
+)
+
+// CustomFieldExtractor extracts typed custom fields from alert labels and
+// annotations according to per-tenant schemas.
+type CustomFieldExtractor struct {
+	cfg config.CustomFieldsConfig
+}
+
+// NewCustomFieldExtractor creates a new CustomFieldExtractor.
+func NewCustomFieldExtractor(cfg config.CustomFieldsConfig) *CustomFieldExtractor {
+	return &CustomFieldExtractor{cfg: cfg}
+}
+
+// Extract returns the custom field values for the alert and a list of
+// validation problems. Invalid values are left out of the result rather than
+// rejecting the alert, so a schema mistake never drops a page.
+func (e *CustomFieldExtractor) Extract(alert *entity.Alert) (map[string]string, []string) {
+	fields := e.schemaFor(alert)
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	values := make(map[string]string, len(fields))
+	var problems []string
+
+	for _, field := range fields {
+		raw := alert.GetLabel(field.Label)
+		if field.Annotation != "" {
+			raw = alert.GetAnnotation(field.Annotation)
+		}
+		raw = strings.TrimSpace(raw)
+
+		if raw == "" {
+			if field.Required {
+				problems = append(problems, fmt.Sprintf("%s: required value missing", field.Name))
+			}
+			continue
+		}
+
+		value, err := normalizeCustomField(field, raw)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %v", field.Name, err))
+			continue
+		}
+		values[field.Name] = value
+	}
+
+	return values, problems
+}
+
+// schemaFor returns the field definitions for the alert's tenant, falling
+// back to the default schema.
+func (e *CustomFieldExtractor) schemaFor(alert *entity.Alert) []config.CustomFieldConfig {
+	if e.cfg.TenantLabel != "" {
+		if fields, ok := e.cfg.Tenants[alert.GetLabel(e.cfg.TenantLabel)]; ok {
+			return fields
+		}
+	}
+	return e.cfg.Tenants[config.DefaultTenant]
+}
+
+// normalizeCustomField validates raw against the field type and returns its
+// canonical form.
+func normalizeCustomField(field config.CustomFieldConfig, raw string) (string, error) {
+	switch field.Type {
+	case config.CustomFieldNumber:
+		n, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return "", fmt.Errorf("%q is not a number", raw)
+		}
+		return strconv.FormatFloat(n, 'f', -1, 64), nil
+	case config.CustomFieldEnum:
+		if !slices.Contains(field.Values, raw) {
+			return "", fmt.Errorf("%q is not one of %s", raw, strings.Join(field.Values, ", "))
+		}
+		return raw, nil
+	default:
+		return raw, nil
+	}
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/config"
+)
+
+func TestCustomFieldExtractor_Extract(t *testing.T) {
+	extractor := NewCustomFieldExtractor(config.CustomFieldsConfig{
+		TenantLabel: "tenant",
+		Tenants: map[string][]config.CustomFieldConfig{
+			config.DefaultTenant: {
+				{Name: "team", Type: config.CustomFieldString, Label: "team"},
+			},
+			"payments": {
+				{Name: "region", Type: config.CustomFieldEnum, Label: "region", Values: []string{"us", "eu"}},
+				{Name: "cost", Type: config.CustomFieldNumber, Annotation: "cost_usd"},
+				{Name: "owner", Type: config.CustomFieldString, Label: "owner", Required: true},
+			},
+		},
+	})
+
+	t.Run("tenant schema with normalization and validation", func(t *testing.T) {
+		alert := entity.NewAlert("fp1", "HighLatency", "api-1", "api", "", entity.SeverityWarning)
+		alert.AddLabel("tenant", "payments")
+		alert.AddLabel("region", "apac")
+		alert.AddAnnotation("cost_usd", "12.50")
+
+		fields, problems := extractor.Extract(alert)
+
+		assert.Equal(t, map[string]string{"cost": "12.5"}, fields)
+		assert.Len(t, problems, 2) // region not in enum, owner missing
+	})
+
+	t.Run("unknown tenant falls back to default schema", func(t *testing.T) {
+		alert := entity.NewAlert("fp2", "DiskFull", "db-1", "db", "", entity.SeverityCritical)
+		alert.AddLabel("tenant", "search")
+		alert.AddLabel("team", "storage")
+
+		fields, problems := extractor.Extract(alert)
+
+		assert.Equal(t, map[string]string{"team": "storage"}, fields)
+		assert.Empty(t, problems)
+	})
+}
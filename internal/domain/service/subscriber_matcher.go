@@ -2,6 +2,7 @@ package service
 
 import (
 	"sort"
+	"time"
 
 	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
 	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/config"
@@ -16,17 +17,24 @@ type MatchedSubscriber struct {
 	// MatchCount is the number of labels that matched between the subscriber's
 	// filter and the alert's labels.
 	MatchCount int
+
+	// Rotation names the on-call rotation that selected the subscriber.
+	// Empty when the subscriber's own labels matched.
+	Rotation string
 }
 
 // SubscriberMatcher matches alerts to subscribers based on label filters.
 type SubscriberMatcher struct {
 	subscribers []config.SubscriberConfig
+	rotations   []config.OnCallConfig
+	now         func() time.Time
 }
 
 // NewSubscriberMatcher creates a new SubscriberMatcher with the given subscribers.
 func NewSubscriberMatcher(subscribers []config.SubscriberConfig) *SubscriberMatcher {
 	return &SubscriberMatcher{
 		subscribers: subscribers,
+		now:         time.Now,
 	}
 }
 
@@ -35,10 +43,37 @@ func (m *SubscriberMatcher) UpdateSubscribers(subscribers []config.SubscriberCon
 	m.subscribers = subscribers
 }
 
+// SetRotations enables on-call rotations: alerts matching a rotation's
+// labels also match the member currently on call.
+func (m *SubscriberMatcher) SetRotations(rotations []config.OnCallConfig) {
+	m.rotations = rotations
+}
+
+// OnCall returns the member of the rotation on call at t.
+func OnCall(rotation config.OnCallConfig, t time.Time) string {
+	if len(rotation.Members) == 0 {
+		return ""
+	}
+	elapsed, length := t.Sub(rotation.Start), rotation.ShiftLength()
+	shifts := int64(elapsed / length)
+	if elapsed%length < 0 {
+		// Before Start, round down so shifts count back from the first
+		shifts--
+	}
+	n := int64(len(rotation.Members))
+	return rotation.Members[((shifts%n)+n)%n]
+}
+
 // MatchAlert finds all subscribers that match the given alert's labels.
 // Returns subscribers sorted by match count in descending order (most matches first).
 // A subscriber matches if ALL of their configured labels exist in the alert with the same values.
 func (m *SubscriberMatcher) MatchAlert(alert *entity.Alert) []MatchedSubscriber {
+	return m.MatchAlertAt(alert, m.now())
+}
+
+// MatchAlertAt is MatchAlert with rotations evaluated at t rather than now,
+// such as for the members on call when a past alert fired.
+func (m *SubscriberMatcher) MatchAlertAt(alert *entity.Alert, t time.Time) []MatchedSubscriber {
 	var matched []MatchedSubscriber
 
 	for _, sub := range m.subscribers {
@@ -56,14 +91,66 @@ func (m *SubscriberMatcher) MatchAlert(alert *entity.Alert) []MatchedSubscriber
 		}
 	}
 
+	matched = m.appendOnCall(matched, alert, t)
+
 	// Sort by match count descending (most matches first)
-	sort.Slice(matched, func(i, j int) bool {
+	sort.SliceStable(matched, func(i, j int) bool {
 		return matched[i].MatchCount > matched[j].MatchCount
 	})
 
 	return matched
 }
 
+// appendOnCall adds the current member of every rotation covering the
+// alert, unless that subscriber already matched.
+func (m *SubscriberMatcher) appendOnCall(matched []MatchedSubscriber, alert *entity.Alert, now time.Time) []MatchedSubscriber {
+	if len(m.rotations) == 0 {
+		return matched
+	}
+
+	seen := make(map[string]bool, len(matched))
+	for _, ms := range matched {
+		seen[ms.Subscriber.Name] = true
+	}
+
+	for _, rotation := range m.rotations {
+		matchCount := m.countMatchingLabels(rotation.Labels, alert.Labels)
+		if matchCount != len(rotation.Labels) {
+			continue
+		}
+		name := OnCall(rotation, now)
+		if seen[name] {
+			continue
+		}
+		for _, sub := range m.subscribers {
+			if sub.Name == name && sub.IsEnabled() {
+				matched = append(matched, MatchedSubscriber{
+					Subscriber: sub,
+					MatchCount: matchCount,
+					Rotation:   rotation.Name,
+				})
+				seen[name] = true
+				break
+			}
+		}
+	}
+	return matched
+}
+
+// SubscriberForUser returns the enabled subscriber whose Slack or PagerDuty
+// user ID is userID.
+func (m *SubscriberMatcher) SubscriberForUser(userID string) (config.SubscriberConfig, bool) {
+	if userID == "" {
+		return config.SubscriberConfig{}, false
+	}
+	for _, sub := range m.subscribers {
+		if sub.IsEnabled() && (sub.SlackUserID == userID || sub.PagerDutyUserID == userID) {
+			return sub, true
+		}
+	}
+	return config.SubscriberConfig{}, false
+}
+
 // MatchAlertForSlack returns all matching subscribers for Slack mentions.
 // Returns all subscribers that match, regardless of order (they'll all be mentioned).
 func (m *SubscriberMatcher) MatchAlertForSlack(alert *entity.Alert) []MatchedSubscriber {
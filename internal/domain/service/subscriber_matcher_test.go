@@ -2,6 +2,7 @@ package service
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -238,3 +239,93 @@ func TestSubscriberMatcher_UpdateSubscribers(t *testing.T) {
 	require.Len(t, matched, 1)
 	assert.Equal(t, "jeseon", matched[0].Subscriber.Name)
 }
+
+func TestOnCall(t *testing.T) {
+	start := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	weekly := config.OnCallConfig{Rotation: config.RotationWeekly, Start: start, Members: []string{"alice", "bob", "carol"}}
+	daily := config.OnCallConfig{Rotation: config.RotationDaily, Start: start, Members: []string{"alice", "bob"}}
+
+	tests := []struct {
+		name     string
+		rotation config.OnCallConfig
+		at       time.Time
+		expected string
+	}{
+		{"first shift starts", weekly, start, "alice"},
+		{"last minute of first shift", weekly, start.Add(7*24*time.Hour - time.Minute), "alice"},
+		{"second shift", weekly, start.Add(7 * 24 * time.Hour), "bob"},
+		{"wraps around", weekly, start.Add(3 * 7 * 24 * time.Hour), "alice"},
+		{"daily handoff", daily, start.Add(25 * time.Hour), "bob"},
+		{"before start", weekly, start.Add(-time.Hour), "carol"},
+		{"exactly one shift before start", weekly, start.Add(-7 * 24 * time.Hour), "carol"},
+		{"two shifts before start", weekly, start.Add(-7*24*time.Hour - time.Hour), "bob"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, OnCall(tt.rotation, tt.at))
+		})
+	}
+}
+
+func TestSubscriberMatcher_OnCallRotation(t *testing.T) {
+	start := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	subscribers := []config.SubscriberConfig{
+		{Name: "alice", SlackUserID: "UALICE", PagerDutyUserID: "PALICE"},
+		{Name: "bob", SlackUserID: "UBOB", PagerDutyUserID: "PBOB"},
+		{Name: "dba", SlackUserID: "UDBA", Labels: map[string]string{"team": "db", "severity": "critical"}},
+	}
+	matcher := NewSubscriberMatcher(subscribers)
+	matcher.SetRotations([]config.OnCallConfig{
+		{
+			Name:     "db-primary",
+			Labels:   map[string]string{"team": "db"},
+			Rotation: config.RotationWeekly,
+			Start:    start,
+			Members:  []string{"alice", "bob"},
+		},
+	})
+
+	alert := entity.NewAlert("fp", "DiskFull", "db-1", "db-1", "disk full", entity.SeverityCritical)
+	alert.Labels = map[string]string{"team": "db", "severity": "critical"}
+
+	matcher.now = func() time.Time { return start.Add(time.Hour) }
+	matched := matcher.MatchAlert(alert)
+	require.Len(t, matched, 2)
+	assert.Equal(t, "dba", matched[0].Subscriber.Name)
+	assert.Equal(t, "alice", matched[1].Subscriber.Name)
+	assert.Equal(t, "db-primary", matched[1].Rotation)
+	assert.Equal(t, 1, matched[1].MatchCount)
+
+	// The next week, mentions and PagerDuty targets follow the handoff
+	matcher.now = func() time.Time { return start.Add(8 * 24 * time.Hour) }
+	assert.Equal(t, []string{"UDBA", "UBOB"}, GetSlackUserIDs(matcher.MatchAlert(alert)))
+
+	// Alerts outside the rotation's labels don't page the on-call member
+	other := entity.NewAlert("fp2", "CPUHigh", "web-1", "web-1", "cpu", entity.SeverityWarning)
+	other.Labels = map[string]string{"team": "web"}
+	assert.Empty(t, matcher.MatchAlert(other))
+}
+
+func TestSubscriberMatcher_OnCallSkipsMatchedAndDisabled(t *testing.T) {
+	start := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	matcher := NewSubscriberMatcher([]config.SubscriberConfig{
+		{Name: "alice", SlackUserID: "UALICE", Labels: map[string]string{"team": "db"}},
+		{Name: "bob", SlackUserID: "UBOB", Enabled: boolPtr(false)},
+	})
+	matcher.SetRotations([]config.OnCallConfig{
+		{Name: "all", Rotation: config.RotationDaily, Start: start, Members: []string{"alice", "bob"}},
+	})
+	alert := entity.NewAlert("fp", "DiskFull", "db-1", "db-1", "disk full", entity.SeverityCritical)
+	alert.Labels = map[string]string{"team": "db"}
+
+	// alice is on call and already matched by her labels
+	matcher.now = func() time.Time { return start }
+	matched := matcher.MatchAlert(alert)
+	require.Len(t, matched, 1)
+	assert.Empty(t, matched[0].Rotation)
+
+	// bob is on call but disabled
+	matcher.now = func() time.Time { return start.Add(24 * time.Hour) }
+	assert.Len(t, matcher.MatchAlert(alert), 1)
+}
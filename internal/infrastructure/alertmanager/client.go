@@ -0,0 +1,213 @@
+// Package alertmanager creates and expires silences through the
+// Alertmanager v2 API.
+package alertmanager
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// commentMarker ends the comment of silences created for alert-bridge
+// silences, followed by the silence ID. It finds them again without
+// storing their Alertmanager IDs.
+const commentMarker = "alert-bridge silence "
+
+// Client mirrors alert-bridge silences in Alertmanager.
+type Client struct {
+	baseURL    string
+	username   string
+	password   string
+	httpClient *http.Client
+}
+
+// NewClient creates a client of the Alertmanager at baseURL, e.g.
+// http://alertmanager:9093. username may be empty to disable basic auth.
+func NewClient(baseURL, username, password string) *Client {
+	return &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		username:   username,
+		password:   password,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// SetHTTPClient replaces the default client used to call the API.
+func (c *Client) SetHTTPClient(httpClient *http.Client) {
+	c.httpClient = httpClient
+}
+
+// silence is a silence of the v2 API.
+type silence struct {
+	ID        string         `json:"id,omitempty"`
+	Matchers  []matcher      `json:"matchers"`
+	StartsAt  time.Time      `json:"startsAt"`
+	EndsAt    time.Time      `json:"endsAt"`
+	CreatedBy string         `json:"createdBy"`
+	Comment   string         `json:"comment"`
+	Status    *silenceStatus `json:"status,omitempty"`
+}
+
+type matcher struct {
+	Name    string `json:"name"`
+	Value   string `json:"value"`
+	IsRegex bool   `json:"isRegex"`
+	IsEqual bool   `json:"isEqual"`
+}
+
+type silenceStatus struct {
+	State string `json:"state"`
+}
+
+// statusError is returned when Alertmanager responds with a non-2xx status.
+type statusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("alertmanager returned status %d: %s", e.StatusCode, e.Body)
+}
+
+// CreateSilence creates an Alertmanager silence of the matchers for as long
+// as the silence lasts.
+func (c *Client) CreateSilence(ctx context.Context, s *entity.SilenceMark, matchers []entity.LabelMatcher) error {
+	createdBy := s.CreatedBy
+	if s.CreatedByEmail != "" {
+		createdBy = s.CreatedByEmail
+	}
+	comment := commentMarker + s.ID
+	if s.Reason != "" {
+		comment = s.Reason + " (" + comment + ")"
+	}
+
+	body := silence{
+		Matchers:  make([]matcher, 0, len(matchers)),
+		StartsAt:  s.StartAt,
+		EndsAt:    s.EndAt,
+		CreatedBy: createdBy,
+		Comment:   comment,
+	}
+	for _, m := range matchers {
+		body.Matchers = append(body.Matchers, matcher{
+			Name:    m.Name,
+			Value:   m.Value,
+			IsRegex: m.Type == entity.MatchRegexp || m.Type == entity.MatchNotRegexp,
+			IsEqual: m.Type == entity.MatchEqual || m.Type == entity.MatchRegexp,
+		})
+	}
+	return c.postSilence(ctx, body)
+}
+
+// ExtendSilence moves the end of the Alertmanager silences of s to its end.
+func (c *Client) ExtendSilence(ctx context.Context, s *entity.SilenceMark) error {
+	silences, err := c.findSilences(ctx, s.ID)
+	if err != nil {
+		return err
+	}
+	for _, existing := range silences {
+		existing.EndsAt = s.EndAt
+		existing.Status = nil
+		if err := c.postSilence(ctx, existing); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExpireSilence expires the Alertmanager silences of the silence ID. It
+// does nothing if there are none.
+func (c *Client) ExpireSilence(ctx context.Context, silenceID string) error {
+	silences, err := c.findSilences(ctx, silenceID)
+	if err != nil {
+		return err
+	}
+	for _, existing := range silences {
+		err := c.do(ctx, http.MethodDelete, "/api/v2/silence/"+url.PathEscape(existing.ID), nil, nil)
+		var stErr *statusError
+		if errors.As(err, &stErr) && stErr.StatusCode == http.StatusNotFound {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("expiring alertmanager silence %s: %w", existing.ID, err)
+		}
+	}
+	return nil
+}
+
+// findSilences returns the unexpired Alertmanager silences of the silence
+// ID.
+func (c *Client) findSilences(ctx context.Context, silenceID string) ([]silence, error) {
+	var all []silence
+	if err := c.do(ctx, http.MethodGet, "/api/v2/silences", nil, &all); err != nil {
+		return nil, fmt.Errorf("listing alertmanager silences: %w", err)
+	}
+
+	var found []silence
+	for _, s := range all {
+		if s.Status != nil && s.Status.State == "expired" {
+			continue
+		}
+		if strings.HasSuffix(strings.TrimSuffix(s.Comment, ")"), commentMarker+silenceID) {
+			found = append(found, s)
+		}
+	}
+	return found, nil
+}
+
+func (c *Client) postSilence(ctx context.Context, body silence) error {
+	if err := c.do(ctx, http.MethodPost, "/api/v2/silences", body, nil); err != nil {
+		return fmt.Errorf("creating alertmanager silence: %w", err)
+	}
+	return nil
+}
+
+// do calls the API, encoding in as the request body and decoding the
+// response into out, if not nil.
+func (c *Client) do(ctx context.Context, method, path string, in, out any) error {
+	var body io.Reader
+	if in != nil {
+		payload, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("marshaling request: %w", err)
+		}
+		body = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.username != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return &statusError{StatusCode: resp.StatusCode, Body: string(respBody)}
+	}
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return fmt.Errorf("decoding response: %w", err)
+		}
+	}
+	return nil
+}
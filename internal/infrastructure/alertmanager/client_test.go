@@ -0,0 +1,114 @@
+package alertmanager
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// fakeAlertmanager serves the silence endpoints of the v2 API.
+type fakeAlertmanager struct {
+	mu       sync.Mutex
+	silences []silence
+	expired  []string
+}
+
+func (f *fakeAlertmanager) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if user, pass, _ := r.BasicAuth(); user != "am" || pass != "secret" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/api/v2/silences":
+		_ = json.NewEncoder(w).Encode(f.silences)
+	case r.Method == http.MethodPost && r.URL.Path == "/api/v2/silences":
+		var s silence
+		_ = json.NewDecoder(r.Body).Decode(&s)
+		if s.ID == "" {
+			s.ID = "am-" + string(rune('a'+len(f.silences)))
+			s.Status = &silenceStatus{State: "active"}
+			f.silences = append(f.silences, s)
+		} else {
+			for i := range f.silences {
+				if f.silences[i].ID == s.ID {
+					f.silences[i].EndsAt = s.EndsAt
+				}
+			}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{"silenceID": s.ID})
+	case r.Method == http.MethodDelete:
+		id := r.URL.Path[len("/api/v2/silence/"):]
+		f.expired = append(f.expired, id)
+		for i := range f.silences {
+			if f.silences[i].ID == id {
+				f.silences[i].Status = &silenceStatus{State: "expired"}
+			}
+		}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestClient_Silences(t *testing.T) {
+	ctx := context.Background()
+	am := &fakeAlertmanager{}
+	server := httptest.NewServer(am)
+	defer server.Close()
+
+	client := NewClient(server.URL+"/", "am", "secret")
+
+	s, err := entity.NewSilenceMark(time.Hour, "alice", "alice@example.com", entity.AckSourceSlack)
+	require.NoError(t, err)
+	s.WithReason("deploy")
+	matchers := []entity.LabelMatcher{
+		{Name: "alertname", Type: entity.MatchEqual, Value: "HighCPU"},
+		{Name: "env", Type: entity.MatchNotRegexp, Value: "dev|staging"},
+	}
+	require.NoError(t, client.CreateSilence(ctx, s, matchers))
+
+	require.Len(t, am.silences, 1)
+	created := am.silences[0]
+	assert.Equal(t, "alice@example.com", created.CreatedBy)
+	assert.Equal(t, "deploy (alert-bridge silence "+s.ID+")", created.Comment)
+	assert.Equal(t, []matcher{
+		{Name: "alertname", Value: "HighCPU", IsRegex: false, IsEqual: true},
+		{Name: "env", Value: "dev|staging", IsRegex: true, IsEqual: false},
+	}, created.Matchers)
+
+	require.NoError(t, s.Extend(time.Hour))
+	require.NoError(t, client.ExtendSilence(ctx, s))
+	assert.True(t, am.silences[0].EndsAt.Equal(s.EndAt))
+
+	require.NoError(t, client.ExpireSilence(ctx, s.ID))
+	assert.Equal(t, []string{"am-a"}, am.expired)
+
+	// Expired silences are not expired again
+	require.NoError(t, client.ExpireSilence(ctx, s.ID))
+	assert.Len(t, am.expired, 1)
+}
+
+func TestClient_StatusError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "bad matchers", http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	s, err := entity.NewSilenceMark(time.Hour, "alice", "", entity.AckSourceSlack)
+	require.NoError(t, err)
+
+	err = NewClient(server.URL, "", "").CreateSilence(context.Background(), s, nil)
+	assert.ErrorContains(t, err, "status 400: bad matchers")
+}
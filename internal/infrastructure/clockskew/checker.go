@@ -0,0 +1,144 @@
+// Package clockskew detects drift of the host clock, which makes signed
+// webhooks with a timestamp (such as Slack's) fail verification. It
+// compares the local time with the Date header of a well-known HTTPS
+// server.
+package clockskew
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Config tunes the checker.
+type Config struct {
+	// URL is requested with HEAD; its Date header is the reference time.
+	URL string
+
+	// Interval is the time between checks.
+	Interval time.Duration
+
+	// WarnThreshold is the offset beyond which the skew is reported.
+	WarnThreshold time.Duration
+
+	// WidenTolerance adds the measured offset to signature timestamp
+	// tolerances while it exceeds WarnThreshold, up to MaxTolerance.
+	WidenTolerance bool
+	MaxTolerance   time.Duration
+}
+
+// Checker periodically measures the offset of the host clock.
+type Checker struct {
+	cfg    Config
+	client *http.Client
+	logger *slog.Logger
+	now    func() time.Time
+
+	mu     sync.RWMutex
+	offset time.Duration
+	skewed bool
+}
+
+// NewChecker creates a checker that sends its requests through client.
+func NewChecker(cfg Config, client *http.Client, logger *slog.Logger) *Checker {
+	return &Checker{
+		cfg:    cfg,
+		client: client,
+		logger: logger,
+		now:    time.Now,
+	}
+}
+
+// Run checks the clock immediately and then every interval until ctx is
+// cancelled.
+func (c *Checker) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := c.Check(ctx); err != nil && ctx.Err() == nil {
+			c.logger.Warn("clock skew check failed", "url", c.cfg.URL, "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check measures the offset of the reference clock from the local clock:
+// positive when the host is behind. The local time is taken halfway through
+// the request, and the Date header has a resolution of one second.
+func (c *Checker) Check(ctx context.Context) (time.Duration, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, c.cfg.URL, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := c.now()
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("requesting reference time: %w", err)
+	}
+	resp.Body.Close()
+	received := c.now()
+
+	reference, err := http.ParseTime(resp.Header.Get("Date"))
+	if err != nil {
+		return 0, fmt.Errorf("reading Date header: %w", err)
+	}
+	local := sent.Add(received.Sub(sent) / 2)
+	offset := reference.Sub(local).Truncate(time.Second)
+
+	c.record(offset)
+	return offset, nil
+}
+
+// record stores the offset and reports when the clock starts or stops
+// being skewed.
+func (c *Checker) record(offset time.Duration) {
+	skewed := offset.Abs() > c.cfg.WarnThreshold
+
+	c.mu.Lock()
+	wasSkewed := c.skewed
+	c.offset, c.skewed = offset, skewed
+	c.mu.Unlock()
+
+	switch {
+	case skewed:
+		// Repeated on every check until the clock is fixed
+		c.logger.Error("host clock is skewed; signed Slack requests may be rejected. Check NTP on this host",
+			"offset", offset,
+			"threshold", c.cfg.WarnThreshold,
+			"widenTolerance", c.cfg.WidenTolerance,
+			"maxTolerance", c.cfg.MaxTolerance,
+		)
+	case wasSkewed:
+		c.logger.Info("host clock skew resolved", "offset", offset)
+	default:
+		c.logger.Debug("host clock checked", "offset", offset)
+	}
+}
+
+// Offset returns the last measured offset.
+func (c *Checker) Offset() time.Duration {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.offset
+}
+
+// Tolerance returns base widened by the measured offset while the clock is
+// skewed and widening is enabled, capped at MaxTolerance. Otherwise it
+// returns base.
+func (c *Checker) Tolerance(base time.Duration) time.Duration {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if !c.cfg.WidenTolerance || !c.skewed {
+		return base
+	}
+	return min(base+c.offset.Abs(), max(base, c.cfg.MaxTolerance))
+}
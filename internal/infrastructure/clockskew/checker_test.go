@@ -0,0 +1,74 @@
+package clockskew
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newReferenceServer serves a Date header that is ahead of the local clock
+// by offset.
+func newReferenceServer(t *testing.T, offset time.Duration) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Date", time.Now().Add(offset).UTC().Format(http.TimeFormat))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func newTestChecker(url string, widen bool) *Checker {
+	return NewChecker(Config{
+		URL:            url,
+		Interval:       time.Minute,
+		WarnThreshold:  30 * time.Second,
+		WidenTolerance: widen,
+		MaxTolerance:   time.Hour,
+	}, http.DefaultClient, slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
+func TestChecker_Check(t *testing.T) {
+	srv := newReferenceServer(t, -10*time.Minute)
+	checker := newTestChecker(srv.URL, true)
+
+	offset, err := checker.Check(context.Background())
+	require.NoError(t, err)
+	assert.InDelta(t, (-10 * time.Minute).Seconds(), offset.Seconds(), 2)
+	assert.Equal(t, offset, checker.Offset())
+}
+
+func TestChecker_Tolerance(t *testing.T) {
+	base := 5 * time.Minute
+
+	t.Run("widened while skewed", func(t *testing.T) {
+		checker := newTestChecker("", true)
+		checker.record(10 * time.Minute)
+		assert.Equal(t, 15*time.Minute, checker.Tolerance(base))
+	})
+
+	t.Run("capped at max tolerance", func(t *testing.T) {
+		checker := newTestChecker("", true)
+		checker.record(-3 * time.Hour)
+		assert.Equal(t, time.Hour, checker.Tolerance(base))
+	})
+
+	t.Run("restored once the clock is fixed", func(t *testing.T) {
+		checker := newTestChecker("", true)
+		checker.record(10 * time.Minute)
+		checker.record(2 * time.Second)
+		assert.Equal(t, base, checker.Tolerance(base))
+	})
+
+	t.Run("not widened when disabled", func(t *testing.T) {
+		checker := newTestChecker("", false)
+		checker.record(10 * time.Minute)
+		assert.Equal(t, base, checker.Tolerance(base))
+	})
+}
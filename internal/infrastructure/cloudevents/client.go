@@ -0,0 +1,240 @@
+package cloudevents
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	domainerrors "github.com/altuslabsxyz/alert-bridge/internal/domain/errors"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/payloadlog"
+)
+
+// Types of the alert events emitted, one per alert state.
+const (
+	TypeFiring       = "io.alertbridge.alert.firing"
+	TypeAcknowledged = "io.alertbridge.alert.acknowledged"
+	TypeResolved     = "io.alertbridge.alert.resolved"
+)
+
+// AlertData is the data of alert events. Its status, labels, annotations,
+// startsAt, endsAt and fingerprint follow the Alertmanager alert format, so
+// another bridge or an Alertmanager-aware consumer can take the events as
+// they are.
+type AlertData struct {
+	ID          string            `json:"id"`
+	Status      string            `json:"status"` // firing or resolved
+	State       string            `json:"state"`
+	Name        string            `json:"name"`
+	Instance    string            `json:"instance,omitempty"`
+	Severity    string            `json:"severity"`
+	Source      string            `json:"source,omitempty"`
+	Summary     string            `json:"summary,omitempty"`
+	Labels      map[string]string `json:"labels"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	StartsAt    time.Time         `json:"startsAt"`
+	EndsAt      time.Time         `json:"endsAt,omitzero"`
+	Fingerprint string            `json:"fingerprint"`
+	AckedBy     string            `json:"ackedBy,omitempty"`
+	AckedAt     *time.Time        `json:"ackedAt,omitempty"`
+}
+
+// Sink is an endpoint receiving alert events, such as a Knative broker or
+// an event mesh gateway.
+type Sink struct {
+	Name string
+	URL  string
+
+	// Mode is ModeBinary or ModeStructured.
+	Mode string
+
+	// Headers are added to every request, e.g. authorization.
+	Headers map[string]string
+}
+
+// Client emits an event to every sink when an alert fires, is acknowledged
+// or resolves. Implements the alert.Notifier interface.
+type Client struct {
+	source     string
+	sinks      []Sink
+	httpClient *http.Client
+	recorder   *payloadlog.Recorder
+}
+
+// NewClient creates a client emitting events with the given source
+// attribute, such as "alert-bridge".
+func NewClient(source string, sinks ...Sink) *Client {
+	return &Client{
+		source:     source,
+		sinks:      sinks,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// SetHTTPClient replaces the default client used to post to sinks.
+func (c *Client) SetHTTPClient(httpClient *http.Client) {
+	c.httpClient = httpClient
+}
+
+// SetRecorder enables recording of outbound events.
+func (c *Client) SetRecorder(recorder *payloadlog.Recorder) {
+	c.recorder = recorder
+}
+
+// Name returns the notifier identifier.
+func (c *Client) Name() string {
+	return "cloudevents"
+}
+
+// Notify emits the firing event. Returns the alert ID as reference ID, so
+// that state changes are emitted too, and the sink names as channel.
+func (c *Client) Notify(ctx context.Context, alert *entity.Alert) (entity.NotifyResult, error) {
+	sinks := make([]string, len(c.sinks))
+	for i, sink := range c.sinks {
+		sinks[i] = sink.Name
+	}
+	channel := strings.Join(sinks, ",")
+
+	if err := c.emit(ctx, alert); err != nil {
+		err = categorizeError(err, "emitting cloudevent")
+		return entity.NotifyResult{Channel: channel, Retriable: domainerrors.IsTransientError(err)}, err
+	}
+	return entity.NotifyResult{ReferenceID: alert.ID, Channel: channel, DeliveredAt: time.Now()}, nil
+}
+
+// UpdateMessage emits the event of the alert's current state.
+func (c *Client) UpdateMessage(ctx context.Context, messageID string, alert *entity.Alert) error {
+	if err := c.emit(ctx, alert); err != nil {
+		return categorizeError(err, "emitting cloudevent")
+	}
+	return nil
+}
+
+// emit posts the alert's event to every sink. A failing sink does not keep
+// the others from receiving it; a retry sends the same event ID again, so
+// sinks can drop what they already have.
+func (c *Client) emit(ctx context.Context, alert *entity.Alert) error {
+	event, err := c.NewEvent(alert)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, sink := range c.sinks {
+		err := c.post(ctx, sink, event)
+		c.recorder.Record(c.Name(), "post", sink.Name, event, err)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", sink.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// NewEvent builds the event of the alert's current state. The ID is the
+// same for the same state change.
+func (c *Client) NewEvent(alert *entity.Alert) (Event, error) {
+	data := AlertData{
+		ID:          alert.ID,
+		Status:      "firing",
+		State:       string(alert.State),
+		Name:        alert.Name,
+		Instance:    alert.Instance,
+		Severity:    string(alert.Severity),
+		Source:      alert.Source,
+		Summary:     alert.Summary,
+		Labels:      make(map[string]string, len(alert.Labels)+1),
+		Annotations: alert.Annotations,
+		StartsAt:    alert.FiredAt,
+		Fingerprint: alert.Fingerprint,
+		AckedBy:     alert.AckedBy,
+		AckedAt:     alert.AckedAt,
+	}
+	maps.Copy(data.Labels, alert.Labels)
+	if data.Labels["alertname"] == "" {
+		data.Labels["alertname"] = alert.Name
+	}
+
+	eventType := TypeFiring
+	switch {
+	case alert.IsResolved():
+		eventType = TypeResolved
+		data.Status = "resolved"
+		if alert.ResolvedAt != nil {
+			data.EndsAt = *alert.ResolvedAt
+		}
+	case alert.IsAcked():
+		eventType = TypeAcknowledged
+	}
+
+	body, err := json.Marshal(data)
+	if err != nil {
+		return Event{}, fmt.Errorf("marshaling alert data: %w", err)
+	}
+	return Event{
+		SpecVersion:     SpecVersion,
+		ID:              fmt.Sprintf("%s-%s-%d", alert.ID, alert.State, alert.UpdatedAt.UnixNano()),
+		Source:          c.source,
+		Type:            eventType,
+		Subject:         alert.Name,
+		Time:            alert.UpdatedAt.UTC(),
+		DataContentType: "application/json",
+		Data:            body,
+	}, nil
+}
+
+// post sends the event to one sink.
+func (c *Client) post(ctx context.Context, sink Sink, event Event) error {
+	req, err := NewRequest(ctx, event, sink.Mode, sink.URL)
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	for name, value := range sink.Headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return &statusError{StatusCode: resp.StatusCode, Body: string(body)}
+	}
+	return nil
+}
+
+// statusError is returned when a sink responds with a non-2xx status.
+type statusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("sink returned status %d: %s", e.StatusCode, e.Body)
+}
+
+// categorizeError wraps sink errors as transient or permanent domain
+// errors. One transient failure makes the whole emit transient, so the
+// event is retried.
+func categorizeError(err error, operation string) error {
+	var netErr net.Error
+	if errors.As(err, &netErr) || errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return domainerrors.NewTransientError(fmt.Sprintf("%s: network error", operation), err)
+	}
+
+	var stErr *statusError
+	if errors.As(err, &stErr) && (stErr.StatusCode == http.StatusTooManyRequests || stErr.StatusCode >= 500) {
+		return domainerrors.NewTransientError(fmt.Sprintf("%s: status %d", operation, stErr.StatusCode), err)
+	}
+
+	return domainerrors.NewPermanentError(fmt.Sprintf("%s: %v", operation, err), err)
+}
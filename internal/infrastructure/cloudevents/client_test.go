@@ -0,0 +1,100 @@
+package cloudevents
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	domainerrors "github.com/altuslabsxyz/alert-bridge/internal/domain/errors"
+)
+
+func TestClient_NewEvent(t *testing.T) {
+	a := entity.NewAlert("fp-1", "HighCPU", "web-1", "", "CPU above 90%", entity.SeverityCritical)
+	c := NewClient("alert-bridge")
+
+	firing, err := c.NewEvent(a)
+	require.NoError(t, err)
+	assert.Equal(t, TypeFiring, firing.Type)
+	assert.Equal(t, "alert-bridge", firing.Source)
+	assert.Equal(t, "HighCPU", firing.Subject)
+
+	var data AlertData
+	require.NoError(t, json.Unmarshal(firing.Data, &data))
+	assert.Equal(t, "firing", data.Status)
+	assert.Equal(t, "HighCPU", data.Labels["alertname"])
+	assert.Equal(t, "fp-1", data.Fingerprint)
+	assert.Empty(t, a.Labels, "alert labels must not be modified")
+
+	again, err := c.NewEvent(a)
+	require.NoError(t, err)
+	assert.Equal(t, firing.ID, again.ID, "same state change gives the same ID")
+
+	require.NoError(t, a.Acknowledge("alice", a.UpdatedAt.Add(time.Second)))
+	acked, err := c.NewEvent(a)
+	require.NoError(t, err)
+	assert.Equal(t, TypeAcknowledged, acked.Type)
+	assert.NotEqual(t, firing.ID, acked.ID)
+
+	a.Resolve(a.UpdatedAt.Add(time.Second))
+	resolved, err := c.NewEvent(a)
+	require.NoError(t, err)
+	assert.Equal(t, TypeResolved, resolved.Type)
+	require.NoError(t, json.Unmarshal(resolved.Data, &data))
+	assert.Equal(t, "resolved", data.Status)
+	assert.Equal(t, *a.ResolvedAt, data.EndsAt)
+}
+
+func TestClient_Notify(t *testing.T) {
+	var got []Event
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		events, err := ReadRequest(r)
+		require.NoError(t, err)
+		got = append(got, events...)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	headers := map[string]string{"Authorization": "Bearer token"}
+	c := NewClient("alert-bridge",
+		Sink{Name: "binary", URL: srv.URL, Mode: ModeBinary, Headers: headers},
+		Sink{Name: "structured", URL: srv.URL, Mode: ModeStructured, Headers: headers},
+	)
+	a := entity.NewAlert("fp-1", "HighCPU", "web-1", "", "CPU above 90%", entity.SeverityCritical)
+
+	result, err := c.Notify(context.Background(), a)
+	require.NoError(t, err)
+	assert.Equal(t, a.ID, result.ReferenceID)
+	assert.Equal(t, "binary,structured", result.Channel)
+	require.Len(t, got, 2)
+	assert.Equal(t, got[0], got[1])
+	assert.NoError(t, got[0].Validate())
+}
+
+func TestClient_NotifyErrors(t *testing.T) {
+	status := http.StatusServiceUnavailable
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	c := NewClient("alert-bridge", Sink{Name: "mesh", URL: srv.URL, Mode: ModeBinary})
+	a := entity.NewAlert("fp-1", "HighCPU", "web-1", "", "", entity.SeverityWarning)
+
+	result, err := c.Notify(context.Background(), a)
+	assert.True(t, domainerrors.IsTransientError(err))
+	assert.True(t, result.Retriable)
+	assert.ErrorContains(t, err, "mesh")
+
+	status = http.StatusBadRequest
+	err = c.UpdateMessage(context.Background(), a.ID, a)
+	require.Error(t, err)
+	assert.False(t, domainerrors.IsTransientError(err))
+}
@@ -0,0 +1,186 @@
+// Package cloudevents reads and writes alerts as CloudEvents 1.0 over HTTP,
+// in binary and structured content modes:
+// https://github.com/cloudevents/spec/blob/v1.0.2/cloudevents/bindings/http-protocol-binding.md
+package cloudevents
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// SpecVersion is the CloudEvents version read and written.
+const SpecVersion = "1.0"
+
+// Content modes of the HTTP binding.
+const (
+	// ModeBinary carries the attributes in ce-* headers and the data as
+	// the body.
+	ModeBinary = "binary"
+
+	// ModeStructured carries the whole event as a JSON body.
+	ModeStructured = "structured"
+)
+
+// Media types of structured and batched events.
+const (
+	ContentTypeStructured = "application/cloudevents+json"
+	ContentTypeBatch      = "application/cloudevents-batch+json"
+)
+
+// headerPrefix prefixes the attribute headers of binary mode.
+const headerPrefix = "Ce-"
+
+// Event is a CloudEvent with JSON data.
+type Event struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Subject         string          `json:"subject,omitempty"`
+	Time            time.Time       `json:"time,omitzero"`
+	DataContentType string          `json:"datacontenttype,omitempty"`
+	Data            json.RawMessage `json:"data,omitempty"`
+
+	// DataBase64 is only read, and moved to Data.
+	DataBase64 []byte `json:"data_base64,omitempty"`
+}
+
+// Validate checks the required attributes and that the data is JSON.
+func (e *Event) Validate() error {
+	if e.SpecVersion != SpecVersion {
+		return fmt.Errorf("specversion must be %s, got %q", SpecVersion, e.SpecVersion)
+	}
+	for _, attr := range []struct{ name, value string }{
+		{"id", e.ID},
+		{"source", e.Source},
+		{"type", e.Type},
+	} {
+		if attr.value == "" {
+			return fmt.Errorf("%s is required", attr.name)
+		}
+	}
+	if !isJSON(e.DataContentType) {
+		return fmt.Errorf("datacontenttype must be JSON, got %q", e.DataContentType)
+	}
+	if len(e.Data) == 0 {
+		return errors.New("data is required")
+	}
+	return nil
+}
+
+// ReadRequest reads the events of an HTTP request in any content mode: a
+// batch, a structured event, or else a binary one. The events are not
+// validated.
+func ReadRequest(r *http.Request) ([]Event, error) {
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading body: %w", err)
+	}
+
+	var events []Event
+	switch mediaType {
+	case ContentTypeBatch:
+		if err := json.Unmarshal(body, &events); err != nil {
+			return nil, fmt.Errorf("decoding batch: %w", err)
+		}
+	case ContentTypeStructured:
+		var event Event
+		if err := json.Unmarshal(body, &event); err != nil {
+			return nil, fmt.Errorf("decoding event: %w", err)
+		}
+		events = []Event{event}
+	default:
+		event, err := readBinary(r.Header, body)
+		if err != nil {
+			return nil, err
+		}
+		events = []Event{event}
+	}
+
+	for i := range events {
+		if len(events[i].Data) == 0 && len(events[i].DataBase64) > 0 {
+			events[i].Data = events[i].DataBase64
+			events[i].DataBase64 = nil
+		}
+	}
+	return events, nil
+}
+
+// readBinary reads a binary mode event from its headers and body.
+func readBinary(header http.Header, body []byte) (Event, error) {
+	event := Event{
+		SpecVersion:     header.Get(headerPrefix + "Specversion"),
+		ID:              header.Get(headerPrefix + "Id"),
+		Source:          header.Get(headerPrefix + "Source"),
+		Type:            header.Get(headerPrefix + "Type"),
+		Subject:         header.Get(headerPrefix + "Subject"),
+		DataContentType: header.Get("Content-Type"),
+		Data:            body,
+	}
+	if event.SpecVersion == "" {
+		return Event{}, fmt.Errorf("not a CloudEvent: no ce-specversion header and content type is not %s", ContentTypeStructured)
+	}
+	if v := header.Get(headerPrefix + "Time"); v != "" {
+		t, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return Event{}, fmt.Errorf("invalid ce-time %q", v)
+		}
+		event.Time = t
+	}
+	return event, nil
+}
+
+// NewRequest creates a POST of event to url in the given content mode.
+func NewRequest(ctx context.Context, event Event, mode, url string) (*http.Request, error) {
+	if mode == ModeStructured {
+		body, err := json.Marshal(event)
+		if err != nil {
+			return nil, fmt.Errorf("marshaling event: %w", err)
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", ContentTypeStructured+"; charset=utf-8")
+		return req, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(event.Data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", event.DataContentType)
+	req.Header.Set(headerPrefix+"Specversion", event.SpecVersion)
+	req.Header.Set(headerPrefix+"Id", event.ID)
+	req.Header.Set(headerPrefix+"Source", event.Source)
+	req.Header.Set(headerPrefix+"Type", event.Type)
+	if event.Subject != "" {
+		req.Header.Set(headerPrefix+"Subject", event.Subject)
+	}
+	if !event.Time.IsZero() {
+		req.Header.Set(headerPrefix+"Time", event.Time.UTC().Format(time.RFC3339Nano))
+	}
+	return req, nil
+}
+
+// isJSON reports whether a datacontenttype is JSON. An empty type is JSON in
+// structured mode, as the spec implies.
+func isJSON(contentType string) bool {
+	if contentType == "" {
+		return true
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == "application/json" || mediaType == "text/json" || strings.HasSuffix(mediaType, "+json")
+}
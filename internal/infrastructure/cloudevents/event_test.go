@@ -0,0 +1,86 @@
+package cloudevents
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestRoundTrip(t *testing.T) {
+	event := Event{
+		SpecVersion:     SpecVersion,
+		ID:              "evt-1",
+		Source:          "alert-bridge",
+		Type:            TypeFiring,
+		Subject:         "HighCPU",
+		Time:            time.Date(2026, 1, 2, 3, 4, 5, 6, time.UTC),
+		DataContentType: "application/json",
+		Data:            json.RawMessage(`{"status":"firing"}`),
+	}
+
+	for _, mode := range []string{ModeBinary, ModeStructured} {
+		t.Run(mode, func(t *testing.T) {
+			req, err := NewRequest(context.Background(), event, mode, "http://sink.example")
+			require.NoError(t, err)
+
+			events, err := ReadRequest(req)
+			require.NoError(t, err)
+			require.Len(t, events, 1)
+			assert.Equal(t, event, events[0])
+			assert.NoError(t, events[0].Validate())
+		})
+	}
+}
+
+func TestReadRequest(t *testing.T) {
+	t.Run("batch with base64 data", func(t *testing.T) {
+		body := `[
+			{"specversion":"1.0","id":"1","source":"s","type":"t","data":{"a":1}},
+			{"specversion":"1.0","id":"2","source":"s","type":"t","data_base64":"eyJhIjoyfQ=="}
+		]`
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		req.Header.Set("Content-Type", ContentTypeBatch)
+
+		events, err := ReadRequest(req)
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		assert.JSONEq(t, `{"a":1}`, string(events[0].Data))
+		assert.JSONEq(t, `{"a":2}`, string(events[1].Data))
+		assert.Nil(t, events[1].DataBase64)
+	})
+
+	t.Run("plain JSON is not an event", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{}`))
+		req.Header.Set("Content-Type", "application/json")
+
+		_, err := ReadRequest(req)
+		assert.ErrorContains(t, err, "not a CloudEvent")
+	})
+}
+
+func TestEvent_Validate(t *testing.T) {
+	valid := Event{SpecVersion: SpecVersion, ID: "1", Source: "s", Type: "t", Data: json.RawMessage(`{}`)}
+	assert.NoError(t, valid.Validate())
+
+	tests := map[string]func(*Event){
+		"specversion":     func(e *Event) { e.SpecVersion = "0.3" },
+		"id":              func(e *Event) { e.ID = "" },
+		"type":            func(e *Event) { e.Type = "" },
+		"datacontenttype": func(e *Event) { e.DataContentType = "text/plain" },
+		"data":            func(e *Event) { e.Data = nil },
+	}
+	for name, mutate := range tests {
+		t.Run(name, func(t *testing.T) {
+			event := valid
+			mutate(&event)
+			assert.ErrorContains(t, event.Validate(), name)
+		})
+	}
+}
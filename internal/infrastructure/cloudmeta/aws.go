@@ -0,0 +1,166 @@
+package cloudmeta
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/sigv4"
+)
+
+// ec2APIVersion is the EC2 Query API version used for DescribeInstances.
+const ec2APIVersion = "2016-11-15"
+
+// asgTag is set by EC2 Auto Scaling on the instances it launches.
+const asgTag = "aws:autoscaling:groupName"
+
+var ec2InstanceID = regexp.MustCompile(`^i-[0-9a-f]{8,17}$`)
+
+// AWSConfig configures the EC2 provider.
+type AWSConfig struct {
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string // For temporary credentials
+	// Endpoint overrides https://ec2.<region>.amazonaws.com (for testing).
+	Endpoint string
+	Timeout  time.Duration
+}
+
+// AWSProvider looks up EC2 instances with DescribeInstances.
+type AWSProvider struct {
+	cfg      AWSConfig
+	endpoint string
+	client   *http.Client
+	now      func() time.Time
+}
+
+// NewAWSProvider creates an EC2 provider for one region.
+func NewAWSProvider(cfg AWSConfig) *AWSProvider {
+	endpoint := cfg.Endpoint
+	if endpoint == "" {
+		endpoint = "https://ec2." + cfg.Region + ".amazonaws.com/"
+	}
+	return &AWSProvider{
+		cfg:      cfg,
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: cfg.Timeout},
+		now:      time.Now,
+	}
+}
+
+// Name implements Provider.
+func (p *AWSProvider) Name() string { return "aws" }
+
+// Lookup implements Provider. host may be an instance ID, a private IP, a
+// private DNS name, or the instance's Name tag.
+func (p *AWSProvider) Lookup(ctx context.Context, host string) (*Instance, error) {
+	params := url.Values{
+		"Action":  {"DescribeInstances"},
+		"Version": {ec2APIVersion},
+	}
+	switch {
+	case ec2InstanceID.MatchString(host):
+		params.Set("InstanceId.1", host)
+	case net.ParseIP(host) != nil:
+		params.Set("Filter.1.Name", "private-ip-address")
+		params.Set("Filter.1.Value.1", host)
+	case strings.Contains(host, "."):
+		params.Set("Filter.1.Name", "private-dns-name")
+		params.Set("Filter.1.Value.1", host)
+	default:
+		params.Set("Filter.1.Name", "tag:Name")
+		params.Set("Filter.1.Value.1", host)
+	}
+
+	body := params.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint, strings.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	p.sign(req, body)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("describing instances: %w", err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("reading response: %w", err)
+	}
+
+	// An unknown instance ID is an error in EC2 but a miss here
+	if resp.StatusCode != http.StatusOK {
+		var apiErr ec2ErrorResponse
+		if xml.Unmarshal(data, &apiErr) == nil && len(apiErr.Errors) > 0 {
+			if apiErr.Errors[0].Code == "InvalidInstanceID.NotFound" {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("describing instances: %s: %s", apiErr.Errors[0].Code, apiErr.Errors[0].Message)
+		}
+		return nil, fmt.Errorf("describing instances: %s", resp.Status)
+	}
+
+	var result ec2DescribeInstancesResponse
+	if err := xml.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("decoding response: %w", err)
+	}
+	for _, reservation := range result.Reservations {
+		for _, inst := range reservation.Instances {
+			instance := &Instance{
+				Provider:     "aws",
+				ID:           inst.ID,
+				Region:       p.cfg.Region,
+				Zone:         inst.Zone,
+				InstanceType: inst.Type,
+			}
+			for _, tag := range inst.Tags {
+				if tag.Key == asgTag {
+					instance.Group = tag.Value
+				}
+			}
+			return instance, nil
+		}
+	}
+	return nil, nil
+}
+
+// sign adds AWS Signature Version 4 headers for the EC2 service.
+func (p *AWSProvider) sign(req *http.Request, body string) {
+	sigv4.Sign(req, []byte(body), sigv4.Credentials{
+		AccessKeyID:     p.cfg.AccessKeyID,
+		SecretAccessKey: p.cfg.SecretAccessKey,
+		SessionToken:    p.cfg.SessionToken,
+	}, p.cfg.Region, "ec2", p.now())
+}
+
+// DescribeInstances response, reduced to the fields used.
+type ec2DescribeInstancesResponse struct {
+	Reservations []struct {
+		Instances []struct {
+			ID   string `xml:"instanceId"`
+			Type string `xml:"instanceType"`
+			Zone string `xml:"placement>availabilityZone"`
+			Tags []struct {
+				Key   string `xml:"key"`
+				Value string `xml:"value"`
+			} `xml:"tagSet>item"`
+		} `xml:"instancesSet>item"`
+	} `xml:"reservationSet>item"`
+}
+
+type ec2ErrorResponse struct {
+	Errors []struct {
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	} `xml:"Errors>Error"`
+}
@@ -0,0 +1,205 @@
+// Package cloudmeta resolves alert instances to cloud provider metadata
+// (region, zone, instance type, owning group) and adds it to alerts as
+// labels.
+package cloudmeta
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// Label suffixes added to enriched alerts, after the configured prefix.
+const (
+	LabelProvider     = "provider"
+	LabelRegion       = "region"
+	LabelZone         = "zone"
+	LabelInstanceID   = "instance_id"
+	LabelInstanceType = "instance_type"
+	LabelGroup        = "group"
+)
+
+// maxCacheEntries bounds the lookup cache.
+const maxCacheEntries = 10000
+
+// Instance is the metadata of one cloud instance.
+type Instance struct {
+	Provider     string
+	ID           string
+	Region       string
+	Zone         string
+	InstanceType string
+	// Group is the owning autoscaling group (AWS) or managed instance
+	// group (GCP), if any.
+	Group string
+}
+
+// Provider looks up instances in one cloud.
+type Provider interface {
+	// Name identifies the provider in logs ("aws", "gcp").
+	Name() string
+
+	// Lookup finds the instance identified by host, an instance ID, private
+	// IP or hostname. Returns nil, nil if the provider has no such instance.
+	Lookup(ctx context.Context, host string) (*Instance, error)
+}
+
+// Config configures an Enricher.
+type Config struct {
+	// Label names the alert label holding the host (default "instance").
+	// A ":port" suffix is ignored.
+	Label string
+	// LabelPrefix is prepended to the added labels (default "cloud_").
+	LabelPrefix string
+	// CacheTTL is how long found instances are cached.
+	CacheTTL time.Duration
+	// NegativeCacheTTL is how long misses and lookup errors are cached, so
+	// alerts from unknown hosts do not call the cloud APIs every time.
+	NegativeCacheTTL time.Duration
+}
+
+// Enricher adds cloud metadata labels to alerts. Lookups are cached per
+// host and shared by all providers; providers are tried in order.
+type Enricher struct {
+	providers []Provider
+	cfg       Config
+	now       func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	instance *Instance // nil for a cached miss
+	expires  time.Time
+}
+
+// NewEnricher creates an enricher querying providers in order.
+func NewEnricher(cfg Config, providers ...Provider) *Enricher {
+	if cfg.Label == "" {
+		cfg.Label = "instance"
+	}
+	if cfg.LabelPrefix == "" {
+		cfg.LabelPrefix = "cloud_"
+	}
+	return &Enricher{
+		providers: providers,
+		cfg:       cfg,
+		now:       time.Now,
+		cache:     make(map[string]cacheEntry),
+	}
+}
+
+// Enrich returns the metadata labels for alert's instance, or nil if the
+// alert has no instance label or no provider knows it. A lookup error is
+// returned after all providers were tried and is cached like a miss.
+func (e *Enricher) Enrich(ctx context.Context, alert *entity.Alert) (map[string]string, error) {
+	host := normalizeHost(alert.Labels[e.cfg.Label])
+	if host == "" {
+		return nil, nil
+	}
+
+	instance, cached := e.cached(host)
+	if !cached {
+		var err error
+		instance, err = e.lookup(ctx, host)
+		e.store(host, instance)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if instance == nil {
+		return nil, nil
+	}
+	return e.labels(instance), nil
+}
+
+// lookup asks each provider in turn until one finds host.
+func (e *Enricher) lookup(ctx context.Context, host string) (*Instance, error) {
+	var errs []string
+	for _, p := range e.providers {
+		instance, err := p.Lookup(ctx, host)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", p.Name(), err))
+			continue
+		}
+		if instance != nil {
+			return instance, nil
+		}
+	}
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("looking up %q: %s", host, strings.Join(errs, "; "))
+	}
+	return nil, nil
+}
+
+func (e *Enricher) cached(host string) (*Instance, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	entry, ok := e.cache[host]
+	if !ok || e.now().After(entry.expires) {
+		return nil, false
+	}
+	return entry.instance, true
+}
+
+func (e *Enricher) store(host string, instance *Instance) {
+	ttl := e.cfg.CacheTTL
+	if instance == nil {
+		ttl = e.cfg.NegativeCacheTTL
+	}
+	if ttl <= 0 {
+		return
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	now := e.now()
+	if len(e.cache) >= maxCacheEntries {
+		for k, entry := range e.cache {
+			if now.After(entry.expires) {
+				delete(e.cache, k)
+			}
+		}
+		// Still full: drop an arbitrary entry rather than grow unbounded
+		for k := range e.cache {
+			if len(e.cache) < maxCacheEntries {
+				break
+			}
+			delete(e.cache, k)
+		}
+	}
+	e.cache[host] = cacheEntry{instance: instance, expires: now.Add(ttl)}
+}
+
+// labels converts instance to prefixed labels, skipping empty values.
+func (e *Enricher) labels(instance *Instance) map[string]string {
+	labels := make(map[string]string, 6)
+	for key, value := range map[string]string{
+		LabelProvider:     instance.Provider,
+		LabelInstanceID:   instance.ID,
+		LabelRegion:       instance.Region,
+		LabelZone:         instance.Zone,
+		LabelInstanceType: instance.InstanceType,
+		LabelGroup:        instance.Group,
+	} {
+		if value != "" {
+			labels[e.cfg.LabelPrefix+key] = value
+		}
+	}
+	return labels
+}
+
+// normalizeHost strips a port and lowercases the host.
+func normalizeHost(value string) string {
+	value = strings.TrimSpace(value)
+	if host, _, err := net.SplitHostPort(value); err == nil {
+		value = host
+	}
+	return strings.ToLower(value)
+}
@@ -0,0 +1,232 @@
+package cloudmeta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+type fakeProvider struct {
+	instances map[string]*Instance
+	err       error
+	calls     int
+}
+
+func (p *fakeProvider) Name() string { return "fake" }
+
+func (p *fakeProvider) Lookup(ctx context.Context, host string) (*Instance, error) {
+	p.calls++
+	if p.err != nil {
+		return nil, p.err
+	}
+	return p.instances[host], nil
+}
+
+func alertWithInstance(instance string) *entity.Alert {
+	return &entity.Alert{Labels: map[string]string{"instance": instance}}
+}
+
+func TestEnricher_Enrich(t *testing.T) {
+	provider := &fakeProvider{instances: map[string]*Instance{
+		"10.0.0.1": {Provider: "aws", ID: "i-0abc", Region: "eu-west-1", Zone: "eu-west-1a", InstanceType: "m5.large"},
+	}}
+	e := NewEnricher(Config{CacheTTL: time.Hour, NegativeCacheTTL: time.Minute}, provider)
+	now := time.Now()
+	e.now = func() time.Time { return now }
+
+	labels, err := e.Enrich(context.Background(), alertWithInstance("10.0.0.1:9100"))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"cloud_provider":      "aws",
+		"cloud_instance_id":   "i-0abc",
+		"cloud_region":        "eu-west-1",
+		"cloud_zone":          "eu-west-1a",
+		"cloud_instance_type": "m5.large",
+	}, labels)
+
+	// Cached
+	_, err = e.Enrich(context.Background(), alertWithInstance("10.0.0.1:9100"))
+	require.NoError(t, err)
+	assert.Equal(t, 1, provider.calls)
+
+	// Expired
+	now = now.Add(2 * time.Hour)
+	_, err = e.Enrich(context.Background(), alertWithInstance("10.0.0.1"))
+	require.NoError(t, err)
+	assert.Equal(t, 2, provider.calls)
+
+	t.Run("no instance label", func(t *testing.T) {
+		labels, err := e.Enrich(context.Background(), &entity.Alert{Labels: map[string]string{}})
+		require.NoError(t, err)
+		assert.Nil(t, labels)
+	})
+}
+
+func TestEnricher_NegativeCache(t *testing.T) {
+	provider := &fakeProvider{err: errors.New("throttled")}
+	e := NewEnricher(Config{CacheTTL: time.Hour, NegativeCacheTTL: time.Minute}, provider)
+
+	_, err := e.Enrich(context.Background(), alertWithInstance("web-1"))
+	assert.ErrorContains(t, err, "throttled")
+
+	labels, err := e.Enrich(context.Background(), alertWithInstance("web-1"))
+	require.NoError(t, err)
+	assert.Nil(t, labels)
+	assert.Equal(t, 1, provider.calls)
+}
+
+func TestEnricher_ProvidersInOrder(t *testing.T) {
+	aws := &fakeProvider{}
+	gcp := &fakeProvider{instances: map[string]*Instance{"web-1": {Provider: "gcp", Group: "web"}}}
+	e := NewEnricher(Config{LabelPrefix: "meta_"}, aws, gcp)
+
+	labels, err := e.Enrich(context.Background(), alertWithInstance("WEB-1"))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"meta_provider": "gcp", "meta_group": "web"}, labels)
+	assert.Equal(t, 1, aws.calls)
+}
+
+const describeInstancesResponse = `<?xml version="1.0" encoding="UTF-8"?>
+<DescribeInstancesResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+  <reservationSet>
+    <item>
+      <instancesSet>
+        <item>
+          <instanceId>i-0123456789abcdef0</instanceId>
+          <instanceType>c6g.xlarge</instanceType>
+          <placement><availabilityZone>us-east-1b</availabilityZone></placement>
+          <tagSet>
+            <item><key>Name</key><value>api</value></item>
+            <item><key>aws:autoscaling:groupName</key><value>api-asg</value></item>
+          </tagSet>
+        </item>
+      </instancesSet>
+    </item>
+  </reservationSet>
+</DescribeInstancesResponse>`
+
+func TestAWSProvider_Lookup(t *testing.T) {
+	var form map[string]string
+	var auth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		form = map[string]string{}
+		for k := range r.PostForm {
+			form[k] = r.PostForm.Get(k)
+		}
+		auth = r.Header.Get("Authorization")
+		if form["InstanceId.1"] == "i-00000000" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `<Response><Errors><Error><Code>InvalidInstanceID.NotFound</Code><Message>not found</Message></Error></Errors></Response>`)
+			return
+		}
+		fmt.Fprint(w, describeInstancesResponse)
+	}))
+	defer server.Close()
+
+	p := NewAWSProvider(AWSConfig{
+		Region:          "us-east-1",
+		AccessKeyID:     "AKIDEXAMPLE",
+		SecretAccessKey: "secret",
+		Endpoint:        server.URL,
+	})
+
+	instance, err := p.Lookup(context.Background(), "10.1.2.3")
+	require.NoError(t, err)
+	assert.Equal(t, &Instance{
+		Provider:     "aws",
+		ID:           "i-0123456789abcdef0",
+		Region:       "us-east-1",
+		Zone:         "us-east-1b",
+		InstanceType: "c6g.xlarge",
+		Group:        "api-asg",
+	}, instance)
+	assert.Equal(t, "DescribeInstances", form["Action"])
+	assert.Equal(t, "private-ip-address", form["Filter.1.Name"])
+	assert.Equal(t, "10.1.2.3", form["Filter.1.Value.1"])
+	assert.True(t, strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/"))
+	assert.Contains(t, auth, "/us-east-1/ec2/aws4_request, SignedHeaders=content-type;host;x-amz-date, Signature=")
+
+	_, err = p.Lookup(context.Background(), "ip-10-1-2-3.ec2.internal")
+	require.NoError(t, err)
+	assert.Equal(t, "private-dns-name", form["Filter.1.Name"])
+
+	instance, err = p.Lookup(context.Background(), "i-00000000")
+	require.NoError(t, err)
+	assert.Nil(t, instance)
+}
+
+func TestAWSProvider_Sign(t *testing.T) {
+	p := NewAWSProvider(AWSConfig{Region: "us-east-1", AccessKeyID: "ASIAEXAMPLE", SecretAccessKey: "secret", SessionToken: "session"})
+	p.now = func() time.Time { return time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC) }
+
+	req := httptest.NewRequest(http.MethodPost, "https://ec2.us-east-1.amazonaws.com/", nil)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	p.sign(req, "")
+
+	assert.Equal(t, "session", req.Header.Get("X-Amz-Security-Token"))
+	assert.Contains(t, req.Header.Get("Authorization"),
+		"Credential=ASIAEXAMPLE/20150830/us-east-1/ec2/aws4_request, SignedHeaders=content-type;host;x-amz-date;x-amz-security-token, ")
+}
+
+func TestGCPProvider_Lookup(t *testing.T) {
+	tokenRequests := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metadata/instance/service-accounts/default/token", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Google", r.Header.Get("Metadata-Flavor"))
+		tokenRequests++
+		fmt.Fprint(w, `{"access_token":"ya29.token","expires_in":3600,"token_type":"Bearer"}`)
+	})
+	mux.HandleFunc("/compute/projects/my-project/aggregated/instances", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer ya29.token", r.Header.Get("Authorization"))
+		if r.URL.Query().Get("filter") != `name = "web-1"` {
+			fmt.Fprint(w, `{"items":{"zones/europe-west1-b":{"warning":{"code":"NO_RESULTS_ON_PAGE"}}}}`)
+			return
+		}
+		fmt.Fprint(w, `{"items":{"zones/europe-west1-b":{"instances":[{
+			"id":"123456789",
+			"name":"web-1",
+			"zone":"https://www.googleapis.com/compute/v1/projects/my-project/zones/europe-west1-b",
+			"machineType":"https://www.googleapis.com/compute/v1/projects/my-project/zones/europe-west1-b/machineTypes/e2-standard-4",
+			"metadata":{"items":[{"key":"created-by","value":"projects/1234/zones/europe-west1-b/instanceGroupManagers/web-mig"}]}
+		}]}}}`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	p := NewGCPProvider(GCPConfig{
+		Project:     "my-project",
+		APIURL:      server.URL + "/compute",
+		MetadataURL: server.URL + "/metadata",
+	})
+
+	instance, err := p.Lookup(context.Background(), "web-1.c.my-project.internal")
+	require.NoError(t, err)
+	assert.Equal(t, &Instance{
+		Provider:     "gcp",
+		ID:           "123456789",
+		Region:       "europe-west1",
+		Zone:         "europe-west1-b",
+		InstanceType: "e2-standard-4",
+		Group:        "web-mig",
+	}, instance)
+
+	instance, err = p.Lookup(context.Background(), "web-2")
+	require.NoError(t, err)
+	assert.Nil(t, instance)
+	assert.Equal(t, 1, tokenRequests)
+
+	instance, err = p.Lookup(context.Background(), "10.0.0.1")
+	require.NoError(t, err)
+	assert.Nil(t, instance)
+}
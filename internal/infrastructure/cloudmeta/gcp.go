@@ -0,0 +1,211 @@
+package cloudmeta
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultComputeURL  = "https://compute.googleapis.com/compute/v1"
+	defaultMetadataURL = "http://metadata.google.internal/computeMetadata/v1"
+)
+
+// GCPConfig configures the Compute Engine provider.
+type GCPConfig struct {
+	Project string
+	// APIURL overrides the Compute Engine API base URL (for testing).
+	APIURL string
+	// MetadataURL overrides the metadata server base URL (for testing).
+	MetadataURL string
+	Timeout     time.Duration
+}
+
+// GCPProvider looks up Compute Engine instances by name. It authenticates
+// with the default service account from the metadata server, so it only
+// works when alert-bridge itself runs on GCP.
+type GCPProvider struct {
+	cfg    GCPConfig
+	client *http.Client
+	now    func() time.Time
+
+	mu          sync.Mutex
+	token       string
+	tokenExpiry time.Time
+}
+
+// NewGCPProvider creates a Compute Engine provider for one project.
+func NewGCPProvider(cfg GCPConfig) *GCPProvider {
+	if cfg.APIURL == "" {
+		cfg.APIURL = defaultComputeURL
+	}
+	if cfg.MetadataURL == "" {
+		cfg.MetadataURL = defaultMetadataURL
+	}
+	cfg.APIURL = strings.TrimSuffix(cfg.APIURL, "/")
+	cfg.MetadataURL = strings.TrimSuffix(cfg.MetadataURL, "/")
+	return &GCPProvider{
+		cfg:    cfg,
+		client: &http.Client{Timeout: cfg.Timeout},
+		now:    time.Now,
+	}
+}
+
+// Name implements Provider.
+func (p *GCPProvider) Name() string { return "gcp" }
+
+// Lookup implements Provider. Instances are matched by name; a domain
+// suffix (as in "web-1.c.project.internal") is ignored. IP addresses
+// never match.
+func (p *GCPProvider) Lookup(ctx context.Context, host string) (*Instance, error) {
+	name, _, _ := strings.Cut(host, ".")
+	if name == "" || !isGCEName(name) {
+		return nil, nil
+	}
+
+	token, err := p.accessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("%s/projects/%s/aggregated/instances?filter=%s",
+		p.cfg.APIURL, url.PathEscape(p.cfg.Project), url.QueryEscape(fmt.Sprintf("name = %q", name)))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	var result gceAggregatedInstances
+	if err := p.do(req, &result); err != nil {
+		return nil, fmt.Errorf("listing instances: %w", err)
+	}
+	for _, scoped := range result.Items {
+		for _, inst := range scoped.Instances {
+			zone := lastSegment(inst.Zone)
+			instance := &Instance{
+				Provider:     "gcp",
+				ID:           inst.ID,
+				Region:       zoneRegion(zone),
+				Zone:         zone,
+				InstanceType: lastSegment(inst.MachineType),
+			}
+			for _, item := range inst.Metadata.Items {
+				if item.Key == "created-by" {
+					instance.Group = lastSegment(item.Value)
+				}
+			}
+			return instance, nil
+		}
+	}
+	return nil, nil
+}
+
+// accessToken returns a cached token from the metadata server, refreshed a
+// minute before it expires.
+func (p *GCPProvider) accessToken(ctx context.Context) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.token != "" && p.now().Before(p.tokenExpiry) {
+		return p.token, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		p.cfg.MetadataURL+"/instance/service-accounts/default/token", nil)
+	if err != nil {
+		return "", fmt.Errorf("creating token request: %w", err)
+	}
+	req.Header.Set("Metadata-Flavor", "Google")
+
+	var token struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	if err := p.do(req, &token); err != nil {
+		return "", fmt.Errorf("fetching access token: %w", err)
+	}
+	if token.AccessToken == "" {
+		return "", fmt.Errorf("fetching access token: empty token")
+	}
+	p.token = token.AccessToken
+	p.tokenExpiry = p.now().Add(time.Duration(token.ExpiresIn)*time.Second - time.Minute)
+	return p.token, nil
+}
+
+// do sends req and decodes a JSON response into v.
+func (p *GCPProvider) do(req *http.Request, v any) error {
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return fmt.Errorf("reading response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		var apiErr struct {
+			Error struct {
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		if json.Unmarshal(data, &apiErr) == nil && apiErr.Error.Message != "" {
+			return fmt.Errorf("%s: %s", resp.Status, apiErr.Error.Message)
+		}
+		return fmt.Errorf("%s", resp.Status)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("decoding response: %w", err)
+	}
+	return nil
+}
+
+// isGCEName reports whether name can be a Compute Engine instance name
+// (lowercase letters, digits and hyphens, starting with a letter).
+func isGCEName(name string) bool {
+	if name[0] < 'a' || name[0] > 'z' {
+		return false
+	}
+	for _, c := range name {
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') && c != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+// zoneRegion derives the region from a zone name ("europe-west1-b" ->
+// "europe-west1").
+func zoneRegion(zone string) string {
+	if i := strings.LastIndex(zone, "-"); i > 0 {
+		return zone[:i]
+	}
+	return zone
+}
+
+func lastSegment(s string) string {
+	return s[strings.LastIndex(s, "/")+1:]
+}
+
+// aggregatedList response, reduced to the fields used.
+type gceAggregatedInstances struct {
+	Items map[string]struct {
+		Instances []struct {
+			ID          string `json:"id"`
+			Zone        string `json:"zone"`
+			MachineType string `json:"machineType"`
+			Metadata    struct {
+				Items []struct {
+					Key   string `json:"key"`
+					Value string `json:"value"`
+				} `json:"items"`
+			} `json:"metadata"`
+		} `json:"instances"`
+	} `json:"items"`
+}
@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"gopkg.in/yaml.v3"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/notifytemplate"
 )
 
 // Config holds all application configuration.
@@ -16,10 +18,139 @@ type Config struct {
 	Storage      StorageConfig      `yaml:"storage"`
 	Slack        SlackConfig        `yaml:"slack"`
 	PagerDuty    PagerDutyConfig    `yaml:"pagerduty"`
+	Teams        TeamsConfig        `yaml:"teams"`
+	FireHydrant  IncidentToolConfig `yaml:"firehydrant"`
+	IncidentIO   IncidentToolConfig `yaml:"incidentio"`
+	Email        EmailConfig        `yaml:"email"`
 	Alerting     AlertingConfig     `yaml:"alerting"`
 	Logging      LoggingConfig      `yaml:"logging"`
 	Alertmanager AlertmanagerConfig `yaml:"alertmanager"`
+	Grafana      GrafanaConfig      `yaml:"grafana"`
+	CloudWatch   CloudWatchConfig   `yaml:"cloudwatch"`
+	Sentry       SentryConfig       `yaml:"sentry"`
 	Subscribers  []SubscriberConfig `yaml:"subscribers"`
+	OnCall       []OnCallConfig     `yaml:"oncall"`
+	CustomFields CustomFieldsConfig `yaml:"custom_fields"`
+	Reports      []ReportConfig     `yaml:"reports"`
+	OnCallReport OnCallReportConfig `yaml:"oncall_report"`
+	Canary       CanaryConfig       `yaml:"canary"`
+	PayloadLog   PayloadLogConfig   `yaml:"payload_log"`
+	DeliverySLO  DeliverySLOConfig  `yaml:"delivery_slo"`
+	BatchIngest  BatchIngestConfig  `yaml:"batch_ingest"`
+	CloudEvents  CloudEventsConfig  `yaml:"cloudevents"`
+	APIAuth      APIAuthConfig      `yaml:"api_auth"`
+
+	Observability ObservabilityConfig `yaml:"observability"`
+	CloudMetadata CloudMetadataConfig `yaml:"cloud_metadata"`
+	Enrichment    EnrichmentConfig    `yaml:"enrichment"`
+	RetryQueue    RetryQueueConfig    `yaml:"retry_queue"`
+	Escalation    EscalationConfig    `yaml:"escalation"`
+	Routing       RoutingConfig       `yaml:"routing"`
+	ClockSkew     ClockSkewConfig     `yaml:"clock_skew"`
+	OutboundHTTP  OutboundHTTPConfig  `yaml:"outbound_http"`
+	Users         UsersConfig         `yaml:"users"`
+
+	// AlertNames maps a canonical alert name to the names sources use for
+	// the same condition. Aliases are renamed on ingestion, before
+	// fingerprinting, routing and storage.
+	AlertNames map[string][]string `yaml:"alert_names"`
+
+	// SeverityMapping maps the severity label values sources send to alert
+	// severities. Hot-reloadable.
+	SeverityMapping SeverityMappingConfig `yaml:"severity_mapping"`
+
+	// Templates customizes notification bodies. Hot-reloadable.
+	Templates TemplatesConfig `yaml:"templates"`
+
+	// NotificationLimits caps how many new alerts each notifier posts.
+	NotificationLimits NotificationLimitsConfig `yaml:"notification_limits"`
+
+	// ResponseTimeReport configures the MTTA/MTTR report and its weekly
+	// Slack digest.
+	ResponseTimeReport ResponseTimeReportConfig `yaml:"response_time_report"`
+
+	GenericWebhooks []GenericWebhookConfig `yaml:"generic_webhooks"`
+}
+
+// Sources whose severity label values can be mapped with severity_mapping.
+var SeverityMappingSources = []string{"alertmanager", "grafana", "batch", "cloudevents"}
+
+// SeverityMappingConfig maps the severity label values of Alertmanager,
+// Grafana, batch and CloudEvents alerts to alert severities.
+type SeverityMappingConfig struct {
+	// Sources maps, per source, severity label values (case-insensitive) to
+	// critical, warning or info. Values a source does not map use the
+	// built-in mapping: critical and page are critical, warning and warn
+	// are warning.
+	Sources map[string]map[string]string `yaml:"sources"`
+
+	// Default is the severity of values no mapping knows. Defaults to info.
+	Default string `yaml:"default"`
+}
+
+// TemplatesConfig replaces the built-in Slack message body, PagerDuty
+// summary and email body with Go text/templates, executed with .Alert,
+// .Labels, .Annotations, .Status and .Duration.
+type TemplatesConfig struct {
+	// Dir holds template files named slack_message.tmpl,
+	// pagerduty_summary.tmpl and email_body.tmpl. Missing files keep the
+	// built-in format.
+	Dir string `yaml:"dir"`
+
+	// Inline templates take precedence over the files of Dir.
+	SlackMessage     string `yaml:"slack_message"`
+	PagerDutySummary string `yaml:"pagerduty_summary"`
+	EmailBody        string `yaml:"email_body"`
+}
+
+// Inline returns the inline templates by name.
+func (c TemplatesConfig) Inline() map[string]string {
+	return map[string]string{
+		notifytemplate.SlackMessage:     c.SlackMessage,
+		notifytemplate.PagerDutySummary: c.PagerDutySummary,
+		notifytemplate.EmailBody:        c.EmailBody,
+	}
+}
+
+// Custom field types.
+const (
+	CustomFieldString = "string"
+	CustomFieldEnum   = "enum"
+	CustomFieldNumber = "number"
+)
+
+// DefaultTenant is the custom field schema used for alerts without a tenant
+// label or whose tenant has no schema of its own.
+const DefaultTenant = "default"
+
+// CustomFieldsConfig declares typed fields that are extracted from alert
+// labels or annotations and stored separately from them.
+type CustomFieldsConfig struct {
+	// TenantLabel is the alert label whose value selects the schema in Tenants.
+	TenantLabel string `yaml:"tenant_label"`
+
+	// Tenants maps a tenant (TenantLabel value) to its field definitions.
+	// The "default" entry applies when no tenant-specific schema matches.
+	Tenants map[string][]CustomFieldConfig `yaml:"tenants"`
+}
+
+// CustomFieldConfig defines a single typed custom field.
+type CustomFieldConfig struct {
+	// Name is the field name shown in Slack and used for filtering.
+	Name string `yaml:"name"`
+
+	// Type is "string", "enum", or "number".
+	Type string `yaml:"type"`
+
+	// Label or Annotation names the source key; exactly one must be set.
+	Label      string `yaml:"label,omitempty"`
+	Annotation string `yaml:"annotation,omitempty"`
+
+	// Values lists the allowed values for enum fields.
+	Values []string `yaml:"values,omitempty"`
+
+	// Required reports missing values as validation problems on ingest.
+	Required bool `yaml:"required,omitempty"`
 }
 
 // SubscriberConfig defines a subscriber who receives alert notifications.
@@ -51,16 +182,190 @@ type SubscriberConfig struct {
 	Enabled *bool `yaml:"enabled,omitempty"`
 }
 
+// On-call rotation periods.
+const (
+	RotationDaily  = "daily"
+	RotationWeekly = "weekly"
+)
+
+// OnCallConfig defines an on-call rotation. Alerts matching Labels are
+// routed to the member currently on call, as if that member were a
+// subscriber with these labels.
+type OnCallConfig struct {
+	// Name identifies the rotation (e.g., "infra-primary").
+	Name string `yaml:"name"`
+
+	// Labels selects the alerts the rotation covers (AND logic). Empty
+	// covers every alert.
+	Labels map[string]string `yaml:"labels"`
+
+	// Rotation is how often the shift changes hands: "daily" or "weekly".
+	Rotation string `yaml:"rotation"`
+
+	// Start is when the first member's first shift begins, with its UTC
+	// offset (e.g., 2026-01-05T09:00:00+09:00). Later handoffs happen at the
+	// same time of day.
+	Start time.Time `yaml:"start"`
+
+	// Members are subscriber names, in shift order. Members need no labels
+	// of their own.
+	Members []string `yaml:"members"`
+}
+
+// ShiftLength returns the duration of one shift.
+func (o *OnCallConfig) ShiftLength() time.Duration {
+	if o.Rotation == RotationWeekly {
+		return 7 * 24 * time.Hour
+	}
+	return 24 * time.Hour
+}
+
 // StorageConfig holds persistence storage settings.
 type StorageConfig struct {
-	Type   string       `yaml:"type"` // "memory", "sqlite", or "mysql"
+	Type   string       `yaml:"type"` // "memory", "sqlite", "mysql", or "redis"
 	SQLite SQLiteConfig `yaml:"sqlite"`
 	MySQL  MySQLConfig  `yaml:"mysql"`
+	Redis  RedisConfig  `yaml:"redis"`
+
+	// Encryption encrypts free-text fields in the SQL backends.
+	Encryption StorageEncryptionConfig `yaml:"encryption"`
+
+	// Degradation keeps alerts flowing when the backend fails.
+	Degradation StorageDegradationConfig `yaml:"degradation"`
+
+	// FiringCache skips storage for duplicates of firing alerts.
+	FiringCache FiringCacheConfig `yaml:"firing_cache"`
+
+	// Retention purges old data so storage does not grow unbounded.
+	Retention RetentionConfig `yaml:"retention"`
+}
+
+// RetentionConfig runs a background janitor that permanently deletes old
+// resolved alerts (with their timelines), ack events and expired silences,
+// for every backend.
+type RetentionConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// Interval is how often the janitor runs. Defaults to 1h.
+	Interval time.Duration `yaml:"interval"`
+
+	// ResolvedAlerts is how long alerts are kept after they resolve.
+	// Defaults to 720h (30 days).
+	ResolvedAlerts time.Duration `yaml:"resolved_alerts"`
+
+	// AckEvents is how long acknowledgment events are kept. Defaults to
+	// 2160h (90 days).
+	AckEvents time.Duration `yaml:"ack_events"`
+
+	// ExpiredSilences is how long silences are kept after they expire.
+	// Defaults to 168h (7 days).
+	ExpiredSilences time.Duration `yaml:"expired_silences"`
+
+	// Archive exports resolved alerts to object storage before they are
+	// deleted.
+	Archive ArchiveConfig `yaml:"archive"`
+}
+
+// ArchiveConfig uploads resolved alerts, with their ack events and
+// timelines, to an S3-compatible bucket as gzipped JSON Lines before the
+// retention janitor deletes them. Google Cloud Storage is reached through
+// its S3-compatible XML API with HMAC keys.
+type ArchiveConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// Endpoint is the base URL of the object storage API. Defaults to
+	// https://s3.<region>.amazonaws.com; use https://storage.googleapis.com
+	// for Google Cloud Storage.
+	Endpoint string `yaml:"endpoint"`
+
+	// Region signs requests. Defaults to us-east-1; GCS accepts "auto".
+	Region string `yaml:"region"`
+
+	// Bucket receives the archive files.
+	Bucket string `yaml:"bucket"`
+
+	// Prefix is prepended to the object keys, e.g. "alert-bridge/".
+	Prefix string `yaml:"prefix"`
+
+	// AccessKeyID and SecretAccessKey sign requests (AWS access keys, or
+	// GCS HMAC keys).
+	AccessKeyID     string `yaml:"access_key_id"`
+	SecretAccessKey string `yaml:"secret_access_key"`
+
+	// SessionToken accompanies temporary AWS credentials, such as those of
+	// an assumed role.
+	SessionToken string `yaml:"session_token"`
+
+	// PathStyle addresses the bucket in the path rather than the host name,
+	// as MinIO and most self-hosted stores require.
+	PathStyle bool `yaml:"path_style"`
+
+	// Timeout bounds each upload. Defaults to 60s.
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+// FiringCacheConfig keeps the fingerprints of firing alerts in memory, in
+// sync with alert writes, so an alert sent again while firing at the same
+// severity is recognized without querying or updating the SQLite, MySQL or
+// Redis backend.
+type FiringCacheConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// TTL is how long a fingerprint is trusted before storage is read
+	// again, which also refreshes the alert's last-seen time. With several
+	// replicas, it bounds how long a resolution by another replica goes
+	// unnoticed. Must be below alerting.stale_after. Defaults to 30s.
+	TTL time.Duration `yaml:"ttl"`
+}
+
+// StorageDegradationConfig chooses what happens to writes that fail
+// because the SQLite, MySQL or Redis backend is unavailable, instead of
+// failing the webhook.
+type StorageDegradationConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// Order lists the steps tried for a failed write, in order: retry
+	// (retry once after retry_backoff) and buffer (hold the write in
+	// memory, keep notifying, and write it back when the backend
+	// recovers). If every step fails, the write fails. Defaults to
+	// [retry, buffer].
+	Order []string `yaml:"order"`
+
+	// RetryBackoff is the wait before the retry step. Defaults to 500ms.
+	RetryBackoff time.Duration `yaml:"retry_backoff"`
+
+	// MaxBuffered bounds the writes held in memory. Defaults to 10000.
+	MaxBuffered int `yaml:"max_buffered"`
+
+	// FlushInterval is how often held writes are written back while the
+	// backend is down. Defaults to 15s.
+	FlushInterval time.Duration `yaml:"flush_interval"`
+
+	// MetaAlertChannelID is the Slack channel told when storage degrades
+	// and recovers.
+	MetaAlertChannelID string `yaml:"meta_alert_channel_id"`
+}
+
+// StorageEncryptionConfig encrypts ack notes, alert notes and alert event
+// details, which often carry incident detail, before the SQLite and MySQL
+// backends store them.
+type StorageEncryptionConfig struct {
+	// Key is a base64-encoded 32-byte AES-256 key, such as from
+	// "openssl rand -base64 32". Empty disables encryption. Values stored
+	// before it was set stay readable; values stored with it cannot be read
+	// without it.
+	Key string `yaml:"key"`
 }
 
 // SQLiteConfig holds SQLite-specific settings.
 type SQLiteConfig struct {
 	Path string `yaml:"path"` // Database file path, use ":memory:" for in-memory
+
+	// ReadPoolSize opens that many read-only connections for list queries,
+	// such as alert searches, so that they do not queue behind writes on
+	// the single write connection. 0 (default) runs all queries on the
+	// write connection.
+	ReadPoolSize int `yaml:"read_pool_size"`
 }
 
 // MySQLConfig holds MySQL-specific settings.
@@ -73,6 +378,47 @@ type MySQLConfig struct {
 	Charset   string              `yaml:"charset"`
 }
 
+// RedisConfig holds Redis storage settings.
+type RedisConfig struct {
+	Addr string `yaml:"addr"` // host:port
+
+	// Username authenticates as a Redis 6 ACL user. Without it, Password
+	// authenticates as the default user.
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+	DB       int    `yaml:"db"`
+
+	// TLS connects over TLS, as most managed Redis services require.
+	TLS RedisTLSConfig `yaml:"tls"`
+
+	// KeyPrefix namespaces all keys, so several deployments can share a
+	// Redis instance. Defaults to "alert-bridge:".
+	KeyPrefix string `yaml:"key_prefix"`
+
+	// ResolvedTTL is how long resolved alerts are kept before Redis expires
+	// them. Defaults to 7 days.
+	ResolvedTTL time.Duration `yaml:"resolved_ttl"`
+
+	PoolSize    int           `yaml:"pool_size"`
+	DialTimeout time.Duration `yaml:"dial_timeout"`
+}
+
+// RedisTLSConfig holds the TLS settings for Redis connections.
+type RedisTLSConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// CAFile is a PEM bundle to verify the server with instead of the
+	// system roots.
+	CAFile string `yaml:"ca_file"`
+
+	// ServerName is the name verified against the server certificate.
+	// Defaults to the host of Addr.
+	ServerName string `yaml:"server_name"`
+
+	// InsecureSkipVerify skips verifying the server certificate.
+	InsecureSkipVerify bool `yaml:"insecure_skip_verify"`
+}
+
 // MySQLInstanceConfig holds MySQL instance connection settings.
 type MySQLInstanceConfig struct {
 	Host     string `yaml:"host"`
@@ -82,7 +428,9 @@ type MySQLInstanceConfig struct {
 	Password string `yaml:"password"`
 }
 
-// MySQLReplicaConfig holds MySQL replica settings.
+// MySQLReplicaConfig holds MySQL replica settings. List queries go to the
+// replica while it is healthy; writes and lookups of single rows go to the
+// primary.
 type MySQLReplicaConfig struct {
 	Enabled  bool   `yaml:"enabled"`
 	Host     string `yaml:"host"`
@@ -90,6 +438,15 @@ type MySQLReplicaConfig struct {
 	Database string `yaml:"database"`
 	Username string `yaml:"username"`
 	Password string `yaml:"password"`
+
+	// MaxLag is the replication lag tolerated before reads fail over to
+	// the primary (default 30s). The lag is read from SHOW REPLICA STATUS,
+	// which needs the REPLICATION CLIENT privilege; without it only
+	// connectivity is checked.
+	MaxLag time.Duration `yaml:"max_lag"`
+
+	// HealthCheckInterval is the time between replica checks (default 10s).
+	HealthCheckInterval time.Duration `yaml:"health_check_interval"`
 }
 
 // MySQLPoolConfig holds MySQL connection pool settings.
@@ -107,20 +464,172 @@ type ServerConfig struct {
 	WriteTimeout    time.Duration `yaml:"write_timeout"`
 	RequestTimeout  time.Duration `yaml:"request_timeout"`
 	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+
+	// DrainTimeout bounds how long shutdown waits, once the HTTP server
+	// has stopped, for accepted webhooks and queued alert work to finish
+	// before storage is closed. Defaults to 15s.
+	DrainTimeout time.Duration `yaml:"drain_timeout"`
+
+	// MaxDecompressedBodyBytes bounds gzip/deflate webhook bodies after
+	// decoding. Defaults to 10 MiB.
+	MaxDecompressedBodyBytes int64 `yaml:"max_decompressed_body_bytes"`
+
+	Systemd SystemdConfig `yaml:"systemd"`
+}
+
+// SystemdConfig enables systemd's notification protocol for units with
+// Type=notify: readiness once the HTTP listener is up, and watchdog
+// keep-alives while health checks pass when the unit sets WatchdogSec.
+// It has no effect outside systemd.
+type SystemdConfig struct {
+	Enabled bool `yaml:"enabled"`
 }
 
 // SlackConfig holds Slack integration settings.
 type SlackConfig struct {
-	Enabled       bool             `yaml:"enabled"`
-	BotToken      string           `yaml:"bot_token"`
-	SigningSecret string           `yaml:"signing_secret"`
-	ChannelID     string           `yaml:"channel_id"`
-	AppID         string           `yaml:"app_id"`
-	APIURL        string           `yaml:"api_url,omitempty"` // Optional: for E2E testing with mock services
-	SocketMode    SocketModeConfig `yaml:"socket_mode"`
+	Enabled       bool   `yaml:"enabled"`
+	BotToken      string `yaml:"bot_token"`
+	SigningSecret string `yaml:"signing_secret"`
+	ChannelID     string `yaml:"channel_id"`
+	AppID         string `yaml:"app_id"`
+	APIURL        string `yaml:"api_url,omitempty"` // Optional: for E2E testing with mock services
+
+	// Mode is how Slack reaches alert-bridge: "events" (default) over the
+	// public /webhook/slack/* endpoints, or "socket" over an outbound Socket
+	// Mode connection, which needs no public endpoint. Unset follows
+	// socket_mode.enabled.
+	Mode       string           `yaml:"mode"`
+	SocketMode SocketModeConfig `yaml:"socket_mode"`
+
+	// ChannelLabel names the alert label that selects a channel from
+	// Channels (e.g., "team"). Alerts whose value has no entry are posted
+	// to ChannelID.
+	ChannelLabel string            `yaml:"channel_label"`
+	Channels     map[string]string `yaml:"channels"`
+
+	// Lifecycle cleans up the messages of resolved alerts, keyed by
+	// channel ID. Channels without an entry keep their messages.
+	Lifecycle map[string]MessageLifecycleConfig `yaml:"lifecycle"`
+
+	// HistoryChannelID mirrors a one-line, final-state record of every
+	// resolved alert to a dedicated channel (optional).
+	HistoryChannelID string `yaml:"history_channel_id"`
+
+	// FallbackTemplate is a text/template for the plain-text line sent with
+	// alert messages, which Slack shows in push notifications. Executed with
+	// .Alert, .Emoji and .Status. Empty uses "🔴 HighCPU on server-01".
+	FallbackTemplate string `yaml:"fallback_template"`
+
+	// RepostDeletedMessages posts a fresh message when an alert's message
+	// was deleted by hand, so that its state changes render again.
+	RepostDeletedMessages bool `yaml:"repost_deleted_messages"`
+
+	// DuplicateCheckWindow makes each new alert message first search this
+	// much channel history for an unresolved message of the same
+	// fingerprint, and update it instead of posting again. Guards against
+	// duplicates after a crash with memory storage. 0 disables the check.
+	DuplicateCheckWindow time.Duration `yaml:"duplicate_check_window"`
+
+	// ThreadReplies lists the lifecycle events posted as replies in the
+	// alert's thread: acknowledged, unacknowledged, resolved, silenced,
+	// severity_changed, note_added and escalated (default: silenced and
+	// note_added). An empty list posts none.
+	ThreadReplies []string `yaml:"thread_replies"`
+
+	// RateLimit paces posts per channel below Slack's chat.postMessage limit.
+	RateLimit SlackRateLimitConfig `yaml:"rate_limit"`
+
+	// AsyncInteractions handles button clicks after acknowledging them.
+	AsyncInteractions SlackAsyncInteractionsConfig `yaml:"async_interactions"`
+
+	// Actions adds buttons to the messages of matching alerts, such as
+	// "Open dashboard" or "Restart service".
+	Actions []SlackActionConfig `yaml:"actions"`
+}
+
+// SlackActionConfig is a custom button shown on the messages of alerts that
+// are not resolved. It either opens URL or posts the alert to Webhook.
+type SlackActionConfig struct {
+	// Name identifies the action in webhook payloads; letters, digits, "-"
+	// and "." only.
+	Name string `yaml:"name"`
+
+	// Label is the button text.
+	Label string `yaml:"label"`
+
+	// URL opens a link. It is a text/template executed with .Alert, such
+	// as "https://grafana/d/x?var-host={{ .Alert.Instance }}".
+	URL string `yaml:"url"`
+
+	// Webhook receives a JSON POST with the alert and who clicked.
+	Webhook string `yaml:"webhook"`
+
+	// Confirm asks for confirmation before the action runs.
+	Confirm bool `yaml:"confirm"`
+
+	// Style is "primary", "danger" or empty for the default.
+	Style string `yaml:"style"`
+
+	// Match selects alerts whose labels have these exact values; MatchRE
+	// selects on regular expressions matching the whole label value.
+	// Neither shows the button on every alert.
+	Match   map[string]string `yaml:"match"`
+	MatchRE map[string]string `yaml:"match_re"`
+}
+
+// SlackRateLimitConfig configures the token bucket that paces messages
+// posted to each Slack channel. Posts beyond the burst wait for a token
+// instead of failing with rate_limited. Message updates are not paced.
+type SlackRateLimitConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// PerSecond is the sustained rate of posts per channel (default: 1).
+	PerSecond float64 `yaml:"per_second"`
+
+	// Burst is how many posts a quiet channel takes at once (default: 3).
+	Burst int `yaml:"burst"`
+}
+
+// SlackAsyncInteractionsConfig queues button clicks and acknowledges them
+// at once, so that slow calls, such as syncing an ack to PagerDuty, do not
+// exceed Slack's 3-second limit and show users "operation timed out".
+// Clicks are handled by a pool of workers, which report failures through
+// the interaction's response_url; clicks on the same message go to the same
+// worker, in order. Modal submissions are still handled in the request, as
+// their validation errors are returned in the response.
+type SlackAsyncInteractionsConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// Workers is the number of clicks handled at once (default: 4).
+	Workers int `yaml:"workers"`
+
+	// QueueSize is how many clicks may wait per worker; beyond it, the user
+	// is asked to try again (default: 100).
+	QueueSize int `yaml:"queue_size"`
+}
+
+// MessageLifecycleConfig deletes or collapses the Slack messages of a
+// channel After their alert resolved. Alerts keep their full history in
+// storage.
+type MessageLifecycleConfig struct {
+	// Action is "delete", or "collapse" to replace the message with a
+	// one-line record.
+	Action string        `yaml:"action"`
+	After  time.Duration `yaml:"after"`
+
+	// HistoryChannelID optionally receives the one-line record of each
+	// alert before the action is applied.
+	HistoryChannelID string `yaml:"history_channel_id"`
 }
 
-// SocketModeConfig holds Socket Mode settings for local development.
+// Slack modes, how Slack delivers commands, interactions and events.
+const (
+	SlackModeEvents = "events"
+	SlackModeSocket = "socket"
+)
+
+// SocketModeConfig holds Socket Mode settings, used when slack.mode is
+// "socket".
 type SocketModeConfig struct {
 	Enabled      bool          `yaml:"enabled"`
 	AppToken     string        `yaml:"app_token"`
@@ -138,6 +647,88 @@ type PagerDutyConfig struct {
 	FromEmail       string `yaml:"from_email"`
 	DefaultSeverity string `yaml:"default_severity"`
 	APIURL          string `yaml:"api_url,omitempty"` // Optional: for E2E testing with mock services
+
+	// DashboardURL links triggered incidents to the alert's page, with
+	// {alert_id} and {fingerprint} replaced. A link to the Slack message is
+	// added whenever the alert was posted to Slack.
+	DashboardURL string `yaml:"dashboard_url"`
+
+	// SendQueue holds events while the Events API throttles them.
+	SendQueue PagerDutySendQueueConfig `yaml:"send_queue"`
+}
+
+// PagerDutySendQueueConfig configures the queue that holds PagerDuty events
+// while the Events API answers with HTTP 429. Queued triggers are sent
+// before acks and updates, the most severe first.
+type PagerDutySendQueueConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// Backoff is how long sends pause after a throttled event (default: 30s).
+	Backoff time.Duration `yaml:"backoff"`
+
+	// MaxAge is how long triggers and acks of alerts below ShedBelow may
+	// wait before they are shed (default: 10m).
+	MaxAge time.Duration `yaml:"max_age"`
+
+	// ShedBelow is the lowest severity that is never shed: critical,
+	// warning or info (default: critical).
+	ShedBelow string `yaml:"shed_below"`
+}
+
+// TeamsConfig holds Microsoft Teams integration settings.
+type TeamsConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// WebhookURL is the incoming webhook (or Workflows "post to channel") URL.
+	WebhookURL string `yaml:"webhook_url"`
+	// PublicURL is the externally reachable base URL of alert-bridge.
+	// Ack buttons are only rendered when this is set.
+	PublicURL string `yaml:"public_url"`
+	// SigningSecret signs ack links so /webhook/teams can verify them.
+	SigningSecret string `yaml:"signing_secret"`
+	// ActionLinkTTL is how long an ack link stays valid after the card is posted.
+	ActionLinkTTL time.Duration `yaml:"action_link_ttl"`
+}
+
+// IncidentToolConfig connects an incident management tool, FireHydrant or
+// incident.io. Alerts linked to one of its incidents have their lifecycle
+// events posted to the incident's timeline, and the incident's webhooks
+// acknowledge and resolve them.
+type IncidentToolConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// APIToken authenticates timeline posts.
+	APIToken string `yaml:"api_token"`
+
+	// APIURL is the API base URL, for proxies and tests (defaults to the
+	// tool's public API).
+	APIURL string `yaml:"api_url"`
+
+	// WebhookSecret verifies the tool's webhooks. The webhook endpoint is
+	// only served when it is set.
+	WebhookSecret string `yaml:"webhook_secret"`
+
+	// Events lists the alert events posted to linked incidents' timelines
+	// (default: acknowledged, unacknowledged, resolved, severity_changed,
+	// note_added, silenced, assigned and escalated).
+	Events []string `yaml:"events"`
+}
+
+// EmailConfig holds SMTP email notification settings.
+type EmailConfig struct {
+	Enabled  bool   `yaml:"enabled"`
+	SMTPHost string `yaml:"smtp_host"`
+	SMTPPort int    `yaml:"smtp_port"`
+	// Username and Password enable SMTP AUTH PLAIN when Username is set.
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+	From     string `yaml:"from"`
+	// Recipients maps alert severity (critical, warning, info) to addresses.
+	Recipients map[string][]string `yaml:"recipients"`
+	// DefaultRecipients receive alerts whose severity has no Recipients entry.
+	DefaultRecipients []string `yaml:"default_recipients"`
+	// Timeout bounds each delivery, from connecting to the end of the
+	// session. Defaults to 30s.
+	Timeout time.Duration `yaml:"timeout"`
 }
 
 // AlertingConfig holds alerting behavior settings.
@@ -145,18 +736,858 @@ type AlertingConfig struct {
 	DeduplicationWindow time.Duration   `yaml:"deduplication_window"`
 	ResendInterval      time.Duration   `yaml:"resend_interval"`
 	SilenceDurations    []time.Duration `yaml:"silence_durations"`
+
+	// StaleAfter auto-resolves Alertmanager alerts that have not been sent
+	// again for this long (and whose endsAt passed), e.g. after a missed
+	// resolve webhook. Must exceed Alertmanager's repeat_interval. 0 disables.
+	StaleAfter time.Duration `yaml:"stale_after"`
+
+	// Resend reminds responders in Slack of alerts that stay active and
+	// unacknowledged, every ResendInterval unless overridden per severity.
+	Resend ResendConfig `yaml:"resend"`
+
+	// SilenceReminder asks the creators of silences in Slack whether to
+	// extend them shortly before they expire.
+	SilenceReminder SilenceReminderConfig `yaml:"silence_reminder"`
+
+	// Sharding processes incoming alerts on worker shards.
+	Sharding ShardingConfig `yaml:"sharding"`
+
+	// Parallel processes the alerts of a webhook payload concurrently and
+	// calls notifiers in parallel.
+	Parallel ParallelConfig `yaml:"parallel"`
+
+	// Pipeline lists the stages new alerts go through, in order: enrich,
+	// custom_fields, silence, route and notify. Stages may be left out or
+	// repeated; notify must be last. Defaults to all five in that order.
+	Pipeline []string `yaml:"pipeline"`
+}
+
+// ParallelConfig processes the alerts of a webhook payload concurrently, up
+// to Concurrency at a time, and calls the notifiers of each alert in
+// parallel. Alerts with the same fingerprint are still processed in order.
+// With sharding enabled, the shards decide which alerts run at once and
+// only the notifier calls follow this setting.
+type ParallelConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// Concurrency is how many alerts of a payload are processed at once
+	// (default: 16).
+	Concurrency int `yaml:"concurrency"`
+}
+
+// ShardingConfig processes incoming alerts on a fixed set of worker shards,
+// choosing each alert's shard by consistent hashing of its fingerprint.
+// Alerts with the same fingerprint are processed one at a time, in order of
+// arrival; alerts of a webhook payload with different fingerprints are
+// processed in parallel.
+type ShardingConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// Shards is the number of workers (default: 8).
+	Shards int `yaml:"shards"`
+
+	// QueueSize is how many alerts may wait per shard before webhooks wait
+	// for room (default: 1000).
+	QueueSize int `yaml:"queue_size"`
+}
+
+// SilenceReminderConfig sends a Slack direct message to the creator of a
+// silence that is about to expire, with a select to extend it. Only
+// silences created from Slack know their creator.
+type SilenceReminderConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// Before is how long before expiry the reminder is sent (default 15m).
+	Before time.Duration `yaml:"before"`
+}
+
+// ResendConfig replies in the Slack thread of, or re-posts, alerts that
+// stay active and unacknowledged.
+type ResendConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// Action is "reply" (default) to reply in the alert's thread, "repost"
+	// to post a fresh message, or "none".
+	Action string `yaml:"action"`
+
+	// Mention is added to the reminder, such as "@here", "@channel" or a
+	// Slack mention like "<!subteam^S0123>" (optional).
+	Mention string `yaml:"mention"`
+
+	// Severities override the interval, action and mention per severity
+	// (critical, warning, info).
+	Severities map[string]ResendPolicyConfig `yaml:"severities"`
+}
+
+// ResendPolicyConfig overrides the resend settings of a severity. Empty
+// fields keep the defaults.
+type ResendPolicyConfig struct {
+	Interval time.Duration `yaml:"interval"`
+	Action   string        `yaml:"action"`
+	Mention  string        `yaml:"mention"`
 }
 
 // LoggingConfig holds logging settings.
 type LoggingConfig struct {
 	Level  string `yaml:"level"`
 	Format string `yaml:"format"`
+
+	// Redact lists extra values to remove from log output, such as
+	// credentials of systems alert-bridge logs about but does not call.
+	// Configured credentials and Slack tokens are always redacted.
+	Redact []string `yaml:"redact"`
 }
 
 // AlertmanagerConfig holds Alertmanager webhook settings.
 type AlertmanagerConfig struct {
 	WebhookSecret string   `yaml:"webhook_secret"`
 	AllowedIPs    []string `yaml:"allowed_ips"` // Optional IP whitelist (not yet implemented)
+
+	Fingerprinting FingerprintConfig `yaml:"fingerprinting"`
+
+	// SilenceSync creates silences made in Slack in Alertmanager as well.
+	SilenceSync AlertmanagerSilenceSyncConfig `yaml:"silence_sync"`
+
+	// Async answers webhooks before their alerts are processed.
+	Async AlertmanagerAsyncConfig `yaml:"async"`
+}
+
+// AlertmanagerAsyncConfig queues Alertmanager webhook payloads and answers
+// 202 at once, so slow notifier calls do not hold up Alertmanager and make
+// it retry. Payloads are processed by a pool of workers; payloads of the
+// same group go to the same worker, in order.
+type AlertmanagerAsyncConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// Workers is the number of payloads processed at once (default: 4).
+	Workers int `yaml:"workers"`
+
+	// QueueSize is how many payloads may wait per worker; beyond it,
+	// webhooks are answered 503 so Alertmanager retries (default: 100).
+	QueueSize int `yaml:"queue_size"`
+}
+
+// AlertmanagerSilenceSyncConfig mirrors Slack silences in Alertmanager
+// through its v2 API, so that Alertmanager stops sending the silenced
+// alerts too and its UI shows the silences.
+type AlertmanagerSilenceSyncConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// URL is the Alertmanager base URL, e.g. http://alertmanager:9093.
+	URL string `yaml:"url"`
+
+	// Username and Password authenticate with HTTP basic auth (optional).
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+
+	// Timeout limits each API call (default 10s).
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+// GrafanaConfig holds Grafana alerting webhook settings.
+type GrafanaConfig struct {
+	// WebhookToken, if set, must be sent by Grafana as "Authorization: Bearer <token>"
+	// (contact point: Authorization Header - Credentials).
+	WebhookToken string `yaml:"webhook_token"`
+
+	Fingerprinting FingerprintConfig `yaml:"fingerprinting"`
+}
+
+// CloudWatchConfig holds settings for CloudWatch alarms delivered via Amazon SNS.
+type CloudWatchConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// Severity is applied to all CloudWatch alarms, which carry none of their own.
+	Severity string `yaml:"severity"`
+
+	// TopicARNs, if set, restricts accepted notifications to these SNS topics.
+	TopicARNs []string `yaml:"topic_arns"`
+
+	Fingerprinting FingerprintConfig `yaml:"fingerprinting"`
+}
+
+// SentryConfig holds settings for Sentry integration platform webhooks.
+type SentryConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// ClientSecret is the internal integration's client secret, used to
+	// verify the Sentry-Hook-Signature header.
+	ClientSecret string `yaml:"client_secret"`
+
+	Fingerprinting FingerprintConfig `yaml:"fingerprinting"`
+}
+
+// GenericWebhookConfig defines a JSON webhook source whose payload is mapped
+// to alerts by expressions (see internal/infrastructure/jsonmap), served at
+// /webhook/generic/<name>.
+type GenericWebhookConfig struct {
+	Name string `yaml:"name"`
+
+	// Token, if set, must be sent as "Authorization: Bearer <token>".
+	Token string `yaml:"token"`
+
+	// Alerts is an optional path to an array in the payload; each element
+	// is mapped as one alert. Without it the whole payload is one alert.
+	Alerts string `yaml:"alerts"`
+
+	Mapping GenericMappingConfig `yaml:"mapping"`
+
+	Fingerprinting FingerprintConfig `yaml:"fingerprinting"`
+}
+
+// GenericMappingConfig holds the expressions that build an alert from a
+// payload. Only Name is required.
+type GenericMappingConfig struct {
+	// Fingerprint identifies the alert across updates.
+	// Defaults to a hash of the mapped labels.
+	Fingerprint string `yaml:"fingerprint"`
+	Name        string `yaml:"name"`
+
+	// Status is compared with ResolvedValues; anything else is firing.
+	// Without it every payload fires.
+	Status         string   `yaml:"status"`
+	ResolvedValues []string `yaml:"resolved_values"`
+
+	// Severity is looked up in SeverityMap first, then interpreted as
+	// critical/warning/info.
+	Severity    string            `yaml:"severity"`
+	SeverityMap map[string]string `yaml:"severity_map"`
+
+	Instance    string `yaml:"instance"`
+	Target      string `yaml:"target"`
+	Summary     string `yaml:"summary"`
+	Description string `yaml:"description"`
+
+	// FiredAt accepts RFC 3339 or Unix seconds/milliseconds. Defaults to now.
+	FiredAt string `yaml:"fired_at"`
+
+	Labels      map[string]string `yaml:"labels"`
+	Annotations map[string]string `yaml:"annotations"`
+}
+
+// Expressions returns every non-empty mapping expression keyed by its
+// config field name, for validation.
+func (m GenericMappingConfig) Expressions() map[string]string {
+	exprs := map[string]string{
+		"fingerprint": m.Fingerprint,
+		"name":        m.Name,
+		"status":      m.Status,
+		"severity":    m.Severity,
+		"instance":    m.Instance,
+		"target":      m.Target,
+		"summary":     m.Summary,
+		"description": m.Description,
+		"fired_at":    m.FiredAt,
+	}
+	for k, v := range m.Labels {
+		exprs["labels."+k] = v
+	}
+	for k, v := range m.Annotations {
+		exprs["annotations."+k] = v
+	}
+	for k, v := range exprs {
+		if v == "" {
+			delete(exprs, k)
+		}
+	}
+	return exprs
+}
+
+// CanaryConfig defines a shadow Slack channel that receives a sample of
+// alerts rendered with candidate settings, for comparison before rollout.
+type CanaryConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// Percent of alerts (by fingerprint) sent to the shadow channel, 0-100.
+	Percent int `yaml:"percent"`
+
+	// Label selects additional alerts: any alert with this label set
+	// (to a value other than "false") is always sent. Defaults to "canary".
+	Label string `yaml:"label"`
+
+	// SlackChannelID is the shadow channel.
+	SlackChannelID string `yaml:"slack_channel_id"`
+
+	// SilenceDurations is the candidate silence button set.
+	// Defaults to alerting.silence_durations.
+	SilenceDurations []time.Duration `yaml:"silence_durations"`
+
+	// SlackMessage is the candidate Slack message body template.
+	// Defaults to templates.slack_message.
+	SlackMessage string `yaml:"slack_message"`
+
+	// FallbackTemplate is the candidate plain-text line. Defaults to
+	// slack.fallback_template.
+	FallbackTemplate string `yaml:"fallback_template"`
+
+	// Route is the candidate routing tree: sampled alerts are mirrored
+	// only if it delivers them to slack. Defaults to routing.route when
+	// routing is enabled, otherwise every sampled alert is mirrored.
+	Route *RouteConfig `yaml:"route"`
+}
+
+// Templates returns the inline templates of the shadow channel: the live
+// ones, with the candidate Slack message body if set.
+func (c CanaryConfig) Templates(live TemplatesConfig) map[string]string {
+	inline := live.Inline()
+	if c.SlackMessage != "" {
+		inline[notifytemplate.SlackMessage] = c.SlackMessage
+	}
+	return inline
+}
+
+// PayloadLogConfig controls in-memory recording of outbound notification
+// payloads, inspectable via GET /-/payloads.
+type PayloadLogConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// Size is the number of payloads kept per notifier. Defaults to 50.
+	Size int `yaml:"size"`
+}
+
+// DeliverySLOConfig defines latency objectives for delivering new alerts,
+// measured from webhook receipt to a successful notification.
+type DeliverySLOConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// Window is the rolling period compliance is computed over. Defaults to 1h.
+	Window time.Duration `yaml:"window"`
+
+	// MinSamples is the number of deliveries in the window needed before a
+	// breach is reported. Defaults to 10.
+	MinSamples int `yaml:"min_samples"`
+
+	// MetaAlertChannelID is the Slack channel that receives breach and
+	// recovery notices. Should not be the channel the objectives cover.
+	MetaAlertChannelID string `yaml:"meta_alert_channel_id"`
+
+	Objectives []DeliveryObjectiveConfig `yaml:"objectives"`
+}
+
+// NotificationLimitsConfig caps the new alerts each notifier posts, so a
+// misconfigured rule cannot flood a channel. Alerts over a limit are not
+// posted and are summed up in one summary message per interval instead.
+// Updates of posted alerts are never limited.
+type NotificationLimitsConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// Notifiers maps a notifier name (slack, pagerduty, teams, email,
+	// cloudevents, canary or an escalation target) to its limits.
+	// Notifiers not listed are not limited.
+	Notifiers map[string]NotificationLimitConfig `yaml:"notifiers"`
+
+	// SummaryInterval is how often suppressed alerts are summed up.
+	// Defaults to 1m.
+	SummaryInterval time.Duration `yaml:"summary_interval"`
+
+	// SummaryChannelID is the Slack channel summaries are posted to.
+	// Defaults to slack.channel_id.
+	SummaryChannelID string `yaml:"summary_channel_id"`
+}
+
+// NotificationLimitConfig limits one notifier with a token bucket, a
+// budget per window, or both.
+type NotificationLimitConfig struct {
+	// PerSecond is the sustained rate of new alerts. 0 disables the bucket.
+	PerSecond float64 `yaml:"per_second"`
+
+	// Burst is how many new alerts a quiet notifier posts at once
+	// (default: 10).
+	Burst int `yaml:"burst"`
+
+	// Budget is the most new alerts posted per Window. 0 disables it.
+	Budget int `yaml:"budget"`
+
+	// Window is the fixed period Budget applies to (default: 1m).
+	Window time.Duration `yaml:"window"`
+}
+
+// BatchIngestConfig enables POST /webhook/batch, which accepts NDJSON
+// streams of alerts for backfills and bursty producers.
+type BatchIngestConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// Token is the bearer token producers must send.
+	Token string `yaml:"token"`
+
+	// MaxLineBytes bounds a single NDJSON line; longer lines are reported as
+	// invalid. Defaults to 1 MiB.
+	MaxLineBytes int `yaml:"max_line_bytes"`
+
+	// MaxItems bounds the alerts read from one request. Defaults to 10000.
+	MaxItems int `yaml:"max_items"`
+
+	Fingerprinting FingerprintConfig `yaml:"fingerprinting"`
+}
+
+// CloudEventsConfig emits alerts as CloudEvents to event sinks and accepts
+// them on POST /webhook/cloudevents.
+type CloudEventsConfig struct {
+	// Source is the source attribute of emitted events. Defaults to
+	// "alert-bridge".
+	Source string `yaml:"source"`
+
+	Ingest CloudEventsIngestConfig `yaml:"ingest"`
+	Sinks  []CloudEventsSinkConfig `yaml:"sinks"`
+}
+
+// CloudEventsIngestConfig enables POST /webhook/cloudevents.
+type CloudEventsIngestConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// Token is the bearer token senders must send.
+	Token string `yaml:"token"`
+
+	// MaxEvents bounds the events of one batched request. Defaults to 1000.
+	MaxEvents int `yaml:"max_events"`
+
+	Fingerprinting FingerprintConfig `yaml:"fingerprinting"`
+}
+
+// CloudEventsSinkConfig is an endpoint receiving an event for every alert
+// state change, such as a Knative broker.
+type CloudEventsSinkConfig struct {
+	Name string `yaml:"name"`
+	URL  string `yaml:"url"`
+
+	// Mode is the HTTP content mode, binary (default) or structured.
+	Mode string `yaml:"mode"`
+
+	// Headers are sent with every event, e.g. authorization.
+	Headers map[string]string `yaml:"headers"`
+}
+
+// FingerprintConfig chooses how a source's alerts are fingerprinted. The
+// fingerprint deduplicates alerts and links them to their Slack, PagerDuty
+// and Teams messages, so senders with unstable fingerprints can be keyed on
+// labels instead.
+type FingerprintConfig struct {
+	// Strategy is upstream (default; the sender's fingerprint, or a hash of
+	// all labels if it sends none), labels (hash of LabelKeys), or
+	// all_labels (hash of every label).
+	Strategy string `yaml:"strategy"`
+
+	// LabelKeys are hashed by the labels strategy. Missing labels hash as
+	// empty.
+	LabelKeys []string `yaml:"label_keys"`
+}
+
+// APIAuthConfig protects the REST API (/api/v1) and admin (/-/) endpoints
+// with bearer tokens. Tokens are re-read on every request, so changes take
+// effect on config reload.
+type APIAuthConfig struct {
+	Enabled bool             `yaml:"enabled"`
+	Tokens  []APITokenConfig `yaml:"tokens"`
+}
+
+// ObservabilityConfig configures telemetry export. Prometheus metrics are
+// always served on /metrics.
+type ObservabilityConfig struct {
+	Tracing TracingConfig `yaml:"tracing"`
+}
+
+// TracingConfig exports OpenTelemetry spans to a collector over OTLP/HTTP.
+type TracingConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// Endpoint is the full OTLP/HTTP traces URL, e.g.
+	// http://otel-collector:4318/v1/traces.
+	Endpoint string `yaml:"endpoint"`
+
+	// Headers are added to every export request, e.g. collector auth.
+	Headers map[string]string `yaml:"headers"`
+
+	// SampleRatio is the fraction of new traces recorded, from 0 to 1
+	// (default 1). Requests with a traceparent header follow the caller's
+	// sampling decision.
+	SampleRatio float64 `yaml:"sample_ratio"`
+
+	// Timeout bounds each export request (default 10s).
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+// RetryQueueConfig persists notifications that failed with a transient
+// error (after the in-process retries) and retries them in the background.
+// Retries that exhaust max_attempts are kept as dead letters.
+type RetryQueueConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// Workers is the number of retries attempted concurrently (default 2).
+	Workers int `yaml:"workers"`
+
+	// PollInterval is how often due retries are looked up (default 10s).
+	PollInterval time.Duration `yaml:"poll_interval"`
+
+	// MaxAttempts counts every failed call, including the original
+	// notification (default 10).
+	MaxAttempts int `yaml:"max_attempts"`
+
+	// InitialBackoff is the wait before the first retry (default 30s); it
+	// doubles after every failure up to MaxBackoff (default 30m).
+	InitialBackoff time.Duration `yaml:"initial_backoff"`
+	MaxBackoff     time.Duration `yaml:"max_backoff"`
+}
+
+// EscalationConfig notifies further targets about firing alerts that stay
+// unacknowledged, following the first policy that matches each alert.
+type EscalationConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// Interval is how often alerts are checked against the policies
+	// (default 1m).
+	Interval time.Duration `yaml:"interval"`
+
+	// Targets are PagerDuty services only paged by escalation, such as a
+	// secondary on-call rotation.
+	Targets []EscalationTargetConfig `yaml:"targets"`
+
+	Policies []EscalationPolicyConfig `yaml:"policies"`
+}
+
+// EscalationTargetConfig is a PagerDuty service that policies notify by
+// name. It uses the pagerduty section's API token for acks and resolves.
+type EscalationTargetConfig struct {
+	Name                string `yaml:"name"`
+	PagerDutyRoutingKey string `yaml:"pagerduty_routing_key"`
+}
+
+// EscalationPolicyConfig escalates alerts with the given severity and
+// labels; leaving both empty matches every alert.
+type EscalationPolicyConfig struct {
+	Name     string                 `yaml:"name"`
+	Severity string                 `yaml:"severity"`
+	Labels   map[string]string      `yaml:"labels"`
+	Steps    []EscalationStepConfig `yaml:"steps"`
+}
+
+// EscalationStepConfig notifies targets once an alert has been firing
+// unacknowledged for After. Notify lists escalation targets or the built-in
+// notifiers (slack, pagerduty, teams, email).
+type EscalationStepConfig struct {
+	After  time.Duration `yaml:"after"`
+	Notify []string      `yaml:"notify"`
+}
+
+// RoutingConfig decides which notifiers receive each alert from a tree of
+// label-matching routes, like Alertmanager's route tree. When disabled,
+// every enabled notifier receives every alert.
+type RoutingConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// Route is the root of the tree. It matches every alert, so it may not
+	// set match, match_re or min_severity.
+	Route RouteConfig `yaml:"route"`
+}
+
+// RouteConfig is a node of the routing tree. An alert descends into the
+// first child route that matches it, and into later siblings too while the
+// matching child sets Continue. A route without a matching child delivers
+// the alert itself.
+type RouteConfig struct {
+	// Name identifies the route in logs.
+	Name string `yaml:"name"`
+
+	// Match selects alerts whose labels have these exact values; MatchRE
+	// selects on regular expressions matching the whole label value. All
+	// entries must match (AND logic).
+	Match   map[string]string `yaml:"match"`
+	MatchRE map[string]string `yaml:"match_re"`
+
+	// MinSeverity skips alerts less severe than this: critical, warning or
+	// info. Empty matches every severity.
+	MinSeverity string `yaml:"min_severity"`
+
+	// Notifiers lists the notifiers that receive matching alerts (slack,
+	// pagerduty, teams, email). Unset inherits the parent's list; an empty
+	// list delivers to none.
+	Notifiers []string `yaml:"notifiers"`
+
+	// SlackChannelID posts matching alerts to this channel instead of the
+	// slack section's channel. Unset inherits the parent's override.
+	SlackChannelID string `yaml:"slack_channel_id"`
+
+	// Continue lets the alert also be matched against the following
+	// sibling routes.
+	Continue bool `yaml:"continue"`
+
+	// Pipeline replaces the stages after route in alerting.pipeline for
+	// alerts this route delivers. Unset inherits the parent's stages.
+	Pipeline []string `yaml:"pipeline"`
+
+	Routes []RouteConfig `yaml:"routes"`
+}
+
+// ClockSkewConfig periodically compares the host clock with the Date
+// header of a reference server. A drifted host rejects every signed Slack
+// request as stale, so the skew is logged as an error and the accepted
+// timestamp distance can be widened meanwhile.
+type ClockSkewConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// URL is the reference server (default https://slack.com).
+	URL string `yaml:"url"`
+
+	// Interval is the time between checks (default 10m).
+	Interval time.Duration `yaml:"interval"`
+
+	// WarnThreshold is the offset reported as skew (default 30s).
+	WarnThreshold time.Duration `yaml:"warn_threshold"`
+
+	// WidenTolerance adds the measured offset to the 5 minute Slack
+	// timestamp tolerance while the clock is skewed, up to MaxTolerance
+	// (default 1h). This weakens replay protection, so it is off by default.
+	WidenTolerance bool          `yaml:"widen_tolerance"`
+	MaxTolerance   time.Duration `yaml:"max_tolerance"`
+}
+
+// OutboundHTTPConfig tunes the connection pool shared by the Slack,
+// PagerDuty and Teams clients.
+type OutboundHTTPConfig struct {
+	// MaxIdleConns caps idle connections across all hosts (default 100).
+	MaxIdleConns int `yaml:"max_idle_conns"`
+
+	// MaxIdleConnsPerHost caps idle connections per host (default 20). Raise
+	// it if outbound_connections_total shows few reused connections during
+	// alert storms.
+	MaxIdleConnsPerHost int `yaml:"max_idle_conns_per_host"`
+
+	// IdleConnTimeout closes connections idle for longer (default 90s).
+	IdleConnTimeout time.Duration `yaml:"idle_conn_timeout"`
+
+	// DisableHTTP2 restricts connections to HTTP/1.1.
+	DisableHTTP2 bool `yaml:"disable_http2"`
+
+	// DNSCacheTTL caches resolved addresses; 0 (default) resolves every new
+	// connection.
+	DNSCacheTTL time.Duration `yaml:"dns_cache_ttl"`
+}
+
+// UsersConfig maps people between their email, Slack user and PagerDuty
+// user, so that an acknowledgment from one system names the user in the
+// other system's terms, such as a Slack mention for an ack in PagerDuty.
+type UsersConfig struct {
+	// Directory lists known users. They take precedence over lookups.
+	Directory []UserConfig `yaml:"directory"`
+
+	Lookup UserLookupConfig `yaml:"lookup"`
+}
+
+// UserConfig is one person's identity across the integrations. Only the
+// email is required.
+type UserConfig struct {
+	Email           string `yaml:"email"`
+	Name            string `yaml:"name"`
+	SlackUserID     string `yaml:"slack_user_id"`
+	PagerDutyUserID string `yaml:"pagerduty_user_id"`
+}
+
+// UserLookupConfig looks up users missing from the directory by email.
+type UserLookupConfig struct {
+	// Slack looks users up with users.lookupByEmail, which requires the
+	// users:read.email scope.
+	Slack bool `yaml:"slack"`
+
+	// PagerDuty looks users up through the REST API, which requires
+	// pagerduty.api_token.
+	PagerDuty bool `yaml:"pagerduty"`
+
+	// CacheTTL is how long looked up users, and lookups that found nobody,
+	// are remembered (default 1h).
+	CacheTTL time.Duration `yaml:"cache_ttl"`
+}
+
+// CloudMetadataConfig enriches new alerts with the cloud metadata of their
+// instance (provider, region, zone, instance type, autoscaling group) as
+// labels, looked up through the AWS and GCP APIs.
+type CloudMetadataConfig struct {
+	Enabled bool `yaml:"enabled"`
+
+	// Label names the alert label holding the host: an instance ID, private
+	// IP or hostname, with an optional port (default "instance").
+	Label string `yaml:"label"`
+
+	// LabelPrefix is prepended to the added labels (default "cloud_").
+	LabelPrefix string `yaml:"label_prefix"`
+
+	// CacheTTL is how long found instances are cached (default 1h).
+	CacheTTL time.Duration `yaml:"cache_ttl"`
+
+	// NegativeCacheTTL is how long unknown hosts and failed lookups are
+	// cached (default 5m).
+	NegativeCacheTTL time.Duration `yaml:"negative_cache_ttl"`
+
+	// Timeout bounds each API request (default 5s).
+	Timeout time.Duration `yaml:"timeout"`
+
+	AWS CloudMetadataAWSConfig `yaml:"aws"`
+	GCP CloudMetadataGCPConfig `yaml:"gcp"`
+}
+
+// CloudMetadataAWSConfig looks up EC2 instances with DescribeInstances. The
+// credentials need ec2:DescribeInstances and fall back to the standard
+// AWS_* environment variables.
+type CloudMetadataAWSConfig struct {
+	Enabled         bool   `yaml:"enabled"`
+	Region          string `yaml:"region"`
+	AccessKeyID     string `yaml:"access_key_id"`
+	SecretAccessKey string `yaml:"secret_access_key"`
+	SessionToken    string `yaml:"session_token"`
+}
+
+// EnrichmentConfig calls HTTP endpoints to add labels and annotations to
+// new alerts before they are notified, such as the owning team looked up
+// in a CMDB. A failing endpoint adds nothing; the alert is still notified.
+type EnrichmentConfig struct {
+	Webhooks []EnrichmentWebhookConfig `yaml:"webhooks"`
+}
+
+// EnrichmentWebhookConfig is one enrichment endpoint. It receives a JSON
+// POST with the alert and answers with the labels and annotations to add.
+type EnrichmentWebhookConfig struct {
+	// Name identifies the webhook in logs.
+	Name string `yaml:"name"`
+
+	URL string `yaml:"url"`
+
+	// Headers are added to every request, e.g. authorization.
+	Headers map[string]string `yaml:"headers"`
+
+	// Timeout bounds each request (default 2s).
+	Timeout time.Duration `yaml:"timeout"`
+
+	// Match selects alerts whose labels have these exact values; empty
+	// calls the webhook for every new alert.
+	Match map[string]string `yaml:"match"`
+
+	// MaxFailures consecutive failures skip the webhook for Cooldown
+	// (defaults 5 and 1m), so an unreachable endpoint does not delay every
+	// alert by its timeout.
+	MaxFailures int           `yaml:"max_failures"`
+	Cooldown    time.Duration `yaml:"cooldown"`
+}
+
+// CloudMetadataGCPConfig looks up Compute Engine instances by name using
+// the default service account of the VM alert-bridge runs on.
+type CloudMetadataGCPConfig struct {
+	Enabled bool   `yaml:"enabled"`
+	Project string `yaml:"project"`
+}
+
+// APITokenConfig is one API client's token and what it may do.
+type APITokenConfig struct {
+	// Name identifies the client in audit logs.
+	Name  string `yaml:"name"`
+	Token string `yaml:"token"`
+
+	// Scopes granted to the token: read, ack, silence, admin. admin implies
+	// all others.
+	Scopes []string `yaml:"scopes"`
+}
+
+// DeliveryObjectiveConfig is one route's delivery objective, e.g. critical
+// alerts reach PagerDuty within 10s for 99% of alerts.
+type DeliveryObjectiveConfig struct {
+	Name string `yaml:"name"`
+
+	// Notifier restricts the objective to one notifier (slack, pagerduty,
+	// teams, email). Empty matches all notifiers.
+	Notifier string `yaml:"notifier"`
+
+	// Severity restricts the objective to one severity. Empty matches all.
+	Severity string `yaml:"severity"`
+
+	// Latency is the delivery deadline.
+	Latency time.Duration `yaml:"latency"`
+
+	// Target is the percentage of deliveries that must meet Latency.
+	Target float64 `yaml:"target"`
+}
+
+// ReportConfig defines a scheduled report rendered from a shared saved view
+// and delivered to a Slack channel, email recipients, or both.
+type ReportConfig struct {
+	Name string `yaml:"name"`
+
+	// View is the name of a shared saved view (see /alert-view).
+	View string `yaml:"view"`
+
+	// Schedule is a five-field cron expression or @hourly/@daily/@weekly/@monthly.
+	Schedule string `yaml:"schedule"`
+
+	// Timezone is the IANA zone the schedule is evaluated in. Defaults to UTC.
+	Timezone string `yaml:"timezone"`
+
+	// Period is how far back each report looks. Defaults to 168h (one week).
+	Period time.Duration `yaml:"period"`
+
+	SlackChannel    string   `yaml:"slack_channel"`
+	EmailRecipients []string `yaml:"email_recipients"`
+}
+
+// OnCallReportConfig configures the monthly on-call load report: pages,
+// acknowledgments and after-hours acknowledgments per person and team.
+type OnCallReportConfig struct {
+	// TeamLabel is the alert label naming the team. Defaults to "team".
+	TeamLabel string `yaml:"team_label"`
+
+	// Timezone is the IANA zone months and working hours are evaluated in.
+	// Defaults to UTC.
+	Timezone string `yaml:"timezone"`
+
+	// WorkingHours is the weekday window acks are not counted as after
+	// hours in, e.g. "09:00-18:00" (the default). Weekends are after hours.
+	WorkingHours string `yaml:"working_hours"`
+
+	// SlackDM sends each person their own stats for the previous month.
+	SlackDM bool `yaml:"slack_dm"`
+
+	// DMSchedule is the cron schedule of the direct messages, evaluated in
+	// Timezone. Defaults to "0 9 1 * *" (09:00 on the 1st).
+	DMSchedule string `yaml:"dm_schedule"`
+}
+
+// ParseWorkingHours returns the start and end of working hours as offsets
+// from midnight.
+func (o *OnCallReportConfig) ParseWorkingHours() (start, end time.Duration, err error) {
+	from, to, ok := strings.Cut(o.WorkingHours, "-")
+	if !ok {
+		return 0, 0, fmt.Errorf("working hours must be HH:MM-HH:MM, got %q", o.WorkingHours)
+	}
+	for _, bound := range []struct {
+		text string
+		out  *time.Duration
+	}{{from, &start}, {to, &end}} {
+		t, err := time.Parse("15:04", strings.TrimSpace(bound.text))
+		if err != nil {
+			return 0, 0, fmt.Errorf("working hours must be HH:MM-HH:MM, got %q", o.WorkingHours)
+		}
+		*bound.out = time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+	}
+	if end <= start {
+		return 0, 0, fmt.Errorf("working hours must end after they start, got %q", o.WorkingHours)
+	}
+	return start, end, nil
+}
+
+// ResponseTimeReportConfig configures the MTTA/MTTR report per alert name,
+// team and severity, and its scheduled Slack digest.
+type ResponseTimeReportConfig struct {
+	// TeamLabel is the alert label naming the team. Defaults to "team".
+	TeamLabel string `yaml:"team_label"`
+
+	// SlackChannel receives the digest. The digest is off when empty.
+	SlackChannel string `yaml:"slack_channel"`
+
+	// Schedule is the cron schedule of the digest, evaluated in Timezone.
+	// Defaults to "0 9 * * 1" (09:00 on Mondays).
+	Schedule string `yaml:"schedule"`
+
+	// Timezone is the IANA zone Schedule is evaluated in. Defaults to UTC.
+	Timezone string `yaml:"timezone"`
+
+	// Period is how far back the digest looks. Defaults to 168h (one week).
+	Period time.Duration `yaml:"period"`
 }
 
 // Load reads configuration from file and environment.
@@ -214,60 +1645,279 @@ func (c *Config) overrideFromEnv() {
 	if v := os.Getenv("SLACK_CHANNEL_ID"); v != "" {
 		c.Slack.ChannelID = v
 	}
-	if v := os.Getenv("SLACK_APP_ID"); v != "" {
-		c.Slack.AppID = v
+	if v := os.Getenv("SLACK_APP_ID"); v != "" {
+		c.Slack.AppID = v
+	}
+	if v := os.Getenv("SLACK_REPOST_DELETED_MESSAGES"); v != "" {
+		c.Slack.RepostDeletedMessages = strings.ToLower(v) == "true"
+	}
+	if v := os.Getenv("SLACK_DUPLICATE_CHECK_WINDOW"); v != "" {
+		if duration, err := time.ParseDuration(v); err == nil {
+			c.Slack.DuplicateCheckWindow = duration
+		}
+	}
+	if v := os.Getenv("SLACK_HISTORY_CHANNEL_ID"); v != "" {
+		c.Slack.HistoryChannelID = v
+	}
+	if v := os.Getenv("SLACK_RATE_LIMIT_ENABLED"); v != "" {
+		c.Slack.RateLimit.Enabled = strings.ToLower(v) == "true"
+	}
+	if v := os.Getenv("SLACK_ASYNC_INTERACTIONS_ENABLED"); v != "" {
+		c.Slack.AsyncInteractions.Enabled = strings.ToLower(v) == "true"
+	}
+
+	// Slack Socket Mode
+	if v := os.Getenv("SLACK_MODE"); v != "" {
+		c.Slack.Mode = strings.ToLower(v)
+	}
+	if v := os.Getenv("SLACK_SOCKET_MODE_ENABLED"); v != "" {
+		c.Slack.SocketMode.Enabled = strings.ToLower(v) == "true"
+	}
+	if v := os.Getenv("SLACK_SOCKET_MODE_APP_TOKEN"); v != "" {
+		c.Slack.SocketMode.AppToken = v
+	}
+	if v := os.Getenv("SLACK_SOCKET_MODE_DEBUG"); v != "" {
+		c.Slack.SocketMode.Debug = strings.ToLower(v) == "true"
+	}
+	if v := os.Getenv("SLACK_SOCKET_MODE_PING_INTERVAL"); v != "" {
+		if duration, err := time.ParseDuration(v); err == nil {
+			c.Slack.SocketMode.PingInterval = duration
+		}
+	}
+
+	// PagerDuty
+	if v := os.Getenv("PAGERDUTY_ENABLED"); v != "" {
+		c.PagerDuty.Enabled = strings.ToLower(v) == "true"
+	}
+	if v := os.Getenv("PAGERDUTY_API_TOKEN"); v != "" {
+		c.PagerDuty.APIToken = v
+	}
+	if v := os.Getenv("PAGERDUTY_ROUTING_KEY"); v != "" {
+		c.PagerDuty.RoutingKey = v
+	}
+	if v := os.Getenv("PAGERDUTY_SERVICE_ID"); v != "" {
+		c.PagerDuty.ServiceID = v
+	}
+	if v := os.Getenv("PAGERDUTY_WEBHOOK_SECRET"); v != "" {
+		c.PagerDuty.WebhookSecret = v
+	}
+	if v := os.Getenv("PAGERDUTY_FROM_EMAIL"); v != "" {
+		c.PagerDuty.FromEmail = v
+	}
+	if v := os.Getenv("PAGERDUTY_DEFAULT_SEVERITY"); v != "" {
+		c.PagerDuty.DefaultSeverity = v
+	}
+	if v := os.Getenv("PAGERDUTY_DASHBOARD_URL"); v != "" {
+		c.PagerDuty.DashboardURL = v
+	}
+	if v := os.Getenv("PAGERDUTY_SEND_QUEUE_ENABLED"); v != "" {
+		c.PagerDuty.SendQueue.Enabled = strings.ToLower(v) == "true"
+	}
+
+	// On-call load report
+	if v := os.Getenv("ONCALL_REPORT_SLACK_DM"); v != "" {
+		c.OnCallReport.SlackDM = strings.ToLower(v) == "true"
+	}
+
+	// Response time report
+	if v := os.Getenv("RESPONSE_TIME_REPORT_SLACK_CHANNEL"); v != "" {
+		c.ResponseTimeReport.SlackChannel = v
+	}
+
+	// Teams
+	if v := os.Getenv("TEAMS_ENABLED"); v != "" {
+		c.Teams.Enabled = strings.ToLower(v) == "true"
+	}
+	if v := os.Getenv("TEAMS_WEBHOOK_URL"); v != "" {
+		c.Teams.WebhookURL = v
+	}
+	if v := os.Getenv("TEAMS_PUBLIC_URL"); v != "" {
+		c.Teams.PublicURL = v
+	}
+	if v := os.Getenv("TEAMS_SIGNING_SECRET"); v != "" {
+		c.Teams.SigningSecret = v
+	}
+
+	// Incident management tools
+	if v := os.Getenv("FIREHYDRANT_ENABLED"); v != "" {
+		c.FireHydrant.Enabled = strings.ToLower(v) == "true"
+	}
+	if v := os.Getenv("FIREHYDRANT_API_TOKEN"); v != "" {
+		c.FireHydrant.APIToken = v
+	}
+	if v := os.Getenv("FIREHYDRANT_WEBHOOK_SECRET"); v != "" {
+		c.FireHydrant.WebhookSecret = v
+	}
+	if v := os.Getenv("INCIDENTIO_ENABLED"); v != "" {
+		c.IncidentIO.Enabled = strings.ToLower(v) == "true"
+	}
+	if v := os.Getenv("INCIDENTIO_API_TOKEN"); v != "" {
+		c.IncidentIO.APIToken = v
+	}
+	if v := os.Getenv("INCIDENTIO_WEBHOOK_SECRET"); v != "" {
+		c.IncidentIO.WebhookSecret = v
+	}
+
+	// Email
+	if v := os.Getenv("EMAIL_ENABLED"); v != "" {
+		c.Email.Enabled = strings.ToLower(v) == "true"
+	}
+	if v := os.Getenv("EMAIL_SMTP_HOST"); v != "" {
+		c.Email.SMTPHost = v
+	}
+	if v := os.Getenv("EMAIL_SMTP_USERNAME"); v != "" {
+		c.Email.Username = v
+	}
+	if v := os.Getenv("EMAIL_SMTP_PASSWORD"); v != "" {
+		c.Email.Password = v
+	}
+	if v := os.Getenv("EMAIL_FROM"); v != "" {
+		c.Email.From = v
+	}
+
+	// Logging
+	if v := os.Getenv("LOG_LEVEL"); v != "" {
+		c.Logging.Level = v
+	}
+	if v := os.Getenv("LOG_FORMAT"); v != "" {
+		c.Logging.Format = v
+	}
+
+	// Alert processing
+	if v := os.Getenv("ALERTING_SHARDING_ENABLED"); v != "" {
+		c.Alerting.Sharding.Enabled = strings.ToLower(v) == "true"
+	}
+	if v := os.Getenv("ALERTING_PARALLEL_ENABLED"); v != "" {
+		c.Alerting.Parallel.Enabled = strings.ToLower(v) == "true"
+	}
+
+	// Alertmanager
+	if v := os.Getenv("ALERTMANAGER_ASYNC_ENABLED"); v != "" {
+		c.Alertmanager.Async.Enabled = strings.ToLower(v) == "true"
+	}
+	if v := os.Getenv("ALERTMANAGER_WEBHOOK_SECRET"); v != "" {
+		c.Alertmanager.WebhookSecret = v
+	}
+	if v := os.Getenv("ALERTMANAGER_SILENCE_SYNC_ENABLED"); v != "" {
+		c.Alertmanager.SilenceSync.Enabled = strings.ToLower(v) == "true"
+	}
+	if v := os.Getenv("ALERTMANAGER_URL"); v != "" {
+		c.Alertmanager.SilenceSync.URL = v
+	}
+	if v := os.Getenv("ALERTMANAGER_PASSWORD"); v != "" {
+		c.Alertmanager.SilenceSync.Password = v
+	}
+
+	// Grafana
+	if v := os.Getenv("GRAFANA_WEBHOOK_TOKEN"); v != "" {
+		c.Grafana.WebhookToken = v
+	}
+
+	// CloudWatch
+	if v := os.Getenv("CLOUDWATCH_ENABLED"); v != "" {
+		c.CloudWatch.Enabled = strings.ToLower(v) == "true"
+	}
+
+	// Canary
+	if v := os.Getenv("CANARY_ENABLED"); v != "" {
+		c.Canary.Enabled = strings.ToLower(v) == "true"
+	}
+
+	// Payload log
+	if v := os.Getenv("PAYLOAD_LOG_ENABLED"); v != "" {
+		c.PayloadLog.Enabled = strings.ToLower(v) == "true"
+	}
+
+	// Delivery SLO
+	if v := os.Getenv("DELIVERY_SLO_ENABLED"); v != "" {
+		c.DeliverySLO.Enabled = strings.ToLower(v) == "true"
+	}
+
+	// Notification limits
+	if v := os.Getenv("NOTIFICATION_LIMITS_ENABLED"); v != "" {
+		c.NotificationLimits.Enabled = strings.ToLower(v) == "true"
+	}
+
+	// Batch ingestion
+	if v := os.Getenv("BATCH_INGEST_ENABLED"); v != "" {
+		c.BatchIngest.Enabled = strings.ToLower(v) == "true"
+	}
+	if v := os.Getenv("BATCH_INGEST_TOKEN"); v != "" {
+		c.BatchIngest.Token = v
+	}
+
+	// CloudEvents ingestion
+	if v := os.Getenv("CLOUDEVENTS_INGEST_ENABLED"); v != "" {
+		c.CloudEvents.Ingest.Enabled = strings.ToLower(v) == "true"
+	}
+	if v := os.Getenv("CLOUDEVENTS_INGEST_TOKEN"); v != "" {
+		c.CloudEvents.Ingest.Token = v
 	}
 
-	// Slack Socket Mode
-	if v := os.Getenv("SLACK_SOCKET_MODE_ENABLED"); v != "" {
-		c.Slack.SocketMode.Enabled = strings.ToLower(v) == "true"
+	// API authentication
+	if v := os.Getenv("API_AUTH_ENABLED"); v != "" {
+		c.APIAuth.Enabled = strings.ToLower(v) == "true"
 	}
-	if v := os.Getenv("SLACK_SOCKET_MODE_APP_TOKEN"); v != "" {
-		c.Slack.SocketMode.AppToken = v
+
+	// Tracing; the endpoint also honors the standard OpenTelemetry variable
+	if v := os.Getenv("TRACING_ENABLED"); v != "" {
+		c.Observability.Tracing.Enabled = strings.ToLower(v) == "true"
 	}
-	if v := os.Getenv("SLACK_SOCKET_MODE_DEBUG"); v != "" {
-		c.Slack.SocketMode.Debug = strings.ToLower(v) == "true"
+	if v := os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"); v != "" {
+		c.Observability.Tracing.Endpoint = v
 	}
-	if v := os.Getenv("SLACK_SOCKET_MODE_PING_INTERVAL"); v != "" {
-		if duration, err := time.ParseDuration(v); err == nil {
-			c.Slack.SocketMode.PingInterval = duration
-		}
+
+	// Retry queue
+	if v := os.Getenv("RETRY_QUEUE_ENABLED"); v != "" {
+		c.RetryQueue.Enabled = strings.ToLower(v) == "true"
 	}
 
-	// PagerDuty
-	if v := os.Getenv("PAGERDUTY_ENABLED"); v != "" {
-		c.PagerDuty.Enabled = strings.ToLower(v) == "true"
+	// Escalation
+	if v := os.Getenv("ESCALATION_ENABLED"); v != "" {
+		c.Escalation.Enabled = strings.ToLower(v) == "true"
 	}
-	if v := os.Getenv("PAGERDUTY_API_TOKEN"); v != "" {
-		c.PagerDuty.APIToken = v
+
+	// systemd notification
+	if v := os.Getenv("SYSTEMD_NOTIFY_ENABLED"); v != "" {
+		c.Server.Systemd.Enabled = strings.ToLower(v) == "true"
 	}
-	if v := os.Getenv("PAGERDUTY_ROUTING_KEY"); v != "" {
-		c.PagerDuty.RoutingKey = v
+
+	// Clock skew detection
+	if v := os.Getenv("CLOCK_SKEW_ENABLED"); v != "" {
+		c.ClockSkew.Enabled = strings.ToLower(v) == "true"
 	}
-	if v := os.Getenv("PAGERDUTY_SERVICE_ID"); v != "" {
-		c.PagerDuty.ServiceID = v
+
+	// Routing
+	if v := os.Getenv("ROUTING_ENABLED"); v != "" {
+		c.Routing.Enabled = strings.ToLower(v) == "true"
 	}
-	if v := os.Getenv("PAGERDUTY_WEBHOOK_SECRET"); v != "" {
-		c.PagerDuty.WebhookSecret = v
+
+	// Cloud metadata; AWS credentials use the standard variable names
+	if v := os.Getenv("CLOUD_METADATA_ENABLED"); v != "" {
+		c.CloudMetadata.Enabled = strings.ToLower(v) == "true"
 	}
-	if v := os.Getenv("PAGERDUTY_FROM_EMAIL"); v != "" {
-		c.PagerDuty.FromEmail = v
+	if v := os.Getenv("AWS_REGION"); v != "" && c.CloudMetadata.AWS.Region == "" {
+		c.CloudMetadata.AWS.Region = v
 	}
-	if v := os.Getenv("PAGERDUTY_DEFAULT_SEVERITY"); v != "" {
-		c.PagerDuty.DefaultSeverity = v
+	if v := os.Getenv("AWS_ACCESS_KEY_ID"); v != "" && c.CloudMetadata.AWS.AccessKeyID == "" {
+		c.CloudMetadata.AWS.AccessKeyID = v
 	}
-
-	// Logging
-	if v := os.Getenv("LOG_LEVEL"); v != "" {
-		c.Logging.Level = v
+	if v := os.Getenv("AWS_SECRET_ACCESS_KEY"); v != "" && c.CloudMetadata.AWS.SecretAccessKey == "" {
+		c.CloudMetadata.AWS.SecretAccessKey = v
 	}
-	if v := os.Getenv("LOG_FORMAT"); v != "" {
-		c.Logging.Format = v
+	if v := os.Getenv("AWS_SESSION_TOKEN"); v != "" && c.CloudMetadata.AWS.SessionToken == "" {
+		c.CloudMetadata.AWS.SessionToken = v
+	}
+	if v := os.Getenv("GOOGLE_CLOUD_PROJECT"); v != "" && c.CloudMetadata.GCP.Project == "" {
+		c.CloudMetadata.GCP.Project = v
 	}
 
-	// Alertmanager
-	if v := os.Getenv("ALERTMANAGER_WEBHOOK_SECRET"); v != "" {
-		c.Alertmanager.WebhookSecret = v
+	// Sentry
+	if v := os.Getenv("SENTRY_ENABLED"); v != "" {
+		c.Sentry.Enabled = strings.ToLower(v) == "true"
+	}
+	if v := os.Getenv("SENTRY_CLIENT_SECRET"); v != "" {
+		c.Sentry.ClientSecret = v
 	}
 
 	// Storage
@@ -277,6 +1927,49 @@ func (c *Config) overrideFromEnv() {
 	if v := os.Getenv("SQLITE_DATABASE_PATH"); v != "" {
 		c.Storage.SQLite.Path = v
 	}
+	if v := os.Getenv("STORAGE_ENCRYPTION_KEY"); v != "" {
+		c.Storage.Encryption.Key = v
+	}
+	if v := os.Getenv("STORAGE_DEGRADATION_ENABLED"); v != "" {
+		c.Storage.Degradation.Enabled = strings.ToLower(v) == "true"
+	}
+	if v := os.Getenv("STORAGE_FIRING_CACHE_ENABLED"); v != "" {
+		c.Storage.FiringCache.Enabled = strings.ToLower(v) == "true"
+	}
+	if v := os.Getenv("STORAGE_RETENTION_ENABLED"); v != "" {
+		c.Storage.Retention.Enabled = strings.ToLower(v) == "true"
+	}
+	if v := os.Getenv("STORAGE_ARCHIVE_ENABLED"); v != "" {
+		c.Storage.Retention.Archive.Enabled = strings.ToLower(v) == "true"
+	}
+	if v := os.Getenv("STORAGE_ARCHIVE_ACCESS_KEY_ID"); v != "" {
+		c.Storage.Retention.Archive.AccessKeyID = v
+	}
+	if v := os.Getenv("STORAGE_ARCHIVE_SECRET_ACCESS_KEY"); v != "" {
+		c.Storage.Retention.Archive.SecretAccessKey = v
+	}
+	if v := os.Getenv("STORAGE_ARCHIVE_SESSION_TOKEN"); v != "" {
+		c.Storage.Retention.Archive.SessionToken = v
+	}
+	if v := os.Getenv("SQLITE_READ_POOL_SIZE"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			c.Storage.SQLite.ReadPoolSize = n
+		}
+	}
+
+	// Redis
+	if v := os.Getenv("REDIS_ADDR"); v != "" {
+		c.Storage.Redis.Addr = v
+	}
+	if v := os.Getenv("REDIS_USERNAME"); v != "" {
+		c.Storage.Redis.Username = v
+	}
+	if v := os.Getenv("REDIS_PASSWORD"); v != "" {
+		c.Storage.Redis.Password = v
+	}
+	if v := os.Getenv("REDIS_TLS_ENABLED"); v != "" {
+		c.Storage.Redis.TLS.Enabled = strings.ToLower(v) == "true"
+	}
 
 	// MySQL
 	if v := os.Getenv("MYSQL_HOST"); v != "" {
@@ -338,6 +2031,11 @@ func (c *Config) overrideFromEnv() {
 	if v := os.Getenv("MYSQL_REPLICA_PASSWORD"); v != "" {
 		c.Storage.MySQL.Replica.Password = v
 	}
+	if v := os.Getenv("MYSQL_REPLICA_MAX_LAG"); v != "" {
+		if duration, err := time.ParseDuration(v); err == nil {
+			c.Storage.MySQL.Replica.MaxLag = duration
+		}
+	}
 }
 
 // applyDefaults sets default values for unset config options.
@@ -358,6 +2056,12 @@ func (c *Config) applyDefaults() {
 	if c.Server.ShutdownTimeout == 0 {
 		c.Server.ShutdownTimeout = 30 * time.Second
 	}
+	if c.Server.DrainTimeout == 0 {
+		c.Server.DrainTimeout = 15 * time.Second
+	}
+	if c.Server.MaxDecompressedBodyBytes == 0 {
+		c.Server.MaxDecompressedBodyBytes = 10 << 20
+	}
 
 	// Alerting defaults
 	if c.Alerting.DeduplicationWindow == 0 {
@@ -366,6 +2070,21 @@ func (c *Config) applyDefaults() {
 	if c.Alerting.ResendInterval == 0 {
 		c.Alerting.ResendInterval = 30 * time.Minute
 	}
+	if c.Alerting.Resend.Action == "" {
+		c.Alerting.Resend.Action = "reply"
+	}
+	if c.Alerting.SilenceReminder.Before == 0 {
+		c.Alerting.SilenceReminder.Before = 15 * time.Minute
+	}
+	if c.Alerting.Sharding.Shards == 0 {
+		c.Alerting.Sharding.Shards = 8
+	}
+	if c.Alerting.Sharding.QueueSize == 0 {
+		c.Alerting.Sharding.QueueSize = 1000
+	}
+	if c.Alerting.Parallel.Concurrency == 0 {
+		c.Alerting.Parallel.Concurrency = 16
+	}
 	if len(c.Alerting.SilenceDurations) == 0 {
 		c.Alerting.SilenceDurations = []time.Duration{
 			15 * time.Minute,
@@ -375,15 +2094,281 @@ func (c *Config) applyDefaults() {
 		}
 	}
 
-	// Slack Socket Mode defaults
+	// Slack thread reply defaults; an explicit empty list turns them off
+	if c.Slack.ThreadReplies == nil {
+		c.Slack.ThreadReplies = []string{"silenced", "note_added"}
+	}
+
+	// Slack Socket Mode defaults; the mode decides whether it is used
+	if c.Slack.Mode == "" {
+		c.Slack.Mode = SlackModeEvents
+		if c.Slack.SocketMode.Enabled {
+			c.Slack.Mode = SlackModeSocket
+		}
+	}
+	c.Slack.SocketMode.Enabled = c.Slack.Mode == SlackModeSocket
 	if c.Slack.SocketMode.PingInterval == 0 {
 		c.Slack.SocketMode.PingInterval = 30 * time.Second
 	}
+	if c.Slack.RateLimit.PerSecond == 0 {
+		c.Slack.RateLimit.PerSecond = 1
+	}
+	if c.Slack.RateLimit.Burst == 0 {
+		c.Slack.RateLimit.Burst = 3
+	}
+	if c.Slack.AsyncInteractions.Workers == 0 {
+		c.Slack.AsyncInteractions.Workers = 4
+	}
+	if c.Slack.AsyncInteractions.QueueSize == 0 {
+		c.Slack.AsyncInteractions.QueueSize = 100
+	}
 
 	// PagerDuty defaults
 	if c.PagerDuty.DefaultSeverity == "" {
 		c.PagerDuty.DefaultSeverity = "warning"
 	}
+	if c.PagerDuty.SendQueue.Backoff == 0 {
+		c.PagerDuty.SendQueue.Backoff = 30 * time.Second
+	}
+	if c.PagerDuty.SendQueue.MaxAge == 0 {
+		c.PagerDuty.SendQueue.MaxAge = 10 * time.Minute
+	}
+	if c.PagerDuty.SendQueue.ShedBelow == "" {
+		c.PagerDuty.SendQueue.ShedBelow = "critical"
+	}
+
+	// Generic webhook defaults
+	for i := range c.GenericWebhooks {
+		if len(c.GenericWebhooks[i].Mapping.ResolvedValues) == 0 {
+			c.GenericWebhooks[i].Mapping.ResolvedValues = []string{"resolved", "ok", "up", "recovered"}
+		}
+	}
+
+	// Canary defaults
+	if c.Canary.Label == "" {
+		c.Canary.Label = "canary"
+	}
+	if len(c.Canary.SilenceDurations) == 0 {
+		c.Canary.SilenceDurations = c.Alerting.SilenceDurations
+	}
+
+	// Payload log defaults
+	if c.PayloadLog.Size == 0 {
+		c.PayloadLog.Size = 50
+	}
+
+	// Delivery SLO defaults
+	if c.DeliverySLO.Window == 0 {
+		c.DeliverySLO.Window = time.Hour
+	}
+	if c.DeliverySLO.MinSamples == 0 {
+		c.DeliverySLO.MinSamples = 10
+	}
+
+	// Notification limit defaults
+	if c.NotificationLimits.SummaryInterval == 0 {
+		c.NotificationLimits.SummaryInterval = time.Minute
+	}
+	for name, limit := range c.NotificationLimits.Notifiers {
+		if limit.PerSecond > 0 && limit.Burst == 0 {
+			limit.Burst = 10
+		}
+		if limit.Budget > 0 && limit.Window == 0 {
+			limit.Window = time.Minute
+		}
+		c.NotificationLimits.Notifiers[name] = limit
+	}
+
+	// Batch ingestion defaults
+	if c.BatchIngest.MaxLineBytes == 0 {
+		c.BatchIngest.MaxLineBytes = 1 << 20
+	}
+	if c.BatchIngest.MaxItems == 0 {
+		c.BatchIngest.MaxItems = 10000
+	}
+
+	// CloudEvents defaults
+	if c.CloudEvents.Source == "" {
+		c.CloudEvents.Source = "alert-bridge"
+	}
+	if c.CloudEvents.Ingest.MaxEvents == 0 {
+		c.CloudEvents.Ingest.MaxEvents = 1000
+	}
+	for i := range c.CloudEvents.Sinks {
+		if c.CloudEvents.Sinks[i].Mode == "" {
+			c.CloudEvents.Sinks[i].Mode = "binary"
+		}
+	}
+
+	// Tracing defaults
+	if c.Observability.Tracing.SampleRatio == 0 {
+		c.Observability.Tracing.SampleRatio = 1
+	}
+	if c.Observability.Tracing.Timeout == 0 {
+		c.Observability.Tracing.Timeout = 10 * time.Second
+	}
+
+	// Retry queue defaults
+	if c.RetryQueue.Workers == 0 {
+		c.RetryQueue.Workers = 2
+	}
+	if c.RetryQueue.PollInterval == 0 {
+		c.RetryQueue.PollInterval = 10 * time.Second
+	}
+	if c.RetryQueue.MaxAttempts == 0 {
+		c.RetryQueue.MaxAttempts = 10
+	}
+	if c.RetryQueue.InitialBackoff == 0 {
+		c.RetryQueue.InitialBackoff = 30 * time.Second
+	}
+	if c.RetryQueue.MaxBackoff == 0 {
+		c.RetryQueue.MaxBackoff = 30 * time.Minute
+	}
+
+	// Escalation defaults
+	if c.Escalation.Interval == 0 {
+		c.Escalation.Interval = time.Minute
+	}
+
+	// Clock skew defaults
+	if c.Alertmanager.SilenceSync.Timeout == 0 {
+		c.Alertmanager.SilenceSync.Timeout = 10 * time.Second
+	}
+	if c.Alertmanager.Async.Workers == 0 {
+		c.Alertmanager.Async.Workers = 4
+	}
+	if c.Alertmanager.Async.QueueSize == 0 {
+		c.Alertmanager.Async.QueueSize = 100
+	}
+
+	if c.ClockSkew.URL == "" {
+		c.ClockSkew.URL = "https://slack.com"
+	}
+	if c.ClockSkew.Interval == 0 {
+		c.ClockSkew.Interval = 10 * time.Minute
+	}
+	if c.ClockSkew.WarnThreshold == 0 {
+		c.ClockSkew.WarnThreshold = 30 * time.Second
+	}
+	if c.ClockSkew.MaxTolerance == 0 {
+		c.ClockSkew.MaxTolerance = time.Hour
+	}
+
+	// Outbound HTTP defaults
+	if c.OutboundHTTP.MaxIdleConns == 0 {
+		c.OutboundHTTP.MaxIdleConns = 100
+	}
+	if c.OutboundHTTP.MaxIdleConnsPerHost == 0 {
+		c.OutboundHTTP.MaxIdleConnsPerHost = 20
+	}
+	if c.OutboundHTTP.IdleConnTimeout == 0 {
+		c.OutboundHTTP.IdleConnTimeout = 90 * time.Second
+	}
+
+	// User lookup defaults
+	if c.Users.Lookup.CacheTTL == 0 {
+		c.Users.Lookup.CacheTTL = time.Hour
+	}
+
+	// Cloud metadata defaults
+	if c.CloudMetadata.Label == "" {
+		c.CloudMetadata.Label = "instance"
+	}
+	if c.CloudMetadata.LabelPrefix == "" {
+		c.CloudMetadata.LabelPrefix = "cloud_"
+	}
+	if c.CloudMetadata.CacheTTL == 0 {
+		c.CloudMetadata.CacheTTL = time.Hour
+	}
+	if c.CloudMetadata.NegativeCacheTTL == 0 {
+		c.CloudMetadata.NegativeCacheTTL = 5 * time.Minute
+	}
+	if c.CloudMetadata.Timeout == 0 {
+		c.CloudMetadata.Timeout = 5 * time.Second
+	}
+
+	// Enrichment webhook defaults
+	for i := range c.Enrichment.Webhooks {
+		wh := &c.Enrichment.Webhooks[i]
+		if wh.Timeout == 0 {
+			wh.Timeout = 2 * time.Second
+		}
+		if wh.MaxFailures == 0 {
+			wh.MaxFailures = 5
+		}
+		if wh.Cooldown == 0 {
+			wh.Cooldown = time.Minute
+		}
+	}
+
+	// Teams defaults
+	if c.Teams.ActionLinkTTL == 0 {
+		c.Teams.ActionLinkTTL = 7 * 24 * time.Hour
+	}
+
+	// Incident management tool defaults
+	if c.FireHydrant.APIURL == "" {
+		c.FireHydrant.APIURL = "https://api.firehydrant.io"
+	}
+	if c.IncidentIO.APIURL == "" {
+		c.IncidentIO.APIURL = "https://api.incident.io"
+	}
+	for _, tool := range []*IncidentToolConfig{&c.FireHydrant, &c.IncidentIO} {
+		if tool.Events == nil {
+			tool.Events = []string{"acknowledged", "unacknowledged", "resolved", "severity_changed", "note_added", "silenced", "assigned", "escalated"}
+		}
+	}
+
+	// Email defaults
+	if c.Email.SMTPPort == 0 {
+		c.Email.SMTPPort = 587
+	}
+	if c.Email.Timeout == 0 {
+		c.Email.Timeout = 30 * time.Second
+	}
+
+	// CloudWatch defaults
+	if c.CloudWatch.Severity == "" {
+		c.CloudWatch.Severity = "warning"
+	}
+
+	// Report defaults
+	for i := range c.Reports {
+		if c.Reports[i].Period == 0 {
+			c.Reports[i].Period = 7 * 24 * time.Hour
+		}
+		if c.Reports[i].Timezone == "" {
+			c.Reports[i].Timezone = "UTC"
+		}
+	}
+
+	// On-call load report defaults
+	if c.OnCallReport.TeamLabel == "" {
+		c.OnCallReport.TeamLabel = "team"
+	}
+	if c.OnCallReport.Timezone == "" {
+		c.OnCallReport.Timezone = "UTC"
+	}
+	if c.OnCallReport.WorkingHours == "" {
+		c.OnCallReport.WorkingHours = "09:00-18:00"
+	}
+	if c.OnCallReport.DMSchedule == "" {
+		c.OnCallReport.DMSchedule = "0 9 1 * *"
+	}
+
+	// Response time report defaults
+	if c.ResponseTimeReport.TeamLabel == "" {
+		c.ResponseTimeReport.TeamLabel = "team"
+	}
+	if c.ResponseTimeReport.Schedule == "" {
+		c.ResponseTimeReport.Schedule = "0 9 * * 1"
+	}
+	if c.ResponseTimeReport.Timezone == "" {
+		c.ResponseTimeReport.Timezone = "UTC"
+	}
+	if c.ResponseTimeReport.Period == 0 {
+		c.ResponseTimeReport.Period = 7 * 24 * time.Hour
+	}
 
 	// Logging defaults
 	if c.Logging.Level == "" {
@@ -400,6 +2385,56 @@ func (c *Config) applyDefaults() {
 	if c.Storage.SQLite.Path == "" {
 		c.Storage.SQLite.Path = "./data/alert-bridge.db"
 	}
+	if len(c.Storage.Degradation.Order) == 0 {
+		c.Storage.Degradation.Order = []string{"retry", "buffer"}
+	}
+	if c.Storage.Degradation.RetryBackoff == 0 {
+		c.Storage.Degradation.RetryBackoff = 500 * time.Millisecond
+	}
+	if c.Storage.Degradation.MaxBuffered == 0 {
+		c.Storage.Degradation.MaxBuffered = 10000
+	}
+	if c.Storage.Degradation.FlushInterval == 0 {
+		c.Storage.Degradation.FlushInterval = 15 * time.Second
+	}
+	if c.Storage.FiringCache.TTL == 0 {
+		c.Storage.FiringCache.TTL = 30 * time.Second
+	}
+	if c.Storage.Retention.Interval == 0 {
+		c.Storage.Retention.Interval = time.Hour
+	}
+	if c.Storage.Retention.ResolvedAlerts == 0 {
+		c.Storage.Retention.ResolvedAlerts = 30 * 24 * time.Hour
+	}
+	if c.Storage.Retention.AckEvents == 0 {
+		c.Storage.Retention.AckEvents = 90 * 24 * time.Hour
+	}
+	if c.Storage.Retention.ExpiredSilences == 0 {
+		c.Storage.Retention.ExpiredSilences = 7 * 24 * time.Hour
+	}
+	if c.Storage.Retention.Archive.Region == "" {
+		c.Storage.Retention.Archive.Region = "us-east-1"
+	}
+	if c.Storage.Retention.Archive.Endpoint == "" {
+		c.Storage.Retention.Archive.Endpoint = "https://s3." + c.Storage.Retention.Archive.Region + ".amazonaws.com"
+	}
+	if c.Storage.Retention.Archive.Timeout == 0 {
+		c.Storage.Retention.Archive.Timeout = 60 * time.Second
+	}
+
+	// Redis defaults
+	if c.Storage.Redis.KeyPrefix == "" {
+		c.Storage.Redis.KeyPrefix = "alert-bridge:"
+	}
+	if c.Storage.Redis.ResolvedTTL == 0 {
+		c.Storage.Redis.ResolvedTTL = 7 * 24 * time.Hour
+	}
+	if c.Storage.Redis.PoolSize == 0 {
+		c.Storage.Redis.PoolSize = 10
+	}
+	if c.Storage.Redis.DialTimeout == 0 {
+		c.Storage.Redis.DialTimeout = 5 * time.Second
+	}
 
 	// MySQL defaults (from research.md)
 	if c.Storage.MySQL.Pool.MaxOpenConns == 0 {
@@ -429,6 +2464,12 @@ func (c *Config) applyDefaults() {
 	if c.Storage.MySQL.Replica.Port == 0 {
 		c.Storage.MySQL.Replica.Port = 3306
 	}
+	if c.Storage.MySQL.Replica.MaxLag == 0 {
+		c.Storage.MySQL.Replica.MaxLag = 30 * time.Second
+	}
+	if c.Storage.MySQL.Replica.HealthCheckInterval == 0 {
+		c.Storage.MySQL.Replica.HealthCheckInterval = 10 * time.Second
+	}
 }
 
 // validate checks that required configuration is present.
@@ -443,11 +2484,163 @@ func (c *Config) IsSlackEnabled() bool {
 	return c.Slack.Enabled
 }
 
+// IsSlackSocketMode returns true if Slack reaches alert-bridge over Socket
+// Mode instead of the public webhook endpoints.
+func (c *Config) IsSlackSocketMode() bool {
+	return c.Slack.Enabled && c.Slack.Mode == SlackModeSocket
+}
+
+// IsSlackRateLimitEnabled returns true if Slack posts are paced per channel.
+func (c *Config) IsSlackRateLimitEnabled() bool {
+	return c.IsSlackEnabled() && c.Slack.RateLimit.Enabled
+}
+
 // IsPagerDutyEnabled returns true if PagerDuty integration is enabled.
 func (c *Config) IsPagerDutyEnabled() bool {
 	return c.PagerDuty.Enabled
 }
 
+// IsPagerDutySendQueueEnabled returns true if throttled PagerDuty events
+// are queued.
+func (c *Config) IsPagerDutySendQueueEnabled() bool {
+	return c.IsPagerDutyEnabled() && c.PagerDuty.SendQueue.Enabled
+}
+
+// IsEmailEnabled returns true if email notifications are enabled.
+func (c *Config) IsEmailEnabled() bool {
+	return c.Email.Enabled
+}
+
+// IsCloudWatchEnabled returns true if CloudWatch alarm ingestion is enabled.
+func (c *Config) IsCloudWatchEnabled() bool {
+	return c.CloudWatch.Enabled
+}
+
+// IsPayloadLogEnabled returns true if outbound payloads are recorded.
+func (c *Config) IsPayloadLogEnabled() bool {
+	return c.PayloadLog.Enabled
+}
+
+// IsStorageDegradationEnabled returns true if writes failing because a
+// persistent backend is unavailable are retried or buffered. In-memory
+// storage cannot fail this way.
+func (c *Config) IsStorageDegradationEnabled() bool {
+	return c.Storage.Degradation.Enabled && c.Storage.Type != "memory"
+}
+
+// IsFiringCacheEnabled returns true if duplicates of firing alerts are
+// recognized from memory. In-memory storage needs no cache.
+func (c *Config) IsFiringCacheEnabled() bool {
+	return c.Storage.FiringCache.Enabled && c.Storage.Type != "memory" && c.Storage.Type != ""
+}
+
+// IsNotificationLimitsEnabled returns true if new alerts are limited per
+// notifier.
+func (c *Config) IsNotificationLimitsEnabled() bool {
+	return c.NotificationLimits.Enabled && len(c.NotificationLimits.Notifiers) > 0
+}
+
+// IsDeliverySLOEnabled returns true if delivery objectives are tracked.
+func (c *Config) IsDeliverySLOEnabled() bool {
+	return c.DeliverySLO.Enabled
+}
+
+// IsBatchIngestEnabled returns true if the NDJSON batch endpoint is enabled.
+func (c *Config) IsBatchIngestEnabled() bool {
+	return c.BatchIngest.Enabled
+}
+
+// IsCloudEventsIngestEnabled returns true if the CloudEvents endpoint is
+// enabled.
+func (c *Config) IsCloudEventsIngestEnabled() bool {
+	return c.CloudEvents.Ingest.Enabled
+}
+
+// IsCloudEventsEmitEnabled returns true if any CloudEvents sink is
+// configured.
+func (c *Config) IsCloudEventsEmitEnabled() bool {
+	return len(c.CloudEvents.Sinks) > 0
+}
+
+// IsAPIAuthEnabled returns true if API and admin endpoints require a token.
+func (c *Config) IsAPIAuthEnabled() bool {
+	return c.APIAuth.Enabled
+}
+
+// IsTracingEnabled returns true if spans are exported to an OTLP collector.
+func (c *Config) IsTracingEnabled() bool {
+	return c.Observability.Tracing.Enabled
+}
+
+// IsRetryQueueEnabled returns true if failed notifications are queued for
+// retry.
+func (c *Config) IsRetryQueueEnabled() bool {
+	return c.RetryQueue.Enabled
+}
+
+// IsEscalationEnabled returns true if unacknowledged alerts are escalated.
+func (c *Config) IsEscalationEnabled() bool {
+	return c.Escalation.Enabled
+}
+
+// IsRoutingEnabled returns true if notifiers are chosen by the routing tree.
+func (c *Config) IsRoutingEnabled() bool {
+	return c.Routing.Enabled
+}
+
+// IsAlertmanagerSilenceSyncEnabled returns true if Slack silences are
+// created in Alertmanager as well.
+func (c *Config) IsAlertmanagerSilenceSyncEnabled() bool {
+	return c.Alertmanager.SilenceSync.Enabled
+}
+
+// IsClockSkewEnabled returns true if the host clock is checked for drift.
+func (c *Config) IsClockSkewEnabled() bool {
+	return c.ClockSkew.Enabled
+}
+
+// IsUserDirectoryEnabled returns true if users are mapped between Slack,
+// PagerDuty and email.
+func (c *Config) IsUserDirectoryEnabled() bool {
+	return len(c.Users.Directory) > 0 || c.Users.Lookup.Slack || c.Users.Lookup.PagerDuty
+}
+
+// IsCloudMetadataEnabled returns true if alerts are enriched with cloud
+// instance metadata.
+func (c *Config) IsCloudMetadataEnabled() bool {
+	return c.CloudMetadata.Enabled
+}
+
+// IsEnrichmentEnabled returns true if enrichment webhooks are configured.
+func (c *Config) IsEnrichmentEnabled() bool {
+	return len(c.Enrichment.Webhooks) > 0
+}
+
+// IsCanaryEnabled returns true if the canary shadow channel is enabled.
+func (c *Config) IsCanaryEnabled() bool {
+	return c.Canary.Enabled
+}
+
+// IsSentryEnabled returns true if Sentry webhook ingestion is enabled.
+func (c *Config) IsSentryEnabled() bool {
+	return c.Sentry.Enabled
+}
+
+// IsTeamsEnabled returns true if Microsoft Teams integration is enabled.
+func (c *Config) IsTeamsEnabled() bool {
+	return c.Teams.Enabled
+}
+
+// IsFireHydrantEnabled returns true if FireHydrant integration is enabled.
+func (c *Config) IsFireHydrantEnabled() bool {
+	return c.FireHydrant.Enabled
+}
+
+// IsIncidentIOEnabled returns true if incident.io integration is enabled.
+func (c *Config) IsIncidentIOEnabled() bool {
+	return c.IncidentIO.Enabled
+}
+
 // IsEnabled returns true if the subscriber is enabled.
 // Defaults to true if Enabled is not explicitly set.
 func (s *SubscriberConfig) IsEnabled() bool {
@@ -457,6 +2650,66 @@ func (s *SubscriberConfig) IsEnabled() bool {
 	return *s.Enabled
 }
 
+// Secrets lists the configured credentials, which must never appear in logs
+// or recorded payloads. Unset values are included as empty strings.
+func (c *Config) Secrets() []string {
+	secrets := []string{
+		c.Slack.BotToken,
+		c.Slack.SigningSecret,
+		c.Slack.SocketMode.AppToken,
+		c.PagerDuty.APIToken,
+		c.PagerDuty.RoutingKey,
+		c.PagerDuty.WebhookSecret,
+		c.Teams.WebhookURL,
+		c.Teams.SigningSecret,
+		c.Email.Password,
+		c.Alertmanager.WebhookSecret,
+		c.Alertmanager.SilenceSync.Password,
+		c.Grafana.WebhookToken,
+		c.Sentry.ClientSecret,
+		c.FireHydrant.APIToken,
+		c.FireHydrant.WebhookSecret,
+		c.IncidentIO.APIToken,
+		c.IncidentIO.WebhookSecret,
+		c.BatchIngest.Token,
+		c.CloudEvents.Ingest.Token,
+		c.Storage.MySQL.Primary.Password,
+		c.Storage.MySQL.Replica.Password,
+		c.Storage.Redis.Password,
+		c.Storage.Encryption.Key,
+		c.Storage.Retention.Archive.SecretAccessKey,
+		c.Storage.Retention.Archive.SessionToken,
+		c.CloudMetadata.AWS.SecretAccessKey,
+		c.CloudMetadata.AWS.SessionToken,
+	}
+	for _, sub := range c.Subscribers {
+		secrets = append(secrets, sub.PagerDutyRoutingKey)
+	}
+	for _, t := range c.Escalation.Targets {
+		secrets = append(secrets, t.PagerDutyRoutingKey)
+	}
+	for _, wh := range c.GenericWebhooks {
+		secrets = append(secrets, wh.Token)
+	}
+	for _, t := range c.APIAuth.Tokens {
+		secrets = append(secrets, t.Token)
+	}
+	for _, v := range c.Observability.Tracing.Headers {
+		secrets = append(secrets, v)
+	}
+	for _, sink := range c.CloudEvents.Sinks {
+		for _, v := range sink.Headers {
+			secrets = append(secrets, v)
+		}
+	}
+	for _, wh := range c.Enrichment.Webhooks {
+		for _, v := range wh.Headers {
+			secrets = append(secrets, v)
+		}
+	}
+	return append(secrets, c.Logging.Redact...)
+}
+
 // GetEnabledSubscribers returns all enabled subscribers.
 func (c *Config) GetEnabledSubscribers() []SubscriberConfig {
 	var enabled []SubscriberConfig
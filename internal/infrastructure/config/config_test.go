@@ -0,0 +1,72 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad_SlackMode(t *testing.T) {
+	tests := []struct {
+		name       string
+		slack      string
+		wantMode   string
+		wantSocket bool
+		wantErr    string
+	}{
+		{
+			name:     "default",
+			slack:    "signing_secret: secret",
+			wantMode: SlackModeEvents,
+		},
+		{
+			name:       "socket",
+			slack:      "mode: socket\n  socket_mode:\n    app_token: xapp-1",
+			wantMode:   SlackModeSocket,
+			wantSocket: true,
+		},
+		{
+			name:       "legacy socket_mode.enabled",
+			slack:      "socket_mode:\n    enabled: true\n    app_token: xapp-1",
+			wantMode:   SlackModeSocket,
+			wantSocket: true,
+		},
+		{
+			name:     "events wins over socket_mode.enabled",
+			slack:    "mode: events\n  signing_secret: secret\n  socket_mode:\n    enabled: true",
+			wantMode: SlackModeEvents,
+		},
+		{
+			name:    "socket requires app token",
+			slack:   "mode: socket",
+			wantErr: "slack.socket_mode.app_token",
+		},
+		{
+			name:    "unknown mode",
+			slack:   "mode: rtm\n  signing_secret: secret",
+			wantErr: "slack.mode must be events or socket",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "config.yaml")
+			data := "slack:\n  enabled: true\n  bot_token: xoxb-1\n  channel_id: C1\n  " + tt.slack + "\n"
+			require.NoError(t, os.WriteFile(path, []byte(data), 0o644))
+
+			cfg, err := Load(path)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantMode, cfg.Slack.Mode)
+			assert.Equal(t, tt.wantSocket, cfg.IsSlackSocketMode())
+			assert.Equal(t, tt.wantSocket, cfg.Slack.SocketMode.Enabled)
+		})
+	}
+}
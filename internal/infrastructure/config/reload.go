@@ -152,9 +152,41 @@ func extractConfigDiff(oldCfg, newCfg *Config) ConfigDiff {
 		diff.NewValues["alerting.resend_interval"] = newCfg.Alerting.ResendInterval.String()
 	}
 
+	if !reflect.DeepEqual(oldCfg.SeverityMapping, newCfg.SeverityMapping) {
+		diff.ChangedKeys = append(diff.ChangedKeys, "severity_mapping")
+		diff.OldValues["severity_mapping"] = oldCfg.SeverityMapping
+		diff.NewValues["severity_mapping"] = newCfg.SeverityMapping
+	}
+
+	if oldCfg.Templates != newCfg.Templates {
+		diff.ChangedKeys = append(diff.ChangedKeys, "templates")
+		diff.OldValues["templates"] = oldCfg.Templates
+		diff.NewValues["templates"] = newCfg.Templates
+	}
+
+	// Token values are never logged, only which clients exist
+	if oldCfg.APIAuth.Enabled != newCfg.APIAuth.Enabled || !reflect.DeepEqual(oldCfg.APIAuth.Tokens, newCfg.APIAuth.Tokens) {
+		diff.ChangedKeys = append(diff.ChangedKeys, "api_auth")
+		diff.OldValues["api_auth"] = apiAuthSummary(oldCfg.APIAuth)
+		diff.NewValues["api_auth"] = apiAuthSummary(newCfg.APIAuth)
+	}
+
 	return diff
 }
 
+// apiAuthSummary describes API auth settings for reload logs without
+// exposing tokens.
+func apiAuthSummary(cfg APIAuthConfig) map[string]interface{} {
+	clients := make(map[string][]string, len(cfg.Tokens))
+	for _, tok := range cfg.Tokens {
+		clients[tok.Name] = tok.Scopes
+	}
+	return map[string]interface{}{
+		"enabled": cfg.Enabled,
+		"clients": clients,
+	}
+}
+
 // detectStaticChanges checks if any static (restart-required) config has changed.
 func detectStaticChanges(oldCfg, newCfg *Config) []string {
 	changes := make([]string, 0)
@@ -179,6 +211,26 @@ func detectStaticChanges(oldCfg, newCfg *Config) []string {
 		changes = append(changes, "storage.mysql")
 	}
 
+	// Redis config (static)
+	if oldCfg.Storage.Redis != newCfg.Storage.Redis {
+		changes = append(changes, "storage.redis")
+	}
+
+	// Storage degradation (static)
+	if !reflect.DeepEqual(oldCfg.Storage.Degradation, newCfg.Storage.Degradation) {
+		changes = append(changes, "storage.degradation")
+	}
+
+	// Pipeline stages (static)
+	if !reflect.DeepEqual(oldCfg.Alerting.Pipeline, newCfg.Alerting.Pipeline) {
+		changes = append(changes, "alerting.pipeline")
+	}
+
+	// Firing cache (static)
+	if oldCfg.Storage.FiringCache != newCfg.Storage.FiringCache {
+		changes = append(changes, "storage.firing_cache")
+	}
+
 	return changes
 }
 
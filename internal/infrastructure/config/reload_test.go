@@ -1,9 +1,11 @@
 package config
 
 import (
+	"fmt"
 	"log/slog"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -217,7 +219,175 @@ alerting:
 	}
 }
 
+// TestAPITokensHotReload tests that API tokens and scopes can be rotated
+// without a restart, and that the diff never carries token values.
+func TestAPITokensHotReload(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+
+	initialConfig := `
+logging:
+  level: info
+  format: json
+api_auth:
+  enabled: true
+  tokens:
+    - name: deploy
+      token: deploy-token-0000000001
+      scopes: [silence]
+`
+	if err := os.WriteFile(configPath, []byte(initialConfig), 0644); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+
+	v := viper.New()
+	v.SetConfigFile(configPath)
+	if err := v.ReadInConfig(); err != nil {
+		t.Fatalf("failed to read config: %v", err)
+	}
+
+	cfg, err := Load(configPath)
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+	cm := NewConfigManager(cfg, v, configPath, logger)
+
+	// Rotate the deploy token and add a read-only client
+	updatedConfig := `
+logging:
+  level: info
+  format: json
+api_auth:
+  enabled: true
+  tokens:
+    - name: deploy
+      token: deploy-token-0000000002
+      scopes: [silence, read]
+    - name: dashboard
+      token: dashboard-token-00000001
+      scopes: [read]
+`
+	if err := os.WriteFile(configPath, []byte(updatedConfig), 0644); err != nil {
+		t.Fatalf("failed to update config file: %v", err)
+	}
+
+	oldCfg := cm.Get()
+	if err := cm.TryReload(); err != nil {
+		t.Fatalf("reload failed: %v", err)
+	}
+
+	tokens := cm.Get().APIAuth.Tokens
+	if len(tokens) != 2 {
+		t.Fatalf("expected 2 tokens after reload, got %d", len(tokens))
+	}
+	if tokens[0].Token != "deploy-token-0000000002" {
+		t.Errorf("expected rotated deploy token, got '%s'", tokens[0].Token)
+	}
+
+	diff := extractConfigDiff(oldCfg, cm.Get())
+	if len(diff.ChangedKeys) != 1 || diff.ChangedKeys[0] != "api_auth" {
+		t.Fatalf("expected api_auth change, got %v", diff.ChangedKeys)
+	}
+	for _, values := range []map[string]interface{}{diff.OldValues, diff.NewValues} {
+		summary := fmt.Sprint(values["api_auth"])
+		if strings.Contains(summary, "token-") {
+			t.Errorf("diff must not contain token values: %s", summary)
+		}
+	}
+}
+
 // TestInvalidYAMLHandling tests that invalid YAML preserves existing config.
+// TestSeverityMappingHotReload tests that severity_mapping can be
+// hot-reloaded and that invalid mappings are rejected.
+func TestSeverityMappingHotReload(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+
+	initialConfig := `
+logging:
+  level: info
+  format: json
+severity_mapping:
+  sources:
+    alertmanager:
+      p1: critical
+`
+	if err := os.WriteFile(configPath, []byte(initialConfig), 0644); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+
+	v := viper.New()
+	v.SetConfigFile(configPath)
+	if err := v.ReadInConfig(); err != nil {
+		t.Fatalf("failed to read config: %v", err)
+	}
+
+	cfg, err := Load(configPath)
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+	cm := NewConfigManager(cfg, v, configPath, logger)
+
+	var reloaded *Config
+	cm.SetReloadCallback(func(newCfg *Config) { reloaded = newCfg })
+
+	updatedConfig := `
+logging:
+  level: info
+  format: json
+severity_mapping:
+  default: warning
+  sources:
+    alertmanager:
+      p1: critical
+    grafana:
+      high: critical
+`
+	if err := os.WriteFile(configPath, []byte(updatedConfig), 0644); err != nil {
+		t.Fatalf("failed to update config file: %v", err)
+	}
+
+	oldCfg := cm.Get()
+	if err := cm.TryReload(); err != nil {
+		t.Fatalf("reload failed: %v", err)
+	}
+	if reloaded == nil || reloaded.SeverityMapping.Sources["grafana"]["high"] != "critical" {
+		t.Fatalf("expected reload callback with the grafana mapping, got %+v", reloaded)
+	}
+
+	diff := extractConfigDiff(oldCfg, cm.Get())
+	if len(diff.ChangedKeys) != 1 || diff.ChangedKeys[0] != "severity_mapping" {
+		t.Fatalf("expected severity_mapping change, got %v", diff.ChangedKeys)
+	}
+
+	invalidConfig := `
+logging:
+  level: info
+  format: json
+severity_mapping:
+  sources:
+    sentry:
+      fatal: page
+`
+	if err := os.WriteFile(configPath, []byte(invalidConfig), 0644); err != nil {
+		t.Fatalf("failed to update config file: %v", err)
+	}
+	err = cm.TryReload()
+	if err == nil {
+		t.Fatal("expected invalid severity mapping to be rejected")
+	}
+	if !strings.Contains(err.Error(), "severity_mapping.sources.sentry: unknown source") {
+		t.Errorf("expected unknown source error, got %v", err)
+	}
+	if cm.Get().SeverityMapping.Default != "warning" {
+		t.Error("expected the previous mapping to be kept")
+	}
+}
+
 func TestInvalidYAMLHandling(t *testing.T) {
 	tmpDir := t.TempDir()
 	configPath := filepath.Join(tmpDir, "config.yaml")
@@ -2,9 +2,28 @@ package config
 
 import (
 	"fmt"
+	"net/url"
+	"os"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"text/template"
 	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/jsonmap"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/notifytemplate"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/fieldcrypt"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/schedule"
 )
 
+// genericSourceName restricts generic webhook names to URL-safe path segments.
+var genericSourceName = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// slackActionName restricts custom Slack action names to characters that
+// cannot be confused with the "_" separators of action IDs.
+var slackActionName = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9.-]*$`)
+
 // reloadableKeys defines the whitelist of configuration keys that can be hot-reloaded.
 var reloadableKeys = map[string]bool{
 	"logging.level":                 true,
@@ -12,6 +31,8 @@ var reloadableKeys = map[string]bool{
 	"slack.channel_id":              true,
 	"alerting.deduplication_window": true,
 	"alerting.resend_interval":      true,
+	"severity_mapping":              true,
+	"templates":                     true,
 }
 
 // staticKeys defines configuration keys that require application restart.
@@ -20,6 +41,7 @@ var staticKeys = map[string]string{
 	"storage.type":        "Storage backend initialization required",
 	"storage.sqlite.path": "Database connection recreation required",
 	"storage.mysql":       "Database connection pool recreation required",
+	"storage.redis":       "Redis connection pool recreation required",
 }
 
 // IsReloadable returns true if the given config key can be hot-reloaded.
@@ -91,9 +113,32 @@ func ValidateStorageType(storageType string) error {
 		"memory": true,
 		"sqlite": true,
 		"mysql":  true,
+		"redis":  true,
 	}
 	if !validTypes[storageType] {
-		return fmt.Errorf("invalid storage type: %s (must be memory, sqlite, or mysql)", storageType)
+		return fmt.Errorf("invalid storage type: %s (must be memory, sqlite, mysql, or redis)", storageType)
+	}
+	return nil
+}
+
+// ValidateFingerprint checks a source's fingerprinting strategy.
+func ValidateFingerprint(cfg FingerprintConfig, fieldName string) error {
+	switch cfg.Strategy {
+	case "", "upstream", "all_labels":
+		if len(cfg.LabelKeys) > 0 {
+			return fmt.Errorf("%s.label_keys is only used by the labels strategy", fieldName)
+		}
+	case "labels":
+		if len(cfg.LabelKeys) == 0 {
+			return fmt.Errorf("%s.label_keys is required for the labels strategy", fieldName)
+		}
+		for _, key := range cfg.LabelKeys {
+			if key == "" {
+				return fmt.Errorf("%s.label_keys must not contain empty keys", fieldName)
+			}
+		}
+	default:
+		return fmt.Errorf("%s.strategy must be upstream, labels, or all_labels, got %q", fieldName, cfg.Strategy)
 	}
 	return nil
 }
@@ -119,6 +164,13 @@ func (c *Config) Validate() error {
 	if err := ValidateDuration(c.Server.ShutdownTimeout, "server.shutdown_timeout"); err != nil {
 		errors = append(errors, err.Error())
 	}
+	if err := ValidateDuration(c.Server.DrainTimeout, "server.drain_timeout"); err != nil {
+		errors = append(errors, err.Error())
+	}
+
+	if c.Server.MaxDecompressedBodyBytes < 1024 {
+		errors = append(errors, fmt.Sprintf("server.max_decompressed_body_bytes must be at least 1024, got %d", c.Server.MaxDecompressedBodyBytes))
+	}
 
 	// Logical constraint: RequestTimeout should be less than WriteTimeout
 	if c.Server.RequestTimeout >= c.Server.WriteTimeout {
@@ -130,11 +182,114 @@ func (c *Config) Validate() error {
 		errors = append(errors, err.Error())
 	}
 
+	// Field encryption validation
+	if c.Storage.Encryption.Key != "" {
+		if c.Storage.Type != "sqlite" && c.Storage.Type != "mysql" {
+			errors = append(errors, fmt.Sprintf("storage.encryption requires the sqlite or mysql storage type, got %q", c.Storage.Type))
+		}
+		if _, err := fieldcrypt.NewCipher(c.Storage.Encryption.Key); err != nil {
+			errors = append(errors, fmt.Sprintf("storage.encryption.key is invalid: %v", err))
+		}
+	}
+
+	// Storage degradation validation
+	if c.IsStorageDegradationEnabled() {
+		degradation := c.Storage.Degradation
+		seen := make(map[string]bool, len(degradation.Order))
+		for _, step := range degradation.Order {
+			if step != "retry" && step != "buffer" {
+				errors = append(errors, fmt.Sprintf("storage.degradation.order: unknown step %q, must be retry or buffer", step))
+			} else if seen[step] {
+				errors = append(errors, fmt.Sprintf("storage.degradation.order: duplicate step %q", step))
+			}
+			seen[step] = true
+		}
+		if degradation.RetryBackoff < 0 {
+			errors = append(errors, fmt.Sprintf("storage.degradation.retry_backoff must not be negative, got %s", degradation.RetryBackoff))
+		}
+		if degradation.MaxBuffered < 1 {
+			errors = append(errors, fmt.Sprintf("storage.degradation.max_buffered must be positive, got %d", degradation.MaxBuffered))
+		}
+		if degradation.FlushInterval < time.Second {
+			errors = append(errors, fmt.Sprintf("storage.degradation.flush_interval must be at least 1s, got %s", degradation.FlushInterval))
+		}
+		if degradation.MetaAlertChannelID != "" && !c.IsSlackEnabled() {
+			errors = append(errors, "storage.degradation.meta_alert_channel_id requires slack to be enabled")
+		}
+	}
+
+	// Firing cache validation
+	if c.IsFiringCacheEnabled() {
+		ttl := c.Storage.FiringCache.TTL
+		if ttl < time.Second {
+			errors = append(errors, fmt.Sprintf("storage.firing_cache.ttl must be at least 1s, got %s", ttl))
+		}
+		if c.Alerting.StaleAfter > 0 && ttl >= c.Alerting.StaleAfter {
+			errors = append(errors, fmt.Sprintf("storage.firing_cache.ttl (%s) must be below alerting.stale_after (%s)", ttl, c.Alerting.StaleAfter))
+		}
+	}
+
+	// Retention validation
+	if c.Storage.Retention.Enabled {
+		retention := c.Storage.Retention
+		if retention.Interval < time.Minute {
+			errors = append(errors, fmt.Sprintf("storage.retention.interval must be at least 1m, got %s", retention.Interval))
+		}
+		if err := ValidateDuration(retention.ResolvedAlerts, "storage.retention.resolved_alerts"); err != nil {
+			errors = append(errors, err.Error())
+		}
+		if err := ValidateDuration(retention.AckEvents, "storage.retention.ack_events"); err != nil {
+			errors = append(errors, err.Error())
+		}
+		if err := ValidateDuration(retention.ExpiredSilences, "storage.retention.expired_silences"); err != nil {
+			errors = append(errors, err.Error())
+		}
+	}
+	if archive := c.Storage.Retention.Archive; archive.Enabled {
+		if !c.Storage.Retention.Enabled {
+			errors = append(errors, "storage.retention.archive requires storage.retention.enabled")
+		}
+		if u, err := url.Parse(archive.Endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errors = append(errors, fmt.Sprintf("storage.retention.archive.endpoint must be an http(s) URL, got %q", archive.Endpoint))
+		}
+		if err := ValidateNonEmpty(archive.Bucket, "storage.retention.archive.bucket"); err != nil {
+			errors = append(errors, err.Error())
+		}
+		if archive.AccessKeyID == "" || archive.SecretAccessKey == "" {
+			errors = append(errors, "storage.retention.archive requires access_key_id and secret_access_key")
+		}
+		if err := ValidateDuration(archive.Timeout, "storage.retention.archive.timeout"); err != nil {
+			errors = append(errors, err.Error())
+		}
+	}
+
 	// SQLite-specific validation
 	if c.Storage.Type == "sqlite" {
 		if err := ValidateNonEmpty(c.Storage.SQLite.Path, "storage.sqlite.path"); err != nil {
 			errors = append(errors, err.Error())
 		}
+		if c.Storage.SQLite.ReadPoolSize < 0 {
+			errors = append(errors, fmt.Sprintf("storage.sqlite.read_pool_size must not be negative, got %d", c.Storage.SQLite.ReadPoolSize))
+		}
+		if c.Storage.SQLite.ReadPoolSize > 0 && c.Storage.SQLite.Path == ":memory:" {
+			errors = append(errors, "storage.sqlite.read_pool_size requires a database file, not :memory:")
+		}
+	}
+
+	// Redis-specific validation
+	if c.Storage.Type == "redis" {
+		if err := ValidateNonEmpty(c.Storage.Redis.Addr, "storage.redis.addr"); err != nil {
+			errors = append(errors, err.Error())
+		}
+		if c.Storage.Redis.DB < 0 {
+			errors = append(errors, fmt.Sprintf("storage.redis.db must be non-negative, got %d", c.Storage.Redis.DB))
+		}
+		if c.Storage.Redis.ResolvedTTL < time.Minute {
+			errors = append(errors, fmt.Sprintf("storage.redis.resolved_ttl must be at least 1m, got %s", c.Storage.Redis.ResolvedTTL))
+		}
+		if c.Storage.Redis.PoolSize < 1 {
+			errors = append(errors, fmt.Sprintf("storage.redis.pool_size must be positive, got %d", c.Storage.Redis.PoolSize))
+		}
 	}
 
 	// MySQL-specific validation
@@ -172,6 +327,12 @@ func (c *Config) Validate() error {
 			if err := ValidateNonEmpty(c.Storage.MySQL.Replica.Password, "storage.mysql.replica.password"); err != nil {
 				errors = append(errors, err.Error())
 			}
+			if c.Storage.MySQL.Replica.MaxLag < 0 {
+				errors = append(errors, fmt.Sprintf("storage.mysql.replica.max_lag must not be negative, got %s", c.Storage.MySQL.Replica.MaxLag))
+			}
+			if c.Storage.MySQL.Replica.HealthCheckInterval < time.Second {
+				errors = append(errors, fmt.Sprintf("storage.mysql.replica.health_check_interval must be at least 1s, got %s", c.Storage.MySQL.Replica.HealthCheckInterval))
+			}
 		}
 
 		// Connection pool validation
@@ -194,8 +355,73 @@ func (c *Config) Validate() error {
 		if err := ValidateNonEmpty(c.Slack.ChannelID, "slack.channel_id"); err != nil {
 			errors = append(errors, err.Error())
 		}
+		if len(c.Slack.Channels) > 0 && c.Slack.ChannelLabel == "" {
+			errors = append(errors, "slack.channels requires slack.channel_label")
+		}
+		for value, channel := range c.Slack.Channels {
+			if err := ValidateNonEmpty(channel, fmt.Sprintf("slack.channels.%s", value)); err != nil {
+				errors = append(errors, err.Error())
+			}
+		}
+
+		if c.Slack.HistoryChannelID != "" && c.Slack.HistoryChannelID == c.Slack.ChannelID {
+			errors = append(errors, "slack.history_channel_id must differ from slack.channel_id")
+		}
+		if c.Slack.FallbackTemplate != "" {
+			if _, err := template.New("fallback").Parse(c.Slack.FallbackTemplate); err != nil {
+				errors = append(errors, fmt.Sprintf("slack.fallback_template is invalid: %v", err))
+			}
+		}
+		if c.Slack.DuplicateCheckWindow < 0 {
+			errors = append(errors, fmt.Sprintf("slack.duplicate_check_window must not be negative, got %s", c.Slack.DuplicateCheckWindow))
+		}
+		for channel, lifecycle := range c.Slack.Lifecycle {
+			if lifecycle.Action != "delete" && lifecycle.Action != "collapse" {
+				errors = append(errors, fmt.Sprintf("slack.lifecycle.%s.action must be delete or collapse, got %q", channel, lifecycle.Action))
+			}
+			if lifecycle.After < 0 {
+				errors = append(errors, fmt.Sprintf("slack.lifecycle.%s.after must not be negative", channel))
+			}
+			if lifecycle.HistoryChannelID == channel {
+				errors = append(errors, fmt.Sprintf("slack.lifecycle.%s.history_channel_id must be another channel", channel))
+			}
+		}
+		for _, event := range c.Slack.ThreadReplies {
+			switch event {
+			case "acknowledged", "unacknowledged", "resolved", "silenced", "severity_changed", "note_added", "escalated":
+			default:
+				errors = append(errors, fmt.Sprintf("slack.thread_replies must list acknowledged, unacknowledged, resolved, silenced, severity_changed, note_added or escalated, got %q", event))
+			}
+		}
+		actionNames := make(map[string]bool, len(c.Slack.Actions))
+		for i, action := range c.Slack.Actions {
+			errors = append(errors, validateSlackAction(action, fmt.Sprintf("slack.actions[%d]", i))...)
+			if actionNames[action.Name] {
+				errors = append(errors, fmt.Sprintf("slack.actions[%d].name %q is used by another action", i, action.Name))
+			}
+			actionNames[action.Name] = true
+		}
+		if limit := c.Slack.RateLimit; limit.Enabled {
+			if limit.PerSecond < 0 {
+				errors = append(errors, fmt.Sprintf("slack.rate_limit.per_second must be positive, got %g", limit.PerSecond))
+			}
+			if limit.Burst < 0 {
+				errors = append(errors, fmt.Sprintf("slack.rate_limit.burst must be positive, got %d", limit.Burst))
+			}
+		}
+		if async := c.Slack.AsyncInteractions; async.Enabled {
+			if async.Workers < 1 {
+				errors = append(errors, fmt.Sprintf("slack.async_interactions.workers must be positive, got %d", async.Workers))
+			}
+			if async.QueueSize < 1 {
+				errors = append(errors, fmt.Sprintf("slack.async_interactions.queue_size must be positive, got %d", async.QueueSize))
+			}
+		}
 
 		// Socket Mode validation
+		if c.Slack.Mode != SlackModeEvents && c.Slack.Mode != SlackModeSocket {
+			errors = append(errors, fmt.Sprintf("slack.mode must be events or socket, got %q", c.Slack.Mode))
+		}
 		if c.Slack.SocketMode.Enabled {
 			// Socket Mode requires app token
 			if err := ValidateNonEmpty(c.Slack.SocketMode.AppToken, "slack.socket_mode.app_token"); err != nil {
@@ -228,6 +454,611 @@ func (c *Config) Validate() error {
 		if err := ValidateNonEmpty(c.PagerDuty.FromEmail, "pagerduty.from_email"); err != nil {
 			errors = append(errors, err.Error())
 		}
+		if dashboard := c.PagerDuty.DashboardURL; dashboard != "" {
+			if u, err := url.Parse(dashboard); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				errors = append(errors, fmt.Sprintf("pagerduty.dashboard_url must be an http(s) URL, got %q", dashboard))
+			}
+		}
+		if queue := c.PagerDuty.SendQueue; queue.Enabled {
+			if queue.Backoff < 0 {
+				errors = append(errors, fmt.Sprintf("pagerduty.send_queue.backoff must not be negative, got %s", queue.Backoff))
+			}
+			if queue.MaxAge < 0 {
+				errors = append(errors, fmt.Sprintf("pagerduty.send_queue.max_age must not be negative, got %s", queue.MaxAge))
+			}
+			switch queue.ShedBelow {
+			case "critical", "warning", "info":
+			default:
+				errors = append(errors, fmt.Sprintf("pagerduty.send_queue.shed_below must be critical, warning, or info, got %q", queue.ShedBelow))
+			}
+		}
+	}
+
+	// Incident management tool validation
+	for _, tool := range []struct {
+		name string
+		cfg  IncidentToolConfig
+	}{{"firehydrant", c.FireHydrant}, {"incidentio", c.IncidentIO}} {
+		if !tool.cfg.Enabled {
+			continue
+		}
+		if err := ValidateNonEmpty(tool.cfg.APIToken, tool.name+".api_token"); err != nil {
+			errors = append(errors, err.Error())
+		}
+		if u, err := url.Parse(tool.cfg.APIURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errors = append(errors, fmt.Sprintf("%s.api_url must be an http(s) URL, got %q", tool.name, tool.cfg.APIURL))
+		}
+		for _, event := range tool.cfg.Events {
+			switch event {
+			case "acknowledged", "unacknowledged", "resolved", "silenced", "severity_changed", "note_added", "assigned", "escalated":
+			default:
+				errors = append(errors, fmt.Sprintf("%s.events must list acknowledged, unacknowledged, resolved, silenced, severity_changed, note_added, assigned or escalated, got %q", tool.name, event))
+			}
+		}
+	}
+
+	// Teams validation
+	if c.IsTeamsEnabled() {
+		if err := ValidateNonEmpty(c.Teams.WebhookURL, "teams.webhook_url"); err != nil {
+			errors = append(errors, err.Error())
+		}
+		// Ack links are signed, so a public URL without a secret would produce unusable buttons
+		if c.Teams.PublicURL != "" {
+			if err := ValidateNonEmpty(c.Teams.SigningSecret, "teams.signing_secret"); err != nil {
+				errors = append(errors, err.Error())
+			}
+			if err := ValidateDuration(c.Teams.ActionLinkTTL, "teams.action_link_ttl"); err != nil {
+				errors = append(errors, err.Error())
+			}
+		}
+	}
+
+	// CloudWatch validation
+	if c.IsCloudWatchEnabled() {
+		switch c.CloudWatch.Severity {
+		case "critical", "warning", "info":
+		default:
+			errors = append(errors, fmt.Sprintf("cloudwatch.severity must be critical, warning, or info, got %q", c.CloudWatch.Severity))
+		}
+	}
+
+	// Sentry validation: webhooks are always signed, so require the secret
+	if c.IsSentryEnabled() {
+		if err := ValidateNonEmpty(c.Sentry.ClientSecret, "sentry.client_secret"); err != nil {
+			errors = append(errors, err.Error())
+		}
+	}
+
+	// Fingerprinting validation
+	for _, fp := range []struct {
+		field string
+		cfg   FingerprintConfig
+	}{
+		{"alertmanager.fingerprinting", c.Alertmanager.Fingerprinting},
+		{"grafana.fingerprinting", c.Grafana.Fingerprinting},
+		{"cloudwatch.fingerprinting", c.CloudWatch.Fingerprinting},
+		{"sentry.fingerprinting", c.Sentry.Fingerprinting},
+		{"batch_ingest.fingerprinting", c.BatchIngest.Fingerprinting},
+		{"cloudevents.ingest.fingerprinting", c.CloudEvents.Ingest.Fingerprinting},
+	} {
+		if err := ValidateFingerprint(fp.cfg, fp.field); err != nil {
+			errors = append(errors, err.Error())
+		}
+	}
+
+	// Generic webhook validation
+	seenSources := make(map[string]bool, len(c.GenericWebhooks))
+	for i, source := range c.GenericWebhooks {
+		prefix := fmt.Sprintf("generic_webhooks[%d]", i)
+		if !genericSourceName.MatchString(source.Name) {
+			errors = append(errors, fmt.Sprintf("%s.name must match %s, got %q", prefix, genericSourceName, source.Name))
+		} else if seenSources[source.Name] {
+			errors = append(errors, fmt.Sprintf("%s: duplicate source name %q", prefix, source.Name))
+		}
+		seenSources[source.Name] = true
+
+		if source.Alerts != "" {
+			if e, err := jsonmap.Compile(source.Alerts); err != nil {
+				errors = append(errors, fmt.Sprintf("%s.alerts: %v", prefix, err))
+			} else if !e.IsPath() {
+				errors = append(errors, prefix+".alerts must be a path (starting with $)")
+			}
+		}
+
+		if source.Mapping.Name == "" {
+			errors = append(errors, prefix+".mapping.name is required")
+		}
+		if err := ValidateFingerprint(source.Fingerprinting, prefix+".fingerprinting"); err != nil {
+			errors = append(errors, err.Error())
+		}
+		for field, expr := range source.Mapping.Expressions() {
+			if _, err := jsonmap.Compile(expr); err != nil {
+				errors = append(errors, fmt.Sprintf("%s.mapping.%s: %v", prefix, field, err))
+			}
+		}
+		for from, to := range source.Mapping.SeverityMap {
+			switch to {
+			case "critical", "warning", "info":
+			default:
+				errors = append(errors, fmt.Sprintf("%s.mapping.severity_map[%s]: must be critical, warning, or info, got %q", prefix, from, to))
+			}
+		}
+	}
+
+	// Canary validation
+	if c.IsCanaryEnabled() {
+		if !c.IsSlackEnabled() {
+			errors = append(errors, "canary requires slack to be enabled")
+		}
+		if err := ValidateNonEmpty(c.Canary.SlackChannelID, "canary.slack_channel_id"); err != nil {
+			errors = append(errors, err.Error())
+		} else if c.Canary.SlackChannelID == c.Slack.ChannelID {
+			errors = append(errors, "canary.slack_channel_id must differ from slack.channel_id")
+		}
+		if c.Canary.Percent < 0 || c.Canary.Percent > 100 {
+			errors = append(errors, fmt.Sprintf("canary.percent must be between 0 and 100, got %d", c.Canary.Percent))
+		}
+		for _, duration := range c.Canary.SilenceDurations {
+			if duration <= 0 {
+				errors = append(errors, fmt.Sprintf("canary.silence_durations contains invalid duration: %s", duration))
+			}
+		}
+		if c.Canary.SlackMessage != "" {
+			if _, err := notifytemplate.Load("", map[string]string{notifytemplate.SlackMessage: c.Canary.SlackMessage}); err != nil {
+				errors = append(errors, fmt.Sprintf("canary.slack_message: %v", err))
+			}
+		}
+		if c.Canary.FallbackTemplate != "" {
+			if _, err := template.New("fallback").Parse(c.Canary.FallbackTemplate); err != nil {
+				errors = append(errors, fmt.Sprintf("canary.fallback_template is invalid: %v", err))
+			}
+		}
+		if c.Canary.Route != nil {
+			errors = append(errors, validateRootRoute(*c.Canary.Route, "canary.route", c.routeNotifiers())...)
+		}
+	}
+
+	// Payload log validation
+	if c.IsPayloadLogEnabled() && c.PayloadLog.Size < 0 {
+		errors = append(errors, fmt.Sprintf("payload_log.size must be positive, got %d", c.PayloadLog.Size))
+	}
+
+	// Delivery SLO validation
+	if c.IsDeliverySLOEnabled() {
+		if len(c.DeliverySLO.Objectives) == 0 {
+			errors = append(errors, "delivery_slo requires at least one objective")
+		}
+		if c.DeliverySLO.Window < time.Minute {
+			errors = append(errors, fmt.Sprintf("delivery_slo.window must be at least 1m, got %s", c.DeliverySLO.Window))
+		}
+		if c.DeliverySLO.MinSamples < 1 {
+			errors = append(errors, fmt.Sprintf("delivery_slo.min_samples must be positive, got %d", c.DeliverySLO.MinSamples))
+		}
+		if c.DeliverySLO.MetaAlertChannelID != "" && !c.IsSlackEnabled() {
+			errors = append(errors, "delivery_slo.meta_alert_channel_id requires slack to be enabled")
+		}
+		names := make(map[string]bool)
+		for i, obj := range c.DeliverySLO.Objectives {
+			prefix := fmt.Sprintf("delivery_slo.objectives[%d]", i)
+			if err := ValidateNonEmpty(obj.Name, prefix+".name"); err != nil {
+				errors = append(errors, err.Error())
+			} else if names[obj.Name] {
+				errors = append(errors, fmt.Sprintf("%s.name %q is duplicated", prefix, obj.Name))
+			}
+			names[obj.Name] = true
+			switch obj.Notifier {
+			case "", "slack", "pagerduty", "teams", "email":
+			default:
+				errors = append(errors, fmt.Sprintf("%s.notifier must be slack, pagerduty, teams, or email, got %q", prefix, obj.Notifier))
+			}
+			switch obj.Severity {
+			case "", "critical", "warning", "info":
+			default:
+				errors = append(errors, fmt.Sprintf("%s.severity must be critical, warning, or info, got %q", prefix, obj.Severity))
+			}
+			if obj.Latency <= 0 {
+				errors = append(errors, fmt.Sprintf("%s.latency must be positive, got %s", prefix, obj.Latency))
+			}
+			if obj.Target <= 0 || obj.Target > 100 {
+				errors = append(errors, fmt.Sprintf("%s.target must be between 0 and 100, got %g", prefix, obj.Target))
+			}
+		}
+	}
+
+	// Notification limits validation
+	if c.IsNotificationLimitsEnabled() {
+		limits := c.NotificationLimits
+		notifiers := map[string]bool{
+			"slack":       c.IsSlackEnabled(),
+			"pagerduty":   c.IsPagerDutyEnabled(),
+			"teams":       c.IsTeamsEnabled(),
+			"email":       c.IsEmailEnabled(),
+			"cloudevents": c.IsCloudEventsEmitEnabled(),
+			"canary":      c.IsCanaryEnabled(),
+		}
+		if c.IsEscalationEnabled() && c.IsPagerDutyEnabled() {
+			for _, target := range c.Escalation.Targets {
+				notifiers[target.Name] = true
+			}
+		}
+		names := make([]string, 0, len(limits.Notifiers))
+		for name := range limits.Notifiers {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			limit := limits.Notifiers[name]
+			prefix := fmt.Sprintf("notification_limits.notifiers.%s", name)
+			if !notifiers[name] {
+				errors = append(errors, fmt.Sprintf("%s: %q is not an enabled notifier or escalation target", prefix, name))
+			}
+			if limit.PerSecond < 0 || limit.Burst < 0 || limit.Budget < 0 || limit.Window < 0 {
+				errors = append(errors, fmt.Sprintf("%s: per_second, burst, budget and window must not be negative", prefix))
+			} else if limit.PerSecond == 0 && limit.Budget == 0 {
+				errors = append(errors, fmt.Sprintf("%s requires per_second or budget", prefix))
+			}
+		}
+		if limits.SummaryInterval < time.Second {
+			errors = append(errors, fmt.Sprintf("notification_limits.summary_interval must be at least 1s, got %s", limits.SummaryInterval))
+		}
+		if limits.SummaryChannelID != "" && !c.IsSlackEnabled() {
+			errors = append(errors, "notification_limits.summary_channel_id requires slack to be enabled")
+		}
+	}
+
+	// Batch ingestion validation
+	if c.IsBatchIngestEnabled() {
+		if err := ValidateNonEmpty(c.BatchIngest.Token, "batch_ingest.token"); err != nil {
+			errors = append(errors, err.Error())
+		}
+		if c.BatchIngest.MaxLineBytes < 1024 {
+			errors = append(errors, fmt.Sprintf("batch_ingest.max_line_bytes must be at least 1024, got %d", c.BatchIngest.MaxLineBytes))
+		}
+		if c.BatchIngest.MaxItems < 1 {
+			errors = append(errors, fmt.Sprintf("batch_ingest.max_items must be positive, got %d", c.BatchIngest.MaxItems))
+		}
+	}
+
+	// CloudEvents validation
+	if c.IsCloudEventsIngestEnabled() {
+		if err := ValidateNonEmpty(c.CloudEvents.Ingest.Token, "cloudevents.ingest.token"); err != nil {
+			errors = append(errors, err.Error())
+		}
+		if c.CloudEvents.Ingest.MaxEvents < 1 {
+			errors = append(errors, fmt.Sprintf("cloudevents.ingest.max_events must be positive, got %d", c.CloudEvents.Ingest.MaxEvents))
+		}
+	}
+	seenSinks := make(map[string]bool, len(c.CloudEvents.Sinks))
+	for i, sink := range c.CloudEvents.Sinks {
+		prefix := fmt.Sprintf("cloudevents.sinks[%d]", i)
+		if sink.Name == "" {
+			errors = append(errors, prefix+".name is required")
+		} else if seenSinks[sink.Name] {
+			errors = append(errors, fmt.Sprintf("%s: duplicate sink name %q", prefix, sink.Name))
+		}
+		seenSinks[sink.Name] = true
+
+		if u, err := url.Parse(sink.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errors = append(errors, fmt.Sprintf("%s.url must be an http(s) URL, got %q", prefix, sink.URL))
+		}
+		if sink.Mode != "binary" && sink.Mode != "structured" {
+			errors = append(errors, fmt.Sprintf("%s.mode must be binary or structured, got %q", prefix, sink.Mode))
+		}
+	}
+
+	// Tracing validation
+	if c.IsTracingEnabled() {
+		tracing := c.Observability.Tracing
+		if u, err := url.Parse(tracing.Endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errors = append(errors, fmt.Sprintf("observability.tracing.endpoint must be an http(s) URL, got %q", tracing.Endpoint))
+		}
+		if tracing.SampleRatio < 0 || tracing.SampleRatio > 1 {
+			errors = append(errors, fmt.Sprintf("observability.tracing.sample_ratio must be between 0 and 1, got %g", tracing.SampleRatio))
+		}
+		if tracing.Timeout < 0 {
+			errors = append(errors, fmt.Sprintf("observability.tracing.timeout must be positive, got %s", tracing.Timeout))
+		}
+	}
+
+	// Retry queue validation
+	if c.IsRetryQueueEnabled() {
+		queue := c.RetryQueue
+		if queue.Workers < 1 {
+			errors = append(errors, fmt.Sprintf("retry_queue.workers must be at least 1, got %d", queue.Workers))
+		}
+		if queue.PollInterval <= 0 {
+			errors = append(errors, fmt.Sprintf("retry_queue.poll_interval must be positive, got %s", queue.PollInterval))
+		}
+		if queue.MaxAttempts < 2 {
+			errors = append(errors, fmt.Sprintf("retry_queue.max_attempts must be at least 2, got %d", queue.MaxAttempts))
+		}
+		if queue.InitialBackoff <= 0 || queue.MaxBackoff < queue.InitialBackoff {
+			errors = append(errors, fmt.Sprintf("retry_queue requires 0 < initial_backoff <= max_backoff, got %s and %s", queue.InitialBackoff, queue.MaxBackoff))
+		}
+	}
+
+	// On-call rotation validation
+	if len(c.OnCall) > 0 {
+		subscribers := make(map[string]bool, len(c.Subscribers))
+		for _, sub := range c.Subscribers {
+			subscribers[sub.Name] = true
+		}
+		names := make(map[string]bool)
+		for i, rotation := range c.OnCall {
+			prefix := fmt.Sprintf("oncall[%d]", i)
+			if err := ValidateNonEmpty(rotation.Name, prefix+".name"); err != nil {
+				errors = append(errors, err.Error())
+			} else if names[rotation.Name] {
+				errors = append(errors, fmt.Sprintf("%s.name %q is duplicated", prefix, rotation.Name))
+			}
+			names[rotation.Name] = true
+			if rotation.Rotation != RotationDaily && rotation.Rotation != RotationWeekly {
+				errors = append(errors, fmt.Sprintf("%s.rotation must be daily or weekly, got %q", prefix, rotation.Rotation))
+			}
+			if rotation.Start.IsZero() {
+				errors = append(errors, fmt.Sprintf("%s.start is required", prefix))
+			}
+			if len(rotation.Members) == 0 {
+				errors = append(errors, fmt.Sprintf("%s requires at least one member", prefix))
+			}
+			for _, member := range rotation.Members {
+				if !subscribers[member] {
+					errors = append(errors, fmt.Sprintf("%s.members: %q is not a subscriber", prefix, member))
+				}
+			}
+		}
+	}
+
+	// Escalation validation
+	if c.IsEscalationEnabled() {
+		esc := c.Escalation
+		if esc.Interval < time.Second {
+			errors = append(errors, fmt.Sprintf("escalation.interval must be at least 1s, got %s", esc.Interval))
+		}
+		if len(esc.Policies) == 0 {
+			errors = append(errors, "escalation requires at least one policy")
+		}
+		if len(esc.Targets) > 0 && !c.IsPagerDutyEnabled() {
+			errors = append(errors, "escalation.targets require pagerduty to be enabled")
+		}
+
+		notifiers := map[string]bool{
+			"slack":     c.IsSlackEnabled(),
+			"pagerduty": c.IsPagerDutyEnabled(),
+			"teams":     c.IsTeamsEnabled(),
+			"email":     c.IsEmailEnabled(),
+		}
+		for i, target := range esc.Targets {
+			prefix := fmt.Sprintf("escalation.targets[%d]", i)
+			if err := ValidateNonEmpty(target.Name, prefix+".name"); err != nil {
+				errors = append(errors, err.Error())
+			} else if _, taken := notifiers[target.Name]; taken || target.Name == "canary" {
+				errors = append(errors, fmt.Sprintf("%s.name %q is already used by a notifier", prefix, target.Name))
+			}
+			notifiers[target.Name] = true
+			if err := ValidateNonEmpty(target.PagerDutyRoutingKey, prefix+".pagerduty_routing_key"); err != nil {
+				errors = append(errors, err.Error())
+			}
+		}
+
+		names := make(map[string]bool)
+		for i, policy := range esc.Policies {
+			prefix := fmt.Sprintf("escalation.policies[%d]", i)
+			if err := ValidateNonEmpty(policy.Name, prefix+".name"); err != nil {
+				errors = append(errors, err.Error())
+			} else if names[policy.Name] {
+				errors = append(errors, fmt.Sprintf("%s.name %q is duplicated", prefix, policy.Name))
+			}
+			names[policy.Name] = true
+			switch policy.Severity {
+			case "", "critical", "warning", "info":
+			default:
+				errors = append(errors, fmt.Sprintf("%s.severity must be critical, warning, or info, got %q", prefix, policy.Severity))
+			}
+			if len(policy.Steps) == 0 {
+				errors = append(errors, fmt.Sprintf("%s requires at least one step", prefix))
+			}
+			var last time.Duration
+			for j, step := range policy.Steps {
+				stepPrefix := fmt.Sprintf("%s.steps[%d]", prefix, j)
+				if step.After <= last {
+					errors = append(errors, fmt.Sprintf("%s.after must be positive and later than the previous step, got %s", stepPrefix, step.After))
+				}
+				last = step.After
+				if len(step.Notify) == 0 {
+					errors = append(errors, fmt.Sprintf("%s.notify requires at least one target", stepPrefix))
+				}
+				for _, name := range step.Notify {
+					if !notifiers[name] {
+						errors = append(errors, fmt.Sprintf("%s.notify: %q is not an enabled notifier or escalation target", stepPrefix, name))
+					}
+				}
+			}
+		}
+	}
+
+	// Routing validation
+	if c.IsRoutingEnabled() {
+		root := c.Routing.Route
+		errors = append(errors, validateRootRoute(root, "routing.route", c.routeNotifiers())...)
+		if len(c.Alerting.Pipeline) > 0 && !slices.Contains(c.Alerting.Pipeline, "route") && routeSetsPipeline(root) {
+			errors = append(errors, "routing route pipelines require the route stage in alerting.pipeline")
+		}
+	}
+	if len(c.Alerting.Pipeline) > 0 {
+		errors = append(errors, validatePipeline(c.Alerting.Pipeline, "alerting.pipeline", true)...)
+	}
+
+	// Alertmanager async ingestion validation
+	if async := c.Alertmanager.Async; async.Enabled {
+		if async.Workers < 1 {
+			errors = append(errors, fmt.Sprintf("alertmanager.async.workers must be positive, got %d", async.Workers))
+		}
+		if async.QueueSize < 1 {
+			errors = append(errors, fmt.Sprintf("alertmanager.async.queue_size must be positive, got %d", async.QueueSize))
+		}
+	}
+
+	// Alertmanager silence sync validation
+	if c.IsAlertmanagerSilenceSyncEnabled() {
+		sync := c.Alertmanager.SilenceSync
+		if u, err := url.Parse(sync.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errors = append(errors, fmt.Sprintf("alertmanager.silence_sync.url must be an http(s) URL, got %q", sync.URL))
+		}
+		if sync.Password != "" && sync.Username == "" {
+			errors = append(errors, "alertmanager.silence_sync.password requires a username")
+		}
+		if sync.Timeout < 0 {
+			errors = append(errors, fmt.Sprintf("alertmanager.silence_sync.timeout must not be negative, got %s", sync.Timeout))
+		}
+		if !c.IsSlackEnabled() {
+			errors = append(errors, "alertmanager.silence_sync requires Slack, where the silences are made")
+		}
+	}
+
+	// Clock skew validation
+	if c.IsClockSkewEnabled() {
+		skew := c.ClockSkew
+		if u, err := url.Parse(skew.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errors = append(errors, fmt.Sprintf("clock_skew.url must be an http(s) URL, got %q", skew.URL))
+		}
+		if skew.Interval < time.Minute {
+			errors = append(errors, fmt.Sprintf("clock_skew.interval must be at least 1m, got %s", skew.Interval))
+		}
+		if skew.WarnThreshold < time.Second {
+			errors = append(errors, fmt.Sprintf("clock_skew.warn_threshold must be at least 1s, got %s", skew.WarnThreshold))
+		}
+		if skew.WidenTolerance && skew.MaxTolerance <= 5*time.Minute {
+			errors = append(errors, fmt.Sprintf("clock_skew.max_tolerance must exceed the default 5m tolerance, got %s", skew.MaxTolerance))
+		}
+	}
+
+	// Outbound HTTP validation
+	outbound := c.OutboundHTTP
+	if outbound.MaxIdleConns < 0 || outbound.MaxIdleConnsPerHost < 0 {
+		errors = append(errors, "outbound_http.max_idle_conns and max_idle_conns_per_host must not be negative")
+	}
+	if outbound.MaxIdleConns > 0 && outbound.MaxIdleConnsPerHost > outbound.MaxIdleConns {
+		errors = append(errors, fmt.Sprintf("outbound_http.max_idle_conns_per_host (%d) must not exceed max_idle_conns (%d)", outbound.MaxIdleConnsPerHost, outbound.MaxIdleConns))
+	}
+	if outbound.IdleConnTimeout < 0 || outbound.DNSCacheTTL < 0 {
+		errors = append(errors, "outbound_http.idle_conn_timeout and dns_cache_ttl must not be negative")
+	}
+
+	// User directory validation
+	emails := make(map[string]bool)
+	for i, user := range c.Users.Directory {
+		prefix := fmt.Sprintf("users.directory[%d]", i)
+		email := strings.ToLower(user.Email)
+		if err := ValidateNonEmpty(user.Email, prefix+".email"); err != nil {
+			errors = append(errors, err.Error())
+		} else if emails[email] {
+			errors = append(errors, fmt.Sprintf("%s.email %q is duplicated", prefix, user.Email))
+		}
+		emails[email] = true
+	}
+	if c.Users.Lookup.Slack && !c.IsSlackEnabled() {
+		errors = append(errors, "users.lookup.slack requires slack to be enabled")
+	}
+	if c.Users.Lookup.PagerDuty && (!c.IsPagerDutyEnabled() || c.PagerDuty.APIToken == "") {
+		errors = append(errors, "users.lookup.pagerduty requires pagerduty.api_token")
+	}
+	if c.Users.Lookup.CacheTTL < 0 {
+		errors = append(errors, fmt.Sprintf("users.lookup.cache_ttl must not be negative, got %s", c.Users.Lookup.CacheTTL))
+	}
+
+	// Cloud metadata validation
+	if c.IsCloudMetadataEnabled() {
+		cloud := c.CloudMetadata
+		if !cloud.AWS.Enabled && !cloud.GCP.Enabled {
+			errors = append(errors, "cloud_metadata requires aws or gcp to be enabled")
+		}
+		if cloud.AWS.Enabled {
+			if cloud.AWS.Region == "" {
+				errors = append(errors, "cloud_metadata.aws.region is required")
+			}
+			if cloud.AWS.AccessKeyID == "" || cloud.AWS.SecretAccessKey == "" {
+				errors = append(errors, "cloud_metadata.aws requires access_key_id and secret_access_key (or AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY)")
+			}
+		}
+		if cloud.GCP.Enabled && cloud.GCP.Project == "" {
+			errors = append(errors, "cloud_metadata.gcp.project is required")
+		}
+		if cloud.CacheTTL < 0 || cloud.NegativeCacheTTL < 0 || cloud.Timeout < 0 {
+			errors = append(errors, "cloud_metadata cache_ttl, negative_cache_ttl and timeout must not be negative")
+		}
+	}
+
+	// Enrichment webhook validation
+	enrichmentNames := make(map[string]bool, len(c.Enrichment.Webhooks))
+	for i, wh := range c.Enrichment.Webhooks {
+		prefix := fmt.Sprintf("enrichment.webhooks[%d]", i)
+		if err := ValidateNonEmpty(wh.Name, prefix+".name"); err != nil {
+			errors = append(errors, err.Error())
+		} else if enrichmentNames[wh.Name] {
+			errors = append(errors, fmt.Sprintf("%s.name %q is used by another webhook", prefix, wh.Name))
+		}
+		enrichmentNames[wh.Name] = true
+		if u, err := url.Parse(wh.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errors = append(errors, fmt.Sprintf("%s.url must be an http(s) URL, got %q", prefix, wh.URL))
+		}
+		if wh.Timeout < 0 || wh.Cooldown < 0 || wh.MaxFailures < 0 {
+			errors = append(errors, fmt.Sprintf("%s timeout, cooldown and max_failures must not be negative", prefix))
+		}
+	}
+
+	// API authentication validation
+	if c.IsAPIAuthEnabled() {
+		if len(c.APIAuth.Tokens) == 0 {
+			errors = append(errors, "api_auth requires at least one token")
+		}
+		names := make(map[string]bool)
+		tokens := make(map[string]bool)
+		for i, tok := range c.APIAuth.Tokens {
+			prefix := fmt.Sprintf("api_auth.tokens[%d]", i)
+			if err := ValidateNonEmpty(tok.Name, prefix+".name"); err != nil {
+				errors = append(errors, err.Error())
+			} else if names[tok.Name] {
+				errors = append(errors, fmt.Sprintf("%s.name %q is duplicated", prefix, tok.Name))
+			}
+			names[tok.Name] = true
+			if len(tok.Token) < 16 {
+				errors = append(errors, fmt.Sprintf("%s.token must be at least 16 characters", prefix))
+			} else if tokens[tok.Token] {
+				errors = append(errors, fmt.Sprintf("%s.token is shared with another token", prefix))
+			}
+			tokens[tok.Token] = true
+			if len(tok.Scopes) == 0 {
+				errors = append(errors, fmt.Sprintf("%s.scopes must not be empty", prefix))
+			}
+			for _, scope := range tok.Scopes {
+				switch scope {
+				case "read", "ack", "silence", "admin":
+				default:
+					errors = append(errors, fmt.Sprintf("%s.scopes must be read, ack, silence, or admin, got %q", prefix, scope))
+				}
+			}
+		}
+	}
+
+	// Email validation
+	if c.IsEmailEnabled() {
+		if err := ValidateNonEmpty(c.Email.SMTPHost, "email.smtp_host"); err != nil {
+			errors = append(errors, err.Error())
+		}
+		if err := ValidateNonEmpty(c.Email.From, "email.from"); err != nil {
+			errors = append(errors, err.Error())
+		}
+		if len(c.Email.Recipients) == 0 && len(c.Email.DefaultRecipients) == 0 {
+			errors = append(errors, "email requires recipients or default_recipients")
+		}
+		for severity := range c.Email.Recipients {
+			switch severity {
+			case "critical", "warning", "info":
+			default:
+				errors = append(errors, fmt.Sprintf("email.recipients: unknown severity %q (must be critical, warning, or info)", severity))
+			}
+		}
 	}
 
 	// Alerting validation
@@ -243,6 +1074,54 @@ func (c *Config) Validate() error {
 		errors = append(errors, "alerting.resend_interval must be greater than alerting.deduplication_window")
 	}
 
+	if c.Alerting.StaleAfter < 0 {
+		errors = append(errors, fmt.Sprintf("alerting.stale_after must not be negative, got %s", c.Alerting.StaleAfter))
+	}
+
+	if reminder := c.Alerting.SilenceReminder; reminder.Enabled {
+		if !c.IsSlackEnabled() {
+			errors = append(errors, "alerting.silence_reminder requires slack to be enabled")
+		}
+		if reminder.Before < 0 {
+			errors = append(errors, fmt.Sprintf("alerting.silence_reminder.before must not be negative, got %s", reminder.Before))
+		}
+	}
+
+	if sharding := c.Alerting.Sharding; sharding.Enabled {
+		if sharding.Shards < 1 || sharding.Shards > 1024 {
+			errors = append(errors, fmt.Sprintf("alerting.sharding.shards must be between 1 and 1024, got %d", sharding.Shards))
+		}
+		if sharding.QueueSize < 1 {
+			errors = append(errors, fmt.Sprintf("alerting.sharding.queue_size must be positive, got %d", sharding.QueueSize))
+		}
+	}
+
+	if parallel := c.Alerting.Parallel; parallel.Enabled && parallel.Concurrency < 1 {
+		errors = append(errors, fmt.Sprintf("alerting.parallel.concurrency must be positive, got %d", parallel.Concurrency))
+	}
+
+	// Resend validation
+	if resend := c.Alerting.Resend; resend.Enabled {
+		if !c.IsSlackEnabled() {
+			errors = append(errors, "alerting.resend requires slack to be enabled")
+		}
+		if !isResendAction(resend.Action) {
+			errors = append(errors, fmt.Sprintf("alerting.resend.action must be reply, repost or none, got %q", resend.Action))
+		}
+		for severity, policy := range resend.Severities {
+			prefix := fmt.Sprintf("alerting.resend.severities.%s", severity)
+			if severity != "critical" && severity != "warning" && severity != "info" {
+				errors = append(errors, fmt.Sprintf("%s: severity must be critical, warning or info", prefix))
+			}
+			if policy.Action != "" && !isResendAction(policy.Action) {
+				errors = append(errors, fmt.Sprintf("%s.action must be reply, repost or none, got %q", prefix, policy.Action))
+			}
+			if policy.Interval < 0 || (policy.Interval > 0 && policy.Interval < time.Minute) {
+				errors = append(errors, fmt.Sprintf("%s.interval must be at least 1m, got %s", prefix, policy.Interval))
+			}
+		}
+	}
+
 	// Silence durations validation
 	for _, duration := range c.Alerting.SilenceDurations {
 		if duration <= 0 {
@@ -250,6 +1129,169 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	// Custom fields validation
+	for tenant, fields := range c.CustomFields.Tenants {
+		seen := make(map[string]bool, len(fields))
+		for i, field := range fields {
+			prefix := fmt.Sprintf("custom_fields.tenants.%s[%d]", tenant, i)
+			if field.Name == "" {
+				errors = append(errors, prefix+".name is required")
+			} else if seen[field.Name] {
+				errors = append(errors, fmt.Sprintf("%s: duplicate field name %q", prefix, field.Name))
+			}
+			seen[field.Name] = true
+
+			switch field.Type {
+			case CustomFieldString, CustomFieldNumber:
+			case CustomFieldEnum:
+				if len(field.Values) == 0 {
+					errors = append(errors, prefix+": enum fields require values")
+				}
+			default:
+				errors = append(errors, fmt.Sprintf("%s: invalid type %q (must be string, enum, or number)", prefix, field.Type))
+			}
+
+			if (field.Label == "") == (field.Annotation == "") {
+				errors = append(errors, prefix+": exactly one of label or annotation must be set")
+			}
+		}
+	}
+	if len(c.CustomFields.Tenants) > 1 && c.CustomFields.TenantLabel == "" {
+		errors = append(errors, "custom_fields.tenant_label is required when more than one tenant is configured")
+	}
+
+	// Alert name normalization: each alias maps to exactly one canonical
+	// name, and canonical names are not renamed again
+	canonicalNames := make([]string, 0, len(c.AlertNames))
+	for name := range c.AlertNames {
+		canonicalNames = append(canonicalNames, name)
+	}
+	sort.Strings(canonicalNames)
+	aliasOf := make(map[string]string)
+	for _, name := range canonicalNames {
+		if name == "" {
+			errors = append(errors, "alert_names: canonical name must not be empty")
+			continue
+		}
+		for _, alias := range c.AlertNames[name] {
+			switch {
+			case alias == "":
+				errors = append(errors, fmt.Sprintf("alert_names.%s: alias must not be empty", name))
+			case alias == name:
+				errors = append(errors, fmt.Sprintf("alert_names.%s: alias %q is the canonical name", name, alias))
+			case aliasOf[alias] != "":
+				errors = append(errors, fmt.Sprintf("alert_names.%s: alias %q is already an alias of %q", name, alias, aliasOf[alias]))
+			default:
+				if _, ok := c.AlertNames[alias]; ok {
+					errors = append(errors, fmt.Sprintf("alert_names.%s: alias %q is itself a canonical name", name, alias))
+				}
+				aliasOf[alias] = name
+			}
+		}
+	}
+
+	// Notification templates, parsed and test-executed, including the
+	// files of the templates directory
+	if c.Templates.Dir != "" {
+		if info, err := os.Stat(c.Templates.Dir); err != nil || !info.IsDir() {
+			errors = append(errors, fmt.Sprintf("templates.dir: %q is not a directory", c.Templates.Dir))
+		}
+	}
+	if _, err := notifytemplate.Load(c.Templates.Dir, c.Templates.Inline()); err != nil {
+		errors = append(errors, fmt.Sprintf("templates: %v", err))
+	}
+
+	// Severity mapping
+	switch c.SeverityMapping.Default {
+	case "", "critical", "warning", "info":
+	default:
+		errors = append(errors, fmt.Sprintf("severity_mapping.default: must be critical, warning, or info, got %q", c.SeverityMapping.Default))
+	}
+	mappedSources := make([]string, 0, len(c.SeverityMapping.Sources))
+	for source := range c.SeverityMapping.Sources {
+		mappedSources = append(mappedSources, source)
+	}
+	sort.Strings(mappedSources)
+	for _, source := range mappedSources {
+		if !slices.Contains(SeverityMappingSources, source) {
+			errors = append(errors, fmt.Sprintf("severity_mapping.sources.%s: unknown source, must be one of %s", source, strings.Join(SeverityMappingSources, ", ")))
+			continue
+		}
+		for from, to := range c.SeverityMapping.Sources[source] {
+			switch to {
+			case "critical", "warning", "info":
+			default:
+				errors = append(errors, fmt.Sprintf("severity_mapping.sources.%s[%s]: must be critical, warning, or info, got %q", source, from, to))
+			}
+		}
+	}
+
+	// Report validation
+	seenReports := make(map[string]bool, len(c.Reports))
+	for i, report := range c.Reports {
+		prefix := fmt.Sprintf("reports[%d]", i)
+		if report.Name == "" {
+			errors = append(errors, prefix+".name is required")
+		} else if seenReports[report.Name] {
+			errors = append(errors, fmt.Sprintf("%s: duplicate report name %q", prefix, report.Name))
+		}
+		seenReports[report.Name] = true
+
+		if report.View == "" {
+			errors = append(errors, prefix+".view is required")
+		}
+		if _, err := schedule.ParseCron(report.Schedule); err != nil {
+			errors = append(errors, fmt.Sprintf("%s.schedule: %v", prefix, err))
+		}
+		if _, err := time.LoadLocation(report.Timezone); err != nil {
+			errors = append(errors, fmt.Sprintf("%s.timezone: unknown time zone %q", prefix, report.Timezone))
+		}
+		if report.Period <= 0 {
+			errors = append(errors, fmt.Sprintf("%s.period must be positive, got %s", prefix, report.Period))
+		}
+		if report.SlackChannel == "" && len(report.EmailRecipients) == 0 {
+			errors = append(errors, prefix+" requires slack_channel or email_recipients")
+		}
+		if report.SlackChannel != "" && !c.IsSlackEnabled() {
+			errors = append(errors, prefix+".slack_channel requires slack to be enabled")
+		}
+		if len(report.EmailRecipients) > 0 && !c.IsEmailEnabled() {
+			errors = append(errors, prefix+".email_recipients requires email to be enabled")
+		}
+	}
+
+	// On-call load report validation
+	if _, err := time.LoadLocation(c.OnCallReport.Timezone); err != nil {
+		errors = append(errors, fmt.Sprintf("oncall_report.timezone: unknown time zone %q", c.OnCallReport.Timezone))
+	}
+	if _, _, err := c.OnCallReport.ParseWorkingHours(); err != nil {
+		errors = append(errors, fmt.Sprintf("oncall_report.working_hours: %v", err))
+	}
+	if c.OnCallReport.SlackDM {
+		if !c.IsSlackEnabled() {
+			errors = append(errors, "oncall_report.slack_dm requires slack to be enabled")
+		}
+		if _, err := schedule.ParseCron(c.OnCallReport.DMSchedule); err != nil {
+			errors = append(errors, fmt.Sprintf("oncall_report.dm_schedule: %v", err))
+		}
+	}
+
+	// Response time report validation
+	if c.ResponseTimeReport.Period < 0 {
+		errors = append(errors, "response_time_report.period must not be negative")
+	}
+	if c.ResponseTimeReport.SlackChannel != "" {
+		if !c.IsSlackEnabled() {
+			errors = append(errors, "response_time_report.slack_channel requires slack to be enabled")
+		}
+		if _, err := schedule.ParseCron(c.ResponseTimeReport.Schedule); err != nil {
+			errors = append(errors, fmt.Sprintf("response_time_report.schedule: %v", err))
+		}
+		if _, err := time.LoadLocation(c.ResponseTimeReport.Timezone); err != nil {
+			errors = append(errors, fmt.Sprintf("response_time_report.timezone: unknown time zone %q", c.ResponseTimeReport.Timezone))
+		}
+	}
+
 	// Logging validation
 	if err := ValidateLogLevel(c.Logging.Level); err != nil {
 		errors = append(errors, err.Error())
@@ -267,6 +1309,140 @@ func (c *Config) Validate() error {
 }
 
 // joinErrors joins multiple error messages with newlines and bullets.
+// validateSlackAction checks a custom Slack action.
+func validateSlackAction(action SlackActionConfig, prefix string) []string {
+	var errors []string
+	if !slackActionName.MatchString(action.Name) {
+		errors = append(errors, fmt.Sprintf("%s.name must be letters, digits, '-' and '.', got %q", prefix, action.Name))
+	}
+	if err := ValidateNonEmpty(action.Label, prefix+".label"); err != nil {
+		errors = append(errors, err.Error())
+	}
+	switch {
+	case (action.URL == "") == (action.Webhook == ""):
+		errors = append(errors, fmt.Sprintf("%s must set exactly one of url and webhook", prefix))
+	case action.URL != "":
+		if _, err := template.New("url").Parse(action.URL); err != nil {
+			errors = append(errors, fmt.Sprintf("%s.url is invalid: %v", prefix, err))
+		}
+	default:
+		if u, err := url.Parse(action.Webhook); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errors = append(errors, fmt.Sprintf("%s.webhook must be an http(s) URL, got %q", prefix, action.Webhook))
+		}
+	}
+	switch action.Style {
+	case "", "primary", "danger":
+	default:
+		errors = append(errors, fmt.Sprintf("%s.style must be primary or danger, got %q", prefix, action.Style))
+	}
+	for name, pattern := range action.MatchRE {
+		if _, err := regexp.Compile("^(?:" + pattern + ")$"); err != nil {
+			errors = append(errors, fmt.Sprintf("%s.match_re.%s: %v", prefix, name, err))
+		}
+	}
+	return errors
+}
+
+// routeNotifiers returns the notifiers routes may name, and whether each
+// is enabled.
+func (c *Config) routeNotifiers() map[string]bool {
+	return map[string]bool{
+		"slack":     c.IsSlackEnabled(),
+		"pagerduty": c.IsPagerDutyEnabled(),
+		"teams":     c.IsTeamsEnabled(),
+		"email":     c.IsEmailEnabled(),
+	}
+}
+
+// validateRootRoute checks the root of a routing tree, which matches every
+// alert, and its children.
+func validateRootRoute(root RouteConfig, prefix string, notifiers map[string]bool) []string {
+	var errors []string
+	if len(root.Match) > 0 || len(root.MatchRE) > 0 || root.MinSeverity != "" {
+		errors = append(errors, prefix+" is the root route and matches every alert; it may not set match, match_re or min_severity")
+	}
+	if root.Notifiers == nil {
+		errors = append(errors, prefix+".notifiers is required")
+	}
+	if root.Continue {
+		errors = append(errors, prefix+".continue may not be set on the root route")
+	}
+	return append(errors, validateRoute(root, prefix, notifiers)...)
+}
+
+// validateRoute checks a routing tree node and its children.
+func validateRoute(route RouteConfig, prefix string, notifiers map[string]bool) []string {
+	var errors []string
+	for name, pattern := range route.MatchRE {
+		if _, err := regexp.Compile("^(?:" + pattern + ")$"); err != nil {
+			errors = append(errors, fmt.Sprintf("%s.match_re.%s: %v", prefix, name, err))
+		}
+	}
+	switch route.MinSeverity {
+	case "", "critical", "warning", "info":
+	default:
+		errors = append(errors, fmt.Sprintf("%s.min_severity must be critical, warning, or info, got %q", prefix, route.MinSeverity))
+	}
+	for _, name := range route.Notifiers {
+		if !notifiers[name] {
+			errors = append(errors, fmt.Sprintf("%s.notifiers: %q is not an enabled notifier", prefix, name))
+		}
+	}
+	if route.SlackChannelID != "" && !notifiers["slack"] {
+		errors = append(errors, fmt.Sprintf("%s.slack_channel_id requires slack to be enabled", prefix))
+	}
+	if route.Pipeline != nil {
+		errors = append(errors, validatePipeline(route.Pipeline, prefix+".pipeline", false)...)
+	}
+	for i, child := range route.Routes {
+		errors = append(errors, validateRoute(child, fmt.Sprintf("%s.routes[%d]", prefix, i), notifiers)...)
+	}
+	return errors
+}
+
+// validatePipeline checks a list of pipeline stages. route may only appear
+// in the top-level pipeline, once, and notify only once, as the last stage.
+func validatePipeline(stages []string, field string, topLevel bool) []string {
+	var errors []string
+	counts := make(map[string]int, len(stages))
+	for _, stage := range stages {
+		counts[stage]++
+		switch stage {
+		case "enrich", "custom_fields", "silence", "notify":
+		case "route":
+			if !topLevel {
+				errors = append(errors, fmt.Sprintf("%s: route may not appear in a route's pipeline", field))
+			}
+		default:
+			errors = append(errors, fmt.Sprintf("%s: unknown stage %q, must be enrich, custom_fields, silence, route or notify", field, stage))
+		}
+	}
+	if topLevel && counts["route"] > 1 {
+		errors = append(errors, fmt.Sprintf("%s: route may appear only once", field))
+	}
+	if counts["notify"] > 1 {
+		errors = append(errors, fmt.Sprintf("%s: notify may appear only once", field))
+	}
+	if counts["notify"] > 0 && stages[len(stages)-1] != "notify" {
+		errors = append(errors, fmt.Sprintf("%s: notify must be the last stage", field))
+	}
+	return errors
+}
+
+// routeSetsPipeline reports whether the route or any of its children sets
+// a pipeline.
+func routeSetsPipeline(route RouteConfig) bool {
+	if route.Pipeline != nil {
+		return true
+	}
+	for _, child := range route.Routes {
+		if routeSetsPipeline(child) {
+			return true
+		}
+	}
+	return false
+}
+
 func joinErrors(errors []string) string {
 	if len(errors) == 0 {
 		return ""
@@ -277,3 +1453,7 @@ func joinErrors(errors []string) string {
 	}
 	return result
 }
+
+func isResendAction(action string) bool {
+	return action == "reply" || action == "repost" || action == "none"
+}
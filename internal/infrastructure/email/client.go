@@ -0,0 +1,376 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	domainerrors "github.com/altuslabsxyz/alert-bridge/internal/domain/errors"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/notifytemplate"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/payloadlog"
+)
+
+// defaultTimeout bounds one SMTP delivery when the config sets no timeout.
+const defaultTimeout = 30 * time.Second
+
+// sendFunc delivers one message; tests replace it to capture outgoing mail.
+type sendFunc func(ctx context.Context, addr string, auth smtp.Auth, from string, to []string, msg []byte) error
+
+// Config holds the SMTP settings used by Client.
+type Config struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+
+	// Recipients maps alert severity to addresses.
+	Recipients map[entity.AlertSeverity][]string
+
+	// DefaultRecipients receive alerts whose severity has no entry in Recipients.
+	DefaultRecipients []string
+
+	// Timeout bounds each delivery, from dialing to QUIT. Defaults to 30s.
+	Timeout time.Duration
+}
+
+// Client sends alert notifications by email over SMTP.
+// Implements the alert.Notifier interface.
+//
+// Email cannot be edited after delivery, so UpdateMessage sends a follow-up
+// that replies to the original Message-ID and mail clients thread them together.
+type Client struct {
+	addr              string
+	host              string
+	timeout           time.Duration
+	auth              smtp.Auth
+	from              string
+	envelopeFrom      string
+	fromDomain        string
+	recipients        map[entity.AlertSeverity][]string
+	defaultRecipients []string
+	send              sendFunc
+	now               func() time.Time
+	recorder          *payloadlog.Recorder
+	templates         *notifytemplate.Set
+}
+
+// NewClient creates a new email client.
+func NewClient(cfg Config) (*Client, error) {
+	fromAddr, err := mail.ParseAddress(cfg.From)
+	if err != nil {
+		return nil, fmt.Errorf("parsing from address: %w", err)
+	}
+
+	var auth smtp.Auth
+	if cfg.Username != "" {
+		auth = smtp.PlainAuth("", cfg.Username, cfg.Password, cfg.Host)
+	}
+
+	domain := "alert-bridge"
+	if _, d, ok := strings.Cut(fromAddr.Address, "@"); ok && d != "" {
+		domain = d
+	}
+
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+
+	c := &Client{
+		addr:              net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port)),
+		host:              cfg.Host,
+		timeout:           timeout,
+		auth:              auth,
+		from:              fromAddr.String(),
+		envelopeFrom:      fromAddr.Address,
+		fromDomain:        domain,
+		recipients:        cfg.Recipients,
+		defaultRecipients: cfg.DefaultRecipients,
+		now:               time.Now,
+	}
+	c.send = c.sendMail
+	return c, nil
+}
+
+// Notify sends the initial alert email.
+// Returns the generated Message-ID as reference ID so follow-ups can reply
+// to it, and the recipients as channel.
+func (c *Client) Notify(ctx context.Context, alert *entity.Alert) (entity.NotifyResult, error) {
+	messageID := c.newMessageID(alert)
+	recipients := strings.Join(c.recipientsFor(alert), ", ")
+	if err := c.deliver(ctx, alert, messageID, ""); err != nil {
+		err = categorizeSMTPError(err, "sending alert email")
+		return entity.NotifyResult{Channel: recipients, Retriable: domainerrors.IsTransientError(err)}, err
+	}
+	return entity.NotifyResult{ReferenceID: messageID, Channel: recipients, DeliveredAt: time.Now()}, nil
+}
+
+// UpdateMessage sends a follow-up email (acknowledged, resolved) in reply to
+// the original message.
+func (c *Client) UpdateMessage(ctx context.Context, messageID string, alert *entity.Alert) error {
+	if err := c.deliver(ctx, alert, c.newMessageID(alert), messageID); err != nil {
+		return categorizeSMTPError(err, "sending follow-up email")
+	}
+	return nil
+}
+
+// Capabilities reports none: state changes are sent as follow-up emails.
+func (c *Client) Capabilities() entity.NotifierCapabilities {
+	return entity.NotifierCapabilities{}
+}
+
+// Name returns the notifier identifier.
+func (c *Client) Name() string {
+	return "email"
+}
+
+// recipientsFor returns the recipients for the alert's severity.
+func (c *Client) recipientsFor(alert *entity.Alert) []string {
+	if to, ok := c.recipients[alert.Severity]; ok && len(to) > 0 {
+		return to
+	}
+	return c.defaultRecipients
+}
+
+// deliver renders and sends one email. inReplyTo is empty for the first message.
+func (c *Client) deliver(ctx context.Context, alert *entity.Alert, messageID, inReplyTo string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	to := c.recipientsFor(alert)
+	if len(to) == 0 {
+		return fmt.Errorf("no recipients configured for severity %q", alert.Severity)
+	}
+
+	msg, err := c.buildMessage(alert, to, messageID, inReplyTo)
+	if err != nil {
+		return fmt.Errorf("building message: %w", err)
+	}
+
+	err = c.send(ctx, c.addr, c.auth, c.envelopeFrom, to, msg)
+	c.recorder.Record(c.Name(), "send", messageID, map[string]any{"to": to, "message": string(msg)}, err)
+	return err
+}
+
+// sendMail delivers msg like smtp.SendMail, but gives up once ctx is done
+// or the timeout passes, so a hung or tarpitting server cannot block the
+// notifier.
+func (c *Client) sendMail(ctx context.Context, addr string, auth smtp.Auth, from string, to []string, msg []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// The deadline bounds every read and write; cancellation unblocks them
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return err
+		}
+	}
+	stop := context.AfterFunc(ctx, func() { conn.SetDeadline(time.Now()) })
+	defer stop()
+
+	err = c.converse(conn, auth, from, to, msg)
+	if err != nil && ctx.Err() != nil {
+		return fmt.Errorf("%w: %w", ctx.Err(), err)
+	}
+	return err
+}
+
+// converse runs one SMTP session on conn: STARTTLS when offered, AUTH when
+// configured, then the message.
+func (c *Client) converse(conn net.Conn, auth smtp.Auth, from string, to []string, msg []byte) error {
+	client, err := smtp.NewClient(conn, c.host)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if err := client.Hello("localhost"); err != nil {
+		return err
+	}
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: c.host}); err != nil {
+			return err
+		}
+	}
+	if auth != nil {
+		if ok, _ := client.Extension("AUTH"); !ok {
+			return errors.New("smtp: server doesn't support AUTH")
+		}
+		if err := client.Auth(auth); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(from); err != nil {
+		return err
+	}
+	for _, addr := range to {
+		if err := client.Rcpt(addr); err != nil {
+			return err
+		}
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+// SetRecorder enables recording of outbound alert emails.
+func (c *Client) SetRecorder(recorder *payloadlog.Recorder) {
+	c.recorder = recorder
+}
+
+// SetTemplates sets the notification templates; an email_body template
+// replaces the built-in bodies.
+func (c *Client) SetTemplates(templates *notifytemplate.Set) {
+	c.templates = templates
+}
+
+// buildMessage renders a multipart/alternative message with text and HTML bodies.
+func (c *Client) buildMessage(alert *entity.Alert, to []string, messageID, inReplyTo string) ([]byte, error) {
+	textBody, htmlBody, err := renderBodies(alert)
+	if err != nil {
+		return nil, err
+	}
+	if text, ok := c.templates.Render(notifytemplate.EmailBody, alert); ok {
+		textBody, htmlBody = text, renderTemplatedHTML(text)
+	}
+
+	// Keep the subject stable across follow-ups so clients that thread by
+	// subject group them as well.
+	subject := fmt.Sprintf("[%s] %s", strings.ToUpper(string(alert.Severity)), alert.Name)
+	if alert.Instance != "" {
+		subject += " (" + alert.Instance + ")"
+	}
+	if inReplyTo != "" {
+		subject = "Re: " + subject
+	}
+
+	return c.compose(to, subject, messageID, inReplyTo, textBody, htmlBody)
+}
+
+// compose assembles a multipart/alternative message from rendered bodies.
+func (c *Client) compose(to []string, subject, messageID, inReplyTo, textBody, htmlBody string) ([]byte, error) {
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+
+	var head bytes.Buffer
+	writeHeader := func(key, value string) {
+		fmt.Fprintf(&head, "%s: %s\r\n", key, value)
+	}
+	writeHeader("From", c.from)
+	writeHeader("To", strings.Join(to, ", "))
+	writeHeader("Subject", mime.QEncoding.Encode("utf-8", subject))
+	writeHeader("Date", c.now().Format(time.RFC1123Z))
+	writeHeader("Message-ID", messageID)
+	if inReplyTo != "" {
+		writeHeader("In-Reply-To", inReplyTo)
+		writeHeader("References", inReplyTo)
+	}
+	writeHeader("MIME-Version", "1.0")
+	writeHeader("Content-Type", "multipart/alternative; boundary="+mw.Boundary())
+	head.WriteString("\r\n")
+
+	if err := writePart(mw, "text/plain; charset=utf-8", textBody); err != nil {
+		return nil, err
+	}
+	if err := writePart(mw, "text/html; charset=utf-8", htmlBody); err != nil {
+		return nil, err
+	}
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+
+	return append(head.Bytes(), buf.Bytes()...), nil
+}
+
+// writePart writes a quoted-printable encoded body part.
+func writePart(mw *multipart.Writer, contentType, body string) error {
+	part, err := mw.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {contentType},
+		"Content-Transfer-Encoding": {"quoted-printable"},
+	})
+	if err != nil {
+		return err
+	}
+	qp := quotedprintable.NewWriter(part)
+	if _, err := qp.Write([]byte(body)); err != nil {
+		return err
+	}
+	return qp.Close()
+}
+
+// newMessageID generates a unique RFC 5322 Message-ID for an alert email.
+func (c *Client) newMessageID(alert *entity.Alert) string {
+	var b [8]byte
+	_, _ = rand.Read(b[:])
+	return fmt.Sprintf("<alert-%s.%s@%s>", alert.ID, hex.EncodeToString(b[:]), c.fromDomain)
+}
+
+// categorizeSMTPError wraps SMTP errors as transient or permanent domain errors.
+func categorizeSMTPError(err error, operation string) error {
+	if err == nil {
+		return nil
+	}
+
+	// Network errors and context timeouts are transient
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return domainerrors.NewTransientError(
+			fmt.Sprintf("%s: network error", operation),
+			err,
+		)
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return domainerrors.NewTransientError(
+			fmt.Sprintf("%s: context timeout", operation),
+			err,
+		)
+	}
+
+	// 4xx SMTP replies are temporary failures by definition (RFC 5321)
+	var protoErr *textproto.Error
+	if errors.As(err, &protoErr) && protoErr.Code >= 400 && protoErr.Code < 500 {
+		return domainerrors.NewTransientError(
+			fmt.Sprintf("%s: smtp %d", operation, protoErr.Code),
+			err,
+		)
+	}
+
+	// Default to permanent error
+	return domainerrors.NewPermanentError(
+		fmt.Sprintf("%s: %v", operation, err),
+		err,
+	)
+}
@@ -0,0 +1,250 @@
+package email
+
+import (
+	"context"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/notifytemplate"
+)
+
+type sentMail struct {
+	from string
+	to   []string
+	msg  string
+}
+
+func newTestClient(t *testing.T) (*Client, *[]sentMail) {
+	t.Helper()
+
+	client, err := NewClient(Config{
+		Host: "smtp.example.com",
+		Port: 587,
+		From: "Alert Bridge <alerts@example.com>",
+		Recipients: map[entity.AlertSeverity][]string{
+			entity.SeverityCritical: {"oncall@example.com"},
+		},
+		DefaultRecipients: []string{"team@example.com"},
+	})
+	require.NoError(t, err)
+
+	var sent []sentMail
+	client.send = func(_ context.Context, addr string, auth smtp.Auth, from string, to []string, msg []byte) error {
+		sent = append(sent, sentMail{from: from, to: to, msg: string(msg)})
+		return nil
+	}
+	return client, &sent
+}
+
+func TestClient_RecipientsBySeverity(t *testing.T) {
+	client, sent := newTestClient(t)
+
+	critical := entity.NewAlert("fp1", "HighCPU", "server-1", "node", "CPU high", entity.SeverityCritical)
+	_, err := client.Notify(context.Background(), critical)
+	require.NoError(t, err)
+
+	info := entity.NewAlert("fp2", "DiskInfo", "server-2", "node", "Disk usage", entity.SeverityInfo)
+	_, err = client.Notify(context.Background(), info)
+	require.NoError(t, err)
+
+	require.Len(t, *sent, 2)
+	assert.Equal(t, "alerts@example.com", (*sent)[0].from)
+	assert.Equal(t, []string{"oncall@example.com"}, (*sent)[0].to)
+	assert.Equal(t, []string{"team@example.com"}, (*sent)[1].to)
+}
+
+func TestClient_ResolvedFollowUpRepliesToOriginal(t *testing.T) {
+	client, sent := newTestClient(t)
+
+	alert := entity.NewAlert("fp1", "HighCPU", "server-1", "node", "CPU high", entity.SeverityCritical)
+	result, err := client.Notify(context.Background(), alert)
+	require.NoError(t, err)
+	messageID := result.ReferenceID
+	assert.False(t, result.DeliveredAt.IsZero())
+	assert.True(t, strings.HasPrefix(messageID, "<alert-"+alert.ID+"."))
+	assert.True(t, strings.HasSuffix(messageID, "@example.com>"))
+
+	alert.Resolve(time.Now())
+	require.NoError(t, client.UpdateMessage(context.Background(), messageID, alert))
+
+	require.Len(t, *sent, 2)
+	followUp := (*sent)[1].msg
+	assert.Contains(t, followUp, "In-Reply-To: "+messageID+"\r\n")
+	assert.Contains(t, followUp, "References: "+messageID+"\r\n")
+	assert.Contains(t, followUp, "Subject: Re: [CRITICAL] HighCPU (server-1)\r\n")
+	assert.Contains(t, followUp, "RESOLVED: HighCPU")
+	assert.NotContains(t, followUp, "Message-ID: "+messageID+"\r\n")
+}
+
+func TestClient_SendReport(t *testing.T) {
+	client, sent := newTestClient(t)
+
+	view, err := entity.NewSavedView("payments", "", "U1")
+	require.NoError(t, err)
+	end := time.Date(2024, 5, 20, 9, 0, 0, 0, time.UTC)
+	report := entity.NewAlertReport("weekly-ops", view, end.Add(-7*24*time.Hour), end)
+	report.NewCount = 3
+	report.ResolvedCount = 2
+	report.AckedCount = 1
+	report.MTTA = 12 * time.Minute
+
+	err = client.SendReport(context.Background(), []string{"ops@example.com"}, report)
+	require.NoError(t, err)
+
+	require.Len(t, *sent, 1)
+	msg := (*sent)[0].msg
+	assert.Equal(t, []string{"ops@example.com"}, (*sent)[0].to)
+	assert.Contains(t, msg, "Subject: [Report] weekly-ops (2024-05-20)")
+	assert.Contains(t, msg, "Resolved:  2")
+	assert.Contains(t, msg, "MTTA:      12m0s")
+	assert.Contains(t, msg, "MTTR:      n/a")
+
+	assert.Error(t, client.SendReport(context.Background(), nil, report))
+}
+
+func TestClient_TemplatedBody(t *testing.T) {
+	client, sent := newTestClient(t)
+	templates, err := notifytemplate.Load("", map[string]string{
+		notifytemplate.EmailBody: "{{.Alert.Name}} is {{.Status}}\n<runbook: {{.Annotations.runbook}}>",
+	})
+	require.NoError(t, err)
+	client.SetTemplates(templates)
+
+	alert := entity.NewAlert("fp1", "HighCPU", "server-1", "node", "CPU high", entity.SeverityCritical)
+	alert.AddAnnotation("runbook", "wiki/cpu")
+	_, err = client.Notify(context.Background(), alert)
+	require.NoError(t, err)
+
+	require.Len(t, *sent, 1)
+	msg := (*sent)[0].msg
+	assert.Contains(t, msg, "HighCPU is firing\r\n<runbook: wiki/cpu>")
+	assert.Contains(t, msg, "&lt;runbook: wiki/cpu&gt;")
+	assert.NotContains(t, msg, "Fired at:")
+}
+
+// serveSMTP answers one SMTP session on ln without extensions and returns
+// the message it receives.
+func serveSMTP(t *testing.T, ln net.Listener) <-chan string {
+	t.Helper()
+	received := make(chan string, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		tp := textproto.NewConn(conn)
+		_ = tp.PrintfLine("220 smtp.example.com ready")
+		var data string
+		for {
+			line, err := tp.ReadLine()
+			if err != nil {
+				return
+			}
+			switch verb := strings.ToUpper(strings.Fields(line)[0]); verb {
+			case "EHLO", "HELO", "MAIL", "RCPT":
+				_ = tp.PrintfLine("250 ok")
+			case "DATA":
+				_ = tp.PrintfLine("354 go ahead")
+				lines, err := tp.ReadDotLines()
+				if err != nil {
+					return
+				}
+				data = strings.Join(lines, "\n")
+				_ = tp.PrintfLine("250 queued")
+			case "QUIT":
+				_ = tp.PrintfLine("221 bye")
+				received <- data
+				return
+			default:
+				_ = tp.PrintfLine("502 unknown command")
+			}
+		}
+	}()
+	return received
+}
+
+func TestClient_SendMail(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+	received := serveSMTP(t, ln)
+
+	host, port, err := net.SplitHostPort(ln.Addr().String())
+	require.NoError(t, err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(t, err)
+	client, err := NewClient(Config{
+		Host:              host,
+		Port:              portNum,
+		From:              "alerts@example.com",
+		DefaultRecipients: []string{"team@example.com"},
+	})
+	require.NoError(t, err)
+
+	alert := entity.NewAlert("fp1", "HighCPU", "server-1", "node", "CPU high", entity.SeverityCritical)
+	_, err = client.Notify(context.Background(), alert)
+	require.NoError(t, err)
+
+	select {
+	case msg := <-received:
+		assert.Contains(t, msg, "Subject: [CRITICAL] HighCPU (server-1)")
+	case <-time.After(5 * time.Second):
+		t.Fatal("no message received")
+	}
+}
+
+func TestClient_SendMailTimeout(t *testing.T) {
+	// A server that accepts connections and never answers
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+
+	host, port, err := net.SplitHostPort(ln.Addr().String())
+	require.NoError(t, err)
+	portNum, err := strconv.Atoi(port)
+	require.NoError(t, err)
+	client, err := NewClient(Config{
+		Host:              host,
+		Port:              portNum,
+		From:              "alerts@example.com",
+		DefaultRecipients: []string{"team@example.com"},
+		Timeout:           100 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	alert := entity.NewAlert("fp1", "HighCPU", "server-1", "node", "CPU high", entity.SeverityCritical)
+
+	start := time.Now()
+	result, err := client.Notify(context.Background(), alert)
+	require.Error(t, err)
+	assert.True(t, result.Retriable, "timeouts are transient: %v", err)
+	assert.Less(t, time.Since(start), 2*time.Second)
+
+	// Cancellation ends the session before the timeout
+	client.timeout = time.Minute
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+	start = time.Now()
+	_, err = client.Notify(ctx, alert)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Less(t, time.Since(start), 2*time.Second)
+}
@@ -0,0 +1,129 @@
+package email
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// reportTemplateData is the view passed to the report templates.
+type reportTemplateData struct {
+	Report      *entity.AlertReport
+	ViewName    string
+	Criteria    string
+	PeriodStart string
+	PeriodEnd   string
+	MTTA        string
+	MTTR        string
+	Critical    int
+	Warning     int
+	Info        int
+}
+
+const reportTextTemplateSource = `Alert report: {{.Report.Name}}
+
+View:      {{.ViewName}} ({{.Criteria}})
+Period:    {{.PeriodStart}} - {{.PeriodEnd}}
+
+New:       {{.Report.NewCount}} (critical {{.Critical}}, warning {{.Warning}}, info {{.Info}})
+Resolved:  {{.Report.ResolvedCount}}
+Acked:     {{.Report.AckedCount}}
+MTTA:      {{.MTTA}}
+MTTR:      {{.MTTR}}
+
+Still open: {{.Report.Open.TotalAlerts}} ({{.Report.Open.ActiveCount}} active, {{.Report.Open.AcknowledgedCount}} acknowledged)
+`
+
+const reportHTMLTemplateSource = `<!DOCTYPE html>
+<html>
+<body style="font-family: sans-serif; color: #1d1c1d;">
+<h2 style="margin: 0 0 4px 0;">Alert report: {{.Report.Name}}</h2>
+<p style="margin: 0 0 12px 0; color: #616061;">{{.ViewName}} ({{.Criteria}}) &middot; {{.PeriodStart}} - {{.PeriodEnd}}</p>
+<table style="border-collapse: collapse;">
+  <tr><td style="padding: 2px 12px 2px 0;">New</td><td>{{.Report.NewCount}} (critical {{.Critical}}, warning {{.Warning}}, info {{.Info}})</td></tr>
+  <tr><td style="padding: 2px 12px 2px 0;">Resolved</td><td>{{.Report.ResolvedCount}}</td></tr>
+  <tr><td style="padding: 2px 12px 2px 0;">Acked</td><td>{{.Report.AckedCount}}</td></tr>
+  <tr><td style="padding: 2px 12px 2px 0;">MTTA</td><td>{{.MTTA}}</td></tr>
+  <tr><td style="padding: 2px 12px 2px 0;">MTTR</td><td>{{.MTTR}}</td></tr>
+  <tr><td style="padding: 2px 12px 2px 0;">Still open</td><td>{{.Report.Open.TotalAlerts}} ({{.Report.Open.ActiveCount}} active, {{.Report.Open.AcknowledgedCount}} acknowledged)</td></tr>
+</table>
+</body>
+</html>
+`
+
+var (
+	reportTextTemplate = template.Must(template.New("report_text").Parse(reportTextTemplateSource))
+	reportHTMLTemplate = htmltemplate.Must(htmltemplate.New("report_html").Parse(reportHTMLTemplateSource))
+)
+
+// SendReport emails a scheduled alert report to the given recipients.
+func (c *Client) SendReport(ctx context.Context, to []string, report *entity.AlertReport) error {
+	if err := ctx.Err(); err != nil {
+		return categorizeSMTPError(err, "sending report email")
+	}
+	if len(to) == 0 {
+		return fmt.Errorf("no recipients configured for report %q", report.Name)
+	}
+
+	textBody, htmlBody, err := renderReportBodies(report)
+	if err != nil {
+		return fmt.Errorf("rendering report: %w", err)
+	}
+
+	var b [8]byte
+	_, _ = rand.Read(b[:])
+	messageID := fmt.Sprintf("<report-%s.%s@%s>", report.Name, hex.EncodeToString(b[:]), c.fromDomain)
+	subject := fmt.Sprintf("[Report] %s (%s)", report.Name, report.PeriodEnd.UTC().Format("2006-01-02"))
+
+	msg, err := c.compose(to, subject, messageID, "", textBody, htmlBody)
+	if err != nil {
+		return fmt.Errorf("building message: %w", err)
+	}
+
+	if err := c.send(ctx, c.addr, c.auth, c.envelopeFrom, to, msg); err != nil {
+		return categorizeSMTPError(err, "sending report email")
+	}
+	return nil
+}
+
+// renderReportBodies renders the plain-text and HTML bodies for a report.
+func renderReportBodies(report *entity.AlertReport) (text, html string, err error) {
+	data := reportTemplateData{
+		Report:      report,
+		PeriodStart: formatTime(report.PeriodStart),
+		PeriodEnd:   formatTime(report.PeriodEnd),
+		MTTA:        formatMean(report.MTTA),
+		MTTR:        formatMean(report.MTTR),
+		Critical:    report.NewBySeverity[entity.SeverityCritical],
+		Warning:     report.NewBySeverity[entity.SeverityWarning],
+		Info:        report.NewBySeverity[entity.SeverityInfo],
+	}
+	if report.View != nil {
+		data.ViewName = report.View.Name
+		data.Criteria = report.View.Describe()
+	}
+
+	var textBuf, htmlBuf strings.Builder
+	if err := reportTextTemplate.Execute(&textBuf, data); err != nil {
+		return "", "", err
+	}
+	if err := reportHTMLTemplate.Execute(&htmlBuf, data); err != nil {
+		return "", "", err
+	}
+	return textBuf.String(), htmlBuf.String(), nil
+}
+
+// formatMean formats a mean duration, or "n/a" when there was nothing to average.
+func formatMean(d time.Duration) string {
+	if d == 0 {
+		return "n/a"
+	}
+	return d.Round(time.Second).String()
+}
@@ -0,0 +1,134 @@
+package email
+
+import (
+	htmltemplate "html/template"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// templateData is the view passed to the email templates.
+type templateData struct {
+	Alert      *entity.Alert
+	StatusText string
+	Color      string
+	FiredAt    string
+	AckedAt    string
+	ResolvedAt string
+}
+
+const textTemplateSource = `{{.StatusText}}: {{.Alert.Name}}
+
+Severity:  {{.Alert.Severity}}
+{{- if .Alert.Instance}}
+Instance:  {{.Alert.Instance}}
+{{- end}}
+{{- if .Alert.Target}}
+Target:    {{.Alert.Target}}
+{{- end}}
+Fired at:  {{.FiredAt}}
+{{- if .AckedAt}}
+Acked at:  {{.AckedAt}} by {{.Alert.AckedBy}}
+{{- end}}
+{{- if .ResolvedAt}}
+Resolved:  {{.ResolvedAt}}
+{{- end}}
+{{if .Alert.Summary}}
+{{.Alert.Summary}}
+{{end}}
+{{- if .Alert.Description}}
+{{.Alert.Description}}
+{{end}}
+Alert ID: {{.Alert.ID}}
+`
+
+const htmlTemplateSource = `<!DOCTYPE html>
+<html>
+<body style="font-family: sans-serif; color: #1d1c1d;">
+<div style="border-left: 4px solid {{.Color}}; padding-left: 12px;">
+  <h2 style="margin: 0 0 8px 0;">{{.StatusText}}: {{.Alert.Name}}</h2>
+  {{if .Alert.Summary}}<p style="margin: 0 0 8px 0;"><strong>{{.Alert.Summary}}</strong></p>{{end}}
+  {{if .Alert.Description}}<p style="margin: 0 0 8px 0;">{{.Alert.Description}}</p>{{end}}
+  <table style="border-collapse: collapse;">
+    <tr><td style="padding: 2px 12px 2px 0;">Severity</td><td>{{.Alert.Severity}}</td></tr>
+    {{if .Alert.Instance}}<tr><td style="padding: 2px 12px 2px 0;">Instance</td><td>{{.Alert.Instance}}</td></tr>{{end}}
+    {{if .Alert.Target}}<tr><td style="padding: 2px 12px 2px 0;">Target</td><td>{{.Alert.Target}}</td></tr>{{end}}
+    <tr><td style="padding: 2px 12px 2px 0;">Fired at</td><td>{{.FiredAt}}</td></tr>
+    {{if .AckedAt}}<tr><td style="padding: 2px 12px 2px 0;">Acked at</td><td>{{.AckedAt}} by {{.Alert.AckedBy}}</td></tr>{{end}}
+    {{if .ResolvedAt}}<tr><td style="padding: 2px 12px 2px 0;">Resolved</td><td>{{.ResolvedAt}}</td></tr>{{end}}
+  </table>
+  <p style="color: #616061; font-size: 12px;">Alert ID: {{.Alert.ID}}</p>
+</div>
+</body>
+</html>
+`
+
+var (
+	textTemplate = template.Must(template.New("text").Parse(textTemplateSource))
+	htmlTemplate = htmltemplate.Must(htmltemplate.New("html").Parse(htmlTemplateSource))
+)
+
+// renderTemplatedHTML wraps the text of a user template as the HTML body,
+// keeping its line breaks.
+func renderTemplatedHTML(text string) string {
+	return `<!DOCTYPE html>
+<html>
+<body style="font-family: sans-serif; color: #1d1c1d;">
+<div style="white-space: pre-wrap;">` + htmltemplate.HTMLEscapeString(text) + `</div>
+</body>
+</html>
+`
+}
+
+// renderBodies renders the plain-text and HTML bodies for an alert.
+func renderBodies(alert *entity.Alert) (text, html string, err error) {
+	data := newTemplateData(alert)
+
+	var textBuf, htmlBuf strings.Builder
+	if err := textTemplate.Execute(&textBuf, data); err != nil {
+		return "", "", err
+	}
+	if err := htmlTemplate.Execute(&htmlBuf, data); err != nil {
+		return "", "", err
+	}
+	return textBuf.String(), htmlBuf.String(), nil
+}
+
+func newTemplateData(alert *entity.Alert) templateData {
+	statusText, color := getStatusInfo(alert)
+	data := templateData{
+		Alert:      alert,
+		StatusText: statusText,
+		Color:      color,
+		FiredAt:    formatTime(alert.FiredAt),
+	}
+	if alert.AckedAt != nil {
+		data.AckedAt = formatTime(*alert.AckedAt)
+	}
+	if alert.ResolvedAt != nil {
+		data.ResolvedAt = formatTime(*alert.ResolvedAt)
+	}
+	return data
+}
+
+// getStatusInfo returns the status text and accent color for the alert.
+func getStatusInfo(alert *entity.Alert) (text, color string) {
+	switch {
+	case alert.IsResolved():
+		return "RESOLVED", "#2eb67d"
+	case alert.IsAcked():
+		return "ACKNOWLEDGED", "#ecb22e"
+	case alert.Severity == entity.SeverityCritical:
+		return "CRITICAL", "#e01e5a"
+	case alert.Severity == entity.SeverityWarning:
+		return "WARNING", "#ecb22e"
+	default:
+		return "INFO", "#36c5f0"
+	}
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format("2006-01-02 15:04:05 MST")
+}
@@ -0,0 +1,200 @@
+// Package enrichment calls user-configured HTTP endpoints, such as a CMDB,
+// to add labels and annotations to new alerts before they are notified.
+package enrichment
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/resilience"
+)
+
+// maxResponseSize bounds the response body read from a webhook.
+const maxResponseSize = 1 << 20
+
+// Webhook is one enrichment endpoint.
+type Webhook struct {
+	// Name identifies the webhook in logs and errors.
+	Name string
+	// URL receives a JSON POST with the alert.
+	URL string
+	// Headers are added to every request, e.g. authorization.
+	Headers map[string]string
+	// Timeout bounds each request.
+	Timeout time.Duration
+	// Match selects alerts whose labels have these exact values; empty
+	// calls the webhook for every alert.
+	Match map[string]string
+	// MaxFailures consecutive failures skip the webhook for Cooldown, so
+	// an unreachable endpoint does not delay every alert by its timeout.
+	MaxFailures int
+	Cooldown    time.Duration
+}
+
+// Enricher calls the webhooks for new alerts. Webhooks are called
+// concurrently; a failing webhook adds nothing, but never keeps the alert
+// from being processed.
+type Enricher struct {
+	webhooks []webhook
+	client   *http.Client
+}
+
+type webhook struct {
+	Webhook
+	breaker *resilience.CircuitBreaker
+}
+
+// NewEnricher creates an enricher calling webhooks with client.
+func NewEnricher(client *http.Client, webhooks ...Webhook) *Enricher {
+	e := &Enricher{client: client}
+	for _, w := range webhooks {
+		e.webhooks = append(e.webhooks, webhook{
+			Webhook: w,
+			breaker: resilience.NewCircuitBreaker("enrichment-"+w.Name, w.MaxFailures, w.Cooldown),
+		})
+	}
+	return e
+}
+
+// request is the body POSTed to webhooks.
+type request struct {
+	Alert requestAlert `json:"alert"`
+}
+
+type requestAlert struct {
+	ID          string            `json:"id"`
+	Fingerprint string            `json:"fingerprint"`
+	Name        string            `json:"name"`
+	Instance    string            `json:"instance"`
+	Severity    string            `json:"severity"`
+	Source      string            `json:"source,omitempty"`
+	Summary     string            `json:"summary"`
+	Description string            `json:"description,omitempty"`
+	Labels      map[string]string `json:"labels"`
+	Annotations map[string]string `json:"annotations"`
+	FiredAt     time.Time         `json:"fired_at"`
+}
+
+// response is what webhooks answer with. Both maps are optional.
+type response struct {
+	Labels      map[string]string `json:"labels"`
+	Annotations map[string]string `json:"annotations"`
+}
+
+// Enrich calls every webhook matching alert and returns the labels and
+// annotations they added. When webhooks disagree, the one configured first
+// wins. Failures are returned joined, along with what the other webhooks
+// added.
+func (e *Enricher) Enrich(ctx context.Context, alert *entity.Alert) (map[string]string, map[string]string, error) {
+	body, err := json.Marshal(request{Alert: requestAlert{
+		ID:          alert.ID,
+		Fingerprint: alert.Fingerprint,
+		Name:        alert.Name,
+		Instance:    alert.Instance,
+		Severity:    string(alert.Severity),
+		Source:      alert.Source,
+		Summary:     alert.Summary,
+		Description: alert.Description,
+		Labels:      alert.Labels,
+		Annotations: alert.Annotations,
+		FiredAt:     alert.FiredAt,
+	}})
+	if err != nil {
+		return nil, nil, fmt.Errorf("marshaling enrichment request: %w", err)
+	}
+
+	results := make([]response, len(e.webhooks))
+	errs := make([]error, len(e.webhooks))
+	var wg sync.WaitGroup
+	for i := range e.webhooks {
+		w := &e.webhooks[i]
+		if !w.matches(alert) {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = w.breaker.Execute(ctx, func() error {
+				var err error
+				results[i], err = e.call(ctx, w, body)
+				return err
+			})
+			if errs[i] != nil {
+				errs[i] = fmt.Errorf("%s: %w", w.Name, errs[i])
+			}
+		}()
+	}
+	wg.Wait()
+
+	labels := make(map[string]string)
+	annotations := make(map[string]string)
+	for _, result := range results {
+		merge(labels, result.Labels)
+		merge(annotations, result.Annotations)
+	}
+	return labels, annotations, errors.Join(errs...)
+}
+
+// call POSTs body to one webhook and decodes its answer. 204 adds nothing.
+func (e *Enricher) call(ctx context.Context, w *webhook, body []byte) (response, error) {
+	if w.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, w.Timeout)
+		defer cancel()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return response{}, fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range w.Headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return response{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseSize))
+		return response{}, fmt.Errorf("returned status %d", resp.StatusCode)
+	}
+	var result response
+	if resp.StatusCode == http.StatusNoContent {
+		return result, nil
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(&result); err != nil {
+		return response{}, fmt.Errorf("decoding response: %w", err)
+	}
+	return result, nil
+}
+
+// matches reports whether the webhook is called for alert.
+func (w *webhook) matches(alert *entity.Alert) bool {
+	for name, value := range w.Match {
+		if alert.Labels[name] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// merge adds the non-empty values of from to into, keeping values already
+// there.
+func merge(into, from map[string]string) {
+	for k, v := range from {
+		if _, ok := into[k]; !ok && v != "" {
+			into[k] = v
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package enrichment
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+func testAlert() *entity.Alert {
+	alert := entity.NewAlert("fp-1", "HighCPU", "web-1", "node", "CPU is high", entity.SeverityCritical)
+	alert.AddLabel("service", "checkout")
+	return alert
+}
+
+func TestEnricher_Enrich(t *testing.T) {
+	cmdb := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		var req request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "checkout", req.Alert.Labels["service"])
+
+		_ = json.NewEncoder(w).Encode(response{
+			Labels:      map[string]string{"team": "payments", "service": "ignored"},
+			Annotations: map[string]string{"owner": "payments-oncall@example.com"},
+		})
+	}))
+	defer cmdb.Close()
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(response{Labels: map[string]string{"team": "other", "tier": "1"}})
+	}))
+	defer other.Close()
+	empty := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer empty.Close()
+
+	e := NewEnricher(http.DefaultClient,
+		Webhook{Name: "cmdb", URL: cmdb.URL, Headers: map[string]string{"Authorization": "Bearer secret"}, MaxFailures: 5, Cooldown: time.Minute},
+		Webhook{Name: "other", URL: other.URL, MaxFailures: 5, Cooldown: time.Minute},
+		Webhook{Name: "empty", URL: empty.URL, MaxFailures: 5, Cooldown: time.Minute},
+	)
+
+	labels, annotations, err := e.Enrich(context.Background(), testAlert())
+	require.NoError(t, err)
+	// The first webhook wins; the caller keeps the alert's own labels
+	assert.Equal(t, map[string]string{"team": "payments", "service": "ignored", "tier": "1"}, labels)
+	assert.Equal(t, map[string]string{"owner": "payments-oncall@example.com"}, annotations)
+}
+
+func TestEnricher_Match(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	e := NewEnricher(http.DefaultClient, Webhook{
+		Name: "cmdb", URL: srv.URL, Match: map[string]string{"service": "search"}, MaxFailures: 5, Cooldown: time.Minute,
+	})
+	_, _, err := e.Enrich(context.Background(), testAlert())
+	require.NoError(t, err)
+	assert.Zero(t, calls.Load())
+}
+
+func TestEnricher_FailureTolerance(t *testing.T) {
+	var slowCalls atomic.Int32
+	release := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		slowCalls.Add(1)
+		<-release
+	}))
+	defer slow.Close()
+	defer close(release)
+	broken := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer broken.Close()
+	ok := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(response{Labels: map[string]string{"team": "payments"}})
+	}))
+	defer ok.Close()
+
+	e := NewEnricher(http.DefaultClient,
+		Webhook{Name: "slow", URL: slow.URL, Timeout: 20 * time.Millisecond, MaxFailures: 2, Cooldown: time.Minute},
+		Webhook{Name: "broken", URL: broken.URL, MaxFailures: 2, Cooldown: time.Minute},
+		Webhook{Name: "ok", URL: ok.URL, MaxFailures: 2, Cooldown: time.Minute},
+	)
+
+	labels, _, err := e.Enrich(context.Background(), testAlert())
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "slow:")
+	assert.ErrorContains(t, err, "broken: returned status 500")
+	assert.Equal(t, map[string]string{"team": "payments"}, labels)
+
+	// After MaxFailures the slow webhook is skipped without waiting
+	_, _, _ = e.Enrich(context.Background(), testAlert())
+	labels, _, err = e.Enrich(context.Background(), testAlert())
+	assert.ErrorContains(t, err, "circuit breaker is open")
+	assert.Equal(t, map[string]string{"team": "payments"}, labels)
+	assert.Equal(t, int32(2), slowCalls.Load())
+}
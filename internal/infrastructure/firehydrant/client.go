@@ -0,0 +1,76 @@
+// Package firehydrant posts alert events to the timelines of FireHydrant
+// incidents.
+package firehydrant
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Name identifies FireHydrant as a tool alerts are linked to, and is the
+// key of the alert's external reference holding the incident ID.
+const Name = "firehydrant"
+
+// Client adds notes to FireHydrant incidents through the REST API.
+type Client struct {
+	apiURL     string
+	token      string
+	httpClient *http.Client
+}
+
+// NewClient creates a client for the API at apiURL, such as
+// https://api.firehydrant.io, authenticating with a bot token.
+func NewClient(apiURL, token string) *Client {
+	return &Client{
+		apiURL:     strings.TrimRight(apiURL, "/"),
+		token:      token,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// SetHTTPClient replaces the default client used to call the API.
+func (c *Client) SetHTTPClient(httpClient *http.Client) {
+	c.httpClient = httpClient
+}
+
+// Name returns the tool identifier.
+func (c *Client) Name() string {
+	return Name
+}
+
+// PostTimelineEvent adds text as a note on the incident's timeline.
+// FireHydrant stamps notes when they are received, so at is not sent.
+func (c *Client) PostTimelineEvent(ctx context.Context, incidentID, text string, at time.Time) error {
+	payload, err := json.Marshal(map[string]string{"body": text})
+	if err != nil {
+		return fmt.Errorf("marshaling note: %w", err)
+	}
+
+	endpoint := c.apiURL + "/v1/incidents/" + url.PathEscape(incidentID) + "/notes"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.token)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("posting firehydrant note: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("firehydrant returned status %d: %s", resp.StatusCode, body)
+	}
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	return nil
+}
@@ -0,0 +1,32 @@
+package firehydrant
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_PostTimelineEvent(t *testing.T) {
+	var body map[string]string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/incidents/inc-1/notes", r.URL.Path)
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL+"/", "token")
+	require.NoError(t, c.PostTimelineEvent(context.Background(), "inc-1", "HighCPU on web-1: acknowledged by alice", time.Now()))
+	assert.Equal(t, "HighCPU on web-1: acknowledged by alice", body["body"])
+
+	srv.Config.Handler = http.NotFoundHandler()
+	assert.ErrorContains(t, c.PostTimelineEvent(context.Background(), "inc-1", "text", time.Now()), "status 404")
+}
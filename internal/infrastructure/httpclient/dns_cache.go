@@ -0,0 +1,98 @@
+package httpclient
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+)
+
+// resolver is the part of net.Resolver the cache uses.
+type resolver interface {
+	LookupHost(ctx context.Context, host string) ([]string, error)
+}
+
+type dnsEntry struct {
+	addrs   []string
+	expires time.Time
+}
+
+// dnsCache keeps resolved addresses for a fixed TTL. Failed lookups are not
+// cached.
+type dnsCache struct {
+	resolver resolver
+	ttl      time.Duration
+	onLookup func(ctx context.Context, cached bool)
+	now      func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]dnsEntry
+}
+
+func newDNSCache(r resolver, ttl time.Duration, onLookup func(ctx context.Context, cached bool)) *dnsCache {
+	return &dnsCache{
+		resolver: r,
+		ttl:      ttl,
+		onLookup: onLookup,
+		now:      time.Now,
+		entries:  make(map[string]dnsEntry),
+	}
+}
+
+// lookup returns the addresses of host, from the cache if they have not
+// expired.
+func (c *dnsCache) lookup(ctx context.Context, host string) ([]string, error) {
+	now := c.now()
+
+	c.mu.Lock()
+	entry, ok := c.entries[host]
+	c.mu.Unlock()
+	if ok && now.Before(entry.expires) {
+		c.onLookup(ctx, true)
+		return entry.addrs, nil
+	}
+
+	addrs, err := c.resolver.LookupHost(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+	c.onLookup(ctx, false)
+
+	c.mu.Lock()
+	c.entries[host] = dnsEntry{addrs: addrs, expires: now.Add(c.ttl)}
+	c.mu.Unlock()
+	return addrs, nil
+}
+
+// dialContext resolves through the cache and dials the addresses in turn
+// until one connects.
+func (c *dnsCache) dialContext(dialer *net.Dialer) func(ctx context.Context, network, addr string) (net.Conn, error) {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		host, port, err := net.SplitHostPort(addr)
+		if err != nil {
+			return nil, err
+		}
+		if net.ParseIP(host) != nil {
+			return dialer.DialContext(ctx, network, addr)
+		}
+
+		addrs, err := c.lookup(ctx, host)
+		if err != nil {
+			return nil, err
+		}
+
+		var lastErr error
+		for _, ip := range addrs {
+			conn, err := dialer.DialContext(ctx, network, net.JoinHostPort(ip, port))
+			if err == nil {
+				return conn, nil
+			}
+			lastErr = err
+		}
+		if lastErr == nil {
+			lastErr = fmt.Errorf("no addresses for %s", host)
+		}
+		return nil, lastErr
+	}
+}
@@ -0,0 +1,112 @@
+// Package httpclient builds the HTTP clients used for outbound calls to
+// Slack, PagerDuty and webhooks. They share one tuned connection pool, so
+// alert storms reuse connections instead of opening new ones per request.
+package httpclient
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/http/httptrace"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/observability"
+)
+
+// Config tunes the shared transport.
+type Config struct {
+	// MaxIdleConns caps idle connections across all hosts.
+	MaxIdleConns int
+
+	// MaxIdleConnsPerHost caps idle connections kept for each host. Go's
+	// default of 2 is what causes churn under bursts.
+	MaxIdleConnsPerHost int
+
+	// IdleConnTimeout closes connections idle for longer.
+	IdleConnTimeout time.Duration
+
+	// DisableHTTP2 restricts connections to HTTP/1.1.
+	DisableHTTP2 bool
+
+	// DNSCacheTTL caches resolved host addresses; zero disables the cache.
+	DNSCacheTTL time.Duration
+}
+
+// Factory hands out clients that share one transport.
+type Factory struct {
+	transport *http.Transport
+	dns       *dnsCache
+	metrics   *observability.Metrics
+}
+
+// NewFactory creates a factory with a transport tuned by cfg. metrics may be
+// nil.
+func NewFactory(cfg Config, metrics *observability.Metrics) *Factory {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConns = cfg.MaxIdleConns
+	transport.MaxIdleConnsPerHost = cfg.MaxIdleConnsPerHost
+	transport.IdleConnTimeout = cfg.IdleConnTimeout
+	transport.ForceAttemptHTTP2 = !cfg.DisableHTTP2
+	if cfg.DisableHTTP2 {
+		// A non-nil, empty map turns off HTTP/2 negotiation
+		transport.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+	}
+
+	f := &Factory{transport: transport, metrics: metrics}
+	if cfg.DNSCacheTTL > 0 {
+		f.dns = newDNSCache(net.DefaultResolver, cfg.DNSCacheTTL, f.recordLookup)
+		transport.DialContext = f.dns.dialContext(dialer)
+	} else {
+		transport.DialContext = dialer.DialContext
+	}
+	return f
+}
+
+// Client returns a client for the named integration. Connections it uses are
+// counted under name.
+func (f *Factory) Client(name string, timeout time.Duration) *http.Client {
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: &tracingTransport{next: f.transport, name: name, metrics: f.metrics},
+	}
+}
+
+// CloseIdleConnections closes every idle pooled connection.
+func (f *Factory) CloseIdleConnections() {
+	f.transport.CloseIdleConnections()
+}
+
+func (f *Factory) recordLookup(ctx context.Context, cached bool) {
+	if f.metrics != nil {
+		f.metrics.RecordOutboundDNSLookup(ctx, cached)
+	}
+}
+
+// tracingTransport records whether each request got a new or reused
+// connection.
+type tracingTransport struct {
+	next    http.RoundTripper
+	name    string
+	metrics *observability.Metrics
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *tracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.metrics == nil {
+		return t.next.RoundTrip(req)
+	}
+
+	ctx := req.Context()
+	trace := &httptrace.ClientTrace{
+		GotConn: func(info httptrace.GotConnInfo) {
+			t.metrics.RecordOutboundConnection(ctx, t.name, info.Reused)
+		},
+	}
+	return t.next.RoundTrip(req.WithContext(httptrace.WithClientTrace(ctx, trace)))
+}
@@ -0,0 +1,125 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httptrace"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeResolver struct {
+	addrs   []string
+	err     error
+	lookups int
+}
+
+func (r *fakeResolver) LookupHost(ctx context.Context, host string) ([]string, error) {
+	r.lookups++
+	return r.addrs, r.err
+}
+
+func TestDNSCache_Lookup(t *testing.T) {
+	resolver := &fakeResolver{addrs: []string{"10.0.0.1"}}
+	var cached []bool
+	cache := newDNSCache(resolver, time.Minute, func(ctx context.Context, hit bool) {
+		cached = append(cached, hit)
+	})
+	now := time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+	cache.now = func() time.Time { return now }
+
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		addrs, err := cache.lookup(ctx, "hooks.slack.com")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"10.0.0.1"}, addrs)
+	}
+	assert.Equal(t, 1, resolver.lookups)
+
+	now = now.Add(2 * time.Minute)
+	_, err := cache.lookup(ctx, "hooks.slack.com")
+	require.NoError(t, err)
+	assert.Equal(t, 2, resolver.lookups)
+	assert.Equal(t, []bool{false, true, true, false}, cached)
+}
+
+func TestDNSCache_FailuresAreNotCached(t *testing.T) {
+	resolver := &fakeResolver{err: errors.New("no such host")}
+	cache := newDNSCache(resolver, time.Minute, func(context.Context, bool) {})
+
+	ctx := context.Background()
+	_, err := cache.lookup(ctx, "events.pagerduty.com")
+	require.Error(t, err)
+	_, err = cache.lookup(ctx, "events.pagerduty.com")
+	require.Error(t, err)
+	assert.Equal(t, 2, resolver.lookups)
+}
+
+func TestDNSCache_Dial(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, "ok")
+	}))
+	defer server.Close()
+
+	_, port, err := net.SplitHostPort(server.Listener.Addr().String())
+	require.NoError(t, err)
+
+	// The first address refuses connections, so the second is dialed
+	resolver := &fakeResolver{addrs: []string{"127.0.0.2", "127.0.0.1"}}
+	cache := newDNSCache(resolver, time.Minute, func(context.Context, bool) {})
+	dial := cache.dialContext(&net.Dialer{Timeout: time.Second})
+
+	conn, err := dial(context.Background(), "tcp", net.JoinHostPort("bridge.internal", port))
+	require.NoError(t, err)
+	_ = conn.Close()
+	assert.Equal(t, 1, resolver.lookups)
+}
+
+func TestFactory_ReusesConnections(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, "ok")
+	}))
+	defer server.Close()
+
+	factory := NewFactory(Config{
+		MaxIdleConns:        10,
+		MaxIdleConnsPerHost: 5,
+		IdleConnTimeout:     time.Minute,
+	}, nil)
+	defer factory.CloseIdleConnections()
+	slackClient := factory.Client("slack", time.Second)
+	teamsClient := factory.Client("teams", time.Second)
+
+	var reused []bool
+	trace := &httptrace.ClientTrace{
+		GotConn: func(info httptrace.GotConnInfo) { reused = append(reused, info.Reused) },
+	}
+	for _, client := range []*http.Client{slackClient, slackClient, teamsClient} {
+		req, err := http.NewRequestWithContext(httptrace.WithClientTrace(context.Background(), trace), http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}
+
+	// Clients share the pool, so only the first request dials
+	assert.Equal(t, []bool{false, true, true}, reused)
+}
+
+func TestNewFactory_DisableHTTP2(t *testing.T) {
+	factory := NewFactory(Config{DisableHTTP2: true}, nil)
+	assert.False(t, factory.transport.ForceAttemptHTTP2)
+	assert.NotNil(t, factory.transport.TLSNextProto)
+	assert.Empty(t, factory.transport.TLSNextProto)
+
+	factory = NewFactory(Config{}, nil)
+	assert.True(t, factory.transport.ForceAttemptHTTP2)
+}
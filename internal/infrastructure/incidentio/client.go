@@ -0,0 +1,91 @@
+// Package incidentio posts alert events to the timelines of incident.io
+// incidents.
+package incidentio
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Name identifies incident.io as a tool alerts are linked to, and is the
+// key of the alert's external reference holding the incident ID.
+const Name = "incidentio"
+
+// timelineItemsPath is the API endpoint creating timeline items.
+const timelineItemsPath = "/v2/incident_timeline_items"
+
+// Client adds timeline items to incident.io incidents through the REST API.
+type Client struct {
+	apiURL     string
+	token      string
+	httpClient *http.Client
+}
+
+// NewClient creates a client for the API at apiURL, such as
+// https://api.incident.io, authenticating with an API key.
+func NewClient(apiURL, token string) *Client {
+	return &Client{
+		apiURL:     strings.TrimRight(apiURL, "/"),
+		token:      token,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// SetHTTPClient replaces the default client used to call the API.
+func (c *Client) SetHTTPClient(httpClient *http.Client) {
+	c.httpClient = httpClient
+}
+
+// Name returns the tool identifier.
+func (c *Client) Name() string {
+	return Name
+}
+
+// timelineItem is the body of a timeline item request.
+type timelineItem struct {
+	IncidentID  string    `json:"incident_id"`
+	Title       string    `json:"title"`
+	Description string    `json:"description,omitempty"`
+	OccurredAt  time.Time `json:"occurred_at"`
+}
+
+// PostTimelineEvent adds text to the incident's timeline as of at. The
+// first line becomes the item's title and the rest its description.
+func (c *Client) PostTimelineEvent(ctx context.Context, incidentID, text string, at time.Time) error {
+	title, description, _ := strings.Cut(text, "\n")
+	payload, err := json.Marshal(timelineItem{
+		IncidentID:  incidentID,
+		Title:       title,
+		Description: description,
+		OccurredAt:  at.UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("marshaling timeline item: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.apiURL+timelineItemsPath, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.token)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("posting incident.io timeline item: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("incident.io returned status %d: %s", resp.StatusCode, body)
+	}
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	return nil
+}
@@ -0,0 +1,40 @@
+package incidentio
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_PostTimelineEvent(t *testing.T) {
+	var item timelineItem
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, timelineItemsPath, r.URL.Path)
+		assert.Equal(t, "Bearer key", r.Header.Get("Authorization"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&item))
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer srv.Close()
+
+	at := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	c := NewClient(srv.URL, "key")
+	require.NoError(t, c.PostTimelineEvent(context.Background(), "01HX", "HighCPU on web-1: note from alice\nfailover started", at))
+	assert.Equal(t, timelineItem{
+		IncidentID:  "01HX",
+		Title:       "HighCPU on web-1: note from alice",
+		Description: "failover started",
+		OccurredAt:  at,
+	}, item)
+
+	srv.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"type":"validation_error"}`, http.StatusUnprocessableEntity)
+	})
+	assert.ErrorContains(t, c.PostTimelineEvent(context.Background(), "01HX", "text", at), "status 422")
+}
@@ -0,0 +1,205 @@
+// Package jsonmap evaluates the expressions used to map arbitrary JSON
+// webhook payloads to alerts.
+//
+// An expression is either a path or a template:
+//
+//   - Paths start with "$" and select a value: "$.monitor.name",
+//     "$.tags[0]", "$.labels[\"app.kubernetes.io/name\"]".
+//   - Anything else is a Go text/template evaluated against the document,
+//     e.g. "{{.host}}:{{.port}}" or "{{lower .level}}". A string without
+//     "{{" is a constant.
+//
+// Missing values evaluate to the empty string rather than an error, since
+// webhook payloads routinely omit optional fields.
+package jsonmap
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// Expr is a compiled expression.
+type Expr struct {
+	src  string
+	path []segment
+	tmpl *template.Template
+}
+
+// segment is one step of a path: a map key or an array index.
+type segment struct {
+	key   string
+	index int
+	isIdx bool
+}
+
+// funcs are available to template expressions.
+var funcs = template.FuncMap{
+	"lower": strings.ToLower,
+	"upper": strings.ToUpper,
+	"trim":  strings.TrimSpace,
+	"default": func(def string, v any) string {
+		if s := Stringify(v); s != "" {
+			return s
+		}
+		return def
+	},
+	"join": func(sep string, v any) string {
+		items, _ := v.([]any)
+		parts := make([]string, 0, len(items))
+		for _, item := range items {
+			parts = append(parts, Stringify(item))
+		}
+		return strings.Join(parts, sep)
+	},
+}
+
+// Compile parses an expression.
+func Compile(src string) (*Expr, error) {
+	if strings.HasPrefix(src, "$") {
+		path, err := parsePath(src)
+		if err != nil {
+			return nil, err
+		}
+		return &Expr{src: src, path: path}, nil
+	}
+
+	tmpl, err := template.New("expr").Funcs(funcs).Option("missingkey=zero").Parse(src)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template %q: %w", src, err)
+	}
+	return &Expr{src: src, tmpl: tmpl}, nil
+}
+
+// MustCompile is like Compile but panics on error. For tests and constants.
+func MustCompile(src string) *Expr {
+	e, err := Compile(src)
+	if err != nil {
+		panic(err)
+	}
+	return e
+}
+
+// String returns the expression source.
+func (e *Expr) String() string {
+	return e.src
+}
+
+// IsPath reports whether the expression is a path (and so can select
+// non-string values with Select).
+func (e *Expr) IsPath() bool {
+	return e.tmpl == nil
+}
+
+// Eval evaluates the expression against doc and returns its string form.
+func (e *Expr) Eval(doc any) (string, error) {
+	if e.tmpl == nil {
+		return Stringify(e.Select(doc)), nil
+	}
+
+	var b strings.Builder
+	if err := e.tmpl.Execute(&b, doc); err != nil {
+		return "", fmt.Errorf("evaluating %q: %w", e.src, err)
+	}
+	// missingkey=zero renders absent map entries as "<no value>"
+	return strings.ReplaceAll(b.String(), "<no value>", ""), nil
+}
+
+// Select returns the raw value a path expression points at, or nil if any
+// step is missing. Template expressions select their rendered string.
+func (e *Expr) Select(doc any) any {
+	if e.tmpl != nil {
+		s, err := e.Eval(doc)
+		if err != nil {
+			return nil
+		}
+		return s
+	}
+
+	cur := doc
+	for _, seg := range e.path {
+		switch v := cur.(type) {
+		case map[string]any:
+			if seg.isIdx {
+				return nil
+			}
+			cur = v[seg.key]
+		case []any:
+			if !seg.isIdx || seg.index < 0 || seg.index >= len(v) {
+				return nil
+			}
+			cur = v[seg.index]
+		default:
+			return nil
+		}
+	}
+	return cur
+}
+
+// Stringify converts a decoded JSON value to a string. Objects and arrays
+// are re-encoded as JSON; null is the empty string.
+func Stringify(v any) string {
+	switch x := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return x
+	case json.Number:
+		return x.String()
+	case float64:
+		return strconv.FormatFloat(x, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(x)
+	default:
+		b, err := json.Marshal(x)
+		if err != nil {
+			return fmt.Sprint(x)
+		}
+		return string(b)
+	}
+}
+
+// parsePath parses "$", "$.a.b", "$.a[0]" and "$[\"a.b\"]" forms.
+func parsePath(src string) ([]segment, error) {
+	var segs []segment
+	rest := src[1:]
+
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("invalid path %q: empty key", src)
+			}
+			segs = append(segs, segment{key: rest[:end]})
+			rest = rest[end:]
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("invalid path %q: unclosed [", src)
+			}
+			inner := rest[1:end]
+			rest = rest[end+1:]
+
+			if unquoted, err := strconv.Unquote(inner); err == nil {
+				segs = append(segs, segment{key: unquoted})
+				continue
+			}
+			n, err := strconv.Atoi(inner)
+			if err != nil {
+				return nil, fmt.Errorf("invalid path %q: bad index %q", src, inner)
+			}
+			segs = append(segs, segment{index: n, isIdx: true})
+		default:
+			return nil, fmt.Errorf("invalid path %q: expected . or [ at %q", src, rest)
+		}
+	}
+
+	return segs, nil
+}
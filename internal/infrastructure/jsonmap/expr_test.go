@@ -0,0 +1,79 @@
+package jsonmap
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testDoc = `{
+  "monitor": {"id": 42, "name": "api", "up": false},
+  "tags": ["prod", "eu"],
+  "labels": {"app.kubernetes.io/name": "checkout"},
+  "level": "CRITICAL",
+  "missing": null
+}`
+
+func decode(t *testing.T, s string) any {
+	t.Helper()
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+	var doc any
+	require.NoError(t, dec.Decode(&doc))
+	return doc
+}
+
+func TestExpr_Eval(t *testing.T) {
+	doc := decode(t, testDoc)
+
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"$.monitor.name", "api"},
+		{"$.monitor.id", "42"},
+		{"$.monitor.up", "false"},
+		{"$.tags[1]", "eu"},
+		{`$.labels["app.kubernetes.io/name"]`, "checkout"},
+		{"$.tags", `["prod","eu"]`},
+		{"$.missing", ""},
+		{"$.nope.deeper", ""},
+		{"$.tags[5]", ""},
+		{"constant", "constant"},
+		{"{{.monitor.name}}-{{.monitor.id}}", "api-42"},
+		{"{{lower .level}}", "critical"},
+		{"{{.nope}}", ""},
+		{`{{default "unknown" .nope}}`, "unknown"},
+		{`{{join "," .tags}}`, "prod,eu"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			e, err := Compile(tt.expr)
+			require.NoError(t, err)
+			got, err := e.Eval(doc)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestExpr_Select(t *testing.T) {
+	doc := decode(t, testDoc)
+
+	tags, ok := MustCompile("$.tags").Select(doc).([]any)
+	require.True(t, ok)
+	assert.Len(t, tags, 2)
+
+	assert.Equal(t, doc, MustCompile("$").Select(doc))
+}
+
+func TestCompile_Invalid(t *testing.T) {
+	for _, src := range []string{"$..a", "$.a[", "$.a[x]", "$a", "{{.a"} {
+		_, err := Compile(src)
+		assert.Error(t, err, src)
+	}
+}
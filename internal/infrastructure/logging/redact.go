@@ -0,0 +1,135 @@
+// Package logging provides slog handlers shared by the application's
+// loggers.
+package logging
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Redacted replaces secret values in log output.
+const Redacted = "[REDACTED]"
+
+// minSecretLength is the shortest configured secret that is redacted.
+// Shorter values would mangle unrelated text.
+const minSecretLength = 6
+
+// sensitiveKeys are attribute keys whose values are always redacted. "token"
+// is not among them: API auth logs the token's name under that key.
+var sensitiveKeys = map[string]bool{
+	"authorization": true,
+	"password":      true,
+	"routing_key":   true,
+	"api_key":       true,
+	"access_token":  true,
+	"secret":        true,
+}
+
+// tokenPatterns match credentials that are recognisable without
+// configuration. The first group, if any, is kept.
+var tokenPatterns = []*regexp.Regexp{
+	// Slack bot, user and app-level tokens
+	regexp.MustCompile(`()\bxox[a-z]-[0-9A-Za-z-]+`),
+	regexp.MustCompile(`()\bxapp-[0-9A-Za-z-]+`),
+	// Authorization header values, including PagerDuty's "Token token=..."
+	regexp.MustCompile(`(?i)(\bbearer\s+)[^\s"',]+`),
+	// key=value pairs in logged URLs and form bodies
+	regexp.MustCompile(`(?i)(\b(?:routing_key|integration_key|api_key|access_token|token|secret|password|sig)=)[^"&\s,]+`),
+	// "key": "value" pairs in logged JSON bodies
+	regexp.MustCompile(`(?i)("(?:routing_key|integration_key|api_key|access_token|token|secret|password)"\s*:\s*")[^"]+`),
+}
+
+// RedactingHandler removes secrets from log records before passing them to
+// the next handler. It scrubs the message, string attributes and the text of
+// errors and other values, and drops the values of sensitiveKeys entirely.
+type RedactingHandler struct {
+	next    slog.Handler
+	secrets []string
+}
+
+// NewRedactingHandler wraps next. Any secrets given are redacted wherever
+// they appear, in addition to well-known token formats.
+func NewRedactingHandler(next slog.Handler, secrets ...string) *RedactingHandler {
+	h := &RedactingHandler{next: next}
+	for _, s := range secrets {
+		if len(s) >= minSecretLength {
+			h.secrets = append(h.secrets, s)
+		}
+	}
+	// Longest first, so a secret containing another is replaced whole
+	sort.Slice(h.secrets, func(i, j int) bool {
+		return len(h.secrets[i]) > len(h.secrets[j])
+	})
+	return h
+}
+
+// Enabled reports whether the next handler handles level.
+func (h *RedactingHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+// Handle redacts the record and passes it on.
+func (h *RedactingHandler) Handle(ctx context.Context, record slog.Record) error {
+	out := slog.NewRecord(record.Time, record.Level, h.Scrub(record.Message), record.PC)
+	record.Attrs(func(a slog.Attr) bool {
+		out.AddAttrs(h.redactAttr(a))
+		return true
+	})
+	return h.next.Handle(ctx, out)
+}
+
+// WithAttrs returns a handler whose pre-set attributes are redacted.
+func (h *RedactingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	redacted := make([]slog.Attr, len(attrs))
+	for i, a := range attrs {
+		redacted[i] = h.redactAttr(a)
+	}
+	return &RedactingHandler{next: h.next.WithAttrs(redacted), secrets: h.secrets}
+}
+
+// WithGroup returns a handler that qualifies later attributes with name.
+func (h *RedactingHandler) WithGroup(name string) slog.Handler {
+	return &RedactingHandler{next: h.next.WithGroup(name), secrets: h.secrets}
+}
+
+// Scrub replaces configured secrets and well-known token formats in s.
+func (h *RedactingHandler) Scrub(s string) string {
+	for _, secret := range h.secrets {
+		s = strings.ReplaceAll(s, secret, Redacted)
+	}
+	for _, p := range tokenPatterns {
+		s = p.ReplaceAllString(s, "${1}"+Redacted)
+	}
+	return s
+}
+
+func (h *RedactingHandler) redactAttr(a slog.Attr) slog.Attr {
+	a.Value = a.Value.Resolve()
+	if sensitiveKeys[strings.ToLower(a.Key)] && !a.Value.Equal(slog.StringValue("")) {
+		return slog.String(a.Key, Redacted)
+	}
+
+	switch a.Value.Kind() {
+	case slog.KindString:
+		a.Value = slog.StringValue(h.Scrub(a.Value.String()))
+	case slog.KindGroup:
+		group := a.Value.Group()
+		redacted := make([]slog.Attr, len(group))
+		for i, ga := range group {
+			redacted[i] = h.redactAttr(ga)
+		}
+		a.Value = slog.GroupValue(redacted...)
+	case slog.KindAny:
+		// Errors and structs are only replaced when they contain a secret,
+		// so handlers still see the original value otherwise
+		text := fmt.Sprint(a.Value.Any())
+		if scrubbed := h.Scrub(text); scrubbed != text {
+			a.Value = slog.StringValue(scrubbed)
+		}
+	}
+	return a
+}
@@ -0,0 +1,174 @@
+// Package notifytemplate renders user-supplied Go text/templates for the
+// bodies of Slack messages, PagerDuty summaries and emails.
+package notifytemplate
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"text/template"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// Template names. In a templates directory, each is read from the file of
+// the name with a .tmpl extension, such as slack_message.tmpl.
+const (
+	SlackMessage     = "slack_message"
+	PagerDutySummary = "pagerduty_summary"
+	EmailBody        = "email_body"
+)
+
+// Names lists the templates that can be customized.
+var Names = []string{SlackMessage, PagerDutySummary, EmailBody}
+
+// fileExt is the extension of template files.
+const fileExt = ".tmpl"
+
+// Data is the view templates are executed with.
+type Data struct {
+	Alert *entity.Alert
+
+	// Labels and Annotations are the alert's, as shortcuts.
+	Labels      map[string]string
+	Annotations map[string]string
+
+	// Status is "firing", "acknowledged" or "resolved".
+	Status string
+
+	// Duration is how long the alert has been firing, or fired for once
+	// resolved.
+	Duration time.Duration
+}
+
+// funcs are the functions available to templates besides the built-ins.
+var funcs = template.FuncMap{
+	"duration": formatDuration,
+	"since":    time.Since,
+	"upper":    strings.ToUpper,
+	"lower":    strings.ToLower,
+	"join":     strings.Join,
+	"default": func(def, value string) string {
+		if value == "" {
+			return def
+		}
+		return value
+	},
+}
+
+// Set holds the configured templates. It is safe for concurrent use, and
+// Reload replaces all templates at once. Templates that are not configured,
+// and every template of the nil Set, render nothing, so callers keep their
+// built-in format.
+type Set struct {
+	templates atomic.Pointer[map[string]*template.Template]
+}
+
+// Load parses the templates of dir, if set, and inline, keyed by name,
+// which take precedence over files. Each template is test-executed with a
+// sample alert so that unknown fields are reported now rather than when
+// the first alert is sent.
+func Load(dir string, inline map[string]string) (*Set, error) {
+	s := &Set{}
+	if err := s.Reload(dir, inline); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Reload replaces the templates as Load parses them. On error the current
+// templates are kept.
+func (s *Set) Reload(dir string, inline map[string]string) error {
+	sources := make(map[string]string, len(Names))
+	if dir != "" {
+		for _, name := range Names {
+			data, err := os.ReadFile(filepath.Join(dir, name+fileExt))
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("reading template %s: %w", name, err)
+			}
+			sources[name] = string(data)
+		}
+	}
+	for name, text := range inline {
+		if text != "" {
+			sources[name] = text
+		}
+	}
+
+	sample := sampleData()
+	templates := make(map[string]*template.Template, len(sources))
+	for name, text := range sources {
+		tmpl, err := template.New(name).Funcs(funcs).Option("missingkey=zero").Parse(text)
+		if err != nil {
+			return fmt.Errorf("parsing template %s: %w", name, err)
+		}
+		var buf strings.Builder
+		if err := tmpl.Execute(&buf, sample); err != nil {
+			return fmt.Errorf("executing template %s: %w", name, err)
+		}
+		templates[name] = tmpl
+	}
+	s.templates.Store(&templates)
+	return nil
+}
+
+// Render executes the named template for alert. It returns false when the
+// template is not configured, fails to execute or renders only whitespace,
+// in which case the caller uses its built-in format.
+func (s *Set) Render(name string, alert *entity.Alert) (string, bool) {
+	if s == nil {
+		return "", false
+	}
+	tmpl, ok := (*s.templates.Load())[name]
+	if !ok {
+		return "", false
+	}
+
+	var buf strings.Builder
+	if err := tmpl.Execute(&buf, newData(alert, time.Now())); err != nil {
+		return "", false
+	}
+	text := strings.TrimSpace(buf.String())
+	return text, text != ""
+}
+
+// newData builds the template view of an alert.
+func newData(alert *entity.Alert, now time.Time) Data {
+	data := Data{
+		Alert:       alert,
+		Labels:      alert.Labels,
+		Annotations: alert.Annotations,
+		Status:      "firing",
+		Duration:    now.Sub(alert.FiredAt),
+	}
+	switch {
+	case alert.IsResolved():
+		data.Status = "resolved"
+		if alert.ResolvedAt != nil {
+			data.Duration = alert.ResolvedAt.Sub(alert.FiredAt)
+		}
+	case alert.IsAcked():
+		data.Status = "acknowledged"
+	}
+	return data
+}
+
+// sampleData is the view templates are validated with.
+func sampleData() Data {
+	alert := entity.NewAlert("sample", "SampleAlert", "host-1", "node", "Sample summary", entity.SeverityCritical)
+	alert.AddLabel("alertname", "SampleAlert")
+	alert.AddAnnotation("description", "Sample description")
+	return newData(alert, alert.FiredAt.Add(time.Minute))
+}
+
+// formatDuration formats d rounded to the second, such as "1h5m0s".
+func formatDuration(d time.Duration) string {
+	return d.Round(time.Second).String()
+}
@@ -0,0 +1,71 @@
+package notifytemplate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+func TestSet_Render(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "pagerduty_summary.tmpl"), []byte("from file"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "email_body.tmpl"), []byte("file body"), 0o644))
+
+	set, err := Load(dir, map[string]string{
+		SlackMessage: `*{{.Alert.Name}}* on {{.Labels.cluster | default "unknown"}} ({{.Status}}, {{duration .Duration}}){{with .Annotations.runbook}} <{{.}}|runbook>{{end}}`,
+		EmailBody:    "inline body",
+	})
+	require.NoError(t, err)
+
+	alert := entity.NewAlert("fp", "HighCPU", "server-01", "", "CPU above 90%", entity.SeverityCritical)
+	alert.AddLabel("cluster", "prod-eu")
+	alert.FiredAt = time.Now().Add(-90 * time.Minute)
+	resolvedAt := alert.FiredAt.Add(time.Hour)
+	alert.Resolve(resolvedAt)
+
+	text, ok := set.Render(SlackMessage, alert)
+	assert.True(t, ok)
+	assert.Equal(t, "*HighCPU* on prod-eu (resolved, 1h0m0s)", text)
+
+	// Inline templates take precedence over files
+	text, _ = set.Render(EmailBody, alert)
+	assert.Equal(t, "inline body", text)
+	text, _ = set.Render(PagerDutySummary, alert)
+	assert.Equal(t, "from file", text)
+
+	// Reloading picks up edited files
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "pagerduty_summary.tmpl"), []byte("edited"), 0o644))
+	require.NoError(t, set.Reload(dir, nil))
+	text, _ = set.Render(PagerDutySummary, alert)
+	assert.Equal(t, "edited", text)
+	_, ok = set.Render(SlackMessage, alert)
+	assert.False(t, ok)
+
+	// Unconfigured and nil sets render nothing
+	var disabled *Set
+	_, ok = disabled.Render(SlackMessage, alert)
+	assert.False(t, ok)
+}
+
+func TestLoad_Invalid(t *testing.T) {
+	_, err := Load("", map[string]string{SlackMessage: "{{.Alert.Name"})
+	assert.ErrorContains(t, err, "parsing template slack_message")
+
+	// Unknown fields fail on the sample alert
+	_, err = Load("", map[string]string{PagerDutySummary: "{{.Alert.Nmae}}"})
+	assert.ErrorContains(t, err, "executing template pagerduty_summary")
+
+	// A failed reload keeps the current templates
+	set, err := Load("", map[string]string{EmailBody: "body"})
+	require.NoError(t, err)
+	require.Error(t, set.Reload("", map[string]string{EmailBody: "{{"}))
+	text, ok := set.Render(EmailBody, entity.NewAlert("fp", "HighCPU", "", "", "", entity.SeverityInfo))
+	assert.True(t, ok)
+	assert.Equal(t, "body", text)
+}
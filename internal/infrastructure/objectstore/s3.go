@@ -0,0 +1,110 @@
+// Package objectstore uploads files to S3-compatible object storage: Amazon
+// S3, Google Cloud Storage through its XML API with HMAC keys, MinIO and
+// the like. Requests are signed with AWS Signature Version 4.
+package objectstore
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/sigv4"
+)
+
+// Config locates the bucket and signs requests to it.
+type Config struct {
+	// Endpoint is the base URL of the API, e.g. https://s3.eu-west-1.amazonaws.com.
+	Endpoint string
+
+	// Region signs requests.
+	Region string
+
+	Bucket string
+
+	// Prefix is prepended to every object key.
+	Prefix string
+
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string // For temporary credentials
+
+	// PathStyle puts the bucket in the path rather than the host name.
+	PathStyle bool
+}
+
+// Client uploads objects to one bucket.
+type Client struct {
+	cfg        Config
+	httpClient *http.Client
+	now        func() time.Time
+}
+
+// NewClient creates a client for the bucket of cfg.
+func NewClient(cfg Config, httpClient *http.Client) *Client {
+	return &Client{
+		cfg:        cfg,
+		httpClient: httpClient,
+		now:        time.Now,
+	}
+}
+
+// PutObject uploads body under the configured prefix and key, replacing
+// any object already there.
+func (c *Client) PutObject(ctx context.Context, key, contentType string, body []byte) error {
+	objectURL, err := c.objectURL(key)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, objectURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	c.sign(req, body)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("uploading %s: %w", key, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		// Errors come as a small XML document naming the cause
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("uploading %s: %s: %s", key, resp.Status, strings.TrimSpace(string(detail)))
+	}
+	return nil
+}
+
+// objectURL returns the URL of the object of key.
+func (c *Client) objectURL(key string) (string, error) {
+	endpoint, err := url.Parse(c.cfg.Endpoint)
+	if err != nil {
+		return "", fmt.Errorf("parsing endpoint: %w", err)
+	}
+
+	path := strings.TrimSuffix(endpoint.Path, "/") + "/" + c.cfg.Prefix + key
+	if c.cfg.PathStyle {
+		path = strings.TrimSuffix(endpoint.Path, "/") + "/" + c.cfg.Bucket + "/" + c.cfg.Prefix + key
+	} else {
+		endpoint.Host = c.cfg.Bucket + "." + endpoint.Host
+	}
+	endpoint.Path = path
+	endpoint.RawPath = sigv4.EscapePath(path)
+	return endpoint.String(), nil
+}
+
+// sign adds the Signature Version 4 headers to req.
+func (c *Client) sign(req *http.Request, body []byte) {
+	sigv4.Sign(req, body, sigv4.Credentials{
+		AccessKeyID:     c.cfg.AccessKeyID,
+		SecretAccessKey: c.cfg.SecretAccessKey,
+		SessionToken:    c.cfg.SessionToken,
+	}, c.cfg.Region, "s3", c.now())
+}
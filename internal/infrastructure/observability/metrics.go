@@ -22,20 +22,50 @@ type Metrics struct {
 	AlertsProcessedTotal    metric.Int64Counter
 	AlertProcessingDuration metric.Float64Histogram
 	AlertsActiveGauge       metric.Int64UpDownCounter
+	SilenceMatchesTotal     metric.Int64Counter
+	SilencesActive          metric.Int64Gauge
+	SilencesExpiringSoon    metric.Int64Gauge
+	AlertsShardQueued       metric.Int64UpDownCounter
+	AlertsFiringCacheHits   metric.Int64Counter
+
+	// Webhook ingestion metrics (labeled by source handler)
+	WebhookPayloadsTotal        metric.Int64Counter
+	WebhookAlertsReceivedTotal  metric.Int64Counter
+	WebhookParseFailuresTotal   metric.Int64Counter
+	WebhookAlertsPerPayload     metric.Int64Histogram
+	WebhookTruncatedAlertsTotal metric.Int64Counter
+	WebhookAuthFailuresTotal    metric.Int64Counter
+	WebhookPausedTotal          metric.Int64Counter
+	WebhookQueued               metric.Int64UpDownCounter
+	WebhookQueueRejectedTotal   metric.Int64Counter
+	IngestToNotifyDuration      metric.Float64Histogram
 
 	// Notification metrics
 	NotificationsSentTotal   metric.Int64Counter
 	NotificationDuration     metric.Float64Histogram
 	NotificationRetriesTotal metric.Int64Counter
 	NotificationErrorsTotal  metric.Int64Counter
+	NotificationQueueTotal   metric.Int64Counter
+	EscalationsTotal         metric.Int64Counter
+	SuppressedTotal          metric.Int64Counter
+	SlackPostsQueued         metric.Int64UpDownCounter
+	SlackPostDelay           metric.Float64Histogram
 
 	// Acknowledgment metrics
 	AcknowledgmentsSyncedTotal metric.Int64Counter
 	AcknowledgmentErrorsTotal  metric.Int64Counter
+	AcknowledgmentSyncDuration metric.Float64Histogram
 
 	// Repository metrics
 	RepositoryOperationsTotal   metric.Int64Counter
 	RepositoryOperationDuration metric.Float64Histogram
+	StorageDegraded             metric.Int64Gauge
+	StorageBufferedWrites       metric.Int64Gauge
+	StorageRetentionPurgedTotal metric.Int64Counter
+
+	// Outbound HTTP metrics
+	OutboundConnectionsTotal metric.Int64Counter
+	OutboundDNSLookupsTotal  metric.Int64Counter
 }
 
 // NewMetrics creates and registers all application metrics.
@@ -91,6 +121,24 @@ func NewMetrics(meter metric.Meter) (*Metrics, error) {
 		return nil, fmt.Errorf("creating alert_processing_duration: %w", err)
 	}
 
+	m.AlertsShardQueued, err = meter.Int64UpDownCounter(
+		"alerts.shard.queued",
+		metric.WithDescription("Alerts waiting for their processing shard, by shard"),
+		metric.WithUnit("{alerts}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating alerts_shard_queued: %w", err)
+	}
+
+	m.AlertsFiringCacheHits, err = meter.Int64Counter(
+		"alerts.firing_cache.hits.total",
+		metric.WithDescription("Duplicate firing alerts recognized from the firing fingerprint cache without a storage query"),
+		metric.WithUnit("{alerts}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating alerts_firing_cache_hits_total: %w", err)
+	}
+
 	m.AlertsActiveGauge, err = meter.Int64UpDownCounter(
 		"alerts.active",
 		metric.WithDescription("Number of active alerts"),
@@ -100,6 +148,124 @@ func NewMetrics(meter metric.Meter) (*Metrics, error) {
 		return nil, fmt.Errorf("creating alerts_active: %w", err)
 	}
 
+	m.SilenceMatchesTotal, err = meter.Int64Counter(
+		"silences.matched.total",
+		metric.WithDescription("Total number of alerts suppressed by an active silence"),
+		metric.WithUnit("{alerts}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating silences_matched_total: %w", err)
+	}
+
+	m.SilencesActive, err = meter.Int64Gauge(
+		"silences.active",
+		metric.WithDescription("Number of active silences"),
+		metric.WithUnit("{silences}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating silences_active: %w", err)
+	}
+
+	m.SilencesExpiringSoon, err = meter.Int64Gauge(
+		"silences.expiring",
+		metric.WithDescription("Number of active silences expiring within the next hour"),
+		metric.WithUnit("{silences}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating silences_expiring: %w", err)
+	}
+
+	// Webhook ingestion metrics
+	m.WebhookPayloadsTotal, err = meter.Int64Counter(
+		"webhook.payloads.total",
+		metric.WithDescription("Total number of webhook payloads received"),
+		metric.WithUnit("{payloads}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating webhook_payloads_total: %w", err)
+	}
+
+	m.WebhookAlertsReceivedTotal, err = meter.Int64Counter(
+		"webhook.alerts_received.total",
+		metric.WithDescription("Total number of alerts or events received in parsed webhook payloads"),
+		metric.WithUnit("{alerts}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating webhook_alerts_received_total: %w", err)
+	}
+
+	m.WebhookParseFailuresTotal, err = meter.Int64Counter(
+		"webhook.parse_failures.total",
+		metric.WithDescription("Total number of webhook payloads that failed to parse"),
+		metric.WithUnit("{payloads}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating webhook_parse_failures_total: %w", err)
+	}
+
+	m.WebhookAlertsPerPayload, err = meter.Int64Histogram(
+		"webhook.alerts_per_payload",
+		metric.WithDescription("Number of alerts or events carried by each webhook payload"),
+		metric.WithUnit("{alerts}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating webhook_alerts_per_payload: %w", err)
+	}
+
+	m.WebhookTruncatedAlertsTotal, err = meter.Int64Counter(
+		"webhook.truncated_alerts.total",
+		metric.WithDescription("Total number of alerts dropped by the sender due to truncation"),
+		metric.WithUnit("{alerts}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating webhook_truncated_alerts_total: %w", err)
+	}
+
+	m.WebhookAuthFailuresTotal, err = meter.Int64Counter(
+		"webhook.auth_failures.total",
+		metric.WithDescription("Total number of webhook requests rejected for a bad signature or token"),
+		metric.WithUnit("{requests}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating webhook_auth_failures_total: %w", err)
+	}
+
+	m.WebhookPausedTotal, err = meter.Int64Counter(
+		"webhook.paused.total",
+		metric.WithDescription("Total number of webhook requests rejected or dropped because ingestion from their source is paused"),
+		metric.WithUnit("{requests}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating webhook_paused_total: %w", err)
+	}
+
+	m.WebhookQueued, err = meter.Int64UpDownCounter(
+		"webhook.queued",
+		metric.WithDescription("Accepted webhook payloads waiting to be processed, by source"),
+		metric.WithUnit("{payloads}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating webhook_queued: %w", err)
+	}
+
+	m.WebhookQueueRejectedTotal, err = meter.Int64Counter(
+		"webhook.queue.rejected.total",
+		metric.WithDescription("Webhook payloads answered 503 because the processing queue was full, by source"),
+		metric.WithUnit("{payloads}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating webhook_queue_rejected_total: %w", err)
+	}
+
+	m.IngestToNotifyDuration, err = meter.Float64Histogram(
+		"webhook.ingest_to_notify.duration",
+		metric.WithDescription("Time from webhook receipt to notifications sent in seconds"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating ingest_to_notify_duration: %w", err)
+	}
+
 	// Notification metrics
 	m.NotificationsSentTotal, err = meter.Int64Counter(
 		"notifications.sent.total",
@@ -137,6 +303,51 @@ func NewMetrics(meter metric.Meter) (*Metrics, error) {
 		return nil, fmt.Errorf("creating notification_errors_total: %w", err)
 	}
 
+	m.NotificationQueueTotal, err = meter.Int64Counter(
+		"notifications.retry_queue.total",
+		metric.WithDescription("Notifications passing through the persistent retry queue, by outcome"),
+		metric.WithUnit("{notifications}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating notifications_retry_queue_total: %w", err)
+	}
+
+	m.EscalationsTotal, err = meter.Int64Counter(
+		"alerts.escalations.total",
+		metric.WithDescription("Escalation pages sent for unacknowledged alerts, by policy, notifier and outcome"),
+		metric.WithUnit("{escalations}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating alerts_escalations_total: %w", err)
+	}
+
+	m.SuppressedTotal, err = meter.Int64Counter(
+		"notifications.suppressed.total",
+		metric.WithDescription("New alerts not posted because their notifier was over its rate limit or budget, by notifier and reason"),
+		metric.WithUnit("{notifications}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating notifications_suppressed_total: %w", err)
+	}
+
+	m.SlackPostsQueued, err = meter.Int64UpDownCounter(
+		"slack.posts.queued",
+		metric.WithDescription("Slack messages waiting for their channel's rate limit, by channel"),
+		metric.WithUnit("{messages}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating slack_posts_queued: %w", err)
+	}
+
+	m.SlackPostDelay, err = meter.Float64Histogram(
+		"slack.post.delay",
+		metric.WithDescription("Time Slack messages waited for their channel's rate limit in seconds, by channel"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating slack_post_delay: %w", err)
+	}
+
 	// Acknowledgment metrics
 	m.AcknowledgmentsSyncedTotal, err = meter.Int64Counter(
 		"acknowledgments.synced.total",
@@ -156,6 +367,15 @@ func NewMetrics(meter metric.Meter) (*Metrics, error) {
 		return nil, fmt.Errorf("creating acknowledgment_errors_total: %w", err)
 	}
 
+	m.AcknowledgmentSyncDuration, err = meter.Float64Histogram(
+		"acknowledgments.sync.duration",
+		metric.WithDescription("Time to sync an acknowledgment to all connected systems in seconds"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating acknowledgment_sync_duration: %w", err)
+	}
+
 	// Repository metrics
 	m.RepositoryOperationsTotal, err = meter.Int64Counter(
 		"repository.operations.total",
@@ -175,6 +395,51 @@ func NewMetrics(meter metric.Meter) (*Metrics, error) {
 		return nil, fmt.Errorf("creating repository_operation_duration: %w", err)
 	}
 
+	m.StorageDegraded, err = meter.Int64Gauge(
+		"storage.degraded",
+		metric.WithDescription("1 while storage is unavailable and writes are buffered in memory"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating storage_degraded: %w", err)
+	}
+
+	m.StorageBufferedWrites, err = meter.Int64Gauge(
+		"storage.buffered.writes",
+		metric.WithDescription("Number of writes buffered in memory awaiting storage recovery"),
+		metric.WithUnit("{writes}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating storage_buffered_writes: %w", err)
+	}
+
+	m.StorageRetentionPurgedTotal, err = meter.Int64Counter(
+		"storage.retention.purged.total",
+		metric.WithDescription("Rows permanently deleted by the retention janitor, by entity"),
+		metric.WithUnit("{rows}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating storage_retention_purged_total: %w", err)
+	}
+
+	// Outbound HTTP metrics
+	m.OutboundConnectionsTotal, err = meter.Int64Counter(
+		"outbound.connections.total",
+		metric.WithDescription("Connections used by outbound requests, by client and whether they were reused"),
+		metric.WithUnit("{connections}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating outbound_connections_total: %w", err)
+	}
+
+	m.OutboundDNSLookupsTotal, err = meter.Int64Counter(
+		"outbound.dns.lookups.total",
+		metric.WithDescription("Host lookups for outbound connections, by whether the DNS cache answered"),
+		metric.WithUnit("{lookups}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating outbound_dns_lookups_total: %w", err)
+	}
+
 	return m, nil
 }
 
@@ -203,6 +468,87 @@ func (m *Metrics) RecordAlertProcessed(ctx context.Context, alertName, severity,
 	m.AlertProcessingDuration.Record(ctx, duration.Seconds(), metric.WithAttributes(attrs...))
 }
 
+// RecordShardQueued adds delta to the alerts waiting for a processing
+// shard.
+func (m *Metrics) RecordShardQueued(ctx context.Context, shard string, delta int64) {
+	m.AlertsShardQueued.Add(ctx, delta, metric.WithAttributes(attribute.String("shard", shard)))
+}
+
+// RecordFiringCacheHit records a duplicate firing alert recognized from the
+// firing fingerprint cache.
+func (m *Metrics) RecordFiringCacheHit(ctx context.Context, severity string) {
+	m.AlertsFiringCacheHits.Add(ctx, 1, metric.WithAttributes(attribute.String("severity", severity)))
+}
+
+// RecordWebhookPayload records a successfully parsed webhook payload.
+// alertCount is the number of alerts (or events) in the payload and
+// truncated is the number the sender reported as dropped.
+func (m *Metrics) RecordWebhookPayload(ctx context.Context, source string, alertCount, truncated int) {
+	attrs := metric.WithAttributes(attribute.String("source", source))
+
+	m.WebhookPayloadsTotal.Add(ctx, 1, attrs)
+	m.WebhookAlertsReceivedTotal.Add(ctx, int64(alertCount), attrs)
+	m.WebhookAlertsPerPayload.Record(ctx, int64(alertCount), attrs)
+
+	if truncated > 0 {
+		m.WebhookTruncatedAlertsTotal.Add(ctx, int64(truncated), attrs)
+	}
+}
+
+// RecordWebhookParseFailure records a webhook payload that could not be parsed.
+func (m *Metrics) RecordWebhookParseFailure(ctx context.Context, source string) {
+	m.WebhookParseFailuresTotal.Add(ctx, 1, metric.WithAttributes(attribute.String("source", source)))
+}
+
+// RecordWebhookAuthFailure records a webhook request rejected because its
+// signature, token or credentials did not verify.
+func (m *Metrics) RecordWebhookAuthFailure(ctx context.Context, source string) {
+	m.WebhookAuthFailuresTotal.Add(ctx, 1, metric.WithAttributes(attribute.String("source", source)))
+}
+
+// RecordWebhookPaused records a webhook request turned away because
+// ingestion from its source is paused. mode is "reject" or "drop".
+func (m *Metrics) RecordWebhookPaused(ctx context.Context, source, mode string) {
+	m.WebhookPausedTotal.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("source", source),
+		attribute.String("mode", mode),
+	))
+}
+
+// RecordWebhookQueued adds delta to the webhook payloads of source waiting
+// to be processed.
+func (m *Metrics) RecordWebhookQueued(ctx context.Context, source string, delta int64) {
+	m.WebhookQueued.Add(ctx, delta, metric.WithAttributes(attribute.String("source", source)))
+}
+
+// RecordWebhookQueueRejected records a payload of source turned away
+// because the processing queue was full.
+func (m *Metrics) RecordWebhookQueueRejected(ctx context.Context, source string) {
+	m.WebhookQueueRejectedTotal.Add(ctx, 1, metric.WithAttributes(attribute.String("source", source)))
+}
+
+// RecordSilenceMatch records an alert that was suppressed by the given
+// active silence.
+func (m *Metrics) RecordSilenceMatch(ctx context.Context, severity, silenceID string) {
+	m.SilenceMatchesTotal.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("alert.severity", severity),
+		attribute.String("silence.id", silenceID),
+	))
+}
+
+// RecordSilences records the number of active silences and how many of
+// them expire within the next hour.
+func (m *Metrics) RecordSilences(ctx context.Context, active, expiringSoon int) {
+	m.SilencesActive.Record(ctx, int64(active))
+	m.SilencesExpiringSoon.Record(ctx, int64(expiringSoon))
+}
+
+// RecordIngestToNotify records the latency between receiving a webhook and
+// finishing notification delivery for one of its alerts.
+func (m *Metrics) RecordIngestToNotify(ctx context.Context, source string, duration time.Duration) {
+	m.IngestToNotifyDuration.Record(ctx, duration.Seconds(), metric.WithAttributes(attribute.String("source", source)))
+}
+
 // RecordNotificationSent records notification metrics.
 func (m *Metrics) RecordNotificationSent(ctx context.Context, notifier string, success bool, duration time.Duration, retries int) {
 	attrs := []attribute.KeyValue{
@@ -222,13 +568,54 @@ func (m *Metrics) RecordNotificationSent(ctx context.Context, notifier string, s
 	}
 }
 
+// RecordNotificationQueued records a retry queue event for a notifier:
+// queued, delivered, rescheduled, deferred, dropped or dead_lettered.
+func (m *Metrics) RecordNotificationQueued(ctx context.Context, notifier, outcome string) {
+	m.NotificationQueueTotal.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("notifier", notifier),
+		attribute.String("outcome", outcome),
+	))
+}
+
+// RecordEscalation records an escalation page: sent or failed.
+func (m *Metrics) RecordEscalation(ctx context.Context, policy, notifier, outcome string) {
+	m.EscalationsTotal.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("policy", policy),
+		attribute.String("notifier", notifier),
+		attribute.String("outcome", outcome),
+	))
+}
+
+// RecordNotificationSuppressed records a new alert a notifier did not post:
+// rate_limit or budget.
+func (m *Metrics) RecordNotificationSuppressed(ctx context.Context, notifier, reason string) {
+	m.SuppressedTotal.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("notifier", notifier),
+		attribute.String("reason", reason),
+	))
+}
+
+// RecordSlackPostQueued adds delta to the Slack messages waiting for the
+// channel's rate limit.
+func (m *Metrics) RecordSlackPostQueued(ctx context.Context, channel string, delta int64) {
+	m.SlackPostsQueued.Add(ctx, delta, metric.WithAttributes(attribute.String("channel", channel)))
+}
+
+// RecordSlackPostDelayed records how long a Slack message waited for the
+// channel's rate limit.
+func (m *Metrics) RecordSlackPostDelayed(ctx context.Context, channel string, delay time.Duration) {
+	m.SlackPostDelay.Record(ctx, delay.Seconds(), metric.WithAttributes(attribute.String("channel", channel)))
+}
+
 // RecordAcknowledgmentSynced records acknowledgment sync metrics.
-func (m *Metrics) RecordAcknowledgmentSynced(ctx context.Context, source string, syncedSystems int, errors int) {
+// duration covers the whole sync, including every external system.
+func (m *Metrics) RecordAcknowledgmentSynced(ctx context.Context, source string, syncedSystems int, errors int, duration time.Duration) {
 	attrs := []attribute.KeyValue{
 		attribute.String("source", source),
 	}
 
 	m.AcknowledgmentsSyncedTotal.Add(ctx, 1, metric.WithAttributes(attrs...))
+	m.AcknowledgmentSyncDuration.Record(ctx, duration.Seconds(), metric.WithAttributes(attrs...))
 
 	if errors > 0 {
 		errAttrs := append(attrs, attribute.Int("synced_systems", syncedSystems))
@@ -247,3 +634,36 @@ func (m *Metrics) RecordRepositoryOperation(ctx context.Context, operation, enti
 	m.RepositoryOperationsTotal.Add(ctx, 1, metric.WithAttributes(attrs...))
 	m.RepositoryOperationDuration.Record(ctx, duration.Seconds(), metric.WithAttributes(attrs...))
 }
+
+// RecordStorageDegradation records whether storage is degraded and how many
+// writes are buffered.
+func (m *Metrics) RecordStorageDegradation(ctx context.Context, degraded bool, buffered int) {
+	var value int64
+	if degraded {
+		value = 1
+	}
+	m.StorageDegraded.Record(ctx, value)
+	m.StorageBufferedWrites.Record(ctx, int64(buffered))
+}
+
+// RecordRetentionPurged records rows of entity deleted by the retention
+// janitor.
+func (m *Metrics) RecordRetentionPurged(ctx context.Context, entity string, rows int) {
+	m.StorageRetentionPurgedTotal.Add(ctx, int64(rows), metric.WithAttributes(attribute.String("entity", entity)))
+}
+
+// RecordOutboundConnection records the connection an outbound request got.
+func (m *Metrics) RecordOutboundConnection(ctx context.Context, client string, reused bool) {
+	m.OutboundConnectionsTotal.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("client", client),
+		attribute.Bool("reused", reused),
+	))
+}
+
+// RecordOutboundDNSLookup records a host lookup, answered from the DNS cache
+// or resolved.
+func (m *Metrics) RecordOutboundDNSLookup(ctx context.Context, cached bool) {
+	m.OutboundDNSLookupsTotal.Add(ctx, 1, metric.WithAttributes(
+		attribute.Bool("cached", cached),
+	))
+}
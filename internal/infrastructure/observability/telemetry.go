@@ -3,12 +3,20 @@ package observability
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"time"
 
+	promclient "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
 	"go.opentelemetry.io/otel/exporters/prometheus"
 	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
 	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
 	"go.opentelemetry.io/otel/trace"
 	"go.opentelemetry.io/otel/trace/noop"
@@ -24,13 +32,28 @@ type Telemetry struct {
 	TracerProvider trace.TracerProvider
 	MeterProvider  metric.MeterProvider
 	Metrics        *Metrics
+
+	registry *promclient.Registry
+}
+
+// TracingOptions configures span export. The zero value disables tracing.
+type TracingOptions struct {
+	// Endpoint is the full OTLP/HTTP traces URL, e.g.
+	// http://otel-collector:4318/v1/traces. Empty disables tracing.
+	Endpoint string
+	// Headers are sent with every export request, e.g. for collector auth.
+	Headers map[string]string
+	// SampleRatio is the fraction of new traces recorded. Requests that
+	// arrive with a traceparent follow the caller's sampling decision.
+	SampleRatio float64
+	// Timeout bounds each export request.
+	Timeout time.Duration
 }
 
-// NewTelemetry creates and initializes OpenTelemetry telemetry.
-// For now, we use:
-// - NoOp tracer (can be extended to OTLP exporter later)
-// - Prometheus metrics exporter
-func NewTelemetry(serviceName, serviceVersion string) (*Telemetry, error) {
+// NewTelemetry creates and initializes OpenTelemetry telemetry:
+// - Prometheus metrics exporter, served by Handler
+// - OTLP/HTTP span exporter if tracing.Endpoint is set, otherwise a NoOp tracer
+func NewTelemetry(serviceName, serviceVersion string, tracing TracingOptions) (*Telemetry, error) {
 	if serviceName == "" {
 		serviceName = ServiceName
 	}
@@ -47,8 +70,17 @@ func NewTelemetry(serviceName, serviceVersion string) (*Telemetry, error) {
 		return nil, fmt.Errorf("creating resource: %w", err)
 	}
 
-	// Setup metrics with Prometheus exporter
-	prometheusExporter, err := prometheus.New()
+	// Setup metrics with a Prometheus exporter on a dedicated registry, so
+	// /metrics serves exactly what this service registers
+	registry := promclient.NewRegistry()
+	if err := registry.Register(collectors.NewGoCollector()); err != nil {
+		return nil, fmt.Errorf("registering go collector: %w", err)
+	}
+	if err := registry.Register(collectors.NewProcessCollector(collectors.ProcessCollectorOpts{})); err != nil {
+		return nil, fmt.Errorf("registering process collector: %w", err)
+	}
+
+	prometheusExporter, err := prometheus.New(prometheus.WithRegisterer(registry))
 	if err != nil {
 		return nil, fmt.Errorf("creating prometheus exporter: %w", err)
 	}
@@ -67,21 +99,58 @@ func NewTelemetry(serviceName, serviceVersion string) (*Telemetry, error) {
 		return nil, fmt.Errorf("creating metrics: %w", err)
 	}
 
-	// For now, use NoOp tracer (can be extended to OTLP later)
-	tracerProvider := noop.NewTracerProvider()
+	// Setup tracing; W3C trace context is propagated either way so callers'
+	// traceparent headers are honored
+	var tracerProvider trace.TracerProvider = noop.NewTracerProvider()
+	if tracing.Endpoint != "" {
+		opts := []otlptracehttp.Option{otlptracehttp.WithEndpointURL(tracing.Endpoint)}
+		if len(tracing.Headers) > 0 {
+			opts = append(opts, otlptracehttp.WithHeaders(tracing.Headers))
+		}
+		if tracing.Timeout > 0 {
+			opts = append(opts, otlptracehttp.WithTimeout(tracing.Timeout))
+		}
+		// New does not connect; spans are sent by the batcher
+		spanExporter, err := otlptracehttp.New(context.Background(), opts...)
+		if err != nil {
+			return nil, fmt.Errorf("creating span exporter: %w", err)
+		}
+
+		tracerProvider = sdktrace.NewTracerProvider(
+			sdktrace.WithResource(res),
+			sdktrace.WithBatcher(spanExporter),
+			sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(tracing.SampleRatio))),
+		)
+	}
 
-	// Set global tracer provider
+	// Set global tracer provider and propagator
 	otel.SetTracerProvider(tracerProvider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
 
 	return &Telemetry{
 		TracerProvider: tracerProvider,
 		MeterProvider:  meterProvider,
 		Metrics:        metrics,
+		registry:       registry,
 	}, nil
 }
 
-// Shutdown cleanly shuts down the telemetry providers.
+// Handler returns an http.Handler that serves the service's metrics in the
+// Prometheus exposition format.
+func (t *Telemetry) Handler() http.Handler {
+	return promhttp.HandlerFor(t.registry, promhttp.HandlerOpts{})
+}
+
+// Shutdown cleanly shuts down the telemetry providers, flushing buffered spans.
 func (t *Telemetry) Shutdown(ctx context.Context) error {
+	if tp, ok := t.TracerProvider.(*sdktrace.TracerProvider); ok {
+		if err := tp.Shutdown(ctx); err != nil {
+			return fmt.Errorf("shutting down tracer provider: %w", err)
+		}
+	}
 	if mp, ok := t.MeterProvider.(*sdkmetric.MeterProvider); ok {
 		if err := mp.Shutdown(ctx); err != nil {
 			return fmt.Errorf("shutting down meter provider: %w", err)
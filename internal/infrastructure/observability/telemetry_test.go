@@ -0,0 +1,118 @@
+package observability
+
+import (
+	"context"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestTelemetryHandler(t *testing.T) {
+	telemetry, err := NewTelemetry("alert-bridge-test", "test", TracingOptions{})
+	require.NoError(t, err)
+	defer telemetry.Shutdown(context.Background())
+
+	ctx := context.Background()
+	m := telemetry.Metrics
+	m.RecordWebhookPayload(ctx, "alertmanager", 3, 0)
+	m.RecordWebhookAuthFailure(ctx, "grafana")
+	m.RecordSilenceMatch(ctx, "critical", "silence-1")
+	m.RecordSilences(ctx, 3, 1)
+	m.RecordNotificationSent(ctx, "slack", false, time.Second, 1)
+	m.RecordAcknowledgmentSynced(ctx, "slack", 1, 0, 250*time.Millisecond)
+	m.RecordRepositoryOperation(ctx, "save", "alert", time.Millisecond, true)
+
+	rec := httptest.NewRecorder()
+	telemetry.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	body := rec.Body.String()
+	for _, want := range []string{
+		`webhook_alerts_received_total{`,
+		`webhook_auth_failures_total{`,
+		`silences_matched_total{`,
+		`silences_active{`,
+		`silences_expiring{`,
+		`notifications_errors_total{`,
+		`acknowledgments_sync_duration_seconds_bucket{`,
+		`repository_operation_duration_seconds_bucket{`,
+		`go_goroutines`,
+	} {
+		assert.Contains(t, body, want)
+	}
+}
+
+func TestTelemetryExportsSpans(t *testing.T) {
+	var mu sync.Mutex
+	var received []*coltracepb.ExportTraceServiceRequest
+	var header http.Header
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var req coltracepb.ExportTraceServiceRequest
+		require.NoError(t, proto.Unmarshal(body, &req))
+
+		mu.Lock()
+		defer mu.Unlock()
+		header = r.Header
+		received = append(received, &req)
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer collector.Close()
+
+	telemetry, err := NewTelemetry("alert-bridge-test", "test", TracingOptions{
+		Endpoint:    collector.URL + "/v1/traces",
+		Headers:     map[string]string{"Authorization": "Bearer secret"},
+		SampleRatio: 1,
+		Timeout:     time.Second,
+	})
+	require.NoError(t, err)
+
+	tracer := telemetry.TracerProvider.Tracer("test")
+	ctx, parent := tracer.Start(context.Background(), "alert.process")
+	_, child := tracer.Start(ctx, "notify slack")
+	child.SetAttributes(attribute.String("notifier", "slack"))
+	child.SetStatus(codes.Error, "rate limited")
+	child.End()
+	parent.End()
+
+	// Shutdown flushes the batch
+	require.NoError(t, telemetry.Shutdown(context.Background()))
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, received, 1)
+	assert.Equal(t, "Bearer secret", header.Get("Authorization"))
+
+	resourceSpans := received[0].ResourceSpans
+	require.Len(t, resourceSpans, 1)
+	assert.Contains(t, resourceSpans[0].Resource.String(), "alert-bridge-test")
+	require.Len(t, resourceSpans[0].ScopeSpans, 1)
+	assert.Equal(t, "test", resourceSpans[0].ScopeSpans[0].Scope.Name)
+
+	spans := map[string]string{}
+	for _, span := range resourceSpans[0].ScopeSpans[0].Spans {
+		spans[span.Name] = hex.EncodeToString(span.ParentSpanId)
+		if span.Name == "notify slack" {
+			assert.Equal(t, "rate limited", span.Status.Message)
+			require.Len(t, span.Attributes, 1)
+			assert.Equal(t, "slack", span.Attributes[0].Value.GetStringValue())
+		}
+	}
+	assert.Equal(t, map[string]string{
+		"alert.process": "",
+		"notify slack":  parent.SpanContext().SpanID().String(),
+	}, spans)
+}
@@ -10,13 +10,20 @@ import (
 	"net"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/PagerDuty/go-pagerduty"
 
 	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
 	domainerrors "github.com/altuslabsxyz/alert-bridge/internal/domain/errors"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/notifytemplate"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/payloadlog"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/resilience"
 )
 
+// defaultEventsAPIURL is PagerDuty's Events API v2 endpoint.
+const defaultEventsAPIURL = "https://events.pagerduty.com"
+
 // SubscriberNotification represents a notification to be sent for a specific subscriber.
 type SubscriberNotification struct {
 	// SubscriberName is the human-readable name of the subscriber.
@@ -42,6 +49,12 @@ type Client struct {
 	fromEmail       string
 	defaultSeverity string
 	eventsAPIURL    string // Optional: for E2E testing with mock services
+	httpClient      *http.Client
+	recorder        *payloadlog.Recorder
+	name            string
+	links           LinkProvider
+	users           UserDirectory
+	templates       *notifytemplate.Set
 }
 
 // NewClient creates a new PagerDuty client.
@@ -67,14 +80,28 @@ func NewClient(apiToken, routingKey, serviceID, fromEmail, defaultSeverity strin
 		fromEmail:       fromEmail,
 		defaultSeverity: defaultSeverity,
 		eventsAPIURL:    apiURL,
+		name:            "pagerduty",
 	}
 }
 
+// SetName changes the notifier name, which is also the key of the alert's
+// dedup key reference. Used for extra PagerDuty services that alerts are
+// escalated to.
+func (c *Client) SetName(name string) {
+	c.name = name
+}
+
+// SetHTTPClient sends events through httpClient instead of the PagerDuty
+// library's default client, such as one sharing a tuned connection pool.
+func (c *Client) SetHTTPClient(httpClient *http.Client) {
+	c.httpClient = httpClient
+}
+
 // Notify creates a PagerDuty incident for an alert.
-// Returns the incident/dedup key as message ID.
-func (c *Client) Notify(ctx context.Context, alert *entity.Alert) (string, error) {
+// Returns the incident/dedup key as reference ID.
+func (c *Client) Notify(ctx context.Context, alert *entity.Alert) (entity.NotifyResult, error) {
 	if c.routingKey == "" {
-		return "", fmt.Errorf("pagerduty routing key not configured")
+		return entity.NotifyResult{}, fmt.Errorf("pagerduty routing key not configured")
 	}
 
 	// Build the event
@@ -82,6 +109,7 @@ func (c *Client) Notify(ctx context.Context, alert *entity.Alert) (string, error
 		RoutingKey: c.routingKey,
 		Action:     "trigger",
 		DedupKey:   c.buildDedupKey(alert),
+		Links:      c.eventLinks(ctx, alert),
 		Payload: &pagerduty.V2Payload{
 			Summary:   c.buildSummary(alert),
 			Source:    alert.Instance,
@@ -100,23 +128,16 @@ func (c *Client) Notify(ctx context.Context, alert *entity.Alert) (string, error
 	}
 
 	// Send the event
-	var resp *pagerduty.V2EventResponse
-	var err error
-
-	if c.eventsAPIURL != "" {
-		// Use custom Events API endpoint (for E2E testing)
-		resp, err = c.sendEventHTTP(ctx, event)
-	} else {
-		// Use official PagerDuty library
-		resp, err = pagerduty.ManageEventWithContext(ctx, *event)
-	}
+	resp, err := c.manageEvent(ctx, event)
 
+	c.recorder.Record(c.Name(), event.Action, event.DedupKey, event, err)
 	if err != nil {
-		return "", categorizePagerDutyError(err, "sending pagerduty event")
+		err = categorizePagerDutyError(err, "sending pagerduty event")
+		return entity.NotifyResult{Retriable: domainerrors.IsTransientError(err)}, err
 	}
 
 	// Return dedup key as the incident identifier
-	return resp.DedupKey, nil
+	return entity.NotifyResult{ReferenceID: resp.DedupKey, DeliveredAt: time.Now()}, nil
 }
 
 // NotifySubscribersSequentially sends PagerDuty alerts to multiple subscribers
@@ -162,6 +183,7 @@ func (c *Client) notifyWithRoutingKey(ctx context.Context, alert *entity.Alert,
 		RoutingKey: routingKey,
 		Action:     "trigger",
 		DedupKey:   c.buildDedupKey(alert),
+		Links:      c.eventLinks(ctx, alert),
 		Payload: &pagerduty.V2Payload{
 			Summary:   c.buildSummary(alert),
 			Source:    alert.Instance,
@@ -175,15 +197,9 @@ func (c *Client) notifyWithRoutingKey(ctx context.Context, alert *entity.Alert,
 	}
 
 	// Send the event
-	var resp *pagerduty.V2EventResponse
-	var err error
-
-	if c.eventsAPIURL != "" {
-		resp, err = c.sendEventHTTP(ctx, event)
-	} else {
-		resp, err = pagerduty.ManageEventWithContext(ctx, *event)
-	}
+	resp, err := c.manageEvent(ctx, event)
 
+	c.recorder.Record(c.Name(), event.Action, event.DedupKey, event, err)
 	if err != nil {
 		return "", categorizePagerDutyError(err, "sending pagerduty event")
 	}
@@ -220,18 +236,10 @@ func (c *Client) UpdateMessage(ctx context.Context, dedupKey string, alert *enti
 		}
 	}
 
-	if c.eventsAPIURL != "" {
-		// Use custom Events API endpoint (for E2E testing)
-		_, err := c.sendEventHTTP(ctx, event)
-		if err != nil {
-			return categorizePagerDutyError(err, "updating pagerduty event")
-		}
-	} else {
-		// Use official PagerDuty library
-		_, err := pagerduty.ManageEventWithContext(ctx, *event)
-		if err != nil {
-			return categorizePagerDutyError(err, "updating pagerduty event")
-		}
+	_, err := c.manageEvent(ctx, event)
+	c.recorder.Record(c.Name(), event.Action, event.DedupKey, event, err)
+	if err != nil {
+		return categorizePagerDutyError(err, "updating pagerduty event")
 	}
 
 	return nil
@@ -243,7 +251,7 @@ func (c *Client) Acknowledge(ctx context.Context, alert *entity.Alert, ackEvent
 		return fmt.Errorf("pagerduty routing key not configured")
 	}
 
-	dedupKey := alert.GetExternalReference("pagerduty")
+	dedupKey := alert.GetExternalReference(c.Name())
 	if dedupKey == "" {
 		dedupKey = c.buildDedupKey(alert)
 	}
@@ -254,11 +262,13 @@ func (c *Client) Acknowledge(ctx context.Context, alert *entity.Alert, ackEvent
 		DedupKey:   dedupKey,
 	}
 
-	_, err := pagerduty.ManageEventWithContext(ctx, *event)
+	_, err := c.manageEvent(ctx, event)
+	c.recorder.Record(c.Name(), event.Action, event.DedupKey, event, err)
 	if err != nil {
 		return categorizePagerDutyError(err, "acknowledging pagerduty event")
 	}
 
+	c.noteAcknowledgment(ctx, dedupKey, ackEvent)
 	return nil
 }
 
@@ -268,7 +278,7 @@ func (c *Client) Resolve(ctx context.Context, alert *entity.Alert) error {
 		return fmt.Errorf("pagerduty routing key not configured")
 	}
 
-	dedupKey := alert.GetExternalReference("pagerduty")
+	dedupKey := alert.GetExternalReference(c.Name())
 	if dedupKey == "" {
 		dedupKey = c.buildDedupKey(alert)
 	}
@@ -279,7 +289,8 @@ func (c *Client) Resolve(ctx context.Context, alert *entity.Alert) error {
 		DedupKey:   dedupKey,
 	}
 
-	_, err := pagerduty.ManageEventWithContext(ctx, *event)
+	_, err := c.manageEvent(ctx, event)
+	c.recorder.Record(c.Name(), event.Action, event.DedupKey, event, err)
 	if err != nil {
 		return categorizePagerDutyError(err, "resolving pagerduty event")
 	}
@@ -287,9 +298,53 @@ func (c *Client) Resolve(ctx context.Context, alert *entity.Alert) error {
 	return nil
 }
 
+// FindIncidentKey returns the dedup key of the alert's incident, if one
+// exists in PagerDuty. Requires an API token.
+func (c *Client) FindIncidentKey(ctx context.Context, alert *entity.Alert) (string, bool, error) {
+	if c.eventsClient == nil {
+		return "", false, fmt.Errorf("pagerduty api token not configured")
+	}
+
+	opts := pagerduty.ListIncidentsOptions{
+		IncidentKey: c.buildDedupKey(alert),
+		DateRange:   "all",
+		Limit:       1,
+	}
+	if c.serviceID != "" {
+		opts.ServiceIDs = []string{c.serviceID}
+	}
+
+	resp, err := c.eventsClient.ListIncidentsWithContext(ctx, opts)
+	if err != nil {
+		return "", false, categorizePagerDutyError(err, "listing pagerduty incidents")
+	}
+	if len(resp.Incidents) == 0 {
+		return "", false, nil
+	}
+	return opts.IncidentKey, true, nil
+}
+
 // Name returns the notifier identifier.
 func (c *Client) Name() string {
-	return "pagerduty"
+	return c.name
+}
+
+// SetRecorder enables recording of outbound events. Routing keys are
+// redacted by the recorder.
+func (c *Client) SetRecorder(recorder *payloadlog.Recorder) {
+	c.recorder = recorder
+}
+
+// SetTemplates sets the notification templates; a pagerduty_summary
+// template replaces the built-in incident summary.
+func (c *Client) SetTemplates(templates *notifytemplate.Set) {
+	c.templates = templates
+}
+
+// Capabilities reports that incidents follow the alert's state and can be
+// acknowledged in PagerDuty.
+func (c *Client) Capabilities() entity.NotifierCapabilities {
+	return entity.NotifierCapabilities{Update: true, Ack: true}
 }
 
 // SupportsAck returns true as PagerDuty supports acknowledgment.
@@ -306,8 +361,18 @@ func (c *Client) buildDedupKey(alert *entity.Alert) string {
 	return alert.ID
 }
 
+// maxSummaryLength is the longest summary the Events API accepts.
+const maxSummaryLength = 1024
+
 // buildSummary creates the incident summary.
 func (c *Client) buildSummary(alert *entity.Alert) string {
+	if text, ok := c.templates.Render(notifytemplate.PagerDutySummary, alert); ok {
+		if len(text) > maxSummaryLength {
+			text = strings.ToValidUTF8(text[:maxSummaryLength], "")
+		}
+		return text
+	}
+
 	var parts []string
 
 	// Add severity prefix
@@ -381,8 +446,17 @@ func (c *Client) mapSeverity(severity entity.AlertSeverity) string {
 	}
 }
 
-// sendEventHTTP sends an event to a custom PagerDuty Events API endpoint via HTTP.
-// Used for E2E testing with mock services.
+// manageEvent sends an event with the official library, unless a custom
+// endpoint (for E2E testing) or HTTP client is configured.
+func (c *Client) manageEvent(ctx context.Context, event *pagerduty.V2Event) (*pagerduty.V2EventResponse, error) {
+	if c.eventsAPIURL == "" && c.httpClient == nil {
+		return pagerduty.ManageEventWithContext(ctx, *event)
+	}
+	return c.sendEventHTTP(ctx, event)
+}
+
+// sendEventHTTP sends an event to the Events API via HTTP, using the custom
+// endpoint and client when configured.
 func (c *Client) sendEventHTTP(ctx context.Context, event *pagerduty.V2Event) (*pagerduty.V2EventResponse, error) {
 	// Marshal event to JSON
 	payload, err := json.Marshal(event)
@@ -391,7 +465,11 @@ func (c *Client) sendEventHTTP(ctx context.Context, event *pagerduty.V2Event) (*
 	}
 
 	// Create HTTP request
-	req, err := http.NewRequestWithContext(ctx, "POST", c.eventsAPIURL+"/v2/enqueue", bytes.NewReader(payload))
+	apiURL := c.eventsAPIURL
+	if apiURL == "" {
+		apiURL = defaultEventsAPIURL
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", apiURL+"/v2/enqueue", bytes.NewReader(payload))
 	if err != nil {
 		return nil, fmt.Errorf("creating request: %w", err)
 	}
@@ -399,7 +477,10 @@ func (c *Client) sendEventHTTP(ctx context.Context, event *pagerduty.V2Event) (*
 	req.Header.Set("Content-Type", "application/json")
 
 	// Send request
-	client := &http.Client{}
+	client := c.httpClient
+	if client == nil {
+		client = &http.Client{}
+	}
 	resp, err := client.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("sending request: %w", err)
@@ -414,7 +495,7 @@ func (c *Client) sendEventHTTP(ctx context.Context, event *pagerduty.V2Event) (*
 
 	// Check status code
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("HTTP response with status code: %d, body: %s", resp.StatusCode, string(body))
+		return nil, &eventsHTTPError{StatusCode: resp.StatusCode, Body: string(body)}
 	}
 
 	// Parse response
@@ -426,6 +507,33 @@ func (c *Client) sendEventHTTP(ctx context.Context, event *pagerduty.V2Event) (*
 	return &eventResp, nil
 }
 
+// eventsHTTPError is a non-2xx response to an event sent via HTTP.
+type eventsHTTPError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *eventsHTTPError) Error() string {
+	return fmt.Sprintf("HTTP response with status code: %d, body: %s", e.StatusCode, e.Body)
+}
+
+// statusCode returns the HTTP status of a failed PagerDuty call, or 0.
+func statusCode(err error) int {
+	var apiErr pagerduty.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+	var eventsErr pagerduty.EventsAPIV2Error
+	if errors.As(err, &eventsErr) {
+		return eventsErr.StatusCode
+	}
+	var httpErr *eventsHTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode
+	}
+	return 0
+}
+
 // categorizePagerDutyError wraps PagerDuty API errors as transient or permanent domain errors.
 func categorizePagerDutyError(err error, operation string) error {
 	if err == nil {
@@ -441,29 +549,28 @@ func categorizePagerDutyError(err error, operation string) error {
 		)
 	}
 
-	// Check for PagerDuty API errors
-	var pdErr pagerduty.APIError
-	if errors.As(err, &pdErr) {
-		// Rate limiting (HTTP 429) - transient
-		if pdErr.StatusCode == 429 {
+	// Check for PagerDuty API and Events API errors
+	if status := statusCode(err); status != 0 {
+		// Rate limiting (HTTP 429) - transient, and marked so callers back off
+		if status == http.StatusTooManyRequests {
 			return domainerrors.NewTransientError(
 				fmt.Sprintf("%s: rate limited", operation),
-				err,
+				fmt.Errorf("%w: %w", resilience.ErrThrottled, err),
 			)
 		}
 
 		// Server errors (5xx) - transient
-		if pdErr.StatusCode >= 500 && pdErr.StatusCode < 600 {
+		if status >= 500 && status < 600 {
 			return domainerrors.NewTransientError(
-				fmt.Sprintf("%s: pagerduty server error (status %d)", operation, pdErr.StatusCode),
+				fmt.Sprintf("%s: pagerduty server error (status %d)", operation, status),
 				err,
 			)
 		}
 
 		// Client errors (4xx) - permanent
-		if pdErr.StatusCode >= 400 && pdErr.StatusCode < 500 {
+		if status >= 400 && status < 500 {
 			return domainerrors.NewPermanentError(
-				fmt.Sprintf("%s: client error (status %d)", operation, pdErr.StatusCode),
+				fmt.Sprintf("%s: client error (status %d)", operation, status),
 				err,
 			)
 		}
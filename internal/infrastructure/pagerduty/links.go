@@ -0,0 +1,37 @@
+package pagerduty
+
+import (
+	"context"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// Link is a link shown on a PagerDuty alert and incident.
+type Link struct {
+	Href string `json:"href"`
+	Text string `json:"text,omitempty"`
+}
+
+// LinkProvider supplies the links of an alert, such as its Slack message,
+// so that responders can jump from the incident to the discussion.
+type LinkProvider interface {
+	AlertLinks(ctx context.Context, alert *entity.Alert) []Link
+}
+
+// SetLinkProvider attaches the provider's links to trigger events.
+func (c *Client) SetLinkProvider(provider LinkProvider) {
+	c.links = provider
+}
+
+// eventLinks returns the links for an event's links field.
+func (c *Client) eventLinks(ctx context.Context, alert *entity.Alert) []interface{} {
+	if c.links == nil {
+		return nil
+	}
+
+	var links []interface{}
+	for _, link := range c.links.AlertLinks(ctx, alert) {
+		links = append(links, link)
+	}
+	return links
+}
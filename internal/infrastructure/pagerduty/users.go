@@ -0,0 +1,126 @@
+package pagerduty
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/PagerDuty/go-pagerduty"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// UserDirectory maps emails to the PagerDuty users known for them.
+type UserDirectory interface {
+	PagerDutyUserID(email string) string
+}
+
+// SetUserDirectory makes acknowledgments from other systems add a note to
+// the incident naming who acknowledged it, since Events API
+// acknowledgments are anonymous. The note is from the user themselves
+// when they have a PagerDuty user, otherwise from the configured from
+// email. Requires an API token.
+func (c *Client) SetUserDirectory(users UserDirectory) {
+	c.users = users
+}
+
+// LookupUser finds a PagerDuty user by ID. Returns nil if there is none.
+// Requires an API token.
+func (c *Client) LookupUser(ctx context.Context, id string) (*entity.UserIdentity, error) {
+	if c.eventsClient == nil {
+		return nil, fmt.Errorf("pagerduty api token not configured")
+	}
+
+	user, err := c.eventsClient.GetUserWithContext(ctx, id, pagerduty.GetUserOptions{})
+	if err != nil {
+		var apiErr pagerduty.APIError
+		if errors.As(err, &apiErr) && apiErr.NotFound() {
+			return nil, nil
+		}
+		return nil, categorizePagerDutyError(err, "getting pagerduty user")
+	}
+	return userIdentity(user), nil
+}
+
+// LookupUserByEmail finds the PagerDuty user of an email. Returns nil if
+// there is none. Requires an API token.
+func (c *Client) LookupUserByEmail(ctx context.Context, email string) (*entity.UserIdentity, error) {
+	if c.eventsClient == nil {
+		return nil, fmt.Errorf("pagerduty api token not configured")
+	}
+
+	// The query also matches names, so the email is compared exactly
+	resp, err := c.eventsClient.ListUsersWithContext(ctx, pagerduty.ListUsersOptions{Query: email})
+	if err != nil {
+		return nil, categorizePagerDutyError(err, "listing pagerduty users")
+	}
+	for i := range resp.Users {
+		if strings.EqualFold(resp.Users[i].Email, email) {
+			return userIdentity(&resp.Users[i]), nil
+		}
+	}
+	return nil, nil
+}
+
+func userIdentity(user *pagerduty.User) *entity.UserIdentity {
+	return &entity.UserIdentity{
+		Email:           user.Email,
+		Name:            user.Name,
+		PagerDutyUserID: user.ID,
+	}
+}
+
+// noteAcknowledgment adds a note naming who acknowledged the alert to its
+// incident. Failures are only recorded, as the acknowledgment itself
+// succeeded.
+func (c *Client) noteAcknowledgment(ctx context.Context, dedupKey string, ackEvent *entity.AckEvent) {
+	if c.users == nil || c.eventsClient == nil || ackEvent == nil || ackEvent.IsFromPagerDuty() {
+		return
+	}
+
+	from := c.fromEmail
+	if c.users.PagerDutyUserID(ackEvent.UserEmail) != "" {
+		from = ackEvent.UserEmail
+	}
+	if from == "" {
+		return
+	}
+
+	name := ackEvent.UserName
+	if name == "" {
+		name = ackEvent.UserEmail
+	}
+	note := pagerduty.IncidentNote{
+		Content: fmt.Sprintf("Acknowledged in %s by %s", ackEvent.Source, name),
+		User:    pagerduty.APIObject{Summary: from},
+	}
+
+	incidentID, err := c.findIncidentID(ctx, dedupKey)
+	if err == nil && incidentID != "" {
+		_, err = c.eventsClient.CreateIncidentNoteWithContext(ctx, incidentID, note)
+	}
+	c.recorder.Record(c.Name(), "note", dedupKey, note, err)
+}
+
+// findIncidentID returns the ID of the incident of a dedup key, or "" if
+// there is none.
+func (c *Client) findIncidentID(ctx context.Context, dedupKey string) (string, error) {
+	opts := pagerduty.ListIncidentsOptions{
+		IncidentKey: dedupKey,
+		DateRange:   "all",
+		Limit:       1,
+	}
+	if c.serviceID != "" {
+		opts.ServiceIDs = []string{c.serviceID}
+	}
+
+	resp, err := c.eventsClient.ListIncidentsWithContext(ctx, opts)
+	if err != nil {
+		return "", categorizePagerDutyError(err, "listing pagerduty incidents")
+	}
+	if len(resp.Incidents) == 0 {
+		return "", nil
+	}
+	return resp.Incidents[0].ID, nil
+}
@@ -0,0 +1,197 @@
+// Package payloadlog keeps the most recent outbound notification payloads in
+// memory so operators can inspect exactly what was sent to each notifier.
+package payloadlog
+
+import (
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Redacted replaces secret values in recorded payloads.
+const Redacted = "[REDACTED]"
+
+// sensitiveKeys are JSON object keys whose values are always redacted.
+var sensitiveKeys = map[string]bool{
+	"routing_key":   true,
+	"token":         true,
+	"access_token":  true,
+	"api_key":       true,
+	"secret":        true,
+	"password":      true,
+	"authorization": true,
+}
+
+// sensitiveParams matches credential-like query parameters in URLs, such as
+// the signature on Teams action links.
+var sensitiveParams = regexp.MustCompile(`([?&](?:sig|token|key|secret)=)[^&"\s]+`)
+
+// Entry is a single recorded payload.
+type Entry struct {
+	Time      time.Time       `json:"time"`
+	Notifier  string          `json:"notifier"`
+	Operation string          `json:"operation"`
+	Target    string          `json:"target,omitempty"`
+	Payload   json.RawMessage `json:"payload"`
+	Error     string          `json:"error,omitempty"`
+}
+
+// Recorder holds a fixed-size ring buffer of entries per notifier.
+// A nil *Recorder is valid and records nothing, so clients can call Record
+// unconditionally.
+type Recorder struct {
+	mu      sync.Mutex
+	size    int
+	rings   map[string]*ring
+	secrets []string
+}
+
+type ring struct {
+	entries []Entry
+	next    int
+}
+
+// NewRecorder creates a recorder keeping the last size payloads per notifier.
+// Any secrets given are scrubbed from payloads wherever they appear.
+func NewRecorder(size int, secrets ...string) *Recorder {
+	if size <= 0 {
+		size = 1
+	}
+	r := &Recorder{size: size, rings: make(map[string]*ring)}
+	for _, s := range secrets {
+		if s != "" {
+			r.secrets = append(r.secrets, s)
+		}
+	}
+	return r
+}
+
+// Record stores a redacted copy of payload. err is the send result, if any.
+func (r *Recorder) Record(notifier, operation, target string, payload any, err error) {
+	if r == nil {
+		return
+	}
+
+	entry := Entry{
+		Time:      time.Now().UTC(),
+		Notifier:  notifier,
+		Operation: operation,
+		Target:    r.scrub(target),
+		Payload:   r.redact(payload),
+	}
+	if err != nil {
+		entry.Error = r.scrub(err.Error())
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	rg, ok := r.rings[notifier]
+	if !ok {
+		rg = &ring{entries: make([]Entry, 0, r.size)}
+		r.rings[notifier] = rg
+	}
+	if len(rg.entries) < r.size {
+		rg.entries = append(rg.entries, entry)
+		return
+	}
+	rg.entries[rg.next] = entry
+	rg.next = (rg.next + 1) % r.size
+}
+
+// Entries returns up to limit entries, newest first. An empty notifier
+// returns entries across all notifiers. limit <= 0 means no limit.
+func (r *Recorder) Entries(notifier string, limit int) []Entry {
+	if r == nil {
+		return nil
+	}
+
+	r.mu.Lock()
+	var entries []Entry
+	for name, rg := range r.rings {
+		if notifier != "" && name != notifier {
+			continue
+		}
+		entries = append(entries, rg.entries...)
+	}
+	r.mu.Unlock()
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Time.After(entries[j].Time)
+	})
+	if limit > 0 && len(entries) > limit {
+		entries = entries[:limit]
+	}
+	return entries
+}
+
+// Notifiers returns the names of notifiers with recorded payloads.
+func (r *Recorder) Notifiers() []string {
+	if r == nil {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	names := make([]string, 0, len(r.rings))
+	for name := range r.rings {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// redact encodes payload as JSON with sensitive keys and known secrets
+// replaced.
+func (r *Recorder) redact(payload any) json.RawMessage {
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		raw, _ = json.Marshal(map[string]string{"marshal_error": err.Error()})
+		return raw
+	}
+
+	var doc any
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return raw
+	}
+	out, err := json.Marshal(r.redactValue(doc))
+	if err != nil {
+		return raw
+	}
+	return out
+}
+
+func (r *Recorder) redactValue(v any) any {
+	switch x := v.(type) {
+	case map[string]any:
+		for k, val := range x {
+			if sensitiveKeys[strings.ToLower(k)] {
+				x[k] = Redacted
+				continue
+			}
+			x[k] = r.redactValue(val)
+		}
+		return x
+	case []any:
+		for i, val := range x {
+			x[i] = r.redactValue(val)
+		}
+		return x
+	case string:
+		return r.scrub(x)
+	default:
+		return v
+	}
+}
+
+// scrub replaces configured secrets and credential query parameters in s.
+func (r *Recorder) scrub(s string) string {
+	for _, secret := range r.secrets {
+		s = strings.ReplaceAll(s, secret, Redacted)
+	}
+	return sensitiveParams.ReplaceAllString(s, "${1}"+Redacted)
+}
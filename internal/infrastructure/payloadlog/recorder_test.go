@@ -0,0 +1,62 @@
+package payloadlog
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorder_RingBuffer(t *testing.T) {
+	r := NewRecorder(3)
+
+	for i := 0; i < 5; i++ {
+		r.Record("pagerduty", "trigger", "", map[string]int{"n": i}, nil)
+	}
+	r.Record("slack", "post", "C123", map[string]string{"text": "hi"}, nil)
+
+	pd := r.Entries("pagerduty", 0)
+	require.Len(t, pd, 3)
+	// Newest first, oldest two evicted
+	assert.JSONEq(t, `{"n":4}`, string(pd[0].Payload))
+	assert.JSONEq(t, `{"n":2}`, string(pd[2].Payload))
+
+	assert.Len(t, r.Entries("", 0), 4)
+	assert.Len(t, r.Entries("", 2), 2)
+	assert.Equal(t, []string{"pagerduty", "slack"}, r.Notifiers())
+}
+
+func TestRecorder_Redaction(t *testing.T) {
+	r := NewRecorder(10, "https://hooks.example.com/secret-path")
+
+	payload := map[string]any{
+		"routing_key": "R0UT1NG",
+		"payload": map[string]any{
+			"summary": "disk full",
+			"links":   []any{"https://hooks.example.com/secret-path?x=1", "https://ab.example.com/ack?alert_id=a1&sig=deadbeef"},
+		},
+	}
+	r.Record("pagerduty", "trigger", "", payload, errors.New("post https://hooks.example.com/secret-path: timeout"))
+
+	entries := r.Entries("pagerduty", 0)
+	require.Len(t, entries, 1)
+
+	var doc map[string]any
+	require.NoError(t, json.Unmarshal(entries[0].Payload, &doc))
+	assert.Equal(t, Redacted, doc["routing_key"])
+	inner := doc["payload"].(map[string]any)
+	assert.Equal(t, "disk full", inner["summary"])
+	assert.Equal(t, []any{Redacted + "?x=1", "https://ab.example.com/ack?alert_id=a1&sig=" + Redacted}, inner["links"])
+	assert.Equal(t, "post "+Redacted+": timeout", entries[0].Error)
+
+	// The caller's payload is not modified
+	assert.Equal(t, "R0UT1NG", payload["routing_key"])
+}
+
+func TestRecorder_Nil(t *testing.T) {
+	var r *Recorder
+	r.Record("slack", "post", "", "x", nil)
+	assert.Nil(t, r.Entries("", 0))
+}
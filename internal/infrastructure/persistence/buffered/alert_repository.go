@@ -0,0 +1,223 @@
+package buffered
+
+import (
+	"context"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+)
+
+// AlertRepository holds alert writes in its buffer while the underlying
+// repository is unavailable. Lookups see held alerts over stored ones.
+// Search, FindChangedSince, FindActiveAt, Delete and Restore go to the
+// underlying repository only, so they do not see held alerts until they are
+// flushed.
+type AlertRepository struct {
+	next   repository.AlertRepository
+	buffer *Buffer
+}
+
+// NewAlertRepository wraps next, holding writes in buffer.
+func NewAlertRepository(next repository.AlertRepository, buffer *Buffer) *AlertRepository {
+	return &AlertRepository{next: next, buffer: buffer}
+}
+
+// Save persists a new alert, or holds it.
+func (r *AlertRepository) Save(ctx context.Context, alert *entity.Alert) error {
+	return r.buffer.write(ctx, func(ctx context.Context) error {
+		return r.next.Save(ctx, alert)
+	}, func() {
+		r.buffer.holdAlertLocked(alert, true)
+	})
+}
+
+// Update modifies an existing alert, or holds the change.
+func (r *AlertRepository) Update(ctx context.Context, alert *entity.Alert) error {
+	return r.buffer.write(ctx, func(ctx context.Context) error {
+		return r.next.Update(ctx, alert)
+	}, func() {
+		r.buffer.holdAlertLocked(alert, false)
+	})
+}
+
+// FindByID returns the held alert, or the stored one. Fails if the alert is
+// not held and the underlying repository is unavailable, rather than report
+// it missing.
+func (r *AlertRepository) FindByID(ctx context.Context, id string) (*entity.Alert, error) {
+	if alert := r.buffer.held(id); alert != nil {
+		return alert, nil
+	}
+	return r.next.FindByID(ctx, id)
+}
+
+// FindByExternalReference returns the held or stored alert with the
+// reference. Fails like FindByID.
+func (r *AlertRepository) FindByExternalReference(ctx context.Context, system, referenceID string) (*entity.Alert, error) {
+	held := r.buffer.heldMatching(func(a *entity.Alert) bool {
+		return referenceID != "" && a.ExternalReferences.Contains(system, referenceID)
+	})
+	if len(held) > 0 {
+		return held[0], nil
+	}
+
+	alert, err := r.next.FindByExternalReference(ctx, system, referenceID)
+	if err != nil || alert == nil {
+		return alert, err
+	}
+	// A held change may have removed the reference
+	if current := r.buffer.held(alert.ID); current != nil {
+		return nil, nil
+	}
+	return alert, nil
+}
+
+// FindByFingerprint returns held and stored alerts with the fingerprint.
+// While the underlying repository is unavailable, only held alerts are
+// returned, so an alert already stored is raised again rather than
+// dropped.
+func (r *AlertRepository) FindByFingerprint(ctx context.Context, fingerprint string) ([]*entity.Alert, error) {
+	return r.overlay(ctx, func(ctx context.Context) ([]*entity.Alert, error) {
+		return r.next.FindByFingerprint(ctx, fingerprint)
+	}, func(a *entity.Alert) bool {
+		return a.Fingerprint == fingerprint
+	})
+}
+
+// FindActive returns held and stored active alerts.
+func (r *AlertRepository) FindActive(ctx context.Context) ([]*entity.Alert, error) {
+	return r.overlay(ctx, r.next.FindActive, (*entity.Alert).IsActive)
+}
+
+// GetActiveAlerts returns held and stored active alerts, optionally
+// filtered by severity.
+func (r *AlertRepository) GetActiveAlerts(ctx context.Context, severity string) ([]*entity.Alert, error) {
+	return r.overlay(ctx, func(ctx context.Context) ([]*entity.Alert, error) {
+		return r.next.GetActiveAlerts(ctx, severity)
+	}, func(a *entity.Alert) bool {
+		return a.IsActive() && (severity == "" || string(a.Severity) == severity)
+	})
+}
+
+// FindFiring returns held and stored firing alerts.
+func (r *AlertRepository) FindFiring(ctx context.Context) ([]*entity.Alert, error) {
+	return r.overlay(ctx, r.next.FindFiring, (*entity.Alert).IsFiring)
+}
+
+// FindChangedSince returns stored alerts changed since the given time.
+func (r *AlertRepository) FindChangedSince(ctx context.Context, since time.Time) ([]*entity.Alert, error) {
+	return r.next.FindChangedSince(ctx, since)
+}
+
+// FindActiveAt returns stored alerts active at the given time.
+func (r *AlertRepository) FindActiveAt(ctx context.Context, at time.Time) ([]*entity.Alert, error) {
+	return r.next.FindActiveAt(ctx, at)
+}
+
+// Search returns a page of stored alerts matching query.
+func (r *AlertRepository) Search(ctx context.Context, query entity.AlertQuery) ([]*entity.Alert, int, error) {
+	return r.next.Search(ctx, query)
+}
+
+// Delete soft-deletes a stored alert.
+func (r *AlertRepository) Delete(ctx context.Context, id string) error {
+	return r.next.Delete(ctx, id)
+}
+
+// Restore brings back a soft-deleted alert.
+func (r *AlertRepository) Restore(ctx context.Context, id string) error {
+	return r.next.Restore(ctx, id)
+}
+
+// FindResolvedBefore returns stored alerts resolved before the given time.
+// Held alerts are not purged, so they are left out.
+func (r *AlertRepository) FindResolvedBefore(ctx context.Context, before time.Time, limit int) ([]*entity.Alert, error) {
+	return r.next.FindResolvedBefore(ctx, before, limit)
+}
+
+// DeleteResolvedBefore purges stored alerts resolved before the given time.
+// Held alerts are kept until flushed.
+func (r *AlertRepository) DeleteResolvedBefore(ctx context.Context, before time.Time) (int, error) {
+	return r.next.DeleteResolvedBefore(ctx, before)
+}
+
+// DeleteResolvedByIDs purges the given stored alerts. Held alerts are kept
+// until flushed.
+func (r *AlertRepository) DeleteResolvedByIDs(ctx context.Context, ids []string, before time.Time) (int, error) {
+	return r.next.DeleteResolvedByIDs(ctx, ids, before)
+}
+
+// overlay returns the stored alerts of find with held versions in place of
+// stored ones, plus held alerts matching that are not stored. If find
+// fails because the repository is unavailable, only held alerts are
+// returned.
+func (r *AlertRepository) overlay(ctx context.Context, find func(context.Context) ([]*entity.Alert, error), match func(*entity.Alert) bool) ([]*entity.Alert, error) {
+	stored, err := find(ctx)
+	if err != nil && !r.buffer.degrade(ctx, err) {
+		return nil, err
+	}
+
+	r.buffer.mu.Lock()
+	defer r.buffer.mu.Unlock()
+	if len(r.buffer.heldAlerts) == 0 {
+		return stored, nil
+	}
+
+	alerts := make([]*entity.Alert, 0, len(stored))
+	seen := make(map[string]bool, len(stored))
+	for _, alert := range stored {
+		seen[alert.ID] = true
+		if held, ok := r.buffer.heldAlerts[alert.ID]; ok {
+			if !match(held.alert) {
+				continue
+			}
+			alertCopy := *held.alert
+			alert = &alertCopy
+		}
+		alerts = append(alerts, alert)
+	}
+	for id, held := range r.buffer.heldAlerts {
+		if !seen[id] && match(held.alert) {
+			alertCopy := *held.alert
+			alerts = append(alerts, &alertCopy)
+		}
+	}
+	return alerts, nil
+}
+
+// holdAlertLocked holds the latest write of an alert. An alert first saved
+// while held stays new until flushed.
+func (b *Buffer) holdAlertLocked(alert *entity.Alert, isNew bool) {
+	b.seq++
+	if held, ok := b.heldAlerts[alert.ID]; ok {
+		isNew = isNew || held.isNew
+	}
+	alertCopy := *alert
+	b.heldAlerts[alert.ID] = &heldAlert{alert: &alertCopy, isNew: isNew, seq: b.seq}
+}
+
+// held returns a copy of the held alert, or nil.
+func (b *Buffer) held(id string) *entity.Alert {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	held, ok := b.heldAlerts[id]
+	if !ok {
+		return nil
+	}
+	alertCopy := *held.alert
+	return &alertCopy
+}
+
+// heldMatching returns copies of the held alerts matching.
+func (b *Buffer) heldMatching(match func(*entity.Alert) bool) []*entity.Alert {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var alerts []*entity.Alert
+	for _, held := range b.heldAlerts {
+		if match(held.alert) {
+			alertCopy := *held.alert
+			alerts = append(alerts, &alertCopy)
+		}
+	}
+	return alerts
+}
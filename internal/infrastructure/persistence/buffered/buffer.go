@@ -0,0 +1,466 @@
+// Package buffered keeps alerts flowing when the storage backend fails.
+// Writes that fail because the backend is unavailable are held in memory,
+// notifications continue, and the held writes are flushed to the backend
+// once it recovers.
+package buffered
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/observability"
+)
+
+// Steps taken, in the configured order, when a write fails because the
+// backend is unavailable. If every step fails, the write fails as it would
+// without degradation.
+const (
+	// StepRetry retries the write once after the retry backoff.
+	StepRetry = "retry"
+
+	// StepBuffer holds the write in memory and enters degraded mode, in
+	// which every write is held until the backend recovers.
+	StepBuffer = "buffer"
+)
+
+// noticeTimeout bounds posting a degradation notice.
+const noticeTimeout = 10 * time.Second
+
+// ErrBufferFull is returned for writes that fail while the buffer holds
+// its maximum number of writes.
+var ErrBufferFull = errors.New("storage unavailable and write buffer full")
+
+// Config configures degradation.
+type Config struct {
+	// Order lists the steps tried for a failed write.
+	Order []string
+
+	// RetryBackoff is the wait before StepRetry retries.
+	RetryBackoff time.Duration
+
+	// MaxBuffered bounds the writes held in memory.
+	MaxBuffered int
+
+	// FlushInterval is how often held writes are flushed while degraded.
+	FlushInterval time.Duration
+}
+
+// Status is the degradation state.
+type Status struct {
+	Degraded  bool
+	Since     time.Time
+	Buffered  int
+	LastError string
+}
+
+// Poster posts a plain-text message to a channel.
+// Implemented by the Slack client.
+type Poster interface {
+	PostText(ctx context.Context, channelID, text string) error
+}
+
+// Buffer holds the writes made while the backend is unavailable and
+// flushes them once it recovers. The repositories it wraps share it, so
+// that an alert and its events are flushed in order.
+type Buffer struct {
+	cfg    Config
+	alerts repository.AlertRepository
+	events repository.AlertEventRepository
+	acks   repository.AckEventRepository
+	logger *slog.Logger
+
+	// flushMu keeps a shutdown flush from overlapping a periodic one
+	flushMu sync.Mutex
+
+	mu           sync.Mutex
+	heldAlerts   map[string]*heldAlert
+	heldEvents   []*entity.AlertEvent
+	heldAcks     []*entity.AckEvent
+	seq          uint64
+	degraded     bool
+	since        time.Time
+	lastErr      error
+	flushedCount int
+
+	poster    Poster
+	channelID string
+	metrics   *observability.Metrics
+	now       func() time.Time
+}
+
+// heldAlert is the latest write of one alert. seq tells whether the alert
+// was written again while it was being flushed.
+type heldAlert struct {
+	alert *entity.Alert
+	isNew bool
+	seq   uint64
+}
+
+// NewBuffer creates a buffer in front of the given backend repositories.
+func NewBuffer(cfg Config, alerts repository.AlertRepository, events repository.AlertEventRepository, acks repository.AckEventRepository, logger *slog.Logger) *Buffer {
+	return &Buffer{
+		cfg:        cfg,
+		alerts:     alerts,
+		events:     events,
+		acks:       acks,
+		logger:     logger,
+		heldAlerts: make(map[string]*heldAlert),
+		now:        time.Now,
+	}
+}
+
+// SetMetaAlertPoster sets where degradation and recovery notices are
+// posted. Without one, they are only logged.
+func (b *Buffer) SetMetaAlertPoster(poster Poster, channelID string) {
+	b.poster = poster
+	b.channelID = channelID
+}
+
+// SetMetrics enables the degradation gauges.
+func (b *Buffer) SetMetrics(metrics *observability.Metrics) {
+	b.metrics = metrics
+}
+
+// Status returns the degradation state.
+func (b *Buffer) Status() Status {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.statusLocked()
+}
+
+func (b *Buffer) statusLocked() Status {
+	s := Status{
+		Degraded: b.degraded,
+		Since:    b.since,
+		Buffered: b.countLocked(),
+	}
+	if b.lastErr != nil {
+		s.LastError = b.lastErr.Error()
+	}
+	return s
+}
+
+func (b *Buffer) countLocked() int {
+	return len(b.heldAlerts) + len(b.heldEvents) + len(b.heldAcks)
+}
+
+// Accepting reports whether writes can be held if storage fails, so the
+// service can stay ready while degraded.
+func (b *Buffer) Accepting() bool {
+	if !slices.Contains(b.cfg.Order, StepBuffer) {
+		return false
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.countLocked() < b.cfg.MaxBuffered
+}
+
+// Run flushes held writes every flush interval while degraded, until ctx
+// is done. The last flush is left to the caller, once the work still
+// writing to storage has finished.
+func (b *Buffer) Run(ctx context.Context) {
+	ticker := time.NewTicker(b.cfg.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if b.Status().Degraded {
+				if err := b.Flush(ctx); err != nil {
+					b.logger.Warn("storage still unavailable",
+						"buffered", b.Status().Buffered,
+						"error", err,
+					)
+				}
+			}
+		}
+	}
+}
+
+// Flush writes the held writes to the backend, alerts first so their
+// events can refer to them, and leaves degraded mode once none are left.
+// Stops at the first failure; what was written is not written again.
+func (b *Buffer) Flush(ctx context.Context) error {
+	b.flushMu.Lock()
+	defer b.flushMu.Unlock()
+
+	b.mu.Lock()
+	alerts := make([]heldAlert, 0, len(b.heldAlerts))
+	for _, held := range b.heldAlerts {
+		alerts = append(alerts, *held)
+	}
+	events := slices.Clone(b.heldEvents)
+	acks := slices.Clone(b.heldAcks)
+	b.mu.Unlock()
+
+	// Oldest first, so that a failure leaves the newest changes held
+	slices.SortFunc(alerts, func(x, y heldAlert) int { return x.alert.UpdatedAt.Compare(y.alert.UpdatedAt) })
+
+	for _, held := range alerts {
+		if err := b.flushAlert(ctx, held); err != nil {
+			return b.flushFailed(err)
+		}
+		b.mu.Lock()
+		if current, ok := b.heldAlerts[held.alert.ID]; ok && current.seq == held.seq {
+			delete(b.heldAlerts, held.alert.ID)
+			b.flushedCount++
+		}
+		b.mu.Unlock()
+	}
+
+	// Events are only appended while flushing, so the flushed ones are
+	// still at the front
+	for _, event := range events {
+		if err := b.events.Save(ctx, event); err != nil && !errors.Is(err, repository.ErrAlreadyExists) {
+			return b.flushFailed(err)
+		}
+		b.mu.Lock()
+		b.heldEvents = b.heldEvents[1:]
+		b.flushedCount++
+		b.mu.Unlock()
+	}
+	for _, ack := range acks {
+		if err := b.acks.Save(ctx, ack); err != nil && !errors.Is(err, repository.ErrAlreadyExists) {
+			return b.flushFailed(err)
+		}
+		b.mu.Lock()
+		b.heldAcks = b.heldAcks[1:]
+		b.flushedCount++
+		b.mu.Unlock()
+	}
+
+	b.mu.Lock()
+	if !b.degraded || b.countLocked() > 0 {
+		b.mu.Unlock()
+		return nil
+	}
+	since, flushed := b.since, b.flushedCount
+	b.degraded = false
+	b.since = time.Time{}
+	b.lastErr = nil
+	b.flushedCount = 0
+	b.recordLocked()
+	b.mu.Unlock()
+
+	b.logger.Info("storage recovered; buffered writes flushed",
+		"flushed", flushed,
+		"degradedFor", b.now().Sub(since),
+	)
+	b.notify(fmt.Sprintf(
+		":white_check_mark: Storage recovered after %s. %d buffered writes were written back.",
+		b.now().Sub(since).Round(time.Second), flushed,
+	))
+	return nil
+}
+
+// flushAlert writes one held alert, creating it if it was new or the
+// backend does not have it.
+func (b *Buffer) flushAlert(ctx context.Context, held heldAlert) error {
+	if held.isNew {
+		if err := b.supersede(ctx, held.alert); err != nil {
+			return err
+		}
+		err := b.alerts.Save(ctx, held.alert)
+		if !errors.Is(err, entity.ErrDuplicateAlert) {
+			return err
+		}
+	}
+	err := b.alerts.Update(ctx, held.alert)
+	if errors.Is(err, entity.ErrAlertNotFound) {
+		return b.alerts.Save(ctx, held.alert)
+	}
+	return err
+}
+
+// supersede resolves stored alerts that were still firing under the
+// fingerprint of an alert raised while degraded. Lookups only saw held
+// alerts then, so a repeat of a stored alert was raised as a new one; the
+// stored alert ends where the new one took over, or where it resolved.
+func (b *Buffer) supersede(ctx context.Context, alert *entity.Alert) error {
+	stored, err := b.alerts.FindByFingerprint(ctx, alert.Fingerprint)
+	if err != nil {
+		return err
+	}
+	at := alert.CreatedAt
+	if alert.IsResolved() && alert.ResolvedAt != nil {
+		at = *alert.ResolvedAt
+	}
+	for _, s := range stored {
+		if s.ID == alert.ID || !s.IsFiring() {
+			continue
+		}
+		s.Resolve(at)
+		if err := b.alerts.Update(ctx, s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *Buffer) flushFailed(err error) error {
+	b.mu.Lock()
+	b.lastErr = err
+	b.recordLocked()
+	b.mu.Unlock()
+	return err
+}
+
+// write runs a backend write and, if the backend is unavailable, tries
+// the configured steps. hold stores the write in memory; it is called with
+// the lock held. While degraded, writes are held without trying the
+// backend, so they reach it in order. Writes in a transaction are never
+// held, since the transaction could still roll back.
+func (b *Buffer) write(ctx context.Context, op func(context.Context) error, hold func()) error {
+	if repository.TxFromContext(ctx) != nil {
+		return op(ctx)
+	}
+	if held, err := b.holdIfDegraded(hold); held {
+		return err
+	}
+
+	err := op(ctx)
+	if !isUnavailable(ctx, err) {
+		return err
+	}
+
+	for _, step := range b.cfg.Order {
+		switch step {
+		case StepRetry:
+			select {
+			case <-time.After(b.cfg.RetryBackoff):
+			case <-ctx.Done():
+				return err
+			}
+			if err = op(ctx); !isUnavailable(ctx, err) {
+				return err
+			}
+		case StepBuffer:
+			if b.hold(err, hold) {
+				return nil
+			}
+		}
+	}
+	return err
+}
+
+// holdIfDegraded holds the write if degraded. Returns whether it was
+// handled, and ErrBufferFull if it could not be held.
+func (b *Buffer) holdIfDegraded(hold func()) (bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.degraded {
+		return false, nil
+	}
+	if b.countLocked() >= b.cfg.MaxBuffered {
+		return true, ErrBufferFull
+	}
+	hold()
+	b.recordLocked()
+	return true, nil
+}
+
+// hold holds a write that failed with cause, entering degraded mode.
+// Returns false if the buffer is full.
+func (b *Buffer) hold(cause error, hold func()) bool {
+	b.mu.Lock()
+	if b.countLocked() >= b.cfg.MaxBuffered {
+		b.mu.Unlock()
+		return false
+	}
+	hold()
+	entered := b.degradeLocked(cause)
+	b.mu.Unlock()
+
+	if entered {
+		b.entered(cause)
+	}
+	return true
+}
+
+// degrade enters degraded mode after a failed read, if buffering is
+// configured. Returns whether reads should fall back to held writes.
+func (b *Buffer) degrade(ctx context.Context, err error) bool {
+	if !isUnavailable(ctx, err) || !slices.Contains(b.cfg.Order, StepBuffer) {
+		return false
+	}
+	b.mu.Lock()
+	entered := b.degradeLocked(err)
+	b.mu.Unlock()
+
+	if entered {
+		b.entered(err)
+	}
+	return true
+}
+
+// degradeLocked enters degraded mode. Returns whether it was not already.
+func (b *Buffer) degradeLocked(cause error) bool {
+	b.lastErr = cause
+	entered := !b.degraded
+	if entered {
+		b.degraded = true
+		b.since = b.now()
+	}
+	b.recordLocked()
+	return entered
+}
+
+// entered reports entering degraded mode.
+func (b *Buffer) entered(cause error) {
+	b.logger.Error("storage unavailable; buffering writes in memory",
+		"error", cause,
+		"maxBuffered", b.cfg.MaxBuffered,
+	)
+	b.notify(fmt.Sprintf(
+		":rotating_light: *Storage unavailable*: %v\nAlerts are still processed and notified, but writes are held in memory (up to %d) and retried every %s. Held writes are lost if alert-bridge stops before storage recovers.",
+		cause, b.cfg.MaxBuffered, b.cfg.FlushInterval,
+	))
+}
+
+// recordLocked updates the degradation gauges.
+func (b *Buffer) recordLocked() {
+	if b.metrics != nil {
+		b.metrics.RecordStorageDegradation(context.Background(), b.degraded, b.countLocked())
+	}
+}
+
+// notify posts a notice in the background so the write path is not
+// delayed by the meta-alert channel.
+func (b *Buffer) notify(text string) {
+	if b.poster == nil || b.channelID == "" {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), noticeTimeout)
+		defer cancel()
+		if err := b.poster.PostText(ctx, b.channelID, text); err != nil {
+			b.logger.Error("failed to post storage degradation notice",
+				"channel", b.channelID,
+				"error", err,
+			)
+		}
+	}()
+}
+
+// isUnavailable reports whether err means the backend could not serve the
+// call, rather than a result such as not found, or the caller giving up.
+func isUnavailable(ctx context.Context, err error) bool {
+	if err == nil || ctx.Err() != nil {
+		return false
+	}
+	return !entity.IsNotFound(err) &&
+		!errors.Is(err, entity.ErrDuplicateAlert) &&
+		!errors.Is(err, repository.ErrNotFound) &&
+		!errors.Is(err, repository.ErrAlreadyExists) &&
+		!errors.Is(err, repository.ErrConcurrentUpdate)
+}
@@ -0,0 +1,228 @@
+package buffered
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/memory"
+)
+
+var errDown = errors.New("dial tcp 10.0.0.5:3306: connect: connection refused")
+
+// flakyAlerts fails every call while down, and the next failures calls.
+type flakyAlerts struct {
+	*memory.AlertRepository
+	down     bool
+	failures int
+}
+
+func (r *flakyAlerts) fail() error {
+	if r.down {
+		return errDown
+	}
+	if r.failures > 0 {
+		r.failures--
+		return errDown
+	}
+	return nil
+}
+
+func (r *flakyAlerts) Save(ctx context.Context, alert *entity.Alert) error {
+	if err := r.fail(); err != nil {
+		return err
+	}
+	return r.AlertRepository.Save(ctx, alert)
+}
+
+func (r *flakyAlerts) Update(ctx context.Context, alert *entity.Alert) error {
+	if err := r.fail(); err != nil {
+		return err
+	}
+	return r.AlertRepository.Update(ctx, alert)
+}
+
+func (r *flakyAlerts) FindByFingerprint(ctx context.Context, fingerprint string) ([]*entity.Alert, error) {
+	if err := r.fail(); err != nil {
+		return nil, err
+	}
+	return r.AlertRepository.FindByFingerprint(ctx, fingerprint)
+}
+
+type flakyEvents struct {
+	*memory.AlertEventRepository
+	alerts *flakyAlerts
+}
+
+func (r *flakyEvents) Save(ctx context.Context, event *entity.AlertEvent) error {
+	if r.alerts.down {
+		return errDown
+	}
+	return r.AlertEventRepository.Save(ctx, event)
+}
+
+type postedNotices chan string
+
+func (p postedNotices) PostText(ctx context.Context, channelID, text string) error {
+	p <- text
+	return nil
+}
+
+func newTestBuffer(cfg Config) (*Buffer, *flakyAlerts, *flakyEvents) {
+	alerts := &flakyAlerts{AlertRepository: memory.NewAlertRepository()}
+	events := &flakyEvents{AlertEventRepository: memory.NewAlertEventRepository(), alerts: alerts}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	return NewBuffer(cfg, alerts, events, memory.NewAckEventRepository(), logger), alerts, events
+}
+
+func TestBuffer_DegradesAndRecovers(t *testing.T) {
+	ctx := context.Background()
+	buffer, primary, primaryEvents := newTestBuffer(Config{Order: []string{StepBuffer}, MaxBuffered: 10, FlushInterval: time.Minute})
+	notices := make(postedNotices, 2)
+	buffer.SetMetaAlertPoster(notices, "C-ops")
+	alerts := NewAlertRepository(primary, buffer)
+	events := NewAlertEventRepository(primaryEvents, buffer)
+
+	stored := entity.NewAlert("fp-stored", "DiskFull", "db-1", "", "", entity.SeverityWarning)
+	require.NoError(t, alerts.Save(ctx, stored))
+
+	primary.down = true
+
+	// Lookups fall back to held alerts only
+	found, err := alerts.FindByFingerprint(ctx, "fp-new")
+	require.NoError(t, err)
+	assert.Empty(t, found)
+	assert.True(t, buffer.Status().Degraded)
+	assert.Contains(t, <-notices, "Storage unavailable")
+
+	a := entity.NewAlert("fp-new", "HighCPU", "web-1", "", "", entity.SeverityCritical)
+	require.NoError(t, alerts.Save(ctx, a))
+	require.NoError(t, a.Acknowledge("alice", time.Now()))
+	require.NoError(t, alerts.Update(ctx, a))
+	require.NoError(t, alerts.Update(ctx, stored))
+	require.NoError(t, events.Save(ctx, entity.NewAlertEvent(a.ID, entity.AlertEventAcknowledged, "alice", "slack")))
+
+	status := buffer.Status()
+	assert.Equal(t, 3, status.Buffered)
+	assert.Contains(t, status.LastError, "connection refused")
+
+	found, err = alerts.FindByFingerprint(ctx, "fp-new")
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.True(t, found[0].IsAcked())
+
+	assert.ErrorIs(t, buffer.Flush(ctx), errDown)
+	assert.True(t, buffer.Status().Degraded)
+
+	primary.down = false
+	require.NoError(t, buffer.Flush(ctx))
+	assert.Equal(t, Status{}, buffer.Status())
+	assert.Contains(t, <-notices, "Storage recovered")
+
+	saved, err := primary.FindByID(ctx, a.ID)
+	require.NoError(t, err)
+	require.NotNil(t, saved)
+	assert.True(t, saved.IsAcked())
+	timeline, err := primaryEvents.FindByAlertID(ctx, a.ID)
+	require.NoError(t, err)
+	assert.Len(t, timeline, 1)
+}
+
+func TestBuffer_FlushSupersedesStoredAlerts(t *testing.T) {
+	ctx := context.Background()
+	buffer, primary, _ := newTestBuffer(Config{Order: []string{StepBuffer}, MaxBuffered: 10})
+	alerts := NewAlertRepository(primary, buffer)
+
+	stored := entity.NewAlert("fp-1", "HighCPU", "web-1", "", "", entity.SeverityCritical)
+	require.NoError(t, alerts.Save(ctx, stored))
+
+	// The repeat is not matched to the stored alert while degraded
+	primary.down = true
+	found, err := alerts.FindByFingerprint(ctx, "fp-1")
+	require.NoError(t, err)
+	assert.Empty(t, found)
+
+	repeat := entity.NewAlert("fp-1", "HighCPU", "web-1", "", "", entity.SeverityCritical)
+	require.NoError(t, alerts.Save(ctx, repeat))
+	resolvedAt := time.Now().Add(time.Minute)
+	repeat.Resolve(resolvedAt)
+	require.NoError(t, alerts.Update(ctx, repeat))
+
+	primary.down = false
+	require.NoError(t, buffer.Flush(ctx))
+
+	found, err = primary.FindByFingerprint(ctx, "fp-1")
+	require.NoError(t, err)
+	require.Len(t, found, 2)
+	for _, a := range found {
+		assert.True(t, a.IsResolved(), "alert %s is still firing", a.ID)
+	}
+	original, err := primary.FindByID(ctx, stored.ID)
+	require.NoError(t, err)
+	require.NotNil(t, original.ResolvedAt)
+	assert.True(t, original.ResolvedAt.Equal(resolvedAt))
+}
+
+func TestBuffer_Steps(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("retry", func(t *testing.T) {
+		buffer, primary, _ := newTestBuffer(Config{Order: []string{StepRetry}, RetryBackoff: time.Millisecond})
+		alerts := NewAlertRepository(primary, buffer)
+		primary.failures = 1
+
+		require.NoError(t, alerts.Save(ctx, entity.NewAlert("fp", "X", "", "", "", entity.SeverityInfo)))
+		assert.False(t, buffer.Status().Degraded)
+
+		primary.failures = 2
+		assert.ErrorIs(t, alerts.Save(ctx, entity.NewAlert("fp", "X", "", "", "", entity.SeverityInfo)), errDown)
+	})
+
+	t.Run("buffer full", func(t *testing.T) {
+		buffer, primary, _ := newTestBuffer(Config{Order: []string{StepBuffer, StepRetry}, MaxBuffered: 1})
+		alerts := NewAlertRepository(primary, buffer)
+		primary.down = true
+
+		require.NoError(t, alerts.Save(ctx, entity.NewAlert("fp-1", "X", "", "", "", entity.SeverityInfo)))
+		assert.ErrorIs(t, alerts.Save(ctx, entity.NewAlert("fp-2", "X", "", "", "", entity.SeverityInfo)), ErrBufferFull)
+		assert.False(t, buffer.Accepting())
+	})
+
+	t.Run("results are not failures", func(t *testing.T) {
+		buffer, primary, _ := newTestBuffer(Config{Order: []string{StepBuffer}, MaxBuffered: 10})
+		alerts := NewAlertRepository(primary, buffer)
+
+		err := alerts.Update(ctx, entity.NewAlert("fp", "X", "", "", "", entity.SeverityInfo))
+		assert.ErrorIs(t, err, entity.ErrAlertNotFound)
+		assert.False(t, buffer.Status().Degraded)
+	})
+}
+
+func TestTransactionManager_RunsWithoutTransactionWhileDegraded(t *testing.T) {
+	ctx := context.Background()
+	buffer, primary, _ := newTestBuffer(Config{Order: []string{StepBuffer}, MaxBuffered: 10})
+	alerts := NewAlertRepository(primary, buffer)
+	tm := NewTransactionManager(failingTx{}, buffer)
+
+	primary.down = true
+	err := tm.WithTransaction(ctx, func(ctx context.Context) error {
+		return alerts.Save(ctx, entity.NewAlert("fp", "X", "", "", "", entity.SeverityInfo))
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, buffer.Status().Buffered)
+}
+
+// failingTx cannot begin transactions.
+type failingTx struct{}
+
+func (failingTx) BeginTx(context.Context) (repository.Transaction, error) { return nil, errDown }
+
+func (failingTx) WithTransaction(context.Context, func(context.Context) error) error { return errDown }
@@ -0,0 +1,106 @@
+package buffered
+
+import (
+	"context"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+)
+
+// AlertEventRepository holds timeline events in its buffer while the
+// underlying repository is unavailable.
+type AlertEventRepository struct {
+	next   repository.AlertEventRepository
+	buffer *Buffer
+}
+
+// NewAlertEventRepository wraps next, holding writes in buffer.
+func NewAlertEventRepository(next repository.AlertEventRepository, buffer *Buffer) *AlertEventRepository {
+	return &AlertEventRepository{next: next, buffer: buffer}
+}
+
+// Save appends an event to an alert's timeline, or holds it.
+func (r *AlertEventRepository) Save(ctx context.Context, event *entity.AlertEvent) error {
+	return r.buffer.write(ctx, func(ctx context.Context) error {
+		return r.next.Save(ctx, event)
+	}, func() {
+		r.buffer.heldEvents = append(r.buffer.heldEvents, event)
+	})
+}
+
+// FindByAlertID returns an alert's stored timeline followed by its held
+// events. While the underlying repository is unavailable, only held events
+// are returned.
+func (r *AlertEventRepository) FindByAlertID(ctx context.Context, alertID string) ([]*entity.AlertEvent, error) {
+	events, err := r.next.FindByAlertID(ctx, alertID)
+	if err != nil && !r.buffer.degrade(ctx, err) {
+		return nil, err
+	}
+
+	r.buffer.mu.Lock()
+	defer r.buffer.mu.Unlock()
+	for _, event := range r.buffer.heldEvents {
+		if event.AlertID == alertID {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+// AckEventRepository holds acknowledgment events in its buffer while the
+// underlying repository is unavailable. Only Save and FindLatestByAlertID
+// see held events.
+type AckEventRepository struct {
+	next   repository.AckEventRepository
+	buffer *Buffer
+}
+
+// NewAckEventRepository wraps next, holding writes in buffer.
+func NewAckEventRepository(next repository.AckEventRepository, buffer *Buffer) *AckEventRepository {
+	return &AckEventRepository{next: next, buffer: buffer}
+}
+
+// Save persists an ack event, or holds it.
+func (r *AckEventRepository) Save(ctx context.Context, event *entity.AckEvent) error {
+	return r.buffer.write(ctx, func(ctx context.Context) error {
+		return r.next.Save(ctx, event)
+	}, func() {
+		r.buffer.heldAcks = append(r.buffer.heldAcks, event)
+	})
+}
+
+// FindByAlertID retrieves an alert's stored ack events.
+func (r *AckEventRepository) FindByAlertID(ctx context.Context, alertID string) ([]*entity.AckEvent, error) {
+	return r.next.FindByAlertID(ctx, alertID)
+}
+
+// FindByID retrieves a stored ack event.
+func (r *AckEventRepository) FindByID(ctx context.Context, id string) (*entity.AckEvent, error) {
+	return r.next.FindByID(ctx, id)
+}
+
+// FindLatestByAlertID returns the latest held ack event of the alert, or
+// else the latest stored one.
+func (r *AckEventRepository) FindLatestByAlertID(ctx context.Context, alertID string) (*entity.AckEvent, error) {
+	r.buffer.mu.Lock()
+	for i := len(r.buffer.heldAcks) - 1; i >= 0; i-- {
+		if event := r.buffer.heldAcks[i]; event.AlertID == alertID {
+			r.buffer.mu.Unlock()
+			return event, nil
+		}
+	}
+	r.buffer.mu.Unlock()
+	return r.next.FindLatestByAlertID(ctx, alertID)
+}
+
+// GetTopAcknowledgers counts stored acknowledgments.
+func (r *AckEventRepository) GetTopAcknowledgers(ctx context.Context, limit int) ([]*entity.UserAckCount, error) {
+	return r.next.GetTopAcknowledgers(ctx, limit)
+}
+
+// DeleteBefore removes stored ack events created before the given time.
+// Held events are kept until flushed.
+func (r *AckEventRepository) DeleteBefore(ctx context.Context, before time.Time) (int, error) {
+	return r.next.DeleteBefore(ctx, before)
+}
@@ -0,0 +1,55 @@
+package buffered
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+)
+
+// TransactionManager runs transactions on the underlying manager, and runs
+// them without a transaction while writes are held, since held writes
+// cannot be rolled back.
+type TransactionManager struct {
+	next   repository.TransactionManager
+	buffer *Buffer
+}
+
+// NewTransactionManager wraps next.
+func NewTransactionManager(next repository.TransactionManager, buffer *Buffer) *TransactionManager {
+	return &TransactionManager{next: next, buffer: buffer}
+}
+
+// BeginTx starts a transaction on the underlying manager.
+func (m *TransactionManager) BeginTx(ctx context.Context) (repository.Transaction, error) {
+	return m.next.BeginTx(ctx)
+}
+
+// WithTransaction runs fn in a transaction. While degraded, or if the
+// transaction cannot be started because storage is unavailable, fn runs
+// without one and its writes are held.
+func (m *TransactionManager) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if m.buffer.Status().Degraded {
+		return fn(ctx)
+	}
+
+	tx, err := m.next.BeginTx(ctx)
+	if err != nil {
+		if m.buffer.degrade(ctx, err) {
+			return fn(ctx)
+		}
+		return err
+	}
+
+	txCtx := repository.NewContextWithTx(ctx, tx)
+	if err := fn(txCtx); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("rollback after error %w: %v", err, rbErr)
+		}
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+// Package fieldcrypt encrypts free-text fields, such as ack and alert notes,
+// before the SQL backends store them.
+package fieldcrypt
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// prefix marks encrypted values. Values without it were stored before
+// encryption was enabled and are read as they are.
+const prefix = "enc:v1:"
+
+// ErrNoKey is returned when reading an encrypted value without a key.
+var ErrNoKey = errors.New("value is encrypted but no encryption key is configured")
+
+// Cipher encrypts fields with AES-256-GCM. A nil Cipher stores fields as
+// they are.
+type Cipher struct {
+	aead cipher.AEAD
+}
+
+// NewCipher creates a cipher from a base64-encoded 32-byte key.
+func NewCipher(key string) (*Cipher, error) {
+	raw, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, fmt.Errorf("decoding encryption key: %w", err)
+	}
+	if len(raw) != 32 {
+		return nil, fmt.Errorf("encryption key must be 32 bytes, got %d", len(raw))
+	}
+
+	block, err := aes.NewCipher(raw)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &Cipher{aead: aead}, nil
+}
+
+// Encrypt returns the stored form of text. Empty text stays empty.
+func (c *Cipher) Encrypt(text string) (string, error) {
+	if c == nil || text == "" {
+		return text, nil
+	}
+
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("generating nonce: %w", err)
+	}
+	sealed := c.aead.Seal(nonce, nonce, []byte(text), nil)
+	return prefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt returns the text of a stored value.
+func (c *Cipher) Decrypt(value string) (string, error) {
+	encoded, ok := strings.CutPrefix(value, prefix)
+	if !ok {
+		return value, nil
+	}
+	if c == nil {
+		return "", ErrNoKey
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", fmt.Errorf("decoding encrypted value: %w", err)
+	}
+	size := c.aead.NonceSize()
+	if len(sealed) < size {
+		return "", errors.New("encrypted value is too short")
+	}
+	text, err := c.aead.Open(nil, sealed[:size], sealed[size:], nil)
+	if err != nil {
+		return "", fmt.Errorf("decrypting value: %w", err)
+	}
+	return string(text), nil
+}
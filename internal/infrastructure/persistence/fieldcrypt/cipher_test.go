@@ -0,0 +1,60 @@
+package fieldcrypt
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testKey = base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef"))
+
+func TestCipher(t *testing.T) {
+	c, err := NewCipher(testKey)
+	require.NoError(t, err)
+
+	stored, err := c.Encrypt("db failover, see INC-42")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(stored, prefix))
+	assert.NotContains(t, stored, "INC-42")
+
+	text, err := c.Decrypt(stored)
+	require.NoError(t, err)
+	assert.Equal(t, "db failover, see INC-42", text)
+
+	// Values stored before encryption are read as they are
+	text, err = c.Decrypt("plain note")
+	require.NoError(t, err)
+	assert.Equal(t, "plain note", text)
+
+	stored, err = c.Encrypt("")
+	require.NoError(t, err)
+	assert.Empty(t, stored)
+}
+
+func TestCipher_Nil(t *testing.T) {
+	var c *Cipher
+	stored, err := c.Encrypt("note")
+	require.NoError(t, err)
+	assert.Equal(t, "note", stored)
+
+	_, err = c.Decrypt(prefix + "AAAA")
+	assert.ErrorIs(t, err, ErrNoKey)
+}
+
+func TestCipher_WrongKey(t *testing.T) {
+	c, err := NewCipher(testKey)
+	require.NoError(t, err)
+	stored, err := c.Encrypt("note")
+	require.NoError(t, err)
+
+	other, err := NewCipher(base64.StdEncoding.EncodeToString([]byte("fedcba9876543210fedcba9876543210")))
+	require.NoError(t, err)
+	_, err = other.Decrypt(stored)
+	assert.Error(t, err)
+
+	_, err = NewCipher(base64.StdEncoding.EncodeToString([]byte("short")))
+	assert.ErrorContains(t, err, "32 bytes")
+}
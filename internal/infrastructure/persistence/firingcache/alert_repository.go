@@ -0,0 +1,202 @@
+// Package firingcache keeps an in-memory set of the fingerprints of firing
+// alerts, so duplicate webhooks for an alert that is already firing can be
+// recognized without a storage query.
+package firingcache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+)
+
+// AlertRepository records the firing alerts written through it, or found by
+// fingerprint, and forwards every call to the underlying repository.
+// Entries expire after the TTL, which bounds how long a change written by
+// another replica, such as a resolution, goes unnoticed.
+type AlertRepository struct {
+	next repository.AlertRepository
+	ttl  time.Duration
+	now  func() time.Time
+
+	mu            sync.RWMutex
+	byFingerprint map[string]entry
+	fingerprints  map[string]string // alert ID to fingerprint
+}
+
+type entry struct {
+	alertID  string
+	severity entity.AlertSeverity
+	cachedAt time.Time
+}
+
+// NewAlertRepository wraps next, keeping entries for ttl.
+func NewAlertRepository(next repository.AlertRepository, ttl time.Duration) *AlertRepository {
+	return &AlertRepository{
+		next:          next,
+		ttl:           ttl,
+		now:           time.Now,
+		byFingerprint: make(map[string]entry),
+		fingerprints:  make(map[string]string),
+	}
+}
+
+// Firing returns the ID and severity of the firing alert with fingerprint,
+// if one was written or found within the TTL.
+func (r *AlertRepository) Firing(fingerprint string) (alertID string, severity entity.AlertSeverity, ok bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	e, ok := r.byFingerprint[fingerprint]
+	if !ok || r.now().Sub(e.cachedAt) >= r.ttl {
+		return "", "", false
+	}
+	return e.alertID, e.severity, true
+}
+
+// record adds alert if it is firing, and removes its fingerprint otherwise.
+func (r *AlertRepository) record(alert *entity.Alert) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if alert.IsFiring() {
+		r.byFingerprint[alert.Fingerprint] = entry{alertID: alert.ID, severity: alert.Severity, cachedAt: r.now()}
+		r.fingerprints[alert.ID] = alert.Fingerprint
+		return
+	}
+	r.removeLocked(alert.ID)
+}
+
+// forget removes the fingerprint of the alert with id.
+func (r *AlertRepository) forget(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.removeLocked(id)
+}
+
+func (r *AlertRepository) removeLocked(id string) {
+	fingerprint, ok := r.fingerprints[id]
+	if !ok {
+		return
+	}
+	delete(r.fingerprints, id)
+	if e, ok := r.byFingerprint[fingerprint]; ok && e.alertID == id {
+		delete(r.byFingerprint, fingerprint)
+	}
+}
+
+// Save persists a new alert, recording it if firing. On failure the alert
+// is forgotten, since its stored state is unknown.
+func (r *AlertRepository) Save(ctx context.Context, alert *entity.Alert) error {
+	if err := r.next.Save(ctx, alert); err != nil {
+		r.forget(alert.ID)
+		return err
+	}
+	r.record(alert)
+	return nil
+}
+
+// Update modifies an existing alert, recording it if still firing and
+// removing it otherwise. On failure the alert is forgotten.
+func (r *AlertRepository) Update(ctx context.Context, alert *entity.Alert) error {
+	if err := r.next.Update(ctx, alert); err != nil {
+		r.forget(alert.ID)
+		return err
+	}
+	r.record(alert)
+	return nil
+}
+
+// FindByFingerprint finds alerts matching the fingerprint, refreshing the
+// entry of the fingerprint from the result.
+func (r *AlertRepository) FindByFingerprint(ctx context.Context, fingerprint string) ([]*entity.Alert, error) {
+	alerts, err := r.next.FindByFingerprint(ctx, fingerprint)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if e, ok := r.byFingerprint[fingerprint]; ok {
+		delete(r.fingerprints, e.alertID)
+		delete(r.byFingerprint, fingerprint)
+	}
+	for _, alert := range alerts {
+		if alert.IsFiring() {
+			r.byFingerprint[fingerprint] = entry{alertID: alert.ID, severity: alert.Severity, cachedAt: r.now()}
+			r.fingerprints[alert.ID] = fingerprint
+			break
+		}
+	}
+	return alerts, nil
+}
+
+// Delete soft-deletes an alert by ID and forgets it.
+func (r *AlertRepository) Delete(ctx context.Context, id string) error {
+	r.forget(id)
+	return r.next.Delete(ctx, id)
+}
+
+// Restore brings back a soft-deleted alert. It is recorded again once
+// written or found by fingerprint.
+func (r *AlertRepository) Restore(ctx context.Context, id string) error {
+	return r.next.Restore(ctx, id)
+}
+
+// FindResolvedBefore returns alerts resolved before the given time.
+// Resolved alerts are not cached.
+func (r *AlertRepository) FindResolvedBefore(ctx context.Context, before time.Time, limit int) ([]*entity.Alert, error) {
+	return r.next.FindResolvedBefore(ctx, before, limit)
+}
+
+// DeleteResolvedBefore permanently removes alerts resolved before the given
+// time. Resolved alerts are not cached.
+func (r *AlertRepository) DeleteResolvedBefore(ctx context.Context, before time.Time) (int, error) {
+	return r.next.DeleteResolvedBefore(ctx, before)
+}
+
+// DeleteResolvedByIDs permanently removes the given resolved alerts.
+// Resolved alerts are not cached.
+func (r *AlertRepository) DeleteResolvedByIDs(ctx context.Context, ids []string, before time.Time) (int, error) {
+	return r.next.DeleteResolvedByIDs(ctx, ids, before)
+}
+
+// FindByID retrieves an alert by its unique identifier.
+func (r *AlertRepository) FindByID(ctx context.Context, id string) (*entity.Alert, error) {
+	return r.next.FindByID(ctx, id)
+}
+
+// FindByExternalReference finds an alert by its external system reference.
+func (r *AlertRepository) FindByExternalReference(ctx context.Context, system, referenceID string) (*entity.Alert, error) {
+	return r.next.FindByExternalReference(ctx, system, referenceID)
+}
+
+// FindActive returns all currently active alerts.
+func (r *AlertRepository) FindActive(ctx context.Context) ([]*entity.Alert, error) {
+	return r.next.FindActive(ctx)
+}
+
+// GetActiveAlerts returns active alerts, optionally filtered by severity.
+func (r *AlertRepository) GetActiveAlerts(ctx context.Context, severity string) ([]*entity.Alert, error) {
+	return r.next.GetActiveAlerts(ctx, severity)
+}
+
+// FindFiring returns all firing alerts.
+func (r *AlertRepository) FindFiring(ctx context.Context) ([]*entity.Alert, error) {
+	return r.next.FindFiring(ctx)
+}
+
+// FindChangedSince returns alerts still firing or changed since the time.
+func (r *AlertRepository) FindChangedSince(ctx context.Context, since time.Time) ([]*entity.Alert, error) {
+	return r.next.FindChangedSince(ctx, since)
+}
+
+// FindActiveAt returns alerts active at the given time.
+func (r *AlertRepository) FindActiveAt(ctx context.Context, at time.Time) ([]*entity.Alert, error) {
+	return r.next.FindActiveAt(ctx, at)
+}
+
+// Search returns the page of alerts matching query and the total count.
+func (r *AlertRepository) Search(ctx context.Context, query entity.AlertQuery) ([]*entity.Alert, int, error) {
+	return r.next.Search(ctx, query)
+}
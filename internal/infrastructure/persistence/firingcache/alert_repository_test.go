@@ -0,0 +1,106 @@
+package firingcache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/memory"
+)
+
+// failingAlerts fails updates while down.
+type failingAlerts struct {
+	*memory.AlertRepository
+	down bool
+}
+
+func (r *failingAlerts) Update(ctx context.Context, alert *entity.Alert) error {
+	if r.down {
+		return errors.New("dial tcp 10.0.0.5:3306: connect: connection refused")
+	}
+	return r.AlertRepository.Update(ctx, alert)
+}
+
+func TestAlertRepository(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	next := &failingAlerts{AlertRepository: memory.NewAlertRepository()}
+	repo := NewAlertRepository(next, 30*time.Second)
+	repo.now = func() time.Time { return now }
+
+	assertFiring := func(t *testing.T, alert *entity.Alert) {
+		t.Helper()
+		id, severity, ok := repo.Firing(alert.Fingerprint)
+		require.True(t, ok, "fingerprint %s not known as firing", alert.Fingerprint)
+		assert.Equal(t, alert.ID, id)
+		assert.Equal(t, alert.Severity, severity)
+	}
+	assertUnknown := func(t *testing.T, fingerprint string) {
+		t.Helper()
+		_, _, ok := repo.Firing(fingerprint)
+		assert.False(t, ok, "fingerprint %s known as firing", fingerprint)
+	}
+
+	alert := entity.NewAlert("fp-1", "HighCPU", "web-1", "", "CPU above 90%", entity.SeverityWarning)
+	require.NoError(t, repo.Save(ctx, alert))
+	assertFiring(t, alert)
+
+	t.Run("writes keep entries in sync", func(t *testing.T) {
+		alert.ChangeSeverity(entity.SeverityCritical, now)
+		require.NoError(t, repo.Update(ctx, alert))
+		assertFiring(t, alert)
+
+		require.NoError(t, alert.Acknowledge("alice", now))
+		require.NoError(t, repo.Update(ctx, alert))
+		assertFiring(t, alert)
+
+		alert.Resolve(now)
+		require.NoError(t, repo.Update(ctx, alert))
+		assertUnknown(t, "fp-1")
+	})
+
+	t.Run("lookups seed and refresh entries", func(t *testing.T) {
+		other := entity.NewAlert("fp-2", "DiskFull", "db-1", "", "", entity.SeverityWarning)
+		require.NoError(t, next.Save(ctx, other))
+		assertUnknown(t, "fp-2")
+
+		_, err := repo.FindByFingerprint(ctx, "fp-2")
+		require.NoError(t, err)
+		assertFiring(t, other)
+
+		// Resolved by another replica
+		other.Resolve(now)
+		require.NoError(t, next.Update(ctx, other))
+		_, err = repo.FindByFingerprint(ctx, "fp-2")
+		require.NoError(t, err)
+		assertUnknown(t, "fp-2")
+	})
+
+	t.Run("entries expire", func(t *testing.T) {
+		fresh := entity.NewAlert("fp-3", "HighCPU", "web-2", "", "", entity.SeverityWarning)
+		require.NoError(t, repo.Save(ctx, fresh))
+		now = now.Add(29 * time.Second)
+		assertFiring(t, fresh)
+		now = now.Add(time.Second)
+		assertUnknown(t, "fp-3")
+	})
+
+	t.Run("failed writes and deletes forget the alert", func(t *testing.T) {
+		failed := entity.NewAlert("fp-4", "HighCPU", "web-3", "", "", entity.SeverityWarning)
+		require.NoError(t, repo.Save(ctx, failed))
+		next.down = true
+		assert.Error(t, repo.Update(ctx, failed))
+		next.down = false
+		assertUnknown(t, "fp-4")
+
+		deleted := entity.NewAlert("fp-5", "HighCPU", "web-4", "", "", entity.SeverityWarning)
+		require.NoError(t, repo.Save(ctx, deleted))
+		require.NoError(t, repo.Delete(ctx, deleted.ID))
+		assertUnknown(t, "fp-5")
+	})
+}
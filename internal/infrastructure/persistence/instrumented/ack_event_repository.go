@@ -0,0 +1,69 @@
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/observability"
+)
+
+// AckEventRepository records metrics for an underlying repository.AckEventRepository.
+type AckEventRepository struct {
+	next    repository.AckEventRepository
+	metrics *observability.Metrics
+}
+
+// NewAckEventRepository wraps next with operation metrics.
+func NewAckEventRepository(next repository.AckEventRepository, metrics *observability.Metrics) *AckEventRepository {
+	return &AckEventRepository{next: next, metrics: metrics}
+}
+
+// Save persists a new ack event.
+func (r *AckEventRepository) Save(ctx context.Context, event *entity.AckEvent) error {
+	ctx, op := startOperation(ctx, r.metrics, "save", entityAckEvent)
+	err := r.next.Save(ctx, event)
+	op.end(err)
+	return err
+}
+
+// FindByAlertID retrieves all ack events for an alert.
+func (r *AckEventRepository) FindByAlertID(ctx context.Context, alertID string) ([]*entity.AckEvent, error) {
+	ctx, op := startOperation(ctx, r.metrics, "find_by_alert_id", entityAckEvent)
+	events, err := r.next.FindByAlertID(ctx, alertID)
+	op.end(err)
+	return events, err
+}
+
+// FindByID retrieves an ack event by its ID.
+func (r *AckEventRepository) FindByID(ctx context.Context, id string) (*entity.AckEvent, error) {
+	ctx, op := startOperation(ctx, r.metrics, "find_by_id", entityAckEvent)
+	event, err := r.next.FindByID(ctx, id)
+	op.end(err)
+	return event, err
+}
+
+// FindLatestByAlertID retrieves the most recent ack event for an alert.
+func (r *AckEventRepository) FindLatestByAlertID(ctx context.Context, alertID string) (*entity.AckEvent, error) {
+	ctx, op := startOperation(ctx, r.metrics, "find_latest_by_alert_id", entityAckEvent)
+	event, err := r.next.FindLatestByAlertID(ctx, alertID)
+	op.end(err)
+	return event, err
+}
+
+// GetTopAcknowledgers returns users with the most acknowledgments.
+func (r *AckEventRepository) GetTopAcknowledgers(ctx context.Context, limit int) ([]*entity.UserAckCount, error) {
+	ctx, op := startOperation(ctx, r.metrics, "get_top_acknowledgers", entityAckEvent)
+	counts, err := r.next.GetTopAcknowledgers(ctx, limit)
+	op.end(err)
+	return counts, err
+}
+
+// DeleteBefore removes ack events created before the given time.
+func (r *AckEventRepository) DeleteBefore(ctx context.Context, before time.Time) (int, error) {
+	ctx, op := startOperation(ctx, r.metrics, "delete_before", entityAckEvent)
+	n, err := r.next.DeleteBefore(ctx, before)
+	op.end(err)
+	return n, err
+}
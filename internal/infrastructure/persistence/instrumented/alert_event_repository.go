@@ -0,0 +1,36 @@
+package instrumented
+
+import (
+	"context"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/observability"
+)
+
+// AlertEventRepository records metrics for an underlying repository.AlertEventRepository.
+type AlertEventRepository struct {
+	next    repository.AlertEventRepository
+	metrics *observability.Metrics
+}
+
+// NewAlertEventRepository wraps next with operation metrics.
+func NewAlertEventRepository(next repository.AlertEventRepository, metrics *observability.Metrics) *AlertEventRepository {
+	return &AlertEventRepository{next: next, metrics: metrics}
+}
+
+// Save appends an event to an alert's timeline.
+func (r *AlertEventRepository) Save(ctx context.Context, event *entity.AlertEvent) error {
+	ctx, op := startOperation(ctx, r.metrics, "save", entityAlertEvent)
+	err := r.next.Save(ctx, event)
+	op.end(err)
+	return err
+}
+
+// FindByAlertID retrieves an alert's timeline.
+func (r *AlertEventRepository) FindByAlertID(ctx context.Context, alertID string) ([]*entity.AlertEvent, error) {
+	ctx, op := startOperation(ctx, r.metrics, "find_by_alert_id", entityAlertEvent)
+	events, err := r.next.FindByAlertID(ctx, alertID)
+	op.end(err)
+	return events, err
+}
@@ -0,0 +1,149 @@
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/observability"
+)
+
+// AlertRepository records metrics for an underlying repository.AlertRepository.
+type AlertRepository struct {
+	next    repository.AlertRepository
+	metrics *observability.Metrics
+}
+
+// NewAlertRepository wraps next with operation metrics.
+func NewAlertRepository(next repository.AlertRepository, metrics *observability.Metrics) *AlertRepository {
+	return &AlertRepository{next: next, metrics: metrics}
+}
+
+// Save persists a new alert.
+func (r *AlertRepository) Save(ctx context.Context, alert *entity.Alert) error {
+	ctx, op := startOperation(ctx, r.metrics, "save", entityAlert)
+	err := r.next.Save(ctx, alert)
+	op.end(err)
+	return err
+}
+
+// FindByID retrieves an alert by its unique identifier.
+func (r *AlertRepository) FindByID(ctx context.Context, id string) (*entity.Alert, error) {
+	ctx, op := startOperation(ctx, r.metrics, "find_by_id", entityAlert)
+	alert, err := r.next.FindByID(ctx, id)
+	op.end(err)
+	return alert, err
+}
+
+// FindByFingerprint finds alerts matching the fingerprint.
+func (r *AlertRepository) FindByFingerprint(ctx context.Context, fingerprint string) ([]*entity.Alert, error) {
+	ctx, op := startOperation(ctx, r.metrics, "find_by_fingerprint", entityAlert)
+	alerts, err := r.next.FindByFingerprint(ctx, fingerprint)
+	op.end(err)
+	return alerts, err
+}
+
+// FindByExternalReference finds an alert by its external system reference.
+func (r *AlertRepository) FindByExternalReference(ctx context.Context, system, referenceID string) (*entity.Alert, error) {
+	ctx, op := startOperation(ctx, r.metrics, "find_by_external_reference", entityAlert)
+	alert, err := r.next.FindByExternalReference(ctx, system, referenceID)
+	op.end(err)
+	return alert, err
+}
+
+// Update modifies an existing alert.
+func (r *AlertRepository) Update(ctx context.Context, alert *entity.Alert) error {
+	ctx, op := startOperation(ctx, r.metrics, "update", entityAlert)
+	err := r.next.Update(ctx, alert)
+	op.end(err)
+	return err
+}
+
+// FindActive returns all currently active alerts.
+func (r *AlertRepository) FindActive(ctx context.Context) ([]*entity.Alert, error) {
+	ctx, op := startOperation(ctx, r.metrics, "find_active", entityAlert)
+	alerts, err := r.next.FindActive(ctx)
+	op.end(err)
+	return alerts, err
+}
+
+// GetActiveAlerts returns active alerts, optionally filtered by severity.
+func (r *AlertRepository) GetActiveAlerts(ctx context.Context, severity string) ([]*entity.Alert, error) {
+	ctx, op := startOperation(ctx, r.metrics, "get_active_alerts", entityAlert)
+	alerts, err := r.next.GetActiveAlerts(ctx, severity)
+	op.end(err)
+	return alerts, err
+}
+
+// FindFiring returns all firing alerts.
+func (r *AlertRepository) FindFiring(ctx context.Context) ([]*entity.Alert, error) {
+	ctx, op := startOperation(ctx, r.metrics, "find_firing", entityAlert)
+	alerts, err := r.next.FindFiring(ctx)
+	op.end(err)
+	return alerts, err
+}
+
+// FindChangedSince returns alerts still firing or changed at or after since.
+func (r *AlertRepository) FindChangedSince(ctx context.Context, since time.Time) ([]*entity.Alert, error) {
+	ctx, op := startOperation(ctx, r.metrics, "find_changed_since", entityAlert)
+	alerts, err := r.next.FindChangedSince(ctx, since)
+	op.end(err)
+	return alerts, err
+}
+
+// FindActiveAt returns alerts that were firing at the given time.
+func (r *AlertRepository) FindActiveAt(ctx context.Context, at time.Time) ([]*entity.Alert, error) {
+	ctx, op := startOperation(ctx, r.metrics, "find_active_at", entityAlert)
+	alerts, err := r.next.FindActiveAt(ctx, at)
+	op.end(err)
+	return alerts, err
+}
+
+// Search returns the page of alerts matching query and the total matches.
+func (r *AlertRepository) Search(ctx context.Context, query entity.AlertQuery) ([]*entity.Alert, int, error) {
+	ctx, op := startOperation(ctx, r.metrics, "search", entityAlert)
+	alerts, total, err := r.next.Search(ctx, query)
+	op.end(err)
+	return alerts, total, err
+}
+
+// Delete soft-deletes an alert by ID.
+func (r *AlertRepository) Delete(ctx context.Context, id string) error {
+	ctx, op := startOperation(ctx, r.metrics, "delete", entityAlert)
+	err := r.next.Delete(ctx, id)
+	op.end(err)
+	return err
+}
+
+// Restore brings back a soft-deleted alert.
+func (r *AlertRepository) Restore(ctx context.Context, id string) error {
+	ctx, op := startOperation(ctx, r.metrics, "restore", entityAlert)
+	err := r.next.Restore(ctx, id)
+	op.end(err)
+	return err
+}
+
+// FindResolvedBefore returns alerts resolved before the given time.
+func (r *AlertRepository) FindResolvedBefore(ctx context.Context, before time.Time, limit int) ([]*entity.Alert, error) {
+	ctx, op := startOperation(ctx, r.metrics, "find_resolved_before", entityAlert)
+	alerts, err := r.next.FindResolvedBefore(ctx, before, limit)
+	op.end(err)
+	return alerts, err
+}
+
+// DeleteResolvedBefore permanently removes alerts resolved before the given time.
+func (r *AlertRepository) DeleteResolvedBefore(ctx context.Context, before time.Time) (int, error) {
+	ctx, op := startOperation(ctx, r.metrics, "delete_resolved_before", entityAlert)
+	n, err := r.next.DeleteResolvedBefore(ctx, before)
+	op.end(err)
+	return n, err
+}
+
+// DeleteResolvedByIDs permanently removes the given resolved alerts.
+func (r *AlertRepository) DeleteResolvedByIDs(ctx context.Context, ids []string, before time.Time) (int, error) {
+	ctx, op := startOperation(ctx, r.metrics, "delete_resolved_by_ids", entityAlert)
+	n, err := r.next.DeleteResolvedByIDs(ctx, ids, before)
+	op.end(err)
+	return n, err
+}
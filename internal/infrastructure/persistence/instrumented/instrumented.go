@@ -0,0 +1,60 @@
+// Package instrumented wraps repositories so every storage operation gets a
+// trace span and is counted and timed, whichever backend is configured.
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/observability"
+)
+
+// Entity names used as the "entity" metric and span attribute.
+const (
+	entityAlert      = "alert"
+	entityAckEvent   = "ack_event"
+	entityAlertEvent = "alert_event"
+	entitySilence    = "silence"
+	entitySavedView  = "saved_view"
+	entityRetry      = "notification_retry"
+)
+
+// operation is one in-flight repository call.
+type operation struct {
+	ctx     context.Context
+	metrics *observability.Metrics
+	name    string
+	entity  string
+	start   time.Time
+	span    trace.Span
+}
+
+// startOperation starts a client span for the call and returns the context
+// to pass to the underlying repository.
+func startOperation(ctx context.Context, metrics *observability.Metrics, name, entity string) (context.Context, *operation) {
+	ctx, span := observability.Tracer().Start(ctx, "repository "+entity+"."+name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.operation.name", name),
+			attribute.String("entity", entity),
+		),
+	)
+	return ctx, &operation{
+		ctx:     ctx,
+		metrics: metrics,
+		name:    name,
+		entity:  entity,
+		start:   time.Now(),
+		span:    span,
+	}
+}
+
+// end records the operation. A non-nil err marks it as failed; "not found"
+// results returned as nil, nil count as successes.
+func (op *operation) end(err error) {
+	op.metrics.RecordRepositoryOperation(op.ctx, op.name, op.entity, time.Since(op.start), err == nil)
+	observability.EndSpan(op.span, err)
+}
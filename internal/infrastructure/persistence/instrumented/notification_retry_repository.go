@@ -0,0 +1,61 @@
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/observability"
+)
+
+// NotificationRetryRepository records metrics for an underlying repository.NotificationRetryRepository.
+type NotificationRetryRepository struct {
+	next    repository.NotificationRetryRepository
+	metrics *observability.Metrics
+}
+
+// NewNotificationRetryRepository wraps next with operation metrics.
+func NewNotificationRetryRepository(next repository.NotificationRetryRepository, metrics *observability.Metrics) *NotificationRetryRepository {
+	return &NotificationRetryRepository{next: next, metrics: metrics}
+}
+
+// Save creates or replaces a retry.
+func (r *NotificationRetryRepository) Save(ctx context.Context, retry *entity.NotificationRetry) error {
+	ctx, op := startOperation(ctx, r.metrics, "save", entityRetry)
+	err := r.next.Save(ctx, retry)
+	op.end(err)
+	return err
+}
+
+// FindDue returns pending retries due at now.
+func (r *NotificationRetryRepository) FindDue(ctx context.Context, now time.Time, limit int) ([]*entity.NotificationRetry, error) {
+	ctx, op := startOperation(ctx, r.metrics, "find_due", entityRetry)
+	retries, err := r.next.FindDue(ctx, now, limit)
+	op.end(err)
+	return retries, err
+}
+
+// FindByID retrieves a retry by ID.
+func (r *NotificationRetryRepository) FindByID(ctx context.Context, id string) (*entity.NotificationRetry, error) {
+	ctx, op := startOperation(ctx, r.metrics, "find_by_id", entityRetry)
+	retry, err := r.next.FindByID(ctx, id)
+	op.end(err)
+	return retry, err
+}
+
+// FindDead returns the dead-lettered retries.
+func (r *NotificationRetryRepository) FindDead(ctx context.Context) ([]*entity.NotificationRetry, error) {
+	ctx, op := startOperation(ctx, r.metrics, "find_dead", entityRetry)
+	retries, err := r.next.FindDead(ctx)
+	op.end(err)
+	return retries, err
+}
+
+// Delete removes a retry by ID.
+func (r *NotificationRetryRepository) Delete(ctx context.Context, id string) error {
+	ctx, op := startOperation(ctx, r.metrics, "delete", entityRetry)
+	err := r.next.Delete(ctx, id)
+	op.end(err)
+	return err
+}
@@ -0,0 +1,52 @@
+package instrumented
+
+import (
+	"context"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/observability"
+)
+
+// SavedViewRepository records metrics for an underlying repository.SavedViewRepository.
+type SavedViewRepository struct {
+	next    repository.SavedViewRepository
+	metrics *observability.Metrics
+}
+
+// NewSavedViewRepository wraps next with operation metrics.
+func NewSavedViewRepository(next repository.SavedViewRepository, metrics *observability.Metrics) *SavedViewRepository {
+	return &SavedViewRepository{next: next, metrics: metrics}
+}
+
+// Save creates or replaces a saved view.
+func (r *SavedViewRepository) Save(ctx context.Context, view *entity.SavedView) error {
+	ctx, op := startOperation(ctx, r.metrics, "save", entitySavedView)
+	err := r.next.Save(ctx, view)
+	op.end(err)
+	return err
+}
+
+// FindByName returns the view named name that is visible to owner.
+func (r *SavedViewRepository) FindByName(ctx context.Context, owner, name string) (*entity.SavedView, error) {
+	ctx, op := startOperation(ctx, r.metrics, "find_by_name", entitySavedView)
+	view, err := r.next.FindByName(ctx, owner, name)
+	op.end(err)
+	return view, err
+}
+
+// FindVisible returns the owner's views and all shared views.
+func (r *SavedViewRepository) FindVisible(ctx context.Context, owner string) ([]*entity.SavedView, error) {
+	ctx, op := startOperation(ctx, r.metrics, "find_visible", entitySavedView)
+	views, err := r.next.FindVisible(ctx, owner)
+	op.end(err)
+	return views, err
+}
+
+// Delete removes the view with the given owner and name.
+func (r *SavedViewRepository) Delete(ctx context.Context, owner, name string) error {
+	ctx, op := startOperation(ctx, r.metrics, "delete", entitySavedView)
+	err := r.next.Delete(ctx, owner, name)
+	op.end(err)
+	return err
+}
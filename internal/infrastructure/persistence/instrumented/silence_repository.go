@@ -0,0 +1,109 @@
+package instrumented
+
+import (
+	"context"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/observability"
+)
+
+// SilenceRepository records metrics for an underlying repository.SilenceRepository.
+type SilenceRepository struct {
+	next    repository.SilenceRepository
+	metrics *observability.Metrics
+}
+
+// NewSilenceRepository wraps next with operation metrics.
+func NewSilenceRepository(next repository.SilenceRepository, metrics *observability.Metrics) *SilenceRepository {
+	return &SilenceRepository{next: next, metrics: metrics}
+}
+
+// Save persists a new silence.
+func (r *SilenceRepository) Save(ctx context.Context, silence *entity.SilenceMark) error {
+	ctx, op := startOperation(ctx, r.metrics, "save", entitySilence)
+	err := r.next.Save(ctx, silence)
+	op.end(err)
+	return err
+}
+
+// FindByID retrieves a silence by its ID.
+func (r *SilenceRepository) FindByID(ctx context.Context, id string) (*entity.SilenceMark, error) {
+	ctx, op := startOperation(ctx, r.metrics, "find_by_id", entitySilence)
+	silence, err := r.next.FindByID(ctx, id)
+	op.end(err)
+	return silence, err
+}
+
+// FindActive returns all currently active silences.
+func (r *SilenceRepository) FindActive(ctx context.Context) ([]*entity.SilenceMark, error) {
+	ctx, op := startOperation(ctx, r.metrics, "find_active", entitySilence)
+	silences, err := r.next.FindActive(ctx)
+	op.end(err)
+	return silences, err
+}
+
+// FindByAlertID retrieves active silences for a specific alert.
+func (r *SilenceRepository) FindByAlertID(ctx context.Context, alertID string) ([]*entity.SilenceMark, error) {
+	ctx, op := startOperation(ctx, r.metrics, "find_by_alert_id", entitySilence)
+	silences, err := r.next.FindByAlertID(ctx, alertID)
+	op.end(err)
+	return silences, err
+}
+
+// FindByInstance retrieves active silences for a specific instance.
+func (r *SilenceRepository) FindByInstance(ctx context.Context, instance string) ([]*entity.SilenceMark, error) {
+	ctx, op := startOperation(ctx, r.metrics, "find_by_instance", entitySilence)
+	silences, err := r.next.FindByInstance(ctx, instance)
+	op.end(err)
+	return silences, err
+}
+
+// FindByFingerprint retrieves active silences for a specific fingerprint.
+func (r *SilenceRepository) FindByFingerprint(ctx context.Context, fingerprint string) ([]*entity.SilenceMark, error) {
+	ctx, op := startOperation(ctx, r.metrics, "find_by_fingerprint", entitySilence)
+	silences, err := r.next.FindByFingerprint(ctx, fingerprint)
+	op.end(err)
+	return silences, err
+}
+
+// FindMatchingAlert returns all active silences that match the given alert.
+func (r *SilenceRepository) FindMatchingAlert(ctx context.Context, alert *entity.Alert) ([]*entity.SilenceMark, error) {
+	ctx, op := startOperation(ctx, r.metrics, "find_matching_alert", entitySilence)
+	silences, err := r.next.FindMatchingAlert(ctx, alert)
+	op.end(err)
+	return silences, err
+}
+
+// Update modifies an existing silence.
+func (r *SilenceRepository) Update(ctx context.Context, silence *entity.SilenceMark) error {
+	ctx, op := startOperation(ctx, r.metrics, "update", entitySilence)
+	err := r.next.Update(ctx, silence)
+	op.end(err)
+	return err
+}
+
+// Delete soft-deletes a silence by ID.
+func (r *SilenceRepository) Delete(ctx context.Context, id string) error {
+	ctx, op := startOperation(ctx, r.metrics, "delete", entitySilence)
+	err := r.next.Delete(ctx, id)
+	op.end(err)
+	return err
+}
+
+// Restore brings back a soft-deleted silence.
+func (r *SilenceRepository) Restore(ctx context.Context, id string) error {
+	ctx, op := startOperation(ctx, r.metrics, "restore", entitySilence)
+	err := r.next.Restore(ctx, id)
+	op.end(err)
+	return err
+}
+
+// DeleteExpired removes silences that expired before the given time.
+func (r *SilenceRepository) DeleteExpired(ctx context.Context, before time.Time) (int, error) {
+	ctx, op := startOperation(ctx, r.metrics, "delete_expired", entitySilence)
+	n, err := r.next.DeleteExpired(ctx, before)
+	op.end(err)
+	return n, err
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"sort"
 	"sync"
+	"time"
 
 	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
 )
@@ -102,6 +103,32 @@ func (r *AckEventRepository) FindLatestByAlertID(ctx context.Context, alertID st
 	return &eventCopy, nil
 }
 
+// DeleteBefore removes ack events created before the given time.
+func (r *AckEventRepository) DeleteBefore(ctx context.Context, before time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	purged := 0
+	for alertID, ids := range r.byAlertID {
+		kept := ids[:0]
+		for _, id := range ids {
+			if event, ok := r.events[id]; ok && event.CreatedAt.Before(before) {
+				delete(r.events, id)
+				purged++
+				continue
+			}
+			kept = append(kept, id)
+		}
+		if len(kept) == 0 {
+			delete(r.byAlertID, alertID)
+		} else {
+			r.byAlertID[alertID] = kept
+		}
+	}
+
+	return purged, nil
+}
+
 // GetTopAcknowledgers returns users with the most acknowledgments.
 // Limit specifies the maximum number of users to return.
 func (r *AckEventRepository) GetTopAcknowledgers(ctx context.Context, limit int) ([]*entity.UserAckCount, error) {
@@ -0,0 +1,49 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// AlertEventRepository provides an in-memory implementation of repository.AlertEventRepository.
+// Thread-safe for concurrent access.
+type AlertEventRepository struct {
+	mu        sync.RWMutex
+	byAlertID map[string][]*entity.AlertEvent // alertID -> events, in save order
+}
+
+// NewAlertEventRepository creates a new in-memory alert event repository.
+func NewAlertEventRepository() *AlertEventRepository {
+	return &AlertEventRepository{
+		byAlertID: make(map[string][]*entity.AlertEvent),
+	}
+}
+
+// Save appends an event to the alert's timeline.
+func (r *AlertEventRepository) Save(ctx context.Context, event *entity.AlertEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Store a copy to prevent external mutations
+	eventCopy := *event
+	r.byAlertID[event.AlertID] = append(r.byAlertID[event.AlertID], &eventCopy)
+
+	return nil
+}
+
+// FindByAlertID retrieves all events for an alert, oldest first.
+func (r *AlertEventRepository) FindByAlertID(ctx context.Context, alertID string) ([]*entity.AlertEvent, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	stored := r.byAlertID[alertID]
+	events := make([]*entity.AlertEvent, 0, len(stored))
+	for _, event := range stored {
+		eventCopy := *event
+		events = append(events, &eventCopy)
+	}
+
+	return events, nil
+}
@@ -2,7 +2,9 @@ package memory
 
 import (
 	"context"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
 )
@@ -14,6 +16,7 @@ type AlertRepository struct {
 	alerts        map[string]*entity.Alert     // id -> alert
 	byFingerprint map[string][]string          // fingerprint -> alert IDs
 	byExternalRef map[string]map[string]string // system -> (referenceID -> alert ID)
+	deleted       map[string]*entity.Alert     // id -> soft-deleted alert
 }
 
 // NewAlertRepository creates a new in-memory alert repository.
@@ -22,6 +25,7 @@ func NewAlertRepository() *AlertRepository {
 		alerts:        make(map[string]*entity.Alert),
 		byFingerprint: make(map[string][]string),
 		byExternalRef: make(map[string]map[string]string),
+		deleted:       make(map[string]*entity.Alert),
 	}
 }
 
@@ -33,25 +37,47 @@ func (r *AlertRepository) Save(ctx context.Context, alert *entity.Alert) error {
 	if _, exists := r.alerts[alert.ID]; exists {
 		return entity.ErrDuplicateAlert
 	}
+	if _, deleted := r.deleted[alert.ID]; deleted {
+		return entity.ErrDuplicateAlert
+	}
 
 	// Store a copy to prevent external mutations
 	alertCopy := *alert
-	r.alerts[alert.ID] = &alertCopy
+	r.add(&alertCopy)
+
+	return nil
+}
+
+// add stores an alert and indexes it.
+func (r *AlertRepository) add(alert *entity.Alert) {
+	r.alerts[alert.ID] = alert
 
 	// Index by fingerprint
 	r.byFingerprint[alert.Fingerprint] = append(r.byFingerprint[alert.Fingerprint], alert.ID)
 
-	// Index by external references
-	for system, refID := range alert.ExternalReferences {
-		if refID != "" {
-			if r.byExternalRef[system] == nil {
-				r.byExternalRef[system] = make(map[string]string)
+	r.indexExternalReferences(alert)
+}
+
+// indexExternalReferences indexes every reference of an alert, of any key.
+func (r *AlertRepository) indexExternalReferences(alert *entity.Alert) {
+	for _, ref := range alert.ExternalReferences {
+		if ref.ReferenceID != "" {
+			if r.byExternalRef[ref.System] == nil {
+				r.byExternalRef[ref.System] = make(map[string]string)
 			}
-			r.byExternalRef[system][refID] = alert.ID
+			r.byExternalRef[ref.System][ref.ReferenceID] = alert.ID
 		}
 	}
+}
 
-	return nil
+// unindexExternalReferences removes the references of an alert from the
+// index.
+func (r *AlertRepository) unindexExternalReferences(alert *entity.Alert) {
+	for _, ref := range alert.ExternalReferences {
+		if ref.ReferenceID != "" && r.byExternalRef[ref.System][ref.ReferenceID] == alert.ID {
+			delete(r.byExternalRef[ref.System], ref.ReferenceID)
+		}
+	}
 }
 
 // FindByID retrieves an alert by its unique identifier.
@@ -119,27 +145,9 @@ func (r *AlertRepository) Update(ctx context.Context, alert *entity.Alert) error
 		return entity.ErrAlertNotFound
 	}
 
-	// Update external reference indexes if changed
-	// Remove old references
-	for system, oldRefID := range existing.ExternalReferences {
-		newRefID := alert.GetExternalReference(system)
-		if oldRefID != newRefID && oldRefID != "" {
-			if r.byExternalRef[system] != nil {
-				delete(r.byExternalRef[system], oldRefID)
-			}
-		}
-	}
-
-	// Add new references
-	for system, newRefID := range alert.ExternalReferences {
-		oldRefID := existing.GetExternalReference(system)
-		if newRefID != oldRefID && newRefID != "" {
-			if r.byExternalRef[system] == nil {
-				r.byExternalRef[system] = make(map[string]string)
-			}
-			r.byExternalRef[system][newRefID] = alert.ID
-		}
-	}
+	// Reindex external references
+	r.unindexExternalReferences(existing)
+	r.indexExternalReferences(alert)
 
 	// Store updated copy
 	alertCopy := *alert
@@ -198,7 +206,56 @@ func (r *AlertRepository) GetActiveAlerts(ctx context.Context, severity string)
 	return active, nil
 }
 
-// Delete removes an alert by ID.
+// FindChangedSince returns alerts that are still firing, or that fired or
+// resolved at or after since.
+func (r *AlertRepository) FindChangedSince(ctx context.Context, since time.Time) ([]*entity.Alert, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var changed []*entity.Alert
+	for _, alert := range r.alerts {
+		if alert.IsFiring() || !alert.FiredAt.Before(since) ||
+			(alert.ResolvedAt != nil && !alert.ResolvedAt.Before(since)) {
+			alertCopy := *alert
+			changed = append(changed, &alertCopy)
+		}
+	}
+	return changed, nil
+}
+
+// FindActiveAt returns alerts that had fired by at and were not resolved
+// before it.
+func (r *AlertRepository) FindActiveAt(ctx context.Context, at time.Time) ([]*entity.Alert, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var alerts []*entity.Alert
+	for _, alert := range r.alerts {
+		if !alert.FiredAt.After(at) && (alert.ResolvedAt == nil || alert.ResolvedAt.After(at)) {
+			alertCopy := *alert
+			alerts = append(alerts, &alertCopy)
+		}
+	}
+	return alerts, nil
+}
+
+// Search returns the page of alerts matching query, newest fired first,
+// and the total number of matches.
+func (r *AlertRepository) Search(ctx context.Context, query entity.AlertQuery) ([]*entity.Alert, int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var matches []*entity.Alert
+	for _, alert := range r.alerts {
+		if query.Matches(alert) {
+			alertCopy := *alert
+			matches = append(matches, &alertCopy)
+		}
+	}
+	return query.Page(matches), len(matches), nil
+}
+
+// Delete soft-deletes an alert by ID. The alert is kept aside for Restore.
 func (r *AlertRepository) Delete(ctx context.Context, id string) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
@@ -208,22 +265,107 @@ func (r *AlertRepository) Delete(ctx context.Context, id string) error {
 		return entity.ErrAlertNotFound
 	}
 
+	r.remove(alert)
+	r.deleted[id] = alert
+	return nil
+}
+
+// remove drops an alert and its index entries.
+func (r *AlertRepository) remove(alert *entity.Alert) {
 	// Remove from external reference indexes
-	for system, refID := range alert.ExternalReferences {
-		if refID != "" && r.byExternalRef[system] != nil {
-			delete(r.byExternalRef[system], refID)
-		}
-	}
+	r.unindexExternalReferences(alert)
 
 	// Remove from fingerprint index
 	fps := r.byFingerprint[alert.Fingerprint]
 	for i, fpID := range fps {
-		if fpID == id {
+		if fpID == alert.ID {
 			r.byFingerprint[alert.Fingerprint] = append(fps[:i], fps[i+1:]...)
 			break
 		}
 	}
 
-	delete(r.alerts, id)
+	delete(r.alerts, alert.ID)
+}
+
+// Restore brings back a soft-deleted alert.
+func (r *AlertRepository) Restore(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	alert, deleted := r.deleted[id]
+	if !deleted {
+		return entity.ErrAlertNotFound
+	}
+
+	delete(r.deleted, id)
+	r.add(alert)
 	return nil
 }
+
+// FindResolvedBefore returns up to limit alerts resolved before the given
+// time, including soft-deleted ones, oldest resolved first.
+func (r *AlertRepository) FindResolvedBefore(ctx context.Context, before time.Time, limit int) ([]*entity.Alert, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var resolved []*entity.Alert
+	for _, alerts := range []map[string]*entity.Alert{r.alerts, r.deleted} {
+		for _, alert := range alerts {
+			if alert.ResolvedAt != nil && alert.ResolvedAt.Before(before) {
+				alertCopy := *alert
+				resolved = append(resolved, &alertCopy)
+			}
+		}
+	}
+	sort.Slice(resolved, func(i, j int) bool {
+		return resolved[i].ResolvedAt.Before(*resolved[j].ResolvedAt)
+	})
+	if len(resolved) > limit {
+		resolved = resolved[:limit]
+	}
+	return resolved, nil
+}
+
+// DeleteResolvedBefore permanently removes alerts resolved before the given
+// time, including soft-deleted ones.
+func (r *AlertRepository) DeleteResolvedBefore(ctx context.Context, before time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	purged := 0
+	for _, alert := range r.alerts {
+		if alert.ResolvedAt != nil && alert.ResolvedAt.Before(before) {
+			r.remove(alert)
+			purged++
+		}
+	}
+	for id, alert := range r.deleted {
+		if alert.ResolvedAt != nil && alert.ResolvedAt.Before(before) {
+			delete(r.deleted, id)
+			purged++
+		}
+	}
+
+	return purged, nil
+}
+
+// DeleteResolvedByIDs permanently removes the alerts with the given IDs
+// that are still resolved before the given time, including soft-deleted
+// ones.
+func (r *AlertRepository) DeleteResolvedByIDs(ctx context.Context, ids []string, before time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	purged := 0
+	for _, id := range ids {
+		if alert, ok := r.alerts[id]; ok && alert.ResolvedAt != nil && alert.ResolvedAt.Before(before) {
+			r.remove(alert)
+			purged++
+		} else if alert, ok := r.deleted[id]; ok && alert.ResolvedAt != nil && alert.ResolvedAt.Before(before) {
+			delete(r.deleted, id)
+			purged++
+		}
+	}
+
+	return purged, nil
+}
@@ -0,0 +1,100 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// NotificationRetryRepository provides an in-memory implementation of
+// repository.NotificationRetryRepository. Queued notifications are lost on
+// restart. Thread-safe for concurrent access.
+type NotificationRetryRepository struct {
+	mu      sync.RWMutex
+	retries map[string]*entity.NotificationRetry
+}
+
+// NewNotificationRetryRepository creates a new in-memory notification retry repository.
+func NewNotificationRetryRepository() *NotificationRetryRepository {
+	return &NotificationRetryRepository{
+		retries: make(map[string]*entity.NotificationRetry),
+	}
+}
+
+// Save creates or replaces a retry.
+func (r *NotificationRetryRepository) Save(ctx context.Context, retry *entity.NotificationRetry) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.retries[retry.ID] = retry.Copy()
+	return nil
+}
+
+// FindDue returns up to limit pending retries due at now, earliest first.
+func (r *NotificationRetryRepository) FindDue(ctx context.Context, now time.Time, limit int) ([]*entity.NotificationRetry, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	due := make([]*entity.NotificationRetry, 0)
+	for _, retry := range r.retries {
+		if !retry.IsDead() && !retry.NextAttemptAt.After(now) {
+			due = append(due, retry.Copy())
+		}
+	}
+
+	sort.Slice(due, func(i, j int) bool {
+		return due[i].NextAttemptAt.Before(due[j].NextAttemptAt)
+	})
+	if len(due) > limit {
+		due = due[:limit]
+	}
+
+	return due, nil
+}
+
+// FindByID retrieves a retry by ID. Returns nil, nil if not found.
+func (r *NotificationRetryRepository) FindByID(ctx context.Context, id string) (*entity.NotificationRetry, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	retry, ok := r.retries[id]
+	if !ok {
+		return nil, nil
+	}
+	return retry.Copy(), nil
+}
+
+// FindDead returns the dead-lettered retries, most recently failed first.
+func (r *NotificationRetryRepository) FindDead(ctx context.Context) ([]*entity.NotificationRetry, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	dead := make([]*entity.NotificationRetry, 0)
+	for _, retry := range r.retries {
+		if retry.IsDead() {
+			dead = append(dead, retry.Copy())
+		}
+	}
+
+	sort.Slice(dead, func(i, j int) bool {
+		return dead[i].UpdatedAt.After(dead[j].UpdatedAt)
+	})
+
+	return dead, nil
+}
+
+// Delete removes a retry.
+func (r *NotificationRetryRepository) Delete(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.retries[id]; !ok {
+		return entity.ErrNotificationRetryNotFound
+	}
+	delete(r.retries, id)
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// SavedViewRepository provides an in-memory implementation of repository.SavedViewRepository.
+// Thread-safe for concurrent access.
+type SavedViewRepository struct {
+	mu    sync.RWMutex
+	views map[savedViewKey]*entity.SavedView
+}
+
+// savedViewKey is the unique key of a view.
+type savedViewKey struct {
+	owner string
+	name  string
+}
+
+// NewSavedViewRepository creates a new in-memory saved view repository.
+func NewSavedViewRepository() *SavedViewRepository {
+	return &SavedViewRepository{
+		views: make(map[savedViewKey]*entity.SavedView),
+	}
+}
+
+// Save creates or replaces a view.
+func (r *SavedViewRepository) Save(ctx context.Context, view *entity.SavedView) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := savedViewKey{owner: view.Owner, name: view.Name}
+	viewCopy := view.Copy()
+	if existing, ok := r.views[key]; ok {
+		viewCopy.ID = existing.ID
+		viewCopy.CreatedAt = existing.CreatedAt
+	}
+	r.views[key] = viewCopy
+
+	return nil
+}
+
+// FindByName returns the view visible to owner, preferring the owner's own view.
+func (r *SavedViewRepository) FindByName(ctx context.Context, owner, name string) (*entity.SavedView, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if view, ok := r.views[savedViewKey{owner: owner, name: name}]; ok {
+		return view.Copy(), nil
+	}
+	if view, ok := r.views[savedViewKey{name: name}]; ok {
+		return view.Copy(), nil
+	}
+	return nil, nil
+}
+
+// FindVisible returns the owner's views and all shared views, ordered by name.
+func (r *SavedViewRepository) FindVisible(ctx context.Context, owner string) ([]*entity.SavedView, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	views := make([]*entity.SavedView, 0)
+	for key, view := range r.views {
+		if key.owner == "" || key.owner == owner {
+			views = append(views, view.Copy())
+		}
+	}
+
+	sort.Slice(views, func(i, j int) bool {
+		if views[i].Name != views[j].Name {
+			return views[i].Name < views[j].Name
+		}
+		// Personal view before the shared one of the same name
+		return views[i].Owner > views[j].Owner
+	})
+
+	return views, nil
+}
+
+// Delete removes a view.
+func (r *SavedViewRepository) Delete(ctx context.Context, owner, name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := savedViewKey{owner: owner, name: name}
+	if _, ok := r.views[key]; !ok {
+		return entity.ErrSavedViewNotFound
+	}
+	delete(r.views, key)
+
+	return nil
+}
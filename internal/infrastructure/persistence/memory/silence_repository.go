@@ -2,7 +2,9 @@ package memory
 
 import (
 	"context"
+	"slices"
 	"sync"
+	"time"
 
 	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
 )
@@ -15,6 +17,7 @@ type SilenceRepository struct {
 	byAlertID     map[string][]string            // alertID -> silence IDs
 	byInstance    map[string][]string            // instance -> silence IDs
 	byFingerprint map[string][]string            // fingerprint -> silence IDs
+	deleted       map[string]*entity.SilenceMark // id -> soft-deleted silence
 }
 
 // NewSilenceRepository creates a new in-memory silence repository.
@@ -24,6 +27,7 @@ func NewSilenceRepository() *SilenceRepository {
 		byAlertID:     make(map[string][]string),
 		byInstance:    make(map[string][]string),
 		byFingerprint: make(map[string][]string),
+		deleted:       make(map[string]*entity.SilenceMark),
 	}
 }
 
@@ -40,7 +44,15 @@ func (r *SilenceRepository) Save(ctx context.Context, silence *entity.SilenceMar
 			silenceCopy.Labels[k] = v
 		}
 	}
-	r.silences[silence.ID] = &silenceCopy
+	silenceCopy.Matchers = slices.Clone(silence.Matchers)
+	r.add(&silenceCopy)
+
+	return nil
+}
+
+// add stores a silence and indexes it.
+func (r *SilenceRepository) add(silence *entity.SilenceMark) {
+	r.silences[silence.ID] = silence
 
 	// Index by alert ID if set
 	if silence.AlertID != "" {
@@ -56,8 +68,6 @@ func (r *SilenceRepository) Save(ctx context.Context, silence *entity.SilenceMar
 	if silence.Fingerprint != "" {
 		r.byFingerprint[silence.Fingerprint] = append(r.byFingerprint[silence.Fingerprint], silence.ID)
 	}
-
-	return nil
 }
 
 // FindByID retrieves a silence by its ID.
@@ -199,12 +209,13 @@ func (r *SilenceRepository) Update(ctx context.Context, silence *entity.SilenceM
 			silenceCopy.Labels[k] = v
 		}
 	}
+	silenceCopy.Matchers = slices.Clone(silence.Matchers)
 	r.silences[silence.ID] = &silenceCopy
 
 	return nil
 }
 
-// Delete removes a silence by ID.
+// Delete soft-deletes a silence by ID. The silence is kept aside for Restore.
 func (r *SilenceRepository) Delete(ctx context.Context, id string) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
@@ -220,17 +231,34 @@ func (r *SilenceRepository) Delete(ctx context.Context, id string) error {
 	r.removeFromIndex(r.byFingerprint, silence.Fingerprint, id)
 
 	delete(r.silences, id)
+	r.deleted[id] = silence
+	return nil
+}
+
+// Restore brings back a soft-deleted silence.
+func (r *SilenceRepository) Restore(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	silence, deleted := r.deleted[id]
+	if !deleted {
+		return entity.ErrSilenceNotFound
+	}
+
+	delete(r.deleted, id)
+	r.add(silence)
 	return nil
 }
 
-// DeleteExpired removes all expired silences.
-func (r *SilenceRepository) DeleteExpired(ctx context.Context) (int, error) {
+// DeleteExpired removes silences that expired before the given time,
+// including soft-deleted ones.
+func (r *SilenceRepository) DeleteExpired(ctx context.Context, before time.Time) (int, error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
 	var expiredIDs []string
 	for id, silence := range r.silences {
-		if silence.IsExpired() {
+		if silence.EndAt.Before(before) {
 			expiredIDs = append(expiredIDs, id)
 		}
 	}
@@ -242,8 +270,15 @@ func (r *SilenceRepository) DeleteExpired(ctx context.Context) (int, error) {
 		r.removeFromIndex(r.byFingerprint, silence.Fingerprint, id)
 		delete(r.silences, id)
 	}
+	purged := len(expiredIDs)
+	for id, silence := range r.deleted {
+		if silence.EndAt.Before(before) {
+			delete(r.deleted, id)
+			purged++
+		}
+	}
 
-	return len(expiredIDs), nil
+	return purged, nil
 }
 
 // copySilence creates a deep copy of a silence.
@@ -255,6 +290,7 @@ func (r *SilenceRepository) copySilence(silence *entity.SilenceMark) *entity.Sil
 			silenceCopy.Labels[k] = v
 		}
 	}
+	silenceCopy.Matchers = slices.Clone(silence.Matchers)
 	return &silenceCopy
 }
 
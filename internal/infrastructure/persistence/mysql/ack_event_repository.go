@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
 	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
@@ -22,6 +23,11 @@ func NewAckEventRepository(db *DB) *AckEventRepository {
 // Save persists a new ack event.
 // Returns ErrNotFound if the referenced alert doesn't exist (FK constraint).
 func (r *AckEventRepository) Save(ctx context.Context, event *entity.AckEvent) error {
+	note, err := r.db.cipher.Encrypt(event.Note)
+	if err != nil {
+		return fmt.Errorf("encrypting ack event note: %w", err)
+	}
+
 	query := `
 		INSERT INTO ack_events (
 			id, alert_id, source,
@@ -36,14 +42,14 @@ func (r *AckEventRepository) Save(ctx context.Context, event *entity.AckEvent) e
 		)
 	`
 
-	_, err := r.db.Primary().ExecContext(ctx, query,
+	_, err = r.db.Primary().ExecContext(ctx, query,
 		event.ID,
 		event.AlertID,
 		string(event.Source),
 		nullString(event.UserID),
 		nullString(event.UserEmail),
 		nullString(event.UserName),
-		nullString(event.Note),
+		nullString(note),
 		durationToSeconds(event.Duration),
 		timeToTimestamp(event.CreatedAt),
 	)
@@ -78,7 +84,7 @@ func (r *AckEventRepository) FindByID(ctx context.Context, id string) (*entity.A
 	var userID, userEmail, userName, note sql.NullString
 	var durationSeconds sql.NullInt64
 
-	err := r.db.Replica().QueryRowContext(ctx, query, id).Scan(
+	err := r.db.Primary().QueryRowContext(ctx, query, id).Scan(
 		&event.ID,
 		&event.AlertID,
 		&event.Source,
@@ -101,7 +107,9 @@ func (r *AckEventRepository) FindByID(ctx context.Context, id string) (*entity.A
 	event.UserID = stringValue(userID)
 	event.UserEmail = stringValue(userEmail)
 	event.UserName = stringValue(userName)
-	event.Note = stringValue(note)
+	if event.Note, err = r.db.cipher.Decrypt(stringValue(note)); err != nil {
+		return nil, fmt.Errorf("decrypting ack event note: %w", err)
+	}
 	event.Duration = secondsToDuration(durationSeconds)
 
 	return &event, nil
@@ -150,7 +158,7 @@ func (r *AckEventRepository) FindLatestByAlertID(ctx context.Context, alertID st
 	var userID, userEmail, userName, note sql.NullString
 	var durationSeconds sql.NullInt64
 
-	err := r.db.Replica().QueryRowContext(ctx, query, alertID).Scan(
+	err := r.db.Primary().QueryRowContext(ctx, query, alertID).Scan(
 		&event.ID,
 		&event.AlertID,
 		&event.Source,
@@ -173,12 +181,32 @@ func (r *AckEventRepository) FindLatestByAlertID(ctx context.Context, alertID st
 	event.UserID = stringValue(userID)
 	event.UserEmail = stringValue(userEmail)
 	event.UserName = stringValue(userName)
-	event.Note = stringValue(note)
+	if event.Note, err = r.db.cipher.Decrypt(stringValue(note)); err != nil {
+		return nil, fmt.Errorf("decrypting ack event note: %w", err)
+	}
 	event.Duration = secondsToDuration(durationSeconds)
 
 	return &event, nil
 }
 
+// DeleteBefore removes ack events created before the given time.
+// Returns the number of deleted events.
+func (r *AckEventRepository) DeleteBefore(ctx context.Context, before time.Time) (int, error) {
+	query := `DELETE FROM ack_events WHERE created_at < ?`
+
+	result, err := r.db.Primary().ExecContext(ctx, query, before.UTC())
+	if err != nil {
+		return 0, fmt.Errorf("deleting ack events: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("checking rows affected: %w", err)
+	}
+
+	return int(rowsAffected), nil
+}
+
 // GetTopAcknowledgers returns users with the most acknowledgments.
 // Limit specifies the maximum number of users to return.
 func (r *AckEventRepository) GetTopAcknowledgers(ctx context.Context, limit int) ([]*entity.UserAckCount, error) {
@@ -250,7 +278,9 @@ func (r *AckEventRepository) scanAckEvents(rows *sql.Rows) ([]*entity.AckEvent,
 		event.UserID = stringValue(userID)
 		event.UserEmail = stringValue(userEmail)
 		event.UserName = stringValue(userName)
-		event.Note = stringValue(note)
+		if event.Note, err = r.db.cipher.Decrypt(stringValue(note)); err != nil {
+			return nil, fmt.Errorf("decrypting ack event note: %w", err)
+		}
 		event.Duration = secondsToDuration(durationSeconds)
 
 		events = append(events, &event)
@@ -262,7 +262,7 @@ func TestAckEventRepository_FindLatestByAlertID_NotFound(t *testing.T) {
 	assert.Nil(t, latest)
 }
 
-func TestAckEventRepository_CascadeDelete(t *testing.T) {
+func TestAckEventRepository_SoftDeleteKeepsEvents(t *testing.T) {
 	db := setupTestDB(t)
 	defer db.Close()
 
@@ -292,10 +292,10 @@ func TestAckEventRepository_CascadeDelete(t *testing.T) {
 	err = alertRepo.Delete(ctx, alert.ID)
 	require.NoError(t, err)
 
-	// Ack event should be cascade deleted
-	deleted, err := ackRepo.FindByID(ctx, event.ID)
+	// Ack event is kept for a restore of the alert
+	kept, err := ackRepo.FindByID(ctx, event.ID)
 	require.NoError(t, err)
-	assert.Nil(t, deleted, "Ack event should be cascade deleted when alert is deleted")
+	assert.NotNil(t, kept, "Ack event should be kept when alert is soft-deleted")
 }
 
 func TestAckEventRepository_NullableFields(t *testing.T) {
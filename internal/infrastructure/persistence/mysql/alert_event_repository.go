@@ -0,0 +1,106 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// alertEventColumns lists alert_events columns in the order scanAlertEvent
+// expects.
+const alertEventColumns = `id, alert_id, type, actor, detail, created_at`
+
+// AlertEventRepository provides MySQL implementation of repository.AlertEventRepository.
+type AlertEventRepository struct {
+	db *DB
+}
+
+// NewAlertEventRepository creates a new MySQL-backed alert event repository.
+func NewAlertEventRepository(db *DB) *AlertEventRepository {
+	return &AlertEventRepository{db: db}
+}
+
+// Save persists a new event.
+// Returns error if the referenced alert doesn't exist (foreign key constraint).
+func (r *AlertEventRepository) Save(ctx context.Context, event *entity.AlertEvent) error {
+	detail, err := r.db.cipher.Encrypt(event.Detail)
+	if err != nil {
+		return fmt.Errorf("encrypting alert event detail: %w", err)
+	}
+
+	query := `
+		INSERT INTO alert_events (` + alertEventColumns + `)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`
+
+	_, err = r.db.Primary().ExecContext(ctx, query,
+		event.ID,
+		event.AlertID,
+		string(event.Type),
+		event.By,
+		nullString(detail),
+		timeToTimestamp(event.CreatedAt),
+	)
+	if err != nil {
+		if isForeignKeyError(err) {
+			return fmt.Errorf("alert not found: %w", err)
+		}
+		return fmt.Errorf("inserting alert event: %w", err)
+	}
+
+	return nil
+}
+
+// FindByAlertID retrieves all events for an alert, oldest first.
+func (r *AlertEventRepository) FindByAlertID(ctx context.Context, alertID string) ([]*entity.AlertEvent, error) {
+	query := `
+		SELECT ` + alertEventColumns + `
+		FROM alert_events
+		WHERE alert_id = ?
+		ORDER BY created_at ASC
+	`
+
+	rows, err := r.db.Replica().QueryContext(ctx, query, alertID)
+	if err != nil {
+		return nil, fmt.Errorf("querying alert events: %w", err)
+	}
+	defer rows.Close()
+
+	events := []*entity.AlertEvent{}
+	for rows.Next() {
+		event, err := r.scanAlertEvent(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanning alert event row: %w", err)
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating alert event rows: %w", err)
+	}
+
+	return events, nil
+}
+
+// scanAlertEvent scans a row selected with alertEventColumns.
+func (r *AlertEventRepository) scanAlertEvent(row rowScanner) (*entity.AlertEvent, error) {
+	var (
+		event     entity.AlertEvent
+		eventType string
+		detail    sql.NullString
+	)
+
+	if err := row.Scan(&event.ID, &event.AlertID, &eventType, &event.By, &detail, &event.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	event.Type = entity.AlertEventType(eventType)
+	text, err := r.db.cipher.Decrypt(stringValue(detail))
+	if err != nil {
+		return nil, fmt.Errorf("decrypting detail: %w", err)
+	}
+	event.Detail = text
+
+	return &event, nil
+}
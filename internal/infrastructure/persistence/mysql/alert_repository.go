@@ -4,11 +4,23 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
 	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
 )
 
+// alertColumns is the column list shared by all alert SELECT queries.
+// Its order must match scanAlertRow.
+const alertColumns = `id, fingerprint, name, instance, target, summary, description,
+			severity, state, labels, annotations,
+			external_references, group_key, history, custom_fields, tags, notes,
+			fired_at, acked_at, acked_by, resolved_at,
+			version, created_at, updated_at,
+			last_seen_at, ends_at,
+			assigned_to, assignee_slack_id, assigned_at, source`
+
 // AlertRepository provides MySQL implementation of repository.AlertRepository.
 type AlertRepository struct {
 	db *DB
@@ -38,19 +50,43 @@ func (r *AlertRepository) Save(ctx context.Context, alert *entity.Alert) error {
 		return fmt.Errorf("marshaling external_references: %w", err)
 	}
 
+	historyJSON, err := marshalHistory(alert.History)
+	if err != nil {
+		return fmt.Errorf("marshaling history: %w", err)
+	}
+
+	customFieldsJSON, err := marshalJSON(alert.CustomFields)
+	if err != nil {
+		return fmt.Errorf("marshaling custom_fields: %w", err)
+	}
+
+	tagsJSON, err := marshalJSON(alert.Tags)
+	if err != nil {
+		return fmt.Errorf("marshaling tags: %w", err)
+	}
+
+	notesJSON, err := marshalNotes(alert.Notes, r.db.cipher)
+	if err != nil {
+		return fmt.Errorf("marshaling notes: %w", err)
+	}
+
 	query := `
 		INSERT INTO alerts (
 			id, fingerprint, name, instance, target, summary, description,
 			severity, state, labels, annotations,
-			external_references,
+			external_references, group_key, history, custom_fields, tags, notes,
 			fired_at, acked_at, acked_by, resolved_at,
-			version, created_at, updated_at
+			version, created_at, updated_at,
+			last_seen_at, ends_at,
+			assigned_to, assignee_slack_id, assigned_at, source
 		) VALUES (
 			?, ?, ?, ?, ?, ?, ?,
 			?, ?, ?, ?,
-			?,
+			?, ?, ?, ?, ?, ?,
 			?, ?, ?, ?,
-			1, ?, ?
+			1, ?, ?,
+			?, ?,
+			?, ?, ?, ?
 		)
 	`
 
@@ -67,12 +103,23 @@ func (r *AlertRepository) Save(ctx context.Context, alert *entity.Alert) error {
 		labelsJSON,
 		annotationsJSON,
 		externalReferencesJSON,
+		alert.GroupKey,
+		historyJSON,
+		customFieldsJSON,
+		tagsJSON,
+		notesJSON,
 		timeToTimestamp(alert.FiredAt),
 		nullTime(alert.AckedAt),
 		nullString(alert.AckedBy),
 		nullTime(alert.ResolvedAt),
 		timeToTimestamp(alert.CreatedAt),
 		timeToTimestamp(alert.UpdatedAt),
+		nullTimeValue(alert.LastSeenAt),
+		nullTime(alert.EndsAt),
+		nullString(alert.AssignedTo),
+		nullString(alert.AssigneeSlackID),
+		nullTime(alert.AssignedAt),
+		nullString(alert.Source),
 	)
 
 	if err != nil {
@@ -89,44 +136,12 @@ func (r *AlertRepository) Save(ctx context.Context, alert *entity.Alert) error {
 // Returns nil, nil if not found.
 func (r *AlertRepository) FindByID(ctx context.Context, id string) (*entity.Alert, error) {
 	query := `
-		SELECT
-			id, fingerprint, name, instance, target, summary, description,
-			severity, state, labels, annotations,
-			external_references,
-			fired_at, acked_at, acked_by, resolved_at,
-			version, created_at, updated_at
+		SELECT ` + alertColumns + `
 		FROM alerts
-		WHERE id = ?
+		WHERE id = ? AND deleted_at IS NULL
 	`
 
-	var alert entity.Alert
-	var labelsJSON, annotationsJSON, externalReferencesJSON string
-	var ackedBy sql.NullString
-	var ackedAt, resolvedAt sql.NullTime
-	var version int
-
-	err := r.db.Replica().QueryRowContext(ctx, query, id).Scan(
-		&alert.ID,
-		&alert.Fingerprint,
-		&alert.Name,
-		&alert.Instance,
-		&alert.Target,
-		&alert.Summary,
-		&alert.Description,
-		&alert.Severity,
-		&alert.State,
-		&labelsJSON,
-		&annotationsJSON,
-		&externalReferencesJSON,
-		&alert.FiredAt,
-		&ackedAt,
-		&ackedBy,
-		&resolvedAt,
-		&version,
-		&alert.CreatedAt,
-		&alert.UpdatedAt,
-	)
-
+	alert, err := r.scanAlertRow(r.db.Primary().QueryRowContext(ctx, query, id))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -134,41 +149,20 @@ func (r *AlertRepository) FindByID(ctx context.Context, id string) (*entity.Aler
 		return nil, fmt.Errorf("querying alert: %w", err)
 	}
 
-	// Deserialize JSON fields
-	if err := unmarshalJSON(labelsJSON, &alert.Labels); err != nil {
-		return nil, fmt.Errorf("unmarshaling labels: %w", err)
-	}
-	if err := unmarshalJSON(annotationsJSON, &alert.Annotations); err != nil {
-		return nil, fmt.Errorf("unmarshaling annotations: %w", err)
-	}
-	if err := unmarshalJSON(externalReferencesJSON, &alert.ExternalReferences); err != nil {
-		return nil, fmt.Errorf("unmarshaling external_references: %w", err)
-	}
-
-	// Set nullable fields
-	alert.AckedBy = stringValue(ackedBy)
-	alert.AckedAt = timePtr(ackedAt)
-	alert.ResolvedAt = timePtr(resolvedAt)
-
-	return &alert, nil
+	return alert, nil
 }
 
 // FindByFingerprint finds alerts matching the Alertmanager fingerprint.
 // Returns empty slice if none found.
 func (r *AlertRepository) FindByFingerprint(ctx context.Context, fingerprint string) ([]*entity.Alert, error) {
 	query := `
-		SELECT
-			id, fingerprint, name, instance, target, summary, description,
-			severity, state, labels, annotations,
-			external_references,
-			fired_at, acked_at, acked_by, resolved_at,
-			version, created_at, updated_at
+		SELECT ` + alertColumns + `
 		FROM alerts
-		WHERE fingerprint = ?
+		WHERE fingerprint = ? AND deleted_at IS NULL
 		ORDER BY created_at DESC
 	`
 
-	rows, err := r.db.Replica().QueryContext(ctx, query, fingerprint)
+	rows, err := r.db.Primary().QueryContext(ctx, query, fingerprint)
 	if err != nil {
 		return nil, fmt.Errorf("querying alerts by fingerprint: %w", err)
 	}
@@ -181,44 +175,13 @@ func (r *AlertRepository) FindByFingerprint(ctx context.Context, fingerprint str
 // Returns nil, nil if not found.
 func (r *AlertRepository) FindByExternalReference(ctx context.Context, key, value string) (*entity.Alert, error) {
 	query := `
-		SELECT
-			id, fingerprint, name, instance, target, summary, description,
-			severity, state, labels, annotations,
-			external_references,
-			fired_at, acked_at, acked_by, resolved_at,
-			version, created_at, updated_at
+		SELECT ` + alertColumns + `
 		FROM alerts
-		WHERE JSON_EXTRACT(external_references, CONCAT('$.', ?)) = ?
+		WHERE JSON_CONTAINS(JSON_EXTRACT(external_references, '$.references'), JSON_OBJECT('system', ?, 'reference_id', ?))
+			AND deleted_at IS NULL
 	`
 
-	var alert entity.Alert
-	var labelsJSON, annotationsJSON, externalReferencesJSON string
-	var ackedBy sql.NullString
-	var ackedAt, resolvedAt sql.NullTime
-	var version int
-
-	err := r.db.Replica().QueryRowContext(ctx, query, key, value).Scan(
-		&alert.ID,
-		&alert.Fingerprint,
-		&alert.Name,
-		&alert.Instance,
-		&alert.Target,
-		&alert.Summary,
-		&alert.Description,
-		&alert.Severity,
-		&alert.State,
-		&labelsJSON,
-		&annotationsJSON,
-		&externalReferencesJSON,
-		&alert.FiredAt,
-		&ackedAt,
-		&ackedBy,
-		&resolvedAt,
-		&version,
-		&alert.CreatedAt,
-		&alert.UpdatedAt,
-	)
-
+	alert, err := r.scanAlertRow(r.db.Primary().QueryRowContext(ctx, query, key, value))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -226,23 +189,7 @@ func (r *AlertRepository) FindByExternalReference(ctx context.Context, key, valu
 		return nil, fmt.Errorf("querying alert by external reference %s: %w", key, err)
 	}
 
-	// Deserialize JSON fields
-	if err := unmarshalJSON(labelsJSON, &alert.Labels); err != nil {
-		return nil, fmt.Errorf("unmarshaling labels: %w", err)
-	}
-	if err := unmarshalJSON(annotationsJSON, &alert.Annotations); err != nil {
-		return nil, fmt.Errorf("unmarshaling annotations: %w", err)
-	}
-	if err := unmarshalJSON(externalReferencesJSON, &alert.ExternalReferences); err != nil {
-		return nil, fmt.Errorf("unmarshaling external_references: %w", err)
-	}
-
-	// Set nullable fields
-	alert.AckedBy = stringValue(ackedBy)
-	alert.AckedAt = timePtr(ackedAt)
-	alert.ResolvedAt = timePtr(resolvedAt)
-
-	return &alert, nil
+	return alert, nil
 }
 
 // Update modifies an existing alert with optimistic locking.
@@ -251,7 +198,7 @@ func (r *AlertRepository) FindByExternalReference(ctx context.Context, key, valu
 func (r *AlertRepository) Update(ctx context.Context, alert *entity.Alert) error {
 	// First, get the current version
 	var currentVersion int
-	versionQuery := `SELECT version FROM alerts WHERE id = ?`
+	versionQuery := `SELECT version FROM alerts WHERE id = ? AND deleted_at IS NULL`
 	err := r.db.Primary().QueryRowContext(ctx, versionQuery, alert.ID).Scan(&currentVersion)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -276,6 +223,26 @@ func (r *AlertRepository) Update(ctx context.Context, alert *entity.Alert) error
 		return fmt.Errorf("marshaling external_references: %w", err)
 	}
 
+	historyJSON, err := marshalHistory(alert.History)
+	if err != nil {
+		return fmt.Errorf("marshaling history: %w", err)
+	}
+
+	customFieldsJSON, err := marshalJSON(alert.CustomFields)
+	if err != nil {
+		return fmt.Errorf("marshaling custom_fields: %w", err)
+	}
+
+	tagsJSON, err := marshalJSON(alert.Tags)
+	if err != nil {
+		return fmt.Errorf("marshaling tags: %w", err)
+	}
+
+	notesJSON, err := marshalNotes(alert.Notes, r.db.cipher)
+	if err != nil {
+		return fmt.Errorf("marshaling notes: %w", err)
+	}
+
 	// Update with optimistic locking (increment version)
 	query := `
 		UPDATE alerts SET
@@ -290,13 +257,23 @@ func (r *AlertRepository) Update(ctx context.Context, alert *entity.Alert) error
 			labels = ?,
 			annotations = ?,
 			external_references = ?,
+			group_key = ?,
+			history = ?,
+			custom_fields = ?,
+			tags = ?,
+			notes = ?,
 			fired_at = ?,
 			acked_at = ?,
 			acked_by = ?,
 			resolved_at = ?,
 			updated_at = ?,
+			last_seen_at = ?,
+			ends_at = ?,
+			assigned_to = ?,
+			assignee_slack_id = ?,
+			assigned_at = ?,
 			version = version + 1
-		WHERE id = ? AND version = ?
+		WHERE id = ? AND version = ? AND deleted_at IS NULL
 	`
 
 	result, err := r.db.Primary().ExecContext(ctx, query,
@@ -311,11 +288,21 @@ func (r *AlertRepository) Update(ctx context.Context, alert *entity.Alert) error
 		labelsJSON,
 		annotationsJSON,
 		externalReferencesJSON,
+		alert.GroupKey,
+		historyJSON,
+		customFieldsJSON,
+		tagsJSON,
+		notesJSON,
 		timeToTimestamp(alert.FiredAt),
 		nullTime(alert.AckedAt),
 		nullString(alert.AckedBy),
 		nullTime(alert.ResolvedAt),
 		timeToTimestamp(alert.UpdatedAt),
+		nullTimeValue(alert.LastSeenAt),
+		nullTime(alert.EndsAt),
+		nullString(alert.AssignedTo),
+		nullString(alert.AssigneeSlackID),
+		nullTime(alert.AssignedAt),
 		alert.ID,
 		currentVersion,
 	)
@@ -333,7 +320,7 @@ func (r *AlertRepository) Update(ctx context.Context, alert *entity.Alert) error
 		// Either the alert doesn't exist or version mismatch (concurrent update)
 		// Check if alert exists
 		var exists bool
-		existsQuery := `SELECT COUNT(*) > 0 FROM alerts WHERE id = ?`
+		existsQuery := `SELECT COUNT(*) > 0 FROM alerts WHERE id = ? AND deleted_at IS NULL`
 		err := r.db.Primary().QueryRowContext(ctx, existsQuery, alert.ID).Scan(&exists)
 		if err != nil {
 			return fmt.Errorf("checking alert existence: %w", err)
@@ -353,14 +340,9 @@ func (r *AlertRepository) Update(ctx context.Context, alert *entity.Alert) error
 // FindActive returns all currently active (non-resolved) alerts.
 func (r *AlertRepository) FindActive(ctx context.Context) ([]*entity.Alert, error) {
 	query := `
-		SELECT
-			id, fingerprint, name, instance, target, summary, description,
-			severity, state, labels, annotations,
-			external_references,
-			fired_at, acked_at, acked_by, resolved_at,
-			version, created_at, updated_at
+		SELECT ` + alertColumns + `
 		FROM alerts
-		WHERE state != 'resolved'
+		WHERE state != 'resolved' AND deleted_at IS NULL
 		ORDER BY fired_at DESC
 	`
 
@@ -374,20 +356,18 @@ func (r *AlertRepository) FindActive(ctx context.Context) ([]*entity.Alert, erro
 }
 
 // FindFiring returns all firing alerts (active or acknowledged).
+// Reads from the primary: callers such as the stale alert reaper and group
+// resolution update the alerts they get, and a lagging replica would have
+// them overwrite recent acks and silences.
 func (r *AlertRepository) FindFiring(ctx context.Context) ([]*entity.Alert, error) {
 	query := `
-		SELECT
-			id, fingerprint, name, instance, target, summary, description,
-			severity, state, labels, annotations,
-			external_references,
-			fired_at, acked_at, acked_by, resolved_at,
-			version, created_at, updated_at
+		SELECT ` + alertColumns + `
 		FROM alerts
-		WHERE state IN ('active', 'acknowledged')
+		WHERE state IN ('active', 'acknowledged') AND deleted_at IS NULL
 		ORDER BY fired_at DESC
 	`
 
-	rows, err := r.db.Replica().QueryContext(ctx, query)
+	rows, err := r.db.Primary().QueryContext(ctx, query)
 	if err != nil {
 		return nil, fmt.Errorf("querying firing alerts: %w", err)
 	}
@@ -405,27 +385,17 @@ func (r *AlertRepository) GetActiveAlerts(ctx context.Context, severity string)
 	if severity == "" {
 		// Return all active alerts
 		query = `
-			SELECT
-				id, fingerprint, name, instance, target, summary, description,
-				severity, state, labels, annotations,
-				external_references,
-				fired_at, acked_at, acked_by, resolved_at,
-				version, created_at, updated_at
+			SELECT ` + alertColumns + `
 			FROM alerts
-			WHERE state != 'resolved'
+			WHERE state != 'resolved' AND deleted_at IS NULL
 			ORDER BY fired_at DESC
 		`
 	} else {
 		// Filter by severity
 		query = `
-			SELECT
-				id, fingerprint, name, instance, target, summary, description,
-				severity, state, labels, annotations,
-				external_references,
-				fired_at, acked_at, acked_by, resolved_at,
-				version, created_at, updated_at
+			SELECT ` + alertColumns + `
 			FROM alerts
-			WHERE state != 'resolved' AND severity = ?
+			WHERE state != 'resolved' AND severity = ? AND deleted_at IS NULL
 			ORDER BY fired_at DESC
 		`
 		args = append(args, severity)
@@ -440,12 +410,119 @@ func (r *AlertRepository) GetActiveAlerts(ctx context.Context, severity string)
 	return r.scanAlerts(rows)
 }
 
-// Delete removes an alert by ID.
+// FindChangedSince returns alerts that are still firing, or that fired or
+// resolved at or after since.
+func (r *AlertRepository) FindChangedSince(ctx context.Context, since time.Time) ([]*entity.Alert, error) {
+	query := `
+		SELECT ` + alertColumns + `
+		FROM alerts
+		WHERE (state != 'resolved' OR fired_at >= ? OR resolved_at >= ?) AND deleted_at IS NULL
+		ORDER BY fired_at DESC
+	`
+
+	rows, err := r.db.Replica().QueryContext(ctx, query, timeToTimestamp(since), timeToTimestamp(since))
+	if err != nil {
+		return nil, fmt.Errorf("querying changed alerts: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanAlerts(rows)
+}
+
+// FindActiveAt returns alerts that had fired by at and were not resolved
+// before it.
+func (r *AlertRepository) FindActiveAt(ctx context.Context, at time.Time) ([]*entity.Alert, error) {
+	query := `
+		SELECT ` + alertColumns + `
+		FROM alerts
+		WHERE fired_at <= ? AND (resolved_at IS NULL OR resolved_at > ?) AND deleted_at IS NULL
+		ORDER BY fired_at DESC
+	`
+
+	rows, err := r.db.Replica().QueryContext(ctx, query, timeToTimestamp(at), timeToTimestamp(at))
+	if err != nil {
+		return nil, fmt.Errorf("querying alerts active at: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanAlerts(rows)
+}
+
+// Search returns the page of alerts matching query, newest fired first,
+// and the total number of matches.
+func (r *AlertRepository) Search(ctx context.Context, query entity.AlertQuery) ([]*entity.Alert, int, error) {
+	where, args := searchConditions(query)
+
+	var total int
+	if err := r.db.Replica().QueryRowContext(ctx, `SELECT COUNT(*) FROM alerts`+where, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("querying matching alerts: %w", err)
+	}
+
+	page := `SELECT ` + alertColumns + ` FROM alerts` + where + ` ORDER BY fired_at DESC, id`
+	if query.Limit > 0 {
+		page += ` LIMIT ? OFFSET ?`
+		args = append(args, query.Limit, max(query.Offset, 0))
+	}
+
+	rows, err := r.db.Replica().QueryContext(ctx, page, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("querying matching alerts: %w", err)
+	}
+	defer rows.Close()
+
+	alerts, err := r.scanAlerts(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+	return alerts, total, nil
+}
+
+// searchConditions returns the WHERE clause selecting the alerts that match
+// query, and its arguments. Soft-deleted alerts never match.
+func searchConditions(query entity.AlertQuery) (string, []any) {
+	conditions := []string{"deleted_at IS NULL"}
+	var args []any
+
+	if len(query.States) > 0 {
+		placeholders := make([]string, len(query.States))
+		for i, state := range query.States {
+			placeholders[i] = "?"
+			args = append(args, string(state))
+		}
+		conditions = append(conditions, "state IN ("+strings.Join(placeholders, ", ")+")")
+	}
+	if query.Severity != "" {
+		conditions = append(conditions, "severity = ?")
+		args = append(args, string(query.Severity))
+	}
+	for name, value := range query.Labels {
+		conditions = append(conditions, `JSON_UNQUOTE(JSON_EXTRACT(labels, CONCAT('$."', ?, '"'))) = ?`)
+		args = append(args, name, value)
+	}
+	for name, value := range query.Fields {
+		conditions = append(conditions, `JSON_UNQUOTE(JSON_EXTRACT(custom_fields, CONCAT('$."', ?, '"'))) = ?`)
+		args = append(args, name, value)
+	}
+	if !query.ResolvedSince.IsZero() {
+		conditions = append(conditions, "(resolved_at IS NULL OR resolved_at >= ?)")
+		args = append(args, timeToTimestamp(query.ResolvedSince))
+	}
+	if query.Text != "" {
+		pattern := likePattern(strings.ToLower(query.Text))
+		conditions = append(conditions, `(LOWER(name) LIKE ? ESCAPE '!' OR LOWER(summary) LIKE ? ESCAPE '!'
+			OR LOWER(instance) LIKE ? ESCAPE '!' OR LOWER(target) LIKE ? ESCAPE '!')`)
+		args = append(args, pattern, pattern, pattern, pattern)
+	}
+
+	return " WHERE " + strings.Join(conditions, " AND "), args
+}
+
+// Delete soft-deletes an alert by ID, setting its deleted_at.
 // Returns ErrNotFound if the alert doesn't exist.
 func (r *AlertRepository) Delete(ctx context.Context, id string) error {
-	query := `DELETE FROM alerts WHERE id = ?`
+	query := `UPDATE alerts SET deleted_at = ? WHERE id = ? AND deleted_at IS NULL`
 
-	result, err := r.db.Primary().ExecContext(ctx, query, id)
+	result, err := r.db.Primary().ExecContext(ctx, query, timeToTimestamp(time.Now()), id)
 	if err != nil {
 		return fmt.Errorf("deleting alert: %w", err)
 	}
@@ -462,60 +539,109 @@ func (r *AlertRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
+// Restore clears the deleted_at of a soft-deleted alert.
+// Returns ErrNotFound if no deleted alert has the ID.
+func (r *AlertRepository) Restore(ctx context.Context, id string) error {
+	query := `UPDATE alerts SET deleted_at = NULL WHERE id = ? AND deleted_at IS NOT NULL`
+
+	result, err := r.db.Primary().ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("restoring alert: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("checking rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+// FindResolvedBefore returns up to limit alerts resolved before the given
+// time, soft-deleted or not, oldest resolved first.
+func (r *AlertRepository) FindResolvedBefore(ctx context.Context, before time.Time, limit int) ([]*entity.Alert, error) {
+	query := `
+		SELECT ` + alertColumns + `
+		FROM alerts
+		WHERE resolved_at IS NOT NULL AND resolved_at < ?
+		ORDER BY resolved_at
+		LIMIT ?
+	`
+
+	rows, err := r.db.Replica().QueryContext(ctx, query, timeToTimestamp(before), limit)
+	if err != nil {
+		return nil, fmt.Errorf("querying resolved alerts: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanAlerts(rows)
+}
+
+// DeleteResolvedBefore permanently removes alerts resolved before the given
+// time, soft-deleted or not. Their ack events and timelines are removed by
+// cascade.
+// Returns the number of deleted alerts.
+func (r *AlertRepository) DeleteResolvedBefore(ctx context.Context, before time.Time) (int, error) {
+	query := `DELETE FROM alerts WHERE resolved_at IS NOT NULL AND resolved_at < ?`
+
+	result, err := r.db.Primary().ExecContext(ctx, query, before.UTC())
+	if err != nil {
+		return 0, fmt.Errorf("deleting resolved alerts: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("checking rows affected: %w", err)
+	}
+
+	return int(rowsAffected), nil
+}
+
+// DeleteResolvedByIDs permanently removes the alerts with the given IDs
+// that are still resolved before the given time, soft-deleted or not.
+// Their ack events and timelines are removed by cascade.
+// Returns the number of deleted alerts.
+func (r *AlertRepository) DeleteResolvedByIDs(ctx context.Context, ids []string, before time.Time) (int, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	placeholders := make([]string, len(ids))
+	args := make([]interface{}, 0, len(ids)+1)
+	args = append(args, before.UTC())
+	for i, id := range ids {
+		placeholders[i] = "?"
+		args = append(args, id)
+	}
+	query := `DELETE FROM alerts WHERE resolved_at IS NOT NULL AND resolved_at < ? AND id IN (` + strings.Join(placeholders, ", ") + `)`
+
+	result, err := r.db.Primary().ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, fmt.Errorf("deleting resolved alerts: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("checking rows affected: %w", err)
+	}
+
+	return int(rowsAffected), nil
+}
+
 // scanAlerts is a helper function to scan multiple alerts from query results.
 func (r *AlertRepository) scanAlerts(rows *sql.Rows) ([]*entity.Alert, error) {
 	alerts := make([]*entity.Alert, 0)
 
 	for rows.Next() {
-		var alert entity.Alert
-		var labelsJSON, annotationsJSON, externalReferencesJSON string
-		var ackedBy sql.NullString
-		var ackedAt, resolvedAt sql.NullTime
-		var version int
-
-		err := rows.Scan(
-			&alert.ID,
-			&alert.Fingerprint,
-			&alert.Name,
-			&alert.Instance,
-			&alert.Target,
-			&alert.Summary,
-			&alert.Description,
-			&alert.Severity,
-			&alert.State,
-			&labelsJSON,
-			&annotationsJSON,
-			&externalReferencesJSON,
-			&alert.FiredAt,
-			&ackedAt,
-			&ackedBy,
-			&resolvedAt,
-			&version,
-			&alert.CreatedAt,
-			&alert.UpdatedAt,
-		)
-
+		alert, err := r.scanAlertRow(rows)
 		if err != nil {
 			return nil, fmt.Errorf("scanning alert row: %w", err)
 		}
-
-		// Deserialize JSON fields
-		if err := unmarshalJSON(labelsJSON, &alert.Labels); err != nil {
-			return nil, fmt.Errorf("unmarshaling labels: %w", err)
-		}
-		if err := unmarshalJSON(annotationsJSON, &alert.Annotations); err != nil {
-			return nil, fmt.Errorf("unmarshaling annotations: %w", err)
-		}
-		if err := unmarshalJSON(externalReferencesJSON, &alert.ExternalReferences); err != nil {
-			return nil, fmt.Errorf("unmarshaling external_references: %w", err)
-		}
-
-		// Set nullable fields
-		alert.AckedBy = stringValue(ackedBy)
-		alert.AckedAt = timePtr(ackedAt)
-		alert.ResolvedAt = timePtr(resolvedAt)
-
-		alerts = append(alerts, &alert)
+		alerts = append(alerts, alert)
 	}
 
 	if err := rows.Err(); err != nil {
@@ -524,3 +650,99 @@ func (r *AlertRepository) scanAlerts(rows *sql.Rows) ([]*entity.Alert, error) {
 
 	return alerts, nil
 }
+
+// rowScanner is implemented by both *sql.Row and *sql.Rows.
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanAlertRow scans the columns listed in alertColumns into an Alert entity.
+// Returns sql.ErrNoRows unchanged so callers can map it.
+func (r *AlertRepository) scanAlertRow(row rowScanner) (*entity.Alert, error) {
+	var alert entity.Alert
+	var labelsJSON, annotationsJSON, externalReferencesJSON string
+	var ackedBy, historyJSON, customFieldsJSON, tagsJSON, notesJSON sql.NullString
+	var assignedTo, assigneeSlackID, source sql.NullString
+	var ackedAt, resolvedAt, lastSeenAt, endsAt, assignedAt sql.NullTime
+	var version int
+
+	err := row.Scan(
+		&alert.ID,
+		&alert.Fingerprint,
+		&alert.Name,
+		&alert.Instance,
+		&alert.Target,
+		&alert.Summary,
+		&alert.Description,
+		&alert.Severity,
+		&alert.State,
+		&labelsJSON,
+		&annotationsJSON,
+		&externalReferencesJSON,
+		&alert.GroupKey,
+		&historyJSON,
+		&customFieldsJSON,
+		&tagsJSON,
+		&notesJSON,
+		&alert.FiredAt,
+		&ackedAt,
+		&ackedBy,
+		&resolvedAt,
+		&version,
+		&alert.CreatedAt,
+		&alert.UpdatedAt,
+		&lastSeenAt,
+		&endsAt,
+		&assignedTo,
+		&assigneeSlackID,
+		&assignedAt,
+		&source,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Deserialize JSON fields
+	if err := unmarshalJSON(labelsJSON, &alert.Labels); err != nil {
+		return nil, fmt.Errorf("unmarshaling labels: %w", err)
+	}
+	if err := unmarshalJSON(annotationsJSON, &alert.Annotations); err != nil {
+		return nil, fmt.Errorf("unmarshaling annotations: %w", err)
+	}
+	if err := unmarshalJSON(externalReferencesJSON, &alert.ExternalReferences); err != nil {
+		return nil, fmt.Errorf("unmarshaling external_references: %w", err)
+	}
+	history, err := unmarshalHistory(stringValue(historyJSON))
+	if err != nil {
+		return nil, fmt.Errorf("unmarshaling history: %w", err)
+	}
+	alert.History = history
+	if err := unmarshalJSON(stringValue(customFieldsJSON), &alert.CustomFields); err != nil {
+		return nil, fmt.Errorf("unmarshaling custom_fields: %w", err)
+	}
+	if tagsJSON.Valid && tagsJSON.String != "" {
+		if err := unmarshalJSON(tagsJSON.String, &alert.Tags); err != nil {
+			return nil, fmt.Errorf("unmarshaling tags: %w", err)
+		}
+	}
+	notes, err := unmarshalNotes(stringValue(notesJSON), r.db.cipher)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshaling notes: %w", err)
+	}
+	alert.Notes = notes
+
+	// Set nullable fields
+	alert.AckedBy = stringValue(ackedBy)
+	alert.AckedAt = timePtr(ackedAt)
+	alert.ResolvedAt = timePtr(resolvedAt)
+	if lastSeenAt.Valid {
+		alert.LastSeenAt = lastSeenAt.Time
+	}
+	alert.EndsAt = timePtr(endsAt)
+	alert.AssignedTo = stringValue(assignedTo)
+	alert.AssigneeSlackID = stringValue(assigneeSlackID)
+	alert.AssignedAt = timePtr(assignedAt)
+	alert.Source = stringValue(source)
+
+	return &alert, nil
+}
@@ -392,6 +392,13 @@ func TestAlertRepository_Delete(t *testing.T) {
 	found, err := repo.FindByID(ctx, alert.ID)
 	require.NoError(t, err)
 	assert.Nil(t, found)
+
+	// Restore brings it back once
+	require.NoError(t, repo.Restore(ctx, alert.ID))
+	found, err = repo.FindByID(ctx, alert.ID)
+	require.NoError(t, err)
+	assert.NotNil(t, found)
+	assert.ErrorIs(t, repo.Restore(ctx, alert.ID), repository.ErrNotFound)
 }
 
 func TestAlertRepository_Delete_NotFound(t *testing.T) {
@@ -4,12 +4,14 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	_ "github.com/go-sql-driver/mysql"
 
 	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
 	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/config"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/fieldcrypt"
 )
 
 // DB wraps a MySQL database connection with health checking.
@@ -17,6 +19,15 @@ type DB struct {
 	primary *sql.DB
 	replica *sql.DB
 	config  *config.MySQLConfig
+
+	// replicaHealthy is whether reads may go to the replica; see
+	// StartReplicaHealthCheck.
+	replicaHealthy atomic.Bool
+	stopHealth     chan struct{}
+	healthDone     chan struct{}
+
+	// cipher encrypts note fields; nil stores them as they are.
+	cipher *fieldcrypt.Cipher
 }
 
 // NewDB creates a new MySQL database connection with connection pooling.
@@ -89,17 +100,12 @@ func NewDB(cfg *config.MySQLConfig) (*DB, error) {
 		replica.SetConnMaxLifetime(cfg.Pool.ConnMaxLifetime)
 		replica.SetConnMaxIdleTime(cfg.Pool.ConnMaxIdleTime)
 
-		// Test replica connection
+		// An unavailable replica is not fatal: reads go to the primary
+		// until the health check finds it back
+		db.replica = replica
 		ctx, cancel := context.WithTimeout(context.Background(), cfg.Timeout)
 		defer cancel()
-
-		if err := replica.PingContext(ctx); err != nil {
-			primary.Close()
-			replica.Close()
-			return nil, fmt.Errorf("pinging replica database: %w", err)
-		}
-
-		db.replica = replica
+		db.replicaHealthy.Store(db.checkReplica(ctx) == nil)
 	}
 
 	return db, nil
@@ -127,27 +133,28 @@ func (db *DB) Primary() *sql.DB {
 	return db.primary
 }
 
-// Replica returns the replica database connection for reads, or primary if no replica is configured.
+// Replica returns the database connection for list queries: the replica
+// while it is healthy, otherwise the primary. Lookups of single rows, which
+// usually precede an update, use the primary to see the latest writes.
 func (db *DB) Replica() *sql.DB {
-	if db.replica != nil {
+	if db.replica != nil && db.replicaHealthy.Load() {
 		return db.replica
 	}
 	return db.primary
 }
 
-// Ping checks connectivity to the database.
-// It pings both primary and replica (if configured).
+// SetFieldCipher encrypts ack notes, alert notes and alert event details
+// with the cipher. Values stored before remain readable.
+func (db *DB) SetFieldCipher(cipher *fieldcrypt.Cipher) {
+	db.cipher = cipher
+}
+
+// Ping checks connectivity to the primary. An unavailable replica does not
+// fail it, since reads fail over to the primary.
 func (db *DB) Ping(ctx context.Context) error {
 	if err := db.primary.PingContext(ctx); err != nil {
 		return fmt.Errorf("primary ping failed: %w", err)
 	}
-
-	if db.replica != nil {
-		if err := db.replica.PingContext(ctx); err != nil {
-			return fmt.Errorf("replica ping failed: %w", err)
-		}
-	}
-
 	return nil
 }
 
@@ -156,6 +163,12 @@ func (db *DB) Ping(ctx context.Context) error {
 func (db *DB) Close() error {
 	var primaryErr, replicaErr error
 
+	if db.stopHealth != nil {
+		close(db.stopHealth)
+		<-db.healthDone
+		db.stopHealth = nil
+	}
+
 	if db.primary != nil {
 		primaryErr = db.primary.Close()
 	}
@@ -2,6 +2,8 @@ package mysql
 
 import (
 	"context"
+	"database/sql"
+	"log/slog"
 	"testing"
 	"time"
 
@@ -132,6 +134,32 @@ func TestDB_Replica(t *testing.T) {
 	assert.Equal(t, db.replica, db.Replica())
 }
 
+func TestDB_Replica_Failover(t *testing.T) {
+	primary, err := sql.Open("mysql", buildDSN("127.0.0.1", 1, "db", "user", "pass", "utf8mb4", true, time.Second))
+	require.NoError(t, err)
+	defer primary.Close()
+	replica, err := sql.Open("mysql", buildDSN("127.0.0.1", 1, "db", "reader", "pass", "utf8mb4", true, time.Second))
+	require.NoError(t, err)
+	defer replica.Close()
+
+	db := &DB{
+		primary: primary,
+		replica: replica,
+		config:  &config.MySQLConfig{Timeout: time.Second},
+	}
+
+	// Until found healthy, reads go to the primary
+	assert.Same(t, primary, db.Replica())
+
+	db.replicaHealthy.Store(true)
+	assert.Same(t, replica, db.Replica())
+
+	// A replica that does not answer fails over to the primary
+	db.updateReplicaHealth(slog.New(slog.DiscardHandler))
+	assert.False(t, db.replicaHealthy.Load())
+	assert.Same(t, primary, db.Replica())
+}
+
 func TestDB_Stats(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration test in short mode")
@@ -10,9 +10,12 @@ import (
 
 // Repositories holds all MySQL repository implementations.
 type Repositories struct {
-	Alert    repository.AlertRepository
-	AckEvent repository.AckEventRepository
-	Silence  repository.SilenceRepository
+	Alert      repository.AlertRepository
+	AckEvent   repository.AckEventRepository
+	AlertEvent repository.AlertEventRepository
+	Silence    repository.SilenceRepository
+	SavedView  repository.SavedViewRepository
+	Retry      repository.NotificationRetryRepository
 }
 
 // NewRepositories creates all MySQL repository implementations.
@@ -38,9 +41,12 @@ func NewRepositories(cfg *config.MySQLConfig) (*Repositories, *DB, error) {
 
 	// Create repositories
 	repos := &Repositories{
-		Alert:    NewAlertRepository(db),
-		AckEvent: NewAckEventRepository(db),
-		Silence:  NewSilenceRepository(db),
+		Alert:      NewAlertRepository(db),
+		AckEvent:   NewAckEventRepository(db),
+		AlertEvent: NewAlertEventRepository(db),
+		Silence:    NewSilenceRepository(db),
+		SavedView:  NewSavedViewRepository(db),
+		Retry:      NewNotificationRetryRepository(db),
 	}
 
 	return repos, db, nil
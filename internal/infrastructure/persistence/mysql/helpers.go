@@ -9,7 +9,9 @@ import (
 
 	"github.com/go-sql-driver/mysql"
 
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
 	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/fieldcrypt"
 )
 
 // nullString converts a string to sql.NullString.
@@ -33,6 +35,15 @@ func nullTime(t *time.Time) sql.NullTime {
 	}
 }
 
+// nullTimeValue converts a time.Time to sql.NullTime.
+// Returns NULL for the zero time.
+func nullTimeValue(t time.Time) sql.NullTime {
+	if t.IsZero() {
+		return sql.NullTime{Valid: false}
+	}
+	return nullTime(&t)
+}
+
 // timePtr converts sql.NullTime to *time.Time.
 // Returns nil if the value is NULL.
 func timePtr(nt sql.NullTime) *time.Time {
@@ -108,6 +119,98 @@ func unmarshalJSON(data string, v interface{}) error {
 	return nil
 }
 
+// transitionRecord is the JSON storage form of entity.AlertTransition.
+type transitionRecord struct {
+	At               time.Time `json:"at"`
+	PreviousSeverity string    `json:"previous_severity"`
+	Severity         string    `json:"severity"`
+	PreviousState    string    `json:"previous_state"`
+	State            string    `json:"state"`
+	By               string    `json:"by,omitempty"`
+}
+
+// marshalHistory converts alert transitions to a JSON array for storage.
+func marshalHistory(history []entity.AlertTransition) (string, error) {
+	records := make([]transitionRecord, len(history))
+	for i, t := range history {
+		records[i] = transitionRecord{
+			At:               t.At.UTC(),
+			PreviousSeverity: string(t.PreviousSeverity),
+			Severity:         string(t.Severity),
+			PreviousState:    string(t.PreviousState),
+			State:            string(t.State),
+			By:               t.By,
+		}
+	}
+	return marshalJSON(records)
+}
+
+// unmarshalHistory converts a stored JSON array back to alert transitions.
+// NULL (rows written before the column existed) yields an empty history.
+func unmarshalHistory(data string) ([]entity.AlertTransition, error) {
+	if data == "" || data == "[]" {
+		return nil, nil
+	}
+	var records []transitionRecord
+	if err := unmarshalJSON(data, &records); err != nil {
+		return nil, err
+	}
+	history := make([]entity.AlertTransition, len(records))
+	for i, r := range records {
+		history[i] = entity.AlertTransition{
+			At:               r.At,
+			PreviousSeverity: entity.AlertSeverity(r.PreviousSeverity),
+			Severity:         entity.AlertSeverity(r.Severity),
+			PreviousState:    entity.AlertState(r.PreviousState),
+			State:            entity.AlertState(r.State),
+			By:               r.By,
+		}
+	}
+	return history, nil
+}
+
+// noteRecord is the JSON storage form of entity.AlertNote.
+type noteRecord struct {
+	At   time.Time `json:"at"`
+	By   string    `json:"by,omitempty"`
+	Text string    `json:"text"`
+}
+
+// marshalNotes converts alert notes to a JSON array for storage, with
+// their text encrypted by the cipher.
+func marshalNotes(notes []entity.AlertNote, cipher *fieldcrypt.Cipher) (string, error) {
+	records := make([]noteRecord, len(notes))
+	for i, n := range notes {
+		text, err := cipher.Encrypt(n.Text)
+		if err != nil {
+			return "", err
+		}
+		records[i] = noteRecord{At: n.At.UTC(), By: n.By, Text: text}
+	}
+	return marshalJSON(records)
+}
+
+// unmarshalNotes converts a stored JSON array back to alert notes.
+// NULL (rows written before the column existed) yields no notes.
+func unmarshalNotes(data string, cipher *fieldcrypt.Cipher) ([]entity.AlertNote, error) {
+	if data == "" || data == "[]" {
+		return nil, nil
+	}
+	var records []noteRecord
+	if err := unmarshalJSON(data, &records); err != nil {
+		return nil, err
+	}
+	notes := make([]entity.AlertNote, len(records))
+	for i, r := range records {
+		text, err := cipher.Decrypt(r.Text)
+		if err != nil {
+			return nil, err
+		}
+		notes[i] = entity.AlertNote{At: r.At, By: r.By, Text: text}
+	}
+	return notes, nil
+}
+
 // mapError maps MySQL errors to domain repository errors.
 // This provides a consistent error interface across different storage implementations.
 func mapError(err error) error {
@@ -200,3 +303,9 @@ func isDuplicateError(err error) bool {
 
 	return false
 }
+
+// likePattern matches text anywhere in a LIKE ... ESCAPE '!' comparison.
+func likePattern(text string) string {
+	text = strings.NewReplacer("!", "!!", "%", "!%", "_", "!_").Replace(text)
+	return "%" + text + "%"
+}
@@ -1248,7 +1248,7 @@ func TestSilenceExpiration(t *testing.T) {
 	assert.Equal(t, 4, totalCount)
 
 	// Delete expired silences
-	deletedCount, err := repo.DeleteExpired(ctx)
+	deletedCount, err := repo.DeleteExpired(ctx, time.Now())
 	require.NoError(t, err)
 	assert.Equal(t, 2, deletedCount, "Should delete 2 expired silences")
 
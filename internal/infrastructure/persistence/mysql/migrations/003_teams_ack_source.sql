@@ -0,0 +1,7 @@
+-- MySQL Schema Migration: Teams Ack Source
+-- Version: 3
+-- Date: 2026-10-15
+-- Description: Allow 'teams' as an acknowledgment source
+
+ALTER TABLE ack_events
+MODIFY COLUMN source ENUM('slack', 'pagerduty', 'api', 'teams') NOT NULL;
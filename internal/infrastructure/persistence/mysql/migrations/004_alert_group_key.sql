@@ -0,0 +1,8 @@
+-- MySQL Schema Migration: Alert Group Key
+-- Version: 4
+-- Date: 2026-10-15
+-- Description: Track the Alertmanager groupKey each alert was received in
+
+ALTER TABLE alerts
+ADD COLUMN group_key VARCHAR(512) NOT NULL DEFAULT '' AFTER external_references,
+ADD INDEX idx_alerts_group_key (group_key);
@@ -0,0 +1,7 @@
+-- MySQL Schema Migration: Alert History
+-- Version: 5
+-- Date: 2026-10-15
+-- Description: Store severity and state transitions of each alert as a JSON array
+
+ALTER TABLE alerts
+ADD COLUMN history JSON NULL AFTER group_key;
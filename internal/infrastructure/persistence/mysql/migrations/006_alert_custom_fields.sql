@@ -0,0 +1,7 @@
+-- MySQL Schema Migration: Alert Custom Fields
+-- Version: 6
+-- Date: 2026-10-15
+-- Description: Store typed custom fields extracted by per-tenant schemas
+
+ALTER TABLE alerts
+ADD COLUMN custom_fields JSON NULL AFTER history;
@@ -0,0 +1,7 @@
+-- MySQL Schema Migration: Alert Tags
+-- Version: 7
+-- Date: 2026-10-15
+-- Description: Store responder-assigned tags as a JSON array
+
+ALTER TABLE alerts
+ADD COLUMN tags JSON NULL AFTER custom_fields;
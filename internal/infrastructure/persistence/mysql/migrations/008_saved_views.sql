@@ -0,0 +1,22 @@
+-- MySQL Schema Migration: Saved Views
+-- Version: 8
+-- Date: 2026-10-15
+-- Description: Named alert filters, personal (owner set) or shared (owner empty)
+
+CREATE TABLE IF NOT EXISTS saved_views (
+    id VARCHAR(255) PRIMARY KEY NOT NULL,
+    name VARCHAR(64) NOT NULL,
+    owner VARCHAR(255) NOT NULL DEFAULT '',
+
+    -- Criteria
+    labels JSON NULL,
+    severity VARCHAR(16) NOT NULL DEFAULT '',
+    state VARCHAR(16) NOT NULL DEFAULT '',
+
+    created_by VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE KEY uk_saved_views_owner_name (owner, name)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci
+  COMMENT='Named alert filters';
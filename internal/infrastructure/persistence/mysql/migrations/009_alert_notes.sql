@@ -0,0 +1,7 @@
+-- MySQL Schema Migration: Alert Notes
+-- Version: 9
+-- Date: 2026-10-15
+-- Description: Store responder notes as a JSON array
+
+ALTER TABLE alerts
+ADD COLUMN notes JSON NULL AFTER tags;
@@ -0,0 +1,22 @@
+-- MySQL Schema Migration: Notification Retries
+-- Version: 10
+-- Date: 2026-10-15
+-- Description: Failed notifications queued for retry, and dead-lettered ones
+
+CREATE TABLE IF NOT EXISTS notification_retries (
+    id VARCHAR(255) PRIMARY KEY NOT NULL,
+    alert_id VARCHAR(255) NOT NULL,
+    notifier VARCHAR(64) NOT NULL,
+    action ENUM('notify', 'update') NOT NULL,
+    status ENUM('pending', 'dead') NOT NULL DEFAULT 'pending',
+
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT NULL,
+
+    next_attempt_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    INDEX idx_notification_retries_due (status, next_attempt_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci
+  COMMENT='Failed notifications queued for retry, and dead-lettered ones';
@@ -0,0 +1,7 @@
+-- MySQL Schema Migration: Silence Recurrence
+-- Version: 11
+-- Date: 2026-10-15
+-- Description: Repeating windows of recurring silences, in RRULE-like form
+
+ALTER TABLE silences
+ADD COLUMN recurrence VARCHAR(255) NULL AFTER end_at;
@@ -0,0 +1,7 @@
+-- MySQL Schema Migration: Silence Matchers
+-- Version: 12
+-- Date: 2026-10-15
+-- Description: Negative and regex label matchers of silences, as a JSON array
+
+ALTER TABLE silences
+ADD COLUMN matchers JSON NULL AFTER labels;
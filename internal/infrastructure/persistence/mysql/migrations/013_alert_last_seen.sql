@@ -0,0 +1,8 @@
+-- MySQL Schema Migration: Alert Last Seen
+-- Version: 13
+-- Date: 2026-10-15
+-- Description: When the source last sent a firing alert, and the end time it reported
+
+ALTER TABLE alerts
+ADD COLUMN last_seen_at TIMESTAMP NULL DEFAULT NULL AFTER resolved_at,
+ADD COLUMN ends_at TIMESTAMP NULL DEFAULT NULL AFTER last_seen_at;
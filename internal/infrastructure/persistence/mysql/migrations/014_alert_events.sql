@@ -0,0 +1,20 @@
+-- MySQL Schema Migration: Alert Events
+-- Version: 14
+-- Date: 2026-10-15
+-- Description: Alert timelines: state transitions, notifications, acks, notes and silences
+
+CREATE TABLE IF NOT EXISTS alert_events (
+    id VARCHAR(255) PRIMARY KEY NOT NULL,
+    alert_id VARCHAR(255) NOT NULL,
+    type VARCHAR(64) NOT NULL,
+
+    actor VARCHAR(255) NOT NULL DEFAULT '',
+    detail TEXT NULL,
+
+    created_at TIMESTAMP(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+
+    FOREIGN KEY (alert_id) REFERENCES alerts(id) ON DELETE CASCADE,
+
+    INDEX idx_alert_events_alert_created (alert_id, created_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci
+  COMMENT='Alert timelines';
@@ -0,0 +1,9 @@
+-- MySQL Schema Migration: Alert Assignee
+-- Version: 15
+-- Date: 2026-10-15
+-- Description: Who owns an alert, separate from who acknowledged it
+
+ALTER TABLE alerts
+ADD COLUMN assigned_to VARCHAR(255) NULL DEFAULT NULL AFTER ends_at,
+ADD COLUMN assignee_slack_id VARCHAR(64) NULL DEFAULT NULL AFTER assigned_to,
+ADD COLUMN assigned_at TIMESTAMP NULL DEFAULT NULL AFTER assignee_slack_id;
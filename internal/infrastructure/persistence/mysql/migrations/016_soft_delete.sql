@@ -0,0 +1,10 @@
+-- MySQL Schema Migration: Soft Delete
+-- Version: 16
+-- Date: 2026-10-15
+-- Description: Deleted alerts and silences keep their rows until restored or purged
+
+ALTER TABLE alerts
+ADD COLUMN deleted_at TIMESTAMP NULL DEFAULT NULL AFTER assigned_at;
+
+ALTER TABLE silences
+ADD COLUMN deleted_at TIMESTAMP NULL DEFAULT NULL AFTER matchers;
@@ -0,0 +1,7 @@
+-- MySQL Schema Migration: Alert Source
+-- Version: 17
+-- Date: 2026-10-15
+-- Description: The integration that raised each alert, for per-source bulk resolves
+
+ALTER TABLE alerts
+ADD COLUMN source VARCHAR(255) NULL DEFAULT NULL AFTER target;
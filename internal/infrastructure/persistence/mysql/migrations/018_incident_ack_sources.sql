@@ -0,0 +1,7 @@
+-- MySQL Schema Migration: Incident Ack Sources
+-- Version: 18
+-- Date: 2026-10-15
+-- Description: Allow 'firehydrant' and 'incidentio' as acknowledgment sources
+
+ALTER TABLE ack_events
+MODIFY COLUMN source ENUM('slack', 'pagerduty', 'api', 'teams', 'firehydrant', 'incidentio') NOT NULL;
@@ -0,0 +1,18 @@
+-- MySQL Schema Migration: External Reference Records
+-- Version: 19
+-- Date: 2026-10-15
+-- Description: Store external references as versioned per-notifier records instead of a flat map
+
+-- {"slack": "C1:123"} becomes
+-- {"version": 2, "references": [{"system": "slack", "reference_id": "C1:123"}]}
+UPDATE alerts
+SET external_references = JSON_OBJECT('version', 2, 'references', COALESCE((
+    SELECT JSON_ARRAYAGG(JSON_OBJECT(
+        'system', ref.system,
+        'reference_id', JSON_UNQUOTE(JSON_EXTRACT(alerts.external_references, CONCAT('$."', ref.system, '"')))
+    ))
+    FROM JSON_TABLE(JSON_KEYS(alerts.external_references), '$[*]' COLUMNS (system VARCHAR(255) PATH '$')) AS ref
+    WHERE JSON_UNQUOTE(JSON_EXTRACT(alerts.external_references, CONCAT('$."', ref.system, '"'))) != ''
+), JSON_ARRAY()))
+WHERE external_references IS NOT NULL
+  AND COALESCE(JSON_TYPE(JSON_EXTRACT(external_references, '$.version')), '') != 'INTEGER';
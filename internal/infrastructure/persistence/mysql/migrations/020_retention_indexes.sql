@@ -0,0 +1,7 @@
+-- MySQL Schema Migration: Retention Indexes
+-- Version: 20
+-- Date: 2026-10-15
+-- Description: Index the column the retention janitor purges alerts by
+
+ALTER TABLE alerts
+ADD INDEX idx_alerts_resolved_at (resolved_at);
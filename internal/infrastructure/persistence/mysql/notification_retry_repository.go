@@ -0,0 +1,160 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// notificationRetryColumns lists notification_retries columns in the order
+// scanNotificationRetry expects.
+const notificationRetryColumns = `id, alert_id, notifier, action, status, attempts, last_error, next_attempt_at, created_at, updated_at`
+
+// NotificationRetryRepository provides MySQL implementation of repository.NotificationRetryRepository.
+type NotificationRetryRepository struct {
+	db *DB
+}
+
+// NewNotificationRetryRepository creates a new MySQL-backed notification retry repository.
+func NewNotificationRetryRepository(db *DB) *NotificationRetryRepository {
+	return &NotificationRetryRepository{db: db}
+}
+
+// Save creates or replaces a retry.
+func (r *NotificationRetryRepository) Save(ctx context.Context, retry *entity.NotificationRetry) error {
+	query := `
+		INSERT INTO notification_retries (` + notificationRetryColumns + `)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			status = VALUES(status),
+			attempts = VALUES(attempts),
+			last_error = VALUES(last_error),
+			next_attempt_at = VALUES(next_attempt_at),
+			created_at = VALUES(created_at),
+			updated_at = VALUES(updated_at)
+	`
+
+	_, err := r.db.Primary().ExecContext(ctx, query,
+		retry.ID,
+		retry.AlertID,
+		retry.Notifier,
+		string(retry.Action),
+		string(retry.Status),
+		retry.Attempts,
+		retry.LastError,
+		timeToTimestamp(retry.NextAttemptAt),
+		timeToTimestamp(retry.CreatedAt),
+		timeToTimestamp(retry.UpdatedAt),
+	)
+	if err != nil {
+		return fmt.Errorf("upserting notification retry: %w", err)
+	}
+
+	return nil
+}
+
+// FindDue returns up to limit pending retries due at now, earliest first.
+// Reads the primary, as the queue's own writes decide what is due.
+func (r *NotificationRetryRepository) FindDue(ctx context.Context, now time.Time, limit int) ([]*entity.NotificationRetry, error) {
+	query := `
+		SELECT ` + notificationRetryColumns + `
+		FROM notification_retries
+		WHERE status = ? AND next_attempt_at <= ?
+		ORDER BY next_attempt_at
+		LIMIT ?
+	`
+
+	return r.query(ctx, query, string(entity.RetryStatusPending), timeToTimestamp(now), limit)
+}
+
+// FindByID retrieves a retry by ID. Returns nil, nil if not found.
+func (r *NotificationRetryRepository) FindByID(ctx context.Context, id string) (*entity.NotificationRetry, error) {
+	query := `SELECT ` + notificationRetryColumns + ` FROM notification_retries WHERE id = ?`
+
+	retry, err := scanNotificationRetry(r.db.Primary().QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("querying notification retry: %w", err)
+	}
+
+	return retry, nil
+}
+
+// FindDead returns the dead-lettered retries, most recently failed first.
+func (r *NotificationRetryRepository) FindDead(ctx context.Context) ([]*entity.NotificationRetry, error) {
+	query := `
+		SELECT ` + notificationRetryColumns + `
+		FROM notification_retries
+		WHERE status = ?
+		ORDER BY updated_at DESC
+	`
+
+	return r.query(ctx, query, string(entity.RetryStatusDead))
+}
+
+func (r *NotificationRetryRepository) query(ctx context.Context, query string, args ...any) ([]*entity.NotificationRetry, error) {
+	rows, err := r.db.Primary().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying notification retries: %w", err)
+	}
+	defer rows.Close()
+
+	retries := []*entity.NotificationRetry{}
+	for rows.Next() {
+		retry, err := scanNotificationRetry(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanning notification retry row: %w", err)
+		}
+		retries = append(retries, retry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating notification retry rows: %w", err)
+	}
+
+	return retries, nil
+}
+
+// Delete removes a retry.
+// Returns ErrNotificationRetryNotFound if the retry doesn't exist.
+func (r *NotificationRetryRepository) Delete(ctx context.Context, id string) error {
+	result, err := r.db.Primary().ExecContext(ctx,
+		`DELETE FROM notification_retries WHERE id = ?`, id)
+	if err != nil {
+		return fmt.Errorf("deleting notification retry: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("getting rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return entity.ErrNotificationRetryNotFound
+	}
+
+	return nil
+}
+
+// scanNotificationRetry scans a row selected with notificationRetryColumns.
+func scanNotificationRetry(row rowScanner) (*entity.NotificationRetry, error) {
+	var retry entity.NotificationRetry
+	var action, status string
+	var lastError sql.NullString
+
+	if err := row.Scan(
+		&retry.ID, &retry.AlertID, &retry.Notifier, &action, &status,
+		&retry.Attempts, &lastError, &retry.NextAttemptAt, &retry.CreatedAt, &retry.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	retry.Action = entity.NotificationAction(action)
+	retry.Status = entity.RetryStatus(status)
+	retry.LastError = stringValue(lastError)
+
+	return &retry, nil
+}
@@ -0,0 +1,149 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/logger"
+)
+
+// StartReplicaHealthCheck checks the replica every health check interval
+// until Close. Reads fail over to the primary while the replica does not
+// answer, its replication is stopped, or it lags behind by more than
+// max_lag, and return to it once it recovers. Does nothing without a
+// replica.
+func (db *DB) StartReplicaHealthCheck(log logger.Logger) {
+	if db.replica == nil || db.stopHealth != nil {
+		return
+	}
+	if !db.replicaHealthy.Load() {
+		log.Warn("mysql replica unavailable, reading from primary",
+			"host", db.config.Replica.Host,
+		)
+	}
+
+	db.stopHealth = make(chan struct{})
+	db.healthDone = make(chan struct{})
+	go func() {
+		defer close(db.healthDone)
+
+		ticker := time.NewTicker(db.config.Replica.HealthCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-db.stopHealth:
+				return
+			case <-ticker.C:
+				db.updateReplicaHealth(log)
+			}
+		}
+	}()
+}
+
+// updateReplicaHealth checks the replica and logs changes of its health.
+func (db *DB) updateReplicaHealth(log logger.Logger) {
+	ctx, cancel := context.WithTimeout(context.Background(), db.config.Timeout)
+	defer cancel()
+
+	err := db.checkReplica(ctx)
+	healthy := err == nil
+	if db.replicaHealthy.Swap(healthy) == healthy {
+		return
+	}
+	if healthy {
+		log.Info("mysql replica recovered, reading from replica",
+			"host", db.config.Replica.Host,
+		)
+	} else {
+		log.Warn("mysql replica unhealthy, reading from primary",
+			"host", db.config.Replica.Host,
+			"error", err,
+		)
+	}
+}
+
+// checkReplica returns why reads should not go to the replica, if at all.
+func (db *DB) checkReplica(ctx context.Context) error {
+	if err := db.replica.PingContext(ctx); err != nil {
+		return fmt.Errorf("replica ping failed: %w", err)
+	}
+
+	lag, known, err := replicaLag(ctx, db.replica)
+	if err != nil {
+		return err
+	}
+	if known && lag > db.config.Replica.MaxLag {
+		return fmt.Errorf("replica lags %s behind, more than max_lag %s", lag, db.config.Replica.MaxLag)
+	}
+	return nil
+}
+
+// replicaLag returns how far the replica is behind its source, from SHOW
+// REPLICA STATUS (SHOW SLAVE STATUS before MySQL 8.0.22). known is false
+// when the status cannot be read, such as without the REPLICATION CLIENT
+// privilege, or the server is not a replica; the lag is then not checked.
+// Stopped replication is an error.
+func replicaLag(ctx context.Context, replica *sql.DB) (lag time.Duration, known bool, err error) {
+	for _, query := range []string{"SHOW REPLICA STATUS", "SHOW SLAVE STATUS"} {
+		status, err := queryStatus(ctx, replica, query)
+		if err != nil {
+			continue
+		}
+		if status == nil {
+			return 0, false, nil
+		}
+
+		for _, column := range []string{"Seconds_Behind_Source", "Seconds_Behind_Master"} {
+			value, ok := status[column]
+			if !ok {
+				continue
+			}
+			if !value.Valid {
+				return 0, true, fmt.Errorf("replica is not replicating")
+			}
+			seconds, err := strconv.Atoi(value.String)
+			if err != nil {
+				return 0, false, nil
+			}
+			return time.Duration(seconds) * time.Second, true, nil
+		}
+		return 0, false, nil
+	}
+	return 0, false, nil
+}
+
+// queryStatus returns the columns of the single row of a SHOW statement,
+// or nil if it returned no row.
+func queryStatus(ctx context.Context, db *sql.DB, query string) (map[string]sql.NullString, error) {
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+
+	values := make([]sql.NullString, len(columns))
+	dest := make([]any, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	status := make(map[string]sql.NullString, len(columns))
+	for i, column := range columns {
+		status[column] = values[i]
+	}
+	return status, nil
+}
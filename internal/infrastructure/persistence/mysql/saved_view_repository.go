@@ -0,0 +1,153 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// savedViewColumns lists saved_views columns in the order scanSavedView expects.
+const savedViewColumns = `id, name, owner, labels, severity, state, created_by, created_at, updated_at`
+
+// SavedViewRepository provides MySQL implementation of repository.SavedViewRepository.
+type SavedViewRepository struct {
+	db *DB
+}
+
+// NewSavedViewRepository creates a new MySQL-backed saved view repository.
+func NewSavedViewRepository(db *DB) *SavedViewRepository {
+	return &SavedViewRepository{db: db}
+}
+
+// Save creates or replaces a view, keyed by owner and name.
+func (r *SavedViewRepository) Save(ctx context.Context, view *entity.SavedView) error {
+	labelsJSON, err := marshalJSON(view.Labels)
+	if err != nil {
+		return fmt.Errorf("marshaling labels: %w", err)
+	}
+
+	query := `
+		INSERT INTO saved_views (` + savedViewColumns + `)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			labels = VALUES(labels),
+			severity = VALUES(severity),
+			state = VALUES(state),
+			created_by = VALUES(created_by),
+			updated_at = VALUES(updated_at)
+	`
+
+	_, err = r.db.Primary().ExecContext(ctx, query,
+		view.ID,
+		view.Name,
+		view.Owner,
+		labelsJSON,
+		string(view.Severity),
+		string(view.State),
+		view.CreatedBy,
+		timeToTimestamp(view.CreatedAt),
+		timeToTimestamp(view.UpdatedAt),
+	)
+	if err != nil {
+		return fmt.Errorf("upserting saved view: %w", err)
+	}
+
+	return nil
+}
+
+// FindByName returns the view visible to owner, preferring the owner's own view.
+// Returns nil, nil if not found.
+func (r *SavedViewRepository) FindByName(ctx context.Context, owner, name string) (*entity.SavedView, error) {
+	query := `
+		SELECT ` + savedViewColumns + `
+		FROM saved_views
+		WHERE name = ? AND (owner = ? OR owner = '')
+		ORDER BY owner DESC
+		LIMIT 1
+	`
+
+	view, err := scanSavedView(r.db.Primary().QueryRowContext(ctx, query, name, owner))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("querying saved view: %w", err)
+	}
+	return view, nil
+}
+
+// FindVisible returns the owner's views and all shared views, ordered by name.
+func (r *SavedViewRepository) FindVisible(ctx context.Context, owner string) ([]*entity.SavedView, error) {
+	query := `
+		SELECT ` + savedViewColumns + `
+		FROM saved_views
+		WHERE owner = ? OR owner = ''
+		ORDER BY name, owner DESC
+	`
+
+	rows, err := r.db.Replica().QueryContext(ctx, query, owner)
+	if err != nil {
+		return nil, fmt.Errorf("querying saved views: %w", err)
+	}
+	defer rows.Close()
+
+	views := []*entity.SavedView{}
+	for rows.Next() {
+		view, err := scanSavedView(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanning saved view row: %w", err)
+		}
+		views = append(views, view)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating saved view rows: %w", err)
+	}
+
+	return views, nil
+}
+
+// Delete removes a view.
+// Returns ErrSavedViewNotFound if the view doesn't exist.
+func (r *SavedViewRepository) Delete(ctx context.Context, owner, name string) error {
+	result, err := r.db.Primary().ExecContext(ctx,
+		`DELETE FROM saved_views WHERE owner = ? AND name = ?`, owner, name)
+	if err != nil {
+		return fmt.Errorf("deleting saved view: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("getting rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return entity.ErrSavedViewNotFound
+	}
+
+	return nil
+}
+
+// scanSavedView scans a row selected with savedViewColumns.
+func scanSavedView(row rowScanner) (*entity.SavedView, error) {
+	var view entity.SavedView
+	var labelsJSON sql.NullString
+	var severity, state string
+
+	if err := row.Scan(
+		&view.ID, &view.Name, &view.Owner, &labelsJSON, &severity, &state,
+		&view.CreatedBy, &view.CreatedAt, &view.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if labelsJSON.Valid {
+		if err := unmarshalJSON(labelsJSON.String, &view.Labels); err != nil {
+			return nil, fmt.Errorf("unmarshaling labels: %w", err)
+		}
+	}
+	view.Severity = entity.AlertSeverity(severity)
+	view.State = entity.AlertState(state)
+
+	return &view, nil
+}
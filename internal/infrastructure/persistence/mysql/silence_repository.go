@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
 	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
@@ -26,18 +27,22 @@ func (r *SilenceRepository) Save(ctx context.Context, silence *entity.SilenceMar
 	if err != nil {
 		return fmt.Errorf("marshaling labels: %w", err)
 	}
+	matchers, err := matchersToNull(silence.Matchers)
+	if err != nil {
+		return err
+	}
 
 	query := `
 		INSERT INTO silences (
 			id, alert_id, instance, fingerprint, labels,
 			start_at, end_at,
 			created_by, created_by_email, reason, source,
-			version, created_at
+			version, created_at, recurrence, matchers
 		) VALUES (
 			?, ?, ?, ?, ?,
 			?, ?,
 			?, ?, ?, ?,
-			1, ?
+			1, ?, ?, ?
 		)
 	`
 
@@ -54,6 +59,8 @@ func (r *SilenceRepository) Save(ctx context.Context, silence *entity.SilenceMar
 		silence.Reason,
 		string(silence.Source),
 		timeToTimestamp(silence.CreatedAt),
+		recurrenceToNull(silence.Recurrence),
+		matchers,
 	)
 
 	if err != nil {
@@ -74,17 +81,18 @@ func (r *SilenceRepository) FindByID(ctx context.Context, id string) (*entity.Si
 			id, alert_id, instance, fingerprint, labels,
 			start_at, end_at,
 			created_by, created_by_email, reason, source,
-			version, created_at
+			version, created_at, recurrence, matchers
 		FROM silences
-		WHERE id = ?
+		WHERE id = ? AND deleted_at IS NULL
 	`
 
 	var silence entity.SilenceMark
 	var alertID, instance, fingerprint, createdBy, createdByEmail sql.NullString
 	var labelsJSON string
+	var recurrence, matchers sql.NullString
 	var version int
 
-	err := r.db.Replica().QueryRowContext(ctx, query, id).Scan(
+	err := r.db.Primary().QueryRowContext(ctx, query, id).Scan(
 		&silence.ID,
 		&alertID,
 		&instance,
@@ -98,6 +106,8 @@ func (r *SilenceRepository) FindByID(ctx context.Context, id string) (*entity.Si
 		&silence.Source,
 		&version,
 		&silence.CreatedAt,
+		&recurrence,
+		&matchers,
 	)
 
 	if err != nil {
@@ -118,6 +128,12 @@ func (r *SilenceRepository) FindByID(ctx context.Context, id string) (*entity.Si
 	silence.Fingerprint = stringValue(fingerprint)
 	silence.CreatedBy = stringValue(createdBy)
 	silence.CreatedByEmail = stringValue(createdByEmail)
+	if silence.Recurrence, err = recurrenceFromNull(recurrence); err != nil {
+		return nil, err
+	}
+	if silence.Matchers, err = matchersFromNull(matchers); err != nil {
+		return nil, err
+	}
 
 	return &silence, nil
 }
@@ -130,9 +146,9 @@ func (r *SilenceRepository) FindActive(ctx context.Context) ([]*entity.SilenceMa
 			id, alert_id, instance, fingerprint, labels,
 			start_at, end_at,
 			created_by, created_by_email, reason, source,
-			version, created_at
+			version, created_at, recurrence, matchers
 		FROM silences
-		WHERE start_at <= NOW() AND end_at > NOW()
+		WHERE start_at <= NOW() AND end_at > NOW() AND deleted_at IS NULL
 		ORDER BY created_at DESC
 	`
 
@@ -152,10 +168,10 @@ func (r *SilenceRepository) FindByAlertID(ctx context.Context, alertID string) (
 			id, alert_id, instance, fingerprint, labels,
 			start_at, end_at,
 			created_by, created_by_email, reason, source,
-			version, created_at
+			version, created_at, recurrence, matchers
 		FROM silences
 		WHERE alert_id = ?
-		  AND start_at <= NOW() AND end_at > NOW()
+		  AND start_at <= NOW() AND end_at > NOW() AND deleted_at IS NULL
 		ORDER BY created_at DESC
 	`
 
@@ -175,10 +191,10 @@ func (r *SilenceRepository) FindByInstance(ctx context.Context, instance string)
 			id, alert_id, instance, fingerprint, labels,
 			start_at, end_at,
 			created_by, created_by_email, reason, source,
-			version, created_at
+			version, created_at, recurrence, matchers
 		FROM silences
 		WHERE instance = ?
-		  AND start_at <= NOW() AND end_at > NOW()
+		  AND start_at <= NOW() AND end_at > NOW() AND deleted_at IS NULL
 		ORDER BY created_at DESC
 	`
 
@@ -198,10 +214,10 @@ func (r *SilenceRepository) FindByFingerprint(ctx context.Context, fingerprint s
 			id, alert_id, instance, fingerprint, labels,
 			start_at, end_at,
 			created_by, created_by_email, reason, source,
-			version, created_at
+			version, created_at, recurrence, matchers
 		FROM silences
 		WHERE fingerprint = ?
-		  AND start_at <= NOW() AND end_at > NOW()
+		  AND start_at <= NOW() AND end_at > NOW() AND deleted_at IS NULL
 		ORDER BY created_at DESC
 	`
 
@@ -227,9 +243,9 @@ func (r *SilenceRepository) FindMatchingAlert(ctx context.Context, alert *entity
 			id, alert_id, instance, fingerprint, labels,
 			start_at, end_at,
 			created_by, created_by_email, reason, source,
-			version, created_at
+			version, created_at, recurrence, matchers
 		FROM silences
-		WHERE start_at <= NOW() AND end_at > NOW()
+		WHERE start_at <= NOW() AND end_at > NOW() AND deleted_at IS NULL
 	`
 
 	rows, err := r.db.Replica().QueryContext(ctx, query)
@@ -260,7 +276,7 @@ func (r *SilenceRepository) FindMatchingAlert(ctx context.Context, alert *entity
 func (r *SilenceRepository) Update(ctx context.Context, silence *entity.SilenceMark) error {
 	// First, get the current version
 	var currentVersion int
-	versionQuery := `SELECT version FROM silences WHERE id = ?`
+	versionQuery := `SELECT version FROM silences WHERE id = ? AND deleted_at IS NULL`
 	err := r.db.Primary().QueryRowContext(ctx, versionQuery, silence.ID).Scan(&currentVersion)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -274,6 +290,10 @@ func (r *SilenceRepository) Update(ctx context.Context, silence *entity.SilenceM
 	if err != nil {
 		return fmt.Errorf("marshaling labels: %w", err)
 	}
+	matchers, err := matchersToNull(silence.Matchers)
+	if err != nil {
+		return err
+	}
 
 	// Update with optimistic locking (increment version)
 	query := `
@@ -288,8 +308,10 @@ func (r *SilenceRepository) Update(ctx context.Context, silence *entity.SilenceM
 			created_by_email = ?,
 			reason = ?,
 			source = ?,
+			recurrence = ?,
+			matchers = ?,
 			version = version + 1
-		WHERE id = ? AND version = ?
+		WHERE id = ? AND version = ? AND deleted_at IS NULL
 	`
 
 	result, err := r.db.Primary().ExecContext(ctx, query,
@@ -303,6 +325,8 @@ func (r *SilenceRepository) Update(ctx context.Context, silence *entity.SilenceM
 		nullString(silence.CreatedByEmail),
 		silence.Reason,
 		string(silence.Source),
+		recurrenceToNull(silence.Recurrence),
+		matchers,
 		silence.ID,
 		currentVersion,
 	)
@@ -319,7 +343,7 @@ func (r *SilenceRepository) Update(ctx context.Context, silence *entity.SilenceM
 	if rowsAffected == 0 {
 		// Either the silence doesn't exist or version mismatch
 		var exists bool
-		existsQuery := `SELECT COUNT(*) > 0 FROM silences WHERE id = ?`
+		existsQuery := `SELECT COUNT(*) > 0 FROM silences WHERE id = ? AND deleted_at IS NULL`
 		err := r.db.Primary().QueryRowContext(ctx, existsQuery, silence.ID).Scan(&exists)
 		if err != nil {
 			return fmt.Errorf("checking silence existence: %w", err)
@@ -336,10 +360,10 @@ func (r *SilenceRepository) Update(ctx context.Context, silence *entity.SilenceM
 	return nil
 }
 
-// Delete removes a silence by ID.
+// Delete soft-deletes a silence by ID, setting its deleted_at.
 // Returns ErrNotFound if the silence doesn't exist.
 func (r *SilenceRepository) Delete(ctx context.Context, id string) error {
-	query := `DELETE FROM silences WHERE id = ?`
+	query := `UPDATE silences SET deleted_at = NOW() WHERE id = ? AND deleted_at IS NULL`
 
 	result, err := r.db.Primary().ExecContext(ctx, query, id)
 	if err != nil {
@@ -358,12 +382,35 @@ func (r *SilenceRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
-// DeleteExpired removes all expired silences.
+// Restore clears the deleted_at of a soft-deleted silence.
+// Returns ErrNotFound if no deleted silence has the ID.
+func (r *SilenceRepository) Restore(ctx context.Context, id string) error {
+	query := `UPDATE silences SET deleted_at = NULL WHERE id = ? AND deleted_at IS NOT NULL`
+
+	result, err := r.db.Primary().ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("restoring silence: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("checking rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+// DeleteExpired removes silences that expired before the given time,
+// soft-deleted or not.
 // Returns the number of deleted silences.
-func (r *SilenceRepository) DeleteExpired(ctx context.Context) (int, error) {
-	query := `DELETE FROM silences WHERE end_at < NOW()`
+func (r *SilenceRepository) DeleteExpired(ctx context.Context, before time.Time) (int, error) {
+	query := `DELETE FROM silences WHERE end_at < ?`
 
-	result, err := r.db.Primary().ExecContext(ctx, query)
+	result, err := r.db.Primary().ExecContext(ctx, query, before.UTC())
 	if err != nil {
 		return 0, fmt.Errorf("deleting expired silences: %w", err)
 	}
@@ -384,6 +431,7 @@ func (r *SilenceRepository) scanSilences(rows *sql.Rows) ([]*entity.SilenceMark,
 		var silence entity.SilenceMark
 		var alertID, instance, fingerprint, createdBy, createdByEmail sql.NullString
 		var labelsJSON string
+		var recurrence, matchers sql.NullString
 		var version int
 
 		err := rows.Scan(
@@ -400,6 +448,8 @@ func (r *SilenceRepository) scanSilences(rows *sql.Rows) ([]*entity.SilenceMark,
 			&silence.Source,
 			&version,
 			&silence.CreatedAt,
+			&recurrence,
+			&matchers,
 		)
 
 		if err != nil {
@@ -417,6 +467,12 @@ func (r *SilenceRepository) scanSilences(rows *sql.Rows) ([]*entity.SilenceMark,
 		silence.Fingerprint = stringValue(fingerprint)
 		silence.CreatedBy = stringValue(createdBy)
 		silence.CreatedByEmail = stringValue(createdByEmail)
+		if silence.Recurrence, err = recurrenceFromNull(recurrence); err != nil {
+			return nil, err
+		}
+		if silence.Matchers, err = matchersFromNull(matchers); err != nil {
+			return nil, err
+		}
 
 		silences = append(silences, &silence)
 	}
@@ -427,3 +483,49 @@ func (r *SilenceRepository) scanSilences(rows *sql.Rows) ([]*entity.SilenceMark,
 
 	return silences, nil
 }
+
+// recurrenceToNull stores a silence recurrence in its RRULE-like form.
+func recurrenceToNull(recurrence *entity.SilenceRecurrence) sql.NullString {
+	if recurrence == nil {
+		return sql.NullString{}
+	}
+	return nullString(recurrence.String())
+}
+
+// recurrenceFromNull parses a stored silence recurrence.
+func recurrenceFromNull(ns sql.NullString) (*entity.SilenceRecurrence, error) {
+	if !ns.Valid || ns.String == "" {
+		return nil, nil
+	}
+	recurrence, err := entity.ParseSilenceRecurrence(ns.String)
+	if err != nil {
+		return nil, fmt.Errorf("parsing recurrence: %w", err)
+	}
+	return recurrence, nil
+}
+
+// matchersToNull stores the non-equality label matchers of a silence as a
+// JSON array.
+func matchersToNull(matchers []entity.LabelMatcher) (sql.NullString, error) {
+	if len(matchers) == 0 {
+		return sql.NullString{}, nil
+	}
+	data, err := marshalJSON(matchers)
+	if err != nil {
+		return sql.NullString{}, fmt.Errorf("marshaling matchers: %w", err)
+	}
+	return nullString(data), nil
+}
+
+// matchersFromNull parses stored label matchers, compiling their regular
+// expressions.
+func matchersFromNull(ns sql.NullString) ([]entity.LabelMatcher, error) {
+	if !ns.Valid || ns.String == "" {
+		return nil, nil
+	}
+	var matchers []entity.LabelMatcher
+	if err := unmarshalJSON(ns.String, &matchers); err != nil {
+		return nil, fmt.Errorf("unmarshaling matchers: %w", err)
+	}
+	return matchers, nil
+}
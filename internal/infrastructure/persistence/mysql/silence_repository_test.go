@@ -355,6 +355,13 @@ func TestSilenceRepository_Delete(t *testing.T) {
 	found, err := repo.FindByID(ctx, silence.ID)
 	require.NoError(t, err)
 	assert.Nil(t, found)
+
+	// Restore brings it back once
+	require.NoError(t, repo.Restore(ctx, silence.ID))
+	found, err = repo.FindByID(ctx, silence.ID)
+	require.NoError(t, err)
+	assert.NotNil(t, found)
+	assert.ErrorIs(t, repo.Restore(ctx, silence.ID), repository.ErrNotFound)
 }
 
 func TestSilenceRepository_Delete_NotFound(t *testing.T) {
@@ -398,7 +405,7 @@ func TestSilenceRepository_DeleteExpired(t *testing.T) {
 	require.NoError(t, err)
 
 	// DeleteExpired should remove 2 silences
-	count, err := repo.DeleteExpired(ctx)
+	count, err := repo.DeleteExpired(ctx, time.Now())
 	require.NoError(t, err)
 	assert.Equal(t, 2, count)
 
@@ -0,0 +1,193 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// AckEventRepository implements repository.AckEventRepository using Redis.
+//
+// Events are stored as JSON under ack:<id> and indexed per alert in a sorted
+// set scored by creation time. Per-user counts for the leaderboard are kept
+// in hashes so they survive alert expiry.
+type AckEventRepository struct {
+	client *Client
+}
+
+// NewAckEventRepository creates a new Redis ack event repository.
+func NewAckEventRepository(client *Client) *AckEventRepository {
+	return &AckEventRepository{client: client}
+}
+
+// Save persists a new ack event.
+func (r *AckEventRepository) Save(ctx context.Context, event *entity.AckEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshaling ack event: %w", err)
+	}
+
+	user := event.UserEmail
+	if user == "" {
+		user = event.UserID // fallback to user ID
+	}
+	userData, err := json.Marshal(entity.UserAckCount{UserName: event.UserName, UserEmail: event.UserEmail})
+	if err != nil {
+		return fmt.Errorf("marshaling ack user: %w", err)
+	}
+
+	_, err = r.client.Tx(ctx, [][]string{
+		{"SET", r.client.key("ack", event.ID), string(data)},
+		{"ZADD", r.client.key("acks", "alert", event.AlertID), strconv.FormatInt(event.CreatedAt.UnixMilli(), 10), event.ID},
+		{"HINCRBY", r.client.key("acks", "counts"), user, "1"},
+		{"HSET", r.client.key("acks", "users"), user, string(userData)},
+	})
+	if err != nil {
+		return fmt.Errorf("saving ack event: %w", err)
+	}
+	return nil
+}
+
+// FindByAlertID retrieves all ack events for an alert, oldest first.
+func (r *AckEventRepository) FindByAlertID(ctx context.Context, alertID string) ([]*entity.AckEvent, error) {
+	reply, err := r.client.Do(ctx, "ZRANGE", r.client.key("acks", "alert", alertID), "0", "-1")
+	if err != nil {
+		return nil, fmt.Errorf("reading ack index: %w", err)
+	}
+	return r.load(ctx, asStrings(reply))
+}
+
+// FindByID retrieves an ack event by its ID.
+func (r *AckEventRepository) FindByID(ctx context.Context, id string) (*entity.AckEvent, error) {
+	events, err := r.load(ctx, []string{id})
+	if err != nil || len(events) == 0 {
+		return nil, err
+	}
+	return events[0], nil
+}
+
+// FindLatestByAlertID retrieves the most recent ack event for an alert.
+func (r *AckEventRepository) FindLatestByAlertID(ctx context.Context, alertID string) (*entity.AckEvent, error) {
+	reply, err := r.client.Do(ctx, "ZRANGE", r.client.key("acks", "alert", alertID), "-1", "-1")
+	if err != nil {
+		return nil, fmt.Errorf("reading ack index: %w", err)
+	}
+	events, err := r.load(ctx, asStrings(reply))
+	if err != nil || len(events) == 0 {
+		return nil, err
+	}
+	return events[0], nil
+}
+
+// GetTopAcknowledgers returns users with the most acknowledgments.
+// Limit specifies the maximum number of users to return.
+func (r *AckEventRepository) GetTopAcknowledgers(ctx context.Context, limit int) ([]*entity.UserAckCount, error) {
+	if limit <= 0 {
+		limit = 10
+	}
+
+	replies, err := r.client.Pipeline(ctx, [][]string{
+		{"HGETALL", r.client.key("acks", "counts")},
+		{"HGETALL", r.client.key("acks", "users")},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reading ack counts: %w", err)
+	}
+
+	users := make(map[string]string)
+	pairs := asStrings(replies[1])
+	for i := 0; i+1 < len(pairs); i += 2 {
+		users[pairs[i]] = pairs[i+1]
+	}
+
+	pairs = asStrings(replies[0])
+	results := make([]*entity.UserAckCount, 0, len(pairs)/2)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		count, err := strconv.Atoi(pairs[i+1])
+		if err != nil {
+			continue
+		}
+		uc := &entity.UserAckCount{}
+		if data, ok := users[pairs[i]]; ok {
+			_ = json.Unmarshal([]byte(data), uc)
+		}
+		uc.Count = count
+		results = append(results, uc)
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Count > results[j].Count
+	})
+	if len(results) > limit {
+		results = results[:limit]
+	}
+
+	return results, nil
+}
+
+// DeleteBefore removes ack events created before the given time, alert by
+// alert. The per-user counts of the leaderboard are kept.
+func (r *AckEventRepository) DeleteBefore(ctx context.Context, before time.Time) (int, error) {
+	indexes, err := r.client.scan(ctx, r.client.key("acks", "alert", "*"))
+	if err != nil {
+		return 0, fmt.Errorf("listing ack indexes: %w", err)
+	}
+
+	cutoff := "(" + strconv.FormatInt(before.UnixMilli(), 10)
+	purged := 0
+	for _, index := range indexes {
+		reply, err := r.client.Do(ctx, "ZRANGEBYSCORE", index, "-inf", cutoff)
+		if err != nil {
+			return purged, fmt.Errorf("reading ack index: %w", err)
+		}
+		ids := asStrings(reply)
+		if len(ids) == 0 {
+			continue
+		}
+
+		cmds := [][]string{{"ZREMRANGEBYSCORE", index, "-inf", cutoff}}
+		for _, id := range ids {
+			cmds = append(cmds, []string{"DEL", r.client.key("ack", id)})
+		}
+		if _, err := r.client.Tx(ctx, cmds); err != nil {
+			return purged, fmt.Errorf("deleting ack events: %w", err)
+		}
+		purged += len(ids)
+	}
+	return purged, nil
+}
+
+// load fetches events by ID, skipping missing ones and preserving order.
+func (r *AckEventRepository) load(ctx context.Context, ids []string) ([]*entity.AckEvent, error) {
+	events := make([]*entity.AckEvent, 0, len(ids))
+	if len(ids) == 0 {
+		return events, nil
+	}
+
+	args := make([]string, 0, len(ids)+1)
+	args = append(args, "MGET")
+	for _, id := range ids {
+		args = append(args, r.client.key("ack", id))
+	}
+
+	reply, err := r.client.Do(ctx, args...)
+	if err != nil {
+		return nil, fmt.Errorf("loading ack events: %w", err)
+	}
+	for _, data := range asStrings(reply) {
+		if data == "" {
+			continue
+		}
+		var event entity.AckEvent
+		if err := json.Unmarshal([]byte(data), &event); err != nil {
+			return nil, fmt.Errorf("decoding ack event: %w", err)
+		}
+		events = append(events, &event)
+	}
+	return events, nil
+}
@@ -0,0 +1,41 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+func TestAckEventRepository(t *testing.T) {
+	repos := setupTestRepos(t)
+	ctx := context.Background()
+
+	first := entity.NewAckEvent("alert-1", entity.AckSourceSlack, "U1", "alice@example.com", "Alice")
+	first.CreatedAt = time.Now().Add(-time.Minute)
+	second := entity.NewAckEvent("alert-1", entity.AckSourcePagerDuty, "U2", "bob@example.com", "Bob")
+	third := entity.NewAckEvent("alert-2", entity.AckSourceSlack, "U1", "alice@example.com", "Alice")
+	for _, e := range []*entity.AckEvent{first, second, third} {
+		require.NoError(t, repos.AckEvent.Save(ctx, e))
+	}
+
+	events, err := repos.AckEvent.FindByAlertID(ctx, "alert-1")
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, first.ID, events[0].ID)
+
+	latest, err := repos.AckEvent.FindLatestByAlertID(ctx, "alert-1")
+	require.NoError(t, err)
+	require.NotNil(t, latest)
+	assert.Equal(t, second.ID, latest.ID)
+
+	top, err := repos.AckEvent.GetTopAcknowledgers(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, top, 1)
+	assert.Equal(t, "Alice", top[0].UserName)
+	assert.Equal(t, 2, top[0].Count)
+}
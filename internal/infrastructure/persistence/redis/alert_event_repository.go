@@ -0,0 +1,68 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// AlertEventRepository implements repository.AlertEventRepository using Redis.
+//
+// Each alert's timeline is a list of JSON events under events:alert:<id>, in
+// the order they were saved. Like the alert itself, the timeline expires
+// after the resolved TTL once the alert resolves.
+type AlertEventRepository struct {
+	client *Client
+	ttl    time.Duration
+}
+
+// NewAlertEventRepository creates a new Redis alert event repository.
+func NewAlertEventRepository(client *Client, resolvedTTL time.Duration) *AlertEventRepository {
+	return &AlertEventRepository{client: client, ttl: resolvedTTL}
+}
+
+// Save appends an event to the alert's timeline.
+func (r *AlertEventRepository) Save(ctx context.Context, event *entity.AlertEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshaling alert event: %w", err)
+	}
+
+	key := r.client.key("events", "alert", event.AlertID)
+	cmds := [][]string{{"RPUSH", key, string(data)}}
+	switch event.Type {
+	case entity.AlertEventResolved:
+		cmds = append(cmds, []string{"EXPIRE", key, strconv.FormatInt(int64(r.ttl/time.Second), 10)})
+	case entity.AlertEventFired:
+		// A re-fired alert no longer expires
+		cmds = append(cmds, []string{"PERSIST", key})
+	}
+
+	if _, err := r.client.Tx(ctx, cmds); err != nil {
+		return fmt.Errorf("saving alert event: %w", err)
+	}
+	return nil
+}
+
+// FindByAlertID retrieves all events for an alert, oldest first.
+func (r *AlertEventRepository) FindByAlertID(ctx context.Context, alertID string) ([]*entity.AlertEvent, error) {
+	reply, err := r.client.Do(ctx, "LRANGE", r.client.key("events", "alert", alertID), "0", "-1")
+	if err != nil {
+		return nil, fmt.Errorf("reading alert events: %w", err)
+	}
+
+	items := asStrings(reply)
+	events := make([]*entity.AlertEvent, 0, len(items))
+	for _, data := range items {
+		var event entity.AlertEvent
+		if err := json.Unmarshal([]byte(data), &event); err != nil {
+			return nil, fmt.Errorf("unmarshaling alert event: %w", err)
+		}
+		events = append(events, &event)
+	}
+	return events, nil
+}
@@ -0,0 +1,34 @@
+package redis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+func TestAlertEventRepository(t *testing.T) {
+	repos := setupTestRepos(t)
+	ctx := context.Background()
+
+	fired := entity.NewAlertEvent("alert-1", entity.AlertEventFired, "", "critical")
+	notified := entity.NewAlertEvent("alert-1", entity.AlertEventNotified, "", "slack")
+	other := entity.NewAlertEvent("alert-2", entity.AlertEventFired, "", "warning")
+	for _, e := range []*entity.AlertEvent{fired, notified, other} {
+		require.NoError(t, repos.AlertEvent.Save(ctx, e))
+	}
+
+	events, err := repos.AlertEvent.FindByAlertID(ctx, "alert-1")
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, fired.ID, events[0].ID)
+	assert.Equal(t, entity.AlertEventNotified, events[1].Type)
+	assert.Equal(t, "slack", events[1].Detail)
+
+	events, err = repos.AlertEvent.FindByAlertID(ctx, "alert-3")
+	require.NoError(t, err)
+	assert.Empty(t, events)
+}
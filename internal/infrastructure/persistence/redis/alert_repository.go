@@ -0,0 +1,479 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// indexFingerprintScript adds an alert to its fingerprint index and keeps
+// the index alive only while it has a firing member. Firing alerts score 0;
+// resolved alerts score their resolve time and are pruned once past the TTL.
+//
+// KEYS[1] fingerprint index, ARGV: score, alert ID, prune cutoff, TTL seconds.
+const indexFingerprintScript = `
+redis.call('ZADD', KEYS[1], ARGV[1], ARGV[2])
+redis.call('ZREMRANGEBYSCORE', KEYS[1], 1, ARGV[3])
+if redis.call('ZCOUNT', KEYS[1], 0, 0) == 0 then
+  redis.call('EXPIRE', KEYS[1], ARGV[4])
+else
+  redis.call('PERSIST', KEYS[1])
+end
+return 1`
+
+// AlertRepository implements repository.AlertRepository using Redis.
+//
+// Alerts are stored as JSON under alert:<id>. Firing alert IDs are kept in
+// the alerts:firing set and resolved ones in the alerts:resolved sorted set
+// (scored by resolve time). Resolved alerts and their indexes expire after
+// the configured TTL. Deleted alerts are kept unindexed under
+// deleted-alert:<id> for the TTL so they can be restored.
+type AlertRepository struct {
+	client *Client
+	ttl    time.Duration
+}
+
+// NewAlertRepository creates a new Redis alert repository.
+func NewAlertRepository(client *Client, resolvedTTL time.Duration) *AlertRepository {
+	return &AlertRepository{client: client, ttl: resolvedTTL}
+}
+
+// Save persists a new alert.
+func (r *AlertRepository) Save(ctx context.Context, alert *entity.Alert) error {
+	data, err := json.Marshal(alert)
+	if err != nil {
+		return fmt.Errorf("marshaling alert: %w", err)
+	}
+
+	key := r.alertKey(alert.ID)
+	set := []string{"SET", key, string(data)}
+	if alert.IsResolved() {
+		set = append(set, "EX", r.ttlSeconds())
+	}
+	cmds := append([][]string{set}, r.indexCommands(alert, nil)...)
+
+	// The alert and its indexes are written together, so a failure cannot
+	// leave an alert the indexes do not find
+	_, saved, err := r.client.TxIfAbsent(ctx, key, cmds)
+	if err != nil {
+		return fmt.Errorf("saving alert: %w", err)
+	}
+	if !saved {
+		return entity.ErrDuplicateAlert
+	}
+	return nil
+}
+
+// FindByID retrieves an alert by its unique identifier.
+func (r *AlertRepository) FindByID(ctx context.Context, id string) (*entity.Alert, error) {
+	reply, err := r.client.Do(ctx, "GET", r.alertKey(id))
+	if err != nil {
+		return nil, fmt.Errorf("getting alert: %w", err)
+	}
+	return decodeAlert(reply)
+}
+
+// FindByFingerprint finds alerts matching the Alertmanager fingerprint.
+func (r *AlertRepository) FindByFingerprint(ctx context.Context, fingerprint string) ([]*entity.Alert, error) {
+	reply, err := r.client.Do(ctx, "ZRANGE", r.client.key("alerts", "fp", fingerprint), "0", "-1")
+	if err != nil {
+		return nil, fmt.Errorf("reading fingerprint index: %w", err)
+	}
+	return r.load(ctx, asStrings(reply), nil)
+}
+
+// FindByExternalReference finds an alert by its external system reference.
+func (r *AlertRepository) FindByExternalReference(ctx context.Context, system, referenceID string) (*entity.Alert, error) {
+	reply, err := r.client.Do(ctx, "GET", r.refKey(system, referenceID))
+	if err != nil {
+		return nil, fmt.Errorf("reading reference index: %w", err)
+	}
+	id, _ := reply.(string)
+	if id == "" {
+		return nil, nil
+	}
+	return r.FindByID(ctx, id)
+}
+
+// Update modifies an existing alert.
+func (r *AlertRepository) Update(ctx context.Context, alert *entity.Alert) error {
+	existing, err := r.FindByID(ctx, alert.ID)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return entity.ErrAlertNotFound
+	}
+
+	data, err := json.Marshal(alert)
+	if err != nil {
+		return fmt.Errorf("marshaling alert: %w", err)
+	}
+
+	// A plain SET clears any TTL, so a re-fired alert no longer expires
+	set := []string{"SET", r.alertKey(alert.ID), string(data), "XX"}
+	if alert.IsResolved() {
+		set = append(set, "EX", r.ttlSeconds())
+	}
+	cmds := append([][]string{set}, r.indexCommands(alert, existing)...)
+
+	results, err := r.client.Tx(ctx, cmds)
+	if err != nil {
+		return fmt.Errorf("updating alert: %w", err)
+	}
+	if len(results) > 0 && results[0] == nil {
+		// Expired or deleted since it was read
+		return entity.ErrAlertNotFound
+	}
+	return nil
+}
+
+// FindActive returns all currently active (non-resolved) alerts.
+func (r *AlertRepository) FindActive(ctx context.Context) ([]*entity.Alert, error) {
+	return r.findFiring(ctx, (*entity.Alert).IsActive)
+}
+
+// FindFiring returns all firing alerts (active or acknowledged).
+func (r *AlertRepository) FindFiring(ctx context.Context) ([]*entity.Alert, error) {
+	return r.findFiring(ctx, (*entity.Alert).IsFiring)
+}
+
+// GetActiveAlerts returns active alerts, optionally filtered by severity.
+// Pass empty string for severity to get all active alerts.
+func (r *AlertRepository) GetActiveAlerts(ctx context.Context, severity string) ([]*entity.Alert, error) {
+	return r.findFiring(ctx, func(a *entity.Alert) bool {
+		return a.IsActive() && (severity == "" || string(a.Severity) == severity)
+	})
+}
+
+// FindChangedSince returns alerts that are still firing, or that fired or
+// resolved at or after since.
+func (r *AlertRepository) FindChangedSince(ctx context.Context, since time.Time) ([]*entity.Alert, error) {
+	resolvedKey := r.client.key("alerts", "resolved")
+	replies, err := r.client.Pipeline(ctx, [][]string{
+		{"ZREMRANGEBYSCORE", resolvedKey, "-inf", "(" + r.pruneCutoff()},
+		{"SMEMBERS", r.client.key("alerts", "firing")},
+		{"ZRANGEBYSCORE", resolvedKey, strconv.FormatInt(since.Unix(), 10), "+inf"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reading alert indexes: %w", err)
+	}
+	for _, reply := range replies {
+		if e, ok := reply.(Error); ok {
+			return nil, fmt.Errorf("reading alert indexes: %w", e)
+		}
+	}
+
+	ids := append(asStrings(replies[1]), asStrings(replies[2])...)
+	return r.load(ctx, ids, func(a *entity.Alert) bool {
+		return a.IsFiring() || !a.FiredAt.Before(since) ||
+			(a.ResolvedAt != nil && !a.ResolvedAt.Before(since))
+	})
+}
+
+// FindActiveAt returns alerts that had fired by at and were not resolved
+// before it. Alerts resolved longer ago than the TTL are no longer available.
+func (r *AlertRepository) FindActiveAt(ctx context.Context, at time.Time) ([]*entity.Alert, error) {
+	replies, err := r.client.Pipeline(ctx, [][]string{
+		{"SMEMBERS", r.client.key("alerts", "firing")},
+		{"ZRANGEBYSCORE", r.client.key("alerts", "resolved"), strconv.FormatInt(at.Unix(), 10), "+inf"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reading alert indexes: %w", err)
+	}
+	for _, reply := range replies {
+		if e, ok := reply.(Error); ok {
+			return nil, fmt.Errorf("reading alert indexes: %w", e)
+		}
+	}
+
+	ids := append(asStrings(replies[0]), asStrings(replies[1])...)
+	return r.load(ctx, ids, func(a *entity.Alert) bool {
+		return !a.FiredAt.After(at) && (a.ResolvedAt == nil || a.ResolvedAt.After(at))
+	})
+}
+
+// Search returns the page of alerts matching query, newest fired first, and
+// the total number of matches. Alerts resolved longer ago than the TTL are
+// no longer available.
+func (r *AlertRepository) Search(ctx context.Context, query entity.AlertQuery) ([]*entity.Alert, int, error) {
+	cmds := [][]string{{"SMEMBERS", r.client.key("alerts", "firing")}}
+	if !query.OnlyFiring() {
+		cmds = append(cmds, []string{"ZRANGE", r.client.key("alerts", "resolved"), "0", "-1"})
+	}
+	replies, err := r.client.Pipeline(ctx, cmds)
+	if err != nil {
+		return nil, 0, fmt.Errorf("reading alert indexes: %w", err)
+	}
+
+	var ids []string
+	for _, reply := range replies {
+		if e, ok := reply.(Error); ok {
+			return nil, 0, fmt.Errorf("reading alert indexes: %w", e)
+		}
+		ids = append(ids, asStrings(reply)...)
+	}
+
+	matches, err := r.load(ctx, ids, query.Matches)
+	if err != nil {
+		return nil, 0, err
+	}
+	return query.Page(matches), len(matches), nil
+}
+
+// Delete soft-deletes an alert by ID.
+func (r *AlertRepository) Delete(ctx context.Context, id string) error {
+	alert, err := r.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if alert == nil {
+		return entity.ErrAlertNotFound
+	}
+	data, err := json.Marshal(alert)
+	if err != nil {
+		return fmt.Errorf("marshaling alert: %w", err)
+	}
+
+	cmds := [][]string{
+		{"SET", r.deletedKey(id), string(data), "EX", r.ttlSeconds()},
+		{"DEL", r.alertKey(id)},
+		{"SREM", r.client.key("alerts", "firing"), id},
+		{"ZREM", r.client.key("alerts", "resolved"), id},
+		{"ZREM", r.client.key("alerts", "fp", alert.Fingerprint), id},
+	}
+	for _, ref := range alert.ExternalReferences {
+		if ref.ReferenceID != "" {
+			cmds = append(cmds, []string{"DEL", r.refKey(ref.System, ref.ReferenceID)})
+		}
+	}
+
+	if _, err := r.client.Tx(ctx, cmds); err != nil {
+		return fmt.Errorf("deleting alert: %w", err)
+	}
+	return nil
+}
+
+// Restore brings back a soft-deleted alert.
+func (r *AlertRepository) Restore(ctx context.Context, id string) error {
+	reply, err := r.client.Do(ctx, "GET", r.deletedKey(id))
+	if err != nil {
+		return fmt.Errorf("getting deleted alert: %w", err)
+	}
+	alert, err := decodeAlert(reply)
+	if err != nil {
+		return err
+	}
+	if alert == nil {
+		return entity.ErrAlertNotFound
+	}
+
+	if err := r.Save(ctx, alert); err != nil {
+		return fmt.Errorf("restoring alert: %w", err)
+	}
+	if _, err := r.client.Do(ctx, "DEL", r.deletedKey(id)); err != nil {
+		return fmt.Errorf("restoring alert: %w", err)
+	}
+	return nil
+}
+
+// FindResolvedBefore returns up to limit alerts resolved before the given
+// time, oldest resolved first. Soft-deleted alerts expire on their own and
+// are left out.
+func (r *AlertRepository) FindResolvedBefore(ctx context.Context, before time.Time, limit int) ([]*entity.Alert, error) {
+	reply, err := r.client.Do(ctx, "ZRANGEBYSCORE", r.client.key("alerts", "resolved"),
+		"-inf", "("+strconv.FormatInt(before.Unix(), 10), "LIMIT", "0", strconv.Itoa(limit))
+	if err != nil {
+		return nil, fmt.Errorf("reading resolved index: %w", err)
+	}
+
+	// Alerts that fired again since are kept
+	return r.load(ctx, asStrings(reply), func(a *entity.Alert) bool {
+		return a.ResolvedAt != nil && a.ResolvedAt.Before(before)
+	})
+}
+
+// DeleteResolvedBefore permanently removes alerts resolved before the given
+// time, with their timelines. Soft-deleted alerts expire on their own.
+func (r *AlertRepository) DeleteResolvedBefore(ctx context.Context, before time.Time) (int, error) {
+	reply, err := r.client.Do(ctx, "ZRANGEBYSCORE", r.client.key("alerts", "resolved"), "-inf", "("+strconv.FormatInt(before.Unix(), 10))
+	if err != nil {
+		return 0, fmt.Errorf("reading resolved index: %w", err)
+	}
+	return r.deleteResolved(ctx, asStrings(reply), before)
+}
+
+// DeleteResolvedByIDs permanently removes the alerts with the given IDs
+// that are still resolved before the given time, with their timelines.
+func (r *AlertRepository) DeleteResolvedByIDs(ctx context.Context, ids []string, before time.Time) (int, error) {
+	return r.deleteResolved(ctx, ids, before)
+}
+
+// deleteResolved removes those of the given alerts still resolved before
+// the given time. Alerts that fired again since are kept.
+func (r *AlertRepository) deleteResolved(ctx context.Context, ids []string, before time.Time) (int, error) {
+	alerts, err := r.load(ctx, ids, func(a *entity.Alert) bool {
+		return a.ResolvedAt != nil && a.ResolvedAt.Before(before)
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(alerts) == 0 {
+		return 0, nil
+	}
+
+	resolvedKey := r.client.key("alerts", "resolved")
+
+	var cmds [][]string
+	for _, alert := range alerts {
+		cmds = append(cmds,
+			[]string{"DEL", r.alertKey(alert.ID)},
+			[]string{"DEL", r.client.key("events", "alert", alert.ID)},
+			[]string{"ZREM", resolvedKey, alert.ID},
+			[]string{"ZREM", r.client.key("alerts", "fp", alert.Fingerprint), alert.ID},
+		)
+		for _, ref := range alert.ExternalReferences {
+			if ref.ReferenceID != "" {
+				cmds = append(cmds, []string{"DEL", r.refKey(ref.System, ref.ReferenceID)})
+			}
+		}
+	}
+	if _, err := r.client.Tx(ctx, cmds); err != nil {
+		return 0, fmt.Errorf("deleting resolved alerts: %w", err)
+	}
+	return len(alerts), nil
+}
+
+// indexCommands returns the commands that bring the indexes in line with
+// alert. existing is the previously stored version, or nil for a new alert.
+func (r *AlertRepository) indexCommands(alert, existing *entity.Alert) [][]string {
+	firingKey := r.client.key("alerts", "firing")
+	resolvedKey := r.client.key("alerts", "resolved")
+
+	var cmds [][]string
+	score := "0"
+	if alert.IsResolved() {
+		resolvedAt := time.Now()
+		if alert.ResolvedAt != nil {
+			resolvedAt = *alert.ResolvedAt
+		}
+		score = strconv.FormatInt(resolvedAt.Unix(), 10)
+		cmds = append(cmds,
+			[]string{"SREM", firingKey, alert.ID},
+			[]string{"ZADD", resolvedKey, score, alert.ID},
+		)
+	} else {
+		cmds = append(cmds,
+			[]string{"ZREM", resolvedKey, alert.ID},
+			[]string{"SADD", firingKey, alert.ID},
+		)
+	}
+
+	cmds = append(cmds, []string{
+		"EVAL", indexFingerprintScript, "1", r.client.key("alerts", "fp", alert.Fingerprint),
+		score, alert.ID, r.pruneCutoff(), r.ttlSeconds(),
+	})
+
+	// Drop references that changed, then (re)write the current ones so their
+	// TTL follows the alert
+	if existing != nil {
+		for _, old := range existing.ExternalReferences {
+			if old.ReferenceID != "" && !alert.ExternalReferences.Contains(old.System, old.ReferenceID) {
+				cmds = append(cmds, []string{"DEL", r.refKey(old.System, old.ReferenceID)})
+			}
+		}
+	}
+	for _, ref := range alert.ExternalReferences {
+		if ref.ReferenceID == "" {
+			continue
+		}
+		set := []string{"SET", r.refKey(ref.System, ref.ReferenceID), alert.ID}
+		if alert.IsResolved() {
+			set = append(set, "EX", r.ttlSeconds())
+		}
+		cmds = append(cmds, set)
+	}
+
+	return cmds
+}
+
+// findFiring loads alerts from the firing index that satisfy keep.
+func (r *AlertRepository) findFiring(ctx context.Context, keep func(*entity.Alert) bool) ([]*entity.Alert, error) {
+	reply, err := r.client.Do(ctx, "SMEMBERS", r.client.key("alerts", "firing"))
+	if err != nil {
+		return nil, fmt.Errorf("reading firing index: %w", err)
+	}
+	return r.load(ctx, asStrings(reply), keep)
+}
+
+// load fetches alerts by ID, skipping expired ones and any rejected by keep.
+func (r *AlertRepository) load(ctx context.Context, ids []string, keep func(*entity.Alert) bool) ([]*entity.Alert, error) {
+	alerts := make([]*entity.Alert, 0, len(ids))
+	if len(ids) == 0 {
+		return alerts, nil
+	}
+
+	args := make([]string, 0, len(ids)+1)
+	args = append(args, "MGET")
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			args = append(args, r.alertKey(id))
+		}
+	}
+
+	reply, err := r.client.Do(ctx, args...)
+	if err != nil {
+		return nil, fmt.Errorf("loading alerts: %w", err)
+	}
+	items, _ := reply.([]any)
+	for _, item := range items {
+		alert, err := decodeAlert(item)
+		if err != nil {
+			return nil, err
+		}
+		if alert != nil && (keep == nil || keep(alert)) {
+			alerts = append(alerts, alert)
+		}
+	}
+	return alerts, nil
+}
+
+func (r *AlertRepository) alertKey(id string) string {
+	return r.client.key("alert", id)
+}
+
+func (r *AlertRepository) deletedKey(id string) string {
+	return r.client.key("deleted-alert", id)
+}
+
+func (r *AlertRepository) refKey(system, referenceID string) string {
+	return r.client.key("alerts", "ref", system, referenceID)
+}
+
+func (r *AlertRepository) ttlSeconds() string {
+	return strconv.FormatInt(int64(r.ttl/time.Second), 10)
+}
+
+// pruneCutoff is the resolve time before which index entries have expired.
+func (r *AlertRepository) pruneCutoff() string {
+	return strconv.FormatInt(time.Now().Add(-r.ttl).Unix(), 10)
+}
+
+// decodeAlert decodes a GET/MGET reply, returning nil for a missing key.
+func decodeAlert(reply any) (*entity.Alert, error) {
+	data, ok := reply.(string)
+	if !ok {
+		return nil, nil
+	}
+	var alert entity.Alert
+	if err := json.Unmarshal([]byte(data), &alert); err != nil {
+		return nil, fmt.Errorf("decoding alert: %w", err)
+	}
+	return &alert, nil
+}
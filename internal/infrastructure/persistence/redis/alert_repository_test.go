@@ -0,0 +1,121 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/config"
+)
+
+// setupTestRepos connects to a local Redis, skipping when none is running.
+// Each test gets its own key prefix so runs do not interfere.
+func setupTestRepos(t *testing.T) *Repositories {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	cfg := &config.RedisConfig{
+		Addr:        "localhost:6379",
+		KeyPrefix:   fmt.Sprintf("alert-bridge-test:%d:", time.Now().UnixNano()),
+		ResolvedTTL: time.Hour,
+		PoolSize:    4,
+		DialTimeout: time.Second,
+	}
+	repos, client, err := NewRepositories(cfg)
+	if err != nil {
+		t.Skipf("Skipping test: Redis not available: %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+
+	return repos
+}
+
+func TestAlertRepository_SaveAndFind(t *testing.T) {
+	repos := setupTestRepos(t)
+	ctx := context.Background()
+
+	alert := entity.NewAlert("fp-1", "HighCPU", "server-1", "web", "CPU high", entity.SeverityCritical)
+	alert.Labels = map[string]string{"env": "prod"}
+	alert.SetExternalReference("slack", "C1:123.456")
+	require.NoError(t, repos.Alert.Save(ctx, alert))
+
+	assert.ErrorIs(t, repos.Alert.Save(ctx, alert), entity.ErrDuplicateAlert)
+
+	found, err := repos.Alert.FindByID(ctx, alert.ID)
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.Equal(t, "HighCPU", found.Name)
+	assert.Equal(t, "prod", found.Labels["env"])
+
+	byFP, err := repos.Alert.FindByFingerprint(ctx, "fp-1")
+	require.NoError(t, err)
+	require.Len(t, byFP, 1)
+
+	byRef, err := repos.Alert.FindByExternalReference(ctx, "slack", "C1:123.456")
+	require.NoError(t, err)
+	require.NotNil(t, byRef)
+	assert.Equal(t, alert.ID, byRef.ID)
+
+	active, err := repos.Alert.GetActiveAlerts(ctx, "critical")
+	require.NoError(t, err)
+	assert.Len(t, active, 1)
+	active, err = repos.Alert.GetActiveAlerts(ctx, "warning")
+	require.NoError(t, err)
+	assert.Empty(t, active)
+
+	missing, err := repos.Alert.FindByID(ctx, "nope")
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+}
+
+func TestAlertRepository_UpdateResolveAndDelete(t *testing.T) {
+	repos := setupTestRepos(t)
+	ctx := context.Background()
+	start := time.Now().Add(-time.Minute)
+
+	alert := entity.NewAlert("fp-2", "DiskFull", "db-1", "disk", "Disk full", entity.SeverityWarning)
+	alert.SetExternalReference("pagerduty", "old-key")
+	require.NoError(t, repos.Alert.Save(ctx, alert))
+
+	alert.SetExternalReference("pagerduty", "new-key")
+	alert.Resolve(time.Now())
+	require.NoError(t, repos.Alert.Update(ctx, alert))
+
+	old, err := repos.Alert.FindByExternalReference(ctx, "pagerduty", "old-key")
+	require.NoError(t, err)
+	assert.Nil(t, old)
+	current, err := repos.Alert.FindByExternalReference(ctx, "pagerduty", "new-key")
+	require.NoError(t, err)
+	require.NotNil(t, current)
+	assert.True(t, current.IsResolved())
+
+	firing, err := repos.Alert.FindFiring(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, firing)
+
+	changed, err := repos.Alert.FindChangedSince(ctx, start)
+	require.NoError(t, err)
+	require.Len(t, changed, 1)
+	assert.Equal(t, alert.ID, changed[0].ID)
+
+	require.NoError(t, repos.Alert.Delete(ctx, alert.ID))
+	assert.ErrorIs(t, repos.Alert.Delete(ctx, alert.ID), entity.ErrAlertNotFound)
+	assert.ErrorIs(t, repos.Alert.Update(ctx, alert), entity.ErrAlertNotFound)
+
+	byFP, err := repos.Alert.FindByFingerprint(ctx, "fp-2")
+	require.NoError(t, err)
+	assert.Empty(t, byFP)
+
+	require.NoError(t, repos.Alert.Restore(ctx, alert.ID))
+	assert.ErrorIs(t, repos.Alert.Restore(ctx, alert.ID), entity.ErrAlertNotFound)
+	restored, err := repos.Alert.FindByExternalReference(ctx, "pagerduty", "new-key")
+	require.NoError(t, err)
+	require.NotNil(t, restored)
+	assert.Equal(t, alert.ID, restored.ID)
+}
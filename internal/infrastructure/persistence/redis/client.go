@@ -0,0 +1,459 @@
+// Package redis implements the repositories on top of Redis, for deployments
+// that want shared state across replicas without running a SQL database.
+//
+// It speaks RESP2 directly over a small connection pool rather than pulling
+// in a client library; only the handful of commands the repositories need
+// are used.
+package redis
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/config"
+)
+
+// Error is an error reply from the Redis server.
+type Error string
+
+func (e Error) Error() string { return string(e) }
+
+// Client is a pooled Redis connection.
+type Client struct {
+	addr        string
+	username    string
+	password    string
+	db          int
+	dialTimeout time.Duration
+	prefix      string
+	tls         *tls.Config
+
+	idle   chan *conn
+	sem    chan struct{}
+	closed atomic.Bool
+}
+
+// conn is a single buffered connection.
+type conn struct {
+	nc net.Conn
+	rd *bufio.Reader
+	wr *bufio.Writer
+}
+
+// NewClient creates a client and verifies the server is reachable.
+func NewClient(cfg *config.RedisConfig) (*Client, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("redis config is required")
+	}
+
+	poolSize := cfg.PoolSize
+	if poolSize < 1 {
+		poolSize = 1
+	}
+	tlsConfig, err := newTLSConfig(cfg.TLS)
+	if err != nil {
+		return nil, err
+	}
+	c := &Client{
+		addr:        cfg.Addr,
+		username:    cfg.Username,
+		password:    cfg.Password,
+		db:          cfg.DB,
+		dialTimeout: cfg.DialTimeout,
+		prefix:      cfg.KeyPrefix,
+		tls:         tlsConfig,
+		idle:        make(chan *conn, poolSize),
+		sem:         make(chan struct{}, poolSize),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.dialTimeout)
+	defer cancel()
+	if err := c.Ping(ctx); err != nil {
+		return nil, fmt.Errorf("connecting to redis at %s: %w", c.addr, err)
+	}
+
+	return c, nil
+}
+
+// newTLSConfig returns the TLS configuration for connections, or nil when
+// TLS is disabled.
+func newTLSConfig(cfg config.RedisTLSConfig) (*tls.Config, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	tc := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         cfg.ServerName,
+		InsecureSkipVerify: cfg.InsecureSkipVerify,
+	}
+	if cfg.CAFile != "" {
+		pem, err := os.ReadFile(cfg.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading redis CA file: %w", err)
+		}
+		tc.RootCAs = x509.NewCertPool()
+		if !tc.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("redis CA file %s has no certificates", cfg.CAFile)
+		}
+	}
+	return tc, nil
+}
+
+// Ping verifies the server is reachable.
+func (c *Client) Ping(ctx context.Context) error {
+	_, err := c.Do(ctx, "PING")
+	return err
+}
+
+// Close closes idle connections. Connections in use are closed when returned.
+func (c *Client) Close() error {
+	c.closed.Store(true)
+	for {
+		select {
+		case cn := <-c.idle:
+			cn.nc.Close()
+		default:
+			return nil
+		}
+	}
+}
+
+// key builds a namespaced key.
+func (c *Client) key(parts ...string) string {
+	k := c.prefix
+	for i, p := range parts {
+		if i > 0 {
+			k += ":"
+		}
+		k += p
+	}
+	return k
+}
+
+// Do runs a single command. Error replies are returned as Error.
+func (c *Client) Do(ctx context.Context, args ...string) (any, error) {
+	replies, err := c.Pipeline(ctx, [][]string{args})
+	if err != nil {
+		return nil, err
+	}
+	if e, ok := replies[0].(Error); ok {
+		return nil, e
+	}
+	return replies[0], nil
+}
+
+// Pipeline sends several commands in one round trip. Error replies are
+// returned in place rather than as an error.
+func (c *Client) Pipeline(ctx context.Context, cmds [][]string) ([]any, error) {
+	cn, err := c.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	replies, err := cn.roundTrip(ctx, cmds)
+	c.put(cn, err)
+	return replies, err
+}
+
+// Tx runs commands atomically in MULTI/EXEC and returns their replies.
+// A command rejected at queue time aborts the whole transaction.
+func (c *Client) Tx(ctx context.Context, cmds [][]string) ([]any, error) {
+	replies, err := c.Pipeline(ctx, multi(cmds))
+	if err != nil {
+		return nil, err
+	}
+	return execResults(cmds, replies)
+}
+
+// TxIfAbsent runs commands atomically in MULTI/EXEC, but only while key
+// does not exist. It reports false, without running them, if key exists
+// or is created before they run.
+func (c *Client) TxIfAbsent(ctx context.Context, key string, cmds [][]string) ([]any, bool, error) {
+	cn, err := c.get(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	replies, err := cn.roundTrip(ctx, [][]string{{"WATCH", key}, {"EXISTS", key}})
+	if err == nil {
+		err = firstError(replies)
+	}
+	if err == nil && replies[1] != int64(0) {
+		_, err = cn.roundTrip(ctx, [][]string{{"UNWATCH"}})
+		c.put(cn, err)
+		return nil, false, err
+	}
+	if err != nil {
+		// Close it rather than return it to the pool still watching key
+		c.put(cn, err)
+		return nil, false, err
+	}
+
+	replies, err = cn.roundTrip(ctx, multi(cmds))
+	c.put(cn, err)
+	if err != nil {
+		return nil, false, err
+	}
+	if replies[len(replies)-1] == nil {
+		// EXEC aborted: key was written after WATCH
+		return nil, false, nil
+	}
+	results, err := execResults(cmds, replies)
+	return results, err == nil, err
+}
+
+// multi wraps commands in MULTI and EXEC.
+func multi(cmds [][]string) [][]string {
+	wrapped := make([][]string, 0, len(cmds)+2)
+	wrapped = append(wrapped, []string{"MULTI"})
+	wrapped = append(wrapped, cmds...)
+	return append(wrapped, []string{"EXEC"})
+}
+
+// execResults returns the replies to commands run by multi, or the first
+// error among them.
+func execResults(cmds [][]string, replies []any) ([]any, error) {
+	exec := replies[len(replies)-1]
+	if e, ok := exec.(Error); ok {
+		// EXECABORT: report the command that failed to queue
+		for i, r := range replies[1 : len(replies)-1] {
+			if qe, ok := r.(Error); ok {
+				return nil, fmt.Errorf("%s: %w", cmds[i][0], qe)
+			}
+		}
+		return nil, e
+	}
+	results, _ := exec.([]any)
+	for i, r := range results {
+		if e, ok := r.(Error); ok {
+			return nil, fmt.Errorf("%s: %w", cmds[i][0], e)
+		}
+	}
+	return results, nil
+}
+
+// scan returns the keys matching pattern, which must include the key
+// prefix. A key may be returned more than once.
+func (c *Client) scan(ctx context.Context, pattern string) ([]string, error) {
+	var keys []string
+	cursor := "0"
+	for {
+		reply, err := c.Do(ctx, "SCAN", cursor, "MATCH", pattern, "COUNT", "1000")
+		if err != nil {
+			return nil, err
+		}
+		items, _ := reply.([]any)
+		if len(items) != 2 {
+			return nil, fmt.Errorf("redis: malformed SCAN reply")
+		}
+		cursor, _ = items[0].(string)
+		keys = append(keys, asStrings(items[1])...)
+		if cursor == "0" || cursor == "" {
+			return keys, nil
+		}
+	}
+}
+
+// get returns an idle connection or dials a new one, waiting while the pool
+// is exhausted.
+func (c *Client) get(ctx context.Context) (*conn, error) {
+	select {
+	case c.sem <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	select {
+	case cn := <-c.idle:
+		return cn, nil
+	default:
+	}
+
+	cn, err := c.dial(ctx)
+	if err != nil {
+		<-c.sem
+		return nil, err
+	}
+	return cn, nil
+}
+
+// put returns a connection to the pool, discarding it after I/O errors
+// since its read position is unknown.
+func (c *Client) put(cn *conn, err error) {
+	defer func() { <-c.sem }()
+
+	if err != nil || c.closed.Load() {
+		cn.nc.Close()
+		return
+	}
+	select {
+	case c.idle <- cn:
+	default:
+		cn.nc.Close()
+	}
+}
+
+// dial opens and initializes a connection.
+func (c *Client) dial(ctx context.Context) (*conn, error) {
+	var nc net.Conn
+	var err error
+	if c.tls != nil {
+		d := tls.Dialer{NetDialer: &net.Dialer{Timeout: c.dialTimeout}, Config: c.tls}
+		nc, err = d.DialContext(ctx, "tcp", c.addr)
+	} else {
+		d := net.Dialer{Timeout: c.dialTimeout}
+		nc, err = d.DialContext(ctx, "tcp", c.addr)
+	}
+	if err != nil {
+		return nil, err
+	}
+	cn := &conn{nc: nc, rd: bufio.NewReader(nc), wr: bufio.NewWriter(nc)}
+
+	var setup [][]string
+	switch {
+	case c.username != "":
+		setup = append(setup, []string{"AUTH", c.username, c.password})
+	case c.password != "":
+		setup = append(setup, []string{"AUTH", c.password})
+	}
+	if c.db != 0 {
+		setup = append(setup, []string{"SELECT", strconv.Itoa(c.db)})
+	}
+	if len(setup) > 0 {
+		replies, err := cn.roundTrip(ctx, setup)
+		if err == nil {
+			err = firstError(replies)
+		}
+		if err != nil {
+			nc.Close()
+			return nil, err
+		}
+	}
+
+	return cn, nil
+}
+
+// roundTrip writes cmds and reads one reply per command.
+func (cn *conn) roundTrip(ctx context.Context, cmds [][]string) ([]any, error) {
+	deadline, _ := ctx.Deadline()
+	if err := cn.nc.SetDeadline(deadline); err != nil {
+		return nil, err
+	}
+
+	for _, args := range cmds {
+		if err := writeCommand(cn.wr, args); err != nil {
+			return nil, err
+		}
+	}
+	if err := cn.wr.Flush(); err != nil {
+		return nil, err
+	}
+
+	replies := make([]any, len(cmds))
+	for i := range cmds {
+		r, err := readReply(cn.rd)
+		if err != nil {
+			return nil, err
+		}
+		replies[i] = r
+	}
+	return replies, nil
+}
+
+// writeCommand encodes a command as a RESP array of bulk strings.
+func writeCommand(w *bufio.Writer, args []string) error {
+	w.WriteString("*")
+	w.WriteString(strconv.Itoa(len(args)))
+	w.WriteString("\r\n")
+	for _, a := range args {
+		w.WriteString("$")
+		w.WriteString(strconv.Itoa(len(a)))
+		w.WriteString("\r\n")
+		w.WriteString(a)
+		if _, err := w.WriteString("\r\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readReply decodes one RESP2 reply. Bulk strings are returned as string,
+// integers as int64, arrays as []any and nil replies as nil.
+func readReply(r *bufio.Reader) (any, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	if len(line) < 3 || line[len(line)-2] != '\r' {
+		return nil, fmt.Errorf("redis: malformed reply %q", line)
+	}
+	body := line[1 : len(line)-2]
+
+	switch line[0] {
+	case '+':
+		return body, nil
+	case '-':
+		return Error(body), nil
+	case ':':
+		return strconv.ParseInt(body, 10, 64)
+	case '$':
+		n, err := strconv.Atoi(body)
+		if err != nil {
+			return nil, fmt.Errorf("redis: bad bulk length %q", body)
+		}
+		if n < 0 {
+			return nil, nil
+		}
+		buf := make([]byte, n+2)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, err
+		}
+		return string(buf[:n]), nil
+	case '*':
+		n, err := strconv.Atoi(body)
+		if err != nil {
+			return nil, fmt.Errorf("redis: bad array length %q", body)
+		}
+		if n < 0 {
+			return nil, nil
+		}
+		items := make([]any, n)
+		for i := range items {
+			if items[i], err = readReply(r); err != nil {
+				return nil, err
+			}
+		}
+		return items, nil
+	default:
+		return nil, fmt.Errorf("redis: unknown reply type %q", line[0])
+	}
+}
+
+// firstError returns the first error reply, if any.
+func firstError(replies []any) error {
+	for _, r := range replies {
+		if e, ok := r.(Error); ok {
+			return e
+		}
+	}
+	return nil
+}
+
+// asStrings converts an array reply to strings, mapping nil entries to "".
+func asStrings(v any) []string {
+	items, _ := v.([]any)
+	out := make([]string, len(items))
+	for i, item := range items {
+		out[i], _ = item.(string)
+	}
+	return out
+}
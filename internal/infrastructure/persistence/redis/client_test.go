@@ -0,0 +1,253 @@
+package redis
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/pem"
+	"net"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/config"
+)
+
+func TestWriteCommand(t *testing.T) {
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+	require.NoError(t, writeCommand(w, []string{"SET", "k", "a b"}))
+	require.NoError(t, w.Flush())
+	assert.Equal(t, "*3\r\n$3\r\nSET\r\n$1\r\nk\r\n$3\r\na b\r\n", buf.String())
+}
+
+func TestReadReply(t *testing.T) {
+	tests := []struct {
+		name string
+		wire string
+		want any
+	}{
+		{"simple string", "+OK\r\n", "OK"},
+		{"error", "-ERR wrong type\r\n", Error("ERR wrong type")},
+		{"integer", ":42\r\n", int64(42)},
+		{"bulk string", "$5\r\nhe\r\nl\r\n", "he\r\nl"},
+		{"empty bulk", "$0\r\n\r\n", ""},
+		{"nil bulk", "$-1\r\n", nil},
+		{"nil array", "*-1\r\n", nil},
+		{"nested array", "*3\r\n$1\r\na\r\n:1\r\n*1\r\n$-1\r\n", []any{"a", int64(1), []any{nil}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := readReply(bufio.NewReader(strings.NewReader(tt.wire)))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestReadReply_Malformed(t *testing.T) {
+	for _, wire := range []string{"OK\r\n", "+OK\n", "$abc\r\n", "$5\r\nab"} {
+		_, err := readReply(bufio.NewReader(strings.NewReader(wire)))
+		assert.Error(t, err, wire)
+	}
+}
+
+// fakeServer is a minimal Redis server for the commands the client sends
+// on its own: AUTH, PING, WATCH, EXISTS, UNWATCH, MULTI, SET and EXEC.
+type fakeServer struct {
+	addr string
+
+	mu       sync.Mutex
+	auth     [][]string
+	data     map[string]string
+	versions map[string]int
+
+	// beforeExec runs before EXEC, to race a transaction
+	beforeExec func(s *fakeServer)
+}
+
+func newFakeServer(t *testing.T, tlsConfig *tls.Config) *fakeServer {
+	t.Helper()
+	var ln net.Listener
+	var err error
+	if tlsConfig != nil {
+		ln, err = tls.Listen("tcp", "127.0.0.1:0", tlsConfig)
+	} else {
+		ln, err = net.Listen("tcp", "127.0.0.1:0")
+	}
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+
+	s := &fakeServer{addr: ln.Addr().String(), data: map[string]string{}, versions: map[string]int{}}
+	go func() {
+		for {
+			nc, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(nc)
+		}
+	}()
+	return s
+}
+
+func (s *fakeServer) set(key, value string) {
+	s.data[key] = value
+	s.versions[key]++
+}
+
+func (s *fakeServer) serve(nc net.Conn) {
+	defer nc.Close()
+	rd := bufio.NewReader(nc)
+	var watched map[string]int
+	var queued [][]string
+	inMulti := false
+
+	for {
+		req, err := readReply(rd)
+		if err != nil {
+			return
+		}
+		args := asStrings(req)
+
+		s.mu.Lock()
+		var reply string
+		switch {
+		case inMulti && args[0] != "EXEC":
+			queued = append(queued, args)
+			reply = "+QUEUED\r\n"
+		case args[0] == "AUTH":
+			s.auth = append(s.auth, args)
+			reply = "+OK\r\n"
+			if args[len(args)-1] != "secret" {
+				reply = "-WRONGPASS invalid username-password pair\r\n"
+			}
+		case args[0] == "PING":
+			reply = "+PONG\r\n"
+		case args[0] == "WATCH":
+			watched = map[string]int{args[1]: s.versions[args[1]]}
+			reply = "+OK\r\n"
+		case args[0] == "UNWATCH":
+			watched = nil
+			reply = "+OK\r\n"
+		case args[0] == "EXISTS":
+			_, ok := s.data[args[1]]
+			reply = ":0\r\n"
+			if ok {
+				reply = ":1\r\n"
+			}
+		case args[0] == "MULTI":
+			inMulti = true
+			reply = "+OK\r\n"
+		case args[0] == "EXEC":
+			if s.beforeExec != nil {
+				s.beforeExec(s)
+			}
+			aborted := false
+			for key, version := range watched {
+				aborted = aborted || s.versions[key] != version
+			}
+			reply = "*-1\r\n"
+			if !aborted {
+				reply = "*" + strconv.Itoa(len(queued)) + "\r\n"
+				for _, cmd := range queued {
+					s.set(cmd[1], cmd[2])
+					reply += "+OK\r\n"
+				}
+			}
+			inMulti, queued, watched = false, nil, nil
+		default:
+			reply = "-ERR unknown command '" + args[0] + "'\r\n"
+		}
+		s.mu.Unlock()
+
+		if _, err := nc.Write([]byte(reply)); err != nil {
+			return
+		}
+	}
+}
+
+func TestClient_Auth(t *testing.T) {
+	server := newFakeServer(t, nil)
+
+	cfg := &config.RedisConfig{Addr: server.addr, Username: "bridge", Password: "secret", PoolSize: 1, DialTimeout: time.Second}
+	client, err := NewClient(cfg)
+	require.NoError(t, err)
+	client.Close()
+
+	cfg.Username = ""
+	client, err = NewClient(cfg)
+	require.NoError(t, err)
+	client.Close()
+
+	cfg.Password = "wrong"
+	_, err = NewClient(cfg)
+	assert.ErrorContains(t, err, "WRONGPASS")
+
+	assert.Equal(t, [][]string{
+		{"AUTH", "bridge", "secret"},
+		{"AUTH", "secret"},
+		{"AUTH", "wrong"},
+	}, server.auth)
+}
+
+func TestClient_TLS(t *testing.T) {
+	// Borrow httptest's certificate, which is valid for 127.0.0.1
+	https := httptest.NewTLSServer(nil)
+	cert := https.TLS.Certificates[0]
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: https.Certificate().Raw}), 0o600))
+	https.Close()
+
+	server := newFakeServer(t, &tls.Config{Certificates: []tls.Certificate{cert}})
+
+	cfg := &config.RedisConfig{Addr: server.addr, PoolSize: 1, DialTimeout: time.Second}
+	_, err := NewClient(cfg)
+	assert.Error(t, err, "plain connection to a TLS server")
+
+	cfg.TLS = config.RedisTLSConfig{Enabled: true}
+	_, err = NewClient(cfg)
+	assert.Error(t, err, "certificate verified without the CA")
+
+	cfg.TLS.CAFile = caFile
+	client, err := NewClient(cfg)
+	require.NoError(t, err)
+	client.Close()
+}
+
+func TestClient_TxIfAbsent(t *testing.T) {
+	ctx := context.Background()
+	server := newFakeServer(t, nil)
+	client, err := NewClient(&config.RedisConfig{Addr: server.addr, PoolSize: 1, DialTimeout: time.Second})
+	require.NoError(t, err)
+	t.Cleanup(func() { client.Close() })
+
+	cmds := [][]string{{"SET", "alert:1", "a"}, {"SET", "alerts:fp", "1"}}
+	_, ok, err := client.TxIfAbsent(ctx, "alert:1", cmds)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, map[string]string{"alert:1": "a", "alerts:fp": "1"}, server.data)
+
+	_, ok, err = client.TxIfAbsent(ctx, "alert:1", [][]string{{"SET", "alert:1", "b"}})
+	require.NoError(t, err)
+	assert.False(t, ok, "existing key")
+	assert.Equal(t, "a", server.data["alert:1"])
+
+	// Another client writes the key between the check and EXEC
+	server.beforeExec = func(s *fakeServer) { s.set("alert:2", "other") }
+	_, ok, err = client.TxIfAbsent(ctx, "alert:2", [][]string{{"SET", "alert:2", "c"}, {"SET", "alerts:fp", "2"}})
+	require.NoError(t, err)
+	assert.False(t, ok, "key written concurrently")
+	assert.Equal(t, "other", server.data["alert:2"])
+	assert.Equal(t, "1", server.data["alerts:fp"])
+}
@@ -0,0 +1,37 @@
+package redis
+
+import (
+	"fmt"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/config"
+)
+
+// Repositories holds all Redis repository implementations.
+type Repositories struct {
+	Alert      *AlertRepository
+	AckEvent   *AckEventRepository
+	AlertEvent *AlertEventRepository
+	Silence    *SilenceRepository
+	SavedView  *SavedViewRepository
+	Retry      *NotificationRetryRepository
+}
+
+// NewRepositories connects to Redis and creates all repositories on a shared
+// connection pool. The returned client should be closed on shutdown.
+func NewRepositories(cfg *config.RedisConfig) (*Repositories, *Client, error) {
+	client, err := NewClient(cfg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("creating redis client: %w", err)
+	}
+
+	repos := &Repositories{
+		Alert:      NewAlertRepository(client, cfg.ResolvedTTL),
+		AckEvent:   NewAckEventRepository(client),
+		AlertEvent: NewAlertEventRepository(client, cfg.ResolvedTTL),
+		Silence:    NewSilenceRepository(client, cfg.ResolvedTTL),
+		SavedView:  NewSavedViewRepository(client),
+		Retry:      NewNotificationRetryRepository(client),
+	}
+
+	return repos, client, nil
+}
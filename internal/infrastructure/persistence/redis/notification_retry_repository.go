@@ -0,0 +1,147 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// NotificationRetryRepository implements repository.NotificationRetryRepository
+// using Redis.
+//
+// Retries are stored as JSON in the retries hash. Pending retries are also
+// indexed in the retries:due sorted set, scored by their next attempt in
+// Unix milliseconds; dead-lettered retries are only in the hash.
+type NotificationRetryRepository struct {
+	client *Client
+}
+
+// NewNotificationRetryRepository creates a new Redis notification retry repository.
+func NewNotificationRetryRepository(client *Client) *NotificationRetryRepository {
+	return &NotificationRetryRepository{client: client}
+}
+
+// Save creates or replaces a retry.
+func (r *NotificationRetryRepository) Save(ctx context.Context, retry *entity.NotificationRetry) error {
+	data, err := json.Marshal(retry)
+	if err != nil {
+		return fmt.Errorf("marshaling notification retry: %w", err)
+	}
+
+	index := []string{"ZREM", r.dueKey(), retry.ID}
+	if !retry.IsDead() {
+		index = []string{"ZADD", r.dueKey(), strconv.FormatInt(retry.NextAttemptAt.UnixMilli(), 10), retry.ID}
+	}
+	if _, err := r.client.Tx(ctx, [][]string{
+		{"HSET", r.key(), retry.ID, string(data)},
+		index,
+	}); err != nil {
+		return fmt.Errorf("saving notification retry: %w", err)
+	}
+	return nil
+}
+
+// FindDue returns up to limit pending retries due at now, earliest first.
+func (r *NotificationRetryRepository) FindDue(ctx context.Context, now time.Time, limit int) ([]*entity.NotificationRetry, error) {
+	reply, err := r.client.Do(ctx, "ZRANGEBYSCORE", r.dueKey(),
+		"-inf", strconv.FormatInt(now.UnixMilli(), 10), "LIMIT", "0", strconv.Itoa(limit))
+	if err != nil {
+		return nil, fmt.Errorf("reading due retries: %w", err)
+	}
+	ids := asStrings(reply)
+	retries := make([]*entity.NotificationRetry, 0, len(ids))
+	if len(ids) == 0 {
+		return retries, nil
+	}
+
+	reply, err = r.client.Do(ctx, append([]string{"HMGET", r.key()}, ids...)...)
+	if err != nil {
+		return nil, fmt.Errorf("getting notification retries: %w", err)
+	}
+	for _, data := range asStrings(reply) {
+		// Deleted between the two reads
+		if data == "" {
+			continue
+		}
+		retry, err := decodeNotificationRetry(data)
+		if err != nil {
+			return nil, err
+		}
+		retries = append(retries, retry)
+	}
+	return retries, nil
+}
+
+// FindByID retrieves a retry by ID. Returns nil, nil if not found.
+func (r *NotificationRetryRepository) FindByID(ctx context.Context, id string) (*entity.NotificationRetry, error) {
+	reply, err := r.client.Do(ctx, "HGET", r.key(), id)
+	if err != nil {
+		return nil, fmt.Errorf("getting notification retry: %w", err)
+	}
+	data, _ := reply.(string)
+	if data == "" {
+		return nil, nil
+	}
+	return decodeNotificationRetry(data)
+}
+
+// FindDead returns the dead-lettered retries, most recently failed first.
+// Dead letters are not indexed, so this scans the whole hash; the queue is
+// expected to stay small.
+func (r *NotificationRetryRepository) FindDead(ctx context.Context) ([]*entity.NotificationRetry, error) {
+	reply, err := r.client.Do(ctx, "HVALS", r.key())
+	if err != nil {
+		return nil, fmt.Errorf("listing notification retries: %w", err)
+	}
+
+	dead := make([]*entity.NotificationRetry, 0)
+	for _, data := range asStrings(reply) {
+		retry, err := decodeNotificationRetry(data)
+		if err != nil {
+			return nil, err
+		}
+		if retry.IsDead() {
+			dead = append(dead, retry)
+		}
+	}
+	sort.Slice(dead, func(i, j int) bool {
+		return dead[i].UpdatedAt.After(dead[j].UpdatedAt)
+	})
+	return dead, nil
+}
+
+// Delete removes a retry.
+func (r *NotificationRetryRepository) Delete(ctx context.Context, id string) error {
+	results, err := r.client.Tx(ctx, [][]string{
+		{"HDEL", r.key(), id},
+		{"ZREM", r.dueKey(), id},
+	})
+	if err != nil {
+		return fmt.Errorf("deleting notification retry: %w", err)
+	}
+	if n, _ := results[0].(int64); n == 0 {
+		return entity.ErrNotificationRetryNotFound
+	}
+	return nil
+}
+
+func decodeNotificationRetry(data string) (*entity.NotificationRetry, error) {
+	var retry entity.NotificationRetry
+	if err := json.Unmarshal([]byte(data), &retry); err != nil {
+		return nil, fmt.Errorf("decoding notification retry: %w", err)
+	}
+	return &retry, nil
+}
+
+func (r *NotificationRetryRepository) key() string {
+	return r.client.key("retries")
+}
+
+func (r *NotificationRetryRepository) dueKey() string {
+	return r.client.key("retries", "due")
+}
@@ -0,0 +1,62 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+func TestNotificationRetryRepository(t *testing.T) {
+	repos := setupTestRepos(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	later := entity.NewNotificationRetry("alert-1", "slack", entity.NotificationActionNotify, "rate limited", now.Add(-time.Minute))
+	sooner := entity.NewNotificationRetry("alert-2", "pagerduty", entity.NotificationActionUpdate, "timeout", now.Add(-time.Hour))
+	future := entity.NewNotificationRetry("alert-3", "slack", entity.NotificationActionNotify, "timeout", now.Add(time.Hour))
+	for _, r := range []*entity.NotificationRetry{later, sooner, future} {
+		require.NoError(t, repos.Retry.Save(ctx, r))
+	}
+
+	due, err := repos.Retry.FindDue(ctx, now, 10)
+	require.NoError(t, err)
+	require.Len(t, due, 2)
+	assert.Equal(t, sooner.ID, due[0].ID)
+	assert.Equal(t, later.ID, due[1].ID)
+
+	// Dead letters are never due
+	later.MarkDead("channel_not_found")
+	require.NoError(t, repos.Retry.Save(ctx, later))
+	due, err = repos.Retry.FindDue(ctx, now, 10)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+
+	deadLetters, err := repos.Retry.FindDead(ctx)
+	require.NoError(t, err)
+	require.Len(t, deadLetters, 1)
+	assert.Equal(t, later.ID, deadLetters[0].ID)
+	assert.Equal(t, "channel_not_found", deadLetters[0].LastError)
+
+	// Requeued dead letters are due again
+	later.Requeue(now)
+	require.NoError(t, repos.Retry.Save(ctx, later))
+	due, err = repos.Retry.FindDue(ctx, now, 10)
+	require.NoError(t, err)
+	require.Len(t, due, 2)
+
+	found, err := repos.Retry.FindByID(ctx, later.ID)
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.Equal(t, 0, found.Attempts)
+	missing, err := repos.Retry.FindByID(ctx, "missing")
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+
+	require.NoError(t, repos.Retry.Delete(ctx, sooner.ID))
+	assert.ErrorIs(t, repos.Retry.Delete(ctx, sooner.ID), entity.ErrNotificationRetryNotFound)
+}
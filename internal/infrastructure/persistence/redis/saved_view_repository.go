@@ -0,0 +1,130 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// SavedViewRepository implements repository.SavedViewRepository using Redis.
+// All views live in one hash keyed by owner and name.
+type SavedViewRepository struct {
+	client *Client
+}
+
+// NewSavedViewRepository creates a new Redis saved view repository.
+func NewSavedViewRepository(client *Client) *SavedViewRepository {
+	return &SavedViewRepository{client: client}
+}
+
+// Save creates or replaces a view.
+func (r *SavedViewRepository) Save(ctx context.Context, view *entity.SavedView) error {
+	field := viewField(view.Owner, view.Name)
+
+	existing, err := r.get(ctx, field)
+	if err != nil {
+		return err
+	}
+	viewCopy := view.Copy()
+	if existing != nil {
+		viewCopy.ID = existing.ID
+		viewCopy.CreatedAt = existing.CreatedAt
+	}
+
+	data, err := json.Marshal(viewCopy)
+	if err != nil {
+		return fmt.Errorf("marshaling saved view: %w", err)
+	}
+	if _, err := r.client.Do(ctx, "HSET", r.key(), field, string(data)); err != nil {
+		return fmt.Errorf("saving view: %w", err)
+	}
+	return nil
+}
+
+// FindByName returns the view visible to owner, preferring the owner's own view.
+func (r *SavedViewRepository) FindByName(ctx context.Context, owner, name string) (*entity.SavedView, error) {
+	reply, err := r.client.Do(ctx, "HMGET", r.key(), viewField(owner, name), viewField("", name))
+	if err != nil {
+		return nil, fmt.Errorf("getting saved view: %w", err)
+	}
+	for _, data := range asStrings(reply) {
+		if data != "" {
+			return decodeView(data)
+		}
+	}
+	return nil, nil
+}
+
+// FindVisible returns the owner's views and all shared views, ordered by name.
+func (r *SavedViewRepository) FindVisible(ctx context.Context, owner string) ([]*entity.SavedView, error) {
+	reply, err := r.client.Do(ctx, "HVALS", r.key())
+	if err != nil {
+		return nil, fmt.Errorf("listing saved views: %w", err)
+	}
+
+	views := make([]*entity.SavedView, 0)
+	for _, data := range asStrings(reply) {
+		view, err := decodeView(data)
+		if err != nil {
+			return nil, err
+		}
+		if view.Owner == "" || view.Owner == owner {
+			views = append(views, view)
+		}
+	}
+
+	sort.Slice(views, func(i, j int) bool {
+		if views[i].Name != views[j].Name {
+			return views[i].Name < views[j].Name
+		}
+		// Personal view before the shared one of the same name
+		return views[i].Owner > views[j].Owner
+	})
+
+	return views, nil
+}
+
+// Delete removes a view.
+func (r *SavedViewRepository) Delete(ctx context.Context, owner, name string) error {
+	reply, err := r.client.Do(ctx, "HDEL", r.key(), viewField(owner, name))
+	if err != nil {
+		return fmt.Errorf("deleting saved view: %w", err)
+	}
+	if n, _ := reply.(int64); n == 0 {
+		return entity.ErrSavedViewNotFound
+	}
+	return nil
+}
+
+func (r *SavedViewRepository) get(ctx context.Context, field string) (*entity.SavedView, error) {
+	reply, err := r.client.Do(ctx, "HGET", r.key(), field)
+	if err != nil {
+		return nil, fmt.Errorf("getting saved view: %w", err)
+	}
+	data, _ := reply.(string)
+	if data == "" {
+		return nil, nil
+	}
+	return decodeView(data)
+}
+
+func (r *SavedViewRepository) key() string {
+	return r.client.key("views")
+}
+
+// viewField is the hash field for a view. Owners are Slack user IDs, which
+// never contain the separator.
+func viewField(owner, name string) string {
+	return owner + "/" + name
+}
+
+func decodeView(data string) (*entity.SavedView, error) {
+	var view entity.SavedView
+	if err := json.Unmarshal([]byte(data), &view); err != nil {
+		return nil, fmt.Errorf("decoding saved view: %w", err)
+	}
+	return &view, nil
+}
@@ -0,0 +1,50 @@
+package redis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+func TestSavedViewRepository(t *testing.T) {
+	repos := setupTestRepos(t)
+	ctx := context.Background()
+
+	shared, err := entity.NewSavedView("prod", "", "U1")
+	require.NoError(t, err)
+	shared.Labels = map[string]string{"env": "prod"}
+	require.NoError(t, repos.SavedView.Save(ctx, shared))
+	personal, err := entity.NewSavedView("prod", "U2", "U2")
+	require.NoError(t, err)
+	require.NoError(t, repos.SavedView.Save(ctx, personal))
+
+	found, err := repos.SavedView.FindByName(ctx, "U2", "prod")
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.Equal(t, "U2", found.Owner)
+
+	found, err = repos.SavedView.FindByName(ctx, "U3", "prod")
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.Equal(t, "", found.Owner)
+
+	// Replacing keeps the original ID
+	replacement, err := entity.NewSavedView("prod", "", "U1")
+	require.NoError(t, err)
+	require.NoError(t, repos.SavedView.Save(ctx, replacement))
+	found, err = repos.SavedView.FindByName(ctx, "", "prod")
+	require.NoError(t, err)
+	assert.Equal(t, shared.ID, found.ID)
+
+	visible, err := repos.SavedView.FindVisible(ctx, "U2")
+	require.NoError(t, err)
+	require.Len(t, visible, 2)
+	assert.Equal(t, "U2", visible[0].Owner)
+
+	require.NoError(t, repos.SavedView.Delete(ctx, "U2", "prod"))
+	assert.ErrorIs(t, repos.SavedView.Delete(ctx, "U2", "prod"), entity.ErrSavedViewNotFound)
+}
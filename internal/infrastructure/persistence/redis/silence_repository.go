@@ -0,0 +1,240 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// SilenceRepository implements repository.SilenceRepository using Redis.
+//
+// Silences are stored as JSON under silence:<id> with their IDs in the
+// silences set. Each key expires once the silence has ended plus the
+// retention TTL; the set is pruned as expired keys are found. Silences are
+// few, so lookups load them all and filter in memory. Deleted silences are
+// renamed to deleted-silence:<id>, keeping their expiry, until restored.
+type SilenceRepository struct {
+	client *Client
+	ttl    time.Duration
+}
+
+// NewSilenceRepository creates a new Redis silence repository.
+func NewSilenceRepository(client *Client, retention time.Duration) *SilenceRepository {
+	return &SilenceRepository{client: client, ttl: retention}
+}
+
+// Save persists a new silence.
+func (r *SilenceRepository) Save(ctx context.Context, silence *entity.SilenceMark) error {
+	set, err := r.setCommand(silence)
+	if err != nil {
+		return err
+	}
+	if _, err := r.client.Tx(ctx, [][]string{set, {"SADD", r.setKey(), silence.ID}}); err != nil {
+		return fmt.Errorf("saving silence: %w", err)
+	}
+	return nil
+}
+
+// FindByID retrieves a silence by its ID.
+func (r *SilenceRepository) FindByID(ctx context.Context, id string) (*entity.SilenceMark, error) {
+	reply, err := r.client.Do(ctx, "GET", r.silenceKey(id))
+	if err != nil {
+		return nil, fmt.Errorf("getting silence: %w", err)
+	}
+	data, _ := reply.(string)
+	if data == "" {
+		return nil, nil
+	}
+	return decodeSilence(data)
+}
+
+// FindActive returns all currently active silences.
+func (r *SilenceRepository) FindActive(ctx context.Context) ([]*entity.SilenceMark, error) {
+	return r.find(ctx, (*entity.SilenceMark).IsActive)
+}
+
+// FindByAlertID retrieves active silences for a specific alert.
+func (r *SilenceRepository) FindByAlertID(ctx context.Context, alertID string) ([]*entity.SilenceMark, error) {
+	return r.find(ctx, func(s *entity.SilenceMark) bool {
+		return s.AlertID == alertID && s.IsActive()
+	})
+}
+
+// FindByInstance retrieves active silences for a specific instance.
+func (r *SilenceRepository) FindByInstance(ctx context.Context, instance string) ([]*entity.SilenceMark, error) {
+	return r.find(ctx, func(s *entity.SilenceMark) bool {
+		return s.Instance == instance && s.IsActive()
+	})
+}
+
+// FindByFingerprint retrieves active silences for a specific fingerprint.
+func (r *SilenceRepository) FindByFingerprint(ctx context.Context, fingerprint string) ([]*entity.SilenceMark, error) {
+	return r.find(ctx, func(s *entity.SilenceMark) bool {
+		return s.Fingerprint == fingerprint && s.IsActive()
+	})
+}
+
+// FindMatchingAlert returns all active silences that match the given alert.
+func (r *SilenceRepository) FindMatchingAlert(ctx context.Context, alert *entity.Alert) ([]*entity.SilenceMark, error) {
+	return r.find(ctx, func(s *entity.SilenceMark) bool {
+		return s.MatchesAlert(alert)
+	})
+}
+
+// Update modifies an existing silence.
+func (r *SilenceRepository) Update(ctx context.Context, silence *entity.SilenceMark) error {
+	set, err := r.setCommand(silence)
+	if err != nil {
+		return err
+	}
+	reply, err := r.client.Do(ctx, append(set, "XX")...)
+	if err != nil {
+		return fmt.Errorf("updating silence: %w", err)
+	}
+	if reply == nil {
+		return entity.ErrSilenceNotFound
+	}
+	return nil
+}
+
+// Delete soft-deletes a silence by ID.
+func (r *SilenceRepository) Delete(ctx context.Context, id string) error {
+	silence, err := r.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if silence == nil {
+		return entity.ErrSilenceNotFound
+	}
+
+	if _, err := r.client.Tx(ctx, [][]string{
+		{"RENAME", r.silenceKey(id), r.deletedKey(id)},
+		{"SREM", r.setKey(), id},
+	}); err != nil {
+		return fmt.Errorf("deleting silence: %w", err)
+	}
+	return nil
+}
+
+// Restore brings back a soft-deleted silence.
+func (r *SilenceRepository) Restore(ctx context.Context, id string) error {
+	reply, err := r.client.Do(ctx, "EXISTS", r.deletedKey(id))
+	if err != nil {
+		return fmt.Errorf("getting deleted silence: %w", err)
+	}
+	if n, _ := reply.(int64); n == 0 {
+		return entity.ErrSilenceNotFound
+	}
+
+	if _, err := r.client.Tx(ctx, [][]string{
+		{"RENAME", r.deletedKey(id), r.silenceKey(id)},
+		{"SADD", r.setKey(), id},
+	}); err != nil {
+		return fmt.Errorf("restoring silence: %w", err)
+	}
+	return nil
+}
+
+// DeleteExpired removes silences that expired before the given time.
+// Soft-deleted silences expire on their own.
+func (r *SilenceRepository) DeleteExpired(ctx context.Context, before time.Time) (int, error) {
+	expired, err := r.find(ctx, func(s *entity.SilenceMark) bool { return s.EndAt.Before(before) })
+	if err != nil {
+		return 0, err
+	}
+	if len(expired) == 0 {
+		return 0, nil
+	}
+
+	cmds := make([][]string, 0, 2*len(expired))
+	for _, s := range expired {
+		cmds = append(cmds,
+			[]string{"DEL", r.silenceKey(s.ID)},
+			[]string{"SREM", r.setKey(), s.ID},
+		)
+	}
+	if _, err := r.client.Tx(ctx, cmds); err != nil {
+		return 0, fmt.Errorf("deleting expired silences: %w", err)
+	}
+	return len(expired), nil
+}
+
+// find loads all silences and returns those satisfying keep. IDs whose keys
+// have expired are removed from the set.
+func (r *SilenceRepository) find(ctx context.Context, keep func(*entity.SilenceMark) bool) ([]*entity.SilenceMark, error) {
+	reply, err := r.client.Do(ctx, "SMEMBERS", r.setKey())
+	if err != nil {
+		return nil, fmt.Errorf("listing silences: %w", err)
+	}
+	ids := asStrings(reply)
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	args := make([]string, 0, len(ids)+1)
+	args = append(args, "MGET")
+	for _, id := range ids {
+		args = append(args, r.silenceKey(id))
+	}
+	reply, err = r.client.Do(ctx, args...)
+	if err != nil {
+		return nil, fmt.Errorf("loading silences: %w", err)
+	}
+
+	var matches []*entity.SilenceMark
+	stale := []string{"SREM", r.setKey()}
+	for i, data := range asStrings(reply) {
+		if data == "" {
+			stale = append(stale, ids[i])
+			continue
+		}
+		silence, err := decodeSilence(data)
+		if err != nil {
+			return nil, err
+		}
+		if keep(silence) {
+			matches = append(matches, silence)
+		}
+	}
+
+	if len(stale) > 2 {
+		// Best effort; a failure only leaves dangling IDs for next time
+		_, _ = r.client.Do(ctx, stale...)
+	}
+	return matches, nil
+}
+
+// setCommand builds the SET for a silence, expiring it after it ends plus
+// the retention period.
+func (r *SilenceRepository) setCommand(silence *entity.SilenceMark) ([]string, error) {
+	data, err := json.Marshal(silence)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling silence: %w", err)
+	}
+	expireAt := silence.EndAt.Add(r.ttl).Unix()
+	return []string{"SET", r.silenceKey(silence.ID), string(data), "EXAT", strconv.FormatInt(expireAt, 10)}, nil
+}
+
+func (r *SilenceRepository) silenceKey(id string) string {
+	return r.client.key("silence", id)
+}
+
+func (r *SilenceRepository) deletedKey(id string) string {
+	return r.client.key("deleted-silence", id)
+}
+
+func (r *SilenceRepository) setKey() string {
+	return r.client.key("silences")
+}
+
+func decodeSilence(data string) (*entity.SilenceMark, error) {
+	var silence entity.SilenceMark
+	if err := json.Unmarshal([]byte(data), &silence); err != nil {
+		return nil, fmt.Errorf("decoding silence: %w", err)
+	}
+	return &silence, nil
+}
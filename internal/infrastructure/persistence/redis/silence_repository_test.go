@@ -0,0 +1,92 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+func TestSilenceRepository(t *testing.T) {
+	repos := setupTestRepos(t)
+	ctx := context.Background()
+
+	active, err := entity.NewSilenceMark(time.Hour, "alice", "alice@example.com", entity.AckSourceSlack)
+	require.NoError(t, err)
+	active.Instance = "server-1"
+	require.NoError(t, repos.Silence.Save(ctx, active))
+
+	expired, err := entity.NewSilenceMark(time.Hour, "bob", "bob@example.com", entity.AckSourceSlack)
+	require.NoError(t, err)
+	// Ended, but still within the retention TTL
+	expired.StartAt = time.Now().Add(-time.Hour)
+	expired.EndAt = time.Now().Add(-10 * time.Minute)
+	require.NoError(t, repos.Silence.Save(ctx, expired))
+
+	found, err := repos.Silence.FindActive(ctx)
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.Equal(t, active.ID, found[0].ID)
+
+	byInstance, err := repos.Silence.FindByInstance(ctx, "server-1")
+	require.NoError(t, err)
+	assert.Len(t, byInstance, 1)
+
+	alert := entity.NewAlert("fp", "HighCPU", "server-1", "web", "", entity.SeverityCritical)
+	matching, err := repos.Silence.FindMatchingAlert(ctx, alert)
+	require.NoError(t, err)
+	assert.Len(t, matching, 1)
+
+	n, err := repos.Silence.DeleteExpired(ctx, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	require.NoError(t, repos.Silence.Delete(ctx, active.ID))
+	assert.ErrorIs(t, repos.Silence.Delete(ctx, active.ID), entity.ErrSilenceNotFound)
+	assert.ErrorIs(t, repos.Silence.Update(ctx, active), entity.ErrSilenceNotFound)
+
+	require.NoError(t, repos.Silence.Restore(ctx, active.ID))
+	assert.ErrorIs(t, repos.Silence.Restore(ctx, active.ID), entity.ErrSilenceNotFound)
+	found, err = repos.Silence.FindActive(ctx)
+	require.NoError(t, err)
+	assert.Len(t, found, 1)
+}
+
+func TestSilenceRepository_Recurrence(t *testing.T) {
+	repos := setupTestRepos(t)
+	ctx := context.Background()
+
+	recurrence, err := entity.ParseSilenceRecurrence("FREQ=WEEKLY;BYDAY=SA,SU;BYHOUR=2;DURATION=2h;TZID=Europe/Berlin")
+	require.NoError(t, err)
+	silence, err := entity.NewSilenceMark(time.Hour, "alice", "", entity.AckSourceAPI)
+	require.NoError(t, err)
+	silence.WithLabel("job", "backup").WithRecurrence(recurrence)
+	require.NoError(t, repos.Silence.Save(ctx, silence))
+
+	saved, err := repos.Silence.FindByID(ctx, silence.ID)
+	require.NoError(t, err)
+	require.NotNil(t, saved.Recurrence)
+	assert.Equal(t, "FREQ=WEEKLY;BYDAY=SA,SU;BYHOUR=2;BYMINUTE=0;DURATION=2h;TZID=Europe/Berlin", saved.Recurrence.String())
+}
+
+func TestSilenceRepository_LabelMatchers(t *testing.T) {
+	repos := setupTestRepos(t)
+	ctx := context.Background()
+
+	regex, err := entity.ParseLabelMatcher(`service!~"pay.*"`)
+	require.NoError(t, err)
+	silence, err := entity.NewSilenceMark(time.Hour, "alice", "", entity.AckSourceAPI)
+	require.NoError(t, err)
+	silence.WithLabel("env", "prod").WithLabelMatchers([]entity.LabelMatcher{regex})
+	require.NoError(t, repos.Silence.Save(ctx, silence))
+
+	saved, err := repos.Silence.FindByID(ctx, silence.ID)
+	require.NoError(t, err)
+	require.Len(t, saved.Matchers, 1)
+	assert.True(t, saved.Matchers[0].Matches(map[string]string{"service": "ledger"}))
+	assert.False(t, saved.Matchers[0].Matches(map[string]string{"service": "payments"}))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
 )
@@ -21,7 +22,12 @@ func NewAckEventRepository(db *DB) *AckEventRepository {
 // Save persists a new ack event.
 // Returns error if the referenced alert doesn't exist (foreign key constraint).
 func (r *AckEventRepository) Save(ctx context.Context, event *entity.AckEvent) error {
-	_, err := r.db.getExecutor(ctx).ExecContext(ctx, `
+	note, err := r.db.cipher.Encrypt(event.Note)
+	if err != nil {
+		return fmt.Errorf("encrypt ack event note: %w", err)
+	}
+
+	_, err = r.db.getExecutor(ctx).ExecContext(ctx, `
 		INSERT INTO ack_events (
 			id, alert_id, source, user_id, user_email, user_name,
 			note, duration_seconds, created_at
@@ -29,7 +35,7 @@ func (r *AckEventRepository) Save(ctx context.Context, event *entity.AckEvent) e
 	`,
 		event.ID, event.AlertID, string(event.Source),
 		event.UserID, event.UserEmail, event.UserName,
-		nullString(event.Note), durationToSeconds(event.Duration),
+		nullString(note), durationToSeconds(event.Duration),
 		timeToString(event.CreatedAt),
 	)
 
@@ -52,13 +58,13 @@ func (r *AckEventRepository) FindByID(ctx context.Context, id string) (*entity.A
 		FROM ack_events WHERE id = ?
 	`, id)
 
-	return scanAckEvent(row)
+	return r.scanAckEvent(row)
 }
 
 // FindByAlertID retrieves all ack events for an alert, ordered by creation time (oldest first).
 // Returns empty slice if none found.
 func (r *AckEventRepository) FindByAlertID(ctx context.Context, alertID string) ([]*entity.AckEvent, error) {
-	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, `
+	rows, err := r.db.getReader(ctx).QueryContext(ctx, `
 		SELECT id, alert_id, source, user_id, user_email, user_name,
 			note, duration_seconds, created_at
 		FROM ack_events WHERE alert_id = ?
@@ -69,7 +75,7 @@ func (r *AckEventRepository) FindByAlertID(ctx context.Context, alertID string)
 	}
 	defer rows.Close()
 
-	return scanAckEvents(rows)
+	return r.scanAckEvents(rows)
 }
 
 // FindLatestByAlertID retrieves the most recent ack event for an alert.
@@ -83,7 +89,24 @@ func (r *AckEventRepository) FindLatestByAlertID(ctx context.Context, alertID st
 		LIMIT 1
 	`, alertID)
 
-	return scanAckEvent(row)
+	return r.scanAckEvent(row)
+}
+
+// DeleteBefore removes ack events created before the given time.
+// Returns the number of events deleted.
+func (r *AckEventRepository) DeleteBefore(ctx context.Context, before time.Time) (int, error) {
+	result, err := r.db.getExecutor(ctx).ExecContext(ctx,
+		`DELETE FROM ack_events WHERE created_at < ?`, timeToString(before))
+	if err != nil {
+		return 0, fmt.Errorf("delete ack events: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("get rows affected: %w", err)
+	}
+
+	return int(rowsAffected), nil
 }
 
 // GetTopAcknowledgers returns users with the most acknowledgments.
@@ -93,7 +116,7 @@ func (r *AckEventRepository) GetTopAcknowledgers(ctx context.Context, limit int)
 		limit = 10
 	}
 
-	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, `
+	rows, err := r.db.getReader(ctx).QueryContext(ctx, `
 		SELECT user_name, user_email, COUNT(*) as ack_count
 		FROM ack_events
 		GROUP BY user_email
@@ -134,7 +157,7 @@ func (r *AckEventRepository) GetTopAcknowledgers(ctx context.Context, limit int)
 }
 
 // scanAckEvent scans a single row into an AckEvent entity.
-func scanAckEvent(row *sql.Row) (*entity.AckEvent, error) {
+func (r *AckEventRepository) scanAckEvent(row *sql.Row) (*entity.AckEvent, error) {
 	var (
 		event           entity.AckEvent
 		source          string
@@ -157,7 +180,9 @@ func scanAckEvent(row *sql.Row) (*entity.AckEvent, error) {
 
 	// Convert string fields
 	event.Source = entity.AckSource(source)
-	event.Note = stringFromNull(note)
+	if event.Note, err = r.db.cipher.Decrypt(stringFromNull(note)); err != nil {
+		return nil, fmt.Errorf("decrypt ack event note: %w", err)
+	}
 	event.Duration = secondsToDuration(durationSeconds)
 
 	// Parse timestamp
@@ -167,7 +192,7 @@ func scanAckEvent(row *sql.Row) (*entity.AckEvent, error) {
 }
 
 // scanAckEvents scans multiple rows into AckEvent entities.
-func scanAckEvents(rows *sql.Rows) ([]*entity.AckEvent, error) {
+func (r *AckEventRepository) scanAckEvents(rows *sql.Rows) ([]*entity.AckEvent, error) {
 	var events []*entity.AckEvent
 
 	for rows.Next() {
@@ -190,7 +215,9 @@ func scanAckEvents(rows *sql.Rows) ([]*entity.AckEvent, error) {
 
 		// Convert string fields
 		event.Source = entity.AckSource(source)
-		event.Note = stringFromNull(note)
+		if event.Note, err = r.db.cipher.Decrypt(stringFromNull(note)); err != nil {
+			return nil, fmt.Errorf("decrypt ack event note: %w", err)
+		}
 		event.Duration = secondsToDuration(durationSeconds)
 
 		// Parse timestamp
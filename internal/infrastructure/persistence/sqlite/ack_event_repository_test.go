@@ -340,3 +340,26 @@ func TestAckEventRepository_EmptyFields(t *testing.T) {
 		assert.Nil(t, saved.Duration)
 	})
 }
+
+func TestAckEventRepository_DeleteBefore(t *testing.T) {
+	db, alertRepo, repo := setupAckEventTest(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	alert := createTestAlert(t, alertRepo)
+
+	old := entity.NewAckEvent(alert.ID, entity.AckSourceSlack, "U1", "", "alice")
+	old.CreatedAt = time.Now().UTC().Add(-48 * time.Hour)
+	recent := entity.NewAckEvent(alert.ID, entity.AckSourceSlack, "U1", "", "alice")
+	require.NoError(t, repo.Save(ctx, old))
+	require.NoError(t, repo.Save(ctx, recent))
+
+	count, err := repo.DeleteBefore(ctx, time.Now().UTC().Add(-24*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	events, err := repo.FindByAlertID(ctx, alert.ID)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, recent.ID, events[0].ID)
+}
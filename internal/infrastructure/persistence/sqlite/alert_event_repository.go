@@ -0,0 +1,104 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// alertEventColumns lists alert_events columns in the order scanAlertEvent
+// expects.
+const alertEventColumns = `id, alert_id, type, actor, detail, created_at`
+
+// AlertEventRepository provides SQLite implementation of repository.AlertEventRepository.
+type AlertEventRepository struct {
+	db *DB
+}
+
+// NewAlertEventRepository creates a new SQLite-backed alert event repository.
+func NewAlertEventRepository(db *DB) *AlertEventRepository {
+	return &AlertEventRepository{db: db}
+}
+
+// Save persists a new event.
+// Returns error if the referenced alert doesn't exist (foreign key constraint).
+func (r *AlertEventRepository) Save(ctx context.Context, event *entity.AlertEvent) error {
+	detail, err := r.db.cipher.Encrypt(event.Detail)
+	if err != nil {
+		return fmt.Errorf("encrypt alert event detail: %w", err)
+	}
+
+	_, err = r.db.getExecutor(ctx).ExecContext(ctx, `
+		INSERT INTO alert_events (`+alertEventColumns+`)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`,
+		event.ID,
+		event.AlertID,
+		string(event.Type),
+		event.By,
+		detail,
+		timeToString(event.CreatedAt),
+	)
+	if err != nil {
+		if isForeignKeyError(err) {
+			return fmt.Errorf("alert not found: %w", err)
+		}
+		return fmt.Errorf("insert alert event: %w", err)
+	}
+
+	return nil
+}
+
+// FindByAlertID retrieves all events for an alert, oldest first.
+// Events recorded in the same second keep the order they were saved in.
+func (r *AlertEventRepository) FindByAlertID(ctx context.Context, alertID string) ([]*entity.AlertEvent, error) {
+	rows, err := r.db.getReader(ctx).QueryContext(ctx, `
+		SELECT `+alertEventColumns+`
+		FROM alert_events
+		WHERE alert_id = ?
+		ORDER BY created_at ASC, rowid ASC
+	`, alertID)
+	if err != nil {
+		return nil, fmt.Errorf("query alert events: %w", err)
+	}
+	defer rows.Close()
+
+	events := []*entity.AlertEvent{}
+	for rows.Next() {
+		event, err := r.scanAlertEvent(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan alert event row: %w", err)
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration: %w", err)
+	}
+
+	return events, nil
+}
+
+// scanAlertEvent scans a row selected with alertEventColumns.
+func (r *AlertEventRepository) scanAlertEvent(row rowScanner) (*entity.AlertEvent, error) {
+	var (
+		event     entity.AlertEvent
+		eventType string
+		createdAt string
+	)
+
+	if err := row.Scan(&event.ID, &event.AlertID, &eventType, &event.By, &event.Detail, &createdAt); err != nil {
+		return nil, err
+	}
+
+	event.Type = entity.AlertEventType(eventType)
+	event.CreatedAt, _ = parseTime(createdAt)
+
+	detail, err := r.db.cipher.Decrypt(event.Detail)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt detail: %w", err)
+	}
+	event.Detail = detail
+
+	return &event, nil
+}
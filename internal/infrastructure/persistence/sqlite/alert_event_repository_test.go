@@ -0,0 +1,51 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+func TestAlertEventRepository(t *testing.T) {
+	db, err := NewDB(":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, db.Migrate(context.Background()))
+	alertRepo := NewAlertRepository(db)
+	repo := NewAlertEventRepository(db)
+	ctx := context.Background()
+
+	alert := entity.NewAlert("fp1", "HighCPU", "host-1", "", "summary", entity.SeverityCritical)
+	require.NoError(t, alertRepo.Save(ctx, alert))
+
+	// Saved within the same second, so only the save order tells them apart
+	fired := entity.NewAlertEvent(alert.ID, entity.AlertEventFired, "", "critical")
+	notified := entity.NewAlertEvent(alert.ID, entity.AlertEventNotified, "", "slack")
+	acked := entity.NewAlertEvent(alert.ID, entity.AlertEventAcknowledged, "alice", "slack")
+	for _, e := range []*entity.AlertEvent{fired, notified, acked} {
+		require.NoError(t, repo.Save(ctx, e))
+	}
+
+	events, err := repo.FindByAlertID(ctx, alert.ID)
+	require.NoError(t, err)
+	require.Len(t, events, 3)
+	assert.Equal(t, fired.ID, events[0].ID)
+	assert.Equal(t, entity.AlertEventNotified, events[1].Type)
+	assert.Equal(t, "alice", events[2].By)
+	assert.Equal(t, "slack", events[2].Detail)
+
+	t.Run("unknown alert", func(t *testing.T) {
+		assert.Error(t, repo.Save(ctx, entity.NewAlertEvent("missing", entity.AlertEventFired, "", "")))
+	})
+
+	t.Run("kept while the alert is deleted", func(t *testing.T) {
+		require.NoError(t, alertRepo.Delete(ctx, alert.ID))
+		events, err := repo.FindByAlertID(ctx, alert.ID)
+		require.NoError(t, err)
+		assert.Len(t, events, 3)
+	})
+}
@@ -4,10 +4,20 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
 )
 
+// alertColumns is the column list shared by all alert SELECT queries.
+// Its order must match scanAlertRow.
+const alertColumns = `id, fingerprint, name, instance, target, summary, description,
+			severity, state, labels, annotations,
+			external_references, group_key, history, custom_fields, tags, notes,
+			fired_at, acked_at, acked_by, resolved_at, created_at, updated_at,
+			last_seen_at, ends_at, assigned_to, assignee_slack_id, assigned_at, source`
+
 // AlertRepository provides SQLite implementation of repository.AlertRepository.
 type AlertRepository struct {
 	db *DB
@@ -31,26 +41,50 @@ func (r *AlertRepository) Save(ctx context.Context, alert *entity.Alert) error {
 		return fmt.Errorf("marshal annotations: %w", err)
 	}
 
-	externalRefs, err := marshalJSON(alert.ExternalReferences)
+	externalRefs, err := marshalExternalReferences(alert.ExternalReferences)
 	if err != nil {
 		return fmt.Errorf("marshal external references: %w", err)
 	}
 
+	history, err := marshalHistory(alert.History)
+	if err != nil {
+		return fmt.Errorf("marshal history: %w", err)
+	}
+
+	customFields, err := marshalJSON(alert.CustomFields)
+	if err != nil {
+		return fmt.Errorf("marshal custom fields: %w", err)
+	}
+
+	tags, err := marshalTags(alert.Tags)
+	if err != nil {
+		return fmt.Errorf("marshal tags: %w", err)
+	}
+
+	notes, err := marshalNotes(alert.Notes, r.db.cipher)
+	if err != nil {
+		return fmt.Errorf("marshal notes: %w", err)
+	}
+
 	_, err = r.db.getExecutor(ctx).ExecContext(ctx, `
 		INSERT INTO alerts (
 			id, fingerprint, name, instance, target, summary, description,
 			severity, state, labels, annotations,
-			external_references,
-			fired_at, acked_at, acked_by, resolved_at, created_at, updated_at
-		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			external_references, group_key, history, custom_fields, tags, notes,
+			fired_at, acked_at, acked_by, resolved_at, created_at, updated_at,
+			last_seen_at, ends_at, assigned_to, assignee_slack_id, assigned_at, source
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`,
 		alert.ID, alert.Fingerprint, alert.Name, alert.Instance, alert.Target,
 		alert.Summary, alert.Description, string(alert.Severity), string(alert.State),
 		labels, annotations,
-		externalRefs,
+		externalRefs, alert.GroupKey, history, customFields, tags, notes,
 		timeToString(alert.FiredAt),
 		nullTime(alert.AckedAt), nullString(alert.AckedBy), nullTime(alert.ResolvedAt),
 		timeToString(alert.CreatedAt), timeToString(alert.UpdatedAt),
+		nullTimeValue(alert.LastSeenAt), nullTime(alert.EndsAt),
+		nullString(alert.AssignedTo), nullString(alert.AssigneeSlackID), nullTime(alert.AssignedAt),
+		nullString(alert.Source),
 	)
 
 	if err != nil {
@@ -67,47 +101,41 @@ func (r *AlertRepository) Save(ctx context.Context, alert *entity.Alert) error {
 // Returns nil, nil if not found.
 func (r *AlertRepository) FindByID(ctx context.Context, id string) (*entity.Alert, error) {
 	row := r.db.getExecutor(ctx).QueryRowContext(ctx, `
-		SELECT id, fingerprint, name, instance, target, summary, description,
-			severity, state, labels, annotations,
-			external_references,
-			fired_at, acked_at, acked_by, resolved_at, created_at, updated_at
-		FROM alerts WHERE id = ?
+		SELECT `+alertColumns+`
+		FROM alerts WHERE id = ? AND deleted_at IS NULL
 	`, id)
 
-	return scanAlert(row)
+	return r.scanAlert(row)
 }
 
 // FindByFingerprint finds alerts matching the Alertmanager fingerprint.
 // Returns empty slice if none found.
 func (r *AlertRepository) FindByFingerprint(ctx context.Context, fingerprint string) ([]*entity.Alert, error) {
 	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, `
-		SELECT id, fingerprint, name, instance, target, summary, description,
-			severity, state, labels, annotations,
-			external_references,
-			fired_at, acked_at, acked_by, resolved_at, created_at, updated_at
-		FROM alerts WHERE fingerprint = ?
+		SELECT `+alertColumns+`
+		FROM alerts WHERE fingerprint = ? AND deleted_at IS NULL
 	`, fingerprint)
 	if err != nil {
 		return nil, fmt.Errorf("query by fingerprint: %w", err)
 	}
 	defer rows.Close()
 
-	return scanAlerts(rows)
+	return r.scanAlerts(rows)
 }
 
 // FindByExternalReference finds an alert by its external integration reference.
 // Returns nil, nil if not found.
 func (r *AlertRepository) FindByExternalReference(ctx context.Context, system, referenceID string) (*entity.Alert, error) {
 	row := r.db.getExecutor(ctx).QueryRowContext(ctx, `
-		SELECT id, fingerprint, name, instance, target, summary, description,
-			severity, state, labels, annotations,
-			external_references,
-			fired_at, acked_at, acked_by, resolved_at, created_at, updated_at
+		SELECT `+alertColumns+`
 		FROM alerts
-		WHERE json_extract(external_references, '$.' || ?) = ?
+		WHERE EXISTS (
+			SELECT 1 FROM json_each(alerts.external_references, '$.references')
+			WHERE json_extract(value, '$.system') = ? AND json_extract(value, '$.reference_id') = ?
+		) AND deleted_at IS NULL
 	`, system, referenceID)
 
-	return scanAlert(row)
+	return r.scanAlert(row)
 }
 
 // Update modifies an existing alert.
@@ -123,26 +151,49 @@ func (r *AlertRepository) Update(ctx context.Context, alert *entity.Alert) error
 		return fmt.Errorf("marshal annotations: %w", err)
 	}
 
-	externalRefs, err := marshalJSON(alert.ExternalReferences)
+	externalRefs, err := marshalExternalReferences(alert.ExternalReferences)
 	if err != nil {
 		return fmt.Errorf("marshal external references: %w", err)
 	}
 
+	history, err := marshalHistory(alert.History)
+	if err != nil {
+		return fmt.Errorf("marshal history: %w", err)
+	}
+
+	customFields, err := marshalJSON(alert.CustomFields)
+	if err != nil {
+		return fmt.Errorf("marshal custom fields: %w", err)
+	}
+
+	tags, err := marshalTags(alert.Tags)
+	if err != nil {
+		return fmt.Errorf("marshal tags: %w", err)
+	}
+
+	notes, err := marshalNotes(alert.Notes, r.db.cipher)
+	if err != nil {
+		return fmt.Errorf("marshal notes: %w", err)
+	}
+
 	result, err := r.db.getExecutor(ctx).ExecContext(ctx, `
 		UPDATE alerts SET
 			fingerprint = ?, name = ?, instance = ?, target = ?, summary = ?, description = ?,
 			severity = ?, state = ?, labels = ?, annotations = ?,
-			external_references = ?,
-			fired_at = ?, acked_at = ?, acked_by = ?, resolved_at = ?, updated_at = ?
-		WHERE id = ?
+			external_references = ?, group_key = ?, history = ?, custom_fields = ?, tags = ?, notes = ?,
+			fired_at = ?, acked_at = ?, acked_by = ?, resolved_at = ?, updated_at = ?,
+			last_seen_at = ?, ends_at = ?, assigned_to = ?, assignee_slack_id = ?, assigned_at = ?
+		WHERE id = ? AND deleted_at IS NULL
 	`,
 		alert.Fingerprint, alert.Name, alert.Instance, alert.Target,
 		alert.Summary, alert.Description, string(alert.Severity), string(alert.State),
 		labels, annotations,
-		externalRefs,
+		externalRefs, alert.GroupKey, history, customFields, tags, notes,
 		timeToString(alert.FiredAt),
 		nullTime(alert.AckedAt), nullString(alert.AckedBy), nullTime(alert.ResolvedAt),
 		timeToString(alert.UpdatedAt),
+		nullTimeValue(alert.LastSeenAt), nullTime(alert.EndsAt),
+		nullString(alert.AssignedTo), nullString(alert.AssigneeSlackID), nullTime(alert.AssignedAt),
 		alert.ID,
 	)
 	if err != nil {
@@ -162,36 +213,30 @@ func (r *AlertRepository) Update(ctx context.Context, alert *entity.Alert) error
 
 // FindActive returns all currently active (non-resolved) alerts.
 func (r *AlertRepository) FindActive(ctx context.Context) ([]*entity.Alert, error) {
-	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, `
-		SELECT id, fingerprint, name, instance, target, summary, description,
-			severity, state, labels, annotations,
-			external_references,
-			fired_at, acked_at, acked_by, resolved_at, created_at, updated_at
-		FROM alerts WHERE state != 'resolved'
+	rows, err := r.db.getReader(ctx).QueryContext(ctx, `
+		SELECT `+alertColumns+`
+		FROM alerts WHERE state != 'resolved' AND deleted_at IS NULL
 	`)
 	if err != nil {
 		return nil, fmt.Errorf("query active alerts: %w", err)
 	}
 	defer rows.Close()
 
-	return scanAlerts(rows)
+	return r.scanAlerts(rows)
 }
 
 // FindFiring returns all firing alerts (active or acknowledged).
 func (r *AlertRepository) FindFiring(ctx context.Context) ([]*entity.Alert, error) {
-	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, `
-		SELECT id, fingerprint, name, instance, target, summary, description,
-			severity, state, labels, annotations,
-			external_references,
-			fired_at, acked_at, acked_by, resolved_at, created_at, updated_at
-		FROM alerts WHERE state IN ('active', 'acknowledged')
+	rows, err := r.db.getReader(ctx).QueryContext(ctx, `
+		SELECT `+alertColumns+`
+		FROM alerts WHERE state IN ('active', 'acknowledged') AND deleted_at IS NULL
 	`)
 	if err != nil {
 		return nil, fmt.Errorf("query firing alerts: %w", err)
 	}
 	defer rows.Close()
 
-	return scanAlerts(rows)
+	return r.scanAlerts(rows)
 }
 
 // GetActiveAlerts returns active alerts, optionally filtered by severity.
@@ -202,38 +247,146 @@ func (r *AlertRepository) GetActiveAlerts(ctx context.Context, severity string)
 
 	if severity == "" {
 		query = `
-			SELECT id, fingerprint, name, instance, target, summary, description,
-				severity, state, labels, annotations,
-				external_references,
-				fired_at, acked_at, acked_by, resolved_at, created_at, updated_at
-			FROM alerts WHERE state != 'resolved'
+			SELECT ` + alertColumns + `
+			FROM alerts WHERE state != 'resolved' AND deleted_at IS NULL
 			ORDER BY fired_at DESC
 		`
 	} else {
 		query = `
-			SELECT id, fingerprint, name, instance, target, summary, description,
-				severity, state, labels, annotations,
-				external_references,
-				fired_at, acked_at, acked_by, resolved_at, created_at, updated_at
-			FROM alerts WHERE state != 'resolved' AND severity = ?
+			SELECT ` + alertColumns + `
+			FROM alerts WHERE state != 'resolved' AND severity = ? AND deleted_at IS NULL
 			ORDER BY fired_at DESC
 		`
 		args = append(args, severity)
 	}
 
-	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, query, args...)
+	rows, err := r.db.getReader(ctx).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("query active alerts: %w", err)
 	}
 	defer rows.Close()
 
-	return scanAlerts(rows)
+	return r.scanAlerts(rows)
 }
 
-// Delete removes an alert by ID.
+// FindChangedSince returns alerts that are still firing, or that fired or
+// resolved at or after since.
+func (r *AlertRepository) FindChangedSince(ctx context.Context, since time.Time) ([]*entity.Alert, error) {
+	// Timestamps are stored as UTC RFC3339 strings, which compare lexically
+	sinceStr := timeToString(since)
+	query := `
+		SELECT ` + alertColumns + `
+		FROM alerts
+		WHERE (state != 'resolved' OR fired_at >= ? OR resolved_at >= ?) AND deleted_at IS NULL
+		ORDER BY fired_at DESC
+	`
+
+	rows, err := r.db.getReader(ctx).QueryContext(ctx, query, sinceStr, sinceStr)
+	if err != nil {
+		return nil, fmt.Errorf("query changed alerts: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanAlerts(rows)
+}
+
+// FindActiveAt returns alerts that had fired by at and were not resolved
+// before it.
+func (r *AlertRepository) FindActiveAt(ctx context.Context, at time.Time) ([]*entity.Alert, error) {
+	atStr := timeToString(at)
+	query := `
+		SELECT ` + alertColumns + `
+		FROM alerts
+		WHERE fired_at <= ? AND (resolved_at IS NULL OR resolved_at > ?) AND deleted_at IS NULL
+		ORDER BY fired_at DESC
+	`
+
+	rows, err := r.db.getReader(ctx).QueryContext(ctx, query, atStr, atStr)
+	if err != nil {
+		return nil, fmt.Errorf("query alerts active at: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanAlerts(rows)
+}
+
+// Search returns the page of alerts matching query, newest fired first,
+// and the total number of matches.
+func (r *AlertRepository) Search(ctx context.Context, query entity.AlertQuery) ([]*entity.Alert, int, error) {
+	where, args := searchConditions(query)
+
+	var total int
+	if err := r.db.getReader(ctx).QueryRowContext(ctx, `SELECT COUNT(*) FROM alerts`+where, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("query matching alerts: %w", err)
+	}
+
+	page := `SELECT ` + alertColumns + ` FROM alerts` + where + ` ORDER BY fired_at DESC, id`
+	if query.Limit > 0 {
+		page += ` LIMIT ? OFFSET ?`
+		args = append(args, query.Limit, max(query.Offset, 0))
+	}
+
+	rows, err := r.db.getReader(ctx).QueryContext(ctx, page, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("query matching alerts: %w", err)
+	}
+	defer rows.Close()
+
+	alerts, err := r.scanAlerts(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+	return alerts, total, nil
+}
+
+// searchConditions returns the WHERE clause selecting the alerts that match
+// query, and its arguments. Soft-deleted alerts never match.
+func searchConditions(query entity.AlertQuery) (string, []any) {
+	conditions := []string{"deleted_at IS NULL"}
+	var args []any
+
+	if len(query.States) > 0 {
+		placeholders := make([]string, len(query.States))
+		for i, state := range query.States {
+			placeholders[i] = "?"
+			args = append(args, string(state))
+		}
+		conditions = append(conditions, "state IN ("+strings.Join(placeholders, ", ")+")")
+	}
+	if query.Severity != "" {
+		conditions = append(conditions, "severity = ?")
+		args = append(args, string(query.Severity))
+	}
+	for name, value := range query.Labels {
+		conditions = append(conditions, `json_extract(labels, '$."' || ? || '"') = ?`)
+		args = append(args, name, value)
+	}
+	for name, value := range query.Fields {
+		conditions = append(conditions, `json_extract(custom_fields, '$."' || ? || '"') = ?`)
+		args = append(args, name, value)
+	}
+	if !query.ResolvedSince.IsZero() {
+		// Timestamps are stored as UTC RFC3339 strings, which compare lexically
+		conditions = append(conditions, "(resolved_at IS NULL OR resolved_at >= ?)")
+		args = append(args, timeToString(query.ResolvedSince))
+	}
+	if query.Text != "" {
+		pattern := likePattern(strings.ToLower(query.Text))
+		conditions = append(conditions, `(LOWER(name) LIKE ? ESCAPE '!' OR LOWER(summary) LIKE ? ESCAPE '!'
+			OR LOWER(instance) LIKE ? ESCAPE '!' OR LOWER(target) LIKE ? ESCAPE '!')`)
+		args = append(args, pattern, pattern, pattern, pattern)
+	}
+
+	return " WHERE " + strings.Join(conditions, " AND "), args
+}
+
+// Delete soft-deletes an alert by ID, setting its deleted_at.
 // Returns ErrAlertNotFound if the alert doesn't exist.
 func (r *AlertRepository) Delete(ctx context.Context, id string) error {
-	result, err := r.db.getExecutor(ctx).ExecContext(ctx, `DELETE FROM alerts WHERE id = ?`, id)
+	result, err := r.db.getExecutor(ctx).ExecContext(ctx,
+		`UPDATE alerts SET deleted_at = ? WHERE id = ? AND deleted_at IS NULL`,
+		timeToString(time.Now().UTC()), id,
+	)
 	if err != nil {
 		return fmt.Errorf("delete alert: %w", err)
 	}
@@ -249,8 +402,143 @@ func (r *AlertRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
+// Restore clears the deleted_at of a soft-deleted alert.
+// Returns ErrAlertNotFound if no deleted alert has the ID.
+func (r *AlertRepository) Restore(ctx context.Context, id string) error {
+	result, err := r.db.getExecutor(ctx).ExecContext(ctx,
+		`UPDATE alerts SET deleted_at = NULL WHERE id = ? AND deleted_at IS NOT NULL`, id)
+	if err != nil {
+		return fmt.Errorf("restore alert: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return entity.ErrAlertNotFound
+	}
+
+	return nil
+}
+
+// FindResolvedBefore returns up to limit alerts resolved before the given
+// time, soft-deleted or not, oldest resolved first.
+func (r *AlertRepository) FindResolvedBefore(ctx context.Context, before time.Time, limit int) ([]*entity.Alert, error) {
+	query := `
+		SELECT ` + alertColumns + `
+		FROM alerts
+		WHERE resolved_at IS NOT NULL AND resolved_at < ?
+		ORDER BY resolved_at
+		LIMIT ?
+	`
+
+	rows, err := r.db.getReader(ctx).QueryContext(ctx, query, timeToString(before), limit)
+	if err != nil {
+		return nil, fmt.Errorf("query resolved alerts: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanAlerts(rows)
+}
+
+// DeleteResolvedBefore permanently removes alerts resolved before the given
+// time, soft-deleted or not. Their ack events and timelines are removed by
+// cascade.
+// Returns the number of alerts deleted.
+func (r *AlertRepository) DeleteResolvedBefore(ctx context.Context, before time.Time) (int, error) {
+	result, err := r.db.getExecutor(ctx).ExecContext(ctx,
+		`DELETE FROM alerts WHERE resolved_at IS NOT NULL AND resolved_at < ?`,
+		timeToString(before),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("delete resolved alerts: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("get rows affected: %w", err)
+	}
+
+	return int(rowsAffected), nil
+}
+
+// DeleteResolvedByIDs permanently removes the alerts with the given IDs
+// that are still resolved before the given time, soft-deleted or not.
+// Their ack events and timelines are removed by cascade.
+// Returns the number of alerts deleted.
+func (r *AlertRepository) DeleteResolvedByIDs(ctx context.Context, ids []string, before time.Time) (int, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	placeholders := make([]string, len(ids))
+	args := make([]any, 0, len(ids)+1)
+	args = append(args, timeToString(before))
+	for i, id := range ids {
+		placeholders[i] = "?"
+		args = append(args, id)
+	}
+
+	result, err := r.db.getExecutor(ctx).ExecContext(ctx,
+		`DELETE FROM alerts WHERE resolved_at IS NOT NULL AND resolved_at < ? AND id IN (`+strings.Join(placeholders, ", ")+`)`,
+		args...,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("delete resolved alerts: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("get rows affected: %w", err)
+	}
+
+	return int(rowsAffected), nil
+}
+
+// rowScanner is implemented by both *sql.Row and *sql.Rows.
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
 // scanAlert scans a single row into an Alert entity.
-func scanAlert(row *sql.Row) (*entity.Alert, error) {
+func (r *AlertRepository) scanAlert(row *sql.Row) (*entity.Alert, error) {
+	alert, err := r.scanAlertRow(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("scan alert: %w", err)
+	}
+	return alert, nil
+}
+
+// scanAlerts scans multiple rows into Alert entities.
+func (r *AlertRepository) scanAlerts(rows *sql.Rows) ([]*entity.Alert, error) {
+	var alerts []*entity.Alert
+
+	for rows.Next() {
+		alert, err := r.scanAlertRow(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan alert row: %w", err)
+		}
+		alerts = append(alerts, alert)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration: %w", err)
+	}
+
+	// Return empty slice instead of nil
+	if alerts == nil {
+		alerts = []*entity.Alert{}
+	}
+
+	return alerts, nil
+}
+
+// scanAlertRow scans the columns listed in alertColumns into an Alert entity.
+func (r *AlertRepository) scanAlertRow(row rowScanner) (*entity.Alert, error) {
 	var (
 		alert        entity.Alert
 		severity     string
@@ -258,24 +546,33 @@ func scanAlert(row *sql.Row) (*entity.Alert, error) {
 		labels       string
 		annotations  string
 		externalRefs string
+		history      string
+		customFields string
+		tags         string
+		notes        string
 		firedAt      string
 		ackedAt      sql.NullString
 		ackedBy      sql.NullString
 		resolvedAt   sql.NullString
 		createdAt    string
 		updatedAt    string
+		lastSeenAt   sql.NullString
+		endsAt       sql.NullString
+		assignedTo   sql.NullString
+		assigneeID   sql.NullString
+		assignedAt   sql.NullString
+		source       sql.NullString
 	)
 
 	err := row.Scan(
 		&alert.ID, &alert.Fingerprint, &alert.Name, &alert.Instance, &alert.Target,
 		&alert.Summary, &alert.Description, &severity, &state, &labels, &annotations,
-		&externalRefs, &firedAt, &ackedAt, &ackedBy, &resolvedAt, &createdAt, &updatedAt,
+		&externalRefs, &alert.GroupKey, &history, &customFields, &tags, &notes,
+		&firedAt, &ackedAt, &ackedBy, &resolvedAt, &createdAt, &updatedAt,
+		&lastSeenAt, &endsAt, &assignedTo, &assigneeID, &assignedAt, &source,
 	)
-	if err == sql.ErrNoRows {
-		return nil, nil
-	}
 	if err != nil {
-		return nil, fmt.Errorf("scan alert: %w", err)
+		return nil, err
 	}
 
 	// Convert string fields
@@ -286,7 +583,13 @@ func scanAlert(row *sql.Row) (*entity.Alert, error) {
 	// Parse JSON fields
 	alert.Labels, _ = unmarshalJSON(labels)
 	alert.Annotations, _ = unmarshalJSON(annotations)
-	alert.ExternalReferences, _ = unmarshalJSON(externalRefs)
+	alert.ExternalReferences, _ = unmarshalExternalReferences(externalRefs)
+	alert.History, _ = unmarshalHistory(history)
+	alert.CustomFields, _ = unmarshalJSON(customFields)
+	alert.Tags, _ = unmarshalTags(tags)
+	if alert.Notes, err = unmarshalNotes(notes, r.db.cipher); err != nil {
+		return nil, fmt.Errorf("unmarshal notes: %w", err)
+	}
 
 	// Parse timestamps
 	alert.FiredAt, _ = parseTime(firedAt)
@@ -294,67 +597,14 @@ func scanAlert(row *sql.Row) (*entity.Alert, error) {
 	alert.ResolvedAt = scanNullTime(resolvedAt)
 	alert.CreatedAt, _ = parseTime(createdAt)
 	alert.UpdatedAt, _ = parseTime(updatedAt)
-
-	return &alert, nil
-}
-
-// scanAlerts scans multiple rows into Alert entities.
-func scanAlerts(rows *sql.Rows) ([]*entity.Alert, error) {
-	var alerts []*entity.Alert
-
-	for rows.Next() {
-		var (
-			alert        entity.Alert
-			severity     string
-			state        string
-			labels       string
-			annotations  string
-			externalRefs string
-			firedAt      string
-			ackedAt      sql.NullString
-			ackedBy      sql.NullString
-			resolvedAt   sql.NullString
-			createdAt    string
-			updatedAt    string
-		)
-
-		err := rows.Scan(
-			&alert.ID, &alert.Fingerprint, &alert.Name, &alert.Instance, &alert.Target,
-			&alert.Summary, &alert.Description, &severity, &state, &labels, &annotations,
-			&externalRefs, &firedAt, &ackedAt, &ackedBy, &resolvedAt, &createdAt, &updatedAt,
-		)
-		if err != nil {
-			return nil, fmt.Errorf("scan alert row: %w", err)
-		}
-
-		// Convert string fields
-		alert.Severity = entity.AlertSeverity(severity)
-		alert.State = entity.AlertState(state)
-		alert.AckedBy = stringFromNull(ackedBy)
-
-		// Parse JSON fields
-		alert.Labels, _ = unmarshalJSON(labels)
-		alert.Annotations, _ = unmarshalJSON(annotations)
-		alert.ExternalReferences, _ = unmarshalJSON(externalRefs)
-
-		// Parse timestamps
-		alert.FiredAt, _ = parseTime(firedAt)
-		alert.AckedAt = scanNullTime(ackedAt)
-		alert.ResolvedAt = scanNullTime(resolvedAt)
-		alert.CreatedAt, _ = parseTime(createdAt)
-		alert.UpdatedAt, _ = parseTime(updatedAt)
-
-		alerts = append(alerts, &alert)
+	if seen := scanNullTime(lastSeenAt); seen != nil {
+		alert.LastSeenAt = *seen
 	}
+	alert.EndsAt = scanNullTime(endsAt)
+	alert.AssignedTo = assignedTo.String
+	alert.AssigneeSlackID = assigneeID.String
+	alert.AssignedAt = scanNullTime(assignedAt)
+	alert.Source = source.String
 
-	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("rows iteration: %w", err)
-	}
-
-	// Return empty slice instead of nil
-	if alerts == nil {
-		alerts = []*entity.Alert{}
-	}
-
-	return alerts, nil
+	return &alert, nil
 }
@@ -2,6 +2,7 @@ package sqlite
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -33,6 +34,7 @@ func TestAlertRepository_Save(t *testing.T) {
 	alert := entity.NewAlert("fp1", "TestAlert", "instance1", "target1", "Test summary", entity.SeverityWarning)
 	alert.AddLabel("env", "test")
 	alert.AddAnnotation("description", "Test description")
+	alert.Source = "grafana"
 
 	err := repo.Save(ctx, alert)
 	if err != nil {
@@ -53,6 +55,9 @@ func TestAlertRepository_Save(t *testing.T) {
 	if found.Labels["env"] != "test" {
 		t.Errorf("expected label env=test, got %v", found.Labels)
 	}
+	if found.Source != "grafana" {
+		t.Errorf("expected source grafana, got %q", found.Source)
+	}
 }
 
 func TestAlertRepository_Save_Duplicate(t *testing.T) {
@@ -163,6 +168,91 @@ func TestAlertRepository_FindByPagerDutyIncidentID(t *testing.T) {
 	}
 }
 
+func TestAlertRepository_ExternalReferenceRecords(t *testing.T) {
+	repo, cleanup := setupAlertRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	alert := entity.NewAlert("fp1", "TestAlert", "instance1", "target1", "Summary", entity.SeverityWarning)
+	alert.PutExternalReference(entity.ExternalReference{
+		System:      "slack",
+		ReferenceID: "C123:1.1",
+		Permalink:   "https://example.slack.com/archives/C123/p11",
+		Channel:     "C123",
+	})
+	alert.PutExternalReference(entity.ExternalReference{System: "slack", Key: "C456", ReferenceID: "C456:2.2", Channel: "C456"})
+
+	if err := repo.Save(ctx, alert); err != nil {
+		t.Fatalf("failed to save alert: %v", err)
+	}
+
+	// Found by a reference of any key
+	found, err := repo.FindByExternalReference(ctx, "slack", "C456:2.2")
+	if err != nil {
+		t.Fatalf("failed to find by external reference: %v", err)
+	}
+	if found == nil || found.ID != alert.ID {
+		t.Fatalf("expected alert %s, got %v", alert.ID, found)
+	}
+	refs := found.ExternalReferencesOf("slack")
+	if len(refs) != 2 {
+		t.Fatalf("expected 2 slack references, got %v", refs)
+	}
+	if refs[0].Permalink != "https://example.slack.com/archives/C123/p11" || refs[0].Channel != "C123" {
+		t.Errorf("unexpected primary reference %+v", refs[0])
+	}
+	if refs[1].Key != "C456" || refs[1].CreatedAt.IsZero() {
+		t.Errorf("unexpected channel reference %+v", refs[1])
+	}
+
+	if found, err := repo.FindByExternalReference(ctx, "pagerduty", "C456:2.2"); err != nil || found != nil {
+		t.Errorf("expected no alert for another system, got %v, %v", found, err)
+	}
+}
+
+func TestAlertRepository_ExternalReferenceMigration(t *testing.T) {
+	repo, cleanup := setupAlertRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	alert := entity.NewAlert("fp1", "TestAlert", "instance1", "target1", "Summary", entity.SeverityWarning)
+	if err := repo.Save(ctx, alert); err != nil {
+		t.Fatalf("failed to save alert: %v", err)
+	}
+
+	// Stored before migration 019
+	if _, err := repo.db.ExecContext(ctx,
+		`UPDATE alerts SET external_references = '{"slack":"C123:1.1","pagerduty":""}' WHERE id = ?`, alert.ID); err != nil {
+		t.Fatalf("failed to store legacy references: %v", err)
+	}
+	found, err := repo.FindByID(ctx, alert.ID)
+	if err != nil {
+		t.Fatalf("failed to find alert: %v", err)
+	}
+	if got := found.GetExternalReference("slack"); got != "C123:1.1" {
+		t.Errorf("expected legacy slack reference, got %q", got)
+	}
+
+	data, err := migrations.ReadFile("migrations/019_external_reference_records.sql")
+	if err != nil {
+		t.Fatalf("failed to read migration: %v", err)
+	}
+	if _, err := repo.db.ExecContext(ctx, string(data)); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+
+	found, err = repo.FindByExternalReference(ctx, "slack", "C123:1.1")
+	if err != nil {
+		t.Fatalf("failed to find by external reference: %v", err)
+	}
+	if found == nil {
+		t.Fatal("expected to find migrated alert, got nil")
+	}
+	if len(found.ExternalReferences) != 1 {
+		t.Errorf("expected empty references to be dropped, got %v", found.ExternalReferences)
+	}
+}
+
 func TestAlertRepository_Update(t *testing.T) {
 	repo, cleanup := setupAlertRepo(t)
 	defer cleanup()
@@ -195,6 +285,47 @@ func TestAlertRepository_Update(t *testing.T) {
 	}
 }
 
+func TestAlertRepository_Update_History(t *testing.T) {
+	repo, cleanup := setupAlertRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	alert := entity.NewAlert("fp1", "TestAlert", "instance1", "target1", "Summary", entity.SeverityWarning)
+
+	if err := repo.Save(ctx, alert); err != nil {
+		t.Fatalf("failed to save alert: %v", err)
+	}
+
+	escalatedAt := alert.FiredAt.Add(2 * time.Hour)
+	alert.ChangeSeverity(entity.SeverityCritical, escalatedAt)
+	alert.Acknowledge("user@example.com", escalatedAt.Add(time.Minute))
+
+	if err := repo.Update(ctx, alert); err != nil {
+		t.Fatalf("failed to update alert: %v", err)
+	}
+
+	found, err := repo.FindByID(ctx, alert.ID)
+	if err != nil {
+		t.Fatalf("failed to find alert: %v", err)
+	}
+	if len(found.History) != 2 {
+		t.Fatalf("expected 2 transitions, got %d", len(found.History))
+	}
+
+	escalation := found.History[0]
+	if escalation.PreviousSeverity != entity.SeverityWarning || escalation.Severity != entity.SeverityCritical {
+		t.Errorf("expected warning -> critical, got %s -> %s", escalation.PreviousSeverity, escalation.Severity)
+	}
+	if !escalation.At.Equal(escalatedAt) {
+		t.Errorf("expected escalation at %v, got %v", escalatedAt, escalation.At)
+	}
+
+	ack := found.History[1]
+	if ack.State != entity.StateAcked || ack.By != "user@example.com" {
+		t.Errorf("expected ack transition by user@example.com, got %s by %q", ack.State, ack.By)
+	}
+}
+
 func TestAlertRepository_Update_NotFound(t *testing.T) {
 	repo, cleanup := setupAlertRepo(t)
 	defer cleanup()
@@ -290,6 +421,31 @@ func TestAlertRepository_Delete(t *testing.T) {
 	if found != nil {
 		t.Error("expected alert to be deleted")
 	}
+	active, err := repo.FindActive(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(active) != 0 {
+		t.Errorf("expected deleted alert to be left out, got %d alerts", len(active))
+	}
+	if err := repo.Delete(ctx, alert.ID); err != entity.ErrAlertNotFound {
+		t.Errorf("expected ErrAlertNotFound deleting twice, got %v", err)
+	}
+
+	// Restore
+	if err := repo.Restore(ctx, alert.ID); err != nil {
+		t.Fatalf("failed to restore alert: %v", err)
+	}
+	found, err = repo.FindByID(ctx, alert.ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if found == nil {
+		t.Error("expected alert to be restored")
+	}
+	if err := repo.Restore(ctx, alert.ID); err != entity.ErrAlertNotFound {
+		t.Errorf("expected ErrAlertNotFound restoring a live alert, got %v", err)
+	}
 }
 
 func TestAlertRepository_Delete_NotFound(t *testing.T) {
@@ -303,6 +459,122 @@ func TestAlertRepository_Delete_NotFound(t *testing.T) {
 	}
 }
 
+func TestAlertRepository_DeleteResolvedBefore(t *testing.T) {
+	repo, cleanup := setupAlertRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	now := time.Now().UTC()
+	cutoff := now.Add(-24 * time.Hour)
+
+	old := entity.NewAlert("fp1", "Old", "instance1", "target1", "Summary", entity.SeverityWarning)
+	old.Resolve(now.Add(-48 * time.Hour))
+	deleted := entity.NewAlert("fp2", "Deleted", "instance2", "target2", "Summary", entity.SeverityWarning)
+	deleted.Resolve(now.Add(-48 * time.Hour))
+	recent := entity.NewAlert("fp3", "Recent", "instance3", "target3", "Summary", entity.SeverityWarning)
+	recent.Resolve(now.Add(-time.Hour))
+	firing := entity.NewAlert("fp4", "Firing", "instance4", "target4", "Summary", entity.SeverityWarning)
+	for _, a := range []*entity.Alert{old, deleted, recent, firing} {
+		if err := repo.Save(ctx, a); err != nil {
+			t.Fatalf("failed to save alert: %v", err)
+		}
+	}
+	if err := repo.Delete(ctx, deleted.ID); err != nil {
+		t.Fatalf("failed to delete alert: %v", err)
+	}
+
+	// Ack events and timelines go with their alert
+	acks := NewAckEventRepository(repo.db)
+	if err := acks.Save(ctx, entity.NewAckEvent(old.ID, entity.AckSourceSlack, "U1", "", "alice")); err != nil {
+		t.Fatalf("failed to save ack event: %v", err)
+	}
+	events := NewAlertEventRepository(repo.db)
+	if err := events.Save(ctx, entity.NewAlertEvent(old.ID, entity.AlertEventResolved, "", "")); err != nil {
+		t.Fatalf("failed to save alert event: %v", err)
+	}
+
+	resolved, err := repo.FindResolvedBefore(ctx, cutoff, 10)
+	if err != nil {
+		t.Fatalf("failed to find resolved alerts: %v", err)
+	}
+	if len(resolved) != 2 {
+		t.Errorf("expected 2 resolved alerts, got %d", len(resolved))
+	}
+	if limited, err := repo.FindResolvedBefore(ctx, cutoff, 1); err != nil || len(limited) != 1 {
+		t.Errorf("expected 1 resolved alert with limit 1, got %d, %v", len(limited), err)
+	}
+	for _, a := range resolved {
+		if a.ID != old.ID && a.ID != deleted.ID {
+			t.Errorf("unexpected resolved alert %s", a.Name)
+		}
+	}
+
+	count, err := repo.DeleteResolvedBefore(ctx, cutoff)
+	if err != nil {
+		t.Fatalf("failed to delete resolved alerts: %v", err)
+	}
+	if count != 2 {
+		t.Errorf("expected 2 deleted alerts, got %d", count)
+	}
+
+	for _, a := range []*entity.Alert{recent, firing} {
+		if found, err := repo.FindByID(ctx, a.ID); err != nil || found == nil {
+			t.Errorf("expected alert %s to be kept, got %v, %v", a.Name, found, err)
+		}
+	}
+	if found, _ := repo.FindByID(ctx, old.ID); found != nil {
+		t.Error("expected old alert to be deleted")
+	}
+	if err := repo.Restore(ctx, deleted.ID); err != entity.ErrAlertNotFound {
+		t.Errorf("expected soft-deleted alert to be purged, got %v", err)
+	}
+	if found, err := acks.FindByAlertID(ctx, old.ID); err != nil || len(found) != 0 {
+		t.Errorf("expected no ack events, got %v, %v", found, err)
+	}
+	if found, err := events.FindByAlertID(ctx, old.ID); err != nil || len(found) != 0 {
+		t.Errorf("expected no alert events, got %v, %v", found, err)
+	}
+}
+
+func TestAlertRepository_DeleteResolvedByIDs(t *testing.T) {
+	repo, cleanup := setupAlertRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	now := time.Now().UTC()
+	cutoff := now.Add(-24 * time.Hour)
+
+	old := entity.NewAlert("fp1", "Old", "instance1", "target1", "Summary", entity.SeverityWarning)
+	old.Resolve(now.Add(-48 * time.Hour))
+	unlisted := entity.NewAlert("fp2", "Unlisted", "instance2", "target2", "Summary", entity.SeverityWarning)
+	unlisted.Resolve(now.Add(-48 * time.Hour))
+	recent := entity.NewAlert("fp3", "Recent", "instance3", "target3", "Summary", entity.SeverityWarning)
+	recent.Resolve(now.Add(-time.Hour))
+	firing := entity.NewAlert("fp4", "Firing", "instance4", "target4", "Summary", entity.SeverityWarning)
+	for _, a := range []*entity.Alert{old, unlisted, recent, firing} {
+		if err := repo.Save(ctx, a); err != nil {
+			t.Fatalf("failed to save alert: %v", err)
+		}
+	}
+
+	// Only listed alerts still resolved before the cutoff are deleted
+	count, err := repo.DeleteResolvedByIDs(ctx, []string{old.ID, recent.ID, firing.ID}, cutoff)
+	if err != nil {
+		t.Fatalf("failed to delete resolved alerts: %v", err)
+	}
+	if count != 1 {
+		t.Errorf("expected 1 deleted alert, got %d", count)
+	}
+	if found, _ := repo.FindByID(ctx, old.ID); found != nil {
+		t.Error("expected old alert to be deleted")
+	}
+	for _, a := range []*entity.Alert{unlisted, recent, firing} {
+		if found, err := repo.FindByID(ctx, a.ID); err != nil || found == nil {
+			t.Errorf("expected alert %s to be kept, got %v, %v", a.Name, found, err)
+		}
+	}
+}
+
 func TestAlertRepository_EmptySliceNotNil(t *testing.T) {
 	repo, cleanup := setupAlertRepo(t)
 	defer cleanup()
@@ -337,3 +609,296 @@ func TestAlertRepository_EmptySliceNotNil(t *testing.T) {
 		t.Error("expected empty slice, got nil")
 	}
 }
+
+func TestAlertRepository_Update_Tags(t *testing.T) {
+	repo, cleanup := setupAlertRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	alert := entity.NewAlert("fp1", "TestAlert", "instance1", "target1", "Summary", entity.SeverityWarning)
+
+	if err := repo.Save(ctx, alert); err != nil {
+		t.Fatalf("failed to save alert: %v", err)
+	}
+
+	if err := alert.SetTags([]string{" Vendor-Issue", "network", "network"}, time.Now().UTC()); err != nil {
+		t.Fatalf("failed to set tags: %v", err)
+	}
+	if err := repo.Update(ctx, alert); err != nil {
+		t.Fatalf("failed to update alert: %v", err)
+	}
+
+	found, err := repo.FindByID(ctx, alert.ID)
+	if err != nil {
+		t.Fatalf("failed to find alert: %v", err)
+	}
+	if len(found.Tags) != 2 || found.Tags[0] != "network" || found.Tags[1] != "vendor-issue" {
+		t.Errorf("expected [network vendor-issue], got %v", found.Tags)
+	}
+	if !found.HasTag("NETWORK") {
+		t.Error("expected HasTag to be case-insensitive")
+	}
+}
+
+func TestAlertRepository_Update_Assignee(t *testing.T) {
+	repo, cleanup := setupAlertRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	alert := entity.NewAlert("fp1", "TestAlert", "instance1", "target1", "Summary", entity.SeverityWarning)
+
+	if err := repo.Save(ctx, alert); err != nil {
+		t.Fatalf("failed to save alert: %v", err)
+	}
+
+	if err := alert.Assign("alice", "U123", time.Now().UTC()); err != nil {
+		t.Fatalf("failed to assign alert: %v", err)
+	}
+	if err := repo.Update(ctx, alert); err != nil {
+		t.Fatalf("failed to update alert: %v", err)
+	}
+
+	found, err := repo.FindByID(ctx, alert.ID)
+	if err != nil {
+		t.Fatalf("failed to find alert: %v", err)
+	}
+	if found.AssignedTo != "alice" || found.AssigneeSlackID != "U123" || found.AssignedAt == nil {
+		t.Errorf("expected assignment to alice (U123), got %q (%q) at %v", found.AssignedTo, found.AssigneeSlackID, found.AssignedAt)
+	}
+	if found.State != entity.StateActive {
+		t.Errorf("expected assignment to leave the alert active, got %s", found.State)
+	}
+
+	found.Resolve(time.Now().UTC())
+	if err := found.Assign("bob", "", time.Now().UTC()); err != entity.ErrAlertAlreadyResolved {
+		t.Errorf("expected ErrAlertAlreadyResolved, got %v", err)
+	}
+}
+
+func TestAlertRepository_FindChangedSince(t *testing.T) {
+	repo, cleanup := setupAlertRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	now := time.Now().UTC()
+	since := now.Add(-24 * time.Hour)
+
+	// Still firing, fired long ago
+	open := entity.NewAlert("fp1", "Open", "instance1", "target1", "Summary", entity.SeverityWarning)
+	open.FiredAt = now.Add(-72 * time.Hour)
+
+	// Fired and resolved before the window
+	old := entity.NewAlert("fp2", "Old", "instance1", "target1", "Summary", entity.SeverityWarning)
+	old.FiredAt = now.Add(-72 * time.Hour)
+
+	// Fired before the window, resolved inside it
+	recent := entity.NewAlert("fp3", "Recent", "instance1", "target1", "Summary", entity.SeverityWarning)
+	recent.FiredAt = now.Add(-48 * time.Hour)
+
+	for _, a := range []*entity.Alert{open, old, recent} {
+		if err := repo.Save(ctx, a); err != nil {
+			t.Fatalf("failed to save alert: %v", err)
+		}
+	}
+
+	old.Resolve(now.Add(-48 * time.Hour))
+	recent.Resolve(now.Add(-time.Hour))
+	for _, a := range []*entity.Alert{old, recent} {
+		if err := repo.Update(ctx, a); err != nil {
+			t.Fatalf("failed to update alert: %v", err)
+		}
+	}
+
+	changed, err := repo.FindChangedSince(ctx, since)
+	if err != nil {
+		t.Fatalf("failed to find changed alerts: %v", err)
+	}
+
+	ids := make(map[string]bool)
+	for _, a := range changed {
+		ids[a.ID] = true
+	}
+	if len(changed) != 2 || !ids[open.ID] || !ids[recent.ID] {
+		t.Errorf("expected open and recently resolved alerts, got %d alerts", len(changed))
+	}
+}
+
+func TestAlertRepository_FindActiveAt(t *testing.T) {
+	repo, cleanup := setupAlertRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	now := time.Now().UTC()
+	at := now.Add(-24 * time.Hour)
+
+	// Fired before at, still firing
+	open := entity.NewAlert("fp1", "Open", "instance1", "target1", "Summary", entity.SeverityWarning)
+	open.FiredAt = now.Add(-72 * time.Hour)
+
+	// Fired before at, resolved before at
+	old := entity.NewAlert("fp2", "Old", "instance1", "target1", "Summary", entity.SeverityWarning)
+	old.FiredAt = now.Add(-72 * time.Hour)
+
+	// Fired before at, resolved after it
+	spanning := entity.NewAlert("fp3", "Spanning", "instance1", "target1", "Summary", entity.SeverityWarning)
+	spanning.FiredAt = now.Add(-48 * time.Hour)
+
+	// Fired after at
+	later := entity.NewAlert("fp4", "Later", "instance1", "target1", "Summary", entity.SeverityWarning)
+	later.FiredAt = now.Add(-time.Hour)
+
+	for _, a := range []*entity.Alert{open, old, spanning, later} {
+		if err := repo.Save(ctx, a); err != nil {
+			t.Fatalf("failed to save alert: %v", err)
+		}
+	}
+
+	old.Resolve(now.Add(-48 * time.Hour))
+	spanning.Resolve(now.Add(-time.Hour))
+	for _, a := range []*entity.Alert{old, spanning} {
+		if err := repo.Update(ctx, a); err != nil {
+			t.Fatalf("failed to update alert: %v", err)
+		}
+	}
+
+	active, err := repo.FindActiveAt(ctx, at)
+	if err != nil {
+		t.Fatalf("failed to find active alerts: %v", err)
+	}
+
+	ids := make(map[string]bool)
+	for _, a := range active {
+		ids[a.ID] = true
+	}
+	if len(active) != 2 || !ids[open.ID] || !ids[spanning.ID] {
+		t.Errorf("expected open and spanning alerts, got %d alerts", len(active))
+	}
+}
+
+func TestAlertRepository_Search(t *testing.T) {
+	repo, cleanup := setupAlertRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	cpu := entity.NewAlert("fp1", "HighCPU", "validator-1", "target1", "CPU above 90%", entity.SeverityCritical)
+	cpu.AddLabel("chain", "axelar")
+	cpu.CustomFields = map[string]string{"customer": "acme"}
+	cpu.FiredAt = now.Add(-3 * time.Hour)
+
+	disk := entity.NewAlert("fp2", "DiskFull", "validator-2", "target1", "Disk 100_percent full", entity.SeverityCritical)
+	disk.AddLabel("chain", "axelar")
+	disk.FiredAt = now.Add(-2 * time.Hour)
+
+	osmosis := entity.NewAlert("fp3", "HighCPU", "validator-3", "target1", "CPU above 90%", entity.SeverityCritical)
+	osmosis.AddLabel("chain", "osmosis")
+	osmosis.FiredAt = now.Add(-time.Hour)
+
+	resolved := entity.NewAlert("fp4", "HighCPU", "validator-1", "target1", "CPU above 90%", entity.SeverityWarning)
+	resolved.AddLabel("chain", "axelar")
+	resolved.FiredAt = now.Add(-4 * time.Hour)
+
+	for _, a := range []*entity.Alert{cpu, disk, osmosis, resolved} {
+		if err := repo.Save(ctx, a); err != nil {
+			t.Fatalf("failed to save alert: %v", err)
+		}
+	}
+	resolved.Resolve(now)
+	if err := repo.Update(ctx, resolved); err != nil {
+		t.Fatalf("failed to update alert: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		query entity.AlertQuery
+		want  []string
+		total int
+	}{
+		{
+			name:  "state and severity",
+			query: entity.AlertQuery{States: []entity.AlertState{entity.StateActive}, Severity: entity.SeverityCritical},
+			want:  []string{osmosis.ID, disk.ID, cpu.ID},
+			total: 3,
+		},
+		{
+			name:  "label and text",
+			query: entity.AlertQuery{Labels: map[string]string{"chain": "axelar"}, Text: "cpu"},
+			want:  []string{cpu.ID, resolved.ID},
+			total: 2,
+		},
+		{
+			name:  "custom field",
+			query: entity.AlertQuery{Fields: map[string]string{"customer": "acme"}},
+			want:  []string{cpu.ID},
+			total: 1,
+		},
+		{
+			name:  "resolved since",
+			query: entity.AlertQuery{ResolvedSince: now.Add(time.Minute)},
+			want:  []string{osmosis.ID, disk.ID, cpu.ID},
+			total: 3,
+		},
+		{
+			name:  "text with wildcard characters",
+			query: entity.AlertQuery{Text: "100_PERCENT"},
+			want:  []string{disk.ID},
+			total: 1,
+		},
+		{
+			name:  "page",
+			query: entity.AlertQuery{Offset: 1, Limit: 2},
+			want:  []string{disk.ID, cpu.ID},
+			total: 4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			alerts, total, err := repo.Search(ctx, tt.query)
+			if err != nil {
+				t.Fatalf("failed to search alerts: %v", err)
+			}
+			if total != tt.total {
+				t.Errorf("expected %d matches, got %d", tt.total, total)
+			}
+			var ids []string
+			for _, a := range alerts {
+				ids = append(ids, a.ID)
+			}
+			if strings.Join(ids, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("expected alerts %v, got %v", tt.want, ids)
+			}
+		})
+	}
+}
+
+func TestAlertRepository_Update_Notes(t *testing.T) {
+	repo, cleanup := setupAlertRepo(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	alert := entity.NewAlert("fp1", "TestAlert", "instance1", "target1", "Summary", entity.SeverityWarning)
+
+	if err := repo.Save(ctx, alert); err != nil {
+		t.Fatalf("failed to save alert: %v", err)
+	}
+
+	if err := alert.AddNote("alice", "  restarted the pod ", time.Now().UTC()); err != nil {
+		t.Fatalf("failed to add note: %v", err)
+	}
+	if err := alert.AddNote("alice", " ", time.Now().UTC()); err == nil {
+		t.Error("expected error for empty note")
+	}
+	if err := repo.Update(ctx, alert); err != nil {
+		t.Fatalf("failed to update alert: %v", err)
+	}
+
+	found, err := repo.FindByID(ctx, alert.ID)
+	if err != nil {
+		t.Fatalf("failed to find alert: %v", err)
+	}
+	if len(found.Notes) != 1 || found.Notes[0].By != "alice" || found.Notes[0].Text != "restarted the pod" {
+		t.Errorf("expected one note from alice, got %+v", found.Notes)
+	}
+}
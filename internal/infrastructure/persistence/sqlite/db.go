@@ -6,10 +6,13 @@ import (
 	"embed"
 	"fmt"
 	"path/filepath"
+	"strconv"
+	"strings"
 
 	_ "modernc.org/sqlite"
 
 	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/fieldcrypt"
 )
 
 //go:embed migrations/*.sql
@@ -19,6 +22,12 @@ var migrations embed.FS
 type DB struct {
 	*sql.DB
 	path string
+
+	// reader runs list queries when the read pool is open.
+	reader *sql.DB
+
+	// cipher encrypts note fields; nil stores them as they are.
+	cipher *fieldcrypt.Cipher
 }
 
 // NewDB creates a new SQLite database connection.
@@ -57,7 +66,41 @@ func NewDB(path string) (*DB, error) {
 	return &DB{DB: db, path: path}, nil
 }
 
+// OpenReadPool opens a pool of up to size read-only connections for list
+// queries, such as alert searches from the API. WAL lets them run while
+// the single write connection is busy, instead of queuing behind writes.
+// Writes, transactions and lookups of single rows, which usually precede
+// an update, stay on the write connection.
+func (db *DB) OpenReadPool(size int) error {
+	if db.path == ":memory:" {
+		return fmt.Errorf("read pool requires a database file")
+	}
+
+	dsn := fmt.Sprintf("file:%s?_pragma=busy_timeout(5000)&_pragma=query_only(ON)", db.path)
+	reader, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return fmt.Errorf("open read pool: %w", err)
+	}
+	reader.SetMaxOpenConns(size)
+	reader.SetMaxIdleConns(size)
+
+	if err := reader.Ping(); err != nil {
+		reader.Close()
+		return fmt.Errorf("ping read pool: %w", err)
+	}
+
+	db.reader = reader
+	return nil
+}
+
+// SetFieldCipher encrypts ack notes, alert notes and alert event details
+// with the cipher. Values stored before remain readable.
+func (db *DB) SetFieldCipher(cipher *fieldcrypt.Cipher) {
+	db.cipher = cipher
+}
+
 // Migrate runs all pending database migrations.
+// Migration files are named NNN_description.sql and applied in version order.
 func (db *DB) Migrate(ctx context.Context) error {
 	// Check current schema version
 	var currentVersion int
@@ -67,28 +110,68 @@ func (db *DB) Migrate(ctx context.Context) error {
 		currentVersion = 0
 	}
 
-	// Read and execute migration SQL
-	data, err := migrations.ReadFile("migrations/001_initial.sql")
+	entries, err := migrations.ReadDir("migrations")
 	if err != nil {
-		return fmt.Errorf("read migration: %w", err)
+		return fmt.Errorf("read migrations: %w", err)
 	}
 
-	// Only run if not already applied
-	if currentVersion < 1 {
-		_, err = db.ExecContext(ctx, string(data))
+	// ReadDir returns entries sorted by filename, which matches version order
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".sql") {
+			continue
+		}
+
+		prefix, _, ok := strings.Cut(name, "_")
+		if !ok {
+			return fmt.Errorf("invalid migration filename: %s", name)
+		}
+		version, err := strconv.Atoi(prefix)
+		if err != nil {
+			return fmt.Errorf("parse migration version %s: %w", name, err)
+		}
+
+		// Only run if not already applied
+		if version <= currentVersion {
+			continue
+		}
+
+		data, err := migrations.ReadFile("migrations/" + name)
 		if err != nil {
-			return fmt.Errorf("execute migration: %w", err)
+			return fmt.Errorf("read migration %s: %w", name, err)
+		}
+
+		if _, err := db.ExecContext(ctx, string(data)); err != nil {
+			return fmt.Errorf("execute migration %s: %w", name, err)
 		}
 	}
 
 	return nil
 }
 
+// Checkpoint writes the WAL back into the database file and truncates it,
+// so the file is complete on its own, such as for a backup after shutdown.
+// It does nothing for in-memory databases.
+func (db *DB) Checkpoint(ctx context.Context) error {
+	if db.path == ":memory:" {
+		return nil
+	}
+	var busy, logFrames, checkpointed int
+	if err := db.QueryRowContext(ctx, "PRAGMA wal_checkpoint(TRUNCATE)").Scan(&busy, &logFrames, &checkpointed); err != nil {
+		return fmt.Errorf("wal checkpoint: %w", err)
+	}
+	if busy != 0 {
+		return fmt.Errorf("wal checkpoint: database busy, %d of %d frames checkpointed", checkpointed, logFrames)
+	}
+	return nil
+}
+
 // Close closes the database connection with proper cleanup.
 func (db *DB) Close() error {
-	// Force WAL checkpoint before close (only for file-based databases)
-	if db.path != ":memory:" {
-		_, _ = db.Exec("PRAGMA wal_checkpoint(TRUNCATE)")
+	// Force WAL checkpoint before close
+	_ = db.Checkpoint(context.Background())
+	if db.reader != nil {
+		_ = db.reader.Close()
 	}
 	return db.DB.Close()
 }
@@ -166,3 +249,16 @@ func (db *DB) getExecutor(ctx context.Context) interface {
 	}
 	return db.DB
 }
+
+// getReader returns the executor of list queries: the transaction in
+// context, if any, so that it sees its own writes, otherwise the read pool
+// when open.
+func (db *DB) getReader(ctx context.Context) interface {
+	QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error)
+	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
+} {
+	if db.reader == nil || repository.TxFromContext(ctx) != nil {
+		return db.getExecutor(ctx)
+	}
+	return db.reader
+}
@@ -4,7 +4,13 @@ import (
 	"context"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/fieldcrypt"
 )
 
 func TestNewDB_InMemory(t *testing.T) {
@@ -69,8 +75,8 @@ func TestDB_Migrate(t *testing.T) {
 	if err != nil {
 		t.Fatalf("failed to query schema version: %v", err)
 	}
-	if version != 1 {
-		t.Errorf("expected schema version 1, got %d", version)
+	if want := latestMigrationVersion(t); version != want {
+		t.Errorf("expected schema version %d, got %d", want, version)
 	}
 }
 
@@ -91,17 +97,36 @@ func TestDB_MigrateIdempotent(t *testing.T) {
 		t.Fatalf("failed to run second migration: %v", err)
 	}
 
-	// Verify schema version is still 1
+	// Verify schema version is unchanged
 	var version int
 	err = db.QueryRowContext(ctx, "SELECT MAX(version) FROM schema_version").Scan(&version)
 	if err != nil {
 		t.Fatalf("failed to query schema version: %v", err)
 	}
-	if version != 1 {
-		t.Errorf("expected schema version 1, got %d", version)
+	if want := latestMigrationVersion(t); version != want {
+		t.Errorf("expected schema version %d, got %d", want, version)
 	}
 }
 
+// latestMigrationVersion returns the version of the newest embedded migration.
+func latestMigrationVersion(t *testing.T) int {
+	t.Helper()
+
+	entries, err := migrations.ReadDir("migrations")
+	if err != nil {
+		t.Fatalf("failed to read migrations: %v", err)
+	}
+
+	latest := 0
+	for _, entry := range entries {
+		prefix, _, _ := strings.Cut(entry.Name(), "_")
+		if v, err := strconv.Atoi(prefix); err == nil && v > latest {
+			latest = v
+		}
+	}
+	return latest
+}
+
 func TestDB_Ping(t *testing.T) {
 	db, err := NewDB(":memory:")
 	if err != nil {
@@ -132,6 +157,32 @@ func TestDB_Close(t *testing.T) {
 	}
 }
 
+func TestDB_Checkpoint(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "test.db")
+	db, err := NewDB(dbPath)
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+	if err := db.Migrate(ctx); err != nil {
+		t.Fatalf("migration failed: %v", err)
+	}
+	if err := db.Checkpoint(ctx); err != nil {
+		t.Fatalf("checkpoint failed: %v", err)
+	}
+
+	// TRUNCATE empties the WAL file
+	info, err := os.Stat(dbPath + "-wal")
+	if err != nil {
+		t.Fatalf("stat wal: %v", err)
+	}
+	if info.Size() != 0 {
+		t.Errorf("expected empty WAL after checkpoint, got %d bytes", info.Size())
+	}
+}
+
 func TestDB_ForeignKeysEnabled(t *testing.T) {
 	db, err := NewDB(":memory:")
 	if err != nil {
@@ -156,3 +207,154 @@ func TestDB_ForeignKeysEnabled(t *testing.T) {
 		t.Error("expected foreign key constraint error, got nil")
 	}
 }
+
+func TestDB_ReadPool(t *testing.T) {
+	db, err := NewDB(filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+	if err := db.Migrate(ctx); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	if err := db.OpenReadPool(2); err != nil {
+		t.Fatalf("failed to open read pool: %v", err)
+	}
+
+	repo := NewAlertRepository(db)
+	if err := repo.Save(ctx, entity.NewAlert("fp-1", "HighCPU", "web-1", "", "summary", entity.SeverityCritical)); err != nil {
+		t.Fatalf("failed to save alert: %v", err)
+	}
+
+	err = db.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := repo.Save(txCtx, entity.NewAlert("fp-2", "HighMemory", "web-2", "", "summary", entity.SeverityWarning)); err != nil {
+			return err
+		}
+
+		// The transaction sees its own writes
+		alerts, err := repo.FindActive(txCtx)
+		if err != nil {
+			return err
+		}
+		if len(alerts) != 2 {
+			t.Errorf("expected 2 alerts in transaction, got %d", len(alerts))
+		}
+
+		// Other reads do not wait for the write connection, and see
+		// committed data only
+		readCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
+		defer cancel()
+		alerts, err = repo.FindActive(readCtx)
+		if err != nil {
+			return err
+		}
+		if len(alerts) != 1 {
+			t.Errorf("expected 1 committed alert, got %d", len(alerts))
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("transaction failed: %v", err)
+	}
+
+	// The read pool cannot write
+	if _, err := db.reader.ExecContext(ctx, "DELETE FROM alerts"); err == nil {
+		t.Error("expected write through read pool to fail")
+	}
+}
+
+func TestDB_ReadPool_InMemory(t *testing.T) {
+	db, err := NewDB(":memory:")
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+	defer db.Close()
+
+	if err := db.OpenReadPool(2); err == nil {
+		t.Error("expected read pool of in-memory database to fail")
+	}
+}
+
+func TestDB_FieldCipher(t *testing.T) {
+	db, err := NewDB(":memory:")
+	if err != nil {
+		t.Fatalf("failed to create database: %v", err)
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+	if err := db.Migrate(ctx); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+
+	// Written before encryption was enabled
+	repos := NewRepositories(db)
+	alert := entity.NewAlert("fp-1", "HighCPU", "web-1", "", "summary", entity.SeverityCritical)
+	if err := alert.AddNote("alice", "plain note", time.Now()); err != nil {
+		t.Fatalf("failed to add note: %v", err)
+	}
+	if err := repos.Alert.Save(ctx, alert); err != nil {
+		t.Fatalf("failed to save alert: %v", err)
+	}
+
+	cipher, err := fieldcrypt.NewCipher("MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY=")
+	if err != nil {
+		t.Fatalf("failed to create cipher: %v", err)
+	}
+	db.SetFieldCipher(cipher)
+
+	if err := alert.AddNote("bob", "failover to db-2, see INC-42", time.Now()); err != nil {
+		t.Fatalf("failed to add note: %v", err)
+	}
+	if err := repos.Alert.Update(ctx, alert); err != nil {
+		t.Fatalf("failed to update alert: %v", err)
+	}
+	ack := entity.NewAckEvent(alert.ID, entity.AckSourceSlack, "U1", "bob@example.com", "bob").WithNote("INC-42")
+	if err := repos.AckEvent.Save(ctx, ack); err != nil {
+		t.Fatalf("failed to save ack event: %v", err)
+	}
+	if err := repos.AlertEvent.Save(ctx, entity.NewAlertEvent(alert.ID, entity.AlertEventNoteAdded, "bob", "INC-42")); err != nil {
+		t.Fatalf("failed to save alert event: %v", err)
+	}
+
+	// Nothing is stored in the clear
+	var notes, ackNote, detail string
+	if err := db.QueryRowContext(ctx, "SELECT notes FROM alerts").Scan(&notes); err != nil {
+		t.Fatalf("failed to read notes: %v", err)
+	}
+	if err := db.QueryRowContext(ctx, "SELECT note FROM ack_events").Scan(&ackNote); err != nil {
+		t.Fatalf("failed to read ack note: %v", err)
+	}
+	if err := db.QueryRowContext(ctx, "SELECT detail FROM alert_events").Scan(&detail); err != nil {
+		t.Fatalf("failed to read event detail: %v", err)
+	}
+	for _, stored := range []string{notes, ackNote, detail} {
+		if strings.Contains(stored, "INC-42") || strings.Contains(stored, "plain note") {
+			t.Errorf("expected encrypted value, got %q", stored)
+		}
+	}
+
+	found, err := repos.Alert.FindByID(ctx, alert.ID)
+	if err != nil {
+		t.Fatalf("failed to find alert: %v", err)
+	}
+	if len(found.Notes) != 2 || found.Notes[0].Text != "plain note" || found.Notes[1].Text != "failover to db-2, see INC-42" {
+		t.Errorf("unexpected notes: %+v", found.Notes)
+	}
+	acks, err := repos.AckEvent.FindByAlertID(ctx, alert.ID)
+	if err != nil || len(acks) != 1 || acks[0].Note != "INC-42" {
+		t.Errorf("unexpected ack events: %+v, %v", acks, err)
+	}
+	events, err := repos.AlertEvent.FindByAlertID(ctx, alert.ID)
+	if err != nil || len(events) != 1 || events[0].Detail != "INC-42" {
+		t.Errorf("unexpected alert events: %+v, %v", events, err)
+	}
+
+	// Encrypted values need the key
+	db.SetFieldCipher(nil)
+	if _, err := repos.Alert.FindByID(ctx, alert.ID); err == nil {
+		t.Error("expected reading encrypted notes without a key to fail")
+	}
+}
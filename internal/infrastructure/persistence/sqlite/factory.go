@@ -2,9 +2,12 @@ package sqlite
 
 // Repositories holds all SQLite repository implementations.
 type Repositories struct {
-	Alert    *AlertRepository
-	AckEvent *AckEventRepository
-	Silence  *SilenceRepository
+	Alert      *AlertRepository
+	AckEvent   *AckEventRepository
+	AlertEvent *AlertEventRepository
+	Silence    *SilenceRepository
+	SavedView  *SavedViewRepository
+	Retry      *NotificationRetryRepository
 }
 
 // NewRepositories creates all SQLite repositories with a shared database connection.
@@ -12,8 +15,11 @@ type Repositories struct {
 // and connection pooling.
 func NewRepositories(db *DB) *Repositories {
 	return &Repositories{
-		Alert:    NewAlertRepository(db),
-		AckEvent: NewAckEventRepository(db),
-		Silence:  NewSilenceRepository(db),
+		Alert:      NewAlertRepository(db),
+		AckEvent:   NewAckEventRepository(db),
+		AlertEvent: NewAlertEventRepository(db),
+		Silence:    NewSilenceRepository(db),
+		SavedView:  NewSavedViewRepository(db),
+		Retry:      NewNotificationRetryRepository(db),
 	}
 }
@@ -5,6 +5,9 @@ import (
 	"encoding/json"
 	"strings"
 	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/fieldcrypt"
 )
 
 // nullString converts a string to sql.NullString.
@@ -42,6 +45,14 @@ func nullTime(t *time.Time) sql.NullString {
 	return sql.NullString{String: t.UTC().Format(time.RFC3339), Valid: true}
 }
 
+// nullTimeValue converts a time to sql.NullString, NULL for the zero time.
+func nullTimeValue(t time.Time) sql.NullString {
+	if t.IsZero() {
+		return sql.NullString{Valid: false}
+	}
+	return nullTime(&t)
+}
+
 // timeToString converts time.Time to RFC3339 string.
 func timeToString(t time.Time) string {
 	return t.UTC().Format(time.RFC3339)
@@ -92,6 +103,151 @@ func unmarshalJSON(s string) (map[string]string, error) {
 	return m, nil
 }
 
+// marshalTags converts alert tags to a JSON array for storage.
+func marshalTags(tags []string) (string, error) {
+	if len(tags) == 0 {
+		return "[]", nil
+	}
+	data, err := json.Marshal(tags)
+	if err != nil {
+		return "[]", err
+	}
+	return string(data), nil
+}
+
+// unmarshalTags converts a stored JSON array back to tags.
+func unmarshalTags(s string) ([]string, error) {
+	if s == "" || s == "[]" {
+		return nil, nil
+	}
+	var tags []string
+	if err := json.Unmarshal([]byte(s), &tags); err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// marshalExternalReferences converts alert external references to their
+// versioned JSON form for storage.
+func marshalExternalReferences(refs entity.ExternalReferences) (string, error) {
+	data, err := json.Marshal(refs)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// unmarshalExternalReferences converts stored external references back,
+// including the flat map written before migration 019.
+func unmarshalExternalReferences(s string) (entity.ExternalReferences, error) {
+	if s == "" || s == "{}" {
+		return nil, nil
+	}
+	var refs entity.ExternalReferences
+	if err := json.Unmarshal([]byte(s), &refs); err != nil {
+		return nil, err
+	}
+	return refs, nil
+}
+
+// transitionRecord is the JSON storage form of entity.AlertTransition.
+type transitionRecord struct {
+	At               time.Time `json:"at"`
+	PreviousSeverity string    `json:"previous_severity"`
+	Severity         string    `json:"severity"`
+	PreviousState    string    `json:"previous_state"`
+	State            string    `json:"state"`
+	By               string    `json:"by,omitempty"`
+}
+
+// marshalHistory converts alert transitions to a JSON array for storage.
+func marshalHistory(history []entity.AlertTransition) (string, error) {
+	records := make([]transitionRecord, len(history))
+	for i, t := range history {
+		records[i] = transitionRecord{
+			At:               t.At.UTC(),
+			PreviousSeverity: string(t.PreviousSeverity),
+			Severity:         string(t.Severity),
+			PreviousState:    string(t.PreviousState),
+			State:            string(t.State),
+			By:               t.By,
+		}
+	}
+	data, err := json.Marshal(records)
+	if err != nil {
+		return "[]", err
+	}
+	return string(data), nil
+}
+
+// unmarshalHistory converts a stored JSON array back to alert transitions.
+func unmarshalHistory(s string) ([]entity.AlertTransition, error) {
+	if s == "" || s == "[]" {
+		return nil, nil
+	}
+	var records []transitionRecord
+	if err := json.Unmarshal([]byte(s), &records); err != nil {
+		return nil, err
+	}
+	history := make([]entity.AlertTransition, len(records))
+	for i, r := range records {
+		history[i] = entity.AlertTransition{
+			At:               r.At,
+			PreviousSeverity: entity.AlertSeverity(r.PreviousSeverity),
+			Severity:         entity.AlertSeverity(r.Severity),
+			PreviousState:    entity.AlertState(r.PreviousState),
+			State:            entity.AlertState(r.State),
+			By:               r.By,
+		}
+	}
+	return history, nil
+}
+
+// noteRecord is the JSON storage form of entity.AlertNote.
+type noteRecord struct {
+	At   time.Time `json:"at"`
+	By   string    `json:"by,omitempty"`
+	Text string    `json:"text"`
+}
+
+// marshalNotes converts alert notes to a JSON array for storage, with
+// their text encrypted by the cipher.
+func marshalNotes(notes []entity.AlertNote, cipher *fieldcrypt.Cipher) (string, error) {
+	records := make([]noteRecord, len(notes))
+	for i, n := range notes {
+		text, err := cipher.Encrypt(n.Text)
+		if err != nil {
+			return "[]", err
+		}
+		records[i] = noteRecord{At: n.At.UTC(), By: n.By, Text: text}
+	}
+	data, err := json.Marshal(records)
+	if err != nil {
+		return "[]", err
+	}
+	return string(data), nil
+}
+
+// unmarshalNotes converts a stored JSON array back to alert notes.
+func unmarshalNotes(s string, cipher *fieldcrypt.Cipher) ([]entity.AlertNote, error) {
+	if s == "" || s == "[]" {
+		return nil, nil
+	}
+	var records []noteRecord
+	if err := json.Unmarshal([]byte(s), &records); err != nil {
+		return nil, err
+	}
+	notes := make([]entity.AlertNote, len(records))
+	for i, r := range records {
+		text, err := cipher.Decrypt(r.Text)
+		if err != nil {
+			return nil, err
+		}
+		notes[i] = entity.AlertNote{At: r.At, By: r.By, Text: text}
+	}
+	return notes, nil
+}
+
 // isUniqueConstraintError checks if the error is a SQLite unique constraint violation.
 func isUniqueConstraintError(err error) bool {
 	if err == nil {
@@ -140,3 +296,9 @@ func secondsToDuration(ni sql.NullInt64) *time.Duration {
 	d := time.Duration(ni.Int64) * time.Second
 	return &d
 }
+
+// likePattern matches text anywhere in a LIKE ... ESCAPE '!' comparison.
+func likePattern(text string) string {
+	text = strings.NewReplacer("!", "!!", "%", "!%", "_", "!_").Replace(text)
+	return "%" + text + "%"
+}
@@ -211,8 +211,9 @@ func TestForeignKeyConstraints(t *testing.T) {
 	assert.Contains(t, err.Error(), "alert not found")
 }
 
-// TestCascadeDelete verifies cascade delete behavior.
-func TestCascadeDelete(t *testing.T) {
+// TestSoftDeleteKeepsAckEvents verifies that deleting an alert keeps its ack
+// events for a restore.
+func TestSoftDeleteKeepsAckEvents(t *testing.T) {
 	db, err := NewDB(":memory:")
 	require.NoError(t, err)
 	defer db.Close()
@@ -263,8 +264,8 @@ func TestCascadeDelete(t *testing.T) {
 	err = alertRepo.Delete(context.Background(), alert.ID)
 	require.NoError(t, err)
 
-	// Verify ack events were cascade deleted
+	// Verify ack events are kept
 	ackEvents, err = ackRepo.FindByAlertID(context.Background(), alert.ID)
 	require.NoError(t, err)
-	assert.Empty(t, ackEvents)
+	assert.Len(t, ackEvents, 3)
 }
@@ -0,0 +1,14 @@
+-- SQLite Schema Migration: Alert Group Key
+-- Version: 4
+-- Date: 2026-10-15
+-- Description: Track the Alertmanager groupKey each alert was received in
+
+ALTER TABLE alerts ADD COLUMN group_key TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS idx_alerts_group_key
+    ON alerts(group_key)
+    WHERE group_key != '';
+
+-- Insert version 4
+INSERT OR IGNORE INTO schema_version (version, applied_at)
+VALUES (4, datetime('now'));
@@ -0,0 +1,10 @@
+-- SQLite Schema Migration: Alert History
+-- Version: 5
+-- Date: 2026-10-15
+-- Description: Store severity and state transitions of each alert as a JSON array
+
+ALTER TABLE alerts ADD COLUMN history TEXT NOT NULL DEFAULT '[]';
+
+-- Insert version 5
+INSERT OR IGNORE INTO schema_version (version, applied_at)
+VALUES (5, datetime('now'));
@@ -0,0 +1,10 @@
+-- SQLite Schema Migration: Alert Custom Fields
+-- Version: 6
+-- Date: 2026-10-15
+-- Description: Store typed custom fields extracted by per-tenant schemas
+
+ALTER TABLE alerts ADD COLUMN custom_fields TEXT NOT NULL DEFAULT '{}';
+
+-- Insert version 6
+INSERT OR IGNORE INTO schema_version (version, applied_at)
+VALUES (6, datetime('now'));
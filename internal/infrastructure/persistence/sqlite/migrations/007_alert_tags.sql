@@ -0,0 +1,10 @@
+-- SQLite Schema Migration: Alert Tags
+-- Version: 7
+-- Date: 2026-10-15
+-- Description: Store responder-assigned tags as a JSON array
+
+ALTER TABLE alerts ADD COLUMN tags TEXT NOT NULL DEFAULT '[]';
+
+-- Insert version 7
+INSERT OR IGNORE INTO schema_version (version, applied_at)
+VALUES (7, datetime('now'));
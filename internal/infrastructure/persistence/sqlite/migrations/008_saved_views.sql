@@ -0,0 +1,25 @@
+-- SQLite Schema Migration: Saved Views
+-- Version: 8
+-- Date: 2026-10-15
+-- Description: Named alert filters, personal (owner set) or shared (owner empty)
+
+CREATE TABLE IF NOT EXISTS saved_views (
+    id TEXT PRIMARY KEY NOT NULL,
+    name TEXT NOT NULL,
+    owner TEXT NOT NULL DEFAULT '',
+
+    -- Criteria
+    labels TEXT NOT NULL DEFAULT '{}',
+    severity TEXT NOT NULL DEFAULT '',
+    state TEXT NOT NULL DEFAULT '',
+
+    created_by TEXT NOT NULL DEFAULT '',
+    created_at TEXT NOT NULL,
+    updated_at TEXT NOT NULL,
+
+    UNIQUE (owner, name)
+);
+
+-- Insert version 8
+INSERT OR IGNORE INTO schema_version (version, applied_at)
+VALUES (8, datetime('now'));
@@ -0,0 +1,10 @@
+-- SQLite Schema Migration: Alert Notes
+-- Version: 9
+-- Date: 2026-10-15
+-- Description: Store responder notes as a JSON array
+
+ALTER TABLE alerts ADD COLUMN notes TEXT NOT NULL DEFAULT '[]';
+
+-- Insert version 9
+INSERT OR IGNORE INTO schema_version (version, applied_at)
+VALUES (9, datetime('now'));
@@ -0,0 +1,26 @@
+-- SQLite Schema Migration: Notification Retries
+-- Version: 10
+-- Date: 2026-10-15
+-- Description: Failed notifications queued for retry, and dead-lettered ones
+
+CREATE TABLE IF NOT EXISTS notification_retries (
+    id TEXT PRIMARY KEY NOT NULL,
+    alert_id TEXT NOT NULL,
+    notifier TEXT NOT NULL,
+    action TEXT NOT NULL CHECK(action IN ('notify', 'update')),
+    status TEXT NOT NULL DEFAULT 'pending' CHECK(status IN ('pending', 'dead')),
+
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+
+    next_attempt_at TEXT NOT NULL,
+    created_at TEXT NOT NULL,
+    updated_at TEXT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_notification_retries_due
+    ON notification_retries(status, next_attempt_at);
+
+-- Insert version 10
+INSERT OR IGNORE INTO schema_version (version, applied_at)
+VALUES (10, datetime('now'));
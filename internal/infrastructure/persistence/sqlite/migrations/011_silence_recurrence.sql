@@ -0,0 +1,10 @@
+-- SQLite Schema Migration: Silence Recurrence
+-- Version: 11
+-- Date: 2026-10-15
+-- Description: Repeating windows of recurring silences, in RRULE-like form
+
+ALTER TABLE silences ADD COLUMN recurrence TEXT;
+
+-- Insert version 11
+INSERT OR IGNORE INTO schema_version (version, applied_at)
+VALUES (11, datetime('now'));
@@ -0,0 +1,10 @@
+-- SQLite Schema Migration: Silence Matchers
+-- Version: 12
+-- Date: 2026-10-15
+-- Description: Negative and regex label matchers of silences, as a JSON array
+
+ALTER TABLE silences ADD COLUMN matchers TEXT;
+
+-- Insert version 12
+INSERT OR IGNORE INTO schema_version (version, applied_at)
+VALUES (12, datetime('now'));
@@ -0,0 +1,11 @@
+-- SQLite Schema Migration: Alert Last Seen
+-- Version: 13
+-- Date: 2026-10-15
+-- Description: When the source last sent a firing alert, and the end time it reported
+
+ALTER TABLE alerts ADD COLUMN last_seen_at TEXT;
+ALTER TABLE alerts ADD COLUMN ends_at TEXT;
+
+-- Insert version 13
+INSERT OR IGNORE INTO schema_version (version, applied_at)
+VALUES (13, datetime('now'));
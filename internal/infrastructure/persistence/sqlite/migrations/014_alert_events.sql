@@ -0,0 +1,24 @@
+-- SQLite Schema Migration: Alert Events
+-- Version: 14
+-- Date: 2026-10-15
+-- Description: Alert timelines: state transitions, notifications, acks, notes and silences
+
+CREATE TABLE IF NOT EXISTS alert_events (
+    id TEXT PRIMARY KEY NOT NULL,
+    alert_id TEXT NOT NULL,
+    type TEXT NOT NULL,
+
+    actor TEXT NOT NULL DEFAULT '',
+    detail TEXT NOT NULL DEFAULT '',
+
+    created_at TEXT NOT NULL,
+
+    FOREIGN KEY (alert_id) REFERENCES alerts(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_alert_events_alert_created
+    ON alert_events(alert_id, created_at);
+
+-- Insert version 14
+INSERT OR IGNORE INTO schema_version (version, applied_at)
+VALUES (14, datetime('now'));
@@ -0,0 +1,12 @@
+-- SQLite Schema Migration: Alert Assignee
+-- Version: 15
+-- Date: 2026-10-15
+-- Description: Who owns an alert, separate from who acknowledged it
+
+ALTER TABLE alerts ADD COLUMN assigned_to TEXT;
+ALTER TABLE alerts ADD COLUMN assignee_slack_id TEXT;
+ALTER TABLE alerts ADD COLUMN assigned_at TEXT;
+
+-- Insert version 15
+INSERT OR IGNORE INTO schema_version (version, applied_at)
+VALUES (15, datetime('now'));
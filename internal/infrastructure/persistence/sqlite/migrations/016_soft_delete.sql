@@ -0,0 +1,11 @@
+-- SQLite Schema Migration: Soft Delete
+-- Version: 16
+-- Date: 2026-10-15
+-- Description: Deleted alerts and silences keep their rows until restored or purged
+
+ALTER TABLE alerts ADD COLUMN deleted_at TEXT;
+ALTER TABLE silences ADD COLUMN deleted_at TEXT;
+
+-- Insert version 16
+INSERT OR IGNORE INTO schema_version (version, applied_at)
+VALUES (16, datetime('now'));
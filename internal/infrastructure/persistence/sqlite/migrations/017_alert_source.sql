@@ -0,0 +1,10 @@
+-- SQLite Schema Migration: Alert Source
+-- Version: 17
+-- Date: 2026-10-15
+-- Description: The integration that raised each alert, for per-source bulk resolves
+
+ALTER TABLE alerts ADD COLUMN source TEXT;
+
+-- Insert version 17
+INSERT OR IGNORE INTO schema_version (version, applied_at)
+VALUES (17, datetime('now'));
@@ -0,0 +1,36 @@
+-- SQLite Schema Migration: Incident Ack Sources
+-- Version: 18
+-- Date: 2026-10-15
+-- Description: Allow 'firehydrant' and 'incidentio' as acknowledgment sources
+
+-- SQLite cannot alter CHECK constraints in place, so the table is rebuilt.
+CREATE TABLE ack_events_new (
+    id TEXT PRIMARY KEY NOT NULL,
+    alert_id TEXT NOT NULL,
+    source TEXT NOT NULL CHECK (source IN ('slack', 'pagerduty', 'api', 'teams', 'firehydrant', 'incidentio')),
+    user_id TEXT NOT NULL DEFAULT '',
+    user_email TEXT NOT NULL DEFAULT '',
+    user_name TEXT NOT NULL DEFAULT '',
+    note TEXT DEFAULT NULL,
+    duration_seconds INTEGER DEFAULT NULL,
+    created_at TEXT NOT NULL,
+    FOREIGN KEY (alert_id) REFERENCES alerts(id) ON DELETE CASCADE
+);
+
+INSERT INTO ack_events_new (id, alert_id, source, user_id, user_email, user_name, note, duration_seconds, created_at)
+SELECT id, alert_id, source, user_id, user_email, user_name, note, duration_seconds, created_at
+FROM ack_events;
+
+DROP TABLE ack_events;
+
+ALTER TABLE ack_events_new RENAME TO ack_events;
+
+CREATE INDEX IF NOT EXISTS idx_ack_events_alert_id
+    ON ack_events(alert_id);
+
+CREATE INDEX IF NOT EXISTS idx_ack_events_alert_created
+    ON ack_events(alert_id, created_at);
+
+-- Insert version 18
+INSERT OR IGNORE INTO schema_version (version, applied_at)
+VALUES (18, datetime('now'));
@@ -0,0 +1,21 @@
+-- SQLite Schema Migration: External Reference Records
+-- Version: 19
+-- Date: 2026-10-15
+-- Description: Store external references as versioned per-notifier records instead of a flat map
+
+-- {"slack": "C1:123"} becomes
+-- {"version": 2, "references": [{"system": "slack", "reference_id": "C1:123"}]}
+UPDATE alerts
+SET external_references = (
+    SELECT json_object('version', 2, 'references', json_group_array(
+        json_object('system', ref.key, 'reference_id', ref.value)
+    ))
+    FROM json_each(alerts.external_references) AS ref
+    WHERE ref.value != ''
+)
+WHERE json_valid(external_references)
+  AND json_type(external_references, '$.version') IS NOT 'integer';
+
+-- Insert version 19
+INSERT OR IGNORE INTO schema_version (version, applied_at)
+VALUES (19, datetime('now'));
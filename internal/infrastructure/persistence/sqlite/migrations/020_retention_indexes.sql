@@ -0,0 +1,14 @@
+-- SQLite Schema Migration: Retention Indexes
+-- Version: 20
+-- Date: 2026-10-15
+-- Description: Index the columns the retention janitor purges by
+
+CREATE INDEX IF NOT EXISTS idx_alerts_resolved_at
+    ON alerts(resolved_at);
+
+CREATE INDEX IF NOT EXISTS idx_ack_events_created_at
+    ON ack_events(created_at);
+
+-- Insert version 20
+INSERT OR IGNORE INTO schema_version (version, applied_at)
+VALUES (20, datetime('now'));
@@ -0,0 +1,164 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// notificationRetryColumns lists notification_retries columns in the order
+// scanNotificationRetry expects.
+const notificationRetryColumns = `id, alert_id, notifier, action, status, attempts, last_error, next_attempt_at, created_at, updated_at`
+
+// NotificationRetryRepository provides SQLite implementation of repository.NotificationRetryRepository.
+type NotificationRetryRepository struct {
+	db *DB
+}
+
+// NewNotificationRetryRepository creates a new SQLite-backed notification retry repository.
+func NewNotificationRetryRepository(db *DB) *NotificationRetryRepository {
+	return &NotificationRetryRepository{db: db}
+}
+
+// Save creates or replaces a retry.
+func (r *NotificationRetryRepository) Save(ctx context.Context, retry *entity.NotificationRetry) error {
+	_, err := r.db.getExecutor(ctx).ExecContext(ctx, `
+		INSERT INTO notification_retries (`+notificationRetryColumns+`)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (id) DO UPDATE SET
+			status = excluded.status,
+			attempts = excluded.attempts,
+			last_error = excluded.last_error,
+			next_attempt_at = excluded.next_attempt_at,
+			created_at = excluded.created_at,
+			updated_at = excluded.updated_at
+	`,
+		retry.ID,
+		retry.AlertID,
+		retry.Notifier,
+		string(retry.Action),
+		string(retry.Status),
+		retry.Attempts,
+		retry.LastError,
+		timeToString(retry.NextAttemptAt),
+		timeToString(retry.CreatedAt),
+		timeToString(retry.UpdatedAt),
+	)
+	if err != nil {
+		return fmt.Errorf("upsert notification retry: %w", err)
+	}
+
+	return nil
+}
+
+// FindDue returns up to limit pending retries due at now, earliest first.
+func (r *NotificationRetryRepository) FindDue(ctx context.Context, now time.Time, limit int) ([]*entity.NotificationRetry, error) {
+	return r.query(ctx, `
+		SELECT `+notificationRetryColumns+`
+		FROM notification_retries
+		WHERE status = ? AND next_attempt_at <= ?
+		ORDER BY next_attempt_at
+		LIMIT ?
+	`, string(entity.RetryStatusPending), timeToString(now), limit)
+}
+
+// FindByID retrieves a retry by ID. Returns nil, nil if not found.
+func (r *NotificationRetryRepository) FindByID(ctx context.Context, id string) (*entity.NotificationRetry, error) {
+	row := r.db.getExecutor(ctx).QueryRowContext(ctx, `
+		SELECT `+notificationRetryColumns+`
+		FROM notification_retries
+		WHERE id = ?
+	`, id)
+
+	retry, err := scanNotificationRetry(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("query notification retry: %w", err)
+	}
+
+	return retry, nil
+}
+
+// FindDead returns the dead-lettered retries, most recently failed first.
+func (r *NotificationRetryRepository) FindDead(ctx context.Context) ([]*entity.NotificationRetry, error) {
+	return r.query(ctx, `
+		SELECT `+notificationRetryColumns+`
+		FROM notification_retries
+		WHERE status = ?
+		ORDER BY updated_at DESC
+	`, string(entity.RetryStatusDead))
+}
+
+func (r *NotificationRetryRepository) query(ctx context.Context, query string, args ...any) ([]*entity.NotificationRetry, error) {
+	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query notification retries: %w", err)
+	}
+	defer rows.Close()
+
+	retries := []*entity.NotificationRetry{}
+	for rows.Next() {
+		retry, err := scanNotificationRetry(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan notification retry row: %w", err)
+		}
+		retries = append(retries, retry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration: %w", err)
+	}
+
+	return retries, nil
+}
+
+// Delete removes a retry.
+// Returns ErrNotificationRetryNotFound if the retry doesn't exist.
+func (r *NotificationRetryRepository) Delete(ctx context.Context, id string) error {
+	result, err := r.db.getExecutor(ctx).ExecContext(ctx,
+		`DELETE FROM notification_retries WHERE id = ?`, id)
+	if err != nil {
+		return fmt.Errorf("delete notification retry: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return entity.ErrNotificationRetryNotFound
+	}
+
+	return nil
+}
+
+// scanNotificationRetry scans a row selected with notificationRetryColumns.
+func scanNotificationRetry(row rowScanner) (*entity.NotificationRetry, error) {
+	var (
+		retry         entity.NotificationRetry
+		action        string
+		status        string
+		nextAttemptAt string
+		createdAt     string
+		updatedAt     string
+	)
+
+	if err := row.Scan(
+		&retry.ID, &retry.AlertID, &retry.Notifier, &action, &status,
+		&retry.Attempts, &retry.LastError, &nextAttemptAt, &createdAt, &updatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	retry.Action = entity.NotificationAction(action)
+	retry.Status = entity.RetryStatus(status)
+	retry.NextAttemptAt, _ = parseTime(nextAttemptAt)
+	retry.CreatedAt, _ = parseTime(createdAt)
+	retry.UpdatedAt, _ = parseTime(updatedAt)
+
+	return &retry, nil
+}
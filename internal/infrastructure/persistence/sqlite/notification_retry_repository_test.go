@@ -0,0 +1,101 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+func TestNotificationRetryRepository(t *testing.T) {
+	db, err := NewDB(":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, db.Migrate(context.Background()))
+	repo := NewNotificationRetryRepository(db)
+	ctx := context.Background()
+
+	now := time.Now().UTC().Truncate(time.Second)
+	later := entity.NewNotificationRetry("alert-1", "slack", entity.NotificationActionNotify, "rate limited", now.Add(-time.Minute))
+	sooner := entity.NewNotificationRetry("alert-2", "pagerduty", entity.NotificationActionUpdate, "timeout", now.Add(-time.Hour))
+	future := entity.NewNotificationRetry("alert-3", "slack", entity.NotificationActionNotify, "timeout", now.Add(time.Hour))
+	dead := entity.NewNotificationRetry("alert-4", "slack", entity.NotificationActionNotify, "timeout", now.Add(-time.Hour))
+	dead.MarkDead("channel_not_found")
+	for _, r := range []*entity.NotificationRetry{later, sooner, future, dead} {
+		require.NoError(t, repo.Save(ctx, r))
+	}
+
+	due, err := repo.FindDue(ctx, now, 10)
+	require.NoError(t, err)
+	require.Len(t, due, 2)
+	assert.Equal(t, sooner.ID, due[0].ID)
+	assert.Equal(t, entity.NotificationActionUpdate, due[0].Action)
+	assert.Equal(t, later.ID, due[1].ID)
+	assert.Equal(t, "rate limited", due[1].LastError)
+	assert.Equal(t, 1, due[1].Attempts)
+
+	t.Run("limit", func(t *testing.T) {
+		due, err := repo.FindDue(ctx, now, 1)
+		require.NoError(t, err)
+		require.Len(t, due, 1)
+		assert.Equal(t, sooner.ID, due[0].ID)
+	})
+
+	t.Run("save replaces", func(t *testing.T) {
+		later.RecordFailure("still rate limited", now.Add(time.Hour))
+		require.NoError(t, repo.Save(ctx, later))
+
+		due, err := repo.FindDue(ctx, now, 10)
+		require.NoError(t, err)
+		require.Len(t, due, 1)
+		assert.Equal(t, sooner.ID, due[0].ID)
+
+		due, err = repo.FindDue(ctx, now.Add(2*time.Hour), 10)
+		require.NoError(t, err)
+		require.Len(t, due, 3)
+		for _, r := range due {
+			if r.ID == later.ID {
+				assert.Equal(t, 2, r.Attempts)
+				assert.Equal(t, "still rate limited", r.LastError)
+			}
+		}
+	})
+
+	t.Run("dead letters", func(t *testing.T) {
+		deadLetters, err := repo.FindDead(ctx)
+		require.NoError(t, err)
+		require.Len(t, deadLetters, 1)
+		assert.Equal(t, dead.ID, deadLetters[0].ID)
+		assert.Equal(t, "channel_not_found", deadLetters[0].LastError)
+		assert.Equal(t, 2, deadLetters[0].Attempts)
+
+		dead.Requeue(now)
+		require.NoError(t, repo.Save(ctx, dead))
+
+		deadLetters, err = repo.FindDead(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, deadLetters)
+
+		found, err := repo.FindByID(ctx, dead.ID)
+		require.NoError(t, err)
+		require.NotNil(t, found)
+		assert.Equal(t, entity.RetryStatusPending, found.Status)
+		assert.Equal(t, 0, found.Attempts)
+		assert.Equal(t, now, found.NextAttemptAt)
+	})
+
+	t.Run("find by id missing", func(t *testing.T) {
+		found, err := repo.FindByID(ctx, "missing")
+		require.NoError(t, err)
+		assert.Nil(t, found)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, repo.Delete(ctx, sooner.ID))
+		assert.ErrorIs(t, repo.Delete(ctx, sooner.ID), entity.ErrNotificationRetryNotFound)
+	})
+}
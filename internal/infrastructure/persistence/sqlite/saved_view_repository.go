@@ -0,0 +1,152 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// savedViewColumns lists saved_views columns in the order scanSavedView expects.
+const savedViewColumns = `id, name, owner, labels, severity, state, created_by, created_at, updated_at`
+
+// SavedViewRepository provides SQLite implementation of repository.SavedViewRepository.
+type SavedViewRepository struct {
+	db *DB
+}
+
+// NewSavedViewRepository creates a new SQLite-backed saved view repository.
+func NewSavedViewRepository(db *DB) *SavedViewRepository {
+	return &SavedViewRepository{db: db}
+}
+
+// Save creates or replaces a view, keyed by owner and name.
+func (r *SavedViewRepository) Save(ctx context.Context, view *entity.SavedView) error {
+	labels, err := marshalJSON(view.Labels)
+	if err != nil {
+		return fmt.Errorf("marshal labels: %w", err)
+	}
+
+	_, err = r.db.getExecutor(ctx).ExecContext(ctx, `
+		INSERT INTO saved_views (`+savedViewColumns+`)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (owner, name) DO UPDATE SET
+			labels = excluded.labels,
+			severity = excluded.severity,
+			state = excluded.state,
+			created_by = excluded.created_by,
+			updated_at = excluded.updated_at
+	`,
+		view.ID,
+		view.Name,
+		view.Owner,
+		labels,
+		string(view.Severity),
+		string(view.State),
+		view.CreatedBy,
+		timeToString(view.CreatedAt),
+		timeToString(view.UpdatedAt),
+	)
+	if err != nil {
+		return fmt.Errorf("upsert saved view: %w", err)
+	}
+
+	return nil
+}
+
+// FindByName returns the view visible to owner, preferring the owner's own view.
+// Returns nil, nil if not found.
+func (r *SavedViewRepository) FindByName(ctx context.Context, owner, name string) (*entity.SavedView, error) {
+	row := r.db.getExecutor(ctx).QueryRowContext(ctx, `
+		SELECT `+savedViewColumns+`
+		FROM saved_views
+		WHERE name = ? AND (owner = ? OR owner = '')
+		ORDER BY owner DESC
+		LIMIT 1
+	`, name, owner)
+
+	view, err := scanSavedView(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("scan saved view: %w", err)
+	}
+	return view, nil
+}
+
+// FindVisible returns the owner's views and all shared views, ordered by name.
+func (r *SavedViewRepository) FindVisible(ctx context.Context, owner string) ([]*entity.SavedView, error) {
+	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, `
+		SELECT `+savedViewColumns+`
+		FROM saved_views
+		WHERE owner = ? OR owner = ''
+		ORDER BY name, owner DESC
+	`, owner)
+	if err != nil {
+		return nil, fmt.Errorf("query saved views: %w", err)
+	}
+	defer rows.Close()
+
+	views := []*entity.SavedView{}
+	for rows.Next() {
+		view, err := scanSavedView(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan saved view row: %w", err)
+		}
+		views = append(views, view)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration: %w", err)
+	}
+
+	return views, nil
+}
+
+// Delete removes a view.
+// Returns ErrSavedViewNotFound if the view doesn't exist.
+func (r *SavedViewRepository) Delete(ctx context.Context, owner, name string) error {
+	result, err := r.db.getExecutor(ctx).ExecContext(ctx,
+		`DELETE FROM saved_views WHERE owner = ? AND name = ?`, owner, name)
+	if err != nil {
+		return fmt.Errorf("delete saved view: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return entity.ErrSavedViewNotFound
+	}
+
+	return nil
+}
+
+// scanSavedView scans a row selected with savedViewColumns.
+func scanSavedView(row rowScanner) (*entity.SavedView, error) {
+	var (
+		view      entity.SavedView
+		labels    string
+		severity  string
+		state     string
+		createdAt string
+		updatedAt string
+	)
+
+	if err := row.Scan(
+		&view.ID, &view.Name, &view.Owner, &labels, &severity, &state,
+		&view.CreatedBy, &createdAt, &updatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	view.Labels, _ = unmarshalJSON(labels)
+	view.Severity = entity.AlertSeverity(severity)
+	view.State = entity.AlertState(state)
+	view.CreatedAt, _ = parseTime(createdAt)
+	view.UpdatedAt, _ = parseTime(updatedAt)
+
+	return &view, nil
+}
@@ -0,0 +1,102 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+func setupSavedViewTest(t *testing.T) (*DB, *SavedViewRepository) {
+	t.Helper()
+
+	db, err := NewDB(":memory:")
+	require.NoError(t, err)
+
+	err = db.Migrate(context.Background())
+	require.NoError(t, err)
+
+	return db, NewSavedViewRepository(db)
+}
+
+func newTestView(t *testing.T, name, owner string, labels map[string]string) *entity.SavedView {
+	t.Helper()
+
+	view, err := entity.NewSavedView(name, owner, "user@example.com")
+	require.NoError(t, err)
+	view.Labels = labels
+	return view
+}
+
+func TestSavedViewRepository_SaveAndFind(t *testing.T) {
+	db, repo := setupSavedViewTest(t)
+	defer db.Close()
+	ctx := context.Background()
+
+	shared := newTestView(t, "payments-prod", "", map[string]string{"team": "payments"})
+	shared.Severity = entity.SeverityCritical
+	require.NoError(t, repo.Save(ctx, shared))
+
+	t.Run("shared view is visible to everyone", func(t *testing.T) {
+		found, err := repo.FindByName(ctx, "U123", "payments-prod")
+		require.NoError(t, err)
+		require.NotNil(t, found)
+		assert.Equal(t, shared.ID, found.ID)
+		assert.Equal(t, entity.SeverityCritical, found.Severity)
+		assert.Equal(t, "payments", found.Labels["team"])
+	})
+
+	t.Run("personal view takes precedence", func(t *testing.T) {
+		personal := newTestView(t, "payments-prod", "U123", map[string]string{"team": "payments", "env": "prod"})
+		require.NoError(t, repo.Save(ctx, personal))
+
+		found, err := repo.FindByName(ctx, "U123", "payments-prod")
+		require.NoError(t, err)
+		assert.Equal(t, personal.ID, found.ID)
+
+		other, err := repo.FindByName(ctx, "U999", "payments-prod")
+		require.NoError(t, err)
+		assert.Equal(t, shared.ID, other.ID)
+	})
+
+	t.Run("save replaces criteria and keeps ID", func(t *testing.T) {
+		replacement := newTestView(t, "payments-prod", "", map[string]string{"team": "billing"})
+		require.NoError(t, repo.Save(ctx, replacement))
+
+		found, err := repo.FindByName(ctx, "U999", "payments-prod")
+		require.NoError(t, err)
+		assert.Equal(t, shared.ID, found.ID)
+		assert.Equal(t, "billing", found.Labels["team"])
+		assert.Empty(t, found.Severity)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		found, err := repo.FindByName(ctx, "U123", "missing")
+		require.NoError(t, err)
+		assert.Nil(t, found)
+	})
+}
+
+func TestSavedViewRepository_FindVisibleAndDelete(t *testing.T) {
+	db, repo := setupSavedViewTest(t)
+	defer db.Close()
+	ctx := context.Background()
+
+	require.NoError(t, repo.Save(ctx, newTestView(t, "zeta", "", nil)))
+	require.NoError(t, repo.Save(ctx, newTestView(t, "alpha", "U123", nil)))
+	require.NoError(t, repo.Save(ctx, newTestView(t, "hidden", "U999", nil)))
+
+	views, err := repo.FindVisible(ctx, "U123")
+	require.NoError(t, err)
+	require.Len(t, views, 2)
+	assert.Equal(t, "alpha", views[0].Name)
+	assert.Equal(t, "zeta", views[1].Name)
+
+	require.NoError(t, repo.Delete(ctx, "U123", "alpha"))
+	assert.ErrorIs(t, repo.Delete(ctx, "U123", "alpha"), entity.ErrSavedViewNotFound)
+	// Shared views are not deleted through a personal scope
+	assert.ErrorIs(t, repo.Delete(ctx, "U123", "zeta"), entity.ErrSavedViewNotFound)
+}
@@ -3,6 +3,7 @@ package sqlite
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -25,12 +26,16 @@ func (r *SilenceRepository) Save(ctx context.Context, silence *entity.SilenceMar
 	if err != nil {
 		return fmt.Errorf("marshal labels: %w", err)
 	}
+	matchers, err := matchersToNull(silence.Matchers)
+	if err != nil {
+		return fmt.Errorf("marshal matchers: %w", err)
+	}
 
 	_, err = r.db.getExecutor(ctx).ExecContext(ctx, `
 		INSERT INTO silences (
 			id, alert_id, instance, fingerprint, labels,
-			start_at, end_at, created_by, created_by_email, reason, source, created_at
-		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			start_at, end_at, created_by, created_by_email, reason, source, created_at, recurrence, matchers
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`,
 		silence.ID,
 		nullString(silence.AlertID),
@@ -44,6 +49,8 @@ func (r *SilenceRepository) Save(ctx context.Context, silence *entity.SilenceMar
 		silence.Reason,
 		string(silence.Source),
 		timeToString(silence.CreatedAt),
+		recurrenceToNull(silence.Recurrence),
+		matchers,
 	)
 
 	if err != nil {
@@ -58,8 +65,8 @@ func (r *SilenceRepository) Save(ctx context.Context, silence *entity.SilenceMar
 func (r *SilenceRepository) FindByID(ctx context.Context, id string) (*entity.SilenceMark, error) {
 	row := r.db.getExecutor(ctx).QueryRowContext(ctx, `
 		SELECT id, alert_id, instance, fingerprint, labels,
-			start_at, end_at, created_by, created_by_email, reason, source, created_at
-		FROM silences WHERE id = ?
+			start_at, end_at, created_by, created_by_email, reason, source, created_at, recurrence, matchers
+		FROM silences WHERE id = ? AND deleted_at IS NULL
 	`, id)
 
 	return scanSilence(row)
@@ -72,9 +79,9 @@ func (r *SilenceRepository) FindActive(ctx context.Context) ([]*entity.SilenceMa
 
 	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, `
 		SELECT id, alert_id, instance, fingerprint, labels,
-			start_at, end_at, created_by, created_by_email, reason, source, created_at
+			start_at, end_at, created_by, created_by_email, reason, source, created_at, recurrence, matchers
 		FROM silences
-		WHERE start_at <= ? AND end_at > ?
+		WHERE start_at <= ? AND end_at > ? AND deleted_at IS NULL
 	`, now, now)
 	if err != nil {
 		return nil, fmt.Errorf("query active silences: %w", err)
@@ -91,9 +98,9 @@ func (r *SilenceRepository) FindByAlertID(ctx context.Context, alertID string) (
 
 	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, `
 		SELECT id, alert_id, instance, fingerprint, labels,
-			start_at, end_at, created_by, created_by_email, reason, source, created_at
+			start_at, end_at, created_by, created_by_email, reason, source, created_at, recurrence, matchers
 		FROM silences
-		WHERE alert_id = ? AND start_at <= ? AND end_at > ?
+		WHERE alert_id = ? AND start_at <= ? AND end_at > ? AND deleted_at IS NULL
 	`, alertID, now, now)
 	if err != nil {
 		return nil, fmt.Errorf("query silences by alert ID: %w", err)
@@ -110,9 +117,9 @@ func (r *SilenceRepository) FindByInstance(ctx context.Context, instance string)
 
 	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, `
 		SELECT id, alert_id, instance, fingerprint, labels,
-			start_at, end_at, created_by, created_by_email, reason, source, created_at
+			start_at, end_at, created_by, created_by_email, reason, source, created_at, recurrence, matchers
 		FROM silences
-		WHERE instance = ? AND start_at <= ? AND end_at > ?
+		WHERE instance = ? AND start_at <= ? AND end_at > ? AND deleted_at IS NULL
 	`, instance, now, now)
 	if err != nil {
 		return nil, fmt.Errorf("query silences by instance: %w", err)
@@ -129,9 +136,9 @@ func (r *SilenceRepository) FindByFingerprint(ctx context.Context, fingerprint s
 
 	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, `
 		SELECT id, alert_id, instance, fingerprint, labels,
-			start_at, end_at, created_by, created_by_email, reason, source, created_at
+			start_at, end_at, created_by, created_by_email, reason, source, created_at, recurrence, matchers
 		FROM silences
-		WHERE fingerprint = ? AND start_at <= ? AND end_at > ?
+		WHERE fingerprint = ? AND start_at <= ? AND end_at > ? AND deleted_at IS NULL
 	`, fingerprint, now, now)
 	if err != nil {
 		return nil, fmt.Errorf("query silences by fingerprint: %w", err)
@@ -150,9 +157,9 @@ func (r *SilenceRepository) FindMatchingAlert(ctx context.Context, alert *entity
 	// Query all active silences
 	rows, err := r.db.getExecutor(ctx).QueryContext(ctx, `
 		SELECT id, alert_id, instance, fingerprint, labels,
-			start_at, end_at, created_by, created_by_email, reason, source, created_at
+			start_at, end_at, created_by, created_by_email, reason, source, created_at, recurrence, matchers
 		FROM silences
-		WHERE start_at <= ? AND end_at > ?
+		WHERE start_at <= ? AND end_at > ? AND deleted_at IS NULL
 	`, now, now)
 	if err != nil {
 		return nil, fmt.Errorf("query active silences: %w", err)
@@ -182,13 +189,17 @@ func (r *SilenceRepository) Update(ctx context.Context, silence *entity.SilenceM
 	if err != nil {
 		return fmt.Errorf("marshal labels: %w", err)
 	}
+	matchers, err := matchersToNull(silence.Matchers)
+	if err != nil {
+		return fmt.Errorf("marshal matchers: %w", err)
+	}
 
 	result, err := r.db.getExecutor(ctx).ExecContext(ctx, `
 		UPDATE silences SET
 			alert_id = ?, instance = ?, fingerprint = ?, labels = ?,
 			start_at = ?, end_at = ?, created_by = ?, created_by_email = ?,
-			reason = ?, source = ?
-		WHERE id = ?
+			reason = ?, source = ?, recurrence = ?, matchers = ?
+		WHERE id = ? AND deleted_at IS NULL
 	`,
 		nullString(silence.AlertID),
 		nullString(silence.Instance),
@@ -200,6 +211,8 @@ func (r *SilenceRepository) Update(ctx context.Context, silence *entity.SilenceM
 		silence.CreatedByEmail,
 		silence.Reason,
 		string(silence.Source),
+		recurrenceToNull(silence.Recurrence),
+		matchers,
 		silence.ID,
 	)
 	if err != nil {
@@ -217,10 +230,13 @@ func (r *SilenceRepository) Update(ctx context.Context, silence *entity.SilenceM
 	return nil
 }
 
-// Delete removes a silence by ID.
+// Delete soft-deletes a silence by ID, setting its deleted_at.
 // Returns ErrSilenceNotFound if the silence doesn't exist.
 func (r *SilenceRepository) Delete(ctx context.Context, id string) error {
-	result, err := r.db.getExecutor(ctx).ExecContext(ctx, `DELETE FROM silences WHERE id = ?`, id)
+	result, err := r.db.getExecutor(ctx).ExecContext(ctx,
+		`UPDATE silences SET deleted_at = ? WHERE id = ? AND deleted_at IS NULL`,
+		timeToString(time.Now().UTC()), id,
+	)
 	if err != nil {
 		return fmt.Errorf("delete silence: %w", err)
 	}
@@ -236,12 +252,31 @@ func (r *SilenceRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
-// DeleteExpired removes all expired silences (end_at < now).
-// Returns the number of silences deleted.
-func (r *SilenceRepository) DeleteExpired(ctx context.Context) (int, error) {
-	now := timeToString(time.Now().UTC())
+// Restore clears the deleted_at of a soft-deleted silence.
+// Returns ErrSilenceNotFound if no deleted silence has the ID.
+func (r *SilenceRepository) Restore(ctx context.Context, id string) error {
+	result, err := r.db.getExecutor(ctx).ExecContext(ctx,
+		`UPDATE silences SET deleted_at = NULL WHERE id = ? AND deleted_at IS NOT NULL`, id)
+	if err != nil {
+		return fmt.Errorf("restore silence: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return entity.ErrSilenceNotFound
+	}
 
-	result, err := r.db.getExecutor(ctx).ExecContext(ctx, `DELETE FROM silences WHERE end_at < ?`, now)
+	return nil
+}
+
+// DeleteExpired removes silences that expired before the given time
+// (end_at < before), soft-deleted or not.
+// Returns the number of silences deleted.
+func (r *SilenceRepository) DeleteExpired(ctx context.Context, before time.Time) (int, error) {
+	result, err := r.db.getExecutor(ctx).ExecContext(ctx, `DELETE FROM silences WHERE end_at < ?`, timeToString(before))
 	if err != nil {
 		return 0, fmt.Errorf("delete expired silences: %w", err)
 	}
@@ -266,12 +301,14 @@ func scanSilence(row *sql.Row) (*entity.SilenceMark, error) {
 		endAt       string
 		source      string
 		createdAt   string
+		recurrence  sql.NullString
+		matchers    sql.NullString
 	)
 
 	err := row.Scan(
 		&silence.ID, &alertID, &instance, &fingerprint, &labels,
 		&startAt, &endAt, &silence.CreatedBy, &silence.CreatedByEmail,
-		&silence.Reason, &source, &createdAt,
+		&silence.Reason, &source, &createdAt, &recurrence, &matchers,
 	)
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -293,6 +330,12 @@ func scanSilence(row *sql.Row) (*entity.SilenceMark, error) {
 	silence.StartAt, _ = parseTime(startAt)
 	silence.EndAt, _ = parseTime(endAt)
 	silence.CreatedAt, _ = parseTime(createdAt)
+	if silence.Recurrence, err = recurrenceFromNull(recurrence); err != nil {
+		return nil, fmt.Errorf("parse silence recurrence: %w", err)
+	}
+	if silence.Matchers, err = matchersFromNull(matchers); err != nil {
+		return nil, fmt.Errorf("parse silence matchers: %w", err)
+	}
 
 	return &silence, nil
 }
@@ -312,12 +355,14 @@ func scanSilences(rows *sql.Rows) ([]*entity.SilenceMark, error) {
 			endAt       string
 			source      string
 			createdAt   string
+			recurrence  sql.NullString
+			matchers    sql.NullString
 		)
 
 		err := rows.Scan(
 			&silence.ID, &alertID, &instance, &fingerprint, &labels,
 			&startAt, &endAt, &silence.CreatedBy, &silence.CreatedByEmail,
-			&silence.Reason, &source, &createdAt,
+			&silence.Reason, &source, &createdAt, &recurrence, &matchers,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("scan silence row: %w", err)
@@ -336,6 +381,12 @@ func scanSilences(rows *sql.Rows) ([]*entity.SilenceMark, error) {
 		silence.StartAt, _ = parseTime(startAt)
 		silence.EndAt, _ = parseTime(endAt)
 		silence.CreatedAt, _ = parseTime(createdAt)
+		if silence.Recurrence, err = recurrenceFromNull(recurrence); err != nil {
+			return nil, fmt.Errorf("parse silence recurrence: %w", err)
+		}
+		if silence.Matchers, err = matchersFromNull(matchers); err != nil {
+			return nil, fmt.Errorf("parse silence matchers: %w", err)
+		}
 
 		silences = append(silences, &silence)
 	}
@@ -351,3 +402,45 @@ func scanSilences(rows *sql.Rows) ([]*entity.SilenceMark, error) {
 
 	return silences, nil
 }
+
+// recurrenceToNull stores a silence recurrence in its RRULE-like form.
+func recurrenceToNull(recurrence *entity.SilenceRecurrence) sql.NullString {
+	if recurrence == nil {
+		return sql.NullString{}
+	}
+	return nullString(recurrence.String())
+}
+
+// recurrenceFromNull parses a stored silence recurrence.
+func recurrenceFromNull(ns sql.NullString) (*entity.SilenceRecurrence, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return entity.ParseSilenceRecurrence(ns.String)
+}
+
+// matchersToNull stores the non-equality label matchers of a silence as a
+// JSON array.
+func matchersToNull(matchers []entity.LabelMatcher) (sql.NullString, error) {
+	if len(matchers) == 0 {
+		return sql.NullString{}, nil
+	}
+	data, err := json.Marshal(matchers)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+	return nullString(string(data)), nil
+}
+
+// matchersFromNull parses stored label matchers, compiling their regular
+// expressions.
+func matchersFromNull(ns sql.NullString) ([]entity.LabelMatcher, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	var matchers []entity.LabelMatcher
+	if err := json.Unmarshal([]byte(ns.String), &matchers); err != nil {
+		return nil, err
+	}
+	return matchers, nil
+}
@@ -527,6 +527,33 @@ func TestSilenceRepository_Delete(t *testing.T) {
 		err := repo.Delete(context.Background(), "nonexistent")
 		assert.ErrorIs(t, err, entity.ErrSilenceNotFound)
 	})
+
+	t.Run("restore deleted silence", func(t *testing.T) {
+		ctx := context.Background()
+		silence := &entity.SilenceMark{
+			ID:        "restore-1",
+			Instance:  "web-1",
+			StartAt:   now,
+			EndAt:     now.Add(1 * time.Hour),
+			CreatedBy: "user",
+			Source:    entity.AckSourceSlack,
+			Labels:    map[string]string{},
+			CreatedAt: now,
+		}
+		require.NoError(t, repo.Save(ctx, silence))
+		require.NoError(t, repo.Delete(ctx, silence.ID))
+
+		active, err := repo.FindByInstance(ctx, "web-1")
+		require.NoError(t, err)
+		assert.Empty(t, active)
+
+		require.NoError(t, repo.Restore(ctx, silence.ID))
+		active, err = repo.FindByInstance(ctx, "web-1")
+		require.NoError(t, err)
+		assert.Len(t, active, 1)
+
+		assert.ErrorIs(t, repo.Restore(ctx, silence.ID), entity.ErrSilenceNotFound)
+	})
 }
 
 func TestSilenceRepository_DeleteExpired(t *testing.T) {
@@ -567,7 +594,7 @@ func TestSilenceRepository_DeleteExpired(t *testing.T) {
 		require.NoError(t, repo.Save(context.Background(), active))
 
 		// Delete expired
-		count, err := repo.DeleteExpired(context.Background())
+		count, err := repo.DeleteExpired(context.Background(), time.Now())
 		require.NoError(t, err)
 		assert.Equal(t, 3, count)
 
@@ -588,8 +615,88 @@ func TestSilenceRepository_DeleteExpired(t *testing.T) {
 		db2, repo2 := setupSilenceTest(t)
 		defer db2.Close()
 
-		count, err := repo2.DeleteExpired(context.Background())
+		count, err := repo2.DeleteExpired(context.Background(), time.Now())
 		assert.NoError(t, err)
 		assert.Equal(t, 0, count)
 	})
 }
+
+func TestSilenceRepository_Recurrence(t *testing.T) {
+	db, repo := setupSilenceTest(t)
+	defer db.Close()
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	newRecurring := func(hour int) *entity.SilenceMark {
+		recurrence, err := entity.ParseSilenceRecurrence(fmt.Sprintf("FREQ=DAILY;BYHOUR=%d;DURATION=1h", hour))
+		require.NoError(t, err)
+		silence, err := entity.NewSilenceMark(24*time.Hour, "alice", "", entity.AckSourceAPI)
+		require.NoError(t, err)
+		silence.StartAt = now.Add(-time.Hour)
+		return silence.WithLabel("job", "backup").WithRecurrence(recurrence)
+	}
+	inWindow := newRecurring(now.Hour())
+	outOfWindow := newRecurring(now.Add(3 * time.Hour).Hour())
+	require.NoError(t, repo.Save(ctx, inWindow))
+	require.NoError(t, repo.Save(ctx, outOfWindow))
+
+	saved, err := repo.FindByID(ctx, inWindow.ID)
+	require.NoError(t, err)
+	require.NotNil(t, saved.Recurrence)
+	assert.Equal(t, inWindow.Recurrence.String(), saved.Recurrence.String())
+
+	// Both are listed for the whole period, but only one silences now
+	active, err := repo.FindActive(ctx)
+	require.NoError(t, err)
+	assert.Len(t, active, 2)
+
+	alert := entity.NewAlert("fp", "BackupRunning", "db-1", "", "", entity.SeverityWarning)
+	alert.AddLabel("job", "backup")
+	matching, err := repo.FindMatchingAlert(ctx, alert)
+	require.NoError(t, err)
+	require.Len(t, matching, 1)
+	assert.Equal(t, inWindow.ID, matching[0].ID)
+}
+
+func TestSilenceRepository_LabelMatchers(t *testing.T) {
+	db, repo := setupSilenceTest(t)
+	defer db.Close()
+	ctx := context.Background()
+
+	regex, err := entity.ParseLabelMatcher(`service=~"pay.*"`)
+	require.NoError(t, err)
+	notProd, err := entity.ParseLabelMatcher("env!=prod")
+	require.NoError(t, err)
+
+	silence, err := entity.NewSilenceMark(time.Hour, "alice", "", entity.AckSourceAPI)
+	require.NoError(t, err)
+	silence.StartAt = silence.StartAt.Add(-time.Minute)
+	silence.WithLabelMatchers([]entity.LabelMatcher{regex, notProd})
+	require.NoError(t, repo.Save(ctx, silence))
+
+	saved, err := repo.FindByID(ctx, silence.ID)
+	require.NoError(t, err)
+	require.Len(t, saved.Matchers, 2)
+	assert.Equal(t, `service=~"pay.*"`, saved.Matchers[0].String())
+	assert.Equal(t, `env!="prod"`, saved.Matchers[1].String())
+
+	newAlert := func(service, env string) *entity.Alert {
+		alert := entity.NewAlert("fp-"+service+env, "HighLatency", "host-1", "", "", entity.SeverityWarning)
+		alert.AddLabel("service", service)
+		alert.AddLabel("env", env)
+		return alert
+	}
+	tests := []struct {
+		alert *entity.Alert
+		want  int
+	}{
+		{newAlert("payments", "staging"), 1},
+		{newAlert("payments", "prod"), 0},
+		{newAlert("ledger-payments", "staging"), 0},
+	}
+	for _, tt := range tests {
+		matching, err := repo.FindMatchingAlert(ctx, tt.alert)
+		require.NoError(t, err)
+		assert.Len(t, matching, tt.want, "service=%s env=%s", tt.alert.GetLabel("service"), tt.alert.GetLabel("env"))
+	}
+}
@@ -36,6 +36,10 @@ func (s State) String() string {
 var (
 	// ErrCircuitOpen is returned when the circuit breaker is open.
 	ErrCircuitOpen = errors.New("circuit breaker is open")
+
+	// ErrThrottled is wrapped by errors of calls a downstream API rejected
+	// with a rate limit, such as HTTP 429.
+	ErrThrottled = errors.New("throttled by downstream API")
 )
 
 // CircuitBreaker implements the circuit breaker pattern to prevent cascading failures.
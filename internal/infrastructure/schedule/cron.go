@@ -0,0 +1,211 @@
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Cron is a parsed five-field cron expression (minute hour day-of-month
+// month day-of-week). Each field is stored as a bitmask of allowed values.
+type Cron struct {
+	expr   string
+	minute uint64
+	hour   uint64
+	dom    uint64
+	month  uint64
+	dow    uint64
+
+	// domStar and dowStar record unrestricted day fields. When both day
+	// fields are restricted, a time matches if either one matches.
+	domStar bool
+	dowStar bool
+}
+
+// field describes the valid range of one cron field.
+type field struct {
+	name     string
+	min, max int
+}
+
+var (
+	minuteField = field{"minute", 0, 59}
+	hourField   = field{"hour", 0, 23}
+	domField    = field{"day-of-month", 1, 31}
+	monthField  = field{"month", 1, 12}
+	dowField    = field{"day-of-week", 0, 7}
+)
+
+// macros maps the supported shorthand schedules to their expansion.
+var macros = map[string]string{
+	"@hourly":   "0 * * * *",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@weekly":   "0 0 * * 0",
+	"@monthly":  "0 0 1 * *",
+}
+
+// ParseCron parses a standard five-field cron expression.
+// Supports "*", single values, ranges ("1-5"), lists ("1,3,5"), steps
+// ("*/15", "0-30/10") and the @hourly, @daily, @weekly and @monthly macros.
+// Day-of-week accepts 0-7 where both 0 and 7 are Sunday.
+func ParseCron(expr string) (*Cron, error) {
+	spec := strings.TrimSpace(expr)
+	if expanded, ok := macros[strings.ToLower(spec)]; ok {
+		spec = expanded
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron expression %q: expected 5 fields, got %d", expr, len(fields))
+	}
+
+	c := &Cron{expr: expr}
+	var err error
+	if c.minute, err = parseField(fields[0], minuteField); err != nil {
+		return nil, fmt.Errorf("cron expression %q: %w", expr, err)
+	}
+	if c.hour, err = parseField(fields[1], hourField); err != nil {
+		return nil, fmt.Errorf("cron expression %q: %w", expr, err)
+	}
+	if c.dom, err = parseField(fields[2], domField); err != nil {
+		return nil, fmt.Errorf("cron expression %q: %w", expr, err)
+	}
+	if c.month, err = parseField(fields[3], monthField); err != nil {
+		return nil, fmt.Errorf("cron expression %q: %w", expr, err)
+	}
+	if c.dow, err = parseField(fields[4], dowField); err != nil {
+		return nil, fmt.Errorf("cron expression %q: %w", expr, err)
+	}
+
+	// Fold Sunday-as-7 onto 0
+	if c.dow&(1<<7) != 0 {
+		c.dow |= 1
+		c.dow &^= 1 << 7
+	}
+
+	c.domStar = strings.HasPrefix(fields[2], "*")
+	c.dowStar = strings.HasPrefix(fields[4], "*")
+
+	return c, nil
+}
+
+// String returns the original expression.
+func (c *Cron) String() string {
+	return c.expr
+}
+
+// Next returns the first time strictly after t that matches the schedule,
+// in t's location. Returns the zero time if no match exists within five
+// years (e.g. "0 0 30 2 *").
+func (c *Cron) Next(t time.Time) time.Time {
+	loc := t.Location()
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+
+	for t.Before(limit) {
+		if c.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !c.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if c.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			continue
+		}
+		if c.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+
+	return time.Time{}
+}
+
+// dayMatches applies the cron day rules: when both day fields are
+// restricted either may match, otherwise both must.
+func (c *Cron) dayMatches(t time.Time) bool {
+	domMatch := c.dom&(1<<uint(t.Day())) != 0
+	dowMatch := c.dow&(1<<uint(t.Weekday())) != 0
+	if c.domStar || c.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+// parseField parses a comma-separated list of cron terms into a bitmask.
+func parseField(s string, f field) (uint64, error) {
+	var mask uint64
+	for _, term := range strings.Split(s, ",") {
+		bits, err := parseTerm(term, f)
+		if err != nil {
+			return 0, err
+		}
+		mask |= bits
+	}
+	return mask, nil
+}
+
+// parseTerm parses a single "*", "N", "N-M" term with an optional "/step".
+func parseTerm(term string, f field) (uint64, error) {
+	rangePart, stepPart, hasStep := strings.Cut(term, "/")
+
+	step := 1
+	if hasStep {
+		n, err := strconv.Atoi(stepPart)
+		if err != nil || n <= 0 {
+			return 0, fmt.Errorf("invalid step %q in %s field", stepPart, f.name)
+		}
+		step = n
+	}
+
+	lo, hi := f.min, f.max
+	switch {
+	case rangePart == "*":
+	case strings.Contains(rangePart, "-"):
+		loStr, hiStr, _ := strings.Cut(rangePart, "-")
+		var err error
+		if lo, err = parseValue(loStr, f); err != nil {
+			return 0, err
+		}
+		if hi, err = parseValue(hiStr, f); err != nil {
+			return 0, err
+		}
+		if lo > hi {
+			return 0, fmt.Errorf("invalid range %q in %s field", rangePart, f.name)
+		}
+	default:
+		v, err := parseValue(rangePart, f)
+		if err != nil {
+			return 0, err
+		}
+		lo = v
+		// "N/step" means starting at N through the end of the range
+		if !hasStep {
+			hi = v
+		}
+	}
+
+	var mask uint64
+	for v := lo; v <= hi; v += step {
+		mask |= 1 << uint(v)
+	}
+	return mask, nil
+}
+
+// parseValue parses a single numeric value and checks it against the field range.
+func parseValue(s string, f field) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q in %s field", s, f.name)
+	}
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("value %d out of range [%d-%d] in %s field", v, f.min, f.max, f.name)
+	}
+	return v, nil
+}
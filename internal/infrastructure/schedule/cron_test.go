@@ -0,0 +1,80 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCron_Invalid(t *testing.T) {
+	tests := []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"a * * * *",
+		"@yearly",
+	}
+
+	for _, expr := range tests {
+		t.Run(expr, func(t *testing.T) {
+			_, err := ParseCron(expr)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestCron_Next(t *testing.T) {
+	// Wednesday
+	base := time.Date(2024, 5, 15, 10, 30, 45, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		expr string
+		want time.Time
+	}{
+		{"every minute", "* * * * *", time.Date(2024, 5, 15, 10, 31, 0, 0, time.UTC)},
+		{"every 15 minutes", "*/15 * * * *", time.Date(2024, 5, 15, 10, 45, 0, 0, time.UTC)},
+		{"hourly macro", "@hourly", time.Date(2024, 5, 15, 11, 0, 0, 0, time.UTC)},
+		{"daily at 9 rolls over", "0 9 * * *", time.Date(2024, 5, 16, 9, 0, 0, 0, time.UTC)},
+		{"weekly Monday 9am", "0 9 * * 1", time.Date(2024, 5, 20, 9, 0, 0, 0, time.UTC)},
+		{"sunday as 7", "0 0 * * 7", time.Date(2024, 5, 19, 0, 0, 0, 0, time.UTC)},
+		{"weekday range", "0 8 * * 1-5", time.Date(2024, 5, 16, 8, 0, 0, 0, time.UTC)},
+		{"list of hours", "0 6,18 * * *", time.Date(2024, 5, 15, 18, 0, 0, 0, time.UTC)},
+		{"monthly", "@monthly", time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)},
+		{"day of month or weekday", "0 0 1 * 5", time.Date(2024, 5, 17, 0, 0, 0, 0, time.UTC)},
+		{"next year", "0 0 1 1 *", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"leap day", "0 0 29 2 *", time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := ParseCron(tt.expr)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, c.Next(base))
+		})
+	}
+}
+
+func TestCron_Next_Impossible(t *testing.T) {
+	c, err := ParseCron("0 0 30 2 *")
+	require.NoError(t, err)
+	assert.True(t, c.Next(time.Now()).IsZero())
+}
+
+func TestCron_Next_Location(t *testing.T) {
+	loc := time.FixedZone("UTC+9", 9*60*60)
+	c, err := ParseCron("0 9 * * *")
+	require.NoError(t, err)
+
+	next := c.Next(time.Date(2024, 5, 15, 8, 0, 0, 0, loc))
+	assert.Equal(t, time.Date(2024, 5, 15, 9, 0, 0, 0, loc), next)
+}
@@ -0,0 +1,93 @@
+package schedule
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// Job is a named function run on a cron schedule.
+type Job struct {
+	Name     string
+	Schedule *Cron
+
+	// Location is the time zone the schedule is evaluated in. Defaults to UTC.
+	Location *time.Location
+
+	Run func(ctx context.Context) error
+}
+
+// Scheduler runs jobs on their cron schedules until its context is cancelled.
+// Each job runs in its own goroutine; a slow run delays only that job's
+// next run, never the others.
+type Scheduler struct {
+	jobs   []Job
+	logger *slog.Logger
+	now    func() time.Time
+}
+
+// NewScheduler creates a scheduler for the given jobs.
+func NewScheduler(jobs []Job, logger *slog.Logger) *Scheduler {
+	return &Scheduler{
+		jobs:   jobs,
+		logger: logger,
+		now:    time.Now,
+	}
+}
+
+// Run blocks until ctx is cancelled and all running jobs have returned.
+func (s *Scheduler) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, job := range s.jobs {
+		wg.Add(1)
+		go func(job Job) {
+			defer wg.Done()
+			s.loop(ctx, job)
+		}(job)
+	}
+	wg.Wait()
+}
+
+// loop sleeps until the job's next scheduled time and runs it.
+func (s *Scheduler) loop(ctx context.Context, job Job) {
+	loc := job.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	for {
+		next := job.Schedule.Next(s.now().In(loc))
+		if next.IsZero() {
+			s.logger.Warn("scheduled job has no upcoming run",
+				"job", job.Name,
+				"schedule", job.Schedule.String(),
+			)
+			return
+		}
+
+		s.logger.Debug("scheduled job waiting", "job", job.Name, "next", next)
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		start := s.now()
+		if err := job.Run(ctx); err != nil {
+			s.logger.Error("scheduled job failed",
+				"job", job.Name,
+				"error", err,
+				"duration", time.Since(start),
+			)
+			continue
+		}
+		s.logger.Info("scheduled job completed",
+			"job", job.Name,
+			"duration", time.Since(start),
+		)
+	}
+}
@@ -9,19 +9,47 @@ import (
 	"github.com/altuslabsxyz/alert-bridge/internal/adapter/handler/middleware"
 	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/config"
 	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/observability"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/teams"
 )
 
+// defaultMaxDecompressedBodyBytes bounds decoded webhook bodies when the
+// router config does not set a limit.
+const defaultMaxDecompressedBodyBytes = 10 << 20
+
+// maxWebhookBodyBytes bounds webhook bodies as received, before any
+// decompression.
+const maxWebhookBodyBytes = 10 << 20
+
 // Handlers holds all HTTP handlers.
 type Handlers struct {
 	Alertmanager     *handler.AlertmanagerHandler
+	Grafana          *handler.GrafanaHandler
+	CloudWatch       *handler.CloudWatchHandler
+	Sentry           *handler.SentryHandler
+	Generic          *handler.GenericWebhookHandler
+	BatchIngest      *handler.BatchIngestHandler
+	CloudEvents      *handler.CloudEventsHandler
 	SlackCommands    *handler.SlackCommandsHandler
 	SlackInteraction *handler.SlackInteractionHandler
 	SlackEvents      *handler.SlackEventsHandler
 	PagerDutyWebhook *handler.PagerDutyWebhookHandler
+	TeamsInteraction *handler.TeamsInteractionHandler
 	Health           *handler.HealthHandler
 	Ready            *handler.ReadyHandler
 	Reload           *handler.ReloadHandler
 	Metrics          *handler.MetricsHandler
+	PayloadLog       *handler.PayloadLogHandler
+	AlertHistory     *handler.AlertHistoryHandler
+	AlertsAPI        *handler.AlertsAPIHandler
+	SilencesAPI      *handler.SilencesAPIHandler
+	DeadLettersAPI   *handler.DeadLettersAPIHandler
+	IntegrationsAPI  *handler.IntegrationsAPIHandler
+	OnCallLoadAPI    *handler.OnCallLoadAPIHandler
+	ResponseTimesAPI *handler.ResponseTimesAPIHandler
+	DeliverySLO      *handler.DeliverySLOHandler
+	StateExport      *handler.StateExportHandler
+	IngestionPause   *handler.IngestionPauseHandler
+	Incidents        *handler.IncidentsHandler
 }
 
 // RouterConfig holds optional configuration for the router.
@@ -30,10 +58,25 @@ type RouterConfig struct {
 	ConfigManager *config.ConfigManager
 	// Static configuration (backward compatibility)
 	AlertmanagerWebhookSecret string
+	GrafanaWebhookToken       string
+	SentryClientSecret        string
 	SlackSigningSecret        string
 	PagerDutyWebhookSecret    string
+	TeamsSigningSecret        string
+	FireHydrantWebhookSecret  string
+	IncidentIOWebhookSecret   string
+	APIAuth                   config.APIAuthConfig
 	RequestTimeout            time.Duration
+	MaxDecompressedBodyBytes  int64
 	Metrics                   *observability.Metrics
+
+	// SlackTimestampTolerance returns the accepted Slack request timestamp
+	// distance; nil uses the default.
+	SlackTimestampTolerance func() time.Duration
+
+	// SlackSocketMode receives Slack over Socket Mode, so the
+	// /webhook/slack/* endpoints are not served.
+	SlackSocketMode bool
 }
 
 // NewRouter creates the HTTP router with all handlers (backward compatible).
@@ -62,14 +105,55 @@ func NewRouterWithConfig(handlers *Handlers, logger *slog.Logger, cfg *RouterCon
 		mux.Handle("/metrics", handlers.Metrics)
 	}
 
-	// Admin endpoints
-	if handlers.Reload != nil {
-		mux.Handle("/-/reload", handlers.Reload)
+	// API and admin endpoints require a token with the route's scope. They
+	// can ack, silence and page, so without api_auth tokens they are not
+	// served at all.
+	tokensGetter := apiTokensGetter(cfg)
+	protect := func(scope string, h http.Handler) http.Handler {
+		return middleware.APIAuth(scope, tokensGetter, logger)(h)
+	}
+	if tokensGetter != nil && len(tokensGetter()) > 0 {
+		logger.Info("API authentication enabled",
+			"hot_reload", cfg.ConfigManager != nil,
+		)
+		registerAPI(mux, handlers, protect)
+	} else {
+		// Not found rather than the health response of the root path
+		mux.Handle("/api/", http.NotFoundHandler())
+		mux.Handle("/-/", http.NotFoundHandler())
+		logger.Warn("API and admin endpoints disabled; enable api_auth with at least one token to serve them")
+	}
+
+	// Ingestion endpoints accept gzip and deflate bodies, decoded before
+	// signature checks
+	maxDecompressed := int64(defaultMaxDecompressedBodyBytes)
+	if cfg != nil && cfg.MaxDecompressedBodyBytes > 0 {
+		maxDecompressed = cfg.MaxDecompressedBodyBytes
+	}
+	decompress := middleware.Decompress(maxDecompressed, logger)
+	limitBody := middleware.LimitBody(maxWebhookBodyBytes)
+
+	// Webhook requests rejected for a bad signature or token are counted
+	// per source
+	authFailures := func(source string, h http.Handler) http.Handler {
+		if cfg == nil || cfg.Metrics == nil {
+			return h
+		}
+		return middleware.WebhookAuthFailures(source, cfg.Metrics)(h)
+	}
+
+	// Paused sources are turned away once authenticated, where the
+	// endpoint's auth is a middleware
+	gate := func(source string, h http.Handler) http.Handler {
+		if handlers.IngestionPause == nil {
+			return h
+		}
+		return handlers.IngestionPause.Gate(source, h)
 	}
 
 	// Webhook endpoints
 	if handlers.Alertmanager != nil {
-		var h http.Handler = handlers.Alertmanager
+		h := gate("alertmanager", handlers.Alertmanager)
 
 		// Apply authentication middleware if secret is configured
 		if cfg != nil && cfg.AlertmanagerWebhookSecret != "" {
@@ -77,43 +161,97 @@ func NewRouterWithConfig(handlers *Handlers, logger *slog.Logger, cfg *RouterCon
 			logger.Info("Alertmanager webhook authentication enabled")
 		}
 
-		mux.Handle("/webhook/alertmanager", h)
+		mux.Handle("/webhook/alertmanager", limitBody(decompress(authFailures("alertmanager", h))))
 	}
 
-	if handlers.SlackCommands != nil {
+	if handlers.Grafana != nil {
+		h := gate("grafana", handlers.Grafana)
+
+		// Apply bearer token authentication if configured
+		if cfg != nil && cfg.GrafanaWebhookToken != "" {
+			h = middleware.GrafanaAuth(cfg.GrafanaWebhookToken, logger)(h)
+			logger.Info("Grafana webhook authentication enabled")
+		}
+
+		mux.Handle("/webhook/grafana", limitBody(decompress(authFailures("grafana", h))))
+	}
+
+	// CloudWatch messages authenticate themselves with SNS signatures
+	if handlers.CloudWatch != nil {
+		mux.Handle("/webhook/cloudwatch", limitBody(decompress(authFailures("cloudwatch", gate("cloudwatch", handlers.CloudWatch)))))
+	}
+
+	// Generic sources authenticate per source with their own tokens
+	if handlers.Generic != nil {
+		mux.Handle("/webhook/generic/{name}", limitBody(decompress(authFailures("generic", gate("generic", handlers.Generic)))))
+	}
+
+	// Batch ingestion checks its own bearer token and bounds its own work
+	// per line, so the decompressed size is not capped
+	if handlers.BatchIngest != nil {
+		mux.Handle("/webhook/batch", middleware.Decompress(0, logger)(authFailures("batch", gate("batch", handlers.BatchIngest))))
+	}
+
+	// CloudEvents ingestion checks its own bearer token
+	if handlers.CloudEvents != nil {
+		mux.Handle("/webhook/cloudevents", limitBody(decompress(authFailures("cloudevents", gate("cloudevents", handlers.CloudEvents)))))
+	}
+
+	// Sentry webhooks are only accepted with a valid signature
+	if handlers.Sentry != nil && cfg != nil && cfg.SentryClientSecret != "" {
+		h := middleware.SentryAuth(cfg.SentryClientSecret, logger)(gate("sentry", handlers.Sentry))
+		mux.Handle("/webhook/sentry", limitBody(decompress(authFailures("sentry", h))))
+		logger.Info("Sentry webhook authentication enabled")
+	}
+
+	// Incident management tool webhooks are only accepted with a valid
+	// signature
+	if handlers.Incidents != nil && cfg != nil && cfg.FireHydrantWebhookSecret != "" {
+		h := middleware.FireHydrantAuth(cfg.FireHydrantWebhookSecret, logger)(http.HandlerFunc(handlers.Incidents.FireHydrantWebhook))
+		mux.Handle("POST /webhook/firehydrant", limitBody(authFailures("firehydrant", h)))
+		logger.Info("FireHydrant webhook authentication enabled")
+	}
+	if handlers.Incidents != nil && cfg != nil && cfg.IncidentIOWebhookSecret != "" {
+		h := middleware.IncidentIOAuth(cfg.IncidentIOWebhookSecret, logger)(http.HandlerFunc(handlers.Incidents.IncidentIOWebhook))
+		mux.Handle("POST /webhook/incidentio", limitBody(authFailures("incidentio", h)))
+		logger.Info("incident.io webhook authentication enabled")
+	}
+
+	slackWebhooks := cfg == nil || !cfg.SlackSocketMode
+	if handlers.SlackCommands != nil && slackWebhooks {
 		var h http.Handler = handlers.SlackCommands
 
 		// Apply Slack authentication middleware
 		if cfg != nil && cfg.SlackSigningSecret != "" {
-			h = middleware.SlackAuth(cfg.SlackSigningSecret, logger)(h)
+			h = middleware.SlackAuth(cfg.SlackSigningSecret, cfg.SlackTimestampTolerance, logger)(h)
 			logger.Info("Slack commands webhook authentication enabled")
 		}
 
-		mux.Handle("/webhook/slack/commands", h)
+		mux.Handle("/webhook/slack/commands", limitBody(authFailures("slack", h)))
 	}
 
-	if handlers.SlackInteraction != nil {
+	if handlers.SlackInteraction != nil && slackWebhooks {
 		var h http.Handler = handlers.SlackInteraction
 
 		// Apply Slack authentication middleware
 		if cfg != nil && cfg.SlackSigningSecret != "" {
-			h = middleware.SlackAuth(cfg.SlackSigningSecret, logger)(h)
+			h = middleware.SlackAuth(cfg.SlackSigningSecret, cfg.SlackTimestampTolerance, logger)(h)
 			logger.Info("Slack interactions webhook authentication enabled")
 		}
 
-		mux.Handle("/webhook/slack/interactions", h)
+		mux.Handle("/webhook/slack/interactions", limitBody(authFailures("slack", h)))
 	}
 
-	if handlers.SlackEvents != nil {
+	if handlers.SlackEvents != nil && slackWebhooks {
 		var h http.Handler = handlers.SlackEvents
 
 		// Apply Slack authentication middleware
 		if cfg != nil && cfg.SlackSigningSecret != "" {
-			h = middleware.SlackAuth(cfg.SlackSigningSecret, logger)(h)
+			h = middleware.SlackAuth(cfg.SlackSigningSecret, cfg.SlackTimestampTolerance, logger)(h)
 			logger.Info("Slack events webhook authentication enabled")
 		}
 
-		mux.Handle("/webhook/slack/events", h)
+		mux.Handle("/webhook/slack/events", limitBody(authFailures("slack", h)))
 	}
 
 	if handlers.PagerDutyWebhook != nil {
@@ -140,11 +278,26 @@ func NewRouterWithConfig(handlers *Handlers, logger *slog.Logger, cfg *RouterCon
 			)
 		}
 
-		mux.Handle("/webhook/pagerduty", h)
+		mux.Handle("/webhook/pagerduty", limitBody(authFailures("pagerduty", h)))
 	}
 
-	// Apply middleware stack
+	if handlers.TeamsInteraction != nil {
+		var h http.Handler = handlers.TeamsInteraction
+
+		// Teams action links are always signed; without a secret no link can verify
+		secret := ""
+		if cfg != nil {
+			secret = cfg.TeamsSigningSecret
+		}
+		h = middleware.TeamsAuth(secret, logger)(h)
+
+		mux.Handle(teams.ActionPath, authFailures("teams", h))
+	}
+
+	// Apply middleware stack; tracing wraps the mux directly so spans are
+	// named after the matched route
 	var h http.Handler = mux
+	h = middleware.Tracing()(h)
 	h = middleware.RequestID(h)
 	h = middleware.Logging(logger)(h)
 	h = middleware.Recovery(logger)(h)
@@ -162,3 +315,105 @@ func NewRouterWithConfig(handlers *Handlers, logger *slog.Logger, cfg *RouterCon
 
 	return h
 }
+
+// registerAPI mounts the REST API (/api/v1) and admin (/-/) endpoints,
+// each wrapped by protect with the scope it requires.
+func registerAPI(mux *http.ServeMux, handlers *Handlers, protect func(scope string, h http.Handler) http.Handler) {
+	// Admin endpoints
+	if handlers.Reload != nil {
+		mux.Handle("/-/reload", protect(middleware.ScopeAdmin, handlers.Reload))
+	}
+	if handlers.PayloadLog != nil {
+		mux.Handle("/-/payloads", protect(middleware.ScopeAdmin, handlers.PayloadLog))
+	}
+	if handlers.DeliverySLO != nil {
+		mux.Handle("/-/slo", protect(middleware.ScopeRead, handlers.DeliverySLO))
+	}
+	if handlers.StateExport != nil {
+		mux.Handle("/-/state", protect(middleware.ScopeAdmin, handlers.StateExport))
+	}
+	if handlers.IngestionPause != nil {
+		mux.Handle("GET /-/ingestion", protect(middleware.ScopeAdmin, http.HandlerFunc(handlers.IngestionPause.List)))
+		mux.Handle("POST /-/ingestion/{source}/pause", protect(middleware.ScopeAdmin, http.HandlerFunc(handlers.IngestionPause.Pause)))
+		mux.Handle("POST /-/ingestion/{source}/resume", protect(middleware.ScopeAdmin, http.HandlerFunc(handlers.IngestionPause.Resume)))
+	}
+
+	// Alert API
+	if handlers.AlertsAPI != nil {
+		mux.Handle("GET /api/v1/alerts", protect(middleware.ScopeRead, http.HandlerFunc(handlers.AlertsAPI.List)))
+		mux.Handle("POST /api/v1/alerts", protect(middleware.ScopeAck, http.HandlerFunc(handlers.AlertsAPI.Create)))
+		mux.Handle("GET /api/v1/alerts/{id}", protect(middleware.ScopeRead, http.HandlerFunc(handlers.AlertsAPI.Get)))
+		mux.Handle("GET /api/v1/alerts/{id}/timeline", protect(middleware.ScopeRead, http.HandlerFunc(handlers.AlertsAPI.Timeline)))
+		mux.Handle("POST /api/v1/alerts/{id}/ack", protect(middleware.ScopeAck, http.HandlerFunc(handlers.AlertsAPI.Ack)))
+		mux.Handle("POST /api/v1/alerts/{id}/resolve", protect(middleware.ScopeAck, http.HandlerFunc(handlers.AlertsAPI.Resolve)))
+		mux.Handle("POST /api/v1/alerts/{id}/notes", protect(middleware.ScopeAck, http.HandlerFunc(handlers.AlertsAPI.AddNote)))
+		mux.Handle("POST /api/v1/alerts/{id}/restore", protect(middleware.ScopeAdmin, http.HandlerFunc(handlers.AlertsAPI.Restore)))
+		mux.Handle("POST /api/v1/sources/{source}/resolve", protect(middleware.ScopeAdmin, http.HandlerFunc(handlers.AlertsAPI.ResolveSource)))
+	}
+	if handlers.Incidents != nil {
+		mux.Handle("PUT /api/v1/alerts/{id}/incidents/{tool}", protect(middleware.ScopeAck, http.HandlerFunc(handlers.Incidents.Link)))
+		mux.Handle("DELETE /api/v1/alerts/{id}/incidents/{tool}", protect(middleware.ScopeAck, http.HandlerFunc(handlers.Incidents.Unlink)))
+	}
+	if handlers.AlertHistory != nil {
+		mux.Handle("GET /api/v1/alerts/active-at", protect(middleware.ScopeRead, handlers.AlertHistory))
+	}
+	if handlers.SilencesAPI != nil {
+		mux.Handle("GET /api/v1/silences", protect(middleware.ScopeRead, http.HandlerFunc(handlers.SilencesAPI.List)))
+		mux.Handle("POST /api/v1/silences", protect(middleware.ScopeSilence, http.HandlerFunc(handlers.SilencesAPI.Create)))
+		mux.Handle("DELETE /api/v1/silences/{id}", protect(middleware.ScopeSilence, http.HandlerFunc(handlers.SilencesAPI.Delete)))
+		mux.Handle("POST /api/v1/silences/{id}/restore", protect(middleware.ScopeAdmin, http.HandlerFunc(handlers.SilencesAPI.Restore)))
+	}
+	if handlers.DeadLettersAPI != nil {
+		// Dead letter IDs contain slashes, hence the trailing wildcard
+		mux.Handle("GET /api/v1/dead-letters", protect(middleware.ScopeRead, http.HandlerFunc(handlers.DeadLettersAPI.List)))
+		mux.Handle("POST /api/v1/dead-letters/replay", protect(middleware.ScopeAdmin, http.HandlerFunc(handlers.DeadLettersAPI.Replay)))
+		mux.Handle("DELETE /api/v1/dead-letters/{id...}", protect(middleware.ScopeAdmin, http.HandlerFunc(handlers.DeadLettersAPI.Delete)))
+	}
+	if handlers.IntegrationsAPI != nil {
+		mux.Handle("GET /api/v1/integrations", protect(middleware.ScopeRead, http.HandlerFunc(handlers.IntegrationsAPI.List)))
+	}
+	if handlers.OnCallLoadAPI != nil {
+		mux.Handle("GET /api/v1/reports/oncall", protect(middleware.ScopeRead, handlers.OnCallLoadAPI))
+	}
+	if handlers.ResponseTimesAPI != nil {
+		mux.Handle("GET /api/v1/reports/response-times", protect(middleware.ScopeRead, handlers.ResponseTimesAPI))
+	}
+}
+
+// apiTokensGetter returns the source of API tokens, or nil if API
+// authentication can never apply. With a ConfigManager the getter is always
+// returned, so changing tokens takes effect on reload, and disabling auth
+// turns every API request away.
+func apiTokensGetter(cfg *RouterConfig) middleware.APITokensGetter {
+	if cfg == nil {
+		return nil
+	}
+	if cfg.ConfigManager != nil {
+		return func() []middleware.APIToken {
+			return apiTokens(cfg.ConfigManager.Get().APIAuth)
+		}
+	}
+	tokens := apiTokens(cfg.APIAuth)
+	if tokens == nil {
+		return nil
+	}
+	return func() []middleware.APIToken {
+		return tokens
+	}
+}
+
+// apiTokens converts configured tokens. Returns nil if auth is disabled.
+func apiTokens(cfg config.APIAuthConfig) []middleware.APIToken {
+	if !cfg.Enabled {
+		return nil
+	}
+	tokens := make([]middleware.APIToken, 0, len(cfg.Tokens))
+	for _, t := range cfg.Tokens {
+		tokens = append(tokens, middleware.APIToken{
+			Name:   t.Name,
+			Token:  t.Token,
+			Scopes: t.Scopes,
+		})
+	}
+	return tokens
+}
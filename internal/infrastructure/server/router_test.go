@@ -0,0 +1,58 @@
+package server
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/adapter/handler"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/config"
+)
+
+func TestNewRouterWithConfig_APIAuth(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	handlers := &Handlers{
+		Health:         handler.NewHealthHandler(),
+		IngestionPause: handler.NewIngestionPauseHandler(logger),
+	}
+	get := func(router http.Handler, path, token string) int {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	tests := []struct {
+		name    string
+		apiAuth config.APIAuthConfig
+		token   string
+		want    int
+	}{
+		{"auth disabled", config.APIAuthConfig{}, "", http.StatusNotFound},
+		{"auth enabled without tokens", config.APIAuthConfig{Enabled: true}, "", http.StatusNotFound},
+		{"tokens without auth enabled", config.APIAuthConfig{Tokens: []config.APITokenConfig{{Name: "ops", Token: "secret", Scopes: []string{"admin"}}}}, "secret", http.StatusNotFound},
+		{"missing token", config.APIAuthConfig{Enabled: true, Tokens: []config.APITokenConfig{{Name: "ops", Token: "secret", Scopes: []string{"admin"}}}}, "", http.StatusUnauthorized},
+		{"valid token", config.APIAuthConfig{Enabled: true, Tokens: []config.APITokenConfig{{Name: "ops", Token: "secret", Scopes: []string{"admin"}}}}, "secret", http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := NewRouterWithConfig(handlers, logger, &RouterConfig{APIAuth: tt.apiAuth})
+			if got := get(router, "/-/ingestion", tt.token); got != tt.want {
+				t.Errorf("GET /-/ingestion = %d, want %d", got, tt.want)
+			}
+		})
+	}
+
+	// Without a config nothing is protected, so nothing is served
+	if got := get(NewRouter(handlers, logger), "/-/ingestion", ""); got != http.StatusNotFound {
+		t.Errorf("GET /-/ingestion without config = %d, want 404", got)
+	}
+	if got := get(NewRouter(handlers, logger), "/health", ""); got != http.StatusOK {
+		t.Errorf("GET /health = %d, want 200", got)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"net"
 	"net/http"
 	"time"
 
@@ -17,6 +18,9 @@ type Server struct {
 	logger           *slog.Logger
 	cfg              config.ServerConfig
 	socketModeClient *slack.SocketModeClient
+
+	// Called once the HTTP listener accepts connections (optional)
+	onListening func()
 }
 
 // New creates a new HTTP server with optional Socket Mode client.
@@ -33,7 +37,7 @@ func New(cfg config.Config, handler http.Handler, logger *slog.Logger) (*Server,
 	}
 
 	// Initialize Socket Mode client if enabled
-	if cfg.Slack.Enabled && cfg.Slack.SocketMode.Enabled {
+	if cfg.IsSlackSocketMode() {
 		logger.Info("initializing Socket Mode client",
 			"debug", cfg.Slack.SocketMode.Debug,
 			"ping_interval", cfg.Slack.SocketMode.PingInterval)
@@ -61,14 +65,21 @@ func (s *Server) Run(ctx context.Context) error {
 	errChan := make(chan error, 2)
 
 	// Start HTTP server
+	s.logger.Info("starting HTTP server",
+		"addr", s.server.Addr,
+	)
+	listener, err := net.Listen("tcp", s.server.Addr)
+	if err != nil {
+		return fmt.Errorf("HTTP server error: %w", err)
+	}
 	go func() {
-		s.logger.Info("starting HTTP server",
-			"addr", s.server.Addr,
-		)
-		if err := s.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		if err := s.server.Serve(listener); err != nil && err != http.ErrServerClosed {
 			errChan <- fmt.Errorf("HTTP server error: %w", err)
 		}
 	}()
+	if s.onListening != nil {
+		s.onListening()
+	}
 
 	// Start Socket Mode client if enabled
 	if s.socketModeClient != nil {
@@ -149,6 +160,11 @@ func (s *Server) Run(ctx context.Context) error {
 	return nil
 }
 
+// OnListening sets fn to be called once Run has bound the HTTP listener.
+func (s *Server) OnListening(fn func()) {
+	s.onListening = fn
+}
+
 // Addr returns the server address.
 func (s *Server) Addr() string {
 	return s.server.Addr
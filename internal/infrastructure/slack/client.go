@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/http"
 	"strings"
 	"time"
 
@@ -12,68 +13,124 @@ import (
 
 	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
 	domainerrors "github.com/altuslabsxyz/alert-bridge/internal/domain/errors"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/notifytemplate"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/payloadlog"
 )
 
 // Client wraps the Slack API client with domain-specific operations.
 // Implements the alert.Notifier interface.
 type Client struct {
 	api            *slack.Client
+	botToken       string
+	apiURL         string
 	channelID      string
 	messageBuilder *MessageBuilder
+	recorder       *payloadlog.Recorder
+
+	// Per-label-value alert channels (optional)
+	channelLabel string
+	channels     map[string]string
+
+	// Per-channel post pacing (optional)
+	rateLimiter *ChannelRateLimiter
+
+	// History window searched for a message to update before posting
+	// (optional)
+	duplicateWindow time.Duration
 }
 
 // NewClient creates a new Slack client.
 func NewClient(botToken, channelID string, silenceDurations []time.Duration, apiURL ...string) *Client {
-	var api *slack.Client
-	if len(apiURL) > 0 && apiURL[0] != "" {
-		// Use custom API URL (for E2E testing)
-		api = slack.New(botToken, slack.OptionAPIURL(apiURL[0]))
-	} else {
-		api = slack.New(botToken)
-	}
-
-	return &Client{
-		api:            api,
+	c := &Client{
+		botToken:       botToken,
 		channelID:      channelID,
 		messageBuilder: NewMessageBuilder(silenceDurations),
 	}
+	if len(apiURL) > 0 {
+		c.apiURL = apiURL[0]
+	}
+	c.api = c.newAPI()
+	return c
 }
 
-// Notify sends an alert to Slack.
-// Returns the message ID in the format "channel:timestamp".
-func (c *Client) Notify(ctx context.Context, alert *entity.Alert) (string, error) {
-	blocks := c.messageBuilder.BuildAlertMessage(alert)
-
-	options := []slack.MsgOption{
-		slack.MsgOptionBlocks(blocks...),
-	}
+// SetLabelChannels posts alerts to the channel mapped from the value of
+// their label, such as one channel per team. Alerts without a mapped value
+// go to the default channel.
+func (c *Client) SetLabelChannels(label string, channels map[string]string) {
+	c.channelLabel = label
+	c.channels = channels
+}
 
-	channelID, timestamp, err := c.api.PostMessageContext(ctx, c.channelID, options...)
+// SetFallbackTemplate sets the text/template of the plain-text line sent
+// with alert messages, shown in push notifications. See
+// ParseFallbackTemplate.
+func (c *Client) SetFallbackTemplate(text string) error {
+	tmpl, err := ParseFallbackTemplate(text)
 	if err != nil {
-		return "", categorizeSlackError(err, "posting slack message")
+		return fmt.Errorf("parsing slack fallback template: %w", err)
 	}
+	c.messageBuilder.SetFallbackTemplate(tmpl)
+	return nil
+}
 
-	// Return channel:timestamp as message ID
-	return fmt.Sprintf("%s:%s", channelID, timestamp), nil
+// SetTemplates sets the notification templates used for message bodies.
+func (c *Client) SetTemplates(templates *notifytemplate.Set) {
+	c.messageBuilder.SetTemplates(templates)
 }
 
-// NotifyWithMentions sends an alert to Slack with user mentions.
-// All matching subscribers are mentioned at once in the message.
-// Returns the message ID in the format "channel:timestamp".
-func (c *Client) NotifyWithMentions(ctx context.Context, alert *entity.Alert, slackUserIDs []string) (string, error) {
-	blocks := c.messageBuilder.BuildAlertMessageWithMentions(alert, slackUserIDs)
+// SetReadOnly posts alert messages without action buttons, such as copies
+// in a shadow channel.
+func (c *Client) SetReadOnly(readOnly bool) {
+	c.messageBuilder.SetReadOnly(readOnly)
+}
 
-	options := []slack.MsgOption{
-		slack.MsgOptionBlocks(blocks...),
+// SetCustomActions adds the matching custom action buttons to alert
+// messages.
+func (c *Client) SetCustomActions(actions *CustomActions) {
+	c.messageBuilder.SetCustomActions(actions)
+}
+
+// SetHTTPClient sends API calls through httpClient, such as one sharing a
+// tuned connection pool.
+func (c *Client) SetHTTPClient(httpClient *http.Client) {
+	c.api = c.newAPI(slack.OptionHTTPClient(httpClient))
+}
+
+func (c *Client) newAPI(options ...slack.Option) *slack.Client {
+	if c.apiURL != "" {
+		// Use custom API URL (for E2E testing)
+		options = append(options, slack.OptionAPIURL(c.apiURL))
 	}
+	return slack.New(c.botToken, options...)
+}
 
-	channelID, timestamp, err := c.api.PostMessageContext(ctx, c.channelID, options...)
-	if err != nil {
-		return "", categorizeSlackError(err, "posting slack message")
+// Notify sends an alert to Slack, in the channel chosen by targetChannel.
+// The result's reference ID has the format "channel:timestamp".
+func (c *Client) Notify(ctx context.Context, alert *entity.Alert) (entity.NotifyResult, error) {
+	return c.postAlert(ctx, alert, c.messageBuilder.BuildAlertMessage(alert))
+}
+
+// targetChannel picks the alert's channel: the override in ctx, then the
+// channel mapped from the alert's label, then the default channel. The
+// returned message ID carries the channel, so updates find the message
+// wherever it was posted.
+func (c *Client) targetChannel(ctx context.Context, alert *entity.Alert) string {
+	if channel := entity.NotificationChannelFromContext(ctx); channel != "" {
+		return channel
+	}
+	if c.channelLabel != "" {
+		if channel, ok := c.channels[alert.GetLabel(c.channelLabel)]; ok {
+			return channel
+		}
 	}
+	return c.channelID
+}
 
-	// Return channel:timestamp as message ID
-	return fmt.Sprintf("%s:%s", channelID, timestamp), nil
+// NotifyWithMentions sends an alert to Slack with user mentions.
+// All matching subscribers are mentioned at once in the message.
+// The result's reference ID has the format "channel:timestamp".
+func (c *Client) NotifyWithMentions(ctx context.Context, alert *entity.Alert, slackUserIDs []string) (entity.NotifyResult, error) {
+	return c.postAlert(ctx, alert, c.messageBuilder.BuildAlertMessageWithMentions(alert, slackUserIDs))
 }
 
 // UpdateMessage updates an existing Slack message.
@@ -98,9 +155,12 @@ func (c *Client) UpdateMessage(ctx context.Context, messageID string, alert *ent
 
 	options := []slack.MsgOption{
 		slack.MsgOptionBlocks(blocks...),
+		slack.MsgOptionText(c.messageBuilder.BuildFallbackText(alert), false),
+		alertMetadata(alert),
 	}
 
 	_, _, _, err = c.api.UpdateMessageContext(ctx, channelID, timestamp, options...)
+	c.record("update", messageID, blocks, err)
 	if err != nil {
 		return categorizeSlackError(err, "updating slack message")
 	}
@@ -108,11 +168,104 @@ func (c *Client) UpdateMessage(ctx context.Context, messageID string, alert *ent
 	return nil
 }
 
+// Permalink returns the URL of an alert message.
+func (c *Client) Permalink(ctx context.Context, messageID string) (string, error) {
+	channelID, timestamp, err := parseMessageID(messageID)
+	if err != nil {
+		return "", err
+	}
+
+	permalink, err := c.api.GetPermalinkContext(ctx, &slack.PermalinkParameters{Channel: channelID, Ts: timestamp})
+	if err != nil {
+		return "", categorizeSlackError(err, "getting slack permalink")
+	}
+	return permalink, nil
+}
+
+// DeleteMessage deletes an alert message.
+func (c *Client) DeleteMessage(ctx context.Context, messageID string) error {
+	channelID, timestamp, err := parseMessageID(messageID)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = c.api.DeleteMessageContext(ctx, channelID, timestamp)
+	c.record("delete", messageID, nil, err)
+	if err != nil {
+		return categorizeSlackError(err, "deleting slack message")
+	}
+	return nil
+}
+
+// CollapseMessage replaces an alert message with its one-line record.
+func (c *Client) CollapseMessage(ctx context.Context, messageID string, alert *entity.Alert) error {
+	channelID, timestamp, err := parseMessageID(messageID)
+	if err != nil {
+		return err
+	}
+
+	blocks := c.messageBuilder.BuildCompactMessage(alert)
+	_, _, _, err = c.api.UpdateMessageContext(ctx, channelID, timestamp,
+		slack.MsgOptionBlocks(blocks...),
+		slack.MsgOptionText(c.messageBuilder.BuildFallbackText(alert), false),
+		alertMetadata(alert),
+	)
+	c.record("update", messageID, blocks, err)
+	if err != nil {
+		return categorizeSlackError(err, "collapsing slack message")
+	}
+	return nil
+}
+
+// PostAlertRecord posts the one-line record of an alert to a channel, such
+// as a history channel.
+func (c *Client) PostAlertRecord(ctx context.Context, channelID string, alert *entity.Alert) error {
+	blocks := c.messageBuilder.BuildCompactMessage(alert)
+	_, _, err := c.postMessage(ctx, channelID,
+		slack.MsgOptionBlocks(blocks...),
+		slack.MsgOptionText(c.messageBuilder.BuildFallbackText(alert), false),
+	)
+	c.record("post", channelID, blocks, err)
+	if err != nil {
+		return categorizeSlackError(err, "posting slack alert record")
+	}
+	return nil
+}
+
 // Name returns the notifier identifier.
 func (c *Client) Name() string {
 	return "slack"
 }
 
+// Capabilities reports that messages are edited in place, mention
+// subscribers and carry ack buttons.
+func (c *Client) Capabilities() entity.NotifierCapabilities {
+	return entity.NotifierCapabilities{Update: true, Mentions: true, Ack: true}
+}
+
+// SetRecorder enables recording of outbound alert messages.
+func (c *Client) SetRecorder(recorder *payloadlog.Recorder) {
+	c.recorder = recorder
+}
+
+// record stores the blocks sent for an alert message.
+func (c *Client) record(operation, target string, blocks []slack.Block, err error) {
+	c.recorder.Record(c.Name(), operation, target, map[string]any{"blocks": blocks}, err)
+}
+
+// SetRateLimiter paces posts per channel.
+func (c *Client) SetRateLimiter(limiter *ChannelRateLimiter) {
+	c.rateLimiter = limiter
+}
+
+// postMessage posts a message once the channel's rate limit allows it.
+func (c *Client) postMessage(ctx context.Context, channelID string, options ...slack.MsgOption) (string, string, error) {
+	if err := c.rateLimiter.Wait(ctx, channelID); err != nil {
+		return "", "", err
+	}
+	return c.api.PostMessageContext(ctx, channelID, options...)
+}
+
 // PostThreadReply posts a reply in a thread.
 func (c *Client) PostThreadReply(ctx context.Context, messageID, text string) error {
 	channelID, timestamp, err := parseMessageID(messageID)
@@ -125,7 +278,7 @@ func (c *Client) PostThreadReply(ctx context.Context, messageID, text string) er
 		slack.MsgOptionTS(timestamp),
 	}
 
-	_, _, err = c.api.PostMessageContext(ctx, channelID, options...)
+	_, _, err = c.postMessage(ctx, channelID, options...)
 	if err != nil {
 		return categorizeSlackError(err, "posting thread reply")
 	}
@@ -133,6 +286,64 @@ func (c *Client) PostThreadReply(ctx context.Context, messageID, text string) er
 	return nil
 }
 
+// PostText posts a plain-text message to the given channel.
+func (c *Client) PostText(ctx context.Context, channelID, text string) error {
+	if _, _, err := c.postMessage(ctx, channelID, slack.MsgOptionText(text, false)); err != nil {
+		return categorizeSlackError(err, "posting message")
+	}
+	return nil
+}
+
+// PostReport posts a scheduled alert report to the given channel.
+// An empty channelID posts to the default alert channel.
+func (c *Client) PostReport(ctx context.Context, channelID string, report *entity.AlertReport) error {
+	if channelID == "" {
+		channelID = c.channelID
+	}
+
+	options := []slack.MsgOption{
+		slack.MsgOptionBlocks(c.messageBuilder.BuildReportMessage(report)...),
+		slack.MsgOptionText("Alert report: "+report.Name, false),
+	}
+
+	if _, _, err := c.postMessage(ctx, channelID, options...); err != nil {
+		return categorizeSlackError(err, "posting report")
+	}
+
+	return nil
+}
+
+// PostResponseTimes posts a response times digest to the given channel.
+// An empty channelID posts to the default alert channel.
+func (c *Client) PostResponseTimes(ctx context.Context, channelID string, report *entity.ResponseTimeReport) error {
+	if channelID == "" {
+		channelID = c.channelID
+	}
+
+	options := []slack.MsgOption{
+		slack.MsgOptionBlocks(c.messageBuilder.BuildResponseTimesMessage(report)...),
+		slack.MsgOptionText("Response times: "+formatReportMean(report.Overall.MTTA)+" MTTA, "+formatReportMean(report.Overall.MTTR)+" MTTR", false),
+	}
+
+	if _, _, err := c.postMessage(ctx, channelID, options...); err != nil {
+		return categorizeSlackError(err, "posting response times")
+	}
+
+	return nil
+}
+
+// SendOnCallLoad sends a person their own on-call load for the month as a
+// direct message from the bot.
+func (c *Client) SendOnCallLoad(ctx context.Context, userID string, month time.Time, load *entity.PersonOnCallLoad) error {
+	text := fmt.Sprintf("*Your on-call load for %s*\nPages: %d · Acknowledged: %d · After hours: %d",
+		month.Format("January 2006"), load.Pages, load.Acks, load.AfterHoursAcks)
+
+	if _, _, err := c.postMessage(ctx, userID, slack.MsgOptionText(text, false)); err != nil {
+		return categorizeSlackError(err, "sending on-call load")
+	}
+	return nil
+}
+
 // GetUserInfo retrieves user information by ID.
 func (c *Client) GetUserInfo(ctx context.Context, userID string) (*slack.User, error) {
 	user, err := c.api.GetUserInfoContext(ctx, userID)
@@ -178,6 +389,15 @@ func (c *Client) OpenModal(ctx context.Context, triggerID string, view slack.Mod
 	return nil
 }
 
+// PostEphemeral posts a message in a channel that only the given user sees.
+func (c *Client) PostEphemeral(ctx context.Context, channelID, userID, text string) error {
+	_, err := c.api.PostEphemeralContext(ctx, channelID, userID, slack.MsgOptionText(text, false))
+	if err != nil {
+		return categorizeSlackError(err, "posting ephemeral message")
+	}
+	return nil
+}
+
 // GetActiveAlertLabels retrieves unique label keys and values from active alerts.
 // This is used to populate label autocomplete in the silence modal.
 func (c *Client) GetActiveAlertLabels(ctx context.Context, alertRepo interface {
@@ -261,6 +481,12 @@ func categorizeSlackError(err error, operation string) error {
 				err,
 			)
 
+		// Message deleted, e.g. by hand - not found
+		case "message_not_found":
+			return domainerrors.Wrap(err, domainerrors.CategoryNotFound,
+				fmt.Sprintf("%s: %s", operation, slackErr.Err),
+			)
+
 		// Client errors - permanent
 		case "invalid_auth", "account_inactive", "token_revoked", "no_permission",
 			"channel_not_found", "not_in_channel", "is_archived":
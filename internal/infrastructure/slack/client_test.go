@@ -0,0 +1,152 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/slack-go/slack"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+func TestClient_TargetChannel(t *testing.T) {
+	client := NewClient("xoxb-test", "C-DEFAULT", nil)
+	client.SetLabelChannels("team", map[string]string{"payments": "C-PAYMENTS"})
+
+	payments := entity.NewAlert("fp1", "HighLatency", "api-1", "", "summary", entity.SeverityWarning)
+	payments.AddLabel("team", "payments")
+	infra := entity.NewAlert("fp2", "DiskFull", "db-1", "", "summary", entity.SeverityWarning)
+	infra.AddLabel("team", "infra")
+	ctx := context.Background()
+
+	assert.Equal(t, "C-PAYMENTS", client.targetChannel(ctx, payments))
+	assert.Equal(t, "C-DEFAULT", client.targetChannel(ctx, infra))
+
+	// A routing override wins over the label mapping
+	override := entity.WithNotificationChannel(ctx, "C-ROUTED")
+	assert.Equal(t, "C-ROUTED", client.targetChannel(override, payments))
+}
+
+func TestParseAlertMessage(t *testing.T) {
+	builder := NewMessageBuilder(nil)
+	alert := entity.NewAlert("fp1", "HighLatency", "api-1", "", "summary", entity.SeverityWarning)
+
+	active, ok := parseAlertMessage("C1", slack.Message{Msg: slack.Msg{
+		Timestamp: "1700000000.000100",
+		Blocks:    slack.Blocks{BlockSet: builder.BuildAlertMessage(alert)},
+	}})
+	assert.True(t, ok)
+	assert.Equal(t, "C1:1700000000.000100", active.MessageID)
+	assert.Equal(t, alert.ID, active.AlertID)
+	assert.Equal(t, "HighLatency", active.AlertName)
+	assert.Equal(t, time.Unix(1700000000, 0), active.PostedAt)
+
+	alert.Resolve(time.Now())
+	resolved, ok := parseAlertMessage("C1", slack.Message{Msg: slack.Msg{
+		Timestamp: "1700000000.000100",
+		Blocks:    slack.Blocks{BlockSet: builder.BuildResolvedMessage(alert)},
+	}})
+	assert.True(t, ok)
+	assert.Equal(t, alert.ID, resolved.AlertID)
+	assert.Equal(t, "HighLatency", resolved.AlertName)
+
+	_, ok = parseAlertMessage("C1", slack.Message{Msg: slack.Msg{Timestamp: "1700000000.000100", Text: "hello"}})
+	assert.False(t, ok)
+}
+
+func TestParseAlertMessage_Metadata(t *testing.T) {
+	builder := NewMessageBuilder(nil)
+	alert := entity.NewAlert("fp1", "HighLatency", "api-1", "", "summary", entity.SeverityWarning)
+	alert.Resolve(time.Now())
+
+	// Collapsed messages have no buttons; the metadata names the alert
+	msg := slack.Message{Msg: slack.Msg{
+		Timestamp: "1700000000.000100",
+		Blocks: slack.Blocks{BlockSet: []slack.Block{
+			slack.NewHeaderBlock(slack.NewTextBlockObject(slack.PlainTextType, "✅  HighLatency", false, false)),
+		}},
+		Metadata: slack.SlackMetadata{
+			EventType:    alertMetadataEventType,
+			EventPayload: map[string]any{"alert_id": alert.ID, "fingerprint": "fp1", "state": "resolved"},
+		},
+	}}
+	found, ok := parseAlertMessage("C1", msg)
+	assert.True(t, ok)
+	assert.Equal(t, alert.ID, found.AlertID)
+
+	identity, ok := ParseAlertMetadata(msg.Metadata)
+	assert.True(t, ok)
+	assert.Equal(t, AlertIdentity{AlertID: alert.ID, Fingerprint: "fp1", State: entity.StateResolved}, identity)
+
+	// Other apps' metadata is ignored
+	_, ok = ParseAlertMetadata(slack.SlackMetadata{EventType: "deploy", EventPayload: map[string]any{"alert_id": "x"}})
+	assert.False(t, ok)
+
+	// Messages posted before metadata fall back to the buttons
+	active := entity.NewAlert("fp2", "DiskFull", "db-1", "", "summary", entity.SeverityWarning)
+	found, ok = parseAlertMessage("C1", slack.Message{Msg: slack.Msg{
+		Timestamp: "1700000000.000100",
+		Blocks:    slack.Blocks{BlockSet: builder.BuildAlertMessage(active)},
+	}})
+	assert.True(t, ok)
+	assert.Equal(t, active.ID, found.AlertID)
+}
+
+func TestClient_Notify_DuplicateCheck(t *testing.T) {
+	alert := entity.NewAlert("fp1", "HighLatency", "api-1", "", "summary", entity.SeverityWarning)
+
+	var posted, updated int
+	var history slack.GetConversationHistoryResponse
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/conversations.history":
+			assert.Equal(t, "1", r.FormValue("include_all_metadata"))
+			history.Ok = true
+			_ = json.NewEncoder(w).Encode(history)
+		case "/chat.postMessage":
+			posted++
+			_, _ = w.Write([]byte(`{"ok":true,"channel":"C1","ts":"1700000002.000100"}`))
+		case "/chat.update":
+			updated++
+			_, _ = w.Write([]byte(`{"ok":true,"channel":"C1","ts":"1700000001.000100"}`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("xoxb-test", "C1", nil, server.URL+"/")
+	client.SetDuplicateCheck(time.Hour)
+	ctx := context.Background()
+
+	// No earlier message: posted
+	result, err := client.Notify(ctx, alert)
+	require.NoError(t, err)
+	assert.Equal(t, "C1:1700000002.000100", result.ReferenceID)
+	assert.Equal(t, "C1", result.Channel)
+	assert.Equal(t, 1, posted)
+
+	// An unresolved message of the fingerprint is updated instead
+	history.Messages = []slack.Message{
+		{Msg: slack.Msg{Timestamp: "1700000001.000100", Metadata: slack.SlackMetadata{
+			EventType:    alertMetadataEventType,
+			EventPayload: map[string]any{"alert_id": "a1", "fingerprint": "fp1", "state": "active"},
+		}}},
+	}
+	result, err = client.Notify(ctx, alert)
+	require.NoError(t, err)
+	assert.Equal(t, "C1:1700000001.000100", result.ReferenceID)
+	assert.Equal(t, 1, posted)
+	assert.Equal(t, 1, updated)
+
+	// Resolved messages are not reused
+	history.Messages[0].Metadata.EventPayload["state"] = "resolved"
+	_, err = client.Notify(ctx, alert)
+	require.NoError(t, err)
+	assert.Equal(t, 2, posted)
+}
@@ -0,0 +1,248 @@
+package slack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/slack-go/slack"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// CustomActionType is the action type of custom action buttons. Their
+// action ID is CustomActionType + "_" + the alert ID + "_" + the action
+// name.
+const CustomActionType = "custom"
+
+// customActionTimeout bounds a custom action's webhook call, which runs
+// while Slack waits for the interaction to be acknowledged.
+const customActionTimeout = 10 * time.Second
+
+// CustomAction is a button added to the messages of matching alerts. It
+// either opens URL or posts the alert to Webhook.
+type CustomAction struct {
+	// Name identifies the action in action IDs and webhook payloads.
+	Name string
+
+	// Label is the button text.
+	Label string
+
+	// Style is "primary", "danger" or empty for the default.
+	Style string
+
+	// URL opens a link, executed with .Alert like the fallback template.
+	URL *template.Template
+
+	// Webhook receives a POST with the alert and who clicked.
+	Webhook string
+
+	// Confirm asks for confirmation before the action runs.
+	Confirm bool
+
+	// Match and MatchRE select the alerts the button is shown on, like
+	// routes. No entries matches every alert.
+	Match   map[string]string
+	MatchRE map[string]*regexp.Regexp
+}
+
+// ParseCustomActionURL parses the URL template of a custom action.
+func ParseCustomActionURL(text string) (*template.Template, error) {
+	return template.New("url").Option("missingkey=zero").Parse(text)
+}
+
+// Matches reports whether the button is shown on the alert.
+func (a *CustomAction) Matches(alert *entity.Alert) bool {
+	for name, value := range a.Match {
+		if alert.GetLabel(name) != value {
+			return false
+		}
+	}
+	for name, re := range a.MatchRE {
+		if !re.MatchString(alert.GetLabel(name)) {
+			return false
+		}
+	}
+	return true
+}
+
+// CustomActionID returns the action ID of the button of the named action
+// on an alert.
+func CustomActionID(alertID, name string) string {
+	return CustomActionType + "_" + alertID + "_" + name
+}
+
+// ParseCustomActionID splits what follows CustomActionType in an action ID
+// into the alert ID and the action name. Alert IDs are UUIDs, which have
+// no underscores.
+func ParseCustomActionID(rest string) (alertID, name string) {
+	alertID, name, _ = strings.Cut(rest, "_")
+	return alertID, name
+}
+
+// CustomActions holds the configured custom actions and runs their
+// webhooks.
+type CustomActions struct {
+	actions    []CustomAction
+	httpClient *http.Client
+}
+
+// NewCustomActions creates the custom actions, calling webhooks with
+// httpClient.
+func NewCustomActions(actions []CustomAction, httpClient *http.Client) *CustomActions {
+	return &CustomActions{
+		actions:    actions,
+		httpClient: httpClient,
+	}
+}
+
+// For returns the actions shown on the alert, in configuration order.
+func (c *CustomActions) For(alert *entity.Alert) []CustomAction {
+	var matched []CustomAction
+	for _, action := range c.actions {
+		if action.Matches(alert) {
+			matched = append(matched, action)
+		}
+	}
+	return matched
+}
+
+// customActionPayload is the body posted to a custom action's webhook.
+type customActionPayload struct {
+	Action string            `json:"action"`
+	Alert  customActionAlert `json:"alert"`
+	User   customActionUser  `json:"user"`
+	SentAt time.Time         `json:"sent_at"`
+}
+
+type customActionAlert struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Fingerprint string            `json:"fingerprint"`
+	Instance    string            `json:"instance"`
+	Severity    string            `json:"severity"`
+	State       string            `json:"state"`
+	Summary     string            `json:"summary"`
+	Labels      map[string]string `json:"labels"`
+	Annotations map[string]string `json:"annotations"`
+}
+
+type customActionUser struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email,omitempty"`
+}
+
+// Run runs the named action for the alert as clicked by the user, and
+// returns a confirmation for them. Link buttons are opened by Slack, so
+// there is nothing to run for them.
+func (c *CustomActions) Run(ctx context.Context, name string, alert *entity.Alert, userID, userName, userEmail string) (string, error) {
+	action := c.find(name)
+	if action == nil {
+		return "", fmt.Errorf("unknown custom action %q", name)
+	}
+	if action.Webhook == "" {
+		return "", nil
+	}
+
+	payload, err := json.Marshal(customActionPayload{
+		Action: action.Name,
+		Alert: customActionAlert{
+			ID:          alert.ID,
+			Name:        alert.Name,
+			Fingerprint: alert.Fingerprint,
+			Instance:    alert.Instance,
+			Severity:    string(alert.Severity),
+			State:       string(alert.State),
+			Summary:     alert.Summary,
+			Labels:      alert.Labels,
+			Annotations: alert.Annotations,
+		},
+		User:   customActionUser{ID: userID, Name: userName, Email: userEmail},
+		SentAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("marshaling custom action payload: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, customActionTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, action.Webhook, bytes.NewReader(payload))
+	if err != nil {
+		return "", fmt.Errorf("creating custom action request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("calling %s webhook: %w", action.Name, err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", fmt.Errorf("%s webhook returned status %d", action.Name, resp.StatusCode)
+	}
+	return fmt.Sprintf("Ran *%s* for %s.", action.Label, alert.Name), nil
+}
+
+// find returns the named action, or nil.
+func (c *CustomActions) find(name string) *CustomAction {
+	for i := range c.actions {
+		if c.actions[i].Name == name {
+			return &c.actions[i]
+		}
+	}
+	return nil
+}
+
+// buildCustomActions builds the block of the custom action buttons shown
+// on the alert, or nil if there are none.
+func (b *MessageBuilder) buildCustomActions(alert *entity.Alert) *slack.ActionBlock {
+	if b.customActions == nil || alert.IsResolved() {
+		return nil
+	}
+	actions := b.customActions.For(alert)
+	if len(actions) == 0 {
+		return nil
+	}
+
+	elements := make([]slack.BlockElement, 0, len(actions))
+	for _, action := range actions {
+		btn := slack.NewButtonBlockElement(
+			CustomActionID(alert.ID, action.Name),
+			action.Name,
+			slack.NewTextBlockObject(slack.PlainTextType, action.Label, true, false),
+		)
+		if action.URL != nil {
+			var url strings.Builder
+			if err := action.URL.Execute(&url, fallbackData{Alert: alert}); err != nil || url.Len() == 0 {
+				continue
+			}
+			btn.URL = url.String()
+		}
+		if action.Style != "" {
+			btn.Style = slack.Style(action.Style)
+		}
+		if action.Confirm {
+			btn.Confirm = slack.NewConfirmationBlockObject(
+				slack.NewTextBlockObject(slack.PlainTextType, action.Label, false, false),
+				slack.NewTextBlockObject(slack.MarkdownType, fmt.Sprintf("Run *%s* for %s?", action.Label, alert.Name), false, false),
+				slack.NewTextBlockObject(slack.PlainTextType, "Run", false, false),
+				slack.NewTextBlockObject(slack.PlainTextType, "Cancel", false, false),
+			)
+		}
+		elements = append(elements, btn)
+	}
+	if len(elements) == 0 {
+		return nil
+	}
+	return slack.NewActionBlock("custom_actions_"+alert.ID, elements...)
+}
@@ -0,0 +1,121 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/slack-go/slack"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+func TestBuildAlertMessage_CustomActions(t *testing.T) {
+	dashboard, err := ParseCustomActionURL("https://grafana.example.com/d/host?var-host={{ .Alert.Instance }}")
+	require.NoError(t, err)
+
+	builder := NewMessageBuilder(nil)
+	builder.SetCustomActions(NewCustomActions([]CustomAction{
+		{Name: "dashboard", Label: "Open dashboard", URL: dashboard},
+		{
+			Name:    "restart",
+			Label:   "Restart service",
+			Webhook: "https://runner.example.com/restart",
+			Confirm: true,
+			Style:   "danger",
+			Match:   map[string]string{"team": "payments"},
+		},
+		{
+			Name:    "failover",
+			Label:   "Fail over",
+			Webhook: "https://runner.example.com/failover",
+			MatchRE: map[string]*regexp.Regexp{"instance": regexp.MustCompile("^(?:db-.*)$")},
+		},
+	}, http.DefaultClient))
+
+	alert := entity.NewAlert("fp1", "HighCPU", "web-1", "", "summary", entity.SeverityCritical)
+	alert.Labels = map[string]string{"team": "payments"}
+
+	customBlock := findBlock(t, builder.BuildAlertMessage(alert), "custom_actions_"+alert.ID)
+	require.Len(t, customBlock.Elements.ElementSet, 2)
+
+	link := customBlock.Elements.ElementSet[0].(*slack.ButtonBlockElement)
+	assert.Equal(t, "https://grafana.example.com/d/host?var-host=web-1", link.URL)
+	assert.Nil(t, link.Confirm)
+
+	restart := customBlock.Elements.ElementSet[1].(*slack.ButtonBlockElement)
+	assert.Equal(t, CustomActionID(alert.ID, "restart"), restart.ActionID)
+	assert.Equal(t, slack.StyleDanger, restart.Style)
+	assert.NotNil(t, restart.Confirm)
+
+	alertID, name := ParseCustomActionID(restart.ActionID[len(CustomActionType)+1:])
+	assert.Equal(t, alert.ID, alertID)
+	assert.Equal(t, "restart", name)
+
+	// Resolved alerts have no custom actions
+	alert.Resolve(time.Now())
+	for _, block := range builder.BuildAlertMessage(alert) {
+		if actions, ok := block.(*slack.ActionBlock); ok {
+			assert.NotEqual(t, "custom_actions_"+alert.ID, actions.BlockID)
+		}
+	}
+}
+
+func findBlock(t *testing.T, blocks []slack.Block, blockID string) *slack.ActionBlock {
+	t.Helper()
+	for _, block := range blocks {
+		if actions, ok := block.(*slack.ActionBlock); ok && actions.BlockID == blockID {
+			return actions
+		}
+	}
+	t.Fatalf("no action block %q", blockID)
+	return nil
+}
+
+func TestCustomActions_Run(t *testing.T) {
+	var received customActionPayload
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	dashboard, err := ParseCustomActionURL("https://grafana.example.com")
+	require.NoError(t, err)
+	actions := NewCustomActions([]CustomAction{
+		{Name: "restart", Label: "Restart service", Webhook: server.URL},
+		{Name: "dashboard", Label: "Open dashboard", URL: dashboard},
+	}, server.Client())
+
+	ctx := context.Background()
+	alert := entity.NewAlert("fp1", "HighCPU", "web-1", "", "summary", entity.SeverityCritical)
+	alert.Labels = map[string]string{"team": "payments"}
+
+	msg, err := actions.Run(ctx, "restart", alert, "U1", "alice", "alice@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "Ran *Restart service* for HighCPU.", msg)
+	assert.Equal(t, "restart", received.Action)
+	assert.Equal(t, alert.ID, received.Alert.ID)
+	assert.Equal(t, "payments", received.Alert.Labels["team"])
+	assert.Equal(t, "alice", received.User.Name)
+
+	// Link buttons have nothing to run
+	msg, err = actions.Run(ctx, "dashboard", alert, "U1", "alice", "")
+	require.NoError(t, err)
+	assert.Empty(t, msg)
+
+	status = http.StatusInternalServerError
+	_, err = actions.Run(ctx, "restart", alert, "U1", "alice", "")
+	assert.ErrorContains(t, err, "status 500")
+
+	_, err = actions.Run(ctx, "unknown", alert, "U1", "alice", "")
+	assert.Error(t, err)
+}
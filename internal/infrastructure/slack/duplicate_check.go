@@ -0,0 +1,99 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/slack-go/slack"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	domainerrors "github.com/altuslabsxyz/alert-bridge/internal/domain/errors"
+)
+
+// SetDuplicateCheck makes Notify look for a message of the same alert
+// posted within window before posting, and update it instead. This guards
+// against duplicate messages when the alert store lost track of them, such
+// as after a crash with memory storage. Requires the channels:history
+// scope (groups:history for private channels).
+func (c *Client) SetDuplicateCheck(window time.Duration) {
+	c.duplicateWindow = window
+}
+
+// postAlert posts an alert message to the alert's channel, or updates the
+// unresolved message of the same fingerprint when the duplicate check finds
+// one. The result's reference ID has the format "channel:timestamp".
+func (c *Client) postAlert(ctx context.Context, alert *entity.Alert, blocks []slack.Block) (entity.NotifyResult, error) {
+	options := []slack.MsgOption{
+		slack.MsgOptionBlocks(blocks...),
+		slack.MsgOptionText(c.messageBuilder.BuildFallbackText(alert), false),
+		alertMetadata(alert),
+	}
+
+	target := c.targetChannel(ctx, alert)
+
+	// A failed lookup must not hold back the alert, so it falls back to
+	// posting.
+	if messageID, err := c.findDuplicate(ctx, target, alert.Fingerprint); err == nil && messageID != "" {
+		channelID, timestamp, _ := parseMessageID(messageID)
+		_, _, _, err = c.api.UpdateMessageContext(ctx, channelID, timestamp, options...)
+		c.record("update", messageID, blocks, err)
+		if err == nil {
+			return entity.NotifyResult{ReferenceID: messageID, Channel: channelID, DeliveredAt: time.Now()}, nil
+		}
+	}
+
+	channelID, timestamp, err := c.postMessage(ctx, target, options...)
+	c.record("post", target, blocks, err)
+	if err != nil {
+		err = categorizeSlackError(err, "posting slack message")
+		return entity.NotifyResult{Channel: target, Retriable: domainerrors.IsTransientError(err)}, err
+	}
+
+	// Return channel:timestamp as message ID
+	return entity.NotifyResult{
+		ReferenceID: fmt.Sprintf("%s:%s", channelID, timestamp),
+		Channel:     channelID,
+		DeliveredAt: time.Now(),
+	}, nil
+}
+
+// findDuplicate returns the ID of the newest unresolved message of the
+// fingerprint posted to the channel within the duplicate check window, or
+// "" if there is none or the check is disabled.
+func (c *Client) findDuplicate(ctx context.Context, channelID, fingerprint string) (string, error) {
+	if c.duplicateWindow <= 0 {
+		return "", nil
+	}
+
+	params := &slack.GetConversationHistoryParameters{
+		ChannelID:          channelID,
+		Oldest:             fmt.Sprintf("%d.000000", time.Now().Add(-c.duplicateWindow).Unix()),
+		Limit:              200,
+		IncludeAllMetadata: true,
+	}
+	for {
+		resp, err := c.api.GetConversationHistoryContext(ctx, params)
+		if err != nil {
+			return "", categorizeSlackError(err, "reading slack channel history")
+		}
+		// Messages are listed newest first
+		for _, msg := range resp.Messages {
+			if isDuplicate(msg, fingerprint) {
+				return fmt.Sprintf("%s:%s", channelID, msg.Timestamp), nil
+			}
+		}
+		if !resp.HasMore || resp.ResponseMetaData.NextCursor == "" {
+			return "", nil
+		}
+		params.Cursor = resp.ResponseMetaData.NextCursor
+	}
+}
+
+// isDuplicate reports whether msg is the unresolved alert message of the
+// fingerprint. Resolved messages are left alone, so an alert firing again
+// gets a new message.
+func isDuplicate(msg slack.Message, fingerprint string) bool {
+	identity, ok := ParseAlertMetadata(msg.Metadata)
+	return ok && identity.Fingerprint == fingerprint && identity.State != entity.StateResolved
+}
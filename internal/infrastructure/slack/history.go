@@ -0,0 +1,118 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/slack-go/slack"
+)
+
+// AlertMessage is an alert message found in channel history.
+type AlertMessage struct {
+	// MessageID is "channel:timestamp", as returned by Notify.
+	MessageID string
+
+	// AlertID is read from the action buttons. Empty for resolved
+	// messages, which have none.
+	AlertID string
+
+	// AlertName is read from the message header.
+	AlertName string
+
+	PostedAt time.Time
+}
+
+// FindAlertMessages lists the alert messages posted since the given time in
+// the default channel and the label channels. Requires the channels:history
+// scope (groups:history for private channels).
+func (c *Client) FindAlertMessages(ctx context.Context, since time.Time) ([]AlertMessage, error) {
+	var messages []AlertMessage
+	for _, channelID := range c.alertChannels() {
+		found, err := c.findChannelAlertMessages(ctx, channelID, since)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, found...)
+	}
+	return messages, nil
+}
+
+// alertChannels returns the distinct channels alerts may be posted to.
+func (c *Client) alertChannels() []string {
+	seen := map[string]bool{c.channelID: true}
+	channels := []string{c.channelID}
+	for _, channelID := range c.channels {
+		if !seen[channelID] {
+			seen[channelID] = true
+			channels = append(channels, channelID)
+		}
+	}
+	return channels
+}
+
+func (c *Client) findChannelAlertMessages(ctx context.Context, channelID string, since time.Time) ([]AlertMessage, error) {
+	params := &slack.GetConversationHistoryParameters{
+		ChannelID: channelID,
+		Oldest:    fmt.Sprintf("%d.000000", since.Unix()),
+		Limit:     200,
+
+		// For the alert identity in message metadata
+		IncludeAllMetadata: true,
+	}
+
+	var messages []AlertMessage
+	for {
+		resp, err := c.api.GetConversationHistoryContext(ctx, params)
+		if err != nil {
+			return nil, categorizeSlackError(err, "reading slack channel history")
+		}
+		for _, msg := range resp.Messages {
+			if found, ok := parseAlertMessage(channelID, msg); ok {
+				messages = append(messages, found)
+			}
+		}
+		if !resp.HasMore || resp.ResponseMetaData.NextCursor == "" {
+			return messages, nil
+		}
+		params.Cursor = resp.ResponseMetaData.NextCursor
+	}
+}
+
+// parseAlertMessage recognizes a message built by MessageBuilder: a header
+// block of "<emoji>  <alert name>", with the alert ID in the message
+// metadata or, for messages posted before they carried metadata, in the
+// action buttons.
+func parseAlertMessage(channelID string, msg slack.Message) (AlertMessage, bool) {
+	found := AlertMessage{MessageID: fmt.Sprintf("%s:%s", channelID, msg.Timestamp)}
+	identity, hasIdentity := ParseAlertMetadata(msg.Metadata)
+
+	for _, block := range msg.Blocks.BlockSet {
+		switch b := block.(type) {
+		case *slack.HeaderBlock:
+			if found.AlertName == "" && b.Text != nil {
+				if _, name, ok := strings.Cut(b.Text.Text, "  "); ok {
+					found.AlertName = name
+				}
+			}
+		case *slack.ActionBlock:
+			if alertID, ok := strings.CutPrefix(b.BlockID, "actions_"); ok {
+				found.AlertID = alertID
+			}
+		}
+	}
+	if found.AlertName == "" {
+		return AlertMessage{}, false
+	}
+	if hasIdentity {
+		found.AlertID = identity.AlertID
+	}
+
+	seconds, _, _ := strings.Cut(msg.Timestamp, ".")
+	if unix, err := strconv.ParseInt(seconds, 10, 64); err == nil {
+		found.PostedAt = time.Unix(unix, 0)
+	}
+	return found, true
+}
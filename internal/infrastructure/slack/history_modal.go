@@ -0,0 +1,87 @@
+package slack
+
+import (
+	"fmt"
+
+	"github.com/slack-go/slack"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// HistoryModalCallbackID is the callback ID of the alert history modal.
+const HistoryModalCallbackID = "alert_history_modal"
+
+// maxHistoryEvents bounds the events listed in the history modal, which
+// Slack limits to 100 blocks. The most recent events are kept.
+const maxHistoryEvents = 90
+
+// historyEventLabels names each event type in the history modal.
+var historyEventLabels = map[entity.AlertEventType]string{
+	entity.AlertEventFired:              ":red_circle: Fired",
+	entity.AlertEventSeverityChanged:    ":arrow_up_down: Severity changed",
+	entity.AlertEventAcknowledged:       ":eyes: Acknowledged",
+	entity.AlertEventResolved:           ":white_check_mark: Resolved",
+	entity.AlertEventNotified:           ":incoming_envelope: Notified",
+	entity.AlertEventNotificationFailed: ":warning: Notification failed",
+	entity.AlertEventNotificationShed:   ":wastebasket: Notification shed",
+	entity.AlertEventNoteAdded:          ":memo: Note added",
+	entity.AlertEventSilenced:           ":no_bell: Silenced",
+	entity.AlertEventAssigned:           ":bust_in_silhouette: Assigned",
+	entity.AlertEventUnacknowledged:     ":leftwards_arrow_with_hook: Unacknowledged",
+	entity.AlertEventEscalated:          ":arrow_double_up: Escalated",
+}
+
+// BuildHistoryModal creates a read-only modal listing an alert's timeline,
+// oldest event first.
+func BuildHistoryModal(alert *entity.Alert, events []*entity.AlertEvent) slack.ModalViewRequest {
+	blocks := []slack.Block{
+		slack.NewSectionBlock(
+			slack.NewTextBlockObject(slack.MarkdownType, fmt.Sprintf("*%s*", alert.Name), false, false),
+			nil, nil,
+		),
+	}
+
+	if len(events) > maxHistoryEvents {
+		blocks = append(blocks, slack.NewContextBlock("",
+			slack.NewTextBlockObject(slack.MarkdownType,
+				fmt.Sprintf("_%d earlier events not shown_", len(events)-maxHistoryEvents), false, false),
+		))
+		events = events[len(events)-maxHistoryEvents:]
+	}
+	if len(events) == 0 {
+		blocks = append(blocks, slack.NewContextBlock("",
+			slack.NewTextBlockObject(slack.MarkdownType, "_No events recorded_", false, false),
+		))
+	}
+
+	for _, event := range events {
+		blocks = append(blocks, slack.NewContextBlock("",
+			slack.NewTextBlockObject(slack.MarkdownType, FormatSlackTime(event.CreatedAt, SlackDateShort), false, false),
+			slack.NewTextBlockObject(slack.MarkdownType, formatHistoryEvent(event), false, false),
+		))
+	}
+
+	return slack.ModalViewRequest{
+		Type:       slack.VTModal,
+		CallbackID: HistoryModalCallbackID,
+		Title:      slack.NewTextBlockObject(slack.PlainTextType, "Alert History", false, false),
+		Close:      slack.NewTextBlockObject(slack.PlainTextType, "Close", false, false),
+		Blocks:     slack.Blocks{BlockSet: blocks},
+	}
+}
+
+// formatHistoryEvent describes an event as "Label by who: detail".
+func formatHistoryEvent(event *entity.AlertEvent) string {
+	text, ok := historyEventLabels[event.Type]
+	if !ok {
+		text = string(event.Type)
+	}
+	text = "*" + text + "*"
+	if event.By != "" {
+		text += " by " + event.By
+	}
+	if event.Detail != "" {
+		text += ": " + event.Detail
+	}
+	return text
+}
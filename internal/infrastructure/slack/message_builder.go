@@ -2,12 +2,15 @@ package slack
 
 import (
 	"fmt"
+	"sort"
 	"strings"
+	"text/template"
 	"time"
 
 	"github.com/slack-go/slack"
 
 	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/notifytemplate"
 )
 
 // Bright, modern color palette
@@ -44,9 +47,29 @@ func FormatSlackTime(t time.Time, format string) string {
 	return fmt.Sprintf("<!date^%d^%s|%s>", unix, format, fallback)
 }
 
+// DefaultFallbackTemplate renders the notification text of an alert
+// message, such as "🔴 HighCPU on server-01".
+const DefaultFallbackTemplate = `{{.Emoji}} {{.Alert.Name}}{{with .Alert.Instance}} on {{.}}{{end}}`
+
+var defaultFallbackTemplate = template.Must(template.New("fallback").Parse(DefaultFallbackTemplate))
+
+// fallbackData is the view passed to the fallback text template.
+type fallbackData struct {
+	Alert  *entity.Alert
+	Emoji  string
+	Status string
+}
+
 // MessageBuilder constructs Slack Block Kit messages for alerts.
 type MessageBuilder struct {
 	silenceDurations []time.Duration
+	fallbackTemplate *template.Template
+	customActions    *CustomActions
+	users            UserDirectory
+	templates        *notifytemplate.Set
+
+	// readOnly lists the actions as text instead of buttons
+	readOnly bool
 }
 
 // NewMessageBuilder creates a new message builder with the given silence durations.
@@ -61,9 +84,56 @@ func NewMessageBuilder(silenceDurations []time.Duration) *MessageBuilder {
 	}
 	return &MessageBuilder{
 		silenceDurations: silenceDurations,
+		fallbackTemplate: defaultFallbackTemplate,
 	}
 }
 
+// ParseFallbackTemplate parses a text/template for the fallback text. It
+// is executed with .Alert, .Emoji and .Status.
+func ParseFallbackTemplate(text string) (*template.Template, error) {
+	return template.New("fallback").Option("missingkey=zero").Parse(text)
+}
+
+// SetFallbackTemplate replaces the default fallback text template.
+func (b *MessageBuilder) SetFallbackTemplate(tmpl *template.Template) {
+	b.fallbackTemplate = tmpl
+}
+
+// SetTemplates sets the templates; a slack_message template replaces the
+// summary below the header.
+func (b *MessageBuilder) SetTemplates(templates *notifytemplate.Set) {
+	b.templates = templates
+}
+
+// SetCustomActions adds the matching custom action buttons to the messages
+// of alerts that are not resolved.
+func (b *MessageBuilder) SetCustomActions(actions *CustomActions) {
+	b.customActions = actions
+}
+
+// SetReadOnly replaces the action buttons with a line listing them, for
+// copies of alerts whose buttons must not act on the alert.
+func (b *MessageBuilder) SetReadOnly(readOnly bool) {
+	b.readOnly = readOnly
+}
+
+// BuildFallbackText creates the plain-text line sent alongside the blocks,
+// which Slack shows in push notifications and other places that do not
+// render blocks. A template that fails to execute falls back to the
+// default line.
+func (b *MessageBuilder) BuildFallbackText(alert *entity.Alert) string {
+	emoji, status, _ := b.getStatusInfo(alert)
+	data := fallbackData{Alert: alert, Emoji: emoji, Status: status}
+
+	var buf strings.Builder
+	if err := b.fallbackTemplate.Execute(&buf, data); err == nil && strings.TrimSpace(buf.String()) != "" {
+		return strings.TrimSpace(buf.String())
+	}
+	buf.Reset()
+	_ = defaultFallbackTemplate.Execute(&buf, data)
+	return buf.String()
+}
+
 // BuildUserMentions creates a formatted string of Slack user mentions.
 // Example output: "<@U123> <@U456> <@U789>"
 func BuildUserMentions(userIDs []string) string {
@@ -94,11 +164,41 @@ func (b *MessageBuilder) BuildAckedMessage(alert *entity.Alert) []slack.Block {
 	return b.buildMessage(alert, false, true, nil)
 }
 
-// BuildResolvedMessage creates a message for a resolved alert (no buttons).
+// BuildResolvedMessage creates a message for a resolved alert, with only the
+// history button.
 func (b *MessageBuilder) BuildResolvedMessage(alert *entity.Alert) []slack.Block {
 	return b.buildMessage(alert, false, false, nil)
 }
 
+// BuildCompactMessage creates a one-line record of a resolved alert, used to
+// collapse its message or to log it in a history channel.
+func (b *MessageBuilder) BuildCompactMessage(alert *entity.Alert) []slack.Block {
+	emoji, _, _ := b.getStatusInfo(alert)
+	text := fmt.Sprintf("%s *%s*", emoji, alert.Name)
+	if alert.Instance != "" {
+		text += fmt.Sprintf(" `%s`", alert.Instance)
+	}
+	text += " · fired " + FormatSlackTime(alert.FiredAt, SlackDateShort)
+	if alert.ResolvedAt != nil {
+		duration := formatReportMean(alert.ResolvedAt.Sub(alert.FiredAt))
+		switch alert.ResolutionCategory() {
+		case entity.ResolutionManual:
+			text += fmt.Sprintf(" · resolved by %s after %s", b.formatUser(alert.ResolvedBy()), duration)
+		case entity.ResolutionAuto:
+			text += " · auto-resolved after " + duration
+		default:
+			text += " · resolved after " + duration
+		}
+	}
+	if alert.AckedBy != "" {
+		text += " · acked by " + b.formatUser(alert.AckedBy)
+	}
+
+	return []slack.Block{
+		slack.NewContextBlock("", slack.NewTextBlockObject(slack.MarkdownType, text, false, false)),
+	}
+}
+
 // buildMessage creates a clean, bright Block Kit message with configurable button options.
 // slackUserIDs is optional - if provided, mentions will be added at the top of the message.
 func (b *MessageBuilder) buildMessage(alert *entity.Alert, showAckButton, showSilenceButton bool, slackUserIDs []string) []slack.Block {
@@ -121,10 +221,14 @@ func (b *MessageBuilder) buildMessage(alert *entity.Alert, showAckButton, showSi
 		slack.NewTextBlockObject(slack.PlainTextType, headerText, true, false),
 	))
 
-	// Summary (if available) - light and simple
-	if alert.Summary != "" {
+	// Summary (if available) - light and simple, or the configured template
+	body := alert.Summary
+	if text, ok := b.templates.Render(notifytemplate.SlackMessage, alert); ok {
+		body = text
+	}
+	if body != "" {
 		blocks = append(blocks, slack.NewSectionBlock(
-			slack.NewTextBlockObject(slack.MarkdownType, alert.Summary, false, false),
+			slack.NewTextBlockObject(slack.MarkdownType, body, false, false),
 			nil, nil,
 		))
 	}
@@ -132,10 +236,18 @@ func (b *MessageBuilder) buildMessage(alert *entity.Alert, showAckButton, showSi
 	// Compact info line
 	blocks = append(blocks, b.buildCompactInfo(alert))
 
+	// Custom fields from the tenant schema
+	if fieldsBlock := b.buildCustomFieldsSection(alert); fieldsBlock != nil {
+		blocks = append(blocks, fieldsBlock)
+	}
+
 	// Action buttons (configurable)
-	if showAckButton || showSilenceButton {
-		if actionBlock := b.buildActionButtons(alert.ID, showAckButton, showSilenceButton); actionBlock != nil {
-			blocks = append(blocks, actionBlock)
+	if b.readOnly {
+		blocks = append(blocks, b.buildActionList(showAckButton, showSilenceButton))
+	} else {
+		blocks = append(blocks, b.buildActionButtons(alert.ID, showAckButton, showSilenceButton))
+		if customBlock := b.buildCustomActions(alert); customBlock != nil {
+			blocks = append(blocks, customBlock)
 		}
 	}
 
@@ -169,9 +281,45 @@ func (b *MessageBuilder) buildCompactInfo(alert *entity.Alert) *slack.ContextBlo
 				fmt.Sprintf("`%s`", alert.Target), false, false))
 	}
 
+	// Responder tags
+	if len(alert.Tags) > 0 {
+		elements = append(elements,
+			slack.NewTextBlockObject(slack.MarkdownType,
+				fmt.Sprintf(":label: %s", strings.Join(alert.Tags, ", ")), false, false))
+	}
+
 	return slack.NewContextBlock("", elements...)
 }
 
+// maxSectionFields is Slack's limit on fields in a single section block.
+const maxSectionFields = 10
+
+// buildCustomFieldsSection renders custom fields as section fields, sorted by name.
+// Returns nil when the alert has no custom fields.
+func (b *MessageBuilder) buildCustomFieldsSection(alert *entity.Alert) *slack.SectionBlock {
+	if len(alert.CustomFields) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(alert.CustomFields))
+	for name := range alert.CustomFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	if len(names) > maxSectionFields {
+		names = names[:maxSectionFields]
+	}
+
+	fields := make([]*slack.TextBlockObject, 0, len(names))
+	for _, name := range names {
+		fields = append(fields,
+			slack.NewTextBlockObject(slack.MarkdownType,
+				fmt.Sprintf("*%s*\n%s", name, alert.CustomFields[name]), false, false))
+	}
+
+	return slack.NewSectionBlock(nil, fields, nil)
+}
+
 // getStatusInfo returns emoji, text, and color for the alert status.
 func (b *MessageBuilder) getStatusInfo(alert *entity.Alert) (emoji, text, color string) {
 	switch {
@@ -240,13 +388,48 @@ func (b *MessageBuilder) buildTimelineContext(alert *entity.Alert) *slack.Contex
 	if alert.IsAcked() && alert.AckedBy != "" {
 		elements = append(elements,
 			slack.NewTextBlockObject(slack.MarkdownType,
-				fmt.Sprintf("by %s", alert.AckedBy), false, false))
+				fmt.Sprintf("by %s", b.formatUser(alert.AckedBy)), false, false))
+	}
+
+	// Assignee, mentioned when their Slack user ID is known
+	if alert.IsAssigned() && !alert.IsResolved() {
+		assignee := alert.AssignedTo
+		if alert.AssigneeSlackID != "" {
+			assignee = fmt.Sprintf("<@%s>", alert.AssigneeSlackID)
+		}
+		elements = append(elements,
+			slack.NewTextBlockObject(slack.MarkdownType,
+				fmt.Sprintf("assigned to %s", assignee), false, false))
 	}
 
 	return slack.NewContextBlock("", elements...)
 }
 
-// buildActionButtons creates action buttons.
+// buildActionList names the actions buildActionButtons would show, without
+// interactive elements.
+func (b *MessageBuilder) buildActionList(showAck, showSilence bool) *slack.ContextBlock {
+	var actions []string
+	if showAck {
+		actions = append(actions, "Acknowledge")
+	}
+	if showSilence {
+		durations := make([]string, len(b.silenceDurations))
+		for i, d := range b.silenceDurations {
+			durations[i] = b.formatDuration(d)
+		}
+		actions = append(actions, "Silence ("+strings.Join(durations, ", ")+")")
+	}
+	if len(actions) > 0 {
+		actions = append(actions, "Resolve", "Tag", "Assign")
+	}
+	actions = append(actions, "View history")
+
+	text := ":lock: Actions disabled in this copy: " + strings.Join(actions, " · ")
+	return slack.NewContextBlock("", slack.NewTextBlockObject(slack.MarkdownType, text, false, false))
+}
+
+// buildActionButtons creates action buttons. The history button is always
+// shown, so the timeline stays reachable after the alert resolves.
 func (b *MessageBuilder) buildActionButtons(alertID string, showAck, showSilence bool) *slack.ActionBlock {
 	var elements []slack.BlockElement
 
@@ -280,10 +463,45 @@ func (b *MessageBuilder) buildActionButtons(alertID string, showAck, showSilence
 		elements = append(elements, silenceSelect)
 	}
 
-	if len(elements) == 0 {
-		return nil
+	// Resolve, Tag and assignment, shown alongside the other actions
+	if len(elements) > 0 {
+		resolveBtn := slack.NewButtonBlockElement(
+			fmt.Sprintf("resolve_%s", alertID),
+			alertID,
+			slack.NewTextBlockObject(slack.PlainTextType, "Resolve", true, false),
+		)
+		elements = append(elements, resolveBtn)
+
+		tagBtn := slack.NewButtonBlockElement(
+			fmt.Sprintf("tag_%s", alertID),
+			alertID,
+			slack.NewTextBlockObject(slack.PlainTextType, "Tag", true, false),
+		)
+		elements = append(elements, tagBtn)
+
+		// Ownership: the clicker, or anyone picked from the users select
+		assignBtn := slack.NewButtonBlockElement(
+			fmt.Sprintf("assign_%s", alertID),
+			alertID,
+			slack.NewTextBlockObject(slack.PlainTextType, "Assign to me", true, false),
+		)
+		elements = append(elements, assignBtn)
+
+		assignSelect := slack.NewOptionsSelectBlockElement(
+			slack.OptTypeUser,
+			slack.NewTextBlockObject(slack.PlainTextType, "Assign to...", false, false),
+			fmt.Sprintf("assignuser_%s", alertID),
+		)
+		elements = append(elements, assignSelect)
 	}
 
+	historyBtn := slack.NewButtonBlockElement(
+		fmt.Sprintf("history_%s", alertID),
+		alertID,
+		slack.NewTextBlockObject(slack.PlainTextType, "View history", true, false),
+	)
+	elements = append(elements, historyBtn)
+
 	return slack.NewActionBlock(fmt.Sprintf("actions_%s", alertID), elements...)
 }
 
@@ -1,10 +1,14 @@
 package slack
 
 import (
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/slack-go/slack"
+
 	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/notifytemplate"
 )
 
 func TestFormatSlackTime(t *testing.T) {
@@ -164,3 +168,195 @@ func createTestAlert() *entity.Alert {
 	alert.FiredAt = time.Date(2024, 1, 21, 15, 0, 0, 0, time.UTC)
 	return alert
 }
+
+func TestBuildFallbackText(t *testing.T) {
+	alert := entity.NewAlert("fp", "HighCPU", "server-01", "", "CPU above 90%", entity.SeverityCritical)
+	alert.Labels = map[string]string{"cluster": "prod-eu"}
+
+	tests := []struct {
+		name     string
+		template string
+		want     string
+	}{
+		{
+			name: "default",
+			want: "🔴 HighCPU on server-01",
+		},
+		{
+			name:     "custom",
+			template: "{{.Emoji}} [{{.Status}}] {{.Alert.Name}} ({{.Alert.Labels.cluster}}){{.Alert.Labels.team}}",
+			want:     "🔴 [Critical] HighCPU (prod-eu)",
+		},
+		{
+			name:     "failing template uses default",
+			template: "{{.Alert.Name.Missing}}",
+			want:     "🔴 HighCPU on server-01",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder := NewMessageBuilder(nil)
+			if tt.template != "" {
+				tmpl, err := ParseFallbackTemplate(tt.template)
+				if err != nil {
+					t.Fatalf("ParseFallbackTemplate() error = %v", err)
+				}
+				builder.SetFallbackTemplate(tmpl)
+			}
+			if got := builder.BuildFallbackText(alert); got != tt.want {
+				t.Errorf("BuildFallbackText() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildAlertMessage_Template(t *testing.T) {
+	alert := entity.NewAlert("fp", "HighCPU", "server-01", "", "CPU above 90%", entity.SeverityCritical)
+	alert.Labels = map[string]string{"cluster": "prod-eu"}
+
+	templates, err := notifytemplate.Load("", map[string]string{
+		notifytemplate.SlackMessage: "{{.Alert.Summary}} in *{{.Labels.cluster}}*",
+	})
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	builder := NewMessageBuilder(nil)
+	builder.SetTemplates(templates)
+
+	blocks := builder.BuildAlertMessage(alert)
+	section, ok := blocks[1].(*slack.SectionBlock)
+	if !ok {
+		t.Fatalf("expected the body section after the header, got %T", blocks[1])
+	}
+	if got, want := section.Text.Text, "CPU above 90% in *prod-eu*"; got != want {
+		t.Errorf("body = %q, want %q", got, want)
+	}
+}
+
+func TestBuildAlertMessage_ReadOnly(t *testing.T) {
+	alert := entity.NewAlert("fp", "HighCPU", "server-01", "", "CPU above 90%", entity.SeverityCritical)
+	builder := NewMessageBuilder([]time.Duration{30 * time.Minute, 2 * time.Hour})
+	builder.SetCustomActions(NewCustomActions([]CustomAction{{Name: "restart", Label: "Restart"}}, nil))
+	builder.SetReadOnly(true)
+
+	var list string
+	for _, block := range builder.BuildAlertMessage(alert) {
+		switch b := block.(type) {
+		case *slack.ActionBlock:
+			t.Errorf("read-only message has action block %q", b.BlockID)
+		case *slack.ContextBlock:
+			for _, element := range b.ContextElements.Elements {
+				if text, ok := element.(*slack.TextBlockObject); ok && strings.Contains(text.Text, "Actions disabled") {
+					list = text.Text
+				}
+			}
+		}
+	}
+	if !strings.Contains(list, "Acknowledge") || !strings.Contains(list, "Silence (30 min, 2 hours)") {
+		t.Errorf("action list = %q, want the acknowledge and candidate silence actions", list)
+	}
+}
+
+func TestFormatHistoryEvent(t *testing.T) {
+	tests := []struct {
+		name  string
+		event *entity.AlertEvent
+		want  string
+	}{
+		{
+			name:  "by and detail",
+			event: entity.NewAlertEvent("a1", entity.AlertEventNoteAdded, "alice", "restarted the pod"),
+			want:  "*:memo: Note added* by alice: restarted the pod",
+		},
+		{
+			name:  "detail only",
+			event: entity.NewAlertEvent("a1", entity.AlertEventNotified, "", "slack"),
+			want:  "*:incoming_envelope: Notified*: slack",
+		},
+		{
+			name:  "unknown type",
+			event: entity.NewAlertEvent("a1", "reopened", "", ""),
+			want:  "*reopened*",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatHistoryEvent(tt.event); got != tt.want {
+				t.Errorf("formatHistoryEvent() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildHistoryModal_LimitsEvents(t *testing.T) {
+	alert := entity.NewAlert("fp1", "HighCPU", "host-1", "", "summary", entity.SeverityCritical)
+	events := make([]*entity.AlertEvent, maxHistoryEvents+10)
+	for i := range events {
+		events[i] = entity.NewAlertEvent(alert.ID, entity.AlertEventNotified, "", "slack")
+	}
+
+	modal := BuildHistoryModal(alert, events)
+	// Title, the omitted-events note and the kept events
+	if got, want := len(modal.Blocks.BlockSet), maxHistoryEvents+2; got != want {
+		t.Errorf("got %d blocks, want %d", got, want)
+	}
+}
+
+type fakeUserDirectory map[string]string
+
+func (d fakeUserDirectory) SlackUserID(email string) string {
+	return d[email]
+}
+
+func TestBuildCompactMessage_MentionsKnownUsers(t *testing.T) {
+	builder := NewMessageBuilder(nil)
+	builder.SetUserDirectory(fakeUserDirectory{"alice@example.com": "U1"})
+
+	alert := entity.NewAlert("fp", "HighCPU", "server-01", "", "CPU above 90%", entity.SeverityCritical)
+	_ = alert.Acknowledge("alice@example.com", time.Now())
+
+	block := builder.BuildCompactMessage(alert)[0].(*slack.ContextBlock)
+	text := block.ContextElements.Elements[0].(*slack.TextBlockObject).Text
+	if !strings.Contains(text, "acked by <@U1>") {
+		t.Errorf("BuildCompactMessage() = %q, want mention of U1", text)
+	}
+
+	alert.AckedBy = "bob@example.com"
+	block = builder.BuildCompactMessage(alert)[0].(*slack.ContextBlock)
+	text = block.ContextElements.Elements[0].(*slack.TextBlockObject).Text
+	if !strings.Contains(text, "acked by bob@example.com") {
+		t.Errorf("BuildCompactMessage() = %q, want unknown email kept", text)
+	}
+}
+
+func TestBuildResponseTimesMessage(t *testing.T) {
+	end := time.Date(2026, 10, 12, 9, 0, 0, 0, time.UTC)
+	report := entity.NewResponseTimeReport(end.Add(-7*24*time.Hour), end)
+	for i, name := range []string{"HighCPU", "DiskFull"} {
+		alert := entity.NewAlert(name, name, "host-1", "", "summary", entity.SeverityCritical)
+		alert.FiredAt = end.Add(-24 * time.Hour)
+		alert.Resolve(alert.FiredAt.Add(time.Duration(i+1) * time.Hour))
+		report.Add(alert, "")
+	}
+	report.Finish()
+
+	var texts []string
+	for _, block := range NewMessageBuilder(nil).BuildResponseTimesMessage(report) {
+		if section, ok := block.(*slack.SectionBlock); ok && section.Text != nil {
+			texts = append(texts, section.Text.Text)
+		}
+	}
+
+	// No team section without team labels
+	if len(texts) != 2 {
+		t.Fatalf("got sections %q, want severity and slowest", texts)
+	}
+	if !strings.Contains(texts[0], "`critical` MTTA n/a · MTTR 1h 30m · 2 fired") {
+		t.Errorf("severity section = %q", texts[0])
+	}
+	if strings.Index(texts[1], "DiskFull") > strings.Index(texts[1], "HighCPU") {
+		t.Errorf("slowest section = %q, want DiskFull first", texts[1])
+	}
+}
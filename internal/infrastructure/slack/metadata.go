@@ -0,0 +1,56 @@
+package slack
+
+import (
+	"github.com/slack-go/slack"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// alertMetadataEventType is the event type of the message metadata attached
+// to alert messages. It carries the alert's identity, hidden from users but
+// returned with the message by conversations.history and in interaction
+// payloads.
+const alertMetadataEventType = "alert_bridge_alert"
+
+// AlertIdentity identifies the alert of a message, read from its metadata.
+type AlertIdentity struct {
+	AlertID     string
+	Fingerprint string
+
+	// State is the alert's state when the message was last posted or
+	// updated.
+	State entity.AlertState
+}
+
+// alertMetadata returns the message metadata of an alert message.
+func alertMetadata(alert *entity.Alert) slack.MsgOption {
+	return slack.MsgOptionMetadata(slack.SlackMetadata{
+		EventType: alertMetadataEventType,
+		EventPayload: map[string]any{
+			"alert_id":    alert.ID,
+			"fingerprint": alert.Fingerprint,
+			"state":       string(alert.State),
+		},
+	})
+}
+
+// ParseAlertMetadata reads the alert identity from the metadata of a
+// message. Returns false for messages that are not alert messages, or were
+// posted before alert messages carried metadata.
+func ParseAlertMetadata(metadata slack.SlackMetadata) (AlertIdentity, bool) {
+	if metadata.EventType != alertMetadataEventType {
+		return AlertIdentity{}, false
+	}
+	payload := metadata.EventPayload
+	alertID, _ := payload["alert_id"].(string)
+	fingerprint, _ := payload["fingerprint"].(string)
+	state, _ := payload["state"].(string)
+	if alertID == "" {
+		return AlertIdentity{}, false
+	}
+	return AlertIdentity{
+		AlertID:     alertID,
+		Fingerprint: fingerprint,
+		State:       entity.AlertState(state),
+	}, true
+}
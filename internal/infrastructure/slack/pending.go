@@ -0,0 +1,56 @@
+package slack
+
+import (
+	"github.com/slack-go/slack"
+)
+
+// BuildPendingMessage returns the blocks of a message with the element of
+// actionID taken out of its actions block, and a context block showing
+// status in its place, so the clicked button cannot be clicked again while
+// its action runs. Block Kit has no disabled buttons. Returns false if no
+// actions block holds the element, leaving the message as it is.
+func BuildPendingMessage(blocks []slack.Block, actionID, status string) ([]slack.Block, bool) {
+	for i, block := range blocks {
+		actions, ok := block.(*slack.ActionBlock)
+		if !ok || actions.Elements == nil {
+			continue
+		}
+
+		var remaining []slack.BlockElement
+		found := false
+		for _, element := range actions.Elements.ElementSet {
+			if elementActionID(element) == actionID {
+				found = true
+				continue
+			}
+			remaining = append(remaining, element)
+		}
+		if !found {
+			continue
+		}
+
+		pending := make([]slack.Block, 0, len(blocks)+1)
+		pending = append(pending, blocks[:i]...)
+		if len(remaining) > 0 {
+			pending = append(pending, slack.NewActionBlock(actions.BlockID, remaining...))
+		}
+		pending = append(pending, slack.NewContextBlock("",
+			slack.NewTextBlockObject(slack.MarkdownType, ":hourglass_flowing_sand: "+status, false, false)))
+		pending = append(pending, blocks[i+1:]...)
+		return pending, true
+	}
+	return blocks, false
+}
+
+// elementActionID returns the action ID of an interactive element.
+func elementActionID(element slack.BlockElement) string {
+	switch e := element.(type) {
+	case *slack.ButtonBlockElement:
+		return e.ActionID
+	case *slack.SelectBlockElement:
+		return e.ActionID
+	case *slack.OverflowBlockElement:
+		return e.ActionID
+	}
+	return ""
+}
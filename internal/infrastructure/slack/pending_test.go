@@ -0,0 +1,71 @@
+package slack
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/slack-go/slack"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+func TestBuildPendingMessage(t *testing.T) {
+	alert := entity.NewAlert("fp-1", "HighCPU", "host-1", "", "CPU above 90%", entity.SeverityCritical)
+	builder := NewMessageBuilder([]time.Duration{time.Hour})
+
+	// Interaction payloads carry the blocks as Slack returns them
+	data, err := json.Marshal(slack.Blocks{BlockSet: builder.BuildAlertMessage(alert)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var original slack.Blocks
+	if err := json.Unmarshal(data, &original); err != nil {
+		t.Fatal(err)
+	}
+
+	ackID := "ack_" + alert.ID
+	blocks, ok := BuildPendingMessage(original.BlockSet, ackID, "Acknowledging… (<@U1>)")
+	if !ok {
+		t.Fatal("BuildPendingMessage() found no ack button")
+	}
+	if len(blocks) != len(original.BlockSet)+1 {
+		t.Fatalf("got %d blocks, want %d", len(blocks), len(original.BlockSet)+1)
+	}
+
+	var status *slack.ContextBlock
+	for i, block := range blocks {
+		actions, ok := block.(*slack.ActionBlock)
+		if !ok {
+			continue
+		}
+		for _, element := range actions.Elements.ElementSet {
+			if elementActionID(element) == ackID {
+				t.Error("ack button still shown")
+			}
+		}
+		if len(actions.Elements.ElementSet) == 0 {
+			t.Error("other actions dropped")
+		}
+		status, _ = blocks[i+1].(*slack.ContextBlock)
+	}
+	if status == nil {
+		t.Fatal("no status after the actions")
+	}
+	text := status.ContextElements.Elements[0].(*slack.TextBlockObject).Text
+	if !strings.Contains(text, "Acknowledging… (<@U1>)") {
+		t.Errorf("status = %q", text)
+	}
+
+	// The original blocks are left as they were
+	for _, block := range original.BlockSet {
+		if actions, ok := block.(*slack.ActionBlock); ok && elementActionID(actions.Elements.ElementSet[0]) != ackID {
+			t.Error("original actions modified")
+		}
+	}
+
+	if _, ok := BuildPendingMessage(original.BlockSet, "ack_other", "Acknowledging…"); ok {
+		t.Error("BuildPendingMessage() found a button of another alert")
+	}
+}
@@ -0,0 +1,106 @@
+package slack
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/observability"
+)
+
+// ChannelRateLimiter paces chat.postMessage calls with a token bucket per
+// channel. Slack allows about one message per second per channel, with
+// short bursts; beyond that it answers rate_limited.
+type ChannelRateLimiter struct {
+	perSecond float64
+	burst     float64
+	metrics   *observability.Metrics
+	now       func() time.Time
+
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket
+}
+
+// tokenBucket holds a channel's tokens as of updated. Tokens go negative
+// while posts wait; each waiter owns one token of the deficit.
+type tokenBucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+// NewChannelRateLimiter creates a limiter allowing perSecond posts per
+// channel on average, and burst posts at once. metrics may be nil.
+func NewChannelRateLimiter(perSecond float64, burst int, metrics *observability.Metrics) *ChannelRateLimiter {
+	return &ChannelRateLimiter{
+		perSecond: perSecond,
+		burst:     float64(burst),
+		metrics:   metrics,
+		now:       time.Now,
+		buckets:   make(map[string]*tokenBucket),
+	}
+}
+
+// Wait blocks until a post to channel is allowed, or ctx is done.
+func (l *ChannelRateLimiter) Wait(ctx context.Context, channel string) error {
+	if l == nil {
+		return nil
+	}
+
+	delay := l.reserve(channel)
+	if delay <= 0 {
+		return nil
+	}
+
+	if l.metrics != nil {
+		l.metrics.RecordSlackPostQueued(ctx, channel, 1)
+		defer l.metrics.RecordSlackPostQueued(ctx, channel, -1)
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		l.cancel(channel)
+		return ctx.Err()
+	case <-timer.C:
+		if l.metrics != nil {
+			l.metrics.RecordSlackPostDelayed(ctx, channel, delay)
+		}
+		return nil
+	}
+}
+
+// reserve takes a token from the channel's bucket and returns how long to
+// wait until it is available.
+func (l *ChannelRateLimiter) reserve(channel string) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	b, ok := l.buckets[channel]
+	if !ok {
+		b = &tokenBucket{tokens: l.burst, updated: now}
+		l.buckets[channel] = b
+	}
+
+	b.tokens += now.Sub(b.updated).Seconds() * l.perSecond
+	if b.tokens > l.burst {
+		b.tokens = l.burst
+	}
+	b.updated = now
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / l.perSecond * float64(time.Second))
+}
+
+// cancel returns the token of a post that gave up waiting.
+func (l *ChannelRateLimiter) cancel(channel string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if b, ok := l.buckets[channel]; ok {
+		b.tokens = min(b.tokens+1, l.burst)
+	}
+}
@@ -0,0 +1,48 @@
+package slack
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelRateLimiter_Reserve(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	limiter := NewChannelRateLimiter(1, 2, nil)
+	limiter.now = func() time.Time { return now }
+
+	// The burst goes out at once, then posts wait a second each
+	assert.Zero(t, limiter.reserve("C1"))
+	assert.Zero(t, limiter.reserve("C1"))
+	assert.Equal(t, time.Second, limiter.reserve("C1"))
+	assert.Equal(t, 2*time.Second, limiter.reserve("C1"))
+
+	// Channels have their own buckets
+	assert.Zero(t, limiter.reserve("C2"))
+
+	// A cancelled wait gives its token back
+	limiter.cancel("C1")
+	assert.Equal(t, 2*time.Second, limiter.reserve("C1"))
+
+	// Tokens refill over time, up to the burst
+	now = now.Add(time.Minute)
+	assert.Zero(t, limiter.reserve("C1"))
+	assert.Zero(t, limiter.reserve("C1"))
+	assert.Equal(t, time.Second, limiter.reserve("C1"))
+}
+
+func TestChannelRateLimiter_Wait(t *testing.T) {
+	limiter := NewChannelRateLimiter(0.1, 1, nil)
+	require.NoError(t, limiter.Wait(context.Background(), "C1"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, limiter.Wait(ctx, "C1"), context.DeadlineExceeded)
+
+	// A nil limiter never waits
+	var none *ChannelRateLimiter
+	assert.NoError(t, none.Wait(context.Background(), "C1"))
+}
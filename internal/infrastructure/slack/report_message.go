@@ -0,0 +1,153 @@
+package slack
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/slack-go/slack"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// BuildReportMessage constructs the Block Kit message for a scheduled report.
+func (b *MessageBuilder) BuildReportMessage(report *entity.AlertReport) []slack.Block {
+	blocks := []slack.Block{
+		slack.NewHeaderBlock(
+			slack.NewTextBlockObject(slack.PlainTextType, "📊 Alert Report: "+report.Name, true, false),
+		),
+	}
+
+	// View and period
+	viewText := fmt.Sprintf("%s → %s",
+		FormatSlackTime(report.PeriodStart, SlackDateShort),
+		FormatSlackTime(report.PeriodEnd, SlackDateShort),
+	)
+	if report.View != nil {
+		viewText = fmt.Sprintf("View `%s` (%s) | %s", report.View.Name, report.View.Describe(), viewText)
+	}
+	blocks = append(blocks, slack.NewContextBlock("",
+		slack.NewTextBlockObject(slack.MarkdownType, viewText, false, false),
+	))
+
+	// Period activity
+	fields := []*slack.TextBlockObject{
+		slack.NewTextBlockObject(slack.MarkdownType, fmt.Sprintf("*New*\n%d (🔴 %d 🟡 %d 🔵 %d)",
+			report.NewCount,
+			report.NewBySeverity[entity.SeverityCritical],
+			report.NewBySeverity[entity.SeverityWarning],
+			report.NewBySeverity[entity.SeverityInfo],
+		), false, false),
+		slack.NewTextBlockObject(slack.MarkdownType, fmt.Sprintf("*Resolved*\n%d", report.ResolvedCount), false, false),
+		slack.NewTextBlockObject(slack.MarkdownType, fmt.Sprintf("*MTTA*\n%s (%d acked)", formatReportMean(report.MTTA), report.AckedCount), false, false),
+		slack.NewTextBlockObject(slack.MarkdownType, fmt.Sprintf("*MTTR*\n%s", formatReportMean(report.MTTR)), false, false),
+	}
+	blocks = append(blocks, slack.NewSectionBlock(nil, fields, nil))
+
+	blocks = append(blocks, slack.NewDividerBlock())
+
+	// Still open at the end of the period
+	open := report.Open
+	openText := fmt.Sprintf("*Still open:* %d (%d active, %d acknowledged)",
+		open.TotalAlerts, open.ActiveCount(), open.AcknowledgedCount())
+	if top := open.TopInstance(); top != "" {
+		openText += fmt.Sprintf("\nMost alerts on `%s` (%d)", top, open.TopInstanceCount())
+	}
+	blocks = append(blocks, slack.NewSectionBlock(
+		slack.NewTextBlockObject(slack.MarkdownType, openText, false, false),
+		nil, nil,
+	))
+
+	return blocks
+}
+
+// maxResponseTimeGroups caps the teams and alert names listed in a
+// response times digest, keeping sections within Slack's text limit.
+const maxResponseTimeGroups = 10
+
+// BuildResponseTimesMessage constructs the Block Kit message for a response
+// times digest: overall MTTA and MTTR, then per severity, per team and the
+// alert names slowest to resolve.
+func (b *MessageBuilder) BuildResponseTimesMessage(report *entity.ResponseTimeReport) []slack.Block {
+	blocks := []slack.Block{
+		slack.NewHeaderBlock(
+			slack.NewTextBlockObject(slack.PlainTextType, "⏱️ Response Times", true, false),
+		),
+		slack.NewContextBlock("",
+			slack.NewTextBlockObject(slack.MarkdownType, fmt.Sprintf("%s → %s",
+				FormatSlackTime(report.PeriodStart, SlackDateShort),
+				FormatSlackTime(report.PeriodEnd, SlackDateShort),
+			), false, false),
+		),
+	}
+
+	overall := report.Overall
+	fields := []*slack.TextBlockObject{
+		slack.NewTextBlockObject(slack.MarkdownType, fmt.Sprintf("*Fired*\n%d", overall.Fired), false, false),
+		slack.NewTextBlockObject(slack.MarkdownType, fmt.Sprintf("*Resolved*\n%d", overall.Resolved), false, false),
+		slack.NewTextBlockObject(slack.MarkdownType, fmt.Sprintf("*MTTA*\n%s (%d acked)", formatReportMean(overall.MTTA), overall.Acked), false, false),
+		slack.NewTextBlockObject(slack.MarkdownType, fmt.Sprintf("*MTTR*\n%s", formatReportMean(overall.MTTR)), false, false),
+	}
+	blocks = append(blocks, slack.NewSectionBlock(nil, fields, nil))
+
+	// Alert names taking longest to resolve first
+	slowest := make([]*entity.ResponseTimeGroup, 0, len(report.ByName))
+	for _, group := range report.ByName {
+		if group.Resolved > 0 {
+			slowest = append(slowest, group)
+		}
+	}
+	sort.SliceStable(slowest, func(i, j int) bool { return slowest[i].MTTR > slowest[j].MTTR })
+
+	for _, section := range []struct {
+		title  string
+		groups []*entity.ResponseTimeGroup
+	}{
+		{"By severity", report.BySeverity},
+		{"By team", report.ByTeam},
+		{"Slowest to resolve", slowest},
+	} {
+		if len(section.groups) == 0 {
+			continue
+		}
+		blocks = append(blocks, slack.NewDividerBlock(), slack.NewSectionBlock(
+			slack.NewTextBlockObject(slack.MarkdownType, formatResponseTimeGroups(section.title, section.groups), false, false),
+			nil, nil,
+		))
+	}
+
+	return blocks
+}
+
+// formatResponseTimeGroups lists up to maxResponseTimeGroups groups under
+// title, one line each.
+func formatResponseTimeGroups(title string, groups []*entity.ResponseTimeGroup) string {
+	var b strings.Builder
+	b.WriteString("*" + title + "*")
+	for i, group := range groups {
+		if i == maxResponseTimeGroups {
+			fmt.Fprintf(&b, "\n_and %d more_", len(groups)-i)
+			break
+		}
+		fmt.Fprintf(&b, "\n`%s` MTTA %s · MTTR %s · %d fired",
+			group.Key, formatReportMean(group.MTTA), formatReportMean(group.MTTR), group.Fired)
+	}
+	return b.String()
+}
+
+// formatReportMean formats a mean duration, or "n/a" when there was nothing to average.
+func formatReportMean(d time.Duration) string {
+	switch {
+	case d == 0:
+		return "n/a"
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh %dm", int(d.Hours()), int(d.Minutes())%60)
+	default:
+		return fmt.Sprintf("%dd %dh", int(d.Hours()/24), int(d.Hours())%24)
+	}
+}
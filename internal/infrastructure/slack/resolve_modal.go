@@ -0,0 +1,85 @@
+package slack
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/slack-go/slack"
+)
+
+// ResolveModalCallbackID is the callback ID for the resolve modal submission.
+const ResolveModalCallbackID = "alert_resolve_modal"
+
+// Resolve modal block and action IDs
+const (
+	ResolveBlockRootCause  = "resolve_root_cause"
+	ResolveActionRootCause = "resolve_root_cause_select"
+	ResolveBlockNote       = "resolve_note"
+	ResolveActionNote      = "resolve_note_input"
+)
+
+// RootCauseCategories are the root causes offered when resolving an alert.
+var RootCauseCategories = []string{
+	"code change",
+	"configuration change",
+	"infrastructure",
+	"third-party dependency",
+	"capacity",
+	"false positive",
+	"unknown",
+}
+
+// BuildResolveModal creates a modal view for resolving an alert with a root
+// cause and a note. The alert ID travels in private metadata.
+func BuildResolveModal(alertID, alertName string) slack.ModalViewRequest {
+	options := make([]*slack.OptionBlockObject, len(RootCauseCategories))
+	for i, category := range RootCauseCategories {
+		label := strings.ToUpper(category[:1]) + category[1:]
+		options[i] = slack.NewOptionBlockObject(
+			category,
+			slack.NewTextBlockObject(slack.PlainTextType, label, false, false),
+			nil,
+		)
+	}
+	rootCauseSelect := slack.NewOptionsSelectBlockElement(
+		slack.OptTypeStatic,
+		slack.NewTextBlockObject(slack.PlainTextType, "Select a root cause", false, false),
+		ResolveActionRootCause,
+		options...,
+	)
+	rootCauseBlock := slack.NewInputBlock(
+		ResolveBlockRootCause,
+		slack.NewTextBlockObject(slack.PlainTextType, "Root cause", false, false),
+		nil,
+		rootCauseSelect,
+	)
+
+	noteInput := slack.NewPlainTextInputBlockElement(
+		slack.NewTextBlockObject(slack.PlainTextType, "e.g., Rolled back the 14:02 deploy", false, false),
+		ResolveActionNote,
+	)
+	noteInput.Multiline = true
+	noteBlock := slack.NewInputBlock(
+		ResolveBlockNote,
+		slack.NewTextBlockObject(slack.PlainTextType, "Resolution note", false, false),
+		nil,
+		noteInput,
+	)
+	noteBlock.Optional = true
+
+	header := slack.NewSectionBlock(
+		slack.NewTextBlockObject(slack.MarkdownType, fmt.Sprintf("Resolve *%s*", alertName), false, false),
+		nil, nil,
+	)
+
+	return slack.ModalViewRequest{
+		Type:            slack.VTModal,
+		CallbackID:      ResolveModalCallbackID,
+		Title:           slack.NewTextBlockObject(slack.PlainTextType, "Resolve Alert", false, false),
+		Submit:          slack.NewTextBlockObject(slack.PlainTextType, "Resolve", false, false),
+		Close:           slack.NewTextBlockObject(slack.PlainTextType, "Cancel", false, false),
+		Blocks:          slack.Blocks{BlockSet: []slack.Block{header, rootCauseBlock, noteBlock}},
+		ClearOnClose:    true,
+		PrivateMetadata: alertID,
+	}
+}
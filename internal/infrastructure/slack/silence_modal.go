@@ -2,7 +2,9 @@ package slack
 
 import (
 	"fmt"
+	"slices"
 	"sort"
+	"time"
 
 	"github.com/slack-go/slack"
 )
@@ -15,6 +17,10 @@ const (
 	SilenceBlockDuration = "silence_duration"
 	SilenceBlockReason   = "silence_reason"
 	SilenceBlockMatchers = "silence_matchers"
+
+	// SilenceBlockExpressions holds free-form matchers, such as regex and
+	// negative matchers, one per line.
+	SilenceBlockExpressions = "silence_expressions"
 )
 
 // Silence modal action IDs
@@ -22,6 +28,8 @@ const (
 	SilenceActionDuration = "silence_duration_select"
 	SilenceActionReason   = "silence_reason_input"
 	SilenceActionMatchers = "silence_matchers_select"
+
+	SilenceActionExpressions = "silence_expressions_input"
 )
 
 // DurationOption represents a silence duration option.
@@ -45,8 +53,10 @@ func DefaultDurationOptions() []DurationOption {
 }
 
 // BuildSilenceModal creates a modal view for creating a silence.
-// labelOptions is a map of label keys to their possible values.
-func BuildSilenceModal(labelOptions map[string][]string) slack.ModalViewRequest {
+// labelOptions is a map of label keys to their possible values. duration,
+// when positive, is preselected instead of 1 hour. channelID is where the
+// submission is confirmed, and may be empty.
+func BuildSilenceModal(labelOptions map[string][]string, duration time.Duration, channelID string) slack.ModalViewRequest {
 	// Title
 	titleText := slack.NewTextBlockObject(slack.PlainTextType, "Create Silence", false, false)
 
@@ -61,16 +71,15 @@ func BuildSilenceModal(labelOptions map[string][]string) slack.ModalViewRequest
 		BlockSet: []slack.Block{},
 	}
 
-	// Duration select
-	durationOptions := buildDurationOptions()
+	// Duration select, defaulting to 1 hour
+	durationOptions, initial := buildDurationOptions(duration)
 	durationSelect := slack.NewOptionsSelectBlockElement(
 		slack.OptTypeStatic,
 		slack.NewTextBlockObject(slack.PlainTextType, "Select duration", false, false),
 		SilenceActionDuration,
 		durationOptions...,
 	)
-	// Set default to 1 hour
-	durationSelect.InitialOption = durationOptions[1]
+	durationSelect.InitialOption = durationOptions[initial]
 
 	durationInput := slack.NewInputBlock(
 		SilenceBlockDuration,
@@ -114,6 +123,21 @@ func BuildSilenceModal(labelOptions map[string][]string) slack.ModalViewRequest
 		blocks.BlockSet = append(blocks.BlockSet, matcherBlocks...)
 	}
 
+	// Free-form matchers (optional)
+	expressionsInput := slack.NewPlainTextInputBlockElement(
+		slack.NewTextBlockObject(slack.PlainTextType, "e.g., service=~\"pay.*\"\nenv!=staging", false, false),
+		SilenceActionExpressions,
+	)
+	expressionsInput.Multiline = true
+	expressionsBlock := slack.NewInputBlock(
+		SilenceBlockExpressions,
+		slack.NewTextBlockObject(slack.PlainTextType, "Advanced matchers (optional)", false, false),
+		slack.NewTextBlockObject(slack.PlainTextType, "One per line: label=value, label!=value, label=~regex or label!~regex", false, false),
+		expressionsInput,
+	)
+	expressionsBlock.Optional = true
+	blocks.BlockSet = append(blocks.BlockSet, expressionsBlock)
+
 	return slack.ModalViewRequest{
 		Type:            slack.VTModal,
 		CallbackID:      SilenceModalCallbackID,
@@ -123,15 +147,29 @@ func BuildSilenceModal(labelOptions map[string][]string) slack.ModalViewRequest
 		Blocks:          blocks,
 		ClearOnClose:    true,
 		NotifyOnClose:   false,
-		PrivateMetadata: "", // Can be used to pass data between interactions
+		PrivateMetadata: channelID,
 	}
 }
 
-// buildDurationOptions creates the duration select options.
-func buildDurationOptions() []*slack.OptionBlockObject {
+// buildDurationOptions creates the duration select options and returns the
+// index of the one to preselect. A requested duration that is not among
+// the defaults is added in order.
+func buildDurationOptions(requested time.Duration) ([]*slack.OptionBlockObject, int) {
 	options := DefaultDurationOptions()
-	result := make([]*slack.OptionBlockObject, len(options))
+	initial := 1 // 1 hour
+	if requested > 0 {
+		i := sort.Search(len(options), func(i int) bool {
+			d, _ := time.ParseDuration(options[i].Value)
+			return d >= requested
+		})
+		if d, _ := time.ParseDuration(optionValue(options, i)); d != requested {
+			custom := DurationOption{Label: durationLabel(requested), Value: requested.String()}
+			options = slices.Insert(options, i, custom)
+		}
+		initial = i
+	}
 
+	result := make([]*slack.OptionBlockObject, len(options))
 	for i, opt := range options {
 		result[i] = slack.NewOptionBlockObject(
 			opt.Value,
@@ -140,7 +178,35 @@ func buildDurationOptions() []*slack.OptionBlockObject {
 		)
 	}
 
-	return result
+	return result, initial
+}
+
+// optionValue returns the value of options[i], or "" past the end.
+func optionValue(options []DurationOption, i int) string {
+	if i < len(options) {
+		return options[i].Value
+	}
+	return ""
+}
+
+// durationLabel describes a duration in the largest whole unit, e.g.
+// "3 days" or "90 minutes".
+func durationLabel(d time.Duration) string {
+	switch {
+	case d%(24*time.Hour) == 0:
+		return pluralize(int(d/(24*time.Hour)), "day")
+	case d%time.Hour == 0:
+		return pluralize(int(d/time.Hour), "hour")
+	default:
+		return pluralize(int(d/time.Minute), "minute")
+	}
+}
+
+func pluralize(n int, unit string) string {
+	if n == 1 {
+		return "1 " + unit
+	}
+	return fmt.Sprintf("%d %ss", n, unit)
 }
 
 // buildMatcherBlocks creates input blocks for label matchers.
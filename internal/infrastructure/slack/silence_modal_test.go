@@ -0,0 +1,49 @@
+package slack
+
+import (
+	"testing"
+	"time"
+
+	"github.com/slack-go/slack"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildSilenceModal_Duration(t *testing.T) {
+	tests := []struct {
+		name      string
+		duration  time.Duration
+		wantValue string
+		wantLabel string
+		wantCount int
+	}{
+		{"default", 0, "1h", "1 hour", 8},
+		{"listed", 4 * time.Hour, "4h", "4 hours", 8},
+		{"unlisted", 90 * time.Minute, "1h30m0s", "90 minutes", 9},
+		{"longer than listed", 14 * 24 * time.Hour, "336h0m0s", "14 days", 9},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			modal := BuildSilenceModal(nil, tt.duration, "C123")
+			assert.Equal(t, "C123", modal.PrivateMetadata)
+
+			input, ok := modal.Blocks.BlockSet[0].(*slack.InputBlock)
+			require.True(t, ok)
+			selectElement, ok := input.Element.(*slack.SelectBlockElement)
+			require.True(t, ok)
+			assert.Len(t, selectElement.Options, tt.wantCount)
+			assert.Equal(t, tt.wantValue, selectElement.InitialOption.Value)
+			assert.Equal(t, tt.wantLabel, selectElement.InitialOption.Text.Text)
+
+			// Options stay in ascending order
+			var last time.Duration
+			for _, opt := range selectElement.Options {
+				d, err := time.ParseDuration(opt.Value)
+				require.NoError(t, err)
+				assert.Greater(t, d, last)
+				last = d
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/slack-go/slack"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// SilenceExtendAction is the action type of the "Extend by..." select in
+// silence expiry reminders. Its action ID is SilenceExtendAction + "_" +
+// the silence ID, and each option's value is a duration.
+const SilenceExtendAction = "extendsilence"
+
+// RemindSilenceExpiry asks the creator of a silence in a direct message
+// whether to extend it. The creator is looked up by the silence's
+// CreatedByEmail.
+func (c *Client) RemindSilenceExpiry(ctx context.Context, silence *entity.SilenceMark) error {
+	if silence.CreatedByEmail == "" {
+		return fmt.Errorf("silence %s has no creator email", silence.ID)
+	}
+	user, err := c.api.GetUserByEmailContext(ctx, silence.CreatedByEmail)
+	if err != nil {
+		return categorizeSlackError(err, "looking up silence creator")
+	}
+
+	blocks := c.messageBuilder.BuildSilenceReminder(silence, time.Now())
+	_, _, err = c.postMessage(ctx, user.ID,
+		slack.MsgOptionBlocks(blocks...),
+		slack.MsgOptionText(fmt.Sprintf("Your silence %s expires soon", silence.ID), false),
+	)
+	if err != nil {
+		return categorizeSlackError(err, "sending silence reminder")
+	}
+	return nil
+}
+
+// BuildSilenceReminder builds the reminder of a silence about to expire,
+// with a select to extend it by one of the silence durations.
+func (b *MessageBuilder) BuildSilenceReminder(silence *entity.SilenceMark, now time.Time) []slack.Block {
+	remaining := max(silence.EndAt.Sub(now).Round(time.Minute), time.Minute)
+	text := fmt.Sprintf(":hourglass_flowing_sand: Your silence `%s` expires in %s (at %s). Extend it?",
+		silence.ID, b.formatDuration(remaining), silence.EndAt.UTC().Format("15:04 MST"))
+
+	var details []string
+	if matchers := silence.LabelMatchers(); len(matchers) > 0 {
+		formatted := make([]string, len(matchers))
+		for i, m := range matchers {
+			formatted[i] = "`" + m.String() + "`"
+		}
+		details = append(details, "*Matchers:* "+strings.Join(formatted, " "))
+	}
+	if silence.Reason != "" {
+		details = append(details, "*Reason:* "+silence.Reason)
+	}
+	if len(details) > 0 {
+		text += "\n" + strings.Join(details, "\n")
+	}
+
+	options := make([]*slack.OptionBlockObject, len(b.silenceDurations))
+	for i, d := range b.silenceDurations {
+		options[i] = slack.NewOptionBlockObject(
+			d.String(),
+			slack.NewTextBlockObject(slack.PlainTextType, b.formatDuration(d), false, false),
+			nil,
+		)
+	}
+	extendSelect := slack.NewOptionsSelectBlockElement(
+		slack.OptTypeStatic,
+		slack.NewTextBlockObject(slack.PlainTextType, "Extend by...", false, false),
+		SilenceExtendAction+"_"+silence.ID,
+		options...,
+	)
+
+	return []slack.Block{
+		slack.NewSectionBlock(slack.NewTextBlockObject(slack.MarkdownType, text, false, false), nil, nil),
+		slack.NewActionBlock("silence_reminder_"+silence.ID, extendSelect),
+	}
+}
@@ -0,0 +1,36 @@
+package slack
+
+import (
+	"testing"
+	"time"
+
+	"github.com/slack-go/slack"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+func TestBuildSilenceReminder(t *testing.T) {
+	silence, err := entity.NewSilenceMark(12*time.Minute, "alice", "alice@example.com", entity.AckSourceSlack)
+	require.NoError(t, err)
+	silence.WithLabel("team", "db").WithReason("db-2 maintenance")
+
+	builder := NewMessageBuilder([]time.Duration{time.Hour, 4 * time.Hour})
+	blocks := builder.BuildSilenceReminder(silence, silence.StartAt)
+	require.Len(t, blocks, 2)
+
+	section, ok := blocks[0].(*slack.SectionBlock)
+	require.True(t, ok)
+	assert.Contains(t, section.Text.Text, "expires in 12 min")
+	assert.Contains(t, section.Text.Text, "`team=\"db\"`")
+	assert.Contains(t, section.Text.Text, "db-2 maintenance")
+
+	actions, ok := blocks[1].(*slack.ActionBlock)
+	require.True(t, ok)
+	extend, ok := actions.Elements.ElementSet[0].(*slack.SelectBlockElement)
+	require.True(t, ok)
+	assert.Equal(t, SilenceExtendAction+"_"+silence.ID, extend.ActionID)
+	require.Len(t, extend.Options, 2)
+	assert.Equal(t, "1h0m0s", extend.Options[0].Value)
+}
@@ -42,14 +42,17 @@ type EventHandler interface {
 	HandleEvent(evt *socketmode.Event) error
 }
 
-// CommandHandler handles slash commands.
+// CommandHandler handles slash commands. The returned payload, if not nil,
+// is sent with the acknowledgment, like the body of an HTTP response.
 type CommandHandler interface {
-	HandleCommand(cmd *slack.SlashCommand) error
+	HandleCommand(ctx context.Context, cmd *slack.SlashCommand) (any, error)
 }
 
-// InteractionHandler handles interactive components.
+// InteractionHandler handles interactive components. The returned payload,
+// if not nil, is sent with the acknowledgment, such as modal validation
+// errors.
 type InteractionHandler interface {
-	HandleInteraction(callback *slack.InteractionCallback) error
+	HandleInteraction(ctx context.Context, callback *slack.InteractionCallback) (any, error)
 }
 
 // NewSocketModeClient creates a new Socket Mode client.
@@ -62,10 +65,12 @@ func NewSocketModeClient(botToken string, cfg config.SocketModeConfig, logger Lo
 		return nil, fmt.Errorf("bot token is required")
 	}
 
-	// Create Slack API client
+	// Create Slack API client. Debug output goes to the standard logger,
+	// which the application routes through its redacting handler.
 	slackAPI := slack.New(
 		botToken,
 		slack.OptionDebug(cfg.Debug),
+		slack.OptionLog(log.Default()),
 		slack.OptionAppLevelToken(cfg.AppToken),
 	)
 
@@ -73,6 +78,7 @@ func NewSocketModeClient(botToken string, cfg config.SocketModeConfig, logger Lo
 	socketClient := socketmode.New(
 		slackAPI,
 		socketmode.OptionDebug(cfg.Debug),
+		socketmode.OptionLog(log.Default()),
 	)
 
 	return &SocketModeClient{
@@ -187,13 +193,15 @@ func (c *SocketModeClient) runEventLoop(ctx context.Context) {
 			return
 
 		case evt := <-c.client.Events:
-			c.handleSocketModeEvent(evt)
+			// Handle events concurrently, so that a slow interaction does
+			// not hold up the acknowledgment of others
+			go c.handleSocketModeEvent(ctx, evt)
 		}
 	}
 }
 
 // handleSocketModeEvent routes Socket Mode events to appropriate handlers.
-func (c *SocketModeClient) handleSocketModeEvent(evt socketmode.Event) {
+func (c *SocketModeClient) handleSocketModeEvent(ctx context.Context, evt socketmode.Event) {
 	c.logger.Debug("Received Socket Mode event", "type", evt.Type)
 
 	switch evt.Type {
@@ -214,8 +222,10 @@ func (c *SocketModeClient) handleSocketModeEvent(evt socketmode.Event) {
 			return
 		}
 
+		var payload any
 		if c.commandHandler != nil {
-			if err := c.commandHandler.HandleCommand(&cmd); err != nil {
+			var err error
+			if payload, err = c.commandHandler.HandleCommand(ctx, &cmd); err != nil {
 				c.logger.Error("Failed to handle slash command",
 					"command", cmd.Command,
 					"error", err.Error())
@@ -223,7 +233,7 @@ func (c *SocketModeClient) handleSocketModeEvent(evt socketmode.Event) {
 		}
 
 		// Acknowledge the event
-		c.client.Ack(*evt.Request)
+		c.client.Ack(*evt.Request, payload)
 
 	case socketmode.EventTypeInteractive:
 		// Handle interactive component
@@ -233,8 +243,10 @@ func (c *SocketModeClient) handleSocketModeEvent(evt socketmode.Event) {
 			return
 		}
 
+		var payload any
 		if c.interactionHandler != nil {
-			if err := c.interactionHandler.HandleInteraction(&callback); err != nil {
+			var err error
+			if payload, err = c.interactionHandler.HandleInteraction(ctx, &callback); err != nil {
 				c.logger.Error("Failed to handle interaction",
 					"type", callback.Type,
 					"error", err.Error())
@@ -242,7 +254,7 @@ func (c *SocketModeClient) handleSocketModeEvent(evt socketmode.Event) {
 		}
 
 		// Acknowledge the event
-		c.client.Ack(*evt.Request)
+		c.client.Ack(*evt.Request, payload)
 
 	case socketmode.EventTypeEventsAPI:
 		// Handle Events API
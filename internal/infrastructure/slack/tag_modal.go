@@ -0,0 +1,60 @@
+package slack
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/slack-go/slack"
+)
+
+// TagModalCallbackID is the callback ID for the tag modal submission.
+const TagModalCallbackID = "alert_tag_modal"
+
+// Tag modal block and action IDs
+const (
+	TagBlockTags  = "alert_tags"
+	TagActionTags = "alert_tags_input"
+)
+
+// BuildTagModal creates a modal view for editing an alert's tags.
+// messageID is the "channel:ts" of the alert message, so the message can be
+// refreshed after submission; it travels with the alert ID in private metadata.
+func BuildTagModal(alertID, messageID string, currentTags []string) slack.ModalViewRequest {
+	tagsInput := slack.NewPlainTextInputBlockElement(
+		slack.NewTextBlockObject(slack.PlainTextType, "e.g., network, vendor-issue", false, false),
+		TagActionTags,
+	)
+	tagsInput.InitialValue = strings.Join(currentTags, ", ")
+
+	tagsBlock := slack.NewInputBlock(
+		TagBlockTags,
+		slack.NewTextBlockObject(slack.PlainTextType, "Tags", false, false),
+		slack.NewTextBlockObject(slack.PlainTextType, "Comma-separated. Leave empty to clear all tags.", false, false),
+		tagsInput,
+	)
+	tagsBlock.Optional = true
+
+	return slack.ModalViewRequest{
+		Type:            slack.VTModal,
+		CallbackID:      TagModalCallbackID,
+		Title:           slack.NewTextBlockObject(slack.PlainTextType, "Tag Alert", false, false),
+		Submit:          slack.NewTextBlockObject(slack.PlainTextType, "Save", false, false),
+		Close:           slack.NewTextBlockObject(slack.PlainTextType, "Cancel", false, false),
+		Blocks:          slack.Blocks{BlockSet: []slack.Block{tagsBlock}},
+		ClearOnClose:    true,
+		PrivateMetadata: fmt.Sprintf("%s|%s", alertID, messageID),
+	}
+}
+
+// ParseTagModalMetadata splits tag modal private metadata into alert ID and message ID.
+func ParseTagModalMetadata(metadata string) (alertID, messageID string) {
+	alertID, messageID, _ = strings.Cut(metadata, "|")
+	return alertID, messageID
+}
+
+// ParseTagInput splits comma- or whitespace-separated tag input.
+func ParseTagInput(input string) []string {
+	return strings.FieldsFunc(input, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\n' || r == '\t'
+	})
+}
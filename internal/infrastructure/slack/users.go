@@ -0,0 +1,62 @@
+package slack
+
+import (
+	"context"
+	"errors"
+
+	"github.com/slack-go/slack"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// UserDirectory maps emails to the Slack users known for them.
+type UserDirectory interface {
+	SlackUserID(email string) string
+}
+
+// SetUserDirectory makes messages mention the Slack users of the emails
+// that alerts were acknowledged or resolved by, such as from PagerDuty,
+// instead of showing the emails.
+func (c *Client) SetUserDirectory(users UserDirectory) {
+	c.messageBuilder.SetUserDirectory(users)
+}
+
+// LookupUserByEmail finds the Slack user of an email. Returns nil if there
+// is none. Requires the users:read.email scope.
+func (c *Client) LookupUserByEmail(ctx context.Context, email string) (*entity.UserIdentity, error) {
+	user, err := c.api.GetUserByEmailContext(ctx, email)
+	if err != nil {
+		var slackErr slack.SlackErrorResponse
+		if errors.As(err, &slackErr) && slackErr.Err == "users_not_found" {
+			return nil, nil
+		}
+		return nil, categorizeSlackError(err, "looking up user by email")
+	}
+
+	name := user.RealName
+	if name == "" {
+		name = user.Name
+	}
+	return &entity.UserIdentity{
+		Email:       email,
+		Name:        name,
+		SlackUserID: user.ID,
+	}, nil
+}
+
+// SetUserDirectory makes messages mention the Slack users of known emails.
+func (b *MessageBuilder) SetUserDirectory(users UserDirectory) {
+	b.users = users
+}
+
+// formatUser returns a mention of the user named by an email, or the name
+// itself when it is not a known email.
+func (b *MessageBuilder) formatUser(name string) string {
+	if b.users == nil {
+		return name
+	}
+	if userID := b.users.SlackUserID(name); userID != "" {
+		return "<@" + userID + ">"
+	}
+	return name
+}
@@ -0,0 +1,64 @@
+// Package sns verifies and confirms Amazon SNS HTTP(S) deliveries.
+package sns
+
+import "strings"
+
+// SNS message types.
+const (
+	TypeSubscriptionConfirmation = "SubscriptionConfirmation"
+	TypeNotification             = "Notification"
+	TypeUnsubscribeConfirmation  = "UnsubscribeConfirmation"
+)
+
+// Message is the JSON envelope SNS posts to HTTP(S) subscribers.
+// See: https://docs.aws.amazon.com/sns/latest/dg/sns-message-and-json-formats.html
+type Message struct {
+	Type             string `json:"Type"`
+	MessageID        string `json:"MessageId"`
+	Token            string `json:"Token,omitempty"`
+	TopicArn         string `json:"TopicArn"`
+	Subject          string `json:"Subject,omitempty"`
+	Message          string `json:"Message"`
+	Timestamp        string `json:"Timestamp"`
+	SignatureVersion string `json:"SignatureVersion"`
+	Signature        string `json:"Signature"`
+	SigningCertURL   string `json:"SigningCertURL"`
+	SubscribeURL     string `json:"SubscribeURL,omitempty"`
+	UnsubscribeURL   string `json:"UnsubscribeURL,omitempty"`
+}
+
+// stringToSign builds the canonical string SNS signs for this message type.
+func (m *Message) stringToSign() string {
+	var fields [][2]string
+	switch m.Type {
+	case TypeNotification:
+		fields = [][2]string{
+			{"Message", m.Message},
+			{"MessageId", m.MessageID},
+		}
+		if m.Subject != "" {
+			fields = append(fields, [2]string{"Subject", m.Subject})
+		}
+		fields = append(fields,
+			[2]string{"Timestamp", m.Timestamp},
+			[2]string{"TopicArn", m.TopicArn},
+			[2]string{"Type", m.Type},
+		)
+	default:
+		fields = [][2]string{
+			{"Message", m.Message},
+			{"MessageId", m.MessageID},
+			{"SubscribeURL", m.SubscribeURL},
+			{"Timestamp", m.Timestamp},
+			{"Token", m.Token},
+			{"TopicArn", m.TopicArn},
+			{"Type", m.Type},
+		}
+	}
+
+	var b strings.Builder
+	for _, f := range fields {
+		b.WriteString(f[0] + "\n" + f[1] + "\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,170 @@
+package sns
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sync"
+	"time"
+)
+
+// ErrInvalidSignature is returned when a message signature does not verify.
+var ErrInvalidSignature = errors.New("invalid SNS message signature")
+
+// awsSNSHost matches SNS endpoints, which serve signing certificates and
+// subscription confirmation URLs.
+var awsSNSHost = regexp.MustCompile(`^sns\.[a-z0-9-]+\.amazonaws\.com(\.cn)?$`)
+
+// maxCertSize bounds signing certificate downloads.
+const maxCertSize = 64 * 1024
+
+// Verifier checks SNS message signatures and confirms subscriptions.
+// Signing certificates are cached by URL.
+type Verifier struct {
+	httpClient *http.Client
+	certs      sync.Map // URL -> *x509.Certificate
+
+	// allowedHost validates hosts of certificate and subscribe URLs.
+	allowedHost func(host string) bool
+}
+
+// NewVerifier creates a new Verifier.
+func NewVerifier() *Verifier {
+	return &Verifier{
+		httpClient:  &http.Client{Timeout: 10 * time.Second},
+		allowedHost: awsSNSHost.MatchString,
+	}
+}
+
+// Verify checks the message signature against its AWS signing certificate.
+func (v *Verifier) Verify(ctx context.Context, msg *Message) error {
+	var hash crypto.Hash
+	switch msg.SignatureVersion {
+	case "1":
+		hash = crypto.SHA1
+	case "2":
+		hash = crypto.SHA256
+	default:
+		return fmt.Errorf("%w: unsupported signature version %q", ErrInvalidSignature, msg.SignatureVersion)
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(msg.Signature)
+	if err != nil {
+		return fmt.Errorf("%w: decoding signature: %v", ErrInvalidSignature, err)
+	}
+
+	cert, err := v.certificate(ctx, msg.SigningCertURL)
+	if err != nil {
+		return err
+	}
+	pub, ok := cert.PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return fmt.Errorf("%w: signing certificate is not RSA", ErrInvalidSignature)
+	}
+
+	if err := rsa.VerifyPKCS1v15(pub, hash, digest(hash, msg.stringToSign()), signature); err != nil {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// ConfirmSubscription visits the message's SubscribeURL to confirm the subscription.
+func (v *Verifier) ConfirmSubscription(ctx context.Context, msg *Message) error {
+	if err := v.checkURL(msg.SubscribeURL); err != nil {
+		return fmt.Errorf("subscribe URL: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, msg.SubscribeURL, nil)
+	if err != nil {
+		return fmt.Errorf("creating confirmation request: %w", err)
+	}
+	resp, err := v.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("confirming subscription: %w", err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("confirming subscription: unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// certificate returns the signing certificate at certURL, fetching it on first use.
+func (v *Verifier) certificate(ctx context.Context, certURL string) (*x509.Certificate, error) {
+	if cached, ok := v.certs.Load(certURL); ok {
+		return cached.(*x509.Certificate), nil
+	}
+
+	if err := v.checkURL(certURL); err != nil {
+		return nil, fmt.Errorf("%w: signing cert URL: %v", ErrInvalidSignature, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, certURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating certificate request: %w", err)
+	}
+	resp, err := v.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetching signing certificate: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching signing certificate: unexpected status %d", resp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxCertSize))
+	if err != nil {
+		return nil, fmt.Errorf("reading signing certificate: %w", err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("%w: signing certificate is not PEM", ErrInvalidSignature)
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: parsing signing certificate: %v", ErrInvalidSignature, err)
+	}
+
+	v.certs.Store(certURL, cert)
+	return cert, nil
+}
+
+// checkURL ensures rawURL is an HTTPS URL on an SNS host, so a forged message
+// cannot make us fetch arbitrary URLs.
+func (v *Verifier) checkURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "https" {
+		return fmt.Errorf("scheme must be https, got %q", u.Scheme)
+	}
+	if !v.allowedHost(u.Hostname()) {
+		return fmt.Errorf("host %q is not an SNS endpoint", u.Hostname())
+	}
+	return nil
+}
+
+// digest hashes s with the given algorithm.
+func digest(hash crypto.Hash, s string) []byte {
+	if hash == crypto.SHA1 {
+		sum := sha1.Sum([]byte(s))
+		return sum[:]
+	}
+	sum := sha256.Sum256([]byte(s))
+	return sum[:]
+}
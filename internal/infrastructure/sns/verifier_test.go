@@ -0,0 +1,103 @@
+package sns
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestSigner returns a key and a TLS server that serves its certificate.
+func newTestSigner(t *testing.T) (*rsa.PrivateKey, *httptest.Server) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "sns.us-east-1.amazonaws.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(certPEM)
+	}))
+	t.Cleanup(srv.Close)
+	return key, srv
+}
+
+func newTestVerifier(srv *httptest.Server) *Verifier {
+	v := NewVerifier()
+	v.httpClient = srv.Client()
+	v.allowedHost = func(string) bool { return true }
+	return v
+}
+
+func sign(t *testing.T, key *rsa.PrivateKey, msg *Message) {
+	t.Helper()
+	hash := crypto.SHA256
+	if msg.SignatureVersion == "1" {
+		hash = crypto.SHA1
+	}
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, hash, digest(hash, msg.stringToSign()))
+	require.NoError(t, err)
+	msg.Signature = base64.StdEncoding.EncodeToString(sig)
+}
+
+func TestVerifier_Verify(t *testing.T) {
+	key, srv := newTestSigner(t)
+	v := newTestVerifier(srv)
+
+	for _, version := range []string{"1", "2"} {
+		t.Run("version "+version, func(t *testing.T) {
+			msg := &Message{
+				Type:             TypeNotification,
+				MessageID:        "msg-1",
+				TopicArn:         "arn:aws:sns:us-east-1:123456789012:alarms",
+				Subject:          "ALARM: HighCPU",
+				Message:          `{"AlarmName":"HighCPU"}`,
+				Timestamp:        "2026-01-01T00:00:00.000Z",
+				SignatureVersion: version,
+				SigningCertURL:   srv.URL + "/cert.pem",
+			}
+			sign(t, key, msg)
+
+			require.NoError(t, v.Verify(context.Background(), msg))
+
+			msg.Message = `{"AlarmName":"Tampered"}`
+			assert.ErrorIs(t, v.Verify(context.Background(), msg), ErrInvalidSignature)
+		})
+	}
+}
+
+func TestVerifier_RejectsNonSNSHosts(t *testing.T) {
+	v := NewVerifier()
+
+	msg := &Message{
+		Type:             TypeSubscriptionConfirmation,
+		SignatureVersion: "1",
+		Signature:        base64.StdEncoding.EncodeToString([]byte("sig")),
+		SigningCertURL:   "https://evil.example.com/cert.pem",
+		SubscribeURL:     "http://sns.us-east-1.amazonaws.com/?Action=ConfirmSubscription",
+	}
+
+	assert.ErrorIs(t, v.Verify(context.Background(), msg), ErrInvalidSignature)
+	assert.Error(t, v.ConfirmSubscription(context.Background(), msg))
+}
@@ -0,0 +1,62 @@
+// Package systemd implements the parts of systemd's service notification
+// protocol (sd_notify) used by alert-bridge: readiness, stopping and
+// watchdog keep-alives for units with Type=notify and WatchdogSec.
+package systemd
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Notification states.
+const (
+	StateReady    = "READY=1"
+	StateStopping = "STOPPING=1"
+	StateWatchdog = "WATCHDOG=1"
+)
+
+// Notify sends state to the service manager. It returns false without an
+// error when the process was not started by systemd with a notify socket.
+func Notify(state string) (bool, error) {
+	socket := os.Getenv("NOTIFY_SOCKET")
+	if socket == "" {
+		return false, nil
+	}
+	// A leading @ names a socket in the abstract namespace
+	if socket[0] == '@' {
+		socket = "\x00" + socket[1:]
+	}
+
+	conn, err := net.DialUnix("unixgram", nil, &net.UnixAddr{Name: socket, Net: "unixgram"})
+	if err != nil {
+		return false, fmt.Errorf("connecting to notify socket: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.Write([]byte(state)); err != nil {
+		return false, fmt.Errorf("writing to notify socket: %w", err)
+	}
+	return true, nil
+}
+
+// WatchdogInterval returns the unit's watchdog timeout (WatchdogSec), or 0
+// when the watchdog is not enabled for this process.
+func WatchdogInterval() (time.Duration, error) {
+	usec := os.Getenv("WATCHDOG_USEC")
+	if usec == "" {
+		return 0, nil
+	}
+	if pid := os.Getenv("WATCHDOG_PID"); pid != "" && pid != strconv.Itoa(os.Getpid()) {
+		// Meant for another process, e.g. a wrapper script
+		return 0, nil
+	}
+
+	n, err := strconv.ParseInt(usec, 10, 64)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("invalid WATCHDOG_USEC %q", usec)
+	}
+	return time.Duration(n) * time.Microsecond, nil
+}
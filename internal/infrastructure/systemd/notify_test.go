@@ -0,0 +1,99 @@
+package systemd
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// listenNotifySocket creates a notify socket and points NOTIFY_SOCKET at it.
+func listenNotifySocket(t *testing.T) *net.UnixConn {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "notify.sock")
+	conn, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: path, Net: "unixgram"})
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	t.Setenv("NOTIFY_SOCKET", path)
+	return conn
+}
+
+func readState(t *testing.T, conn *net.UnixConn) string {
+	t.Helper()
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(2*time.Second)))
+	buf := make([]byte, 64)
+	n, err := conn.Read(buf)
+	require.NoError(t, err)
+	return string(buf[:n])
+}
+
+func TestNotify(t *testing.T) {
+	conn := listenNotifySocket(t)
+
+	sent, err := Notify(StateReady)
+	require.NoError(t, err)
+	assert.True(t, sent)
+	assert.Equal(t, StateReady, readState(t, conn))
+}
+
+func TestNotify_NoSocket(t *testing.T) {
+	t.Setenv("NOTIFY_SOCKET", "")
+
+	sent, err := Notify(StateReady)
+	assert.NoError(t, err)
+	assert.False(t, sent)
+}
+
+func TestWatchdogInterval(t *testing.T) {
+	t.Setenv("WATCHDOG_USEC", "30000000")
+	t.Setenv("WATCHDOG_PID", "")
+	interval, err := WatchdogInterval()
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Second, interval)
+
+	t.Setenv("WATCHDOG_PID", strconv.Itoa(os.Getpid()+1))
+	interval, err = WatchdogInterval()
+	require.NoError(t, err)
+	assert.Zero(t, interval)
+
+	t.Setenv("WATCHDOG_PID", "")
+	t.Setenv("WATCHDOG_USEC", "soon")
+	_, err = WatchdogInterval()
+	assert.Error(t, err)
+}
+
+func TestRunWatchdog_WithholdsOnFailedCheck(t *testing.T) {
+	conn := listenNotifySocket(t)
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	healthy := make(chan bool, 1)
+	healthy <- false
+	check := func(ctx context.Context) error {
+		select {
+		case ok := <-healthy:
+			if !ok {
+				return errors.New("wedged")
+			}
+		default:
+		}
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	start := time.Now()
+	go RunWatchdog(ctx, 100*time.Millisecond, check, logger)
+
+	// The first tick fails its check, so the first keep-alive comes later
+	assert.Equal(t, StateWatchdog, readState(t, conn))
+	assert.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+}
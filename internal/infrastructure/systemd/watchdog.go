@@ -0,0 +1,37 @@
+package systemd
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// RunWatchdog sends a keep-alive every half timeout until ctx is cancelled,
+// as long as check passes. A failing check withholds the keep-alive, so a
+// wedged process is restarted by systemd once timeout passes. Each check
+// gets a quarter of timeout to complete.
+func RunWatchdog(ctx context.Context, timeout time.Duration, check func(ctx context.Context) error, logger *slog.Logger) {
+	ticker := time.NewTicker(timeout / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			checkCtx, cancel := context.WithTimeout(ctx, timeout/4)
+			err := check(checkCtx)
+			cancel()
+			if err != nil {
+				logger.Warn("health check failed, withholding systemd watchdog keep-alive",
+					"error", err,
+					"timeout", timeout,
+				)
+				continue
+			}
+			if _, err := Notify(StateWatchdog); err != nil {
+				logger.Warn("failed to send systemd watchdog keep-alive", "error", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package teams
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ActionPath is the alert-bridge endpoint that handles Teams card actions.
+const ActionPath = "/webhook/teams"
+
+// ActionSigner builds signed action links for Adaptive Card buttons.
+// Incoming webhooks cannot deliver Action.Submit payloads back to us,
+// so buttons open a signed URL on alert-bridge instead.
+type ActionSigner struct {
+	publicURL string
+	secret    []byte
+	ttl       time.Duration
+}
+
+// NewActionSigner creates a signer for links rooted at publicURL.
+func NewActionSigner(publicURL, secret string, ttl time.Duration) *ActionSigner {
+	return &ActionSigner{
+		publicURL: strings.TrimRight(publicURL, "/"),
+		secret:    []byte(secret),
+		ttl:       ttl,
+	}
+}
+
+// ActionURL returns a signed link that performs action on the given alert.
+func (s *ActionSigner) ActionURL(action, alertID string, now time.Time) string {
+	expires := strconv.FormatInt(now.Add(s.ttl).Unix(), 10)
+
+	q := url.Values{}
+	q.Set("action", action)
+	q.Set("alert_id", alertID)
+	q.Set("expires", expires)
+	q.Set("sig", SignAction(s.secret, action, alertID, expires))
+
+	return s.publicURL + ActionPath + "?" + q.Encode()
+}
+
+// SignAction computes the link signature.
+// Format: hex(HMAC-SHA256(secret, "v1:{action}:{alert_id}:{expires}"))
+func SignAction(secret []byte, action, alertID, expires string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(fmt.Sprintf("v1:%s:%s:%s", action, alertID, expires)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
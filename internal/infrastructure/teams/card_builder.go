@@ -0,0 +1,171 @@
+package teams
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// Adaptive Card text colors.
+// See: https://adaptivecards.io/explorer/TextBlock.html
+const (
+	colorAttention = "Attention"
+	colorWarning   = "Warning"
+	colorGood      = "Good"
+	colorAccent    = "Accent"
+	colorDefault   = "Default"
+)
+
+// WebhookMessage is the envelope accepted by Teams incoming webhooks.
+type WebhookMessage struct {
+	Type        string       `json:"type"`
+	Attachments []Attachment `json:"attachments"`
+}
+
+// Attachment wraps an Adaptive Card in a webhook message.
+type Attachment struct {
+	ContentType string       `json:"contentType"`
+	ContentURL  *string      `json:"contentUrl"`
+	Content     AdaptiveCard `json:"content"`
+}
+
+// AdaptiveCard is the subset of the Adaptive Card schema used for alerts.
+type AdaptiveCard struct {
+	Schema  string        `json:"$schema"`
+	Type    string        `json:"type"`
+	Version string        `json:"version"`
+	Body    []CardElement `json:"body"`
+	Actions []CardAction  `json:"actions,omitempty"`
+}
+
+// CardElement is a body element (TextBlock or FactSet).
+type CardElement struct {
+	Type     string `json:"type"`
+	Text     string `json:"text,omitempty"`
+	Size     string `json:"size,omitempty"`
+	Weight   string `json:"weight,omitempty"`
+	Color    string `json:"color,omitempty"`
+	Wrap     bool   `json:"wrap,omitempty"`
+	IsSubtle bool   `json:"isSubtle,omitempty"`
+	Facts    []Fact `json:"facts,omitempty"`
+}
+
+// Fact is a title/value pair in a FactSet.
+type Fact struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+}
+
+// CardAction is a card-level action button.
+type CardAction struct {
+	Type  string `json:"type"`
+	Title string `json:"title"`
+	URL   string `json:"url,omitempty"`
+}
+
+// CardBuilder constructs Adaptive Cards for alerts.
+type CardBuilder struct {
+	signer *ActionSigner
+}
+
+// NewCardBuilder creates a card builder. signer may be nil, in which case
+// cards are rendered without action buttons.
+func NewCardBuilder(signer *ActionSigner) *CardBuilder {
+	return &CardBuilder{signer: signer}
+}
+
+// BuildAlertMessage wraps the alert card in a webhook message.
+func (b *CardBuilder) BuildAlertMessage(alert *entity.Alert) WebhookMessage {
+	return WebhookMessage{
+		Type: "message",
+		Attachments: []Attachment{{
+			ContentType: "application/vnd.microsoft.card.adaptive",
+			Content:     b.BuildAlertCard(alert),
+		}},
+	}
+}
+
+// BuildAlertCard creates an Adaptive Card reflecting the alert's current state.
+// The acknowledge button is only shown while the alert is active.
+func (b *CardBuilder) BuildAlertCard(alert *entity.Alert) AdaptiveCard {
+	emoji, statusText, color := getStatusInfo(alert)
+
+	card := AdaptiveCard{
+		Schema:  "http://adaptivecards.io/schemas/adaptive-card.json",
+		Type:    "AdaptiveCard",
+		Version: "1.4",
+	}
+
+	card.Body = append(card.Body, CardElement{
+		Type:   "TextBlock",
+		Text:   fmt.Sprintf("%s %s", emoji, alert.Name),
+		Size:   "Large",
+		Weight: "Bolder",
+		Color:  color,
+		Wrap:   true,
+	})
+
+	if alert.Summary != "" {
+		card.Body = append(card.Body, CardElement{
+			Type: "TextBlock",
+			Text: alert.Summary,
+			Wrap: true,
+		})
+	}
+
+	card.Body = append(card.Body, CardElement{
+		Type:  "FactSet",
+		Facts: buildFacts(alert, statusText),
+	})
+
+	card.Body = append(card.Body, CardElement{
+		Type:     "TextBlock",
+		Text:     fmt.Sprintf("Fired %s", alert.FiredAt.UTC().Format(time.RFC1123)),
+		IsSubtle: true,
+		Wrap:     true,
+	})
+
+	if b.signer != nil && alert.IsActive() {
+		card.Actions = append(card.Actions, CardAction{
+			Type:  "Action.OpenUrl",
+			Title: "Acknowledge",
+			URL:   b.signer.ActionURL("ack", alert.ID, time.Now()),
+		})
+	}
+
+	return card
+}
+
+// buildFacts creates the key details shown under the header.
+func buildFacts(alert *entity.Alert, statusText string) []Fact {
+	facts := []Fact{{Title: "Status", Value: statusText}}
+
+	if alert.Instance != "" {
+		facts = append(facts, Fact{Title: "Instance", Value: alert.Instance})
+	}
+	if alert.Target != "" {
+		facts = append(facts, Fact{Title: "Target", Value: alert.Target})
+	}
+	if alert.IsAcked() && alert.AckedBy != "" {
+		facts = append(facts, Fact{Title: "Acknowledged by", Value: alert.AckedBy})
+	}
+
+	return facts
+}
+
+// getStatusInfo returns emoji, text, and color for the alert status.
+func getStatusInfo(alert *entity.Alert) (emoji, text, color string) {
+	switch {
+	case alert.IsResolved():
+		return "🟢", "Resolved", colorGood
+	case alert.IsAcked():
+		return "👀", "Acknowledged", colorAccent
+	case alert.Severity == entity.SeverityCritical:
+		return "🔴", "Critical", colorAttention
+	case alert.Severity == entity.SeverityWarning:
+		return "🟡", "Warning", colorWarning
+	default:
+		return "🔵", "Info", colorDefault
+	}
+}
@@ -0,0 +1,53 @@
+package teams
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+func TestCardBuilder_AckButton(t *testing.T) {
+	signer := NewActionSigner("https://bridge.example.com/", "secret", time.Hour)
+	builder := NewCardBuilder(signer)
+
+	alert := entity.NewAlert("fp-1", "HighCPU", "node-1", "cpu", "CPU above 90%", entity.SeverityCritical)
+
+	card := builder.BuildAlertCard(alert)
+	require.Len(t, card.Actions, 1)
+	assert.Equal(t, "Action.OpenUrl", card.Actions[0].Type)
+
+	link, err := url.Parse(card.Actions[0].URL)
+	require.NoError(t, err)
+	assert.Equal(t, "bridge.example.com", link.Host)
+	assert.Equal(t, ActionPath, link.Path)
+
+	q := link.Query()
+	assert.Equal(t, "ack", q.Get("action"))
+	assert.Equal(t, alert.ID, q.Get("alert_id"))
+	assert.Equal(t, SignAction([]byte("secret"), "ack", alert.ID, q.Get("expires")), q.Get("sig"))
+}
+
+func TestCardBuilder_NoAckButton(t *testing.T) {
+	alert := entity.NewAlert("fp-1", "HighCPU", "node-1", "cpu", "CPU above 90%", entity.SeverityWarning)
+
+	t.Run("without signer", func(t *testing.T) {
+		card := NewCardBuilder(nil).BuildAlertCard(alert)
+		assert.Empty(t, card.Actions)
+	})
+
+	t.Run("acknowledged alert", func(t *testing.T) {
+		acked := *alert
+		require.NoError(t, acked.Acknowledge("oncall@example.com", time.Now()))
+
+		card := NewCardBuilder(NewActionSigner("https://bridge.example.com", "secret", time.Hour)).BuildAlertCard(&acked)
+		assert.Empty(t, card.Actions)
+
+		facts := card.Body[len(card.Body)-2].Facts
+		assert.Contains(t, facts, Fact{Title: "Acknowledged by", Value: "oncall@example.com"})
+	})
+}
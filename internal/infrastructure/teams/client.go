@@ -0,0 +1,170 @@
+package teams
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	domainerrors "github.com/altuslabsxyz/alert-bridge/internal/domain/errors"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/payloadlog"
+)
+
+// Client posts alert cards to a Microsoft Teams channel via an incoming webhook.
+// Implements both alert.Notifier and ack.AckSyncer interfaces.
+//
+// Incoming webhooks cannot edit a previously posted message, so state changes
+// (acknowledged, resolved) are posted as a follow-up card for the same alert.
+type Client struct {
+	webhookURL  string
+	cardBuilder *CardBuilder
+	httpClient  *http.Client
+	recorder    *payloadlog.Recorder
+}
+
+// NewClient creates a new Teams client. signer may be nil to disable ack buttons.
+func NewClient(webhookURL string, signer *ActionSigner) *Client {
+	return &Client{
+		webhookURL:  webhookURL,
+		cardBuilder: NewCardBuilder(signer),
+		httpClient:  &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// SetHTTPClient replaces the default client used to post to the webhook.
+func (c *Client) SetHTTPClient(httpClient *http.Client) {
+	c.httpClient = httpClient
+}
+
+// Notify posts an alert card to Teams.
+// Returns the alert ID as reference ID since incoming webhooks do not return one.
+func (c *Client) Notify(ctx context.Context, alert *entity.Alert) (entity.NotifyResult, error) {
+	if err := c.post(ctx, c.cardBuilder.BuildAlertMessage(alert)); err != nil {
+		err = categorizeTeamsError(err, "posting teams message")
+		return entity.NotifyResult{Retriable: domainerrors.IsTransientError(err)}, err
+	}
+	return entity.NotifyResult{ReferenceID: alert.ID, DeliveredAt: time.Now()}, nil
+}
+
+// UpdateMessage posts a card reflecting the alert's new state.
+func (c *Client) UpdateMessage(ctx context.Context, messageID string, alert *entity.Alert) error {
+	if err := c.post(ctx, c.cardBuilder.BuildAlertMessage(alert)); err != nil {
+		return categorizeTeamsError(err, "updating teams message")
+	}
+	return nil
+}
+
+// Acknowledge posts the acknowledged card when an alert is acked elsewhere.
+func (c *Client) Acknowledge(ctx context.Context, alert *entity.Alert, ackEvent *entity.AckEvent) error {
+	return c.UpdateMessage(ctx, alert.GetExternalReference(c.Name()), alert)
+}
+
+// Name returns the notifier identifier.
+func (c *Client) Name() string {
+	return "teams"
+}
+
+// SetRecorder enables recording of outbound cards.
+func (c *Client) SetRecorder(recorder *payloadlog.Recorder) {
+	c.recorder = recorder
+}
+
+// Capabilities reports whether cards carry ack buttons. State changes are
+// follow-up cards, not edits.
+func (c *Client) Capabilities() entity.NotifierCapabilities {
+	return entity.NotifierCapabilities{Ack: c.cardBuilder.signer != nil}
+}
+
+// SupportsAck returns true as Teams cards reflect acknowledgment state.
+func (c *Client) SupportsAck() bool {
+	return true
+}
+
+// post sends a webhook message to Teams and records it.
+func (c *Client) post(ctx context.Context, msg WebhookMessage) error {
+	err := c.send(ctx, msg)
+	c.recorder.Record(c.Name(), "post", "", msg, err)
+	return err
+}
+
+// send delivers a webhook message to Teams.
+func (c *Client) send(ctx context.Context, msg WebhookMessage) error {
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("marshaling message: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.webhookURL, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return &statusError{StatusCode: resp.StatusCode, Body: string(body)}
+	}
+
+	return nil
+}
+
+// statusError is returned when Teams responds with a non-2xx status.
+type statusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("teams webhook returned status %d: %s", e.StatusCode, e.Body)
+}
+
+// categorizeTeamsError wraps Teams webhook errors as transient or permanent domain errors.
+func categorizeTeamsError(err error, operation string) error {
+	if err == nil {
+		return nil
+	}
+
+	// Network errors and context timeouts are transient
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return domainerrors.NewTransientError(
+			fmt.Sprintf("%s: network error", operation),
+			err,
+		)
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return domainerrors.NewTransientError(
+			fmt.Sprintf("%s: context timeout", operation),
+			err,
+		)
+	}
+
+	var stErr *statusError
+	if errors.As(err, &stErr) {
+		// Rate limiting and server errors are transient
+		if stErr.StatusCode == http.StatusTooManyRequests || stErr.StatusCode >= 500 {
+			return domainerrors.NewTransientError(
+				fmt.Sprintf("%s: status %d", operation, stErr.StatusCode),
+				err,
+			)
+		}
+	}
+
+	// Default to permanent error
+	return domainerrors.NewPermanentError(
+		fmt.Sprintf("%s: %v", operation, err),
+		err,
+	)
+}
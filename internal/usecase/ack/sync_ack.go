@@ -4,12 +4,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+
 	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
 	"github.com/altuslabsxyz/alert-bridge/internal/domain/logger"
 	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
 	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/observability"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
 )
 
 // SyncAckInput contains acknowledgment details from any source.
@@ -23,6 +27,23 @@ type SyncAckInput struct {
 	Duration  *time.Duration
 }
 
+// user returns the acknowledging user's identity as far as the input
+// tells it. UserID is in the terms of the source. Sources fall back to
+// user IDs when the email is unknown, which are left out.
+func (input SyncAckInput) user() entity.UserIdentity {
+	user := entity.UserIdentity{Name: input.UserName}
+	if strings.Contains(input.UserEmail, "@") {
+		user.Email = input.UserEmail
+	}
+	switch input.Source {
+	case entity.AckSourceSlack:
+		user.SlackUserID = input.UserID
+	case entity.AckSourcePagerDuty:
+		user.PagerDutyUserID = input.UserID
+	}
+	return user
+}
+
 // SyncAckOutput contains the result of acknowledgment synchronization.
 type SyncAckOutput struct {
 	Alert      *entity.Alert
@@ -60,6 +81,12 @@ type SyncAckUseCase struct {
 	syncers      []AckSyncer
 	logger       Logger
 	metrics      *observability.Metrics
+
+	// Alert timeline (optional)
+	timeline *alert.Timeline
+
+	// User mapping between the integrations (optional)
+	users *UserDirectory
 }
 
 // NewSyncAckUseCase creates a new SyncAckUseCase with dependencies.
@@ -81,11 +108,37 @@ func NewSyncAckUseCase(
 	}
 }
 
+// SetTimeline records acknowledgments in the alert timeline.
+func (uc *SyncAckUseCase) SetTimeline(timeline *alert.Timeline) {
+	uc.timeline = timeline
+}
+
+// SetUserDirectory fills in what the source did not tell about the
+// acknowledging user, such as the email of a PagerDuty user, and remembers
+// their other identities so that messages can name them in each system's
+// own terms.
+func (uc *SyncAckUseCase) SetUserDirectory(users *UserDirectory) {
+	uc.users = users
+}
+
 // Execute processes an acknowledgment and syncs to all connected systems.
-func (uc *SyncAckUseCase) Execute(ctx context.Context, input SyncAckInput) (*SyncAckOutput, error) {
+func (uc *SyncAckUseCase) Execute(ctx context.Context, input SyncAckInput) (_ *SyncAckOutput, err error) {
+	start := time.Now()
 	var syncedCount int
 	var errorCount int
 
+	ctx, span := observability.StartSpan(ctx, "ack.sync",
+		attribute.String("alert.id", input.AlertID),
+		attribute.String("ack.source", string(input.Source)),
+	)
+	defer func() {
+		span.SetAttributes(
+			attribute.Int("ack.synced_systems", syncedCount),
+			attribute.Int("ack.sync_errors", errorCount),
+		)
+		observability.EndSpan(span, err)
+	}()
+
 	defer func() {
 		if uc.metrics != nil {
 			uc.metrics.RecordAcknowledgmentSynced(
@@ -93,6 +146,7 @@ func (uc *SyncAckUseCase) Execute(ctx context.Context, input SyncAckInput) (*Syn
 				string(input.Source),
 				syncedCount,
 				errorCount,
+				time.Since(start),
 			)
 		}
 	}()
@@ -108,6 +162,14 @@ func (uc *SyncAckUseCase) Execute(ctx context.Context, input SyncAckInput) (*Syn
 		return nil, entity.ErrAlertNotFound
 	}
 
+	user := uc.users.Resolve(ctx, input.user())
+	if input.UserEmail == "" {
+		input.UserEmail = user.Email
+	}
+	if input.UserName == "" {
+		input.UserName = user.Name
+	}
+
 	// 2. Create ack event
 	ackEvent := entity.NewAckEvent(
 		input.AlertID,
@@ -124,14 +186,21 @@ func (uc *SyncAckUseCase) Execute(ctx context.Context, input SyncAckInput) (*Syn
 	}
 
 	// 3-5. Save ack event and update alert in a transaction
+	var ackedBy string
+	var acked bool
 	err = uc.txManager.WithTransaction(ctx, func(txCtx context.Context) error {
 		// 3. Save ack event (for audit trail)
 		if err := uc.ackEventRepo.Save(txCtx, ackEvent); err != nil {
 			return fmt.Errorf("saving ack event: %w", err)
 		}
 
-		// 4. Update alert state
-		err := alert.Acknowledge(input.UserEmail, time.Now().UTC())
+		// 4. Update alert state (sources without an email identify by name)
+		ackedBy = input.UserEmail
+		if ackedBy == "" {
+			ackedBy = input.UserName
+		}
+		err := alert.Acknowledge(ackedBy, time.Now().UTC())
+		acked = err == nil
 		if err != nil {
 			// If already acknowledged, continue to sync (idempotent behavior)
 			if !errors.Is(err, entity.ErrAlertAlreadyAcked) && !errors.Is(err, entity.ErrAlertAlreadyResolved) {
@@ -158,6 +227,11 @@ func (uc *SyncAckUseCase) Execute(ctx context.Context, input SyncAckInput) (*Syn
 	output.AckEvent = ackEvent
 	output.Alert = alert
 
+	// Repeated acks and acks of resolved alerts change nothing
+	if acked {
+		uc.timeline.Record(ctx, alert.ID, entity.AlertEventAcknowledged, ackedBy, string(input.Source))
+	}
+
 	// 6. Sync to other systems (outside transaction - external API calls)
 	uc.syncToExternalSystems(ctx, alert, ackEvent, input.Source, output)
 
@@ -208,7 +282,13 @@ func (uc *SyncAckUseCase) syncToExternalSystems(
 		}
 
 		// Sync to this system
-		if err := syncer.Acknowledge(ctx, alert, ackEvent); err != nil {
+		syncCtx, span := observability.StartSpan(ctx, "sync "+syncer.Name(),
+			attribute.String("syncer", syncer.Name()),
+			attribute.String("alert.id", alert.ID),
+		)
+		err := syncer.Acknowledge(syncCtx, alert, ackEvent)
+		observability.EndSpan(span, err)
+		if err != nil {
 			uc.logger.Error("failed to sync ack",
 				"syncer", syncer.Name(),
 				"alertID", alert.ID,
@@ -0,0 +1,205 @@
+package ack
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// SlackUserLookup finds Slack users by email. It returns nil if there is
+// no such user.
+type SlackUserLookup interface {
+	LookupUserByEmail(ctx context.Context, email string) (*entity.UserIdentity, error)
+}
+
+// PagerDutyUserLookup finds PagerDuty users by ID or email. It returns nil
+// if there is no such user.
+type PagerDutyUserLookup interface {
+	LookupUser(ctx context.Context, id string) (*entity.UserIdentity, error)
+	LookupUserByEmail(ctx context.Context, email string) (*entity.UserIdentity, error)
+}
+
+// UserDirectory maps people between their email, Slack user and PagerDuty
+// user. Configured users are known up front; others are looked up in Slack
+// and PagerDuty, if enabled, and cached for the TTL, including lookups
+// that found nobody. A nil directory knows nobody.
+type UserDirectory struct {
+	users     map[string]entity.UserIdentity
+	slack     SlackUserLookup
+	pagerDuty PagerDutyUserLookup
+	ttl       time.Duration
+	logger    Logger
+	now       func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]cachedUser
+}
+
+type cachedUser struct {
+	user      entity.UserIdentity
+	expiresAt time.Time
+}
+
+// NewUserDirectory creates a directory of the configured users.
+func NewUserDirectory(users []entity.UserIdentity, ttl time.Duration, logger Logger) *UserDirectory {
+	d := &UserDirectory{
+		users:  make(map[string]entity.UserIdentity),
+		ttl:    ttl,
+		logger: logger,
+		now:    time.Now,
+		cache:  make(map[string]cachedUser),
+	}
+	for _, user := range users {
+		for _, key := range userKeys(user) {
+			d.users[key] = user
+		}
+	}
+	return d
+}
+
+// SetSlackLookup looks up users missing from the directory in Slack.
+func (d *UserDirectory) SetSlackLookup(lookup SlackUserLookup) {
+	d.slack = lookup
+}
+
+// SetPagerDutyLookup looks up users missing from the directory in
+// PagerDuty.
+func (d *UserDirectory) SetPagerDutyLookup(lookup PagerDutyUserLookup) {
+	d.pagerDuty = lookup
+}
+
+// Resolve fills in what known lacks from the directory and the lookups.
+// Failed lookups are logged and leave the fields empty.
+func (d *UserDirectory) Resolve(ctx context.Context, known entity.UserIdentity) entity.UserIdentity {
+	if d == nil {
+		return known
+	}
+
+	user := known
+	d.mergeConfigured(&user)
+	if cached, ok := d.cached(user); ok {
+		user.Merge(cached)
+		return user
+	}
+
+	failed := false
+	if d.pagerDuty != nil && user.Email == "" && user.PagerDutyUserID != "" {
+		found, err := d.pagerDuty.LookupUser(ctx, user.PagerDutyUserID)
+		failed = d.merge(&user, found, err, "pagerduty") || failed
+		d.mergeConfigured(&user)
+	}
+	if d.slack != nil && user.Email != "" && user.SlackUserID == "" {
+		found, err := d.slack.LookupUserByEmail(ctx, user.Email)
+		failed = d.merge(&user, found, err, "slack") || failed
+	}
+	if d.pagerDuty != nil && user.Email != "" && user.PagerDutyUserID == "" {
+		found, err := d.pagerDuty.LookupUserByEmail(ctx, user.Email)
+		failed = d.merge(&user, found, err, "pagerduty") || failed
+	}
+
+	// Failures are retried on the next resolve rather than cached
+	if !failed {
+		d.store(user)
+	}
+	return user
+}
+
+// SlackUserID returns the Slack user ID of the email, if the directory
+// knows it. It does not look the user up.
+func (d *UserDirectory) SlackUserID(email string) string {
+	return d.known(email).SlackUserID
+}
+
+// PagerDutyUserID returns the PagerDuty user ID of the email, if the
+// directory knows it. It does not look the user up.
+func (d *UserDirectory) PagerDutyUserID(email string) string {
+	return d.known(email).PagerDutyUserID
+}
+
+// known returns what the directory knows of the email without lookups.
+func (d *UserDirectory) known(email string) entity.UserIdentity {
+	if d == nil || email == "" {
+		return entity.UserIdentity{}
+	}
+	user := entity.UserIdentity{Email: email}
+	d.mergeConfigured(&user)
+	if cached, ok := d.cached(user); ok {
+		user.Merge(cached)
+	}
+	return user
+}
+
+// merge fills user from a lookup result and reports whether the lookup
+// failed.
+func (d *UserDirectory) merge(user *entity.UserIdentity, found *entity.UserIdentity, err error, system string) bool {
+	if err != nil {
+		d.logger.Warn("user lookup failed",
+			"system", system,
+			"email", user.Email,
+			"error", err,
+		)
+		return true
+	}
+	if found != nil {
+		user.Merge(*found)
+	}
+	return false
+}
+
+// mergeConfigured fills user from the configured user of any of its keys.
+func (d *UserDirectory) mergeConfigured(user *entity.UserIdentity) {
+	for _, key := range userKeys(*user) {
+		if configured, ok := d.users[key]; ok {
+			user.Merge(configured)
+			return
+		}
+	}
+}
+
+func (d *UserDirectory) cached(user entity.UserIdentity) (entity.UserIdentity, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.now()
+	for _, key := range userKeys(user) {
+		entry, ok := d.cache[key]
+		if !ok {
+			continue
+		}
+		if now.After(entry.expiresAt) {
+			delete(d.cache, key)
+			continue
+		}
+		return entry.user, true
+	}
+	return entity.UserIdentity{}, false
+}
+
+func (d *UserDirectory) store(user entity.UserIdentity) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	entry := cachedUser{user: user, expiresAt: d.now().Add(d.ttl)}
+	for _, key := range userKeys(user) {
+		d.cache[key] = entry
+	}
+}
+
+// userKeys returns the keys a user is found by. Emails are compared
+// case-insensitively.
+func userKeys(user entity.UserIdentity) []string {
+	var keys []string
+	if user.Email != "" {
+		keys = append(keys, "email:"+strings.ToLower(user.Email))
+	}
+	if user.SlackUserID != "" {
+		keys = append(keys, "slack:"+user.SlackUserID)
+	}
+	if user.PagerDutyUserID != "" {
+		keys = append(keys, "pagerduty:"+user.PagerDutyUserID)
+	}
+	return keys
+}
@@ -0,0 +1,117 @@
+package ack
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...any) {}
+func (nopLogger) Info(string, ...any)  {}
+func (nopLogger) Warn(string, ...any)  {}
+func (nopLogger) Error(string, ...any) {}
+
+// fakeUsers implements both lookups over a list of users.
+type fakeUsers struct {
+	users []entity.UserIdentity
+	calls int
+	err   error
+}
+
+func (f *fakeUsers) find(match func(entity.UserIdentity) bool) (*entity.UserIdentity, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	for _, user := range f.users {
+		if match(user) {
+			return &user, nil
+		}
+	}
+	return nil, nil
+}
+
+func (f *fakeUsers) LookupUser(_ context.Context, id string) (*entity.UserIdentity, error) {
+	return f.find(func(u entity.UserIdentity) bool { return u.PagerDutyUserID == id })
+}
+
+func (f *fakeUsers) LookupUserByEmail(_ context.Context, email string) (*entity.UserIdentity, error) {
+	return f.find(func(u entity.UserIdentity) bool { return strings.EqualFold(u.Email, email) })
+}
+
+func TestUserDirectory_Resolve(t *testing.T) {
+	ctx := context.Background()
+	slack := &fakeUsers{users: []entity.UserIdentity{
+		{Email: "alice@example.com", Name: "alice", SlackUserID: "U1"},
+	}}
+	pagerDuty := &fakeUsers{users: []entity.UserIdentity{
+		{Email: "alice@example.com", Name: "Alice Smith", PagerDutyUserID: "P1"},
+	}}
+
+	directory := NewUserDirectory([]entity.UserIdentity{
+		{Email: "bob@example.com", Name: "Bob", SlackUserID: "U2", PagerDutyUserID: "P2"},
+	}, time.Hour, nopLogger{})
+	directory.SetSlackLookup(slack)
+	directory.SetPagerDutyLookup(pagerDuty)
+
+	// An ack from PagerDuty only names the PagerDuty user
+	user := directory.Resolve(ctx, entity.UserIdentity{PagerDutyUserID: "P1"})
+	assert.Equal(t, entity.UserIdentity{
+		Email: "alice@example.com", Name: "Alice Smith", SlackUserID: "U1", PagerDutyUserID: "P1",
+	}, user)
+	assert.Equal(t, "U1", directory.SlackUserID("Alice@Example.com"))
+	assert.Equal(t, "P1", directory.PagerDutyUserID("alice@example.com"))
+
+	// Cached by any of the user's identities
+	user = directory.Resolve(ctx, entity.UserIdentity{SlackUserID: "U1", Name: "alice"})
+	assert.Equal(t, "alice@example.com", user.Email)
+	assert.Equal(t, "alice", user.Name)
+	assert.Equal(t, 1, slack.calls)
+	assert.Equal(t, 1, pagerDuty.calls)
+
+	// Configured users are not looked up
+	user = directory.Resolve(ctx, entity.UserIdentity{Email: "bob@example.com"})
+	assert.Equal(t, "U2", user.SlackUserID)
+	assert.Equal(t, "P2", user.PagerDutyUserID)
+	assert.Equal(t, 1, slack.calls)
+
+	// Lookups that found nobody are cached too
+	directory.Resolve(ctx, entity.UserIdentity{Email: "carol@example.com"})
+	directory.Resolve(ctx, entity.UserIdentity{Email: "carol@example.com"})
+	assert.Equal(t, 2, slack.calls)
+	assert.Empty(t, directory.SlackUserID("carol@example.com"))
+
+	// Until they expire
+	directory.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+	directory.Resolve(ctx, entity.UserIdentity{Email: "carol@example.com"})
+	assert.Equal(t, 3, slack.calls)
+}
+
+func TestUserDirectory_Resolve_FailedLookup(t *testing.T) {
+	ctx := context.Background()
+	slack := &fakeUsers{err: errors.New("slack down")}
+	directory := NewUserDirectory(nil, time.Hour, nopLogger{})
+	directory.SetSlackLookup(slack)
+
+	user := directory.Resolve(ctx, entity.UserIdentity{Email: "alice@example.com"})
+	assert.Empty(t, user.SlackUserID)
+
+	// Failures are not cached
+	directory.Resolve(ctx, entity.UserIdentity{Email: "alice@example.com"})
+	assert.Equal(t, 2, slack.calls)
+}
+
+func TestUserDirectory_Nil(t *testing.T) {
+	var directory *UserDirectory
+	known := entity.UserIdentity{Email: "alice@example.com"}
+	assert.Equal(t, known, directory.Resolve(context.Background(), known))
+	assert.Empty(t, directory.SlackUserID("alice@example.com"))
+}
@@ -0,0 +1,91 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+)
+
+// AssignAlertInput represents the input for assigning an alert.
+type AssignAlertInput struct {
+	AlertID string
+
+	// Assignee names who takes ownership; AssigneeSlackID is their Slack
+	// user ID, if known.
+	Assignee        string
+	AssigneeSlackID string
+
+	// By identifies who made the assignment.
+	By string
+}
+
+// AssignAlertOutput represents the result of assigning an alert.
+type AssignAlertOutput struct {
+	Alert *entity.Alert
+
+	// Previous is who the alert was assigned to before, if anyone.
+	Previous string
+}
+
+// AssignAlertUseCase records who owns an alert. Unlike acknowledging, it
+// leaves the alert's state alone and can be repeated to hand it over.
+type AssignAlertUseCase struct {
+	alertRepo repository.AlertRepository
+	timeline  *Timeline
+	logger    Logger
+}
+
+// NewAssignAlertUseCase creates a new AssignAlertUseCase.
+func NewAssignAlertUseCase(alertRepo repository.AlertRepository, logger Logger) *AssignAlertUseCase {
+	return &AssignAlertUseCase{
+		alertRepo: alertRepo,
+		logger:    logger,
+	}
+}
+
+// SetTimeline records assignments in the alert timeline.
+func (uc *AssignAlertUseCase) SetTimeline(timeline *Timeline) {
+	uc.timeline = timeline
+}
+
+// Execute assigns the alert to input.Assignee.
+// Returns entity.ErrAlertAlreadyResolved if the alert is resolved.
+func (uc *AssignAlertUseCase) Execute(ctx context.Context, input AssignAlertInput) (*AssignAlertOutput, error) {
+	// 1. Load the alert
+	alert, err := uc.alertRepo.FindByID(ctx, input.AlertID)
+	if err != nil {
+		return nil, fmt.Errorf("finding alert: %w", err)
+	}
+	if alert == nil {
+		return nil, entity.ErrAlertNotFound
+	}
+
+	// 2. Assign
+	previous := alert.AssignedTo
+	if err := alert.Assign(input.Assignee, input.AssigneeSlackID, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+
+	// 3. Persist
+	if err := uc.alertRepo.Update(ctx, alert); err != nil {
+		return nil, fmt.Errorf("updating alert: %w", err)
+	}
+
+	detail := "to " + input.Assignee
+	if previous != "" && previous != input.Assignee {
+		detail += " (was " + previous + ")"
+	}
+	uc.timeline.Record(ctx, alert.ID, entity.AlertEventAssigned, input.By, detail)
+
+	uc.logger.Info("alert assigned",
+		"alertID", alert.ID,
+		"previous", previous,
+		"assignee", input.Assignee,
+		"by", input.By,
+	)
+
+	return &AssignAlertOutput{Alert: alert, Previous: previous}, nil
+}
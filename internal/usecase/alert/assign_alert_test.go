@@ -0,0 +1,53 @@
+package alert
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/memory"
+)
+
+func TestAssignAlertUseCase(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewAlertRepository()
+	events := memory.NewAlertEventRepository()
+	uc := NewAssignAlertUseCase(repo, nopLogger{})
+	uc.SetTimeline(NewTimeline(events, nopLogger{}))
+
+	a := entity.NewAlert("fp1", "HighCPU", "web-1", "", "summary", entity.SeverityWarning)
+	require.NoError(t, repo.Save(ctx, a))
+
+	output, err := uc.Execute(ctx, AssignAlertInput{AlertID: a.ID, Assignee: "alice", AssigneeSlackID: "U1", By: "alice"})
+	require.NoError(t, err)
+	assert.Empty(t, output.Previous)
+
+	// Handing over keeps the alert's state and records who had it
+	output, err = uc.Execute(ctx, AssignAlertInput{AlertID: a.ID, Assignee: "bob", AssigneeSlackID: "U2", By: "alice"})
+	require.NoError(t, err)
+	assert.Equal(t, "alice", output.Previous)
+
+	stored, err := repo.FindByID(ctx, a.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "bob", stored.AssignedTo)
+	assert.Equal(t, "U2", stored.AssigneeSlackID)
+	assert.Equal(t, entity.StateActive, stored.State)
+
+	timeline, err := events.FindByAlertID(ctx, a.ID)
+	require.NoError(t, err)
+	require.Len(t, timeline, 2)
+	assert.Equal(t, entity.AlertEventAssigned, timeline[1].Type)
+	assert.Equal(t, "to bob (was alice)", timeline[1].Detail)
+
+	stored.Resolve(time.Now())
+	require.NoError(t, repo.Update(ctx, stored))
+	_, err = uc.Execute(ctx, AssignAlertInput{AlertID: a.ID, Assignee: "carol"})
+	assert.ErrorIs(t, err, entity.ErrAlertAlreadyResolved)
+
+	_, err = uc.Execute(ctx, AssignAlertInput{AlertID: "missing", Assignee: "carol"})
+	assert.ErrorIs(t, err, entity.ErrAlertNotFound)
+}
@@ -0,0 +1,86 @@
+package alert
+
+import (
+	"context"
+	"hash/fnv"
+	"strings"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// canaryNotifierName is the external reference key for shadow notifications.
+const canaryNotifierName = "canary"
+
+// CanaryNotifier sends a sample of alerts through a shadow notifier built
+// from a candidate configuration, so its output can be compared with the
+// live channel before the change is rolled out.
+//
+// Sampling is keyed on the alert fingerprint, so a sampled alert's
+// acknowledgment and resolution updates reach the shadow channel as well.
+type CanaryNotifier struct {
+	shadow  Notifier
+	percent int
+	label   string
+	routing *RoutingTree
+}
+
+// NewCanaryNotifier creates a canary that forwards percent% of alerts, plus
+// any alert whose label is set to a value other than "false", to shadow.
+// An empty label disables label-based selection.
+func NewCanaryNotifier(shadow Notifier, percent int, label string) *CanaryNotifier {
+	return &CanaryNotifier{
+		shadow:  shadow,
+		percent: percent,
+		label:   label,
+	}
+}
+
+// SetRoutingTree mirrors only alerts the tree delivers to Slack, such as a
+// candidate routing tree, or the live one to shadow Slack's routing.
+func (c *CanaryNotifier) SetRoutingTree(tree *RoutingTree) {
+	c.routing = tree
+}
+
+// Notify forwards the alert to the shadow notifier if it is selected.
+// Returns ErrNotificationSkipped otherwise.
+func (c *CanaryNotifier) Notify(ctx context.Context, alert *entity.Alert) (entity.NotifyResult, error) {
+	if !c.Selects(alert) {
+		return entity.NotifyResult{}, ErrNotificationSkipped
+	}
+	return c.shadow.Notify(ctx, alert)
+}
+
+// UpdateMessage updates the shadow notification. It is only called for
+// alerts that were sampled, since only those have a canary reference.
+func (c *CanaryNotifier) UpdateMessage(ctx context.Context, messageID string, alert *entity.Alert) error {
+	return c.shadow.UpdateMessage(ctx, messageID, alert)
+}
+
+// Name returns the notifier identifier.
+func (c *CanaryNotifier) Name() string {
+	return canaryNotifierName
+}
+
+// Capabilities returns the shadow notifier's capabilities.
+func (c *CanaryNotifier) Capabilities() entity.NotifierCapabilities {
+	return CapabilitiesOf(c.shadow)
+}
+
+// Selects reports whether the alert is part of the canary sample.
+func (c *CanaryNotifier) Selects(alert *entity.Alert) bool {
+	if c.routing != nil && !c.routing.Route(alert).Includes("slack") {
+		return false
+	}
+	if c.label != "" {
+		if v, ok := alert.Labels[c.label]; ok && !strings.EqualFold(v, "false") {
+			return true
+		}
+	}
+	if c.percent <= 0 {
+		return false
+	}
+
+	h := fnv.New32a()
+	h.Write([]byte(alert.Fingerprint))
+	return int(h.Sum32()%100) < c.percent
+}
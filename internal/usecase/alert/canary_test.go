@@ -0,0 +1,126 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/adapter/dto"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/memory"
+)
+
+func TestCanaryNotifier_Selects(t *testing.T) {
+	newAlert := func(fingerprint string, labels map[string]string) *entity.Alert {
+		alert := entity.NewAlert(fingerprint, "HighCPU", "web-1", "", "", entity.SeverityWarning)
+		alert.Labels = labels
+		return alert
+	}
+
+	t.Run("sampling by fingerprint", func(t *testing.T) {
+		canary := NewCanaryNotifier(&namedNotifier{name: "slack"}, 10, "")
+		wider := NewCanaryNotifier(&namedNotifier{name: "slack"}, 50, "")
+
+		selected := 0
+		for i := range 1000 {
+			alert := newAlert(fmt.Sprintf("fp-%d", i), nil)
+			sampled := canary.Selects(alert)
+			if sampled {
+				selected++
+				assert.True(t, wider.Selects(alert), "%s sampled at 10%% but not at 50%%", alert.Fingerprint)
+			}
+			// Updates of the alert reach the shadow channel too
+			assert.Equal(t, sampled, canary.Selects(newAlert(alert.Fingerprint, nil)))
+		}
+		assert.InDelta(t, 100, selected, 30)
+	})
+
+	t.Run("percent bounds", func(t *testing.T) {
+		none := NewCanaryNotifier(&namedNotifier{name: "slack"}, 0, "")
+		all := NewCanaryNotifier(&namedNotifier{name: "slack"}, 100, "")
+		for i := range 100 {
+			alert := newAlert(fmt.Sprintf("fp-%d", i), nil)
+			assert.False(t, none.Selects(alert))
+			assert.True(t, all.Selects(alert))
+		}
+	})
+
+	t.Run("label", func(t *testing.T) {
+		canary := NewCanaryNotifier(&namedNotifier{name: "slack"}, 0, "canary")
+		tests := []struct {
+			labels map[string]string
+			want   bool
+		}{
+			{map[string]string{"canary": "true"}, true},
+			{map[string]string{"canary": ""}, true},
+			{map[string]string{"canary": "FALSE"}, false},
+			{map[string]string{"team": "infra"}, false},
+			{nil, false},
+		}
+		for _, tt := range tests {
+			assert.Equal(t, tt.want, canary.Selects(newAlert("fp-1", tt.labels)), "labels %v", tt.labels)
+		}
+
+		unlabeled := NewCanaryNotifier(&namedNotifier{name: "slack"}, 0, "")
+		assert.False(t, unlabeled.Selects(newAlert("fp-1", map[string]string{"canary": "true"})))
+	})
+
+	t.Run("routing", func(t *testing.T) {
+		canary := NewCanaryNotifier(&namedNotifier{name: "slack"}, 100, "")
+		canary.SetRoutingTree(NewRoutingTree(Route{
+			Name:      "root",
+			Notifiers: []string{"pagerduty"},
+			Routes: []Route{
+				{Name: "infra", Match: map[string]string{"team": "infra"}, Notifiers: []string{"slack"}},
+			},
+		}))
+
+		assert.True(t, canary.Selects(newAlert("fp-1", map[string]string{"team": "infra"})))
+		assert.False(t, canary.Selects(newAlert("fp-1", map[string]string{"team": "db"})))
+	})
+}
+
+func TestCanaryNotifier_SkipsUnsampledAlerts(t *testing.T) {
+	ctx := context.Background()
+	shadow := &namedNotifier{name: "slack"}
+	canary := NewCanaryNotifier(shadow, 0, "canary")
+
+	_, err := canary.Notify(ctx, entity.NewAlert("fp-1", "HighCPU", "web-1", "", "", entity.SeverityWarning))
+	assert.ErrorIs(t, err, ErrNotificationSkipped)
+	assert.Empty(t, shadow.notified)
+
+	// A skipped canary is neither a delivery nor a failure
+	alertRepo := memory.NewAlertRepository()
+	uc := NewProcessAlertUseCase(alertRepo, memory.NewSilenceRepository(), []Notifier{canary}, nopLogger{}, nil)
+	output, err := uc.Execute(ctx, dto.ProcessAlertInput{
+		Fingerprint: "fp-2",
+		Name:        "HighCPU",
+		Severity:    entity.SeverityWarning,
+		Status:      "firing",
+	})
+	require.NoError(t, err)
+	assert.Empty(t, output.Deliveries)
+	assert.Empty(t, output.NotificationsFailed)
+	assert.Empty(t, shadow.notified)
+
+	stored, err := alertRepo.FindByID(ctx, output.AlertID)
+	require.NoError(t, err)
+	assert.False(t, stored.HasExternalReference(canaryNotifierName))
+
+	// A sampled alert is delivered under the canary's name
+	output, err = uc.Execute(ctx, dto.ProcessAlertInput{
+		Fingerprint: "fp-3",
+		Name:        "HighCPU",
+		Labels:      map[string]string{"canary": "true"},
+		Severity:    entity.SeverityWarning,
+		Status:      "firing",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"fp-3"}, shadow.notified)
+	stored, err = alertRepo.FindByID(ctx, output.AlertID)
+	require.NoError(t, err)
+	assert.Equal(t, "msg-fp-3", stored.GetExternalReference(canaryNotifierName))
+}
@@ -0,0 +1,212 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// sloBucketWidth is the granularity of the rolling compliance window.
+const sloBucketWidth = time.Minute
+
+// metaAlertTimeout bounds posting a breach notice, which runs off the
+// notification path.
+const metaAlertTimeout = 10 * time.Second
+
+// DeliveryObjective is a latency objective for one route. Empty Notifier or
+// Severity match any.
+type DeliveryObjective struct {
+	Name     string
+	Notifier string
+	Severity entity.AlertSeverity
+	Latency  time.Duration
+	Target   float64 // percent of deliveries within Latency
+}
+
+// DeliveryObjectiveStatus is an objective's compliance over the window.
+type DeliveryObjectiveStatus struct {
+	Name       string  `json:"name"`
+	Notifier   string  `json:"notifier,omitempty"`
+	Severity   string  `json:"severity,omitempty"`
+	Latency    string  `json:"latency"`
+	Target     float64 `json:"target"`
+	Deliveries int     `json:"deliveries"`
+	Met        int     `json:"met"`
+	Compliance float64 `json:"compliance"` // percent; 100 with no deliveries
+	Breached   bool    `json:"breached"`
+}
+
+// MetaAlertPoster posts a plain-text message to a channel.
+// Implemented by the Slack client.
+type MetaAlertPoster interface {
+	PostText(ctx context.Context, channelID, text string) error
+}
+
+// DeliverySLOTracker measures notification latency against per-route
+// objectives over a rolling window and reports breaches and recoveries to a
+// meta-alert channel.
+type DeliverySLOTracker struct {
+	mu         sync.Mutex
+	objectives []*objectiveState
+	window     time.Duration
+	minSamples int
+
+	poster    MetaAlertPoster
+	channelID string
+	logger    Logger
+	now       func() time.Time
+}
+
+type objectiveState struct {
+	DeliveryObjective
+	buckets  []sloBucket
+	breached bool
+}
+
+type sloBucket struct {
+	start time.Time
+	total int
+	met   int
+}
+
+// NewDeliverySLOTracker creates a tracker. A breach is only reported once
+// the window holds at least minSamples deliveries for the objective.
+func NewDeliverySLOTracker(objectives []DeliveryObjective, window time.Duration, minSamples int, logger Logger) *DeliverySLOTracker {
+	n := int(window / sloBucketWidth)
+	if n < 1 {
+		n = 1
+	}
+
+	t := &DeliverySLOTracker{
+		window:     time.Duration(n) * sloBucketWidth,
+		minSamples: minSamples,
+		logger:     logger,
+		now:        time.Now,
+	}
+	for _, obj := range objectives {
+		t.objectives = append(t.objectives, &objectiveState{
+			DeliveryObjective: obj,
+			buckets:           make([]sloBucket, n),
+		})
+	}
+	return t
+}
+
+// SetMetaAlertPoster sets where breach notices are posted. Without one,
+// breaches are only logged.
+func (t *DeliverySLOTracker) SetMetaAlertPoster(poster MetaAlertPoster, channelID string) {
+	t.poster = poster
+	t.channelID = channelID
+}
+
+// RecordDelivery records one delivery attempt for a new alert. A failed
+// delivery counts against every matching objective.
+func (t *DeliverySLOTracker) RecordDelivery(notifier string, severity entity.AlertSeverity, latency time.Duration, delivered bool) {
+	now := t.now()
+	slot := now.Truncate(sloBucketWidth)
+
+	var notices []string
+
+	t.mu.Lock()
+	for _, obj := range t.objectives {
+		if obj.Notifier != "" && obj.Notifier != notifier {
+			continue
+		}
+		if obj.Severity != "" && obj.Severity != severity {
+			continue
+		}
+
+		b := &obj.buckets[(slot.UnixNano()/int64(sloBucketWidth))%int64(len(obj.buckets))]
+		if !b.start.Equal(slot) {
+			*b = sloBucket{start: slot}
+		}
+		b.total++
+		if delivered && latency <= obj.Latency {
+			b.met++
+		}
+
+		status := t.status(obj, now)
+		switch {
+		case !obj.breached && status.Deliveries >= t.minSamples && status.Compliance < obj.Target:
+			obj.breached = true
+			notices = append(notices, fmt.Sprintf(
+				":rotating_light: Delivery SLO breached: *%s* at %.2f%% within %s (target %g%%, %d deliveries in the last %s)",
+				obj.Name, status.Compliance, obj.Latency, obj.Target, status.Deliveries, t.window,
+			))
+		case obj.breached && status.Compliance >= obj.Target:
+			obj.breached = false
+			notices = append(notices, fmt.Sprintf(
+				":white_check_mark: Delivery SLO recovered: *%s* at %.2f%% within %s (target %g%%)",
+				obj.Name, status.Compliance, obj.Latency, obj.Target,
+			))
+		}
+	}
+	t.mu.Unlock()
+
+	for _, notice := range notices {
+		t.notify(notice)
+	}
+}
+
+// Status returns the current compliance of every objective.
+func (t *DeliverySLOTracker) Status() []DeliveryObjectiveStatus {
+	now := t.now()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	statuses := make([]DeliveryObjectiveStatus, 0, len(t.objectives))
+	for _, obj := range t.objectives {
+		statuses = append(statuses, t.status(obj, now))
+	}
+	return statuses
+}
+
+// status sums the buckets inside the window. Callers hold t.mu.
+func (t *DeliverySLOTracker) status(obj *objectiveState, now time.Time) DeliveryObjectiveStatus {
+	s := DeliveryObjectiveStatus{
+		Name:       obj.Name,
+		Notifier:   obj.Notifier,
+		Severity:   string(obj.Severity),
+		Latency:    obj.Latency.String(),
+		Target:     obj.Target,
+		Compliance: 100,
+		Breached:   obj.breached,
+	}
+
+	cutoff := now.Add(-t.window)
+	for _, b := range obj.buckets {
+		if b.total == 0 || !b.start.After(cutoff) {
+			continue
+		}
+		s.Deliveries += b.total
+		s.Met += b.met
+	}
+	if s.Deliveries > 0 {
+		s.Compliance = float64(s.Met) * 100 / float64(s.Deliveries)
+	}
+	return s
+}
+
+// notify logs a breach notice and posts it in the background so the
+// notification path is not delayed by the meta-alert channel.
+func (t *DeliverySLOTracker) notify(text string) {
+	t.logger.Warn("delivery SLO state changed", "notice", text)
+	if t.poster == nil || t.channelID == "" {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), metaAlertTimeout)
+		defer cancel()
+		if err := t.poster.PostText(ctx, t.channelID, text); err != nil {
+			t.logger.Error("failed to post delivery SLO notice",
+				"channel", t.channelID,
+				"error", err,
+			)
+		}
+	}()
+}
@@ -0,0 +1,243 @@
+package alert
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// textPoster records the notices posted to it.
+type textPoster struct {
+	mu    sync.Mutex
+	texts []string
+}
+
+func (p *textPoster) PostText(_ context.Context, _, text string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.texts = append(p.texts, text)
+	return nil
+}
+
+func (p *textPoster) posted() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string(nil), p.texts...)
+}
+
+func TestDeliverySLOTracker_Status(t *testing.T) {
+	start := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	objective := DeliveryObjective{Name: "slack-critical", Notifier: "slack", Severity: entity.SeverityCritical, Latency: 5 * time.Second, Target: 99}
+
+	type delivery struct {
+		at        time.Duration
+		notifier  string
+		latency   time.Duration
+		delivered bool
+	}
+	fast := func(at time.Duration) delivery { return delivery{at, "slack", time.Second, true} }
+	slow := func(at time.Duration) delivery { return delivery{at, "slack", 10 * time.Second, true} }
+
+	tests := []struct {
+		name       string
+		deliveries []delivery
+		at         time.Duration
+		total      int
+		met        int
+	}{
+		{
+			name: "no deliveries",
+			at:   time.Minute,
+		},
+		{
+			name:       "latency and failures",
+			deliveries: []delivery{fast(0), slow(0), {0, "slack", time.Second, false}},
+			at:         time.Second,
+			total:      3,
+			met:        1,
+		},
+		{
+			name:       "other routes are ignored",
+			deliveries: []delivery{fast(0), {0, "pagerduty", time.Second, true}},
+			at:         time.Second,
+			total:      1,
+			met:        1,
+		},
+		{
+			name:       "inside the window",
+			deliveries: []delivery{slow(0), fast(4 * time.Minute)},
+			at:         4*time.Minute + 59*time.Second,
+			total:      2,
+			met:        1,
+		},
+		{
+			name:       "window cutoff",
+			deliveries: []delivery{slow(0), fast(4 * time.Minute)},
+			at:         5 * time.Minute,
+			total:      1,
+			met:        1,
+		},
+		{
+			name:       "bucket rotation",
+			deliveries: []delivery{slow(0), slow(30 * time.Second), fast(5 * time.Minute)},
+			at:         5 * time.Minute,
+			total:      1,
+			met:        1,
+		},
+		{
+			name:       "rotation after a gap longer than the window",
+			deliveries: []delivery{slow(0), fast(time.Hour)},
+			at:         time.Hour,
+			total:      1,
+			met:        1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracker := NewDeliverySLOTracker([]DeliveryObjective{objective}, 5*time.Minute, 1000, nopLogger{})
+			for _, d := range tt.deliveries {
+				tracker.now = func() time.Time { return start.Add(d.at) }
+				tracker.RecordDelivery(d.notifier, entity.SeverityCritical, d.latency, d.delivered)
+			}
+			tracker.now = func() time.Time { return start.Add(tt.at) }
+
+			statuses := tracker.Status()
+			require.Len(t, statuses, 1)
+			assert.Equal(t, tt.total, statuses[0].Deliveries, "deliveries")
+			assert.Equal(t, tt.met, statuses[0].Met, "met")
+			want := 100.0
+			if tt.total > 0 {
+				want = float64(tt.met) * 100 / float64(tt.total)
+			}
+			assert.InDelta(t, want, statuses[0].Compliance, 0.001)
+		})
+	}
+}
+
+func TestDeliverySLOTracker_Notices(t *testing.T) {
+	start := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	objective := DeliveryObjective{Name: "slack-critical", Notifier: "slack", Latency: 5 * time.Second, Target: 90}
+
+	type fixture struct {
+		tracker *DeliverySLOTracker
+		poster  *textPoster
+	}
+	setup := func(minSamples int) fixture {
+		f := fixture{poster: &textPoster{}}
+		f.tracker = NewDeliverySLOTracker([]DeliveryObjective{objective}, 5*time.Minute, minSamples, nopLogger{})
+		f.tracker.SetMetaAlertPoster(f.poster, "C-META")
+		return f
+	}
+	record := func(f fixture, at time.Duration, met bool, n int) {
+		f.tracker.now = func() time.Time { return start.Add(at) }
+		for range n {
+			latency := time.Second
+			if !met {
+				latency = time.Minute
+			}
+			f.tracker.RecordDelivery("slack", entity.SeverityWarning, latency, true)
+		}
+	}
+	const (
+		breached  = ":rotating_light: Delivery SLO breached: *slack-critical*"
+		recovered = ":white_check_mark: Delivery SLO recovered: *slack-critical*"
+	)
+	// Notices are posted in the background, each from its own goroutine, so
+	// they are compared regardless of order
+	waitForNotices := func(t *testing.T, f fixture, want ...string) {
+		t.Helper()
+		require.Eventually(t, func() bool { return len(f.poster.posted()) >= len(want) }, time.Second, time.Millisecond)
+		time.Sleep(10 * time.Millisecond)
+		var kinds []string
+		for _, text := range f.poster.posted() {
+			switch {
+			case strings.HasPrefix(text, breached):
+				kinds = append(kinds, breached)
+			case strings.HasPrefix(text, recovered):
+				kinds = append(kinds, recovered)
+			default:
+				t.Errorf("unexpected notice %q", text)
+			}
+		}
+		assert.ElementsMatch(t, want, kinds)
+	}
+
+	tests := []struct {
+		name       string
+		minSamples int
+		run        func(f fixture)
+		want       []string
+		breached   bool
+	}{
+		{
+			name:       "below min samples",
+			minSamples: 10,
+			run:        func(f fixture) { record(f, 0, false, 9) },
+		},
+		{
+			name:       "breach at min samples",
+			minSamples: 10,
+			run:        func(f fixture) { record(f, 0, false, 10) },
+			want:       []string{breached},
+			breached:   true,
+		},
+		{
+			name:       "breach is posted once",
+			minSamples: 1,
+			run: func(f fixture) {
+				record(f, 0, false, 5)
+				record(f, time.Minute, false, 5)
+			},
+			want:     []string{breached},
+			breached: true,
+		},
+		{
+			name:       "recovery is posted once",
+			minSamples: 1,
+			run: func(f fixture) {
+				record(f, 0, false, 1)
+				record(f, time.Minute, true, 9)
+				record(f, 2*time.Minute, true, 5)
+			},
+			want: []string{breached, recovered},
+		},
+		{
+			name:       "recovery as failures leave the window",
+			minSamples: 1,
+			run: func(f fixture) {
+				record(f, 0, false, 3)
+				record(f, 5*time.Minute, true, 1)
+			},
+			want: []string{breached, recovered},
+		},
+		{
+			name:       "breach again after recovery",
+			minSamples: 1,
+			run: func(f fixture) {
+				record(f, 0, false, 1)
+				record(f, 5*time.Minute, true, 1)
+				record(f, 6*time.Minute, false, 1)
+			},
+			want:     []string{breached, recovered, breached},
+			breached: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := setup(tt.minSamples)
+			tt.run(f)
+			waitForNotices(t, f, tt.want...)
+
+			statuses := f.tracker.Status()
+			require.Len(t, statuses, 1)
+			assert.Equal(t, tt.breached, statuses[0].Breached)
+		})
+	}
+}
@@ -0,0 +1,317 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/observability"
+)
+
+// Escalation outcomes, used in logs and metrics.
+const (
+	escalationOutcomeSent   = "sent"
+	escalationOutcomeFailed = "failed"
+)
+
+// EscalationStep notifies Notifiers once an alert has been firing
+// unacknowledged for After.
+type EscalationStep struct {
+	After     time.Duration
+	Notifiers []string
+}
+
+// EscalationPolicy escalates unacknowledged alerts that match Severity and
+// Labels. Empty Severity and Labels match any alert. Steps are in order of
+// After.
+type EscalationPolicy struct {
+	Name     string
+	Severity entity.AlertSeverity
+	Labels   map[string]string
+	Steps    []EscalationStep
+}
+
+// Matches reports whether the policy applies to the alert.
+func (p EscalationPolicy) Matches(alert *entity.Alert) bool {
+	if p.Severity != "" && alert.Severity != p.Severity {
+		return false
+	}
+	for name, value := range p.Labels {
+		if alert.GetLabel(name) != value {
+			return false
+		}
+	}
+	return true
+}
+
+type escalationKey struct{}
+
+// withEscalation marks ctx as an escalation, which escalation-only
+// notifiers require before they notify.
+func withEscalation(ctx context.Context) context.Context {
+	return context.WithValue(ctx, escalationKey{}, true)
+}
+
+func isEscalation(ctx context.Context) bool {
+	v, _ := ctx.Value(escalationKey{}).(bool)
+	return v
+}
+
+// EscalationOnlyNotifier is a notifier that is only used by escalation
+// policies, such as a secondary PagerDuty service. It skips alerts on
+// ingestion but, once an escalation has notified it, receives their updates
+// like any other notifier.
+type EscalationOnlyNotifier struct {
+	Notifier
+}
+
+// NewEscalationOnlyNotifier wraps next so it only notifies on escalation.
+func NewEscalationOnlyNotifier(next Notifier) *EscalationOnlyNotifier {
+	return &EscalationOnlyNotifier{Notifier: next}
+}
+
+// Notify forwards escalations. Returns ErrNotificationSkipped otherwise.
+func (n *EscalationOnlyNotifier) Notify(ctx context.Context, alert *entity.Alert) (entity.NotifyResult, error) {
+	if !isEscalation(ctx) {
+		return entity.NotifyResult{}, ErrNotificationSkipped
+	}
+	return n.Notifier.Notify(ctx, alert)
+}
+
+// Capabilities returns the wrapped notifier's capabilities.
+func (n *EscalationOnlyNotifier) Capabilities() entity.NotifierCapabilities {
+	return CapabilitiesOf(n.Notifier)
+}
+
+// EscalationEngine periodically checks firing, unacknowledged alerts against
+// escalation policies and notifies each step's notifiers once the alert has
+// waited long enough.
+//
+// The engine keeps no state of its own: a step's notifier counts as done
+// once the alert has an external reference for it. Escalations therefore
+// survive restarts, a failed notification is tried again on the next
+// evaluation, and acks and resolutions reach escalated notifiers through the
+// usual update path.
+type EscalationEngine struct {
+	alertRepo   repository.AlertRepository
+	silenceRepo repository.SilenceRepository
+	notifiers   map[string]Notifier
+	policies    []EscalationPolicy
+	interval    time.Duration
+	logger      Logger
+	metrics     *observability.Metrics
+	now         func() time.Time
+
+	// Slack thread replies announcing escalations (optional)
+	threadNotifier ThreadNotifier
+
+	// Alert timeline (optional)
+	timeline *Timeline
+}
+
+// NewEscalationEngine creates an engine that evaluates policies every
+// interval. An alert is escalated by the first policy that matches it.
+func NewEscalationEngine(
+	alertRepo repository.AlertRepository,
+	silenceRepo repository.SilenceRepository,
+	notifiers []Notifier,
+	policies []EscalationPolicy,
+	interval time.Duration,
+	logger Logger,
+	metrics *observability.Metrics,
+) *EscalationEngine {
+	byName := make(map[string]Notifier, len(notifiers))
+	for _, n := range notifiers {
+		byName[n.Name()] = n
+	}
+	return &EscalationEngine{
+		alertRepo:   alertRepo,
+		silenceRepo: silenceRepo,
+		notifiers:   byName,
+		policies:    policies,
+		interval:    interval,
+		logger:      logger,
+		metrics:     metrics,
+		now:         time.Now,
+	}
+}
+
+// SetThreadNotifier enables a reply in the alert's Slack thread for each
+// escalation step.
+func (e *EscalationEngine) SetThreadNotifier(notifier ThreadNotifier) {
+	e.threadNotifier = notifier
+}
+
+// SetTimeline records escalation notifications in the alert timeline.
+func (e *EscalationEngine) SetTimeline(timeline *Timeline) {
+	e.timeline = timeline
+}
+
+// Run evaluates the policies every interval until ctx is cancelled.
+func (e *EscalationEngine) Run(ctx context.Context) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			e.Evaluate(ctx)
+		}
+	}
+}
+
+// Evaluate escalates every alert that is due for a step.
+func (e *EscalationEngine) Evaluate(ctx context.Context) {
+	alerts, err := e.alertRepo.FindFiring(ctx)
+	if err != nil {
+		e.logger.Error("failed to load firing alerts for escalation", "error", err)
+		return
+	}
+
+	now := e.now()
+	for _, alert := range alerts {
+		if alert.IsAcked() {
+			continue
+		}
+		policy, ok := e.policyFor(alert)
+		if !ok {
+			continue
+		}
+		e.escalate(ctx, policy, alert, now)
+	}
+}
+
+func (e *EscalationEngine) policyFor(alert *entity.Alert) (EscalationPolicy, bool) {
+	for _, p := range e.policies {
+		if p.Matches(alert) {
+			return p, true
+		}
+	}
+	return EscalationPolicy{}, false
+}
+
+// escalate notifies the notifiers of every due step that have not been
+// notified yet.
+func (e *EscalationEngine) escalate(ctx context.Context, policy EscalationPolicy, alert *entity.Alert, now time.Time) {
+	waited := now.Sub(alert.FiredAt)
+
+	var pending []string
+	for _, step := range policy.Steps {
+		if waited < step.After {
+			break
+		}
+		for _, name := range step.Notifiers {
+			if !alert.HasExternalReference(name) {
+				pending = append(pending, name)
+			}
+		}
+	}
+	if len(pending) == 0 {
+		return
+	}
+
+	// Silenced alerts are not notified on ingestion, so they are not
+	// escalated either
+	silences, err := e.silenceRepo.FindMatchingAlert(ctx, alert)
+	if err != nil {
+		e.logger.Warn("failed to check silences for escalation",
+			"alertID", alert.ID,
+			"error", err,
+		)
+		return
+	}
+	if len(silences) > 0 {
+		return
+	}
+
+	for _, name := range pending {
+		e.notify(ctx, policy, alert, name, waited)
+	}
+}
+
+// notify sends one escalation and stores the notifier's reference.
+func (e *EscalationEngine) notify(ctx context.Context, policy EscalationPolicy, alert *entity.Alert, name string, waited time.Duration) {
+	notifier, ok := e.notifiers[name]
+	if !ok {
+		e.logger.Error("escalation notifier is not configured",
+			"policy", policy.Name,
+			"notifier", name,
+		)
+		return
+	}
+
+	result, err := notifier.Notify(withEscalation(ctx), alert)
+	// A queued escalation is sent again on the next check until the
+	// notifier delivers it and stores its reference
+	if errors.Is(err, ErrNotificationSkipped) || errors.Is(err, ErrNotificationQueued) {
+		return
+	}
+	if err != nil {
+		e.logger.Error("escalation failed",
+			"policy", policy.Name,
+			"notifier", name,
+			"alertID", alert.ID,
+			"error", err,
+		)
+		e.record(ctx, policy.Name, name, escalationOutcomeFailed)
+		e.timeline.Record(ctx, alert.ID, entity.AlertEventNotificationFailed, "",
+			fmt.Sprintf("%s escalation (policy %s): %v", name, policy.Name, err))
+		return
+	}
+
+	if err := e.storeReference(ctx, alert, name, result.ReferenceID); err != nil {
+		e.logger.Error("failed to store escalation message ID",
+			"notifier", name,
+			"alertID", alert.ID,
+			"error", err,
+		)
+	}
+
+	e.logger.Warn("alert escalated",
+		"policy", policy.Name,
+		"notifier", name,
+		"alertID", alert.ID,
+		"unackedFor", waited.Round(time.Second),
+	)
+	e.record(ctx, policy.Name, name, escalationOutcomeSent)
+	e.timeline.Record(ctx, alert.ID, entity.AlertEventNotified, "",
+		fmt.Sprintf("%s escalation (policy %s)", name, policy.Name))
+
+	if slackTS := alert.GetExternalReference("slack"); slackTS != "" && e.threadNotifier != nil {
+		text := fmt.Sprintf(":rotating_light: Escalated to *%s* after %s unacknowledged (policy %s)",
+			name, waited.Round(time.Minute), policy.Name)
+		if err := e.threadNotifier.PostThreadReply(ctx, slackTS, text); err != nil {
+			e.logger.Warn("failed to post escalation to Slack thread",
+				"alertID", alert.ID,
+				"error", err,
+			)
+		}
+	}
+}
+
+// storeReference saves the reference on a fresh copy of the alert, so
+// changes made while the notifier was called are kept.
+func (e *EscalationEngine) storeReference(ctx context.Context, alert *entity.Alert, name, messageID string) error {
+	alert.SetExternalReference(name, messageID)
+
+	current, err := e.alertRepo.FindByID(ctx, alert.ID)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return entity.ErrAlertNotFound
+	}
+	current.SetExternalReference(name, messageID)
+	return e.alertRepo.Update(ctx, current)
+}
+
+func (e *EscalationEngine) record(ctx context.Context, policy, notifier, outcome string) {
+	if e.metrics != nil {
+		e.metrics.RecordEscalation(ctx, policy, notifier, outcome)
+	}
+}
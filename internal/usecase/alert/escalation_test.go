@@ -0,0 +1,173 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/memory"
+)
+
+// escalationNotifier records the alerts it is sent as escalations, and fails
+// with err while it is set.
+type escalationNotifier struct {
+	name     string
+	err      error
+	notified []string
+}
+
+func (n *escalationNotifier) Notify(ctx context.Context, alert *entity.Alert) (entity.NotifyResult, error) {
+	if !isEscalation(ctx) {
+		return entity.NotifyResult{}, errors.New("not an escalation")
+	}
+	n.notified = append(n.notified, alert.Fingerprint)
+	if n.err != nil {
+		return entity.NotifyResult{}, n.err
+	}
+	return entity.NotifyResult{ReferenceID: n.name + "-" + alert.Fingerprint}, nil
+}
+
+func (n *escalationNotifier) UpdateMessage(context.Context, string, *entity.Alert) error { return nil }
+
+func (n *escalationNotifier) Name() string { return n.name }
+
+func TestEscalationEngine_Evaluate(t *testing.T) {
+	ctx := context.Background()
+	firedAt := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	policy := EscalationPolicy{
+		Name:     "critical",
+		Severity: entity.SeverityCritical,
+		Steps: []EscalationStep{
+			{After: 5 * time.Minute, Notifiers: []string{"pagerduty"}},
+			{After: 15 * time.Minute, Notifiers: []string{"phone"}},
+		},
+	}
+
+	type fixture struct {
+		engine    *EscalationEngine
+		alerts    *memory.AlertRepository
+		silences  *memory.SilenceRepository
+		pagerduty *escalationNotifier
+		phone     *escalationNotifier
+	}
+	setup := func(t *testing.T) fixture {
+		t.Helper()
+		f := fixture{
+			alerts:    memory.NewAlertRepository(),
+			silences:  memory.NewSilenceRepository(),
+			pagerduty: &escalationNotifier{name: "pagerduty"},
+			phone:     &escalationNotifier{name: "phone"},
+		}
+		f.engine = NewEscalationEngine(f.alerts, f.silences, []Notifier{f.pagerduty, f.phone},
+			[]EscalationPolicy{policy}, time.Minute, nopLogger{}, nil)
+		return f
+	}
+	fire := func(t *testing.T, f fixture, fingerprint string, severity entity.AlertSeverity) *entity.Alert {
+		t.Helper()
+		alert := entity.NewAlert(fingerprint, "HighCPU", "web-1", "", "CPU above 90%", severity)
+		alert.FiredAt = firedAt
+		require.NoError(t, f.alerts.Save(ctx, alert))
+		return alert
+	}
+	evaluateAt := func(f fixture, after time.Duration) {
+		f.engine.now = func() time.Time { return firedAt.Add(after) }
+		f.engine.Evaluate(ctx)
+	}
+
+	t.Run("steps are notified once due", func(t *testing.T) {
+		f := setup(t)
+		alert := fire(t, f, "fp-1", entity.SeverityCritical)
+		fire(t, f, "fp-warning", entity.SeverityWarning)
+
+		evaluateAt(f, 4*time.Minute)
+		assert.Empty(t, f.pagerduty.notified, "first step before it is due")
+
+		evaluateAt(f, 5*time.Minute)
+		assert.Equal(t, []string{"fp-1"}, f.pagerduty.notified)
+		assert.Empty(t, f.phone.notified, "second step before it is due")
+
+		// The reference is stored, so the step is not notified again
+		stored, err := f.alerts.FindByID(ctx, alert.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "pagerduty-fp-1", stored.GetExternalReference("pagerduty"))
+
+		evaluateAt(f, 10*time.Minute)
+		assert.Equal(t, []string{"fp-1"}, f.pagerduty.notified)
+
+		evaluateAt(f, 15*time.Minute)
+		assert.Equal(t, []string{"fp-1"}, f.pagerduty.notified)
+		assert.Equal(t, []string{"fp-1"}, f.phone.notified)
+	})
+
+	t.Run("late evaluation notifies every due step", func(t *testing.T) {
+		f := setup(t)
+		fire(t, f, "fp-1", entity.SeverityCritical)
+
+		evaluateAt(f, time.Hour)
+		assert.Equal(t, []string{"fp-1"}, f.pagerduty.notified)
+		assert.Equal(t, []string{"fp-1"}, f.phone.notified)
+	})
+
+	t.Run("acknowledged alerts are not escalated", func(t *testing.T) {
+		f := setup(t)
+		alert := fire(t, f, "fp-1", entity.SeverityCritical)
+		require.NoError(t, alert.Acknowledge("alice", firedAt.Add(2*time.Minute)))
+		require.NoError(t, f.alerts.Update(ctx, alert))
+
+		evaluateAt(f, time.Hour)
+		assert.Empty(t, f.pagerduty.notified)
+		assert.Empty(t, f.phone.notified)
+	})
+
+	t.Run("silenced alerts are not escalated", func(t *testing.T) {
+		f := setup(t)
+		fire(t, f, "fp-1", entity.SeverityCritical)
+		silence, err := entity.NewSilenceMark(2*time.Hour, "alice", "", entity.AckSourceSlack)
+		require.NoError(t, err)
+		require.NoError(t, f.silences.Save(ctx, silence.ForFingerprint("fp-1")))
+
+		evaluateAt(f, time.Hour)
+		assert.Empty(t, f.pagerduty.notified)
+		assert.Empty(t, f.phone.notified)
+	})
+
+	t.Run("failed notifications are tried again", func(t *testing.T) {
+		f := setup(t)
+		alert := fire(t, f, "fp-1", entity.SeverityCritical)
+		f.pagerduty.err = errors.New("pagerduty unavailable")
+
+		evaluateAt(f, 5*time.Minute)
+		stored, err := f.alerts.FindByID(ctx, alert.ID)
+		require.NoError(t, err)
+		assert.False(t, stored.HasExternalReference("pagerduty"), "reference stored for a failed notification")
+
+		f.pagerduty.err = nil
+		evaluateAt(f, 6*time.Minute)
+		evaluateAt(f, 7*time.Minute)
+		assert.Equal(t, []string{"fp-1", "fp-1"}, f.pagerduty.notified)
+
+		stored, err = f.alerts.FindByID(ctx, alert.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "pagerduty-fp-1", stored.GetExternalReference("pagerduty"))
+	})
+}
+
+func TestEscalationOnlyNotifier(t *testing.T) {
+	ctx := context.Background()
+	next := &escalationNotifier{name: "pagerduty"}
+	n := NewEscalationOnlyNotifier(next)
+	alert := entity.NewAlert("fp-1", "HighCPU", "web-1", "", "CPU above 90%", entity.SeverityCritical)
+
+	_, err := n.Notify(ctx, alert)
+	assert.ErrorIs(t, err, ErrNotificationSkipped)
+
+	result, err := n.Notify(withEscalation(ctx), alert)
+	require.NoError(t, err)
+	assert.Equal(t, "pagerduty-fp-1", result.ReferenceID)
+	assert.Equal(t, []string{"fp-1"}, next.notified)
+}
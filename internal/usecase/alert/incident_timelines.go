@@ -0,0 +1,175 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+)
+
+// IncidentTimelines posts an alert's lifecycle events to the timelines of
+// the incidents it is linked to in incident management tools, so that
+// responders working an incident see what happened to its alerts.
+//
+// A nil IncidentTimelines posts nothing.
+type IncidentTimelines struct {
+	alertRepo repository.AlertRepository
+	tools     []incidentToolEvents
+	logger    Logger
+}
+
+// incidentToolEvents is a tool and the event types posted to it.
+type incidentToolEvents struct {
+	tool   IncidentTool
+	events map[entity.AlertEventType]bool
+}
+
+// NewIncidentTimelines creates an IncidentTimelines without tools.
+func NewIncidentTimelines(alertRepo repository.AlertRepository, logger Logger) *IncidentTimelines {
+	return &IncidentTimelines{alertRepo: alertRepo, logger: logger}
+}
+
+// AddTool posts the given event types to the timelines of tool's incidents.
+func (t *IncidentTimelines) AddTool(tool IncidentTool, events []entity.AlertEventType) {
+	enabled := make(map[entity.AlertEventType]bool, len(events))
+	for _, eventType := range events {
+		enabled[eventType] = true
+	}
+	t.tools = append(t.tools, incidentToolEvents{tool: tool, events: enabled})
+}
+
+// Tool returns the named tool, or nil if it is not configured.
+func (t *IncidentTimelines) Tool(name string) IncidentTool {
+	if t == nil {
+		return nil
+	}
+	for _, te := range t.tools {
+		if te.tool.Name() == name {
+			return te.tool
+		}
+	}
+	return nil
+}
+
+// incidentOriginKey is the context key of the tool a change came from.
+type incidentOriginKey struct{}
+
+// WithIncidentOrigin marks ctx as carrying out a change made in the named
+// tool, such as an acknowledgment from its webhook. Events recorded under
+// ctx are not posted back to that tool, whose timeline already shows them.
+func WithIncidentOrigin(ctx context.Context, tool string) context.Context {
+	return context.WithValue(ctx, incidentOriginKey{}, tool)
+}
+
+// Post posts the event to each tool whose incident the alert is linked to
+// and that takes the event's type. Failures are logged.
+func (t *IncidentTimelines) Post(ctx context.Context, event *entity.AlertEvent) {
+	if t == nil {
+		return
+	}
+	origin, _ := ctx.Value(incidentOriginKey{}).(string)
+	var targets []IncidentTool
+	for _, te := range t.tools {
+		if te.events[event.Type] && te.tool.Name() != origin {
+			targets = append(targets, te.tool)
+		}
+	}
+	if len(targets) == 0 {
+		return
+	}
+
+	alert, err := t.alertRepo.FindByID(ctx, event.AlertID)
+	if err != nil || alert == nil {
+		t.logger.Warn("failed to load alert for incident timeline",
+			"alertID", event.AlertID,
+			"type", event.Type,
+			"error", err,
+		)
+		return
+	}
+	text := incidentTimelineText(alert, event)
+	for _, tool := range targets {
+		incidentID := alert.GetExternalReference(tool.Name())
+		if incidentID == "" {
+			continue
+		}
+		if err := tool.PostTimelineEvent(ctx, incidentID, text, event.CreatedAt); err != nil {
+			t.logger.Error("failed to post to incident timeline",
+				"tool", tool.Name(),
+				"incidentID", incidentID,
+				"alertID", alert.ID,
+				"type", event.Type,
+				"error", err,
+			)
+		}
+	}
+}
+
+// PostLinked announces on the incident's timeline that the alert was
+// linked to it, with the alert's current state. Failures are logged.
+func (t *IncidentTimelines) PostLinked(ctx context.Context, alert *entity.Alert, tool IncidentTool) {
+	incidentID := alert.GetExternalReference(tool.Name())
+	text := fmt.Sprintf("%s: linked alert (%s, %s), fired %s",
+		alertTitle(alert), alert.Severity, alert.State, alert.FiredAt.UTC().Format("2006-01-02 15:04 MST"))
+	if alert.Summary != "" {
+		text += "\n" + alert.Summary
+	}
+	if err := tool.PostTimelineEvent(ctx, incidentID, text, alert.UpdatedAt); err != nil {
+		t.logger.Error("failed to post to incident timeline",
+			"tool", tool.Name(),
+			"incidentID", incidentID,
+			"alertID", alert.ID,
+			"error", err,
+		)
+	}
+}
+
+// incidentTimelineText renders an event as plain text, e.g.
+// "HighCPU on web-1: resolved after 42m by alice".
+func incidentTimelineText(alert *entity.Alert, event *entity.AlertEvent) string {
+	by := ""
+	if event.By != "" {
+		by = " by " + event.By
+	}
+
+	var text string
+	switch event.Type {
+	case entity.AlertEventAcknowledged:
+		text = "acknowledged" + by
+		if event.Detail != "" {
+			text += " via " + event.Detail
+		}
+	case entity.AlertEventResolved:
+		text = "resolved"
+		if alert.ResolvedAt != nil {
+			text += " after " + formatLifetime(alert.ResolvedAt.Sub(alert.FiredAt))
+		}
+		text += by
+		if event.Detail != "" {
+			text += " (" + event.Detail + ")"
+		}
+	case entity.AlertEventSilenced, entity.AlertEventAssigned:
+		text = fmt.Sprintf("%s %s%s", event.Type, event.Detail, by)
+	case entity.AlertEventEscalated:
+		text = "escalated " + event.Detail
+	case entity.AlertEventSeverityChanged:
+		text = "severity changed: " + event.Detail
+	case entity.AlertEventNoteAdded:
+		text = fmt.Sprintf("note from %s\n%s", event.By, event.Detail)
+	default:
+		text = string(event.Type) + by
+		if event.Detail != "" {
+			text += " (" + event.Detail + ")"
+		}
+	}
+	return alertTitle(alert) + ": " + text
+}
+
+// alertTitle names an alert and its instance, e.g. "HighCPU on web-1".
+func alertTitle(alert *entity.Alert) string {
+	if alert.Instance == "" {
+		return alert.Name
+	}
+	return alert.Name + " on " + alert.Instance
+}
@@ -0,0 +1,78 @@
+package alert
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/memory"
+)
+
+// fakeIncidentTool records timeline posts per incident.
+type fakeIncidentTool struct {
+	name  string
+	posts map[string][]string
+}
+
+func (f *fakeIncidentTool) Name() string { return f.name }
+
+func (f *fakeIncidentTool) PostTimelineEvent(_ context.Context, incidentID, text string, _ time.Time) error {
+	f.posts[incidentID] = append(f.posts[incidentID], text)
+	return nil
+}
+
+func TestIncidentTimelines_Post(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	firehydrant := &fakeIncidentTool{name: "firehydrant", posts: make(map[string][]string)}
+	incidentio := &fakeIncidentTool{name: "incidentio", posts: make(map[string][]string)}
+	incidents := NewIncidentTimelines(alertRepo, nopLogger{})
+	incidents.AddTool(firehydrant, []entity.AlertEventType{entity.AlertEventAcknowledged, entity.AlertEventResolved})
+	incidents.AddTool(incidentio, []entity.AlertEventType{entity.AlertEventAcknowledged})
+	timeline := NewTimeline(memory.NewAlertEventRepository(), nopLogger{})
+	timeline.SetIncidentTimelines(incidents)
+
+	linked := entity.NewAlert("fp1", "HighCPU", "host-1", "", "summary", entity.SeverityCritical)
+	linked.SetExternalReference("firehydrant", "inc-1")
+	linked.Resolve(linked.FiredAt.Add(42 * time.Minute))
+	require.NoError(t, alertRepo.Save(ctx, linked))
+
+	timeline.Record(ctx, linked.ID, entity.AlertEventAcknowledged, "alice", "slack")
+	timeline.Record(ctx, linked.ID, entity.AlertEventResolved, "alice", "via API")
+	timeline.Record(ctx, linked.ID, entity.AlertEventNoteAdded, "alice", "not posted")
+	assert.Equal(t, []string{
+		"HighCPU on host-1: acknowledged by alice via slack",
+		"HighCPU on host-1: resolved after 42m by alice (via API)",
+	}, firehydrant.posts["inc-1"])
+	// Not linked to an incident.io incident
+	assert.Empty(t, incidentio.posts)
+
+	// Changes made in the tool are not echoed back to it
+	firehydrant.posts = make(map[string][]string)
+	timeline.Record(WithIncidentOrigin(ctx, "firehydrant"), linked.ID, entity.AlertEventAcknowledged, "bob", "firehydrant")
+	assert.Empty(t, firehydrant.posts)
+
+	assert.Equal(t, incidentio, incidents.Tool("incidentio"))
+	assert.Nil(t, incidents.Tool("jira"))
+}
+
+func TestIncidentTimelineText(t *testing.T) {
+	alert := entity.NewAlert("fp1", "HighCPU", "host-1", "", "summary", entity.SeverityCritical)
+
+	tests := []struct {
+		event *entity.AlertEvent
+		want  string
+	}{
+		{entity.NewAlertEvent(alert.ID, entity.AlertEventUnacknowledged, "", "acknowledgment timed out"), "HighCPU on host-1: unacknowledged (acknowledgment timed out)"},
+		{entity.NewAlertEvent(alert.ID, entity.AlertEventAssigned, "alice", "to bob"), "HighCPU on host-1: assigned to bob by alice"},
+		{entity.NewAlertEvent(alert.ID, entity.AlertEventSeverityChanged, "", "warning → critical"), "HighCPU on host-1: severity changed: warning → critical"},
+		{entity.NewAlertEvent(alert.ID, entity.AlertEventNoteAdded, "carol", "rolling back"), "HighCPU on host-1: note from carol\nrolling back"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, incidentTimelineText(alert, tt.event))
+	}
+}
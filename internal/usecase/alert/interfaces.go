@@ -2,6 +2,8 @@ package alert
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
 	"github.com/altuslabsxyz/alert-bridge/internal/domain/logger"
@@ -11,8 +13,9 @@ import (
 // OCP: New notification channels implement this interface.
 type Notifier interface {
 	// Notify sends an alert to the notification channel.
-	// Returns a channel-specific message ID for tracking.
-	Notify(ctx context.Context, alert *entity.Alert) (messageID string, err error)
+	// The result's reference ID is the channel-specific message ID for
+	// tracking. On failure, the result tells whether to try again.
+	Notify(ctx context.Context, alert *entity.Alert) (entity.NotifyResult, error)
 
 	// UpdateMessage updates an existing notification (e.g., after ack or resolve).
 	UpdateMessage(ctx context.Context, messageID string, alert *entity.Alert) error
@@ -21,6 +24,48 @@ type Notifier interface {
 	Name() string
 }
 
+// ErrNotificationSkipped is returned by Notify when a notifier deliberately
+// does not handle an alert (e.g. a canary that did not sample it).
+// The alert is neither counted as notified nor as failed.
+var ErrNotificationSkipped = errors.New("notification skipped")
+
+// ErrNotificationQueued is returned by Notify when a notifier holds the
+// alert to send it later, such as while the downstream API throttles.
+// The notifier stores the message ID once it is sent, so callers treat
+// the alert as neither notified nor failed.
+var ErrNotificationQueued = errors.New("notification queued")
+
+// CapabilityReporter is implemented by notifiers that describe what they
+// support beyond posting alerts. Decorators report the wrapped notifier's
+// capabilities.
+type CapabilityReporter interface {
+	Capabilities() entity.NotifierCapabilities
+}
+
+// CapabilitiesOf returns the notifier's capabilities, or none if it does
+// not report them.
+func CapabilitiesOf(notifier Notifier) entity.NotifierCapabilities {
+	if reporter, ok := notifier.(CapabilityReporter); ok {
+		return reporter.Capabilities()
+	}
+	return entity.NotifierCapabilities{}
+}
+
+// NotificationAdmitter is implemented by notifiers that may turn away new
+// alerts, such as a LimitedNotifier over its limit.
+type NotificationAdmitter interface {
+	// Admit returns ErrNotificationSkipped if the alert may not be posted.
+	Admit(ctx context.Context, alert *entity.Alert) error
+}
+
+// FiringCache knows the fingerprints of alerts that are firing, kept in sync
+// with writes to the alert repository.
+type FiringCache interface {
+	// Firing returns the ID and severity of the firing alert with the
+	// fingerprint, if known.
+	Firing(fingerprint string) (alertID string, severity entity.AlertSeverity, ok bool)
+}
+
 // SlackSubscriberNotifier extends Notifier with subscriber mention support.
 // This interface is implemented by the Slack client to support @mentioning
 // matching subscribers when sending alerts.
@@ -29,7 +74,13 @@ type SlackSubscriberNotifier interface {
 
 	// NotifyWithMentions sends an alert to Slack with @mentions for the given user IDs.
 	// All matching subscribers are mentioned at once in the message.
-	NotifyWithMentions(ctx context.Context, alert *entity.Alert, slackUserIDs []string) (messageID string, err error)
+	NotifyWithMentions(ctx context.Context, alert *entity.Alert, slackUserIDs []string) (entity.NotifyResult, error)
+}
+
+// ThreadNotifier posts follow-up replies under an existing notification message.
+// Implemented by the Slack client.
+type ThreadNotifier interface {
+	PostThreadReply(ctx context.Context, messageID, text string) error
 }
 
 // PagerDutySubscriberNotification represents a notification to be sent to a specific subscriber.
@@ -69,6 +120,43 @@ type SubscriberMatcher interface {
 	MatchAlertForPagerDuty(alert *entity.Alert) []MatchedSubscriber
 }
 
+// CustomFieldExtractor extracts typed custom fields from an alert's labels
+// and annotations. Returns the values and any validation problems.
+type CustomFieldExtractor interface {
+	Extract(alert *entity.Alert) (map[string]string, []string)
+}
+
+// LabelEnricher derives extra labels for a new alert from external sources
+// (e.g. cloud instance metadata).
+type LabelEnricher interface {
+	Enrich(ctx context.Context, alert *entity.Alert) (map[string]string, error)
+}
+
+// AlertEnricher derives extra labels and annotations for a new alert from
+// external services (e.g. service ownership from a CMDB). It may return
+// what it found along with an error.
+type AlertEnricher interface {
+	Enrich(ctx context.Context, alert *entity.Alert) (labels, annotations map[string]string, err error)
+}
+
+// IncidentTool posts to the timelines of incidents in an incident
+// management tool, such as FireHydrant or incident.io.
+type IncidentTool interface {
+	// Name identifies the tool. It is also the key of the alert's external
+	// reference holding the ID of the incident the alert is linked to.
+	Name() string
+
+	// PostTimelineEvent adds text, which happened at at, to the incident's
+	// timeline.
+	PostTimelineEvent(ctx context.Context, incidentID, text string, at time.Time) error
+}
+
+// NotificationRetrier queues failed notifier calls for later delivery.
+type NotificationRetrier interface {
+	// Enqueue queues the call if err is retryable and reports whether it did.
+	Enqueue(ctx context.Context, alert *entity.Alert, notifier string, action entity.NotificationAction, err error) bool
+}
+
 // MatchedSubscriber represents a subscriber that matched an alert.
 type MatchedSubscriber struct {
 	Name                string
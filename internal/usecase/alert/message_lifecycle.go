@@ -0,0 +1,231 @@
+package alert
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	domainerrors "github.com/altuslabsxyz/alert-bridge/internal/domain/errors"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+)
+
+// MessageLifecycleAction is what happens to a resolved alert's message.
+type MessageLifecycleAction string
+
+const (
+	// LifecycleDelete deletes the message.
+	LifecycleDelete MessageLifecycleAction = "delete"
+
+	// LifecycleCollapse replaces the message with a one-line record.
+	LifecycleCollapse MessageLifecycleAction = "collapse"
+)
+
+// lifecycleReference is the external reference recording how far the
+// lifecycle policy got with an alert's Slack message.
+const lifecycleReference = "slack_lifecycle"
+
+// lifecycleRecorded marks a record posted to the history channel before
+// the action was applied.
+const lifecycleRecorded = "recorded"
+
+// lifecycleLookback is how long after its After an alert is still picked up,
+// such as after downtime.
+const lifecycleLookback = 24 * time.Hour
+
+// historyReference records that the alert was mirrored to the history
+// channel.
+const historyReference = "slack_history"
+
+// historyLookback is how long after resolution an alert is still mirrored,
+// so that enabling mirroring does not replay older alerts.
+const historyLookback = time.Hour
+
+// MessageLifecyclePolicy cleans up the Slack messages of a channel once
+// their alert has been resolved for After.
+type MessageLifecyclePolicy struct {
+	Action MessageLifecycleAction
+	After  time.Duration
+
+	// HistoryChannelID optionally receives a one-line record of the alert
+	// before the action is applied.
+	HistoryChannelID string
+}
+
+// MessageJanitor cleans up alert messages.
+// Implemented by the Slack client.
+type MessageJanitor interface {
+	DeleteMessage(ctx context.Context, messageID string) error
+	CollapseMessage(ctx context.Context, messageID string, alert *entity.Alert) error
+	PostAlertRecord(ctx context.Context, channelID string, alert *entity.Alert) error
+}
+
+// MessageLifecycleManager applies per-channel lifecycle policies to the
+// Slack messages of resolved alerts, keeping busy channels readable, and
+// mirrors a record of every resolved alert to a history channel. The alerts
+// and their history stay in storage.
+type MessageLifecycleManager struct {
+	alertRepo repository.AlertRepository
+	janitor   MessageJanitor
+	policies  map[string]MessageLifecyclePolicy
+	interval  time.Duration
+	logger    Logger
+	now       func() time.Time
+
+	// Mirroring of resolved alerts (optional)
+	historyChannelID string
+}
+
+// NewMessageLifecycleManager creates a manager that sweeps resolved alerts
+// every interval. Policies are keyed by Slack channel ID.
+func NewMessageLifecycleManager(
+	alertRepo repository.AlertRepository,
+	janitor MessageJanitor,
+	policies map[string]MessageLifecyclePolicy,
+	interval time.Duration,
+	logger Logger,
+) *MessageLifecycleManager {
+	return &MessageLifecycleManager{
+		alertRepo: alertRepo,
+		janitor:   janitor,
+		policies:  policies,
+		interval:  interval,
+		logger:    logger,
+		now:       time.Now,
+	}
+}
+
+// SetHistoryChannel mirrors the one-line record of every resolved alert to
+// channelID, within a sweep of its resolution, separately from the live
+// alert channels.
+func (m *MessageLifecycleManager) SetHistoryChannel(channelID string) {
+	m.historyChannelID = channelID
+}
+
+// Run sweeps until ctx is cancelled.
+func (m *MessageLifecycleManager) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.Sweep(ctx)
+		}
+	}
+}
+
+// Sweep mirrors newly resolved alerts and applies the policies to the
+// messages that are due.
+func (m *MessageLifecycleManager) Sweep(ctx context.Context) {
+	var longest time.Duration
+	for _, policy := range m.policies {
+		longest = max(longest, policy.After)
+	}
+
+	now := m.now()
+	alerts, err := m.alertRepo.FindChangedSince(ctx, now.Add(-longest-lifecycleLookback))
+	if err != nil {
+		m.logger.Error("failed to load resolved alerts for message lifecycle", "error", err)
+		return
+	}
+
+	for _, alert := range alerts {
+		if !alert.IsResolved() || alert.ResolvedAt == nil {
+			continue
+		}
+		if m.historyChannelID != "" && !alert.HasExternalReference(historyReference) &&
+			now.Sub(*alert.ResolvedAt) <= historyLookback {
+			m.mirror(ctx, alert)
+		}
+
+		messageID := alert.GetExternalReference("slack")
+		if messageID == "" {
+			continue
+		}
+		state := alert.GetExternalReference(lifecycleReference)
+		if state != "" && state != lifecycleRecorded {
+			continue
+		}
+		channelID, _, _ := strings.Cut(messageID, ":")
+		policy, ok := m.policies[channelID]
+		if !ok || now.Sub(*alert.ResolvedAt) < policy.After {
+			continue
+		}
+
+		m.apply(ctx, alert, messageID, state, policy)
+	}
+}
+
+// mirror posts the alert's record to the history channel. Failures are
+// retried on the next sweep.
+func (m *MessageLifecycleManager) mirror(ctx context.Context, alert *entity.Alert) {
+	if err := m.janitor.PostAlertRecord(ctx, m.historyChannelID, alert); err != nil {
+		m.logger.Error("failed to mirror resolved alert to history channel",
+			"alertID", alert.ID,
+			"channelID", m.historyChannelID,
+			"error", err,
+		)
+		return
+	}
+
+	alert.SetExternalReference(historyReference, "posted")
+	if err := m.alertRepo.Update(ctx, alert); err != nil {
+		m.logger.Error("failed to store history mirror state",
+			"alertID", alert.ID,
+			"error", err,
+		)
+	}
+}
+
+// apply runs a policy on an alert's message and records the progress, so
+// that each step happens once. Permanent failures, such as a message
+// deleted by hand, are given up on.
+func (m *MessageLifecycleManager) apply(ctx context.Context, alert *entity.Alert, messageID, state string, policy MessageLifecyclePolicy) {
+	// The history channel mirror already has the record
+	recorded := state == lifecycleRecorded || policy.HistoryChannelID == m.historyChannelID
+	if policy.HistoryChannelID != "" && !recorded {
+		if err := m.janitor.PostAlertRecord(ctx, policy.HistoryChannelID, alert); err != nil {
+			m.logger.Error("failed to post alert record to history channel",
+				"alertID", alert.ID,
+				"channelID", policy.HistoryChannelID,
+				"error", err,
+			)
+			return
+		}
+		m.save(ctx, alert, lifecycleRecorded)
+	}
+
+	var err error
+	switch policy.Action {
+	case LifecycleDelete:
+		err = m.janitor.DeleteMessage(ctx, messageID)
+	case LifecycleCollapse:
+		err = m.janitor.CollapseMessage(ctx, messageID, alert)
+	}
+	if err != nil {
+		m.logger.Error("failed to apply message lifecycle",
+			"alertID", alert.ID,
+			"messageID", messageID,
+			"action", policy.Action,
+			"error", err,
+		)
+		if domainerrors.IsTransientError(err) {
+			return
+		}
+	}
+
+	m.save(ctx, alert, string(policy.Action))
+}
+
+func (m *MessageLifecycleManager) save(ctx context.Context, alert *entity.Alert, state string) {
+	alert.SetExternalReference(lifecycleReference, state)
+	if err := m.alertRepo.Update(ctx, alert); err != nil {
+		m.logger.Error("failed to store message lifecycle state",
+			"alertID", alert.ID,
+			"error", err,
+		)
+	}
+}
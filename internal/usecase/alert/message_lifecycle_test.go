@@ -0,0 +1,137 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	domainerrors "github.com/altuslabsxyz/alert-bridge/internal/domain/errors"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/memory"
+)
+
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...any) {}
+func (nopLogger) Info(string, ...any)  {}
+func (nopLogger) Warn(string, ...any)  {}
+func (nopLogger) Error(string, ...any) {}
+
+type fakeJanitor struct {
+	calls     []string
+	deleteErr error
+}
+
+func (j *fakeJanitor) DeleteMessage(_ context.Context, messageID string) error {
+	j.calls = append(j.calls, "delete "+messageID)
+	return j.deleteErr
+}
+
+func (j *fakeJanitor) CollapseMessage(_ context.Context, messageID string, _ *entity.Alert) error {
+	j.calls = append(j.calls, "collapse "+messageID)
+	return nil
+}
+
+func (j *fakeJanitor) PostAlertRecord(_ context.Context, channelID string, _ *entity.Alert) error {
+	j.calls = append(j.calls, "record "+channelID)
+	return nil
+}
+
+func TestMessageLifecycleManager_Sweep(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	newResolved := func(fingerprint, messageID string, resolvedAgo time.Duration) *entity.Alert {
+		alert := entity.NewAlert(fingerprint, "HighCPU", "host-1", "", "summary", entity.SeverityWarning)
+		alert.SetExternalReference("slack", messageID)
+		alert.Resolve(now.Add(-resolvedAgo))
+		return alert
+	}
+
+	repo := memory.NewAlertRepository()
+	due := newResolved("fp1", "C-BUSY:1", time.Hour)
+	notDue := newResolved("fp2", "C-BUSY:2", time.Minute)
+	otherChannel := newResolved("fp3", "C-QUIET:1", time.Hour)
+	collapsed := newResolved("fp4", "C-TEAM:1", time.Hour)
+	firing := entity.NewAlert("fp5", "HighCPU", "host-1", "", "summary", entity.SeverityWarning)
+	firing.SetExternalReference("slack", "C-BUSY:3")
+	for _, alert := range []*entity.Alert{due, notDue, otherChannel, collapsed, firing} {
+		require.NoError(t, repo.Save(ctx, alert))
+	}
+
+	janitor := &fakeJanitor{}
+	manager := NewMessageLifecycleManager(repo, janitor, map[string]MessageLifecyclePolicy{
+		"C-BUSY": {Action: LifecycleDelete, After: 30 * time.Minute, HistoryChannelID: "C-HISTORY"},
+		"C-TEAM": {Action: LifecycleCollapse, After: 30 * time.Minute},
+	}, time.Minute, nopLogger{})
+	manager.now = func() time.Time { return now }
+
+	manager.Sweep(ctx)
+	assert.ElementsMatch(t, []string{"record C-HISTORY", "delete C-BUSY:1", "collapse C-TEAM:1"}, janitor.calls)
+
+	stored, err := repo.FindByID(ctx, due.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "delete", stored.GetExternalReference(lifecycleReference))
+
+	// Each message is handled once
+	janitor.calls = nil
+	manager.Sweep(ctx)
+	assert.Empty(t, janitor.calls)
+}
+
+func TestMessageLifecycleManager_RetriesTransientFailures(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	repo := memory.NewAlertRepository()
+	alert := entity.NewAlert("fp1", "HighCPU", "host-1", "", "summary", entity.SeverityWarning)
+	alert.SetExternalReference("slack", "C-BUSY:1")
+	alert.Resolve(now.Add(-time.Hour))
+	require.NoError(t, repo.Save(ctx, alert))
+
+	janitor := &fakeJanitor{deleteErr: domainerrors.NewTransientError("rate limited", errors.New("rate_limited"))}
+	manager := NewMessageLifecycleManager(repo, janitor, map[string]MessageLifecyclePolicy{
+		"C-BUSY": {Action: LifecycleDelete, After: time.Minute, HistoryChannelID: "C-HISTORY"},
+	}, time.Minute, nopLogger{})
+	manager.now = func() time.Time { return now }
+
+	manager.Sweep(ctx)
+	janitor.deleteErr = nil
+	manager.Sweep(ctx)
+
+	// The record is not posted twice
+	assert.Equal(t, []string{"record C-HISTORY", "delete C-BUSY:1", "delete C-BUSY:1"}, janitor.calls)
+}
+
+func TestMessageLifecycleManager_MirrorsResolvedAlerts(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	repo := memory.NewAlertRepository()
+	recent := entity.NewAlert("fp1", "HighCPU", "host-1", "", "summary", entity.SeverityWarning)
+	recent.SetExternalReference("slack", "C-BUSY:1")
+	recent.Resolve(now.Add(-time.Minute))
+	old := entity.NewAlert("fp2", "HighCPU", "host-2", "", "summary", entity.SeverityWarning)
+	old.Resolve(now.Add(-2 * time.Hour))
+	firing := entity.NewAlert("fp3", "HighCPU", "host-3", "", "summary", entity.SeverityWarning)
+	for _, alert := range []*entity.Alert{recent, old, firing} {
+		require.NoError(t, repo.Save(ctx, alert))
+	}
+
+	janitor := &fakeJanitor{}
+	manager := NewMessageLifecycleManager(repo, janitor, map[string]MessageLifecyclePolicy{
+		"C-BUSY": {Action: LifecycleDelete, HistoryChannelID: "C-HISTORY"},
+	}, time.Minute, nopLogger{})
+	manager.SetHistoryChannel("C-HISTORY")
+	manager.now = func() time.Time { return now }
+
+	manager.Sweep(ctx)
+	manager.Sweep(ctx)
+
+	// Mirrored once, and the policy does not record it a second time
+	assert.Equal(t, []string{"record C-HISTORY", "delete C-BUSY:1"}, janitor.calls)
+}
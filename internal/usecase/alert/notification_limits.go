@@ -0,0 +1,270 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/observability"
+)
+
+// Reasons a LimitedNotifier suppresses a new alert.
+const (
+	suppressedRateLimit = "rate_limit"
+	suppressedBudget    = "budget"
+)
+
+// summaryTopNames is how many alert names a suppression summary lists.
+const summaryTopNames = 5
+
+// NotificationLimit bounds the new alerts one notifier posts, with a token
+// bucket, a budget per fixed window, or both.
+type NotificationLimit struct {
+	// PerSecond is the sustained rate, and Burst how many alerts a quiet
+	// notifier posts at once. A zero PerSecond disables the bucket.
+	PerSecond float64
+	Burst     int
+
+	// Budget is the most alerts posted per Window. Zero disables it.
+	Budget int
+	Window time.Duration
+}
+
+// NotificationLimiter caps the new alerts posted by the notifiers it wraps,
+// so a misconfigured rule cannot flood a channel. Alerts over a limit are
+// not posted; every interval, each notifier's suppressed alerts are summed
+// up in a single "N more alerts suppressed" message.
+type NotificationLimiter struct {
+	interval  time.Duration
+	poster    MetaAlertPoster
+	channelID string
+	logger    Logger
+	metrics   *observability.Metrics
+	now       func() time.Time
+
+	mu        sync.Mutex
+	notifiers []*LimitedNotifier
+}
+
+// NewNotificationLimiter creates a limiter that sums up suppressed alerts
+// every interval. metrics may be nil.
+func NewNotificationLimiter(interval time.Duration, logger Logger, metrics *observability.Metrics) *NotificationLimiter {
+	return &NotificationLimiter{
+		interval: interval,
+		logger:   logger,
+		metrics:  metrics,
+		now:      time.Now,
+	}
+}
+
+// SetSummaryPoster sets where summaries are posted. Without one, they are
+// only logged.
+func (l *NotificationLimiter) SetSummaryPoster(poster MetaAlertPoster, channelID string) {
+	l.poster = poster
+	l.channelID = channelID
+}
+
+// Wrap returns notifier limited to limit.
+func (l *NotificationLimiter) Wrap(notifier Notifier, limit NotificationLimit) *LimitedNotifier {
+	n := &LimitedNotifier{
+		notifier:   notifier,
+		limit:      limit,
+		limiter:    l,
+		suppressed: make(map[string]int),
+	}
+	l.mu.Lock()
+	l.notifiers = append(l.notifiers, n)
+	l.mu.Unlock()
+	return n
+}
+
+// Run posts summaries every interval until ctx is done.
+func (l *NotificationLimiter) Run(ctx context.Context) {
+	ticker := time.NewTicker(l.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			l.Summarize(ctx)
+		}
+	}
+}
+
+// Summarize posts one summary for each notifier that suppressed alerts
+// since the last summary.
+func (l *NotificationLimiter) Summarize(ctx context.Context) {
+	l.mu.Lock()
+	notifiers := append([]*LimitedNotifier(nil), l.notifiers...)
+	l.mu.Unlock()
+
+	for _, n := range notifiers {
+		count, names := n.drain()
+		if count == 0 {
+			continue
+		}
+
+		l.logger.Warn("notifications suppressed",
+			"notifier", n.Name(),
+			"count", count,
+		)
+		if l.poster == nil || l.channelID == "" {
+			continue
+		}
+		postCtx, cancel := context.WithTimeout(ctx, metaAlertTimeout)
+		err := l.poster.PostText(postCtx, l.channelID, suppressionSummary(n.Name(), count, names))
+		cancel()
+		if err != nil {
+			l.logger.Error("failed to post suppression summary",
+				"notifier", n.Name(),
+				"channel", l.channelID,
+				"error", err,
+			)
+		}
+	}
+}
+
+// suppressionSummary describes count suppressed alerts, naming the most
+// frequent.
+func suppressionSummary(notifier string, count int, names map[string]int) string {
+	noun := "alerts"
+	if count == 1 {
+		noun = "alert"
+	}
+
+	ranked := make([]string, 0, len(names))
+	for name := range names {
+		ranked = append(ranked, name)
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if names[ranked[i]] != names[ranked[j]] {
+			return names[ranked[i]] > names[ranked[j]]
+		}
+		return ranked[i] < ranked[j]
+	})
+
+	top := make([]string, 0, summaryTopNames)
+	for _, name := range ranked[:min(len(ranked), summaryTopNames)] {
+		top = append(top, fmt.Sprintf("%s (%d)", name, names[name]))
+	}
+	text := fmt.Sprintf(":mute: *%d more %s suppressed* by the %s notification limit: %s",
+		count, noun, notifier, strings.Join(top, ", "))
+	switch others := len(ranked) - len(top); {
+	case others == 1:
+		text += " and 1 other"
+	case others > 1:
+		text += fmt.Sprintf(" and %d others", others)
+	}
+	return text
+}
+
+// LimitedNotifier posts new alerts through the wrapped notifier while it is
+// within its limit, and returns ErrNotificationSkipped otherwise. Updates of
+// posted alerts are not limited.
+type LimitedNotifier struct {
+	notifier Notifier
+	limit    NotificationLimit
+	limiter  *NotificationLimiter
+
+	mu          sync.Mutex
+	tokens      float64
+	updated     time.Time
+	windowStart time.Time
+	windowCount int
+	suppressed  map[string]int
+	count       int
+}
+
+// Notify posts the alert if the notifier is within its limit.
+func (n *LimitedNotifier) Notify(ctx context.Context, alert *entity.Alert) (entity.NotifyResult, error) {
+	if err := n.Admit(ctx, alert); err != nil {
+		return entity.NotifyResult{}, err
+	}
+	return n.notifier.Notify(ctx, alert)
+}
+
+// Admit takes the alert's share of the limit, or records it as suppressed
+// and returns ErrNotificationSkipped. Callers that post new alerts other
+// than through Notify, such as with subscriber mentions, ask it first.
+func (n *LimitedNotifier) Admit(ctx context.Context, alert *entity.Alert) error {
+	reason := n.take(alert.Name)
+	if reason == "" {
+		return nil
+	}
+	if n.limiter.metrics != nil {
+		n.limiter.metrics.RecordNotificationSuppressed(ctx, n.Name(), reason)
+	}
+	return ErrNotificationSkipped
+}
+
+// UpdateMessage updates a posted alert.
+func (n *LimitedNotifier) UpdateMessage(ctx context.Context, messageID string, alert *entity.Alert) error {
+	return n.notifier.UpdateMessage(ctx, messageID, alert)
+}
+
+// Name returns the wrapped notifier's name.
+func (n *LimitedNotifier) Name() string {
+	return n.notifier.Name()
+}
+
+// Capabilities returns the wrapped notifier's capabilities.
+func (n *LimitedNotifier) Capabilities() entity.NotifierCapabilities {
+	return CapabilitiesOf(n.notifier)
+}
+
+// take uses up a token and a share of the window's budget, and returns
+// empty. If either is exhausted, nothing is used up and the alert is
+// counted as suppressed, and the reason is returned.
+func (n *LimitedNotifier) take(alertName string) string {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	now := n.limiter.now()
+	limit := n.limit
+
+	reason := ""
+	if limit.Budget > 0 {
+		if n.windowStart.IsZero() || now.Sub(n.windowStart) >= limit.Window {
+			n.windowStart = now
+			n.windowCount = 0
+		}
+		if n.windowCount >= limit.Budget {
+			reason = suppressedBudget
+		}
+	}
+	if limit.PerSecond > 0 {
+		if n.updated.IsZero() {
+			n.tokens = float64(limit.Burst)
+		} else {
+			n.tokens = min(n.tokens+now.Sub(n.updated).Seconds()*limit.PerSecond, float64(limit.Burst))
+		}
+		n.updated = now
+		if reason == "" && n.tokens < 1 {
+			reason = suppressedRateLimit
+		}
+	}
+
+	if reason != "" {
+		n.suppressed[alertName]++
+		n.count++
+		return reason
+	}
+	n.tokens--
+	n.windowCount++
+	return ""
+}
+
+// drain returns and resets the alerts suppressed since the last call.
+func (n *LimitedNotifier) drain() (int, map[string]int) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	count, names := n.count, n.suppressed
+	n.count = 0
+	n.suppressed = make(map[string]int)
+	return count, names
+}
@@ -0,0 +1,83 @@
+package alert
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+type recordingPoster struct {
+	texts []string
+}
+
+func (p *recordingPoster) PostText(_ context.Context, _, text string) error {
+	p.texts = append(p.texts, text)
+	return nil
+}
+
+func TestLimitedNotifier(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+	newLimited := func(limit NotificationLimit) (*NotificationLimiter, *LimitedNotifier) {
+		limiter := NewNotificationLimiter(time.Minute, nopLogger{}, nil)
+		limiter.now = func() time.Time { return now }
+		return limiter, limiter.Wrap(&recordingNotifier{}, limit)
+	}
+	notify := func(n *LimitedNotifier, name string) error {
+		_, err := n.Notify(ctx, entity.NewAlert("fp-"+name, name, "", "", "", entity.SeverityWarning))
+		return err
+	}
+
+	t.Run("token bucket", func(t *testing.T) {
+		_, n := newLimited(NotificationLimit{PerSecond: 1, Burst: 2})
+		require.NoError(t, notify(n, "HighCPU"))
+		require.NoError(t, notify(n, "HighCPU"))
+		assert.ErrorIs(t, notify(n, "HighCPU"), ErrNotificationSkipped)
+
+		now = now.Add(time.Second)
+		require.NoError(t, notify(n, "HighCPU"))
+		assert.ErrorIs(t, notify(n, "HighCPU"), ErrNotificationSkipped)
+	})
+
+	t.Run("budget per window", func(t *testing.T) {
+		_, n := newLimited(NotificationLimit{Budget: 2, Window: time.Minute})
+		require.NoError(t, notify(n, "HighCPU"))
+		now = now.Add(30 * time.Second)
+		require.NoError(t, notify(n, "HighCPU"))
+		assert.ErrorIs(t, notify(n, "HighCPU"), ErrNotificationSkipped)
+
+		now = now.Add(30 * time.Second)
+		require.NoError(t, notify(n, "HighCPU"))
+	})
+
+	t.Run("updates are not limited", func(t *testing.T) {
+		_, n := newLimited(NotificationLimit{Budget: 1, Window: time.Minute})
+		require.NoError(t, notify(n, "HighCPU"))
+		assert.ErrorIs(t, notify(n, "HighCPU"), ErrNotificationSkipped)
+		assert.NoError(t, n.UpdateMessage(ctx, "C1:1", entity.NewAlert("fp", "HighCPU", "", "", "", entity.SeverityWarning)))
+	})
+
+	t.Run("summary", func(t *testing.T) {
+		limiter, n := newLimited(NotificationLimit{Budget: 1, Window: time.Hour})
+		poster := &recordingPoster{}
+		limiter.SetSummaryPoster(poster, "C-ops")
+
+		require.NoError(t, notify(n, "HighCPU"))
+		for _, name := range []string{"HighCPU", "HighCPU", "DiskFull", "A", "B", "C", "D"} {
+			assert.ErrorIs(t, notify(n, name), ErrNotificationSkipped)
+		}
+
+		limiter.Summarize(ctx)
+		require.Len(t, poster.texts, 1)
+		assert.Equal(t, ":mute: *7 more alerts suppressed* by the slack notification limit: HighCPU (2), A (1), B (1), C (1), D (1) and 1 other", poster.texts[0])
+
+		// Nothing suppressed since
+		limiter.Summarize(ctx)
+		assert.Len(t, poster.texts, 1)
+	})
+}
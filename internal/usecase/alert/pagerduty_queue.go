@@ -0,0 +1,450 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	domainerrors "github.com/altuslabsxyz/alert-bridge/internal/domain/errors"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/resilience"
+)
+
+// pagerDutyQueueInterval is how often the send queue checks for events it
+// may send.
+const pagerDutyQueueInterval = time.Second
+
+// Acknowledger acknowledges alerts in a downstream system. It has the
+// method set of ack.AckSyncer, so a wrapper can stand in for a syncer.
+type Acknowledger interface {
+	Acknowledge(ctx context.Context, alert *entity.Alert, ackEvent *entity.AckEvent) error
+	SupportsAck() bool
+	Name() string
+}
+
+// PagerDutyQueuePolicy configures a PagerDutySendQueue.
+type PagerDutyQueuePolicy struct {
+	// Backoff is how long sends pause after PagerDuty throttled one.
+	Backoff time.Duration
+
+	// MaxAge is how long triggers and acks of alerts below ShedBelow may
+	// wait before they are shed.
+	MaxAge time.Duration
+
+	// ShedBelow is the lowest severity that is never shed. Empty never
+	// sheds.
+	ShedBelow entity.AlertSeverity
+}
+
+// pagerDutySendKind is the kind of Events API call a queued send makes.
+type pagerDutySendKind string
+
+const (
+	pagerDutyTrigger pagerDutySendKind = "trigger"
+	pagerDutyUpdate  pagerDutySendKind = "update"
+	pagerDutyAck     pagerDutySendKind = "ack"
+)
+
+// pagerDutySend is an event held back while PagerDuty throttles.
+type pagerDutySend struct {
+	kind      pagerDutySendKind
+	alertID   string
+	severity  entity.AlertSeverity
+	messageID string
+	ackEvent  *entity.AckEvent
+	queuedAt  time.Time
+}
+
+func (s *pagerDutySend) key() string {
+	return s.alertID + "/" + string(s.kind)
+}
+
+// PagerDutySendQueue wraps the PagerDuty notifier and ack syncer so that
+// events the Events API throttles (HTTP 429) are held in memory instead of
+// failing. While throttled, and until the queue is drained, every event is
+// queued, so new pages cannot overtake ones already waiting.
+//
+// The queue sends triggers before updates and acks, more severe alerts
+// first, then oldest first. Triggers and acks of low-severity alerts that
+// waited past the policy's MaxAge are shed and recorded on the alert's
+// timeline; updates are never shed, as a lost resolve would leave the
+// incident open.
+//
+// Queued events carry only the alert ID: the alert is loaded again when the
+// event is sent, so it goes out with the alert's latest state.
+type PagerDutySendQueue struct {
+	notifier  Notifier
+	acker     Acknowledger
+	alertRepo repository.AlertRepository
+	policy    PagerDutyQueuePolicy
+	logger    Logger
+	now       func() time.Time
+
+	// Timeline of shed and delivered events (optional)
+	timeline *Timeline
+
+	// Persistent queue taking over held events on shutdown (optional)
+	retrier NotificationRetrier
+
+	mu             sync.Mutex
+	pending        map[string]*pagerDutySend
+	throttledUntil time.Time
+}
+
+// NewPagerDutySendQueue creates a send queue in front of notifier and
+// acker, which are the PagerDuty client or wrappers of it.
+func NewPagerDutySendQueue(
+	notifier Notifier,
+	acker Acknowledger,
+	alertRepo repository.AlertRepository,
+	policy PagerDutyQueuePolicy,
+	logger Logger,
+) *PagerDutySendQueue {
+	return &PagerDutySendQueue{
+		notifier:  notifier,
+		acker:     acker,
+		alertRepo: alertRepo,
+		policy:    policy,
+		logger:    logger,
+		now:       time.Now,
+		pending:   make(map[string]*pagerDutySend),
+	}
+}
+
+// SetTimeline records shed and delivered events on the alert's timeline.
+func (q *PagerDutySendQueue) SetTimeline(timeline *Timeline) {
+	q.timeline = timeline
+}
+
+// SetNotificationRetrier hands events still held on shutdown to retrier,
+// which keeps them across restarts.
+func (q *PagerDutySendQueue) SetNotificationRetrier(retrier NotificationRetrier) {
+	q.retrier = retrier
+}
+
+// Name returns the wrapped notifier's name.
+func (q *PagerDutySendQueue) Name() string {
+	return q.notifier.Name()
+}
+
+// Capabilities returns the wrapped notifier's capabilities.
+func (q *PagerDutySendQueue) Capabilities() entity.NotifierCapabilities {
+	return CapabilitiesOf(q.notifier)
+}
+
+// Notify triggers an incident, or queues the trigger and returns
+// ErrNotificationQueued while PagerDuty throttles.
+func (q *PagerDutySendQueue) Notify(ctx context.Context, alert *entity.Alert) (entity.NotifyResult, error) {
+	send := &pagerDutySend{kind: pagerDutyTrigger, alertID: alert.ID, severity: alert.Severity}
+	if q.hold(send) {
+		return entity.NotifyResult{}, ErrNotificationQueued
+	}
+
+	result, err := q.notifier.Notify(ctx, alert)
+	if q.throttled(err, send) {
+		return entity.NotifyResult{}, ErrNotificationQueued
+	}
+	return result, err
+}
+
+// UpdateMessage updates the incident. A queued update returns nil; the
+// queue sends it later.
+func (q *PagerDutySendQueue) UpdateMessage(ctx context.Context, messageID string, alert *entity.Alert) error {
+	send := &pagerDutySend{kind: pagerDutyUpdate, alertID: alert.ID, severity: alert.Severity, messageID: messageID}
+	if q.hold(send) {
+		return nil
+	}
+
+	err := q.notifier.UpdateMessage(ctx, messageID, alert)
+	if q.throttled(err, send) {
+		return nil
+	}
+	return err
+}
+
+// Acknowledge acknowledges the incident. A queued ack returns nil; the
+// queue sends it later.
+func (q *PagerDutySendQueue) Acknowledge(ctx context.Context, alert *entity.Alert, ackEvent *entity.AckEvent) error {
+	send := &pagerDutySend{kind: pagerDutyAck, alertID: alert.ID, severity: alert.Severity, ackEvent: ackEvent}
+	if q.hold(send) {
+		return nil
+	}
+
+	err := q.acker.Acknowledge(ctx, alert, ackEvent)
+	if q.throttled(err, send) {
+		return nil
+	}
+	return err
+}
+
+// SupportsAck returns true if the wrapped syncer supports acknowledgment.
+func (q *PagerDutySendQueue) SupportsAck() bool {
+	return q.acker != nil && q.acker.SupportsAck()
+}
+
+// Len returns the number of queued events.
+func (q *PagerDutySendQueue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.pending)
+}
+
+// Run sends queued events until ctx is cancelled.
+func (q *PagerDutySendQueue) Run(ctx context.Context) {
+	ticker := time.NewTicker(pagerDutyQueueInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			q.Drain(ctx)
+		}
+	}
+}
+
+// Drain sheds expired events and sends the others in priority order,
+// until the queue is empty or PagerDuty throttles again.
+func (q *PagerDutySendQueue) Drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		send, shed := q.next()
+		for _, s := range shed {
+			q.recordShed(ctx, s)
+		}
+		if send == nil {
+			return
+		}
+		if !q.deliver(ctx, send) {
+			return
+		}
+	}
+}
+
+// Flush empties the queue into the notification retrier, for shutdown.
+// Triggers are queued as notifications; updates and acks as updates, which
+// send the alert's state at delivery. Events it cannot hand over are lost.
+// Returns the number of events handed over.
+func (q *PagerDutySendQueue) Flush(ctx context.Context) int {
+	q.mu.Lock()
+	sends := make([]*pagerDutySend, 0, len(q.pending))
+	for _, send := range q.pending {
+		sends = append(sends, send)
+	}
+	clear(q.pending)
+	q.mu.Unlock()
+
+	flushed := 0
+	for _, send := range sends {
+		if q.handOver(ctx, send) {
+			flushed++
+			continue
+		}
+		q.logger.Warn("queued pagerduty event lost on shutdown",
+			"alertID", send.alertID,
+			"kind", send.kind,
+		)
+	}
+	return flushed
+}
+
+// handOver queues send in the notification retrier.
+func (q *PagerDutySendQueue) handOver(ctx context.Context, send *pagerDutySend) bool {
+	if q.retrier == nil {
+		return false
+	}
+	alert, err := q.alertRepo.FindByID(ctx, send.alertID)
+	if err != nil || alert == nil {
+		return false
+	}
+	action := entity.NotificationActionUpdate
+	if send.kind == pagerDutyTrigger {
+		action = entity.NotificationActionNotify
+	}
+	cause := domainerrors.NewTransientError("pagerduty throttled at shutdown", resilience.ErrThrottled)
+	return q.retrier.Enqueue(ctx, alert, q.notifier.Name(), action, cause)
+}
+
+// hold queues send if the queue is throttled or not yet drained.
+func (q *PagerDutySendQueue) hold(send *pagerDutySend) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(q.pending) == 0 && !q.now().Before(q.throttledUntil) {
+		return false
+	}
+	q.enqueueLocked(send)
+	return true
+}
+
+// throttled queues send and starts a backoff if err is a throttle.
+func (q *PagerDutySendQueue) throttled(err error, send *pagerDutySend) bool {
+	if !errors.Is(err, resilience.ErrThrottled) {
+		return false
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.throttleLocked(err)
+	q.enqueueLocked(send)
+	return true
+}
+
+// enqueueLocked adds send, replacing a queued event of the same kind for
+// the alert. The replacement keeps its place by age.
+func (q *PagerDutySendQueue) enqueueLocked(send *pagerDutySend) {
+	send.queuedAt = q.now()
+	if queued, ok := q.pending[send.key()]; ok {
+		send.queuedAt = queued.queuedAt
+	}
+	q.pending[send.key()] = send
+}
+
+func (q *PagerDutySendQueue) throttleLocked(err error) {
+	q.throttledUntil = q.now().Add(q.policy.Backoff)
+	q.logger.Warn("pagerduty throttled, queueing events",
+		"notifier", q.notifier.Name(),
+		"backoff", q.policy.Backoff,
+		"queued", len(q.pending),
+		"error", err,
+	)
+}
+
+// next removes and returns the event to send next, and the events shed
+// on the way. It returns a nil event while throttled or when empty.
+func (q *PagerDutySendQueue) next() (*pagerDutySend, []*pagerDutySend) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := q.now()
+	if now.Before(q.throttledUntil) {
+		return nil, nil
+	}
+
+	var shed []*pagerDutySend
+	sends := make([]*pagerDutySend, 0, len(q.pending))
+	for key, send := range q.pending {
+		if q.expired(send, now) {
+			delete(q.pending, key)
+			shed = append(shed, send)
+			continue
+		}
+		sends = append(sends, send)
+	}
+	if len(sends) == 0 {
+		return nil, shed
+	}
+
+	sort.Slice(sends, func(i, j int) bool {
+		a, b := sends[i], sends[j]
+		if (a.kind == pagerDutyTrigger) != (b.kind == pagerDutyTrigger) {
+			return a.kind == pagerDutyTrigger
+		}
+		if severityRank(a.severity) != severityRank(b.severity) {
+			return severityRank(a.severity) > severityRank(b.severity)
+		}
+		return a.queuedAt.Before(b.queuedAt)
+	})
+	delete(q.pending, sends[0].key())
+	return sends[0], shed
+}
+
+// expired reports whether send is a low-severity trigger or ack that
+// waited past the policy's MaxAge.
+func (q *PagerDutySendQueue) expired(send *pagerDutySend, now time.Time) bool {
+	if send.kind == pagerDutyUpdate || q.policy.ShedBelow == "" || q.policy.MaxAge <= 0 {
+		return false
+	}
+	if severityRank(send.severity) >= severityRank(q.policy.ShedBelow) {
+		return false
+	}
+	return now.Sub(send.queuedAt) > q.policy.MaxAge
+}
+
+// deliver sends one queued event. It returns false when PagerDuty
+// throttled it, which puts it back in the queue.
+func (q *PagerDutySendQueue) deliver(ctx context.Context, send *pagerDutySend) bool {
+	name := q.notifier.Name()
+	alert, err := q.alertRepo.FindByID(ctx, send.alertID)
+	if err != nil {
+		q.logger.Error("failed to load alert for queued pagerduty event",
+			"alertID", send.alertID,
+			"kind", send.kind,
+			"error", err,
+		)
+		return true
+	}
+	// A trigger or ack of a resolved alert would page for nothing
+	if alert == nil || (send.kind != pagerDutyUpdate && !alert.IsFiring()) {
+		return true
+	}
+
+	switch send.kind {
+	case pagerDutyTrigger:
+		if alert.HasExternalReference(name) {
+			return true
+		}
+		var result entity.NotifyResult
+		result, err = q.notifier.Notify(ctx, alert)
+		if err == nil {
+			alert.PutExternalReference(entity.NewExternalReference(name, result))
+			if updateErr := q.alertRepo.Update(ctx, alert); updateErr != nil {
+				q.logger.Error("failed to store message ID",
+					"notifier", name,
+					"alertID", alert.ID,
+					"error", updateErr,
+				)
+			}
+			q.timeline.Record(ctx, alert.ID, entity.AlertEventNotified, "", name+" (after throttling)")
+		}
+	case pagerDutyUpdate:
+		err = q.notifier.UpdateMessage(ctx, send.messageID, alert)
+	case pagerDutyAck:
+		err = q.acker.Acknowledge(ctx, alert, send.ackEvent)
+	}
+
+	// The wrapped notifier's circuit breaker opens after repeated
+	// throttles; wait for it like for PagerDuty
+	if errors.Is(err, resilience.ErrThrottled) || errors.Is(err, resilience.ErrCircuitOpen) {
+		q.mu.Lock()
+		q.throttleLocked(err)
+		if _, ok := q.pending[send.key()]; !ok {
+			q.pending[send.key()] = send
+		}
+		q.mu.Unlock()
+		return false
+	}
+	if err != nil {
+		q.logger.Error("queued pagerduty event failed",
+			"alertID", alert.ID,
+			"kind", send.kind,
+			"error", err,
+		)
+		q.timeline.Record(ctx, alert.ID, entity.AlertEventNotificationFailed, "",
+			fmt.Sprintf("%s %s: %v", name, send.kind, err))
+		return true
+	}
+
+	q.logger.Info("queued pagerduty event sent",
+		"alertID", alert.ID,
+		"kind", send.kind,
+		"waited", q.now().Sub(send.queuedAt).Round(time.Second),
+	)
+	return true
+}
+
+func (q *PagerDutySendQueue) recordShed(ctx context.Context, send *pagerDutySend) {
+	waited := q.now().Sub(send.queuedAt).Round(time.Second)
+	q.logger.Warn("shed queued pagerduty event",
+		"alertID", send.alertID,
+		"kind", send.kind,
+		"severity", send.severity,
+		"waited", waited,
+	)
+	q.timeline.Record(ctx, send.alertID, entity.AlertEventNotificationShed, "",
+		fmt.Sprintf("%s %s after %s throttled", q.notifier.Name(), send.kind, waited))
+}
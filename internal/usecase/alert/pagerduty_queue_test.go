@@ -0,0 +1,161 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	domainerrors "github.com/altuslabsxyz/alert-bridge/internal/domain/errors"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/memory"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/resilience"
+)
+
+type throttlingPagerDuty struct {
+	throttled bool
+	sent      []string
+}
+
+func (p *throttlingPagerDuty) send(kind string, alert *entity.Alert) error {
+	if p.throttled {
+		return domainerrors.NewTransientError("sending pagerduty event: rate limited",
+			fmt.Errorf("%w: status 429", resilience.ErrThrottled))
+	}
+	p.sent = append(p.sent, kind+" "+alert.Fingerprint)
+	return nil
+}
+
+func (p *throttlingPagerDuty) Notify(_ context.Context, alert *entity.Alert) (entity.NotifyResult, error) {
+	if err := p.send("trigger", alert); err != nil {
+		return entity.NotifyResult{}, err
+	}
+	return entity.NotifyResult{ReferenceID: alert.Fingerprint}, nil
+}
+
+func (p *throttlingPagerDuty) UpdateMessage(_ context.Context, _ string, alert *entity.Alert) error {
+	return p.send("update", alert)
+}
+
+func (p *throttlingPagerDuty) Acknowledge(_ context.Context, alert *entity.Alert, _ *entity.AckEvent) error {
+	return p.send("ack", alert)
+}
+
+func (p *throttlingPagerDuty) SupportsAck() bool { return true }
+
+func (p *throttlingPagerDuty) Name() string { return "pagerduty" }
+
+// recordingRetrier records the notifier calls queued for retry.
+type recordingRetrier struct {
+	queued []string
+}
+
+func (r *recordingRetrier) Enqueue(_ context.Context, alert *entity.Alert, notifier string, action entity.NotificationAction, err error) bool {
+	if !isRetryableNotification(err) {
+		return false
+	}
+	r.queued = append(r.queued, fmt.Sprintf("%s %s %s", notifier, action, alert.Fingerprint))
+	return true
+}
+
+func TestPagerDutySendQueue_Flush(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	pd := &throttlingPagerDuty{throttled: true}
+	queue := NewPagerDutySendQueue(pd, pd, alertRepo, PagerDutyQueuePolicy{Backoff: time.Minute}, nopLogger{})
+
+	firing := entity.NewAlert("firing", "HighCPU", "host-1", "", "summary", entity.SeverityCritical)
+	acked := entity.NewAlert("acked", "HighCPU", "host-2", "", "summary", entity.SeverityCritical)
+	acked.SetExternalReference("pagerduty", "acked")
+	require.NoError(t, alertRepo.Save(ctx, firing))
+	require.NoError(t, alertRepo.Save(ctx, acked))
+
+	_, err := queue.Notify(ctx, firing)
+	require.ErrorIs(t, err, ErrNotificationQueued)
+	require.NoError(t, queue.Acknowledge(ctx, acked, entity.NewAckEvent(acked.ID, entity.AckSourceSlack, "U1", "", "alice")))
+	require.Equal(t, 2, queue.Len())
+
+	// Without a retrier, held events are lost
+	assert.Zero(t, queue.Flush(ctx))
+	assert.Zero(t, queue.Len())
+
+	_, err = queue.Notify(ctx, firing)
+	require.ErrorIs(t, err, ErrNotificationQueued)
+	require.NoError(t, queue.Acknowledge(ctx, acked, entity.NewAckEvent(acked.ID, entity.AckSourceSlack, "U1", "", "alice")))
+
+	retrier := &recordingRetrier{}
+	queue.SetNotificationRetrier(retrier)
+	assert.Equal(t, 2, queue.Flush(ctx))
+	assert.Zero(t, queue.Len())
+	assert.ElementsMatch(t, []string{"pagerduty notify firing", "pagerduty update acked"}, retrier.queued)
+	assert.Empty(t, pd.sent)
+}
+
+func TestPagerDutySendQueue_Throttled(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	alertRepo := memory.NewAlertRepository()
+	eventRepo := memory.NewAlertEventRepository()
+	pd := &throttlingPagerDuty{throttled: true}
+	queue := NewPagerDutySendQueue(pd, pd, alertRepo, PagerDutyQueuePolicy{
+		Backoff:   30 * time.Second,
+		MaxAge:    10 * time.Minute,
+		ShedBelow: entity.SeverityCritical,
+	}, nopLogger{})
+	queue.SetTimeline(NewTimeline(eventRepo, nopLogger{}))
+	queue.now = func() time.Time { return now }
+
+	newAlert := func(fingerprint string, severity entity.AlertSeverity) *entity.Alert {
+		alert := entity.NewAlert(fingerprint, "HighCPU", "host-1", "", "summary", severity)
+		require.NoError(t, alertRepo.Save(ctx, alert))
+		return alert
+	}
+
+	// The first throttled trigger starts the backoff
+	warning := newAlert("warning", entity.SeverityWarning)
+	_, err := queue.Notify(ctx, warning)
+	assert.ErrorIs(t, err, ErrNotificationQueued)
+
+	// Later events wait without calling PagerDuty
+	pd.throttled = false
+	acked := newAlert("acked", entity.SeverityCritical)
+	acked.SetExternalReference("pagerduty", "acked")
+	require.NoError(t, alertRepo.Update(ctx, acked))
+	require.NoError(t, queue.Acknowledge(ctx, acked, entity.NewAckEvent(acked.ID, entity.AckSourceSlack, "U1", "", "alice")))
+	now = now.Add(10 * time.Second)
+	critical := newAlert("critical", entity.SeverityCritical)
+	_, err = queue.Notify(ctx, critical)
+	assert.ErrorIs(t, err, ErrNotificationQueued)
+	assert.Empty(t, pd.sent)
+	assert.Equal(t, 3, queue.Len())
+
+	// Nothing is sent during the backoff
+	queue.Drain(ctx)
+	assert.Empty(t, pd.sent)
+
+	// Triggers go first, the most severe first; the warning trigger waited
+	// too long and is shed
+	now = now.Add(10 * time.Minute)
+	queue.Drain(ctx)
+	assert.Equal(t, []string{"trigger critical", "ack acked"}, pd.sent)
+	assert.Zero(t, queue.Len())
+
+	stored, err := alertRepo.FindByID(ctx, critical.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "critical", stored.GetExternalReference("pagerduty"))
+
+	events, err := eventRepo.FindByAlertID(ctx, warning.ID)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, entity.AlertEventNotificationShed, events[0].Type)
+	assert.Equal(t, "pagerduty trigger after 10m10s throttled", events[0].Detail)
+
+	// Once drained, events go straight to PagerDuty
+	result, err := queue.Notify(ctx, newAlert("info", entity.SeverityInfo))
+	require.NoError(t, err)
+	assert.Equal(t, "info", result.ReferenceID)
+}
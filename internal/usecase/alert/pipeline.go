@@ -0,0 +1,195 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/adapter/dto"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// Stages of the pipeline new alerts go through after they are created.
+const (
+	// StageEnrich adds labels from cloud instance metadata and enrichment
+	// webhooks. Values sent by the source take precedence.
+	StageEnrich = "enrich"
+
+	// StageCustomFields extracts the tenant's custom fields.
+	StageCustomFields = "custom_fields"
+
+	// StageSilence stops the pipeline for silenced alerts, which are
+	// stored but not notified.
+	StageSilence = "silence"
+
+	// StageRoute picks the notifiers with the routing tree. The stages of
+	// the delivering route, if it sets any, replace the stages after it.
+	// Without it, every notifier receives the alert.
+	StageRoute = "route"
+
+	// StageNotify sends the alert to its notifiers.
+	StageNotify = "notify"
+)
+
+// DefaultPipeline is the order of the stages unless configured otherwise.
+var DefaultPipeline = []string{StageEnrich, StageCustomFields, StageSilence, StageRoute, StageNotify}
+
+// pipelineRun is the state of one new alert going through the pipeline.
+type pipelineRun struct {
+	alert  *entity.Alert
+	output *dto.ProcessAlertOutput
+
+	saved   bool
+	silence *entity.SilenceMark
+	route   *RoutingDecision
+}
+
+// runPipeline runs a new alert through the configured stages, saving it
+// before it is notified, or at the end if it never is.
+func (uc *ProcessAlertUseCase) runPipeline(ctx context.Context, alert *entity.Alert, input dto.ProcessAlertInput, output *dto.ProcessAlertOutput) error {
+	run := &pipelineRun{alert: alert, output: output}
+	stages := uc.pipeline
+	if len(stages) == 0 {
+		stages = DefaultPipeline
+	}
+
+stages:
+	for i := 0; i < len(stages); i++ {
+		switch stages[i] {
+		case StageEnrich:
+			uc.enrich(ctx, alert)
+		case StageCustomFields:
+			uc.extractCustomFields(alert)
+		case StageSilence:
+			if uc.checkSilences(ctx, run) {
+				break stages
+			}
+		case StageRoute:
+			if uc.routing == nil {
+				continue
+			}
+			decision := uc.routing.Route(alert)
+			run.route = &decision
+			uc.logger.Debug("alert routed",
+				"alertID", alert.ID,
+				"routes", decision.Routes,
+				"slackChannel", decision.SlackChannelID,
+			)
+			if decision.Pipeline != nil {
+				stages = append(stages[:i+1:i+1], decision.Pipeline...)
+			}
+		case StageNotify:
+			if err := uc.save(ctx, run); err != nil {
+				return err
+			}
+			uc.sendNotifications(ctx, alert, input.ReceivedAt, run.route, output)
+		}
+	}
+
+	return uc.save(ctx, run)
+}
+
+// save stores the new alert once, recording it in the timeline.
+func (uc *ProcessAlertUseCase) save(ctx context.Context, run *pipelineRun) error {
+	if run.saved {
+		return nil
+	}
+	if err := uc.alertRepo.Save(ctx, run.alert); err != nil {
+		if run.silence != nil {
+			return fmt.Errorf("saving silenced alert: %w", err)
+		}
+		return fmt.Errorf("saving alert: %w", err)
+	}
+	run.saved = true
+
+	uc.timeline.Record(ctx, run.alert.ID, entity.AlertEventFired, "", string(run.alert.Severity))
+	if run.silence != nil {
+		uc.timeline.Record(ctx, run.alert.ID, entity.AlertEventSilenced, run.silence.CreatedBy, silenceDetail(run.silence))
+	}
+	run.output.AlertID = run.alert.ID
+	run.output.IsNew = true
+	return nil
+}
+
+// enrich adds labels and annotations from external sources; the
+// fingerprint is already fixed, and values sent by the source take
+// precedence.
+func (uc *ProcessAlertUseCase) enrich(ctx context.Context, alert *entity.Alert) {
+	if uc.labelEnricher != nil {
+		extra, err := uc.labelEnricher.Enrich(ctx, alert)
+		if err != nil {
+			uc.logger.Warn("failed to enrich alert labels",
+				"fingerprint", alert.Fingerprint,
+				"error", err,
+			)
+		}
+		for k, v := range extra {
+			if _, ok := alert.Labels[k]; !ok {
+				alert.AddLabel(k, v)
+			}
+		}
+	}
+	if uc.alertEnricher != nil {
+		labels, annotations, err := uc.alertEnricher.Enrich(ctx, alert)
+		if err != nil {
+			uc.logger.Warn("failed to enrich alert",
+				"fingerprint", alert.Fingerprint,
+				"error", err,
+			)
+		}
+		for k, v := range labels {
+			if _, ok := alert.Labels[k]; !ok {
+				alert.AddLabel(k, v)
+			}
+		}
+		for k, v := range annotations {
+			if _, ok := alert.Annotations[k]; !ok {
+				alert.AddAnnotation(k, v)
+			}
+		}
+	}
+}
+
+// extractCustomFields extracts custom fields; invalid values are dropped,
+// not fatal.
+func (uc *ProcessAlertUseCase) extractCustomFields(alert *entity.Alert) {
+	if uc.customFields == nil {
+		return
+	}
+	fields, problems := uc.customFields.Extract(alert)
+	alert.CustomFields = fields
+	if len(problems) > 0 {
+		uc.logger.Warn("alert custom fields failed validation",
+			"alertID", alert.ID,
+			"fingerprint", alert.Fingerprint,
+			"problems", problems,
+		)
+	}
+}
+
+// checkSilences reports whether the alert is silenced, marking the run and
+// output if so.
+func (uc *ProcessAlertUseCase) checkSilences(ctx context.Context, run *pipelineRun) bool {
+	alert := run.alert
+	silences, err := uc.silenceRepo.FindMatchingAlert(ctx, alert)
+	if err != nil {
+		uc.logger.Warn("failed to check silences",
+			"error", err,
+			"alertID", alert.ID,
+		)
+	}
+	if len(silences) == 0 {
+		return false
+	}
+
+	uc.logger.Info("alert is silenced",
+		"alertID", alert.ID,
+		"silenceID", silences[0].ID,
+		"silenceEndAt", silences[0].EndAt,
+	)
+	run.output.IsSilenced = true
+	run.silence = silences[0]
+	if uc.metrics != nil {
+		uc.metrics.RecordSilenceMatch(ctx, string(alert.Severity), silences[0].ID)
+	}
+	return true
+}
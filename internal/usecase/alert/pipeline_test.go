@@ -0,0 +1,140 @@
+package alert
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/adapter/dto"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/memory"
+)
+
+// namedNotifier records the fingerprints of the alerts it is sent.
+type namedNotifier struct {
+	name     string
+	notified []string
+}
+
+func (n *namedNotifier) Notify(_ context.Context, alert *entity.Alert) (entity.NotifyResult, error) {
+	n.notified = append(n.notified, alert.Fingerprint)
+	return entity.NotifyResult{ReferenceID: "msg-" + alert.Fingerprint}, nil
+}
+
+func (n *namedNotifier) UpdateMessage(context.Context, string, *entity.Alert) error { return nil }
+
+func (n *namedNotifier) Name() string { return n.name }
+
+// staticLabels adds the same labels to every alert.
+type staticLabels map[string]string
+
+func (l staticLabels) Enrich(context.Context, *entity.Alert) (map[string]string, error) {
+	return l, nil
+}
+
+func TestProcessAlertUseCase_Pipeline(t *testing.T) {
+	ctx := context.Background()
+	newUseCase := func(t *testing.T, stages []string, root *Route) (*ProcessAlertUseCase, *namedNotifier, *namedNotifier) {
+		t.Helper()
+		silences := memory.NewSilenceRepository()
+		silence, err := entity.NewSilenceMark(time.Hour, "alice", "", entity.AckSourceSlack)
+		require.NoError(t, err)
+		require.NoError(t, silences.Save(ctx, silence.ForFingerprint("fp-silenced")))
+
+		slack, email := &namedNotifier{name: "slack"}, &namedNotifier{name: "email"}
+		uc := NewProcessAlertUseCase(memory.NewAlertRepository(), silences, []Notifier{slack, email}, nopLogger{}, nil)
+		uc.SetLabelEnricher(staticLabels{"team": "payments"})
+		uc.SetPipeline(stages)
+		if root != nil {
+			uc.SetRoutingTree(NewRoutingTree(*root))
+		}
+		return uc, slack, email
+	}
+	execute := func(t *testing.T, uc *ProcessAlertUseCase, fingerprint string) *dto.ProcessAlertOutput {
+		t.Helper()
+		output, err := uc.Execute(ctx, dto.ProcessAlertInput{
+			Fingerprint: fingerprint,
+			Name:        "HighCPU",
+			Severity:    entity.SeverityWarning,
+			Status:      "firing",
+		})
+		require.NoError(t, err)
+		require.True(t, output.IsNew)
+		require.NotEmpty(t, output.AlertID)
+		return output
+	}
+	payments := Route{
+		Name:      "root",
+		Notifiers: []string{"slack"},
+		Routes: []Route{{
+			Name:      "payments",
+			Match:     map[string]string{"team": "payments"},
+			Notifiers: []string{"email"},
+		}},
+	}
+
+	t.Run("default order", func(t *testing.T) {
+		uc, slack, email := newUseCase(t, nil, &payments)
+		assert.True(t, execute(t, uc, "fp-silenced").IsSilenced)
+		execute(t, uc, "fp-1")
+		assert.Empty(t, slack.notified)
+		// Enriched before routing
+		assert.Equal(t, []string{"fp-1"}, email.notified)
+	})
+
+	t.Run("reordered stages", func(t *testing.T) {
+		uc, slack, email := newUseCase(t, []string{StageRoute, StageEnrich, StageNotify}, &payments)
+		execute(t, uc, "fp-1")
+		assert.Equal(t, []string{"fp-1"}, slack.notified)
+		assert.Empty(t, email.notified)
+	})
+
+	t.Run("disabled stages", func(t *testing.T) {
+		uc, slack, email := newUseCase(t, []string{StageEnrich, StageNotify}, &payments)
+		output := execute(t, uc, "fp-silenced")
+		assert.False(t, output.IsSilenced)
+		// Without routing, every notifier
+		assert.Equal(t, []string{"fp-silenced"}, slack.notified)
+		assert.Equal(t, []string{"fp-silenced"}, email.notified)
+
+		uc, slack, _ = newUseCase(t, []string{StageEnrich, StageSilence}, nil)
+		output = execute(t, uc, "fp-1")
+		assert.Empty(t, output.NotificationsSent)
+		assert.Empty(t, slack.notified)
+	})
+
+	t.Run("route pipelines", func(t *testing.T) {
+		root := payments
+		root.Routes = []Route{{
+			Name:     "payments",
+			Match:    map[string]string{"team": "payments"},
+			Pipeline: []string{StageSilence, StageEnrich, StageNotify},
+			Routes: []Route{{
+				Name:  "payments-db",
+				Match: map[string]string{"service": "mysql"},
+			}},
+		}, {
+			Name:     "muted",
+			Match:    map[string]string{"env": "dev"},
+			Pipeline: []string{},
+		}}
+		uc, slack, _ := newUseCase(t, []string{StageEnrich, StageRoute, StageNotify}, &root)
+
+		// The payments route checks silences; the global pipeline does not
+		assert.True(t, execute(t, uc, "fp-silenced").IsSilenced)
+		assert.Empty(t, slack.notified)
+
+		uc.SetLabelEnricher(staticLabels{"team": "payments", "service": "mysql"})
+		execute(t, uc, "fp-1")
+		// Inherited by payments-db
+		assert.Equal(t, []string{"fp-1"}, slack.notified)
+
+		uc.SetLabelEnricher(staticLabels{"env": "dev"})
+		output := execute(t, uc, "fp-2")
+		assert.Empty(t, output.NotificationsSent)
+		assert.Equal(t, []string{"fp-1"}, slack.notified)
+	})
+}
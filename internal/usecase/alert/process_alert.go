@@ -2,11 +2,16 @@ package alert
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"golang.org/x/sync/errgroup"
+
 	"github.com/altuslabsxyz/alert-bridge/internal/adapter/dto"
 	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	domainerrors "github.com/altuslabsxyz/alert-bridge/internal/domain/errors"
 	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
 	"github.com/altuslabsxyz/alert-bridge/internal/domain/service"
 	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/observability"
@@ -24,6 +29,45 @@ type ProcessAlertUseCase struct {
 	subscriberMatcher *service.SubscriberMatcher
 	slackNotifier     SlackSubscriberNotifier
 	pagerDutyNotifier PagerDutySubscriberNotifier
+
+	// Slack thread replies for group-level warnings (optional)
+	threadNotifier ThreadNotifier
+
+	// Per-tenant custom field schema (optional)
+	customFields CustomFieldExtractor
+
+	// Extra labels and annotations from external sources (optional)
+	labelEnricher LabelEnricher
+	alertEnricher AlertEnricher
+
+	// Persistent retries of transient notifier failures (optional)
+	retrier NotificationRetrier
+
+	// Delivery latency objectives (optional)
+	deliverySLO *DeliverySLOTracker
+
+	// Label-based choice of notifiers (optional); all notifiers when nil
+	routing *RoutingTree
+
+	// Stages of new alerts; DefaultPipeline when empty
+	pipeline []string
+
+	// Alert timeline (optional)
+	timeline *Timeline
+
+	// Per-fingerprint processing shards (optional); callers' goroutines
+	// when nil
+	shards *ShardPool
+
+	// Alerts of a batch processed at once without shards, and whether
+	// notifiers are called in parallel; one at a time when 0
+	concurrency int
+
+	// Fingerprints of firing alerts, to skip storage for duplicates
+	// (optional)
+	firingCache FiringCache
+
+	now func() time.Time
 }
 
 // NewProcessAlertUseCase creates a new ProcessAlertUseCase with dependencies.
@@ -40,6 +84,7 @@ func NewProcessAlertUseCase(
 		notifiers:   notifiers,
 		logger:      logger,
 		metrics:     metrics,
+		now:         time.Now,
 	}
 }
 
@@ -59,11 +104,166 @@ func (uc *ProcessAlertUseCase) SetPagerDutySubscriberNotifier(notifier PagerDuty
 	uc.pagerDutyNotifier = notifier
 }
 
-// Execute processes an incoming alert.
+// SetThreadNotifier sets the notifier used to post warnings in Slack alert threads.
+func (uc *ProcessAlertUseCase) SetThreadNotifier(notifier ThreadNotifier) {
+	uc.threadNotifier = notifier
+}
+
+// SetCustomFieldExtractor enables typed custom field extraction on ingest.
+func (uc *ProcessAlertUseCase) SetCustomFieldExtractor(extractor CustomFieldExtractor) {
+	uc.customFields = extractor
+}
+
+// SetLabelEnricher sets the enricher that adds labels to new alerts.
+func (uc *ProcessAlertUseCase) SetLabelEnricher(enricher LabelEnricher) {
+	uc.labelEnricher = enricher
+}
+
+// SetAlertEnricher sets the enricher that adds labels and annotations to new
+// alerts, after the label enricher.
+func (uc *ProcessAlertUseCase) SetAlertEnricher(enricher AlertEnricher) {
+	uc.alertEnricher = enricher
+}
+
+// SetNotificationRetrier sets the queue that retries notifications failing
+// with a transient error.
+func (uc *ProcessAlertUseCase) SetNotificationRetrier(retrier NotificationRetrier) {
+	uc.retrier = retrier
+}
+
+// SetDeliverySLOTracker sets the tracker that measures new-alert delivery
+// latency against per-route objectives.
+func (uc *ProcessAlertUseCase) SetDeliverySLOTracker(tracker *DeliverySLOTracker) {
+	uc.deliverySLO = tracker
+}
+
+// SetRoutingTree restricts new alerts to the notifiers the routing tree
+// picks for them.
+func (uc *ProcessAlertUseCase) SetRoutingTree(tree *RoutingTree) {
+	uc.routing = tree
+}
+
+// SetTimeline records state transitions, silences and notifications in the
+// alert timeline.
+func (uc *ProcessAlertUseCase) SetTimeline(timeline *Timeline) {
+	uc.timeline = timeline
+}
+
+// SetShardPool processes alerts on the shard of their fingerprint, so
+// alerts with the same fingerprint are processed one at a time, in order.
+func (uc *ProcessAlertUseCase) SetShardPool(pool *ShardPool) {
+	uc.shards = pool
+}
+
+// SetPipeline sets the stages new alerts go through, in order. Stages may
+// repeat; notify should be last.
+func (uc *ProcessAlertUseCase) SetPipeline(stages []string) {
+	uc.pipeline = stages
+}
+
+// SetFiringCache sets the cache of firing fingerprints. A firing alert
+// whose fingerprint it knows at the same severity is a duplicate, and is
+// skipped without reading or writing storage.
+func (uc *ProcessAlertUseCase) SetFiringCache(cache FiringCache) {
+	uc.firingCache = cache
+}
+
+// SetConcurrency processes up to n alerts of a batch at once when there is
+// no shard pool, and calls the notifiers of an alert in parallel. Alerts with
+// the same fingerprint are still processed in order. 0 disables both.
+func (uc *ProcessAlertUseCase) SetConcurrency(n int) {
+	uc.concurrency = n
+}
+
+// Execute processes an incoming alert. With a shard pool, it waits for the
+// alert's turn on the shard of its fingerprint.
 func (uc *ProcessAlertUseCase) Execute(ctx context.Context, input dto.ProcessAlertInput) (*dto.ProcessAlertOutput, error) {
+	outputs, errs := uc.ExecuteAll(ctx, []dto.ProcessAlertInput{input})
+	return outputs[0], errs[0]
+}
+
+// ExecuteAll processes incoming alerts, returning the output and error of
+// each. With a shard pool, alerts of different fingerprints are processed
+// in parallel, and alerts of the same fingerprint in the order given. The
+// same holds without one when a concurrency is set; otherwise they are
+// processed one after another.
+func (uc *ProcessAlertUseCase) ExecuteAll(ctx context.Context, inputs []dto.ProcessAlertInput) ([]*dto.ProcessAlertOutput, []error) {
+	outputs := make([]*dto.ProcessAlertOutput, len(inputs))
+	errs := make([]error, len(inputs))
+	if uc.shards == nil && uc.concurrency > 0 && len(inputs) > 1 {
+		uc.executeConcurrently(ctx, inputs, outputs, errs)
+		return outputs, errs
+	}
+	if uc.shards == nil {
+		for i, input := range inputs {
+			outputs[i], errs[i] = uc.execute(ctx, input)
+		}
+		return outputs, errs
+	}
+
+	dones := make([]<-chan struct{}, len(inputs))
+	queueErrs := make([]error, len(inputs))
+	for i, input := range inputs {
+		dones[i], queueErrs[i] = uc.shards.Submit(ctx, input.Fingerprint, func(ctx context.Context) {
+			outputs[i], errs[i] = uc.execute(ctx, input)
+		})
+	}
+	for i, done := range dones {
+		if queueErrs[i] != nil {
+			errs[i] = fmt.Errorf("queueing alert for processing: %w", queueErrs[i])
+			continue
+		}
+		<-done
+	}
+	return outputs, errs
+}
+
+// executeConcurrently processes inputs up to uc.concurrency fingerprints at
+// a time, the inputs of each fingerprint in order.
+func (uc *ProcessAlertUseCase) executeConcurrently(ctx context.Context, inputs []dto.ProcessAlertInput, outputs []*dto.ProcessAlertOutput, errs []error) {
+	var order []string
+	byFingerprint := make(map[string][]int)
+	for i, input := range inputs {
+		if _, ok := byFingerprint[input.Fingerprint]; !ok {
+			order = append(order, input.Fingerprint)
+		}
+		byFingerprint[input.Fingerprint] = append(byFingerprint[input.Fingerprint], i)
+	}
+
+	var g errgroup.Group
+	g.SetLimit(uc.concurrency)
+	for _, fingerprint := range order {
+		g.Go(func() error {
+			for _, i := range byFingerprint[fingerprint] {
+				outputs[i], errs[i] = uc.execute(ctx, inputs[i])
+			}
+			return nil
+		})
+	}
+	g.Wait()
+}
+
+// execute processes an incoming alert.
+func (uc *ProcessAlertUseCase) execute(ctx context.Context, input dto.ProcessAlertInput) (output *dto.ProcessAlertOutput, err error) {
 	start := time.Now()
 	success := false
 
+	ctx, span := observability.StartSpan(ctx, "alert.process",
+		attribute.String("alert.fingerprint", input.Fingerprint),
+		attribute.String("alert.name", input.Name),
+		attribute.String("alert.status", input.Status),
+	)
+	defer func() {
+		if output != nil {
+			span.SetAttributes(
+				attribute.String("alert.id", output.AlertID),
+				attribute.Bool("alert.new", output.IsNew),
+				attribute.Bool("alert.silenced", output.IsSilenced),
+			)
+		}
+		observability.EndSpan(span, err)
+	}()
+
 	defer func() {
 		duration := time.Since(start)
 		if uc.metrics != nil {
@@ -78,7 +278,40 @@ func (uc *ProcessAlertUseCase) Execute(ctx context.Context, input dto.ProcessAle
 		}
 	}()
 
-	output := &dto.ProcessAlertOutput{}
+	output = &dto.ProcessAlertOutput{}
+	now := uc.now().UTC()
+	if input.ReceivedAt.IsZero() {
+		input.ReceivedAt = start
+	}
+
+	// A firing alert whose EndsAt already passed expired before it reached us
+	// (late or retried delivery); handle it as a resolution.
+	if input.Status == "firing" && isExpired(input.EndsAt, now) {
+		uc.logger.Info("received already-expired firing alert, treating as resolved",
+			"fingerprint", input.Fingerprint,
+			"endsAt", input.EndsAt,
+		)
+		input.Status = "resolved"
+	}
+
+	// Duplicates of an alert known to be firing need no storage; the cache
+	// entry expires, so the last-seen time is still written now and then
+	if input.Status == "firing" && uc.firingCache != nil {
+		if alertID, severity, ok := uc.firingCache.Firing(input.Fingerprint); ok && severity == input.Severity {
+			uc.logger.Debug("alert already firing, skipping",
+				"alertID", alertID,
+				"fingerprint", input.Fingerprint,
+				"cached", true,
+			)
+			if uc.metrics != nil {
+				uc.metrics.RecordFiringCacheHit(ctx, string(severity))
+			}
+			output.AlertID = alertID
+			output.IsNew = false
+			success = true
+			return output, nil
+		}
+	}
 
 	// 1. Check if alert exists (by fingerprint)
 	existing, err := uc.alertRepo.FindByFingerprint(ctx, input.Fingerprint)
@@ -101,11 +334,12 @@ func (uc *ProcessAlertUseCase) Execute(ctx context.Context, input dto.ProcessAle
 			return output, nil
 		}
 
-		// Resolve the alert
-		alert.Resolve(time.Now().UTC())
+		// Resolve the alert at the time the source says it ended
+		alert.Resolve(resolutionTime(alert, input.EndsAt, now))
 		if err := uc.alertRepo.Update(ctx, alert); err != nil {
 			return nil, fmt.Errorf("updating resolved alert: %w", err)
 		}
+		uc.timeline.Record(ctx, alert.ID, entity.AlertEventResolved, "", "")
 
 		output.AlertID = alert.ID
 		output.IsNew = false
@@ -120,11 +354,54 @@ func (uc *ProcessAlertUseCase) Execute(ctx context.Context, input dto.ProcessAle
 	// Status is "firing"
 	// 3. Check if we already have a firing alert for this fingerprint
 	alert = uc.findFiringAlert(existing)
+	var previousSeverity entity.AlertSeverity
+	if alert != nil {
+		previousSeverity = alert.Severity
+	}
+	if alert != nil && alert.ChangeSeverity(input.Severity, now) {
+		// Same alert, new severity (e.g. warning escalated to critical):
+		// record the transition and refresh notifications.
+		alert.Seen(now, input.EndsAt)
+		if input.GroupKey != "" {
+			alert.GroupKey = input.GroupKey
+		}
+		if err := uc.alertRepo.Update(ctx, alert); err != nil {
+			return nil, fmt.Errorf("updating alert severity: %w", err)
+		}
+		uc.timeline.Record(ctx, alert.ID, entity.AlertEventSeverityChanged, "",
+			fmt.Sprintf("%s → %s", previousSeverity, alert.Severity))
+
+		uc.logger.Info("alert severity changed",
+			"alertID", alert.ID,
+			"fingerprint", input.Fingerprint,
+			"severity", alert.Severity,
+		)
+
+		output.AlertID = alert.ID
+		output.IsNew = false
+		uc.updateNotifications(ctx, alert, output)
+
+		success = true
+		return output, nil
+	}
 	if alert != nil {
-		// Already have a firing alert, skip (deduplication)
+		// Already have a firing alert, skip (deduplication), but record
+		// that the source still reports it so it is not considered stale.
+		// Matching groupKeys mean Alertmanager re-sent or retried the same
+		// group; otherwise the alert moved to a new group, which now owns it.
+		sameGroup := input.GroupKey != "" && input.GroupKey == alert.GroupKey
+		alert.Seen(now, input.EndsAt)
+		if input.GroupKey != "" {
+			alert.GroupKey = input.GroupKey
+		}
+		if err := uc.alertRepo.Update(ctx, alert); err != nil {
+			return nil, fmt.Errorf("updating alert last seen: %w", err)
+		}
 		uc.logger.Debug("alert already firing, skipping",
 			"alertID", alert.ID,
 			"fingerprint", input.Fingerprint,
+			"groupKey", input.GroupKey,
+			"sameGroup", sameGroup,
 		)
 		output.AlertID = alert.ID
 		output.IsNew = false
@@ -142,7 +419,10 @@ func (uc *ProcessAlertUseCase) Execute(ctx context.Context, input dto.ProcessAle
 		input.Severity,
 	)
 	alert.Description = input.Description
+	alert.Source = input.Source
 	alert.FiredAt = input.FiredAt
+	alert.GroupKey = input.GroupKey
+	alert.Seen(now, input.EndsAt)
 
 	// Copy labels and annotations
 	for k, v := range input.Labels {
@@ -152,47 +432,149 @@ func (uc *ProcessAlertUseCase) Execute(ctx context.Context, input dto.ProcessAle
 		alert.AddAnnotation(k, v)
 	}
 
-	// 5. Check if alert is silenced
-	silences, err := uc.silenceRepo.FindMatchingAlert(ctx, alert)
-	if err != nil {
-		uc.logger.Warn("failed to check silences",
-			"error", err,
-			"alertID", alert.ID,
+	// 5. Run the pipeline: enrich, check silences, route and notify, with
+	// the alert saved before it is notified
+	if err := uc.runPipeline(ctx, alert, input, output); err != nil {
+		return nil, err
+	}
+
+	success = true
+	return output, nil
+}
+
+// ProcessGroup applies group-level webhook semantics after each alert in the
+// payload has gone through Execute:
+//   - when the group resolves, firing alerts from the same group that were not
+//     listed (e.g. dropped by truncation) are resolved too;
+//   - when Alertmanager truncated the payload, a warning is posted in the Slack
+//     thread of the first notified alert.
+func (uc *ProcessAlertUseCase) ProcessGroup(ctx context.Context, input dto.ProcessAlertGroupInput) (*dto.ProcessAlertGroupOutput, error) {
+	output := &dto.ProcessAlertGroupOutput{}
+
+	// 1. Resolve unlisted alerts of a resolved group
+	if input.Status == "resolved" && input.GroupKey != "" {
+		resolved, err := uc.resolveUnlistedGroupAlerts(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		output.ResolvedAlertIDs = resolved
+	}
+
+	// 2. Surface truncation to responders
+	if input.TruncatedAlerts > 0 {
+		uc.logger.Warn("alertmanager truncated webhook payload",
+			"groupKey", input.GroupKey,
+			"received", len(input.Fingerprints),
+			"truncated", input.TruncatedAlerts,
 		)
+		output.TruncationReported = uc.reportTruncation(ctx, input)
+	}
+
+	return output, nil
+}
+
+// resolveUnlistedGroupAlerts resolves firing alerts whose group resolved
+// but which were not part of the payload.
+func (uc *ProcessAlertUseCase) resolveUnlistedGroupAlerts(ctx context.Context, input dto.ProcessAlertGroupInput) ([]string, error) {
+	firing, err := uc.alertRepo.FindFiring(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("finding firing alerts: %w", err)
+	}
+
+	listed := make(map[string]bool, len(input.Fingerprints))
+	for _, fp := range input.Fingerprints {
+		listed[fp] = true
 	}
 
-	if len(silences) > 0 {
-		uc.logger.Info("alert is silenced",
+	now := uc.now().UTC()
+	var resolved []string
+	for _, alert := range firing {
+		if alert.GroupKey != input.GroupKey || listed[alert.Fingerprint] {
+			continue
+		}
+
+		// Resolve the alert when its last EndsAt says it ended, as for a
+		// listed resolution
+		var endsAt time.Time
+		if alert.EndsAt != nil {
+			endsAt = *alert.EndsAt
+		}
+		alert.Resolve(resolutionTime(alert, endsAt, now))
+		if err := uc.alertRepo.Update(ctx, alert); err != nil {
+			uc.logger.Error("failed to resolve grouped alert",
+				"alertID", alert.ID,
+				"groupKey", input.GroupKey,
+				"error", err,
+			)
+			continue
+		}
+		uc.timeline.Record(ctx, alert.ID, entity.AlertEventResolved, "", "with its group")
+
+		uc.updateNotifications(ctx, alert, &dto.ProcessAlertOutput{})
+		resolved = append(resolved, alert.ID)
+
+		uc.logger.Info("resolved alert with its group",
 			"alertID", alert.ID,
-			"silenceID", silences[0].ID,
-			"silenceEndAt", silences[0].EndAt,
+			"fingerprint", alert.Fingerprint,
+			"groupKey", input.GroupKey,
 		)
-		output.IsSilenced = true
+	}
 
-		// Still save the alert for tracking, but don't notify
-		if err := uc.alertRepo.Save(ctx, alert); err != nil {
-			return nil, fmt.Errorf("saving silenced alert: %w", err)
-		}
+	return resolved, nil
+}
 
-		output.AlertID = alert.ID
-		output.IsNew = true
-		success = true
-		return output, nil
+// reportTruncation posts a truncation warning in the Slack thread of the first
+// alert from the payload that has a Slack message. Returns true if posted.
+func (uc *ProcessAlertUseCase) reportTruncation(ctx context.Context, input dto.ProcessAlertGroupInput) bool {
+	if uc.threadNotifier == nil {
+		return false
 	}
 
-	// 6. Save alert
-	if err := uc.alertRepo.Save(ctx, alert); err != nil {
-		return nil, fmt.Errorf("saving alert: %w", err)
+	for _, alertID := range input.AlertIDs {
+		alert, err := uc.alertRepo.FindByID(ctx, alertID)
+		if err != nil || alert == nil {
+			continue
+		}
+
+		messageID := uc.getMessageID(alert, "slack")
+		if messageID == "" {
+			continue
+		}
+
+		text := fmt.Sprintf(
+			":warning: Alertmanager truncated this notification: %d more alert(s) in the group were not delivered. Check Alertmanager for the full list.",
+			input.TruncatedAlerts,
+		)
+		if err := uc.threadNotifier.PostThreadReply(ctx, messageID, text); err != nil {
+			uc.logger.Error("failed to post truncation warning",
+				"alertID", alert.ID,
+				"groupKey", input.GroupKey,
+				"error", err,
+			)
+			return false
+		}
+		return true
 	}
 
-	output.AlertID = alert.ID
-	output.IsNew = true
+	return false
+}
 
-	// 7. Send notifications
-	uc.sendNotifications(ctx, alert, output)
+// expiryGrace tolerates clock skew between the alert source and this host
+// before a firing alert's EndsAt is considered to have passed.
+const expiryGrace = time.Minute
 
-	success = true
-	return output, nil
+// isExpired reports whether a firing alert's EndsAt is already in the past.
+func isExpired(endsAt, now time.Time) bool {
+	return !endsAt.IsZero() && endsAt.Before(now.Add(-expiryGrace))
+}
+
+// resolutionTime returns when the alert actually resolved: the source-reported
+// EndsAt when it is usable, otherwise now.
+func resolutionTime(alert *entity.Alert, endsAt, now time.Time) time.Time {
+	if endsAt.IsZero() || endsAt.After(now) || endsAt.Before(alert.FiredAt) {
+		return now
+	}
+	return endsAt.UTC()
 }
 
 // findFiringAlert finds a firing (non-resolved) alert from the list.
@@ -205,8 +587,10 @@ func (uc *ProcessAlertUseCase) findFiringAlert(alerts []*entity.Alert) *entity.A
 	return nil
 }
 
-// sendNotifications sends notifications to all configured notifiers.
-func (uc *ProcessAlertUseCase) sendNotifications(ctx context.Context, alert *entity.Alert, output *dto.ProcessAlertOutput) {
+// sendNotifications sends notifications to the notifiers route includes,
+// or to all configured notifiers if route is nil. receivedAt is when the
+// alert reached the bridge, for delivery SLOs.
+func (uc *ProcessAlertUseCase) sendNotifications(ctx context.Context, alert *entity.Alert, receivedAt time.Time, route *RoutingDecision, output *dto.ProcessAlertOutput) {
 	// Get matching subscribers if subscriber matcher is configured
 	var slackUserIDs []string
 	var pdSubscribers []service.UseCaseMatchedSubscriber
@@ -242,49 +626,134 @@ func (uc *ProcessAlertUseCase) sendNotifications(ctx context.Context, alert *ent
 		}
 	}
 
+	var notifiers []Notifier
 	for _, notifier := range uc.notifiers {
-		var messageID string
+		if route == nil || route.Includes(notifier.Name()) {
+			notifiers = append(notifiers, notifier)
+		}
+	}
+
+	results := uc.callNotifiers(notifiers, func(notifier Notifier) notifierResult {
+		var result entity.NotifyResult
 		var err error
 
+		notifyCtx, span := observability.StartSpan(ctx, "notify "+notifier.Name(),
+			attribute.String("notifier", notifier.Name()),
+			attribute.String("alert.id", alert.ID),
+		)
+		if route != nil {
+			if channel := route.Channel(notifier.Name()); channel != "" {
+				notifyCtx = entity.WithNotificationChannel(notifyCtx, channel)
+			}
+		}
 		switch notifier.Name() {
 		case "slack":
-			messageID, err = uc.sendSlackNotification(ctx, alert, slackUserIDs)
+			result, err = uc.sendSlackNotification(notifyCtx, alert, slackUserIDs)
 		case "pagerduty":
-			messageID, err = uc.sendPagerDutyNotification(ctx, alert, pdSubscribers)
+			result, err = uc.sendPagerDutyNotification(notifyCtx, alert, pdSubscribers)
 		default:
 			// Use generic notifier for other notification types
-			messageID, err = notifier.Notify(ctx, alert)
+			result, err = notifier.Notify(notifyCtx, alert)
+		}
+
+		switch {
+		case errors.Is(err, ErrNotificationSkipped):
+			span.SetAttributes(attribute.Bool("notification.skipped", true))
+			span.End()
+		case errors.Is(err, ErrNotificationQueued):
+			span.SetAttributes(attribute.Bool("notification.queued", true))
+			span.End()
+		default:
+			observability.EndSpan(span, err)
 		}
+		return notifierResult{result: result, err: err, latency: time.Since(receivedAt)}
+	})
+
+	for i, notifier := range notifiers {
+		result, err := results[i].result, results[i].err
 
+		if errors.Is(err, ErrNotificationSkipped) {
+			continue
+		}
+		if errors.Is(err, ErrNotificationQueued) {
+			// The notifier records the delivery once it sends
+			continue
+		}
+		if uc.deliverySLO != nil && notifier.Name() != canaryNotifierName {
+			uc.deliverySLO.RecordDelivery(notifier.Name(), alert.Severity, results[i].latency, err == nil)
+		}
 		if err != nil {
 			uc.logger.Error("notification failed",
 				"notifier", notifier.Name(),
 				"alertID", alert.ID,
 				"error", err,
 			)
+			uc.timeline.Record(ctx, alert.ID, entity.AlertEventNotificationFailed, "",
+				fmt.Sprintf("%s: %v", notifier.Name(), err))
+			uc.queueRetry(ctx, alert, notifier.Name(), entity.NotificationActionNotify, err)
 			output.NotificationsFailed = append(output.NotificationsFailed, dto.NotificationError{
 				NotifierName: notifier.Name(),
 				Error:        err,
+				Retriable:    result.Retriable,
 			})
 			continue
 		}
 
 		// Store message ID for later updates
-		uc.storeMessageID(ctx, alert, notifier.Name(), messageID)
+		uc.storeMessageID(ctx, alert, notifier.Name(), result)
+		uc.timeline.Record(ctx, alert.ID, entity.AlertEventNotified, "", notifier.Name())
 		output.NotificationsSent = append(output.NotificationsSent, notifier.Name())
+		output.Deliveries = append(output.Deliveries, dto.NotificationDelivery{
+			NotifierName: notifier.Name(),
+			Result:       result,
+		})
 
 		uc.logger.Info("notification sent",
 			"notifier", notifier.Name(),
 			"alertID", alert.ID,
-			"messageID", messageID,
+			"messageID", result.ReferenceID,
+			"channel", result.Channel,
 		)
 	}
 }
 
+// notifierResult is the outcome of calling one notifier for an alert.
+type notifierResult struct {
+	result  entity.NotifyResult
+	err     error
+	latency time.Duration
+}
+
+// callNotifiers calls call for each notifier, in parallel when a
+// concurrency is set, and returns the results in the order of notifiers.
+// call must not modify the alert; results are applied by the caller.
+func (uc *ProcessAlertUseCase) callNotifiers(notifiers []Notifier, call func(Notifier) notifierResult) []notifierResult {
+	results := make([]notifierResult, len(notifiers))
+	if uc.concurrency == 0 || len(notifiers) < 2 {
+		for i, notifier := range notifiers {
+			results[i] = call(notifier)
+		}
+		return results
+	}
+
+	var g errgroup.Group
+	for i, notifier := range notifiers {
+		g.Go(func() error {
+			results[i] = call(notifier)
+			return nil
+		})
+	}
+	g.Wait()
+	return results
+}
+
 // sendSlackNotification sends a Slack notification with optional user mentions.
-func (uc *ProcessAlertUseCase) sendSlackNotification(ctx context.Context, alert *entity.Alert, slackUserIDs []string) (string, error) {
+func (uc *ProcessAlertUseCase) sendSlackNotification(ctx context.Context, alert *entity.Alert, slackUserIDs []string) (entity.NotifyResult, error) {
 	// Use subscriber-aware notifier if available and we have matching subscribers
 	if uc.slackNotifier != nil && len(slackUserIDs) > 0 {
+		if err := uc.admit(ctx, "slack", alert); err != nil {
+			return entity.NotifyResult{}, err
+		}
 		return uc.slackNotifier.NotifyWithMentions(ctx, alert, slackUserIDs)
 	}
 
@@ -295,13 +764,28 @@ func (uc *ProcessAlertUseCase) sendSlackNotification(ctx context.Context, alert
 		}
 	}
 
-	return "", fmt.Errorf("slack notifier not found")
+	return entity.NotifyResult{}, fmt.Errorf("slack notifier not found")
+}
+
+// admit asks the named notifier whether it takes the alert, for
+// subscriber notifications that bypass its Notify.
+func (uc *ProcessAlertUseCase) admit(ctx context.Context, name string, alert *entity.Alert) error {
+	for _, notifier := range uc.notifiers {
+		if admitter, ok := notifier.(NotificationAdmitter); ok && notifier.Name() == name {
+			return admitter.Admit(ctx, alert)
+		}
+	}
+	return nil
 }
 
 // sendPagerDutyNotification sends PagerDuty notifications to subscribers sequentially.
-func (uc *ProcessAlertUseCase) sendPagerDutyNotification(ctx context.Context, alert *entity.Alert, subscribers []service.UseCaseMatchedSubscriber) (string, error) {
+func (uc *ProcessAlertUseCase) sendPagerDutyNotification(ctx context.Context, alert *entity.Alert, subscribers []service.UseCaseMatchedSubscriber) (entity.NotifyResult, error) {
 	// Use subscriber-aware notifier if available and we have matching subscribers
 	if uc.pagerDutyNotifier != nil && len(subscribers) > 0 {
+		if err := uc.admit(ctx, "pagerduty", alert); err != nil {
+			return entity.NotifyResult{}, err
+		}
+
 		// Convert to PagerDuty notification format
 		pdNotifications := make([]PagerDutySubscriberNotification, len(subscribers))
 		for i, sub := range subscribers {
@@ -339,7 +823,7 @@ func (uc *ProcessAlertUseCase) sendPagerDutyNotification(ctx context.Context, al
 
 		// Return the first dedup key for tracking
 		if firstDedupKey != "" {
-			return firstDedupKey, nil
+			return entity.NotifyResult{ReferenceID: firstDedupKey, DeliveredAt: time.Now()}, nil
 		}
 	}
 
@@ -350,27 +834,44 @@ func (uc *ProcessAlertUseCase) sendPagerDutyNotification(ctx context.Context, al
 		}
 	}
 
-	return "", fmt.Errorf("pagerduty notifier not found")
+	return entity.NotifyResult{}, fmt.Errorf("pagerduty notifier not found")
 }
 
 // updateNotifications updates existing notifications for resolved/acked alerts.
 func (uc *ProcessAlertUseCase) updateNotifications(ctx context.Context, alert *entity.Alert, output *dto.ProcessAlertOutput) {
+	var notifiers []Notifier
 	for _, notifier := range uc.notifiers {
-		messageID := uc.getMessageID(alert, notifier.Name())
-		if messageID == "" {
-			continue
+		if uc.getMessageID(alert, notifier.Name()) != "" {
+			notifiers = append(notifiers, notifier)
 		}
+	}
 
-		if err := notifier.UpdateMessage(ctx, messageID, alert); err != nil {
+	results := uc.callNotifiers(notifiers, func(notifier Notifier) notifierResult {
+		updateCtx, span := observability.StartSpan(ctx, "update "+notifier.Name(),
+			attribute.String("notifier", notifier.Name()),
+			attribute.String("alert.id", alert.ID),
+		)
+		err := notifier.UpdateMessage(updateCtx, uc.getMessageID(alert, notifier.Name()), alert)
+		observability.EndSpan(span, err)
+		return notifierResult{err: err}
+	})
+
+	for i, notifier := range notifiers {
+		messageID, err := uc.getMessageID(alert, notifier.Name()), results[i].err
+		if err != nil {
 			uc.logger.Error("failed to update notification",
 				"notifier", notifier.Name(),
 				"alertID", alert.ID,
 				"messageID", messageID,
 				"error", err,
 			)
+			uc.timeline.Record(ctx, alert.ID, entity.AlertEventNotificationFailed, "",
+				fmt.Sprintf("%s update: %v", notifier.Name(), err))
+			uc.queueRetry(ctx, alert, notifier.Name(), entity.NotificationActionUpdate, err)
 			output.NotificationsFailed = append(output.NotificationsFailed, dto.NotificationError{
 				NotifierName: notifier.Name(),
 				Error:        err,
+				Retriable:    domainerrors.IsTransientError(err),
 			})
 			continue
 		}
@@ -379,9 +880,19 @@ func (uc *ProcessAlertUseCase) updateNotifications(ctx context.Context, alert *e
 	}
 }
 
-// storeMessageID stores the message ID for a notifier.
-func (uc *ProcessAlertUseCase) storeMessageID(ctx context.Context, alert *entity.Alert, notifierName, messageID string) {
-	alert.SetExternalReference(notifierName, messageID)
+// queueRetry hands a failed notifier call to the retry queue, if any.
+// Canary deliveries are best effort and never retried.
+func (uc *ProcessAlertUseCase) queueRetry(ctx context.Context, alert *entity.Alert, notifierName string, action entity.NotificationAction, err error) {
+	if uc.retrier == nil || notifierName == canaryNotifierName {
+		return
+	}
+	uc.retrier.Enqueue(ctx, alert, notifierName, action, err)
+}
+
+// storeMessageID stores the reference of a sent notification, with its
+// channel and permalink.
+func (uc *ProcessAlertUseCase) storeMessageID(ctx context.Context, alert *entity.Alert, notifierName string, result entity.NotifyResult) {
+	alert.PutExternalReference(entity.NewExternalReference(notifierName, result))
 
 	// Update the alert with the new message ID
 	if err := uc.alertRepo.Update(ctx, alert); err != nil {
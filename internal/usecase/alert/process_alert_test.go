@@ -0,0 +1,452 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/adapter/dto"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/firingcache"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/memory"
+)
+
+// barrier releases its callers once want of them are waiting at once, so
+// calls only succeed if they are made in parallel.
+type barrier struct {
+	mu      sync.Mutex
+	waiting int
+	want    int
+	release chan struct{}
+}
+
+func newBarrier(want int) *barrier {
+	return &barrier{want: want, release: make(chan struct{})}
+}
+
+func (b *barrier) wait() error {
+	b.mu.Lock()
+	b.waiting++
+	if b.waiting == b.want {
+		close(b.release)
+	}
+	b.mu.Unlock()
+
+	select {
+	case <-b.release:
+		return nil
+	case <-time.After(time.Second):
+		return errors.New("not called in parallel")
+	}
+}
+
+type barrierNotifier struct {
+	name    string
+	barrier *barrier
+}
+
+func (n *barrierNotifier) Notify(_ context.Context, alert *entity.Alert) (entity.NotifyResult, error) {
+	if err := n.barrier.wait(); err != nil {
+		return entity.NotifyResult{}, err
+	}
+	return entity.NotifyResult{ReferenceID: "msg-" + alert.Fingerprint}, nil
+}
+
+func (n *barrierNotifier) UpdateMessage(context.Context, string, *entity.Alert) error {
+	return nil
+}
+
+func (n *barrierNotifier) Name() string { return n.name }
+
+func TestProcessAlertUseCase_Concurrency(t *testing.T) {
+	ctx := context.Background()
+	firing := func(fingerprint string) dto.ProcessAlertInput {
+		return dto.ProcessAlertInput{
+			Fingerprint: fingerprint,
+			Name:        "HighCPU",
+			Severity:    entity.SeverityWarning,
+			Status:      "firing",
+		}
+	}
+
+	t.Run("notifiers are called in parallel", func(t *testing.T) {
+		b := newBarrier(2)
+		notifiers := []Notifier{&barrierNotifier{name: "webhook-a", barrier: b}, &barrierNotifier{name: "webhook-b", barrier: b}}
+		uc := NewProcessAlertUseCase(memory.NewAlertRepository(), memory.NewSilenceRepository(), notifiers, nopLogger{}, nil)
+		uc.SetConcurrency(4)
+
+		output, err := uc.Execute(ctx, firing("fp-1"))
+		require.NoError(t, err)
+		assert.Empty(t, output.NotificationsFailed)
+		assert.Equal(t, []string{"webhook-a", "webhook-b"}, output.NotificationsSent)
+	})
+
+	t.Run("alerts of a batch are processed concurrently, in order per fingerprint", func(t *testing.T) {
+		repo := memory.NewAlertRepository()
+		notifiers := []Notifier{&barrierNotifier{name: "webhook", barrier: newBarrier(2)}}
+		uc := NewProcessAlertUseCase(repo, memory.NewSilenceRepository(), notifiers, nopLogger{}, nil)
+		uc.SetConcurrency(4)
+
+		resolved := firing("fp-1")
+		resolved.Status = "resolved"
+		outputs, errs := uc.ExecuteAll(ctx, []dto.ProcessAlertInput{firing("fp-1"), firing("fp-2"), resolved})
+		for i, err := range errs {
+			require.NoError(t, err, "input %d", i)
+		}
+		assert.Equal(t, []string{"webhook"}, outputs[0].NotificationsSent)
+		assert.Equal(t, []string{"webhook"}, outputs[1].NotificationsSent)
+		// The resolve updated the message of the firing alert
+		assert.Equal(t, []string{"webhook"}, outputs[2].NotificationsSent)
+
+		alerts, err := repo.FindByFingerprint(ctx, "fp-1")
+		require.NoError(t, err)
+		require.Len(t, alerts, 1)
+		assert.True(t, alerts[0].IsResolved())
+	})
+}
+
+// countingAlerts counts fingerprint lookups.
+type countingAlerts struct {
+	*memory.AlertRepository
+	finds int
+}
+
+func (r *countingAlerts) FindByFingerprint(ctx context.Context, fingerprint string) ([]*entity.Alert, error) {
+	r.finds++
+	return r.AlertRepository.FindByFingerprint(ctx, fingerprint)
+}
+
+func TestProcessAlertUseCase_FiringCache(t *testing.T) {
+	ctx := context.Background()
+	stored := &countingAlerts{AlertRepository: memory.NewAlertRepository()}
+	cache := firingcache.NewAlertRepository(stored, time.Minute)
+	uc := NewProcessAlertUseCase(cache, memory.NewSilenceRepository(), nil, nopLogger{}, nil)
+	uc.SetFiringCache(cache)
+
+	input := dto.ProcessAlertInput{
+		Fingerprint: "fp-1",
+		Name:        "HighCPU",
+		Severity:    entity.SeverityWarning,
+		Status:      "firing",
+	}
+	first, err := uc.Execute(ctx, input)
+	require.NoError(t, err)
+	require.True(t, first.IsNew)
+	require.Equal(t, 1, stored.finds)
+
+	// Duplicates skip storage
+	for i := 0; i < 3; i++ {
+		output, err := uc.Execute(ctx, input)
+		require.NoError(t, err)
+		assert.Equal(t, first.AlertID, output.AlertID)
+		assert.False(t, output.IsNew)
+	}
+	assert.Equal(t, 1, stored.finds)
+
+	// A new severity and a resolution read storage
+	input.Severity = entity.SeverityCritical
+	_, err = uc.Execute(ctx, input)
+	require.NoError(t, err)
+	assert.Equal(t, 2, stored.finds)
+
+	input.Status = "resolved"
+	_, err = uc.Execute(ctx, input)
+	require.NoError(t, err)
+	assert.Equal(t, 3, stored.finds)
+
+	// Firing again after the resolution creates a new alert
+	input.Status = "firing"
+	again, err := uc.Execute(ctx, input)
+	require.NoError(t, err)
+	assert.True(t, again.IsNew)
+	assert.NotEqual(t, first.AlertID, again.AlertID)
+}
+
+// resultNotifier returns the same result for every alert.
+type resultNotifier struct {
+	name   string
+	result entity.NotifyResult
+	err    error
+}
+
+func (n *resultNotifier) Notify(context.Context, *entity.Alert) (entity.NotifyResult, error) {
+	return n.result, n.err
+}
+
+func (n *resultNotifier) UpdateMessage(context.Context, string, *entity.Alert) error { return nil }
+
+func (n *resultNotifier) Name() string { return n.name }
+
+func TestProcessAlertUseCase_Deliveries(t *testing.T) {
+	ctx := context.Background()
+	delivered := entity.NotifyResult{
+		ReferenceID: "<msg-1@example.com>",
+		Channel:     "oncall@example.com",
+		DeliveredAt: time.Now(),
+	}
+	notifiers := []Notifier{
+		&resultNotifier{name: "email", result: delivered},
+		&resultNotifier{name: "teams", result: entity.NotifyResult{Retriable: true}, err: errors.New("timeout")},
+	}
+	alertRepo := memory.NewAlertRepository()
+	uc := NewProcessAlertUseCase(alertRepo, memory.NewSilenceRepository(), notifiers, nopLogger{}, nil)
+
+	output, err := uc.Execute(ctx, dto.ProcessAlertInput{
+		Fingerprint: "fp-1",
+		Name:        "HighCPU",
+		Severity:    entity.SeverityWarning,
+		Status:      "firing",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []dto.NotificationDelivery{{NotifierName: "email", Result: delivered}}, output.Deliveries)
+	require.Len(t, output.NotificationsFailed, 1)
+	assert.True(t, output.NotificationsFailed[0].Retriable)
+
+	stored, err := alertRepo.FindByID(ctx, output.AlertID)
+	require.NoError(t, err)
+	assert.Equal(t, delivered.ReferenceID, stored.GetExternalReference("email"))
+	ref, ok := stored.ExternalReferences.Get("email", "")
+	require.True(t, ok)
+	assert.Equal(t, delivered.Channel, ref.Channel)
+	assert.True(t, delivered.DeliveredAt.Equal(ref.CreatedAt))
+}
+
+// threadReplies records thread replies, failing them with err.
+type threadReplies struct {
+	replies map[string]string
+	err     error
+}
+
+func (n *threadReplies) PostThreadReply(_ context.Context, messageID, text string) error {
+	if n.err != nil {
+		return n.err
+	}
+	n.replies[messageID] = text
+	return nil
+}
+
+func TestProcessAlertUseCase_ProcessGroup(t *testing.T) {
+	ctx := context.Background()
+	t0 := time.Date(2026, 10, 10, 12, 0, 0, 0, time.UTC)
+	now := t0
+
+	alertRepo := memory.NewAlertRepository()
+	notifiers := []Notifier{&resultNotifier{name: "slack", result: entity.NotifyResult{ReferenceID: "ts-1"}}}
+	uc := NewProcessAlertUseCase(alertRepo, memory.NewSilenceRepository(), notifiers, nopLogger{}, nil)
+	uc.now = func() time.Time { return now }
+
+	fire := func(fingerprint, groupKey string, endsAt time.Time) string {
+		output, err := uc.Execute(ctx, dto.ProcessAlertInput{
+			Fingerprint: fingerprint,
+			Name:        "HighCPU",
+			Severity:    entity.SeverityWarning,
+			Status:      "firing",
+			FiredAt:     t0.Add(-time.Hour),
+			EndsAt:      endsAt,
+			GroupKey:    groupKey,
+		})
+		require.NoError(t, err)
+		return output.AlertID
+	}
+	listed := fire("fp-listed", "group-1", time.Time{})
+	ended := fire("fp-ended", "group-1", t0.Add(5*time.Minute))
+	open := fire("fp-open", "group-1", time.Time{})
+	other := fire("fp-other", "group-2", time.Time{})
+
+	t.Run("unlisted alerts resolve with their group", func(t *testing.T) {
+		now = t0.Add(time.Hour)
+		output, err := uc.ProcessGroup(ctx, dto.ProcessAlertGroupInput{
+			GroupKey:     "group-1",
+			Status:       "resolved",
+			Fingerprints: []string{"fp-listed"},
+			AlertIDs:     []string{listed},
+		})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{ended, open}, output.ResolvedAlertIDs)
+		assert.False(t, output.TruncationReported)
+
+		resolvedAt := func(id string) *time.Time {
+			alert, err := alertRepo.FindByID(ctx, id)
+			require.NoError(t, err)
+			return alert.ResolvedAt
+		}
+		// The last EndsAt is used when it passed, as for listed alerts
+		require.NotNil(t, resolvedAt(ended))
+		assert.Equal(t, t0.Add(5*time.Minute), *resolvedAt(ended))
+		require.NotNil(t, resolvedAt(open))
+		assert.Equal(t, now, *resolvedAt(open))
+		assert.Nil(t, resolvedAt(listed))
+		assert.Nil(t, resolvedAt(other))
+	})
+
+	t.Run("a firing group resolves nothing", func(t *testing.T) {
+		output, err := uc.ProcessGroup(ctx, dto.ProcessAlertGroupInput{GroupKey: "group-2", Status: "firing"})
+		require.NoError(t, err)
+		assert.Empty(t, output.ResolvedAlertIDs)
+	})
+
+	t.Run("truncation is reported in the first thread", func(t *testing.T) {
+		input := dto.ProcessAlertGroupInput{
+			GroupKey:        "group-2",
+			Status:          "firing",
+			TruncatedAlerts: 3,
+			AlertIDs:        []string{"unknown", other},
+		}
+
+		// Without a thread notifier there is nowhere to report it
+		output, err := uc.ProcessGroup(ctx, input)
+		require.NoError(t, err)
+		assert.False(t, output.TruncationReported)
+
+		replies := &threadReplies{replies: make(map[string]string)}
+		uc.SetThreadNotifier(replies)
+		output, err = uc.ProcessGroup(ctx, input)
+		require.NoError(t, err)
+		assert.True(t, output.TruncationReported)
+		assert.Contains(t, replies.replies["ts-1"], "3 more alert(s)")
+
+		replies.err = errors.New("channel_not_found")
+		output, err = uc.ProcessGroup(ctx, input)
+		require.NoError(t, err)
+		assert.False(t, output.TruncationReported)
+	})
+
+	t.Run("alerts belong to the group that last carried them", func(t *testing.T) {
+		moved := fire("fp-moved", "group-3", time.Time{})
+		require.Equal(t, moved, fire("fp-moved", "group-4", time.Time{}))
+
+		output, err := uc.ProcessGroup(ctx, dto.ProcessAlertGroupInput{GroupKey: "group-3", Status: "resolved"})
+		require.NoError(t, err)
+		assert.Empty(t, output.ResolvedAlertIDs)
+
+		output, err = uc.ProcessGroup(ctx, dto.ProcessAlertGroupInput{GroupKey: "group-4", Status: "resolved"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{moved}, output.ResolvedAlertIDs)
+	})
+}
+
+func TestProcessAlertUseCase_SeverityChange(t *testing.T) {
+	ctx := context.Background()
+	t0 := time.Date(2026, 10, 10, 12, 0, 0, 0, time.UTC)
+	now := t0
+
+	alertRepo := memory.NewAlertRepository()
+	eventRepo := memory.NewAlertEventRepository()
+	uc := NewProcessAlertUseCase(alertRepo, memory.NewSilenceRepository(), nil, nopLogger{}, nil)
+	uc.SetTimeline(NewTimeline(eventRepo, nopLogger{}))
+	uc.now = func() time.Time { return now }
+
+	input := dto.ProcessAlertInput{
+		Fingerprint: "fp-1",
+		Name:        "DiskFull",
+		Severity:    entity.SeverityWarning,
+		Status:      "firing",
+	}
+	first, err := uc.Execute(ctx, input)
+	require.NoError(t, err)
+
+	now = t0.Add(10 * time.Minute)
+	input.Severity = entity.SeverityCritical
+	escalated, err := uc.Execute(ctx, input)
+	require.NoError(t, err)
+	assert.False(t, escalated.IsNew)
+	assert.Equal(t, first.AlertID, escalated.AlertID)
+
+	stored, err := alertRepo.FindByID(ctx, first.AlertID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.SeverityCritical, stored.Severity)
+	require.Len(t, stored.History, 1)
+	transition := stored.History[0]
+	assert.True(t, transition.IsSeverityChange())
+	assert.Equal(t, entity.SeverityWarning, transition.PreviousSeverity)
+	assert.Equal(t, entity.SeverityCritical, transition.Severity)
+	assert.True(t, transition.At.Equal(now))
+
+	events, err := eventRepo.FindByAlertID(ctx, first.AlertID)
+	require.NoError(t, err)
+	var details []string
+	for _, event := range events {
+		if event.Type == entity.AlertEventSeverityChanged {
+			details = append(details, event.Detail)
+		}
+	}
+	assert.Equal(t, []string{"warning → critical"}, details)
+}
+
+func TestProcessAlertUseCase_EndsAt(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 10, 12, 0, 0, 0, time.UTC)
+	firedAt := now.Add(-time.Hour)
+
+	tests := []struct {
+		name           string
+		status         string
+		endsAt         time.Time
+		wantResolvedAt time.Time // Zero if the alert stays firing
+	}{
+		{"firing with past EndsAt resolves at EndsAt", "firing", now.Add(-10 * time.Minute), now.Add(-10 * time.Minute)},
+		{"firing with EndsAt within the grace stays firing", "firing", now.Add(-30 * time.Second), time.Time{}},
+		{"firing with zero EndsAt stays firing", "firing", time.Time{}, time.Time{}},
+		{"firing with future EndsAt stays firing", "firing", now.Add(5 * time.Minute), time.Time{}},
+		{"resolved with past EndsAt resolves at EndsAt", "resolved", now.Add(-10 * time.Minute), now.Add(-10 * time.Minute)},
+		{"resolved with zero EndsAt resolves now", "resolved", time.Time{}, now},
+		{"resolved with future EndsAt resolves now", "resolved", now.Add(5 * time.Minute), now},
+		{"resolved with EndsAt before firing resolves now", "resolved", firedAt.Add(-time.Minute), now},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			alertRepo := memory.NewAlertRepository()
+			uc := NewProcessAlertUseCase(alertRepo, memory.NewSilenceRepository(), nil, nopLogger{}, nil)
+			uc.now = func() time.Time { return now }
+
+			input := dto.ProcessAlertInput{
+				Fingerprint: "fp-1",
+				Name:        "HighCPU",
+				Severity:    entity.SeverityWarning,
+				Status:      "firing",
+				FiredAt:     firedAt,
+			}
+			first, err := uc.Execute(ctx, input)
+			require.NoError(t, err)
+
+			input.Status = tt.status
+			input.EndsAt = tt.endsAt
+			_, err = uc.Execute(ctx, input)
+			require.NoError(t, err)
+
+			alert, err := alertRepo.FindByID(ctx, first.AlertID)
+			require.NoError(t, err)
+			if tt.wantResolvedAt.IsZero() {
+				assert.False(t, alert.IsResolved())
+				return
+			}
+			require.True(t, alert.IsResolved())
+			assert.Equal(t, tt.wantResolvedAt, *alert.ResolvedAt)
+		})
+	}
+
+	t.Run("expired firing alert without a stored alert creates none", func(t *testing.T) {
+		alertRepo := memory.NewAlertRepository()
+		uc := NewProcessAlertUseCase(alertRepo, memory.NewSilenceRepository(), nil, nopLogger{}, nil)
+		uc.now = func() time.Time { return now }
+
+		output, err := uc.Execute(ctx, dto.ProcessAlertInput{
+			Fingerprint: "fp-1",
+			Name:        "HighCPU",
+			Severity:    entity.SeverityWarning,
+			Status:      "firing",
+			FiredAt:     firedAt,
+			EndsAt:      now.Add(-10 * time.Minute),
+		})
+		require.NoError(t, err)
+		assert.False(t, output.IsNew)
+		alerts, err := alertRepo.FindByFingerprint(ctx, "fp-1")
+		require.NoError(t, err)
+		assert.Empty(t, alerts)
+	})
+}
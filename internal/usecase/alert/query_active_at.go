@@ -0,0 +1,102 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+)
+
+// QueryActiveAtInput represents a point-in-time alert state query.
+type QueryActiveAtInput struct {
+	At time.Time
+
+	// Severity optionally restricts results to the severity in effect at At.
+	Severity entity.AlertSeverity
+}
+
+// QueryActiveAtOutput lists the alerts firing at the requested time.
+type QueryActiveAtOutput struct {
+	At        time.Time
+	Snapshots []*entity.AlertSnapshot
+}
+
+// QueryActiveAtUseCase answers "which alerts were firing at time T" by
+// replaying each candidate alert's history and ack events up to T.
+type QueryActiveAtUseCase struct {
+	alertRepo    repository.AlertRepository
+	ackEventRepo repository.AckEventRepository
+}
+
+// NewQueryActiveAtUseCase creates a new QueryActiveAtUseCase.
+func NewQueryActiveAtUseCase(alertRepo repository.AlertRepository, ackEventRepo repository.AckEventRepository) *QueryActiveAtUseCase {
+	return &QueryActiveAtUseCase{
+		alertRepo:    alertRepo,
+		ackEventRepo: ackEventRepo,
+	}
+}
+
+// Execute returns snapshots of the alerts firing at input.At, oldest first.
+func (uc *QueryActiveAtUseCase) Execute(ctx context.Context, input QueryActiveAtInput) (*QueryActiveAtOutput, error) {
+	candidates, err := uc.alertRepo.FindActiveAt(ctx, input.At)
+	if err != nil {
+		return nil, fmt.Errorf("finding alerts: %w", err)
+	}
+
+	output := &QueryActiveAtOutput{At: input.At, Snapshots: make([]*entity.AlertSnapshot, 0, len(candidates))}
+	for _, alert := range candidates {
+		snap, ok := alert.SnapshotAt(input.At)
+		if !ok || !snap.IsFiring() {
+			continue
+		}
+		if input.Severity != "" && snap.Severity != input.Severity {
+			continue
+		}
+		if snap.State == entity.StateAcked {
+			if err := uc.attributeAck(ctx, snap); err != nil {
+				return nil, err
+			}
+		}
+		output.Snapshots = append(output.Snapshots, snap)
+	}
+
+	sort.Slice(output.Snapshots, func(i, j int) bool {
+		return output.Snapshots[i].Alert.FiredAt.Before(output.Snapshots[j].Alert.FiredAt)
+	})
+
+	return output, nil
+}
+
+// attributeAck names the acknowledger from the ack history, which carries
+// display names where the alert's own history may only have a user ID.
+func (uc *QueryActiveAtUseCase) attributeAck(ctx context.Context, snap *entity.AlertSnapshot) error {
+	events, err := uc.ackEventRepo.FindByAlertID(ctx, snap.Alert.ID)
+	if err != nil {
+		return fmt.Errorf("finding ack events: %w", err)
+	}
+
+	var latest *entity.AckEvent
+	for _, event := range events {
+		if !event.CreatedAt.After(snap.At) {
+			latest = event
+		}
+	}
+	if latest == nil {
+		return nil
+	}
+
+	switch {
+	case latest.UserName != "":
+		snap.AckedBy = latest.UserName
+	case latest.UserEmail != "":
+		snap.AckedBy = latest.UserEmail
+	}
+	if snap.AckedAt == nil {
+		at := latest.CreatedAt
+		snap.AckedAt = &at
+	}
+	return nil
+}
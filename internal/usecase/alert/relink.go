@@ -0,0 +1,186 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+)
+
+// relinkMatchWindow is how far a message may be posted from the alert's
+// creation to be matched by name alone.
+const relinkMatchWindow = 2 * time.Minute
+
+// PostedMessage is an alert message found in a notifier's history.
+type PostedMessage struct {
+	MessageID string
+
+	// AlertID is empty when the message does not carry it, such as a
+	// resolved Slack message posted before resolved messages kept the
+	// history button.
+	AlertID   string
+	AlertName string
+	PostedAt  time.Time
+}
+
+// MessageFinder lists the alert messages a notifier posted.
+// Implemented by the Slack client.
+type MessageFinder interface {
+	FindAlertMessages(ctx context.Context, since time.Time) ([]PostedMessage, error)
+}
+
+// IncidentFinder looks up the incident of an alert.
+// Implemented by the PagerDuty client.
+type IncidentFinder interface {
+	FindIncidentKey(ctx context.Context, alert *entity.Alert) (key string, found bool, err error)
+}
+
+// RelinkInput selects the alerts to repair.
+type RelinkInput struct {
+	// Since limits the repair to alerts firing or changed since this time,
+	// and the message search to messages posted since then.
+	Since time.Time
+
+	// DryRun reports the links without saving them.
+	DryRun bool
+}
+
+// Relink is a restored external reference.
+type Relink struct {
+	AlertID   string
+	AlertName string
+	System    string
+	Reference string
+}
+
+// RelinkOutput reports the outcome of a repair.
+type RelinkOutput struct {
+	// Scanned counts the alerts missing at least one reference.
+	Scanned int
+
+	Relinked []Relink
+
+	// Unmatched counts the missing references no message or incident was
+	// found for.
+	Unmatched int
+}
+
+// RelinkReferencesUseCase restores the Slack message and PagerDuty incident
+// references of alerts that lost them, e.g. after restoring storage from a
+// backup, so that later updates edit the original notifications again.
+type RelinkReferencesUseCase struct {
+	alertRepo repository.AlertRepository
+	messages  MessageFinder
+	incidents IncidentFinder
+}
+
+// NewRelinkReferencesUseCase creates a new RelinkReferencesUseCase. A nil
+// finder skips that system.
+func NewRelinkReferencesUseCase(alertRepo repository.AlertRepository, messages MessageFinder, incidents IncidentFinder) *RelinkReferencesUseCase {
+	return &RelinkReferencesUseCase{
+		alertRepo: alertRepo,
+		messages:  messages,
+		incidents: incidents,
+	}
+}
+
+// Execute relinks the alerts selected by input.
+func (uc *RelinkReferencesUseCase) Execute(ctx context.Context, input RelinkInput) (*RelinkOutput, error) {
+	alerts, err := uc.alertRepo.FindChangedSince(ctx, input.Since)
+	if err != nil {
+		return nil, fmt.Errorf("finding alerts: %w", err)
+	}
+
+	var posted []PostedMessage
+	if uc.messages != nil {
+		posted, err = uc.messages.FindAlertMessages(ctx, input.Since)
+		if err != nil {
+			return nil, fmt.Errorf("finding slack messages: %w", err)
+		}
+	}
+
+	// Messages still referenced by an alert are not candidates
+	claimed := make(map[string]bool)
+	for _, alert := range alerts {
+		if ref := alert.GetExternalReference("slack"); ref != "" {
+			claimed[ref] = true
+		}
+	}
+
+	output := &RelinkOutput{}
+	for _, alert := range alerts {
+		missingSlack := uc.messages != nil && !alert.HasExternalReference("slack")
+		missingPagerDuty := uc.incidents != nil && !alert.HasExternalReference("pagerduty")
+		if !missingSlack && !missingPagerDuty {
+			continue
+		}
+		output.Scanned++
+
+		var relinks []Relink
+		if missingSlack {
+			if messageID, ok := matchPostedMessage(alert, posted, claimed); ok {
+				claimed[messageID] = true
+				relinks = append(relinks, Relink{System: "slack", Reference: messageID})
+			} else {
+				output.Unmatched++
+			}
+		}
+		if missingPagerDuty {
+			key, found, err := uc.incidents.FindIncidentKey(ctx, alert)
+			if err != nil {
+				return nil, fmt.Errorf("finding pagerduty incident of alert %s: %w", alert.ID, err)
+			}
+			if found {
+				relinks = append(relinks, Relink{System: "pagerduty", Reference: key})
+			} else {
+				output.Unmatched++
+			}
+		}
+		if len(relinks) == 0 {
+			continue
+		}
+
+		for i := range relinks {
+			relinks[i].AlertID = alert.ID
+			relinks[i].AlertName = alert.Name
+			alert.SetExternalReference(relinks[i].System, relinks[i].Reference)
+		}
+		if !input.DryRun {
+			if err := uc.alertRepo.Update(ctx, alert); err != nil {
+				return nil, fmt.Errorf("updating alert %s: %w", alert.ID, err)
+			}
+		}
+		output.Relinked = append(output.Relinked, relinks...)
+	}
+
+	return output, nil
+}
+
+// matchPostedMessage finds the alert's message: the one carrying its ID or,
+// failing that, the only unclaimed message with its name posted around its
+// creation.
+func matchPostedMessage(alert *entity.Alert, posted []PostedMessage, claimed map[string]bool) (string, bool) {
+	for _, msg := range posted {
+		if msg.AlertID == alert.ID {
+			return msg.MessageID, true
+		}
+	}
+
+	var match string
+	for _, msg := range posted {
+		if msg.AlertID != "" || msg.AlertName != alert.Name || claimed[msg.MessageID] {
+			continue
+		}
+		if d := msg.PostedAt.Sub(alert.CreatedAt); d < -relinkMatchWindow || d > relinkMatchWindow {
+			continue
+		}
+		if match != "" {
+			// Ambiguous
+			return "", false
+		}
+		match = msg.MessageID
+	}
+	return match, match != ""
+}
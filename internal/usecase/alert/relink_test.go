@@ -0,0 +1,68 @@
+package alert
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+func TestMatchPostedMessage(t *testing.T) {
+	alert := entity.NewAlert("fp", "HighCPU", "host-1", "", "summary", entity.SeverityWarning)
+	postedAt := alert.CreatedAt.Add(time.Second)
+
+	tests := []struct {
+		name    string
+		posted  []PostedMessage
+		claimed map[string]bool
+		wantID  string
+		wantOK  bool
+	}{
+		{
+			name: "alert ID wins over name",
+			posted: []PostedMessage{
+				{MessageID: "C1:1", AlertName: "HighCPU", PostedAt: postedAt},
+				{MessageID: "C1:2", AlertID: alert.ID, AlertName: "HighCPU", PostedAt: postedAt.Add(time.Hour)},
+			},
+			wantID: "C1:2",
+			wantOK: true,
+		},
+		{
+			name:   "resolved message matched by name and time",
+			posted: []PostedMessage{{MessageID: "C1:1", AlertName: "HighCPU", PostedAt: postedAt}},
+			wantID: "C1:1",
+			wantOK: true,
+		},
+		{
+			name:   "posted long after creation",
+			posted: []PostedMessage{{MessageID: "C1:1", AlertName: "HighCPU", PostedAt: postedAt.Add(time.Hour)}},
+		},
+		{
+			name:   "message of another alert",
+			posted: []PostedMessage{{MessageID: "C1:1", AlertID: "other", AlertName: "HighCPU", PostedAt: postedAt}},
+		},
+		{
+			name:    "claimed message",
+			posted:  []PostedMessage{{MessageID: "C1:1", AlertName: "HighCPU", PostedAt: postedAt}},
+			claimed: map[string]bool{"C1:1": true},
+		},
+		{
+			name: "ambiguous name match",
+			posted: []PostedMessage{
+				{MessageID: "C1:1", AlertName: "HighCPU", PostedAt: postedAt},
+				{MessageID: "C2:1", AlertName: "HighCPU", PostedAt: postedAt},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, ok := matchPostedMessage(alert, tt.posted, tt.claimed)
+
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantID, id)
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package alert
+
+import (
+	"context"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	domainerrors "github.com/altuslabsxyz/alert-bridge/internal/domain/errors"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+)
+
+// RepostingNotifier wraps a Notifier so that an update of a message that no
+// longer exists, such as a Slack message deleted by hand, posts a fresh
+// message instead of failing on every later state change. The stale
+// reference is replaced with the new message ID.
+type RepostingNotifier struct {
+	notifier  Notifier
+	alertRepo repository.AlertRepository
+	routing   *RoutingTree
+	logger    Logger
+}
+
+// NewRepostingNotifier creates a new RepostingNotifier. The wrapped
+// notifier must report missing messages as not found errors.
+func NewRepostingNotifier(notifier Notifier, alertRepo repository.AlertRepository, logger Logger) *RepostingNotifier {
+	return &RepostingNotifier{
+		notifier:  notifier,
+		alertRepo: alertRepo,
+		logger:    logger,
+	}
+}
+
+// SetRoutingTree re-posts to the channel the routing tree picks for the
+// alert, as for the first notification.
+func (n *RepostingNotifier) SetRoutingTree(tree *RoutingTree) {
+	n.routing = tree
+}
+
+// Notify forwards to the wrapped notifier.
+func (n *RepostingNotifier) Notify(ctx context.Context, alert *entity.Alert) (entity.NotifyResult, error) {
+	return n.notifier.Notify(ctx, alert)
+}
+
+// UpdateMessage updates the message, re-posting it if it was deleted.
+// Resolved alerts only drop the stale reference, since a fresh message
+// would announce an alert that is already over.
+func (n *RepostingNotifier) UpdateMessage(ctx context.Context, messageID string, alert *entity.Alert) error {
+	err := n.notifier.UpdateMessage(ctx, messageID, alert)
+	if err == nil || !domainerrors.IsNotFoundError(err) {
+		return err
+	}
+
+	if alert.IsResolved() {
+		alert.ClearExternalReference(n.Name())
+		n.logger.Info("notification message deleted, dropped its reference",
+			"notifier", n.Name(),
+			"alertID", alert.ID,
+			"messageID", messageID,
+		)
+		return n.alertRepo.Update(ctx, alert)
+	}
+
+	notifyCtx := ctx
+	if n.routing != nil {
+		if channel := n.routing.Route(alert).Channel(n.Name()); channel != "" {
+			notifyCtx = entity.WithNotificationChannel(ctx, channel)
+		}
+	}
+	// The stale reference is kept on failure, so the next update tries
+	// again
+	result, err := n.notifier.Notify(notifyCtx, alert)
+	if err != nil {
+		return err
+	}
+
+	alert.PutExternalReference(entity.NewExternalReference(n.Name(), result))
+	n.logger.Info("notification message deleted, re-posted it",
+		"notifier", n.Name(),
+		"alertID", alert.ID,
+		"messageID", messageID,
+		"newMessageID", result.ReferenceID,
+	)
+	if err := n.alertRepo.Update(ctx, alert); err != nil {
+		// The message is posted; the caller may still save the reference
+		n.logger.Error("failed to store re-posted message ID",
+			"notifier", n.Name(),
+			"alertID", alert.ID,
+			"error", err,
+		)
+	}
+	return nil
+}
+
+// Name returns the wrapped notifier's name.
+func (n *RepostingNotifier) Name() string {
+	return n.notifier.Name()
+}
+
+// Capabilities returns the wrapped notifier's capabilities.
+func (n *RepostingNotifier) Capabilities() entity.NotifierCapabilities {
+	return CapabilitiesOf(n.notifier)
+}
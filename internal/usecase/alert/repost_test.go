@@ -0,0 +1,102 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	domainerrors "github.com/altuslabsxyz/alert-bridge/internal/domain/errors"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/memory"
+)
+
+type deletedMessageNotifier struct {
+	deleted   map[string]bool
+	notifyErr error
+	posted    int
+}
+
+func (n *deletedMessageNotifier) Notify(context.Context, *entity.Alert) (entity.NotifyResult, error) {
+	if n.notifyErr != nil {
+		return entity.NotifyResult{}, n.notifyErr
+	}
+	n.posted++
+	return entity.NotifyResult{ReferenceID: "C1:new"}, nil
+}
+
+func (n *deletedMessageNotifier) UpdateMessage(_ context.Context, messageID string, _ *entity.Alert) error {
+	if n.deleted[messageID] {
+		return domainerrors.Wrap(errors.New("message_not_found"), domainerrors.CategoryNotFound, "updating slack message")
+	}
+	return nil
+}
+
+func (n *deletedMessageNotifier) Name() string { return "slack" }
+
+func TestRepostingNotifier_UpdateMessage(t *testing.T) {
+	ctx := context.Background()
+
+	newAlert := func(t *testing.T, repo *memory.AlertRepository, messageID string) *entity.Alert {
+		alert := entity.NewAlert("fp-"+messageID, "HighCPU", "host-1", "", "summary", entity.SeverityCritical)
+		alert.SetExternalReference("slack", messageID)
+		require.NoError(t, repo.Save(ctx, alert))
+		return alert
+	}
+
+	t.Run("existing message is updated", func(t *testing.T) {
+		repo := memory.NewAlertRepository()
+		inner := &deletedMessageNotifier{}
+		alert := newAlert(t, repo, "C1:old")
+
+		err := NewRepostingNotifier(inner, repo, nopLogger{}).UpdateMessage(ctx, "C1:old", alert)
+		require.NoError(t, err)
+		assert.Zero(t, inner.posted)
+		assert.Equal(t, "C1:old", alert.GetExternalReference("slack"))
+	})
+
+	t.Run("deleted message is re-posted", func(t *testing.T) {
+		repo := memory.NewAlertRepository()
+		inner := &deletedMessageNotifier{deleted: map[string]bool{"C1:old": true}}
+		alert := newAlert(t, repo, "C1:old")
+
+		err := NewRepostingNotifier(inner, repo, nopLogger{}).UpdateMessage(ctx, "C1:old", alert)
+		require.NoError(t, err)
+		assert.Equal(t, 1, inner.posted)
+
+		stored, err := repo.FindByID(ctx, alert.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "C1:new", stored.GetExternalReference("slack"))
+	})
+
+	t.Run("failed re-post keeps the reference", func(t *testing.T) {
+		repo := memory.NewAlertRepository()
+		inner := &deletedMessageNotifier{
+			deleted:   map[string]bool{"C1:old": true},
+			notifyErr: domainerrors.NewTransientError("posting slack message", errors.New("timeout")),
+		}
+		alert := newAlert(t, repo, "C1:old")
+
+		err := NewRepostingNotifier(inner, repo, nopLogger{}).UpdateMessage(ctx, "C1:old", alert)
+		assert.True(t, domainerrors.IsTransientError(err))
+		assert.Equal(t, "C1:old", alert.GetExternalReference("slack"))
+	})
+
+	t.Run("resolved alert only drops the reference", func(t *testing.T) {
+		repo := memory.NewAlertRepository()
+		inner := &deletedMessageNotifier{deleted: map[string]bool{"C1:old": true}}
+		alert := newAlert(t, repo, "C1:old")
+		alert.Resolve(time.Now())
+
+		err := NewRepostingNotifier(inner, repo, nopLogger{}).UpdateMessage(ctx, "C1:old", alert)
+		require.NoError(t, err)
+		assert.Zero(t, inner.posted)
+
+		stored, err := repo.FindByID(ctx, alert.ID)
+		require.NoError(t, err)
+		assert.False(t, stored.HasExternalReference("slack"))
+	})
+}
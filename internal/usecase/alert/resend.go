@@ -0,0 +1,231 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+)
+
+// ResendAction is how an unacknowledged alert is brought back to attention.
+type ResendAction string
+
+const (
+	// ResendReply replies in the alert's Slack thread.
+	ResendReply ResendAction = "reply"
+
+	// ResendRepost posts a fresh alert message, which later updates edit.
+	ResendRepost ResendAction = "repost"
+
+	// ResendNone never resends.
+	ResendNone ResendAction = "none"
+)
+
+// resendReference is the external reference recording when the alert was
+// last resent.
+const resendReference = "slack_resend"
+
+// ResendPolicy resends the alerts of a severity every Interval while they
+// stay active and unacknowledged.
+type ResendPolicy struct {
+	Interval time.Duration
+	Action   ResendAction
+
+	// Mention is prepended to the reminder, in Slack syntax such as
+	// "<!here>" (optional).
+	Mention string
+}
+
+// ResendScheduler reminds responders of active, unacknowledged alerts by
+// replying in their Slack thread or re-posting them, per severity.
+//
+// Like the escalation engine it keeps no state of its own: the time of the
+// last reminder is an external reference of the alert, so reminders keep
+// their pace across restarts.
+type ResendScheduler struct {
+	alertRepo      repository.AlertRepository
+	silenceRepo    repository.SilenceRepository
+	notifier       Notifier
+	threadNotifier ThreadNotifier
+	policies       map[entity.AlertSeverity]ResendPolicy
+	interval       time.Duration
+	logger         Logger
+	now            func() time.Time
+
+	// Channel selection for re-posts (optional)
+	routing *RoutingTree
+}
+
+// NewResendScheduler creates a scheduler that checks alerts every interval.
+// notifier posts fresh messages and threadNotifier posts replies; both are
+// the Slack client. Severities without a policy are not resent.
+func NewResendScheduler(
+	alertRepo repository.AlertRepository,
+	silenceRepo repository.SilenceRepository,
+	notifier Notifier,
+	threadNotifier ThreadNotifier,
+	policies map[entity.AlertSeverity]ResendPolicy,
+	interval time.Duration,
+	logger Logger,
+) *ResendScheduler {
+	return &ResendScheduler{
+		alertRepo:      alertRepo,
+		silenceRepo:    silenceRepo,
+		notifier:       notifier,
+		threadNotifier: threadNotifier,
+		policies:       policies,
+		interval:       interval,
+		logger:         logger,
+		now:            time.Now,
+	}
+}
+
+// SetRoutingTree re-posts to the channel the routing tree picks for the
+// alert, as for the first notification.
+func (s *ResendScheduler) SetRoutingTree(tree *RoutingTree) {
+	s.routing = tree
+}
+
+// Run checks alerts every interval until ctx is cancelled.
+func (s *ResendScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.Sweep(ctx)
+		}
+	}
+}
+
+// Sweep resends every alert that is due.
+func (s *ResendScheduler) Sweep(ctx context.Context) {
+	alerts, err := s.alertRepo.FindFiring(ctx)
+	if err != nil {
+		s.logger.Error("failed to load firing alerts for resend", "error", err)
+		return
+	}
+
+	now := s.now()
+	for _, alert := range alerts {
+		if !alert.IsActive() || !alert.HasExternalReference(s.notifier.Name()) {
+			continue
+		}
+		policy, ok := s.policies[alert.Severity]
+		if !ok || policy.Action == ResendNone || policy.Interval <= 0 {
+			continue
+		}
+		if now.Sub(s.lastSent(alert)) < policy.Interval {
+			continue
+		}
+
+		// Silenced alerts are not notified on ingestion, so they are not
+		// resent either
+		silences, err := s.silenceRepo.FindMatchingAlert(ctx, alert)
+		if err != nil {
+			s.logger.Warn("failed to check silences for resend",
+				"alertID", alert.ID,
+				"error", err,
+			)
+			continue
+		}
+		if len(silences) > 0 {
+			continue
+		}
+
+		s.resend(ctx, alert, policy, now)
+	}
+}
+
+// lastSent is when the alert was last resent, or first notified.
+func (s *ResendScheduler) lastSent(alert *entity.Alert) time.Time {
+	if sent, err := time.Parse(time.RFC3339, alert.GetExternalReference(resendReference)); err == nil {
+		return sent
+	}
+	return alert.CreatedAt
+}
+
+func (s *ResendScheduler) resend(ctx context.Context, alert *entity.Alert, policy ResendPolicy, now time.Time) {
+	name := s.notifier.Name()
+	messageID := alert.GetExternalReference(name)
+	refs := map[string]string{resendReference: now.UTC().Format(time.RFC3339)}
+
+	if policy.Action == ResendRepost {
+		notifyCtx := ctx
+		if s.routing != nil {
+			if channel := s.routing.Route(alert).Channel(name); channel != "" {
+				notifyCtx = entity.WithNotificationChannel(ctx, channel)
+			}
+		}
+		result, err := s.notifier.Notify(notifyCtx, alert)
+		if err != nil {
+			s.logger.Error("failed to re-post unacknowledged alert",
+				"alertID", alert.ID,
+				"error", err,
+			)
+			return
+		}
+		messageID = result.ReferenceID
+		refs[name] = result.ReferenceID
+	}
+
+	// A re-post only needs a reply to carry the mention
+	if policy.Action == ResendReply || policy.Mention != "" {
+		if err := s.threadNotifier.PostThreadReply(ctx, messageID, s.reminderText(alert, policy, now)); err != nil {
+			s.logger.Error("failed to post resend reminder",
+				"alertID", alert.ID,
+				"messageID", messageID,
+				"error", err,
+			)
+			if policy.Action == ResendReply {
+				return
+			}
+		}
+	}
+
+	if err := s.storeReferences(ctx, alert, refs); err != nil {
+		s.logger.Error("failed to store resend state",
+			"alertID", alert.ID,
+			"error", err,
+		)
+	}
+
+	s.logger.Info("resent unacknowledged alert",
+		"alertID", alert.ID,
+		"action", policy.Action,
+		"messageID", messageID,
+	)
+}
+
+func (s *ResendScheduler) reminderText(alert *entity.Alert, policy ResendPolicy, now time.Time) string {
+	text := fmt.Sprintf(":bell: Still firing and unacknowledged after %s", now.Sub(alert.CreatedAt).Round(time.Minute))
+	if policy.Mention != "" {
+		text = policy.Mention + " " + text
+	}
+	return text
+}
+
+// storeReferences saves the references on a fresh copy of the alert, so
+// changes made while Slack was called are kept.
+func (s *ResendScheduler) storeReferences(ctx context.Context, alert *entity.Alert, refs map[string]string) error {
+	for system, ref := range refs {
+		alert.SetExternalReference(system, ref)
+	}
+
+	current, err := s.alertRepo.FindByID(ctx, alert.ID)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return entity.ErrAlertNotFound
+	}
+	for system, ref := range refs {
+		current.SetExternalReference(system, ref)
+	}
+	return s.alertRepo.Update(ctx, current)
+}
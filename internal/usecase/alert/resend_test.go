@@ -0,0 +1,86 @@
+package alert
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/memory"
+)
+
+type fakeThreadNotifier struct {
+	replies map[string]string
+}
+
+func (n *fakeThreadNotifier) PostThreadReply(_ context.Context, messageID, text string) error {
+	n.replies[messageID] = text
+	return nil
+}
+
+type repostNotifier struct {
+	posted int
+}
+
+func (n *repostNotifier) Notify(context.Context, *entity.Alert) (entity.NotifyResult, error) {
+	n.posted++
+	return entity.NotifyResult{ReferenceID: "C1:reposted"}, nil
+}
+
+func (n *repostNotifier) UpdateMessage(context.Context, string, *entity.Alert) error { return nil }
+
+func (n *repostNotifier) Name() string { return "slack" }
+
+func TestResendScheduler_Sweep(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	newAlert := func(fingerprint string, severity entity.AlertSeverity, age time.Duration) *entity.Alert {
+		alert := entity.NewAlert(fingerprint, "HighCPU", "host-1", "", "summary", severity)
+		alert.CreatedAt = now.Add(-age)
+		alert.SetExternalReference("slack", "C1:"+fingerprint)
+		return alert
+	}
+
+	repo := memory.NewAlertRepository()
+	due := newAlert("fp1", entity.SeverityWarning, time.Hour)
+	notDue := newAlert("fp2", entity.SeverityWarning, 10*time.Minute)
+	acked := newAlert("fp3", entity.SeverityWarning, time.Hour)
+	require.NoError(t, acked.Acknowledge("alice", now))
+	reposted := newAlert("fp4", entity.SeverityCritical, 20*time.Minute)
+	disabled := newAlert("fp5", entity.SeverityInfo, time.Hour)
+	for _, alert := range []*entity.Alert{due, notDue, acked, reposted, disabled} {
+		require.NoError(t, repo.Save(ctx, alert))
+	}
+
+	notifier := &repostNotifier{}
+	threads := &fakeThreadNotifier{replies: make(map[string]string)}
+	scheduler := NewResendScheduler(repo, memory.NewSilenceRepository(), notifier, threads, map[entity.AlertSeverity]ResendPolicy{
+		entity.SeverityCritical: {Interval: 15 * time.Minute, Action: ResendRepost, Mention: "<!channel>"},
+		entity.SeverityWarning:  {Interval: 30 * time.Minute, Action: ResendReply},
+		entity.SeverityInfo:     {Interval: 30 * time.Minute, Action: ResendNone},
+	}, time.Minute, nopLogger{})
+	scheduler.now = func() time.Time { return now }
+
+	scheduler.Sweep(ctx)
+
+	assert.Equal(t, 1, notifier.posted)
+	require.Len(t, threads.replies, 2)
+	assert.Contains(t, threads.replies["C1:fp1"], "Still firing and unacknowledged after 1h0m0s")
+	assert.Contains(t, threads.replies["C1:reposted"], "<!channel> ")
+
+	stored, err := repo.FindByID(ctx, reposted.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "C1:reposted", stored.GetExternalReference("slack"))
+
+	// The next reminder waits for another interval
+	threads.replies = make(map[string]string)
+	scheduler.now = func() time.Time { return now.Add(20 * time.Minute) }
+	scheduler.Sweep(ctx)
+	assert.Len(t, threads.replies, 2)
+	assert.Contains(t, threads.replies, "C1:reposted")
+	assert.Contains(t, threads.replies, "C1:fp2")
+}
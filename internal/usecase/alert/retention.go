@@ -0,0 +1,239 @@
+package alert
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/observability"
+)
+
+// archivePageSize is how many alerts each archive file holds, bounding the
+// memory an archive run uses.
+const archivePageSize = 1000
+
+// RetentionPolicy is how long each kind of data is kept.
+type RetentionPolicy struct {
+	// ResolvedAlerts is how long alerts are kept after they resolve.
+	ResolvedAlerts time.Duration
+
+	// AckEvents is how long acknowledgment events are kept.
+	AckEvents time.Duration
+
+	// ExpiredSilences is how long silences are kept after they expire.
+	ExpiredSilences time.Duration
+}
+
+// PurgeResult counts the rows a purge deleted.
+type PurgeResult struct {
+	Alerts    int
+	AckEvents int
+	Silences  int
+
+	// Archived counts the alerts archived before they were deleted.
+	Archived int
+}
+
+// ObjectStore stores archive files.
+// Implemented by the S3-compatible object storage client.
+type ObjectStore interface {
+	PutObject(ctx context.Context, key, contentType string, body []byte) error
+}
+
+// ArchivedAlert is one line of an archive file: a resolved alert with its
+// acknowledgments and timeline.
+type ArchivedAlert struct {
+	Alert     *entity.Alert        `json:"alert"`
+	AckEvents []*entity.AckEvent   `json:"ack_events"`
+	Events    []*entity.AlertEvent `json:"events"`
+}
+
+// RetentionJanitor permanently deletes resolved alerts, ack events and
+// expired silences once they are past their retention period, so storage
+// does not grow unbounded.
+type RetentionJanitor struct {
+	alertRepo   repository.AlertRepository
+	ackRepo     repository.AckEventRepository
+	silenceRepo repository.SilenceRepository
+	policy      RetentionPolicy
+	interval    time.Duration
+	logger      Logger
+	metrics     *observability.Metrics
+	now         func() time.Time
+
+	// archive and eventRepo are set when alerts are archived before they
+	// are deleted.
+	archive   ObjectStore
+	eventRepo repository.AlertEventRepository
+	pageSize  int
+}
+
+// NewRetentionJanitor creates a janitor that purges every interval.
+func NewRetentionJanitor(
+	alertRepo repository.AlertRepository,
+	ackRepo repository.AckEventRepository,
+	silenceRepo repository.SilenceRepository,
+	policy RetentionPolicy,
+	interval time.Duration,
+	logger Logger,
+) *RetentionJanitor {
+	return &RetentionJanitor{
+		alertRepo:   alertRepo,
+		ackRepo:     ackRepo,
+		silenceRepo: silenceRepo,
+		policy:      policy,
+		interval:    interval,
+		logger:      logger,
+		now:         time.Now,
+		pageSize:    archivePageSize,
+	}
+}
+
+// SetMetrics enables the purged rows counter.
+func (j *RetentionJanitor) SetMetrics(metrics *observability.Metrics) {
+	j.metrics = metrics
+}
+
+// SetArchive makes the janitor upload the alerts it is about to delete, with
+// their ack events and timelines, to store. Alerts are only deleted once
+// archived, a page at a time.
+func (j *RetentionJanitor) SetArchive(store ObjectStore, eventRepo repository.AlertEventRepository) {
+	j.archive = store
+	j.eventRepo = eventRepo
+}
+
+// Run purges until ctx is cancelled.
+func (j *RetentionJanitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := j.Purge(ctx); err != nil {
+				j.logger.Error("failed to purge expired data", "error", err)
+			}
+		}
+	}
+}
+
+// Purge deletes the data past its retention period. A failure to purge one
+// kind of data does not stop the others; the failures are returned together.
+// Deleting alerts also deletes their timelines and, in the SQL backends,
+// their ack events, which are not counted. With an archive, each page of
+// alerts is archived first, and kept if that fails.
+func (j *RetentionJanitor) Purge(ctx context.Context) (PurgeResult, error) {
+	now := j.now().UTC()
+	var result PurgeResult
+	var errs []error
+
+	purge := func(entity string, retention time.Duration, deleteBefore func(context.Context, time.Time) (int, error)) int {
+		n, err := deleteBefore(ctx, now.Add(-retention))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("purging %s: %w", entity, err))
+		}
+		if n > 0 && j.metrics != nil {
+			j.metrics.RecordRetentionPurged(ctx, entity, n)
+		}
+		return n
+	}
+	if j.archive != nil {
+		// A failed page keeps its alerts and the rest until the next run
+		archived, deleted, err := j.archiveAlerts(ctx, now.Add(-j.policy.ResolvedAlerts), now)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("archiving alerts: %w", err))
+		}
+		if deleted > 0 && j.metrics != nil {
+			j.metrics.RecordRetentionPurged(ctx, "alert", deleted)
+		}
+		result.Archived = archived
+		result.Alerts = deleted
+	} else {
+		result.Alerts = purge("alert", j.policy.ResolvedAlerts, j.alertRepo.DeleteResolvedBefore)
+	}
+	result.AckEvents = purge("ack_event", j.policy.AckEvents, j.ackRepo.DeleteBefore)
+	result.Silences = purge("silence", j.policy.ExpiredSilences, j.silenceRepo.DeleteExpired)
+
+	if result.Alerts > 0 || result.AckEvents > 0 || result.Silences > 0 {
+		j.logger.Info("purged expired data",
+			"alerts", result.Alerts,
+			"archived", result.Archived,
+			"ackEvents", result.AckEvents,
+			"silences", result.Silences,
+		)
+	}
+	return result, errors.Join(errs...)
+}
+
+// archiveAlerts uploads the alerts resolved before the given time as
+// gzipped JSON Lines files of up to one page each, keyed by the day they
+// were written, and deletes the alerts of each page once it is uploaded.
+// Returns the number of alerts archived and deleted; an alert that fired
+// again after it was archived is kept.
+func (j *RetentionJanitor) archiveAlerts(ctx context.Context, before, now time.Time) (archived, deleted int, err error) {
+	for page := 1; ; page++ {
+		alerts, err := j.alertRepo.FindResolvedBefore(ctx, before, j.pageSize)
+		if err != nil {
+			return archived, deleted, err
+		}
+		if len(alerts) == 0 {
+			return archived, deleted, nil
+		}
+
+		body, err := j.encodeArchive(ctx, alerts)
+		if err != nil {
+			return archived, deleted, err
+		}
+		key := fmt.Sprintf("alerts/date=%s/alerts-%s-%04d.jsonl.gz", now.Format("2006-01-02"), now.Format("20060102T150405Z"), page)
+		if err := j.archive.PutObject(ctx, key, "application/gzip", body); err != nil {
+			return archived, deleted, err
+		}
+		archived += len(alerts)
+
+		ids := make([]string, len(alerts))
+		for i, alert := range alerts {
+			ids[i] = alert.ID
+		}
+		n, err := j.alertRepo.DeleteResolvedByIDs(ctx, ids, before)
+		deleted += n
+		if err != nil {
+			return archived, deleted, fmt.Errorf("deleting archived alerts: %w", err)
+		}
+		// The next page would be this one again
+		if n == 0 {
+			return archived, deleted, nil
+		}
+	}
+}
+
+// encodeArchive returns one archive file holding the alerts.
+func (j *RetentionJanitor) encodeArchive(ctx context.Context, alerts []*entity.Alert) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	encoder := json.NewEncoder(zw)
+	for _, alert := range alerts {
+		var err error
+		record := ArchivedAlert{Alert: alert}
+		if record.AckEvents, err = j.ackRepo.FindByAlertID(ctx, alert.ID); err != nil {
+			return nil, fmt.Errorf("loading ack events of %s: %w", alert.ID, err)
+		}
+		if record.Events, err = j.eventRepo.FindByAlertID(ctx, alert.ID); err != nil {
+			return nil, fmt.Errorf("loading timeline of %s: %w", alert.ID, err)
+		}
+		if err := encoder.Encode(record); err != nil {
+			return nil, fmt.Errorf("encoding %s: %w", alert.ID, err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("compressing archive: %w", err)
+	}
+	return buf.Bytes(), nil
+}
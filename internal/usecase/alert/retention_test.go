@@ -0,0 +1,243 @@
+package alert
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/memory"
+)
+
+func TestRetentionJanitor_Purge(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+	day := 24 * time.Hour
+
+	alerts := memory.NewAlertRepository()
+	newAlert := func(fingerprint string, resolvedAgo time.Duration) *entity.Alert {
+		alert := entity.NewAlert(fingerprint, "HighCPU", "host-1", "", "summary", entity.SeverityWarning)
+		if resolvedAgo > 0 {
+			alert.Resolve(now.Add(-resolvedAgo))
+		}
+		alert.SetExternalReference("slack", "C1:"+fingerprint)
+		require.NoError(t, alerts.Save(ctx, alert))
+		return alert
+	}
+	old := newAlert("fp-old", 40*day)
+	recent := newAlert("fp-recent", day)
+	firing := newAlert("fp-firing", 0)
+	deleted := newAlert("fp-deleted", 40*day)
+	require.NoError(t, alerts.Delete(ctx, deleted.ID))
+
+	acks := memory.NewAckEventRepository()
+	oldAck := entity.NewAckEvent(firing.ID, entity.AckSourceSlack, "U1", "", "alice")
+	oldAck.CreatedAt = now.Add(-100 * day)
+	recentAck := entity.NewAckEvent(firing.ID, entity.AckSourceSlack, "U1", "", "alice")
+	for _, event := range []*entity.AckEvent{oldAck, recentAck} {
+		require.NoError(t, acks.Save(ctx, event))
+	}
+
+	silences := memory.NewSilenceRepository()
+	newSilence := func(endedAgo time.Duration) *entity.SilenceMark {
+		silence, err := entity.NewSilenceMark(time.Hour, "alice", "", entity.AckSourceSlack)
+		require.NoError(t, err)
+		silence.StartAt = now.Add(-endedAgo - time.Hour)
+		silence.EndAt = now.Add(-endedAgo)
+		require.NoError(t, silences.Save(ctx, silence.ForFingerprint("fp-firing")))
+		return silence
+	}
+	expired := newSilence(10 * day)
+	recentlyExpired := newSilence(day)
+	active := newSilence(-time.Hour)
+
+	janitor := NewRetentionJanitor(alerts, acks, silences, RetentionPolicy{
+		ResolvedAlerts:  30 * day,
+		AckEvents:       90 * day,
+		ExpiredSilences: 7 * day,
+	}, time.Hour, nopLogger{})
+	janitor.now = func() time.Time { return now }
+
+	result, err := janitor.Purge(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, PurgeResult{Alerts: 2, AckEvents: 1, Silences: 1}, result)
+
+	for _, alert := range []*entity.Alert{recent, firing} {
+		found, err := alerts.FindByID(ctx, alert.ID)
+		require.NoError(t, err)
+		assert.NotNil(t, found, alert.Fingerprint)
+	}
+	found, err := alerts.FindByID(ctx, old.ID)
+	require.NoError(t, err)
+	assert.Nil(t, found)
+	found, err = alerts.FindByExternalReference(ctx, "slack", "C1:fp-old")
+	require.NoError(t, err)
+	assert.Nil(t, found)
+	assert.ErrorIs(t, alerts.Restore(ctx, deleted.ID), entity.ErrAlertNotFound)
+
+	events, err := acks.FindByAlertID(ctx, firing.ID)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, recentAck.ID, events[0].ID)
+
+	for _, silence := range []*entity.SilenceMark{recentlyExpired, active} {
+		found, err := silences.FindByID(ctx, silence.ID)
+		require.NoError(t, err)
+		assert.NotNil(t, found)
+	}
+	gone, err := silences.FindByID(ctx, expired.ID)
+	require.NoError(t, err)
+	assert.Nil(t, gone)
+
+	// Nothing left to purge
+	result, err = janitor.Purge(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, PurgeResult{}, result)
+}
+
+type fakeObjectStore struct {
+	objects map[string][]byte
+	err     error
+}
+
+func (s *fakeObjectStore) PutObject(ctx context.Context, key, contentType string, body []byte) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.objects[key] = body
+	return nil
+}
+
+func TestRetentionJanitor_Archive(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+
+	alerts := memory.NewAlertRepository()
+	old := entity.NewAlert("fp-old", "HighCPU", "host-1", "", "summary", entity.SeverityWarning)
+	old.Resolve(now.Add(-40 * 24 * time.Hour))
+	recent := entity.NewAlert("fp-recent", "HighCPU", "host-2", "", "summary", entity.SeverityWarning)
+	recent.Resolve(now.Add(-time.Hour))
+	for _, alert := range []*entity.Alert{old, recent} {
+		require.NoError(t, alerts.Save(ctx, alert))
+	}
+	acks := memory.NewAckEventRepository()
+	require.NoError(t, acks.Save(ctx, entity.NewAckEvent(old.ID, entity.AckSourceSlack, "U1", "", "alice")))
+	events := memory.NewAlertEventRepository()
+	require.NoError(t, events.Save(ctx, entity.NewAlertEvent(old.ID, entity.AlertEventResolved, "", "")))
+
+	store := &fakeObjectStore{objects: map[string][]byte{}, err: errors.New("bucket unavailable")}
+	janitor := NewRetentionJanitor(alerts, acks, memory.NewSilenceRepository(), RetentionPolicy{
+		ResolvedAlerts:  30 * 24 * time.Hour,
+		AckEvents:       90 * 24 * time.Hour,
+		ExpiredSilences: 7 * 24 * time.Hour,
+	}, time.Hour, nopLogger{})
+	janitor.SetArchive(store, events)
+	janitor.now = func() time.Time { return now }
+
+	// Alerts that could not be archived are kept
+	result, err := janitor.Purge(ctx)
+	assert.ErrorContains(t, err, "bucket unavailable")
+	assert.Equal(t, PurgeResult{}, result)
+	found, err := alerts.FindByID(ctx, old.ID)
+	require.NoError(t, err)
+	assert.NotNil(t, found)
+
+	store.err = nil
+	result, err = janitor.Purge(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, PurgeResult{Alerts: 1, Archived: 1}, result)
+
+	body, ok := store.objects["alerts/date=2026-10-15/alerts-20261015T120000Z-0001.jsonl.gz"]
+	require.True(t, ok, "archive file")
+	zr, err := gzip.NewReader(bytes.NewReader(body))
+	require.NoError(t, err)
+	var records []ArchivedAlert
+	decoder := json.NewDecoder(zr)
+	for decoder.More() {
+		var record ArchivedAlert
+		require.NoError(t, decoder.Decode(&record))
+		records = append(records, record)
+	}
+	require.Len(t, records, 1)
+	assert.Equal(t, old.ID, records[0].Alert.ID)
+	assert.Len(t, records[0].AckEvents, 1)
+	assert.Len(t, records[0].Events, 1)
+
+	found, err = alerts.FindByID(ctx, old.ID)
+	require.NoError(t, err)
+	assert.Nil(t, found)
+}
+
+// failingObjectStore fails every upload after the first uploads.
+type failingObjectStore struct {
+	fakeObjectStore
+	uploads int
+}
+
+func (s *failingObjectStore) PutObject(ctx context.Context, key, contentType string, body []byte) error {
+	if len(s.objects) == s.uploads {
+		return errors.New("bucket unavailable")
+	}
+	return s.fakeObjectStore.PutObject(ctx, key, contentType, body)
+}
+
+func TestRetentionJanitor_ArchivePages(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+
+	alerts := memory.NewAlertRepository()
+	var old []*entity.Alert
+	for i := range 5 {
+		alert := entity.NewAlert(fmt.Sprintf("fp-%d", i), "HighCPU", "host-1", "", "summary", entity.SeverityWarning)
+		alert.Resolve(now.Add(-40*24*time.Hour + time.Duration(i)*time.Minute))
+		require.NoError(t, alerts.Save(ctx, alert))
+		old = append(old, alert)
+	}
+
+	store := &failingObjectStore{fakeObjectStore: fakeObjectStore{objects: map[string][]byte{}}, uploads: 1}
+	janitor := NewRetentionJanitor(alerts, memory.NewAckEventRepository(), memory.NewSilenceRepository(), RetentionPolicy{
+		ResolvedAlerts:  30 * 24 * time.Hour,
+		AckEvents:       90 * 24 * time.Hour,
+		ExpiredSilences: 7 * 24 * time.Hour,
+	}, time.Hour, nopLogger{})
+	janitor.SetArchive(store, memory.NewAlertEventRepository())
+	janitor.now = func() time.Time { return now }
+	janitor.pageSize = 2
+
+	kept := func() []string {
+		var ids []string
+		for _, alert := range old {
+			found, err := alerts.FindByID(ctx, alert.ID)
+			require.NoError(t, err)
+			if found != nil {
+				ids = append(ids, alert.Fingerprint)
+			}
+		}
+		return ids
+	}
+
+	// Only the uploaded page is deleted
+	result, err := janitor.Purge(ctx)
+	assert.ErrorContains(t, err, "bucket unavailable")
+	assert.Equal(t, PurgeResult{Alerts: 2, Archived: 2}, result)
+	assert.Equal(t, []string{"fp-2", "fp-3", "fp-4"}, kept())
+
+	store.uploads = 10
+	janitor.now = func() time.Time { return now.Add(time.Hour) }
+	result, err = janitor.Purge(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, PurgeResult{Alerts: 3, Archived: 3}, result)
+	assert.Empty(t, kept())
+
+	// One file per page
+	assert.Len(t, store.objects, 3)
+	assert.Contains(t, store.objects, "alerts/date=2026-10-15/alerts-20261015T130000Z-0002.jsonl.gz")
+}
@@ -2,10 +2,14 @@ package alert
 
 import (
 	"context"
+	"errors"
 	"math"
 	"math/rand"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
 	domainerrors "github.com/altuslabsxyz/alert-bridge/internal/domain/errors"
 	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/observability"
@@ -61,10 +65,10 @@ func NewRetryableNotifier(notifier Notifier, policy RetryPolicy, logger Logger,
 }
 
 // Notify sends a notification with retry logic for transient failures.
-func (r *RetryableNotifier) Notify(ctx context.Context, alert *entity.Alert) (string, error) {
+func (r *RetryableNotifier) Notify(ctx context.Context, alert *entity.Alert) (entity.NotifyResult, error) {
 	start := time.Now()
 	var lastErr error
-	var messageID string
+	var result entity.NotifyResult
 	var success bool
 	retriesUsed := 0
 
@@ -88,18 +92,19 @@ func (r *RetryableNotifier) Notify(ctx context.Context, alert *entity.Alert) (st
 
 		// Attempt notification through circuit breaker
 		cbErr := r.circuitBreaker.Execute(ctx, func() error {
-			messageID, lastErr = r.notifier.Notify(ctx, alert)
+			result, lastErr = r.notifier.Notify(ctx, alert)
 			return lastErr
 		})
 
 		// Check if circuit breaker blocked the request
 		if cbErr == resilience.ErrCircuitOpen {
+			trace.SpanFromContext(ctx).AddEvent("circuit breaker open")
 			r.logger.Warn("circuit breaker open, skipping notification",
 				"notifier", r.notifier.Name(),
 				"alert_id", alert.ID,
 				"cb_state", r.circuitBreaker.State(),
 			)
-			return "", cbErr
+			return entity.NotifyResult{Retriable: true}, cbErr
 		}
 
 		// Success - return immediately
@@ -112,18 +117,29 @@ func (r *RetryableNotifier) Notify(ctx context.Context, alert *entity.Alert) (st
 					"attempt", attempt,
 				)
 			}
-			return messageID, nil
+			return result, nil
+		}
+
+		// A throttled API asks callers to back off; retrying right away
+		// only prolongs it
+		if errors.Is(lastErr, resilience.ErrThrottled) {
+			r.logger.Warn("notification throttled",
+				"notifier", r.notifier.Name(),
+				"alert_id", alert.ID,
+				"error", lastErr,
+			)
+			return result, lastErr
 		}
 
 		// Check if error is retryable
-		if !domainerrors.IsTransientError(lastErr) {
+		if !result.Retriable && !domainerrors.IsTransientError(lastErr) {
 			// Permanent error - don't retry
 			r.logger.Warn("notification failed with permanent error",
 				"notifier", r.notifier.Name(),
 				"alert_id", alert.ID,
 				"error", lastErr,
 			)
-			return "", lastErr
+			return result, lastErr
 		}
 
 		// Last attempt failed - don't sleep
@@ -138,7 +154,8 @@ func (r *RetryableNotifier) Notify(ctx context.Context, alert *entity.Alert) (st
 		}
 
 		// Calculate backoff with jitter
-		backoff := r.calculateBackoff(attempt)
+		backoff := r.policy.Backoff(attempt)
+		r.recordRetry(ctx, attempt, backoff, lastErr)
 		r.logger.Warn("notification failed, retrying",
 			"notifier", r.notifier.Name(),
 			"alert_id", alert.ID,
@@ -152,11 +169,16 @@ func (r *RetryableNotifier) Notify(ctx context.Context, alert *entity.Alert) (st
 		case <-time.After(backoff):
 			// Continue to next attempt
 		case <-ctx.Done():
-			return "", ctx.Err()
+			return entity.NotifyResult{}, ctx.Err()
 		}
 	}
 
-	return "", lastErr
+	return result, lastErr
+}
+
+// Capabilities returns the wrapped notifier's capabilities.
+func (r *RetryableNotifier) Capabilities() entity.NotifierCapabilities {
+	return CapabilitiesOf(r.notifier)
 }
 
 // UpdateMessage updates a notification with retry logic.
@@ -178,6 +200,16 @@ func (r *RetryableNotifier) UpdateMessage(ctx context.Context, messageID string,
 			return nil
 		}
 
+		// Throttled - back off instead of retrying
+		if errors.Is(lastErr, resilience.ErrThrottled) {
+			r.logger.Warn("update message throttled",
+				"notifier", r.notifier.Name(),
+				"message_id", messageID,
+				"error", lastErr,
+			)
+			return lastErr
+		}
+
 		// Check if error is retryable
 		if !domainerrors.IsTransientError(lastErr) {
 			r.logger.Warn("update message failed with permanent error",
@@ -200,7 +232,8 @@ func (r *RetryableNotifier) UpdateMessage(ctx context.Context, messageID string,
 		}
 
 		// Calculate backoff with jitter
-		backoff := r.calculateBackoff(attempt)
+		backoff := r.policy.Backoff(attempt)
+		r.recordRetry(ctx, attempt, backoff, lastErr)
 		r.logger.Warn("update message failed, retrying",
 			"notifier", r.notifier.Name(),
 			"message_id", messageID,
@@ -226,19 +259,29 @@ func (r *RetryableNotifier) Name() string {
 	return r.notifier.Name()
 }
 
-// calculateBackoff calculates the backoff duration with exponential growth and jitter.
+// recordRetry adds a retry event to the caller's span, so traces show where
+// delivery time went.
+func (r *RetryableNotifier) recordRetry(ctx context.Context, attempt int, backoff time.Duration, err error) {
+	trace.SpanFromContext(ctx).AddEvent("notification retry", trace.WithAttributes(
+		attribute.Int("attempt", attempt),
+		attribute.String("backoff", backoff.String()),
+		attribute.String("error", err.Error()),
+	))
+}
+
+// Backoff calculates the wait after the given failed attempt, with exponential growth and jitter.
 // Formula: min(InitialInterval * Multiplier^(attempt-1) * (1 ± jitter), MaxInterval)
-func (r *RetryableNotifier) calculateBackoff(attempt int) time.Duration {
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
 	// Exponential backoff
-	backoff := float64(r.policy.InitialInterval) * math.Pow(r.policy.Multiplier, float64(attempt-1))
+	backoff := float64(p.InitialInterval) * math.Pow(p.Multiplier, float64(attempt-1))
 
 	// Apply jitter (-jitterFactor to +jitterFactor)
-	jitter := 1.0 + (rand.Float64()*2.0-1.0)*r.policy.JitterFactor
+	jitter := 1.0 + (rand.Float64()*2.0-1.0)*p.JitterFactor
 	backoff *= jitter
 
 	// Cap at max interval
-	if backoff > float64(r.policy.MaxInterval) {
-		backoff = float64(r.policy.MaxInterval)
+	if backoff > float64(p.MaxInterval) {
+		backoff = float64(p.MaxInterval)
 	}
 
 	return time.Duration(backoff)
@@ -0,0 +1,428 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	domainerrors "github.com/altuslabsxyz/alert-bridge/internal/domain/errors"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/observability"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/resilience"
+)
+
+// retryClaimTimeout is how long a dispatched retry is hidden from other
+// polls. If the process stops mid-attempt, the retry is picked up again
+// after this long.
+const retryClaimTimeout = 5 * time.Minute
+
+// outageDeferral is how long retries wait while their notifier's circuit
+// breaker is open. It matches the breaker's timeout, after which the breaker
+// lets a call through again to probe for recovery.
+const outageDeferral = 30 * time.Second
+
+// Retry queue outcomes, used in logs and metrics.
+const (
+	retryOutcomeQueued      = "queued"
+	retryOutcomeDelivered   = "delivered"
+	retryOutcomeRescheduled = "rescheduled"
+	retryOutcomeDeferred    = "deferred"
+	retryOutcomeDropped     = "dropped"
+	retryOutcomeDead        = "dead_lettered"
+)
+
+// RetryQueuePolicy configures the persistent notification retry queue.
+type RetryQueuePolicy struct {
+	// Backoff between attempts; MaxAttempts counts the original failure.
+	RetryPolicy
+
+	// Workers is the number of retries attempted concurrently.
+	Workers int
+
+	// PollInterval is how often the queue looks for due retries.
+	PollInterval time.Duration
+}
+
+// NotificationRetryQueue persists notifier calls that failed with a
+// transient error and retries them in the background with exponential
+// backoff. Retries that run out of attempts, or then fail permanently,
+// are kept as dead letters.
+//
+// The queue rides out notifier outages: while a notifier's circuit breaker
+// is open its retries wait without using up attempts, and each alert has at
+// most one queued notification per notifier, sent with the alert's state at
+// delivery. Catching up after an outage therefore posts or updates each
+// alert once, with its latest state, rather than replaying every change.
+type NotificationRetryQueue struct {
+	repo      repository.NotificationRetryRepository
+	alertRepo repository.AlertRepository
+	notifiers map[string]Notifier
+	policy    RetryQueuePolicy
+	logger    Logger
+	metrics   *observability.Metrics
+	now       func() time.Time
+
+	// Channel overrides for retried notifications (optional)
+	routing *RoutingTree
+
+	// Alert timeline (optional)
+	timeline *Timeline
+
+	// Start of each notifier's current outage
+	mu      sync.Mutex
+	outages map[string]time.Time
+}
+
+// NewNotificationRetryQueue creates a queue delivering through notifiers.
+func NewNotificationRetryQueue(
+	repo repository.NotificationRetryRepository,
+	alertRepo repository.AlertRepository,
+	notifiers []Notifier,
+	policy RetryQueuePolicy,
+	logger Logger,
+	metrics *observability.Metrics,
+) *NotificationRetryQueue {
+	byName := make(map[string]Notifier, len(notifiers))
+	for _, n := range notifiers {
+		byName[n.Name()] = n
+	}
+	if policy.Workers < 1 {
+		policy.Workers = 1
+	}
+	return &NotificationRetryQueue{
+		repo:      repo,
+		alertRepo: alertRepo,
+		notifiers: byName,
+		policy:    policy,
+		logger:    logger,
+		metrics:   metrics,
+		now:       time.Now,
+		outages:   make(map[string]time.Time),
+	}
+}
+
+// SetRoutingTree posts retried notifications to the channel the routing
+// tree picks for the alert, as the original notification did.
+func (q *NotificationRetryQueue) SetRoutingTree(tree *RoutingTree) {
+	q.routing = tree
+}
+
+// SetTimeline records delivered notifications in the alert timeline.
+func (q *NotificationRetryQueue) SetTimeline(timeline *Timeline) {
+	q.timeline = timeline
+}
+
+// Enqueue queues a failed notifier call if err is transient. It replaces
+// any queued retry of the same call. Returns true if the call was queued.
+func (q *NotificationRetryQueue) Enqueue(ctx context.Context, alert *entity.Alert, notifier string, action entity.NotificationAction, err error) bool {
+	if !isRetryableNotification(err) {
+		return false
+	}
+
+	// A queued notification posts the alert's latest state, so updates
+	// before it is delivered need no retry of their own
+	if action == entity.NotificationActionUpdate {
+		pending, findErr := q.repo.FindByID(ctx, entity.NotificationRetryID(alert.ID, notifier, entity.NotificationActionNotify))
+		if findErr == nil && pending != nil && !pending.IsDead() {
+			return true
+		}
+	}
+
+	retry := entity.NewNotificationRetry(alert.ID, notifier, action, err.Error(), q.now().Add(q.policy.Backoff(1)))
+	if saveErr := q.repo.Save(ctx, retry); saveErr != nil {
+		q.logger.Error("failed to queue notification for retry",
+			"notifier", notifier,
+			"alertID", alert.ID,
+			"action", action,
+			"error", saveErr,
+		)
+		return false
+	}
+
+	q.logger.Warn("notification queued for retry",
+		"notifier", notifier,
+		"alertID", alert.ID,
+		"action", action,
+		"nextAttemptAt", retry.NextAttemptAt,
+		"error", err,
+	)
+	q.record(ctx, notifier, retryOutcomeQueued)
+	return true
+}
+
+// Run attempts due retries until ctx is cancelled. In-flight attempts are
+// finished before Run returns.
+func (q *NotificationRetryQueue) Run(ctx context.Context) {
+	jobs := make(chan *entity.NotificationRetry)
+	var wg sync.WaitGroup
+	for i := 0; i < q.policy.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for retry := range jobs {
+				q.attempt(context.WithoutCancel(ctx), retry)
+			}
+		}()
+	}
+	defer func() {
+		close(jobs)
+		wg.Wait()
+	}()
+
+	ticker := time.NewTicker(q.policy.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		q.dispatch(ctx, jobs)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// dispatch claims due retries and hands them to the workers.
+func (q *NotificationRetryQueue) dispatch(ctx context.Context, jobs chan<- *entity.NotificationRetry) {
+	now := q.now()
+	due, err := q.repo.FindDue(ctx, now, q.policy.Workers*4)
+	if err != nil {
+		q.logger.Error("failed to load due notification retries", "error", err)
+		return
+	}
+
+	for _, retry := range due {
+		// Claim the retry so the next poll does not dispatch it again
+		claimed := retry.Copy()
+		claimed.NextAttemptAt = now.Add(retryClaimTimeout)
+		if err := q.repo.Save(ctx, claimed); err != nil {
+			q.logger.Error("failed to claim notification retry",
+				"retryID", retry.ID,
+				"error", err,
+			)
+			continue
+		}
+
+		select {
+		case jobs <- claimed:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// attempt repeats the notifier call with the alert's current state.
+func (q *NotificationRetryQueue) attempt(ctx context.Context, retry *entity.NotificationRetry) {
+	alert, err := q.alertRepo.FindByID(ctx, retry.AlertID)
+	if err != nil {
+		// Storage trouble is not the notifier's fault; try again later
+		q.fail(ctx, retry, err, true)
+		return
+	}
+	if alert == nil {
+		q.drop(ctx, retry, "alert no longer exists")
+		return
+	}
+
+	notifier, ok := q.notifiers[retry.Notifier]
+	if !ok {
+		q.deadLetter(ctx, retry, "notifier is not configured")
+		return
+	}
+
+	switch retry.Action {
+	case entity.NotificationActionNotify:
+		if alert.GetExternalReference(retry.Notifier) != "" {
+			q.drop(ctx, retry, "already delivered")
+			return
+		}
+		// A late notification for a resolved alert would page for nothing
+		if !alert.IsFiring() {
+			q.drop(ctx, retry, "alert resolved")
+			return
+		}
+		notifyCtx := ctx
+		if q.routing != nil {
+			if channel := q.routing.Route(alert).Channel(retry.Notifier); channel != "" {
+				notifyCtx = entity.WithNotificationChannel(ctx, channel)
+			}
+		}
+		var result entity.NotifyResult
+		result, err = notifier.Notify(notifyCtx, alert)
+		if err == nil {
+			// The notification carries the latest state
+			q.remove(ctx, &entity.NotificationRetry{ID: entity.NotificationRetryID(alert.ID, retry.Notifier, entity.NotificationActionUpdate)})
+
+			alert.PutExternalReference(entity.NewExternalReference(retry.Notifier, result))
+			if updateErr := q.alertRepo.Update(ctx, alert); updateErr != nil {
+				q.logger.Error("failed to store message ID",
+					"notifier", retry.Notifier,
+					"alertID", alert.ID,
+					"error", updateErr,
+				)
+			}
+			q.timeline.Record(ctx, alert.ID, entity.AlertEventNotified, "", retry.Notifier+" (retried)")
+		}
+	case entity.NotificationActionUpdate:
+		messageID := alert.GetExternalReference(retry.Notifier)
+		if messageID == "" {
+			q.drop(ctx, retry, "no message to update")
+			return
+		}
+		err = notifier.UpdateMessage(ctx, messageID, alert)
+	default:
+		q.deadLetter(ctx, retry, "unknown action "+string(retry.Action))
+		return
+	}
+
+	if errors.Is(err, ErrNotificationSkipped) {
+		q.drop(ctx, retry, "skipped by notifier")
+		return
+	}
+	if errors.Is(err, ErrNotificationQueued) {
+		q.drop(ctx, retry, "queued by notifier")
+		return
+	}
+	if err != nil {
+		q.fail(ctx, retry, err, isRetryableNotification(err))
+		return
+	}
+
+	q.remove(ctx, retry)
+	q.recovered(retry.Notifier)
+	q.logger.Info("queued notification delivered",
+		"notifier", retry.Notifier,
+		"alertID", retry.AlertID,
+		"action", retry.Action,
+		"attempts", retry.Attempts+1,
+	)
+	q.record(ctx, retry.Notifier, retryOutcomeDelivered)
+}
+
+// fail reschedules a failed retry, or dead-letters it when the error is
+// not retryable or the attempts are used up.
+func (q *NotificationRetryQueue) fail(ctx context.Context, retry *entity.NotificationRetry, err error, retryable bool) {
+	if errors.Is(err, resilience.ErrCircuitOpen) {
+		q.deferRetry(ctx, retry, err)
+		return
+	}
+	if !retryable || retry.Attempts+1 >= q.policy.MaxAttempts {
+		q.deadLetter(ctx, retry, err.Error())
+		return
+	}
+
+	retry.RecordFailure(err.Error(), q.now().Add(q.policy.Backoff(retry.Attempts+1)))
+	if saveErr := q.repo.Save(ctx, retry); saveErr != nil {
+		q.logger.Error("failed to reschedule notification retry",
+			"retryID", retry.ID,
+			"error", saveErr,
+		)
+		return
+	}
+
+	q.logger.Warn("queued notification failed, rescheduled",
+		"notifier", retry.Notifier,
+		"alertID", retry.AlertID,
+		"action", retry.Action,
+		"attempts", retry.Attempts,
+		"nextAttemptAt", retry.NextAttemptAt,
+		"error", err,
+	)
+	q.record(ctx, retry.Notifier, retryOutcomeRescheduled)
+}
+
+// deferRetry reschedules a retry whose notifier is down without using up an
+// attempt, so that retries outlast an outage however long it is.
+func (q *NotificationRetryQueue) deferRetry(ctx context.Context, retry *entity.NotificationRetry, err error) {
+	now := q.now()
+	q.mu.Lock()
+	if _, ok := q.outages[retry.Notifier]; !ok {
+		q.outages[retry.Notifier] = now
+		q.logger.Warn("notifier is down, holding queued notifications until it recovers",
+			"notifier", retry.Notifier,
+			"error", err,
+		)
+	}
+	q.mu.Unlock()
+
+	retry.Defer(err.Error(), now.Add(outageDeferral))
+	if saveErr := q.repo.Save(ctx, retry); saveErr != nil {
+		q.logger.Error("failed to defer notification retry",
+			"retryID", retry.ID,
+			"error", saveErr,
+		)
+		return
+	}
+	q.record(ctx, retry.Notifier, retryOutcomeDeferred)
+}
+
+// recovered ends the notifier's outage, if any, after a delivery.
+func (q *NotificationRetryQueue) recovered(notifier string) {
+	q.mu.Lock()
+	since, ok := q.outages[notifier]
+	delete(q.outages, notifier)
+	q.mu.Unlock()
+
+	if ok {
+		q.logger.Info("notifier recovered, delivering queued notifications",
+			"notifier", notifier,
+			"outage", q.now().Sub(since).Round(time.Second),
+		)
+	}
+}
+
+// deadLetter stops retrying and keeps the retry for inspection.
+func (q *NotificationRetryQueue) deadLetter(ctx context.Context, retry *entity.NotificationRetry, reason string) {
+	retry.MarkDead(reason)
+	if err := q.repo.Save(ctx, retry); err != nil {
+		q.logger.Error("failed to dead-letter notification retry",
+			"retryID", retry.ID,
+			"error", err,
+		)
+		return
+	}
+
+	q.logger.Error("queued notification given up",
+		"notifier", retry.Notifier,
+		"alertID", retry.AlertID,
+		"action", retry.Action,
+		"attempts", retry.Attempts,
+		"error", reason,
+	)
+	q.record(ctx, retry.Notifier, retryOutcomeDead)
+}
+
+// drop removes a retry that no longer needs delivering.
+func (q *NotificationRetryQueue) drop(ctx context.Context, retry *entity.NotificationRetry, reason string) {
+	q.remove(ctx, retry)
+	q.logger.Info("queued notification dropped",
+		"notifier", retry.Notifier,
+		"alertID", retry.AlertID,
+		"action", retry.Action,
+		"reason", reason,
+	)
+	q.record(ctx, retry.Notifier, retryOutcomeDropped)
+}
+
+func (q *NotificationRetryQueue) remove(ctx context.Context, retry *entity.NotificationRetry) {
+	if err := q.repo.Delete(ctx, retry.ID); err != nil && !errors.Is(err, entity.ErrNotificationRetryNotFound) {
+		q.logger.Error("failed to remove notification retry",
+			"retryID", retry.ID,
+			"error", err,
+		)
+	}
+}
+
+func (q *NotificationRetryQueue) record(ctx context.Context, notifier, outcome string) {
+	if q.metrics != nil {
+		q.metrics.RecordNotificationQueued(ctx, notifier, outcome)
+	}
+}
+
+// isRetryableNotification reports whether a notifier error is worth
+// queueing: a transient failure, or a channel whose circuit breaker is open.
+func isRetryableNotification(err error) bool {
+	return domainerrors.IsTransientError(err) || errors.Is(err, resilience.ErrCircuitOpen)
+}
@@ -0,0 +1,97 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/memory"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/resilience"
+)
+
+type outageNotifier struct {
+	down     bool
+	notified []string
+	updated  []string
+}
+
+func (n *outageNotifier) Notify(_ context.Context, alert *entity.Alert) (entity.NotifyResult, error) {
+	if n.down {
+		return entity.NotifyResult{}, resilience.ErrCircuitOpen
+	}
+	n.notified = append(n.notified, alert.ID)
+	return entity.NotifyResult{ReferenceID: "C1:" + alert.ID}, nil
+}
+
+func (n *outageNotifier) UpdateMessage(_ context.Context, messageID string, _ *entity.Alert) error {
+	if n.down {
+		return resilience.ErrCircuitOpen
+	}
+	n.updated = append(n.updated, messageID)
+	return nil
+}
+
+func (n *outageNotifier) Name() string { return "slack" }
+
+func TestNotificationRetryQueue_Outage(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	alertRepo := memory.NewAlertRepository()
+	retryRepo := memory.NewNotificationRetryRepository()
+	notifier := &outageNotifier{down: true}
+	queue := NewNotificationRetryQueue(retryRepo, alertRepo, []Notifier{notifier}, RetryQueuePolicy{
+		RetryPolicy: RetryPolicy{MaxAttempts: 2, InitialInterval: time.Second, MaxInterval: time.Second, Multiplier: 1},
+	}, nopLogger{}, nil)
+	queue.now = func() time.Time { return now }
+
+	// New during the outage, then changed several times
+	fresh := entity.NewAlert("fp1", "HighCPU", "host-1", "", "summary", entity.SeverityWarning)
+	require.NoError(t, alertRepo.Save(ctx, fresh))
+	assert.True(t, queue.Enqueue(ctx, fresh, "slack", entity.NotificationActionNotify, resilience.ErrCircuitOpen))
+	assert.True(t, queue.Enqueue(ctx, fresh, "slack", entity.NotificationActionUpdate, resilience.ErrCircuitOpen))
+
+	// Posted before the outage, then changed
+	posted := entity.NewAlert("fp2", "HighCPU", "host-2", "", "summary", entity.SeverityWarning)
+	posted.SetExternalReference("slack", "C1:posted")
+	require.NoError(t, alertRepo.Save(ctx, posted))
+	assert.True(t, queue.Enqueue(ctx, posted, "slack", entity.NotificationActionUpdate, resilience.ErrCircuitOpen))
+	assert.True(t, queue.Enqueue(ctx, posted, "slack", entity.NotificationActionUpdate, resilience.ErrCircuitOpen))
+
+	attemptDue := func() {
+		due, err := retryRepo.FindDue(ctx, now, 10)
+		require.NoError(t, err)
+		for _, retry := range due {
+			queue.attempt(ctx, retry)
+		}
+	}
+
+	// Attempts during the outage are not used up
+	for i := 0; i < 5; i++ {
+		now = now.Add(time.Minute)
+		attemptDue()
+	}
+	dead, err := retryRepo.FindDead(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, dead)
+
+	// Catch-up sends each alert once
+	notifier.down = false
+	now = now.Add(time.Minute)
+	attemptDue()
+	assert.Equal(t, []string{fresh.ID}, notifier.notified)
+	assert.Equal(t, []string{"C1:posted"}, notifier.updated)
+
+	for _, alert := range []*entity.Alert{fresh, posted} {
+		for _, action := range []entity.NotificationAction{entity.NotificationActionNotify, entity.NotificationActionUpdate} {
+			retry, err := retryRepo.FindByID(ctx, entity.NotificationRetryID(alert.ID, "slack", action))
+			require.NoError(t, err)
+			assert.Nil(t, retry, fmt.Sprintf("%s %s", alert.Fingerprint, action))
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package alert
+
+import (
+	"regexp"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// Route is a node of the routing tree. It matches alerts whose labels
+// satisfy Match and MatchRE and whose severity is at least MinSeverity.
+type Route struct {
+	Name        string
+	Match       map[string]string
+	MatchRE     map[string]*regexp.Regexp
+	MinSeverity entity.AlertSeverity
+
+	// Notifiers receive the alerts delivered by this route. Nil inherits the
+	// parent's notifiers.
+	Notifiers []string
+
+	// SlackChannelID overrides the Slack channel. Empty inherits the
+	// parent's override.
+	SlackChannelID string
+
+	// Continue also matches the alert against the following siblings.
+	Continue bool
+
+	// Pipeline replaces the pipeline stages after routing for the alerts
+	// this route delivers. Nil inherits the parent's stages.
+	Pipeline []string
+
+	Routes []Route
+}
+
+// Matches reports whether the route itself applies to the alert, without
+// looking at its children.
+func (r Route) Matches(alert *entity.Alert) bool {
+	if r.MinSeverity != "" && severityRank(alert.Severity) < severityRank(r.MinSeverity) {
+		return false
+	}
+	for name, value := range r.Match {
+		if alert.GetLabel(name) != value {
+			return false
+		}
+	}
+	for name, re := range r.MatchRE {
+		if !re.MatchString(alert.GetLabel(name)) {
+			return false
+		}
+	}
+	return true
+}
+
+// RoutingDecision is where an alert is delivered.
+type RoutingDecision struct {
+	// Routes names the routes that delivered the alert.
+	Routes []string
+
+	// Notifiers is the union of the delivering routes' notifiers.
+	Notifiers map[string]bool
+
+	// SlackChannelID is the first delivering route's Slack channel
+	// override, if any.
+	SlackChannelID string
+
+	// Pipeline is the first delivering route's pipeline stages after
+	// routing; nil keeps the configured ones.
+	Pipeline []string
+}
+
+// Includes reports whether the named notifier receives the alert. The canary
+// is always included, since it applies its own routing tree.
+func (d RoutingDecision) Includes(notifier string) bool {
+	if notifier == canaryNotifierName {
+		return true
+	}
+	return d.Notifiers[notifier]
+}
+
+// Channel returns the channel override for the named notifier, if any.
+func (d RoutingDecision) Channel(notifier string) string {
+	if notifier == "slack" {
+		return d.SlackChannelID
+	}
+	return ""
+}
+
+// RoutingTree picks the notifiers of an alert, Alertmanager style: an alert
+// walks down from the root into the first matching child at each level, or
+// into several children when they set Continue, and is delivered by the
+// deepest routes it reaches.
+type RoutingTree struct {
+	root Route
+}
+
+// NewRoutingTree creates a tree from its root route, which matches every
+// alert.
+func NewRoutingTree(root Route) *RoutingTree {
+	return &RoutingTree{root: root}
+}
+
+// Route returns where the alert is delivered.
+func (t *RoutingTree) Route(alert *entity.Alert) RoutingDecision {
+	decision := RoutingDecision{Notifiers: make(map[string]bool)}
+	for _, leaf := range walkRoute(t.root, t.root, alert) {
+		decision.Routes = append(decision.Routes, leaf.Name)
+		for _, name := range leaf.Notifiers {
+			decision.Notifiers[name] = true
+		}
+		if decision.SlackChannelID == "" {
+			decision.SlackChannelID = leaf.SlackChannelID
+		}
+		if len(decision.Routes) == 1 {
+			decision.Pipeline = leaf.Pipeline
+		}
+	}
+	return decision
+}
+
+// walkRoute returns the routes delivering an alert that matched route, with
+// inherited settings resolved.
+func walkRoute(route, parent Route, alert *entity.Alert) []Route {
+	if route.Notifiers == nil {
+		route.Notifiers = parent.Notifiers
+	}
+	if route.SlackChannelID == "" {
+		route.SlackChannelID = parent.SlackChannelID
+	}
+	if route.Pipeline == nil {
+		route.Pipeline = parent.Pipeline
+	}
+
+	var leaves []Route
+	for _, child := range route.Routes {
+		if !child.Matches(alert) {
+			continue
+		}
+		leaves = append(leaves, walkRoute(child, route, alert)...)
+		if !child.Continue {
+			break
+		}
+	}
+	if len(leaves) == 0 {
+		leaves = append(leaves, route)
+	}
+	return leaves
+}
+
+// severityRank orders severities from least to most severe. Unknown
+// severities rank below info.
+func severityRank(severity entity.AlertSeverity) int {
+	switch severity {
+	case entity.SeverityCritical:
+		return 3
+	case entity.SeverityWarning:
+		return 2
+	case entity.SeverityInfo:
+		return 1
+	default:
+		return 0
+	}
+}
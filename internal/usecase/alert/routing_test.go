@@ -0,0 +1,127 @@
+package alert
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+func newRoutedAlert(severity entity.AlertSeverity, labels map[string]string) *entity.Alert {
+	alert := entity.NewAlert("fp", "HighCPU", "host-1", "", "summary", severity)
+	for k, v := range labels {
+		alert.AddLabel(k, v)
+	}
+	return alert
+}
+
+func TestRoutingTree_Route(t *testing.T) {
+	tree := NewRoutingTree(Route{
+		Name:      "root",
+		Notifiers: []string{"slack"},
+		Routes: []Route{
+			{
+				Name:        "critical-pager",
+				MinSeverity: entity.SeverityCritical,
+				Notifiers:   []string{"pagerduty"},
+				Continue:    true,
+			},
+			{
+				Name:           "payments",
+				Match:          map[string]string{"team": "payments"},
+				SlackChannelID: "C-PAYMENTS",
+				Routes: []Route{
+					{
+						Name:      "payments-db",
+						MatchRE:   map[string]*regexp.Regexp{"service": regexp.MustCompile("^(?:mysql|redis)$")},
+						Notifiers: []string{"slack", "email"},
+					},
+				},
+			},
+			{
+				Name:      "dev",
+				Match:     map[string]string{"env": "dev"},
+				Notifiers: []string{},
+			},
+		},
+	})
+
+	tests := []struct {
+		name          string
+		severity      entity.AlertSeverity
+		labels        map[string]string
+		wantRoutes    []string
+		wantNotifiers []string
+		wantChannel   string
+	}{
+		{
+			name:          "no child matches, root delivers",
+			severity:      entity.SeverityWarning,
+			labels:        map[string]string{"team": "infra"},
+			wantRoutes:    []string{"root"},
+			wantNotifiers: []string{"slack"},
+		},
+		{
+			name:          "child inherits notifiers and sets channel",
+			severity:      entity.SeverityWarning,
+			labels:        map[string]string{"team": "payments"},
+			wantRoutes:    []string{"payments"},
+			wantNotifiers: []string{"slack"},
+			wantChannel:   "C-PAYMENTS",
+		},
+		{
+			name:          "nested route inherits channel",
+			severity:      entity.SeverityInfo,
+			labels:        map[string]string{"team": "payments", "service": "mysql"},
+			wantRoutes:    []string{"payments-db"},
+			wantNotifiers: []string{"slack", "email"},
+			wantChannel:   "C-PAYMENTS",
+		},
+		{
+			name:          "regex must match the whole value",
+			severity:      entity.SeverityInfo,
+			labels:        map[string]string{"team": "payments", "service": "mysql-exporter"},
+			wantRoutes:    []string{"payments"},
+			wantNotifiers: []string{"slack"},
+			wantChannel:   "C-PAYMENTS",
+		},
+		{
+			name:          "continue matches later siblings",
+			severity:      entity.SeverityCritical,
+			labels:        map[string]string{"team": "payments"},
+			wantRoutes:    []string{"critical-pager", "payments"},
+			wantNotifiers: []string{"pagerduty", "slack"},
+			wantChannel:   "C-PAYMENTS",
+		},
+		{
+			name:       "empty notifiers deliver nowhere",
+			severity:   entity.SeverityWarning,
+			labels:     map[string]string{"env": "dev"},
+			wantRoutes: []string{"dev"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decision := tree.Route(newRoutedAlert(tt.severity, tt.labels))
+
+			assert.Equal(t, tt.wantRoutes, decision.Routes)
+			assert.Equal(t, tt.wantChannel, decision.SlackChannelID)
+			assert.Len(t, decision.Notifiers, len(tt.wantNotifiers))
+			for _, name := range tt.wantNotifiers {
+				assert.True(t, decision.Includes(name), "expected notifier %s", name)
+			}
+		})
+	}
+}
+
+func TestRoutingDecision_CanaryRoutesItself(t *testing.T) {
+	decision := RoutingDecision{Notifiers: map[string]bool{"slack": true}, SlackChannelID: "C-TEAM"}
+
+	assert.True(t, decision.Includes(canaryNotifierName))
+	assert.True(t, RoutingDecision{}.Includes(canaryNotifierName))
+	assert.Equal(t, "C-TEAM", decision.Channel("slack"))
+	assert.Empty(t, decision.Channel(canaryNotifierName))
+}
@@ -0,0 +1,200 @@
+package alert
+
+import (
+	"context"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/observability"
+)
+
+// shardVirtualNodes is the number of points each shard has on the hash
+// ring, which evens out the share of keys each shard gets.
+const shardVirtualNodes = 64
+
+// ShardPool runs work on a fixed set of worker shards. The shard of a key is
+// chosen by consistent hashing, so work with the same key always runs on
+// the same shard, in the order it was submitted, while work with different
+// keys runs in parallel. Since the ring is keyed by shard name, adding or
+// removing a member moves only that member's share of keys, which lets the
+// members later be replicas instead of in-process workers.
+type ShardPool struct {
+	ring      *hashRing
+	queueSize int
+	metrics   *observability.Metrics
+
+	mu      sync.RWMutex
+	running bool
+	shards  map[string]chan shardJob
+	wg      sync.WaitGroup
+}
+
+type shardJob struct {
+	ctx  context.Context
+	fn   func(context.Context)
+	done chan struct{}
+}
+
+// shardContextKey marks the context of work running on a shard.
+type shardContextKey struct{}
+
+// NewShardPool creates a pool of count shards, each holding up to queueSize
+// waiting jobs. metrics may be nil. Until Run is called, and after it
+// returns, work runs on the caller's goroutine.
+func NewShardPool(count, queueSize int, metrics *observability.Metrics) *ShardPool {
+	names := make([]string, count)
+	for i := range names {
+		names[i] = strconv.Itoa(i)
+	}
+	return &ShardPool{
+		ring:      newHashRing(names, shardVirtualNodes),
+		queueSize: queueSize,
+		metrics:   metrics,
+	}
+}
+
+// Shard returns the name of the shard key maps to.
+func (p *ShardPool) Shard(key string) string {
+	return p.ring.owner(key)
+}
+
+// Run starts one worker per shard and blocks until ctx is done. Jobs
+// already queued then still run; later ones run on the caller's goroutine.
+// Wait blocks until the queued jobs have run.
+func (p *ShardPool) Run(ctx context.Context) {
+	p.mu.Lock()
+	p.shards = make(map[string]chan shardJob, len(p.ring.members))
+	for _, name := range p.ring.members {
+		queue := make(chan shardJob, p.queueSize)
+		p.shards[name] = queue
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			p.work(name, queue)
+		}()
+	}
+	p.running = true
+	p.mu.Unlock()
+
+	<-ctx.Done()
+
+	p.mu.Lock()
+	p.running = false
+	for _, queue := range p.shards {
+		close(queue)
+	}
+	p.mu.Unlock()
+	p.wg.Wait()
+}
+
+// Wait blocks until the jobs queued before shutdown have run, or ctx is
+// done.
+func (p *ShardPool) Wait(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		p.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (p *ShardPool) work(name string, queue <-chan shardJob) {
+	for job := range queue {
+		if p.metrics != nil {
+			p.metrics.RecordShardQueued(job.ctx, name, -1)
+		}
+		job.fn(context.WithValue(job.ctx, shardContextKey{}, name))
+		close(job.done)
+	}
+}
+
+// Submit queues fn on the shard of key and returns a channel closed once
+// fn has run. It waits while the shard's queue is full, and fails if ctx is
+// done first. fn runs at once on the caller's goroutine if the pool is not
+// running, or if the caller is itself running on a shard, since waiting
+// for another shard from one could deadlock.
+func (p *ShardPool) Submit(ctx context.Context, key string, fn func(context.Context)) (<-chan struct{}, error) {
+	done := make(chan struct{})
+
+	p.mu.RLock()
+	if !p.running || ctx.Value(shardContextKey{}) != nil {
+		p.mu.RUnlock()
+		fn(ctx)
+		close(done)
+		return done, nil
+	}
+	defer p.mu.RUnlock()
+
+	name := p.ring.owner(key)
+	if p.metrics != nil {
+		p.metrics.RecordShardQueued(ctx, name, 1)
+	}
+	select {
+	case p.shards[name] <- shardJob{ctx: ctx, fn: fn, done: done}:
+		return done, nil
+	case <-ctx.Done():
+		if p.metrics != nil {
+			p.metrics.RecordShardQueued(ctx, name, -1)
+		}
+		return nil, ctx.Err()
+	}
+}
+
+// hashRing maps keys to members by consistent hashing: each member owns
+// the keys hashing between its points and the previous points on the ring.
+type hashRing struct {
+	members []string
+	points  []uint64
+	owners  map[uint64]string
+}
+
+func newHashRing(members []string, virtualNodes int) *hashRing {
+	r := &hashRing{
+		members: members,
+		owners:  make(map[uint64]string, len(members)*virtualNodes),
+	}
+	for _, member := range members {
+		for i := 0; i < virtualNodes; i++ {
+			point := hashKey(member + "#" + strconv.Itoa(i))
+			if _, taken := r.owners[point]; taken {
+				continue
+			}
+			r.owners[point] = member
+			r.points = append(r.points, point)
+		}
+	}
+	sort.Slice(r.points, func(i, j int) bool { return r.points[i] < r.points[j] })
+	return r
+}
+
+// owner returns the member owning key: the member of the first point at
+// or after the key's hash, wrapping around.
+func (r *hashRing) owner(key string) string {
+	h := hashKey(key)
+	i := sort.Search(len(r.points), func(i int) bool { return r.points[i] >= h })
+	if i == len(r.points) {
+		i = 0
+	}
+	return r.owners[r.points[i]]
+}
+
+// hashKey hashes key with FNV-1a, then mixes the bits, since FNV alone
+// spreads short, similar keys such as "0#1" and "0#2" unevenly.
+func hashKey(key string) uint64 {
+	f := fnv.New64a()
+	f.Write([]byte(key))
+	h := f.Sum64()
+	h ^= h >> 33
+	h *= 0xff51afd7ed558ccd
+	h ^= h >> 33
+	h *= 0xc4ceb9fe1a85ec53
+	h ^= h >> 33
+	return h
+}
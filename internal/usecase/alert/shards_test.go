@@ -0,0 +1,129 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashRing(t *testing.T) {
+	members := []string{"0", "1", "2", "3"}
+	ring := newHashRing(members, shardVirtualNodes)
+	grown := newHashRing(append(members, "4"), shardVirtualNodes)
+
+	counts := make(map[string]int)
+	moved := 0
+	const keys = 10000
+	for i := 0; i < keys; i++ {
+		key := fmt.Sprintf("fingerprint-%d", i)
+		owner := ring.owner(key)
+		assert.Equal(t, owner, ring.owner(key))
+		counts[owner]++
+
+		if newOwner := grown.owner(key); newOwner != owner {
+			// Keys only move to the new member
+			assert.Equal(t, "4", newOwner)
+			moved++
+		}
+	}
+
+	for _, member := range members {
+		assert.InDelta(t, keys/len(members), counts[member], keys/10, "member %s", member)
+	}
+	assert.InDelta(t, keys/5, moved, keys/10)
+}
+
+func TestShardPool(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	pool := NewShardPool(4, 10, nil)
+
+	// Not running: work runs on the caller's goroutine
+	ran := false
+	done, err := pool.Submit(ctx, "fp", func(context.Context) { ran = true })
+	require.NoError(t, err)
+	<-done
+	assert.True(t, ran)
+
+	stopped := make(chan struct{})
+	go func() {
+		pool.Run(ctx)
+		close(stopped)
+	}()
+	require.Eventually(t, func() bool {
+		pool.mu.RLock()
+		defer pool.mu.RUnlock()
+		return pool.running
+	}, time.Second, time.Millisecond)
+
+	t.Run("same key runs in order", func(t *testing.T) {
+		var mu sync.Mutex
+		var order []int
+		var dones []<-chan struct{}
+		for i := 0; i < 50; i++ {
+			done, err := pool.Submit(ctx, "fp-1", func(context.Context) {
+				mu.Lock()
+				order = append(order, i)
+				mu.Unlock()
+			})
+			require.NoError(t, err)
+			dones = append(dones, done)
+		}
+		for _, done := range dones {
+			<-done
+		}
+		for i, n := range order {
+			assert.Equal(t, i, n)
+		}
+	})
+
+	t.Run("different keys run in parallel", func(t *testing.T) {
+		a, b := "fp-a", "fp-b"
+		for i := 0; pool.Shard(a) == pool.Shard(b); i++ {
+			b = fmt.Sprintf("fp-b%d", i)
+		}
+
+		release := make(chan struct{})
+		blocked, err := pool.Submit(ctx, a, func(context.Context) { <-release })
+		require.NoError(t, err)
+		other, err := pool.Submit(ctx, b, func(context.Context) {})
+		require.NoError(t, err)
+
+		select {
+		case <-other:
+		case <-time.After(time.Second):
+			t.Fatal("work on another shard waited for a blocked shard")
+		}
+		close(release)
+		<-blocked
+	})
+
+	t.Run("work on a shard runs nested work inline", func(t *testing.T) {
+		nested := false
+		done, err := pool.Submit(ctx, "fp-1", func(ctx context.Context) {
+			inner, err := pool.Submit(ctx, "fp-2", func(context.Context) { nested = true })
+			require.NoError(t, err)
+			<-inner
+		})
+		require.NoError(t, err)
+		<-done
+		assert.True(t, nested)
+	})
+
+	// Work queued before shutdown still runs, and Wait waits for it
+	release := make(chan struct{})
+	queued, err := pool.Submit(ctx, "fp-1", func(context.Context) { <-release })
+	require.NoError(t, err)
+	cancel()
+	waitCtx, waitCancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer waitCancel()
+	assert.ErrorIs(t, pool.Wait(waitCtx), context.DeadlineExceeded)
+	close(release)
+	<-queued
+	require.NoError(t, pool.Wait(context.Background()))
+	<-stopped
+}
@@ -0,0 +1,145 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/observability"
+)
+
+// silenceExpiringWindow is how soon a silence must expire to count as
+// expiring in the metrics.
+const silenceExpiringWindow = time.Hour
+
+// SilenceReminder asks the creator of a silence whether to extend it.
+// Implemented by the Slack client.
+type SilenceReminder interface {
+	RemindSilenceExpiry(ctx context.Context, silence *entity.SilenceMark) error
+}
+
+// SilenceMonitor records how many silences are active and about to expire,
+// and optionally reminds their creators shortly before they expire.
+type SilenceMonitor struct {
+	silenceRepo repository.SilenceRepository
+	interval    time.Duration
+	logger      Logger
+	metrics     *observability.Metrics
+	now         func() time.Time
+
+	// Expiry reminders (optional)
+	reminder     SilenceReminder
+	remindBefore time.Duration
+
+	// reminded holds the end of each silence its creator was reminded of,
+	// so that a silence extended afterwards is reminded of again.
+	mu       sync.Mutex
+	reminded map[string]time.Time
+}
+
+// NewSilenceMonitor creates a monitor that checks the active silences
+// every interval.
+func NewSilenceMonitor(silenceRepo repository.SilenceRepository, interval time.Duration, logger Logger) *SilenceMonitor {
+	return &SilenceMonitor{
+		silenceRepo: silenceRepo,
+		interval:    interval,
+		logger:      logger,
+		now:         time.Now,
+		reminded:    make(map[string]time.Time),
+	}
+}
+
+// SetMetrics enables the silence gauges.
+func (m *SilenceMonitor) SetMetrics(metrics *observability.Metrics) {
+	m.metrics = metrics
+}
+
+// SetReminder reminds the creators of silences that expire within before.
+// Only silences that recorded their creator's email can be reminded.
+func (m *SilenceMonitor) SetReminder(reminder SilenceReminder, before time.Duration) {
+	m.reminder = reminder
+	m.remindBefore = before
+}
+
+// Run checks the silences until ctx is cancelled.
+func (m *SilenceMonitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := m.Check(ctx); err != nil {
+				m.logger.Error("failed to check silences", "error", err)
+			}
+		}
+	}
+}
+
+// Check records the silence gauges and sends the due reminders. It returns
+// the IDs of the silences whose creators were reminded.
+func (m *SilenceMonitor) Check(ctx context.Context) ([]string, error) {
+	silences, err := m.silenceRepo.FindActive(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("finding active silences: %w", err)
+	}
+
+	now := m.now().UTC()
+	expiring := 0
+	for _, silence := range silences {
+		if silence.EndAt.Sub(now) <= silenceExpiringWindow {
+			expiring++
+		}
+	}
+	if m.metrics != nil {
+		m.metrics.RecordSilences(ctx, len(silences), expiring)
+	}
+
+	if m.reminder == nil {
+		return nil, nil
+	}
+	return m.remind(ctx, silences, now), nil
+}
+
+// remind asks the creators of the silences about to expire whether to
+// extend them, once per silence end.
+func (m *SilenceMonitor) remind(ctx context.Context, silences []*entity.SilenceMark, now time.Time) []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	active := make(map[string]bool, len(silences))
+	var reminded []string
+	for _, silence := range silences {
+		active[silence.ID] = true
+		if silence.CreatedByEmail == "" || silence.EndAt.Sub(now) > m.remindBefore {
+			continue
+		}
+		if endAt, ok := m.reminded[silence.ID]; ok && endAt.Equal(silence.EndAt) {
+			continue
+		}
+
+		if err := m.reminder.RemindSilenceExpiry(ctx, silence); err != nil {
+			m.logger.Warn("failed to remind of silence expiry",
+				"silenceID", silence.ID,
+				"createdBy", silence.CreatedBy,
+				"error", err,
+			)
+			continue
+		}
+		m.reminded[silence.ID] = silence.EndAt
+		reminded = append(reminded, silence.ID)
+	}
+
+	// Forget silences that expired or were deleted
+	for id := range m.reminded {
+		if !active[id] {
+			delete(m.reminded, id)
+		}
+	}
+	return reminded
+}
@@ -0,0 +1,87 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/memory"
+)
+
+type recordingReminder struct {
+	reminded []string
+	err      error
+}
+
+func (r *recordingReminder) RemindSilenceExpiry(_ context.Context, silence *entity.SilenceMark) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.reminded = append(r.reminded, silence.ID)
+	return nil
+}
+
+func TestSilenceMonitor_Check(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewSilenceRepository()
+
+	newSilence := func(duration time.Duration, email string) *entity.SilenceMark {
+		silence, err := entity.NewSilenceMark(duration, "alice", email, entity.AckSourceSlack)
+		require.NoError(t, err)
+		silence.WithLabel("team", "db")
+		require.NoError(t, repo.Save(ctx, silence))
+		return silence
+	}
+	expiring := newSilence(10*time.Minute, "alice@example.com")
+	noEmail := newSilence(10*time.Minute, "")
+	newSilence(4*time.Hour, "alice@example.com")
+
+	reminder := &recordingReminder{}
+	monitor := NewSilenceMonitor(repo, time.Minute, nopLogger{})
+	monitor.SetReminder(reminder, 15*time.Minute)
+
+	reminded, err := monitor.Check(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{expiring.ID}, reminded)
+	assert.NotContains(t, reminder.reminded, noEmail.ID)
+
+	// Each silence end is reminded of once
+	reminded, err = monitor.Check(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, reminded)
+
+	// An extended silence is reminded of again when it nears its new end
+	require.NoError(t, expiring.Extend(time.Minute))
+	require.NoError(t, repo.Update(ctx, expiring))
+	reminded, err = monitor.Check(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{expiring.ID}, reminded)
+
+	// Failed reminders are retried on the next check
+	other := newSilence(5*time.Minute, "bob@example.com")
+	reminder.err = errors.New("slack unavailable")
+	reminded, err = monitor.Check(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, reminded)
+	reminder.err = nil
+	reminded, err = monitor.Check(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{other.ID}, reminded)
+}
+
+func TestSilenceMonitor_CheckWithoutReminder(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewSilenceRepository()
+	silence, err := entity.NewSilenceMark(5*time.Minute, "alice", "alice@example.com", entity.AckSourceSlack)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, silence))
+
+	reminded, err := NewSilenceMonitor(repo, time.Minute, nopLogger{}).Check(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, reminded)
+}
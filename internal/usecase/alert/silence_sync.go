@@ -0,0 +1,144 @@
+package alert
+
+import (
+	"context"
+	"sort"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+)
+
+// SilenceMirror keeps copies of silences in another silence store.
+// Implemented by the Alertmanager client.
+type SilenceMirror interface {
+	CreateSilence(ctx context.Context, silence *entity.SilenceMark, matchers []entity.LabelMatcher) error
+	ExtendSilence(ctx context.Context, silence *entity.SilenceMark) error
+	ExpireSilence(ctx context.Context, silenceID string) error
+}
+
+// SilenceSync mirrors silences made in Slack in Alertmanager, so that it
+// stops sending the silenced alerts too. The copies end when the silences
+// do; deleting or extending a silence changes its copy. Failures are logged
+// and leave the silence in place. A nil SilenceSync does nothing.
+type SilenceSync struct {
+	mirror    SilenceMirror
+	alertRepo repository.AlertRepository
+	logger    Logger
+}
+
+// NewSilenceSync creates a sync to the mirror. alertRepo provides the
+// labels of silences of an alert or fingerprint.
+func NewSilenceSync(mirror SilenceMirror, alertRepo repository.AlertRepository, logger Logger) *SilenceSync {
+	return &SilenceSync{mirror: mirror, alertRepo: alertRepo, logger: logger}
+}
+
+// Created mirrors a new silence. Recurring silences, which Alertmanager
+// cannot express, and silences matching every alert are not mirrored.
+func (s *SilenceSync) Created(ctx context.Context, silence *entity.SilenceMark) {
+	if !s.mirrors(silence) {
+		return
+	}
+	if silence.Recurrence != nil {
+		s.logger.Debug("not mirroring recurring silence", "silenceID", silence.ID)
+		return
+	}
+
+	matchers, err := s.matchers(ctx, silence)
+	if err != nil {
+		s.logger.Warn("failed to find labels of silence",
+			"silenceID", silence.ID,
+			"error", err,
+		)
+		return
+	}
+	if len(matchers) == 0 {
+		s.logger.Debug("not mirroring silence without matchers", "silenceID", silence.ID)
+		return
+	}
+
+	if err := s.mirror.CreateSilence(ctx, silence, matchers); err != nil {
+		s.logger.Warn("failed to mirror silence",
+			"silenceID", silence.ID,
+			"error", err,
+		)
+		return
+	}
+	s.logger.Info("mirrored silence in alertmanager",
+		"silenceID", silence.ID,
+		"matchers", len(matchers),
+	)
+}
+
+// Extended moves the end of a silence's copy to the silence's end.
+func (s *SilenceSync) Extended(ctx context.Context, silence *entity.SilenceMark) {
+	if !s.mirrors(silence) {
+		return
+	}
+	if err := s.mirror.ExtendSilence(ctx, silence); err != nil {
+		s.logger.Warn("failed to extend mirrored silence",
+			"silenceID", silence.ID,
+			"error", err,
+		)
+	}
+}
+
+// Deleted expires the copy of a deleted silence.
+func (s *SilenceSync) Deleted(ctx context.Context, silence *entity.SilenceMark) {
+	if !s.mirrors(silence) {
+		return
+	}
+	if err := s.mirror.ExpireSilence(ctx, silence.ID); err != nil {
+		s.logger.Warn("failed to expire mirrored silence",
+			"silenceID", silence.ID,
+			"error", err,
+		)
+	}
+}
+
+// mirrors reports whether the silence is mirrored, which only those made
+// in Slack are.
+func (s *SilenceSync) mirrors(silence *entity.SilenceMark) bool {
+	return s != nil && silence.Source == entity.AckSourceSlack
+}
+
+// matchers returns the label matchers equivalent to the silence. Silences
+// of an alert or fingerprint match all labels of the alert.
+func (s *SilenceSync) matchers(ctx context.Context, silence *entity.SilenceMark) ([]entity.LabelMatcher, error) {
+	matchers := silence.LabelMatchers()
+	if silence.Instance != "" {
+		matchers = append(matchers, entity.LabelMatcher{Name: "instance", Type: entity.MatchEqual, Value: silence.Instance})
+	}
+
+	var target *entity.Alert
+	var err error
+	switch {
+	case silence.AlertID != "":
+		target, err = s.alertRepo.FindByID(ctx, silence.AlertID)
+	case silence.Fingerprint != "":
+		// Alerts of a fingerprint share their labels
+		var alerts []*entity.Alert
+		alerts, err = s.alertRepo.FindByFingerprint(ctx, silence.Fingerprint)
+		if len(alerts) > 0 {
+			target = alerts[0]
+		}
+	default:
+		return matchers, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if target == nil || len(target.Labels) == 0 {
+		// Without labels the silence cannot be narrowed to the alert
+		return nil, nil
+	}
+
+	names := make([]string, 0, len(target.Labels))
+	for name := range target.Labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		matchers = append(matchers, entity.LabelMatcher{Name: name, Type: entity.MatchEqual, Value: target.Labels[name]})
+	}
+	return matchers, nil
+}
@@ -0,0 +1,82 @@
+package alert
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/memory"
+)
+
+type fakeSilenceMirror struct {
+	created  map[string][]entity.LabelMatcher
+	extended []string
+	expired  []string
+}
+
+func (f *fakeSilenceMirror) CreateSilence(_ context.Context, silence *entity.SilenceMark, matchers []entity.LabelMatcher) error {
+	f.created[silence.ID] = matchers
+	return nil
+}
+
+func (f *fakeSilenceMirror) ExtendSilence(_ context.Context, silence *entity.SilenceMark) error {
+	f.extended = append(f.extended, silence.ID)
+	return nil
+}
+
+func (f *fakeSilenceMirror) ExpireSilence(_ context.Context, silenceID string) error {
+	f.expired = append(f.expired, silenceID)
+	return nil
+}
+
+func TestSilenceSync(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewAlertRepository()
+	mirror := &fakeSilenceMirror{created: make(map[string][]entity.LabelMatcher)}
+	sync := NewSilenceSync(mirror, repo, nopLogger{})
+
+	a := entity.NewAlert("fp1", "HighCPU", "web-1", "", "summary", entity.SeverityWarning)
+	a.Labels = map[string]string{"alertname": "HighCPU", "instance": "web-1"}
+	require.NoError(t, repo.Save(ctx, a))
+
+	newSilence := func(source entity.AckSource) *entity.SilenceMark {
+		s, err := entity.NewSilenceMark(time.Hour, "alice", "", source)
+		require.NoError(t, err)
+		return s
+	}
+
+	// Silences of a fingerprint match the labels of its alerts
+	byFingerprint := newSilence(entity.AckSourceSlack).ForFingerprint("fp1")
+	sync.Created(ctx, byFingerprint)
+	assert.Equal(t, []entity.LabelMatcher{
+		{Name: "alertname", Type: entity.MatchEqual, Value: "HighCPU"},
+		{Name: "instance", Type: entity.MatchEqual, Value: "web-1"},
+	}, mirror.created[byFingerprint.ID])
+
+	byLabels := newSilence(entity.AckSourceSlack).WithLabel("env", "prod")
+	sync.Created(ctx, byLabels)
+	assert.Len(t, mirror.created[byLabels.ID], 1)
+
+	// Not mirrored: silences of every alert, unknown fingerprints, and
+	// silences made outside Slack
+	sync.Created(ctx, newSilence(entity.AckSourceSlack))
+	sync.Created(ctx, newSilence(entity.AckSourceSlack).ForFingerprint("unknown"))
+	fromAPI := newSilence(entity.AckSourceAPI).WithLabel("env", "prod")
+	sync.Created(ctx, fromAPI)
+	assert.Len(t, mirror.created, 2)
+
+	sync.Extended(ctx, byLabels)
+	sync.Deleted(ctx, byLabels)
+	sync.Deleted(ctx, fromAPI)
+	assert.Equal(t, []string{byLabels.ID}, mirror.extended)
+	assert.Equal(t, []string{byLabels.ID}, mirror.expired)
+
+	// A nil sync does nothing
+	var disabled *SilenceSync
+	disabled.Created(ctx, byLabels)
+	disabled.Deleted(ctx, byLabels)
+}
@@ -0,0 +1,120 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/adapter/dto"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+)
+
+// staleReaperAuthor signs the note left on auto-resolved alerts.
+const staleReaperAuthor = "alert-bridge"
+
+// StaleAlertReaper resolves Alertmanager alerts that have not been sent
+// again for a while, such as when Alertmanager missed the resolve webhook,
+// so that they do not stay firing forever. Their Slack and PagerDuty
+// notifications are updated like for any resolution.
+type StaleAlertReaper struct {
+	processAlert *ProcessAlertUseCase
+	staleAfter   time.Duration
+	interval     time.Duration
+	logger       Logger
+	now          func() time.Time
+}
+
+// NewStaleAlertReaper creates a reaper that every interval resolves alerts
+// not sent for staleAfter. staleAfter must exceed Alertmanager's
+// repeat_interval, which is how often it re-sends alerts that are still
+// firing.
+func NewStaleAlertReaper(processAlert *ProcessAlertUseCase, staleAfter, interval time.Duration, logger Logger) *StaleAlertReaper {
+	return &StaleAlertReaper{
+		processAlert: processAlert,
+		staleAfter:   staleAfter,
+		interval:     interval,
+		logger:       logger,
+		now:          time.Now,
+	}
+}
+
+// Run sweeps until ctx is cancelled.
+func (r *StaleAlertReaper) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := r.Sweep(ctx); err != nil {
+				r.logger.Error("failed to resolve stale alerts", "error", err)
+			}
+		}
+	}
+}
+
+// Sweep resolves the stale alerts and returns their IDs.
+func (r *StaleAlertReaper) Sweep(ctx context.Context) ([]string, error) {
+	uc := r.processAlert
+	firing, err := uc.alertRepo.FindFiring(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("finding firing alerts: %w", err)
+	}
+
+	now := r.now().UTC()
+	var resolved []string
+	for _, alert := range firing {
+		if !r.isStale(alert, now) {
+			continue
+		}
+
+		lastSeen := alert.LastSeen()
+		alert.Resolve(r.resolutionTime(alert, now))
+		note := fmt.Sprintf("Auto-resolved: not sent by Alertmanager since %s", lastSeen.Format(time.RFC3339))
+		if err := alert.AddNote(staleReaperAuthor, note, now); err != nil {
+			return resolved, err
+		}
+		if err := uc.alertRepo.Update(ctx, alert); err != nil {
+			r.logger.Error("failed to resolve stale alert",
+				"alertID", alert.ID,
+				"error", err,
+			)
+			continue
+		}
+		uc.timeline.Record(ctx, alert.ID, entity.AlertEventResolved, staleReaperAuthor, note)
+
+		uc.updateNotifications(ctx, alert, &dto.ProcessAlertOutput{})
+		resolved = append(resolved, alert.ID)
+
+		r.logger.Info("resolved stale alert",
+			"alertID", alert.ID,
+			"fingerprint", alert.Fingerprint,
+			"lastSeen", lastSeen,
+		)
+	}
+
+	return resolved, nil
+}
+
+// isStale reports whether the alert came from Alertmanager, which re-sends
+// firing alerts, and was not sent for staleAfter nor is expected to still
+// be firing by the endsAt it last reported.
+func (r *StaleAlertReaper) isStale(alert *entity.Alert, now time.Time) bool {
+	if alert.GroupKey == "" {
+		return false
+	}
+	if now.Sub(alert.LastSeen()) < r.staleAfter {
+		return false
+	}
+	return alert.EndsAt == nil || isExpired(*alert.EndsAt, now)
+}
+
+// resolutionTime is the reported endsAt when usable, otherwise now.
+func (r *StaleAlertReaper) resolutionTime(alert *entity.Alert, now time.Time) time.Time {
+	if alert.EndsAt == nil {
+		return now
+	}
+	return resolutionTime(alert, *alert.EndsAt, now)
+}
@@ -0,0 +1,77 @@
+package alert
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/memory"
+)
+
+type recordingNotifier struct {
+	updated []string
+}
+
+func (n *recordingNotifier) Notify(context.Context, *entity.Alert) (entity.NotifyResult, error) {
+	return entity.NotifyResult{}, nil
+}
+
+func (n *recordingNotifier) UpdateMessage(_ context.Context, messageID string, _ *entity.Alert) error {
+	n.updated = append(n.updated, messageID)
+	return nil
+}
+
+func (n *recordingNotifier) Name() string { return "slack" }
+
+func TestStaleAlertReaper_Sweep(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	newFiring := func(fingerprint, groupKey string, lastSeenAgo time.Duration, endsAt time.Time) *entity.Alert {
+		alert := entity.NewAlert(fingerprint, "HighCPU", "host-1", "", "summary", entity.SeverityWarning)
+		alert.GroupKey = groupKey
+		alert.Seen(now.Add(-lastSeenAgo), endsAt)
+		alert.SetExternalReference("slack", "C1:"+fingerprint)
+		return alert
+	}
+
+	repo := memory.NewAlertRepository()
+	stale := newFiring("fp1", "{}:{alertname=\"HighCPU\"}", 2*time.Hour, time.Time{})
+	notAlertmanager := newFiring("fp2", "", 2*time.Hour, time.Time{})
+	recent := newFiring("fp3", "{}:{alertname=\"HighCPU\"}", time.Minute, time.Time{})
+	notEnded := newFiring("fp4", "{}:{alertname=\"HighCPU\"}", 2*time.Hour, now.Add(time.Hour))
+	for _, alert := range []*entity.Alert{stale, notAlertmanager, recent, notEnded} {
+		require.NoError(t, repo.Save(ctx, alert))
+	}
+
+	notifier := &recordingNotifier{}
+	processAlert := NewProcessAlertUseCase(repo, memory.NewSilenceRepository(), []Notifier{notifier}, nopLogger{}, nil)
+	reaper := NewStaleAlertReaper(processAlert, time.Hour, time.Minute, nopLogger{})
+	reaper.now = func() time.Time { return now }
+
+	resolved, err := reaper.Sweep(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{stale.ID}, resolved)
+	assert.Equal(t, []string{"C1:fp1"}, notifier.updated)
+
+	stored, err := repo.FindByID(ctx, stale.ID)
+	require.NoError(t, err)
+	assert.True(t, stored.IsResolved())
+	require.Len(t, stored.Notes, 1)
+	assert.Equal(t, staleReaperAuthor, stored.Notes[0].By)
+
+	for _, alert := range []*entity.Alert{notAlertmanager, recent, notEnded} {
+		stored, err := repo.FindByID(ctx, alert.ID)
+		require.NoError(t, err)
+		assert.False(t, stored.IsResolved(), alert.Fingerprint)
+	}
+
+	// Resolved alerts are not picked up again
+	resolved, err = reaper.Sweep(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, resolved)
+}
@@ -0,0 +1,71 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+)
+
+// TagAlertInput represents the input for replacing an alert's tags.
+type TagAlertInput struct {
+	AlertID string
+	Tags    []string
+
+	// By identifies who changed the tags, for logging.
+	By string
+}
+
+// TagAlertOutput represents the result of tagging an alert.
+type TagAlertOutput struct {
+	Alert *entity.Alert
+}
+
+// TagAlertUseCase sets responder tags on an alert.
+type TagAlertUseCase struct {
+	alertRepo repository.AlertRepository
+	logger    Logger
+}
+
+// NewTagAlertUseCase creates a new TagAlertUseCase.
+func NewTagAlertUseCase(alertRepo repository.AlertRepository, logger Logger) *TagAlertUseCase {
+	return &TagAlertUseCase{
+		alertRepo: alertRepo,
+		logger:    logger,
+	}
+}
+
+// Execute replaces the alert's tags with input.Tags.
+// Returns entity.ErrInvalidTag if any tag is malformed.
+func (uc *TagAlertUseCase) Execute(ctx context.Context, input TagAlertInput) (*TagAlertOutput, error) {
+	// 1. Load the alert
+	alert, err := uc.alertRepo.FindByID(ctx, input.AlertID)
+	if err != nil {
+		return nil, fmt.Errorf("finding alert: %w", err)
+	}
+	if alert == nil {
+		return nil, entity.ErrAlertNotFound
+	}
+
+	// 2. Apply the tags (validated and normalized by the entity)
+	previous := alert.Tags
+	if err := alert.SetTags(input.Tags, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+
+	// 3. Persist
+	if err := uc.alertRepo.Update(ctx, alert); err != nil {
+		return nil, fmt.Errorf("updating alert: %w", err)
+	}
+
+	uc.logger.Info("alert tags updated",
+		"alertID", alert.ID,
+		"previous", previous,
+		"tags", alert.Tags,
+		"by", input.By,
+	)
+
+	return &TagAlertOutput{Alert: alert}, nil
+}
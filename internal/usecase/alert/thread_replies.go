@@ -0,0 +1,132 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+)
+
+// ThreadReplies posts an alert's lifecycle events as replies under its
+// Slack message. Edits of the message replace its previous state and
+// notify nobody; replies keep the history visible in the channel and
+// notify the thread's followers.
+type ThreadReplies struct {
+	alertRepo      repository.AlertRepository
+	threadNotifier ThreadNotifier
+	events         map[entity.AlertEventType]bool
+	logger         Logger
+}
+
+// NewThreadReplies creates a ThreadReplies that posts the given event
+// types. Other events are only recorded on the timeline.
+func NewThreadReplies(
+	alertRepo repository.AlertRepository,
+	threadNotifier ThreadNotifier,
+	events []entity.AlertEventType,
+	logger Logger,
+) *ThreadReplies {
+	enabled := make(map[entity.AlertEventType]bool, len(events))
+	for _, eventType := range events {
+		enabled[eventType] = true
+	}
+	return &ThreadReplies{
+		alertRepo:      alertRepo,
+		threadNotifier: threadNotifier,
+		events:         enabled,
+		logger:         logger,
+	}
+}
+
+// Post replies in the thread of the alert's Slack message if the event
+// type is enabled and the alert was posted to Slack. Failures are logged.
+func (r *ThreadReplies) Post(ctx context.Context, event *entity.AlertEvent) {
+	if r == nil || !r.events[event.Type] {
+		return
+	}
+
+	alert, err := r.alertRepo.FindByID(ctx, event.AlertID)
+	if err != nil || alert == nil {
+		r.logger.Warn("failed to load alert for thread reply",
+			"alertID", event.AlertID,
+			"type", event.Type,
+			"error", err,
+		)
+		return
+	}
+	messageID := alert.GetExternalReference("slack")
+	if messageID == "" {
+		return
+	}
+
+	if err := r.threadNotifier.PostThreadReply(ctx, messageID, threadReplyText(alert, event)); err != nil {
+		r.logger.Error("failed to post thread reply",
+			"alertID", alert.ID,
+			"type", event.Type,
+			"error", err,
+		)
+	}
+}
+
+// threadReplyText renders an event, e.g. "Resolved after 42m by alice".
+func threadReplyText(alert *entity.Alert, event *entity.AlertEvent) string {
+	by := ""
+	if event.By != "" {
+		by = " by " + event.By
+	}
+
+	switch event.Type {
+	case entity.AlertEventAcknowledged:
+		text := ":eyes: Acknowledged" + by
+		if event.Detail != "" {
+			text += " via " + event.Detail
+		}
+		return text
+	case entity.AlertEventUnacknowledged:
+		text := ":leftwards_arrow_with_hook: Unacknowledged" + by
+		if event.Detail != "" {
+			text += " (" + event.Detail + ")"
+		}
+		return text
+	case entity.AlertEventEscalated:
+		return ":arrow_double_up: Escalated " + event.Detail
+	case entity.AlertEventResolved:
+		text := ":white_check_mark: Resolved"
+		if alert.ResolvedAt != nil {
+			text += " after " + formatLifetime(alert.ResolvedAt.Sub(alert.FiredAt))
+		}
+		text += by
+		if event.Detail != "" {
+			text += " (" + event.Detail + ")"
+		}
+		return text
+	case entity.AlertEventSilenced:
+		return fmt.Sprintf(":no_bell: Silenced %s%s", event.Detail, by)
+	case entity.AlertEventSeverityChanged:
+		return ":arrow_up_down: Severity changed: " + event.Detail
+	case entity.AlertEventNoteAdded:
+		return fmt.Sprintf(":memo: Note from %s: %s", event.By, event.Detail)
+	default:
+		return fmt.Sprintf("%s%s: %s", event.Type, by, event.Detail)
+	}
+}
+
+// formatLifetime renders how long an alert fired, e.g. "42m" or "1h5m",
+// in seconds below a minute.
+func formatLifetime(d time.Duration) string {
+	if d < time.Minute {
+		return d.Round(time.Second).String()
+	}
+	d = d.Round(time.Minute)
+	hours, minutes := int(d.Hours()), int(d.Minutes())%60
+	switch {
+	case hours == 0:
+		return fmt.Sprintf("%dm", minutes)
+	case minutes == 0:
+		return fmt.Sprintf("%dh", hours)
+	default:
+		return fmt.Sprintf("%dh%dm", hours, minutes)
+	}
+}
@@ -0,0 +1,64 @@
+package alert
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/memory"
+)
+
+func TestThreadReplies_Post(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	threads := &fakeThreadNotifier{replies: make(map[string]string)}
+	timeline := NewTimeline(memory.NewAlertEventRepository(), nopLogger{})
+	timeline.SetThreadReplies(NewThreadReplies(alertRepo, threads,
+		[]entity.AlertEventType{entity.AlertEventAcknowledged, entity.AlertEventResolved}, nopLogger{}))
+
+	posted := entity.NewAlert("fp1", "HighCPU", "host-1", "", "summary", entity.SeverityCritical)
+	posted.SetExternalReference("slack", "C1:posted")
+	posted.Resolve(posted.FiredAt.Add(42 * time.Minute))
+	require.NoError(t, alertRepo.Save(ctx, posted))
+	unposted := entity.NewAlert("fp2", "HighCPU", "host-2", "", "summary", entity.SeverityCritical)
+	require.NoError(t, alertRepo.Save(ctx, unposted))
+
+	timeline.Record(ctx, posted.ID, entity.AlertEventResolved, "alice", "via API")
+	assert.Equal(t, ":white_check_mark: Resolved after 42m by alice (via API)", threads.replies["C1:posted"])
+
+	// Disabled event types and alerts without a Slack message are not posted
+	threads.replies = make(map[string]string)
+	timeline.Record(ctx, posted.ID, entity.AlertEventNoteAdded, "alice", "looking")
+	timeline.Record(ctx, unposted.ID, entity.AlertEventAcknowledged, "alice", "slack")
+	assert.Empty(t, threads.replies)
+}
+
+func TestThreadReplyText(t *testing.T) {
+	alert := entity.NewAlert("fp1", "HighCPU", "host-1", "", "summary", entity.SeverityCritical)
+
+	tests := []struct {
+		event *entity.AlertEvent
+		want  string
+	}{
+		{entity.NewAlertEvent(alert.ID, entity.AlertEventAcknowledged, "alice", "pagerduty"), ":eyes: Acknowledged by alice via pagerduty"},
+		{entity.NewAlertEvent(alert.ID, entity.AlertEventSilenced, "bob", "for 1 hour (until Jan 2, 15:04 UTC)"), ":no_bell: Silenced for 1 hour (until Jan 2, 15:04 UTC) by bob"},
+		{entity.NewAlertEvent(alert.ID, entity.AlertEventSeverityChanged, "", "warning → critical"), ":arrow_up_down: Severity changed: warning → critical"},
+		{entity.NewAlertEvent(alert.ID, entity.AlertEventNoteAdded, "carol", "rolling back"), ":memo: Note from carol: rolling back"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, threadReplyText(alert, tt.event))
+	}
+
+	for d, want := range map[time.Duration]string{
+		45 * time.Second:                "45s",
+		42*time.Minute + 10*time.Second: "42m",
+		3 * time.Hour:                   "3h",
+		time.Hour + 5*time.Minute:       "1h5m",
+	} {
+		assert.Equal(t, want, formatLifetime(d))
+	}
+}
@@ -0,0 +1,85 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+)
+
+// Timeline records what happens to alerts as events: state transitions,
+// notifications, acknowledgments, notes and silences.
+//
+// A nil Timeline records nothing, so use cases call it whether or not one
+// is set.
+type Timeline struct {
+	eventRepo repository.AlertEventRepository
+	logger    Logger
+
+	// Slack thread replies for lifecycle events (optional)
+	replies *ThreadReplies
+
+	// Timelines of linked incidents in incident management tools (optional)
+	incidents *IncidentTimelines
+}
+
+// NewTimeline creates a Timeline backed by eventRepo.
+func NewTimeline(eventRepo repository.AlertEventRepository, logger Logger) *Timeline {
+	return &Timeline{eventRepo: eventRepo, logger: logger}
+}
+
+// SetThreadReplies also posts recorded lifecycle events in the alert's
+// Slack thread.
+func (t *Timeline) SetThreadReplies(replies *ThreadReplies) {
+	t.replies = replies
+}
+
+// SetIncidentTimelines also posts recorded lifecycle events to the
+// timelines of the incidents alerts are linked to.
+func (t *Timeline) SetIncidentTimelines(incidents *IncidentTimelines) {
+	t.incidents = incidents
+}
+
+// Record saves an event for the alert. by is who caused it, empty for
+// alert-bridge or the alert source. Failures are logged rather than
+// returned, so a timeline write never fails the action it records.
+func (t *Timeline) Record(ctx context.Context, alertID string, eventType entity.AlertEventType, by, detail string) {
+	if t == nil {
+		return
+	}
+
+	event := entity.NewAlertEvent(alertID, eventType, by, detail)
+	if err := t.eventRepo.Save(ctx, event); err != nil {
+		t.logger.Warn("failed to record alert event",
+			"alertID", alertID,
+			"type", eventType,
+			"error", err,
+		)
+	}
+	t.replies.Post(ctx, event)
+	t.incidents.Post(ctx, event)
+}
+
+// Events returns the alert's timeline, oldest first.
+func (t *Timeline) Events(ctx context.Context, alertID string) ([]*entity.AlertEvent, error) {
+	if t == nil {
+		return nil, nil
+	}
+
+	events, err := t.eventRepo.FindByAlertID(ctx, alertID)
+	if err != nil {
+		return nil, fmt.Errorf("finding alert events: %w", err)
+	}
+	return events, nil
+}
+
+// silenceDetail describes a silence for a silenced event.
+func silenceDetail(silence *entity.SilenceMark) string {
+	detail := "until " + silence.EndAt.UTC().Format(time.RFC3339)
+	if silence.Reason != "" {
+		detail += " (" + silence.Reason + ")"
+	}
+	return detail
+}
@@ -0,0 +1,67 @@
+package alert
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/adapter/dto"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/memory"
+)
+
+func TestProcessAlertUseCase_Timeline(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewAlertRepository()
+	timeline := NewTimeline(memory.NewAlertEventRepository(), nopLogger{})
+	uc := NewProcessAlertUseCase(repo, memory.NewSilenceRepository(), []Notifier{&outageNotifier{}}, nopLogger{}, nil)
+	uc.SetTimeline(timeline)
+
+	input := dto.ProcessAlertInput{
+		Fingerprint: "fp1",
+		Name:        "HighCPU",
+		Severity:    entity.SeverityWarning,
+		Status:      "firing",
+	}
+	output, err := uc.Execute(ctx, input)
+	require.NoError(t, err)
+
+	// Duplicates add nothing
+	_, err = uc.Execute(ctx, input)
+	require.NoError(t, err)
+
+	input.Severity = entity.SeverityCritical
+	_, err = uc.Execute(ctx, input)
+	require.NoError(t, err)
+
+	input.Status = "resolved"
+	_, err = uc.Execute(ctx, input)
+	require.NoError(t, err)
+
+	events, err := timeline.Events(ctx, output.AlertID)
+	require.NoError(t, err)
+
+	var types []entity.AlertEventType
+	for _, e := range events {
+		types = append(types, e.Type)
+	}
+	assert.Equal(t, []entity.AlertEventType{
+		entity.AlertEventFired,
+		entity.AlertEventNotified,
+		entity.AlertEventSeverityChanged,
+		entity.AlertEventResolved,
+	}, types)
+	assert.Equal(t, "slack", events[1].Detail)
+	assert.Equal(t, "warning → critical", events[2].Detail)
+}
+
+func TestTimeline_Nil(t *testing.T) {
+	var timeline *Timeline
+	timeline.Record(context.Background(), "alert-1", entity.AlertEventFired, "", "")
+
+	events, err := timeline.Events(context.Background(), "alert-1")
+	require.NoError(t, err)
+	assert.Empty(t, events)
+}
@@ -0,0 +1,407 @@
+// Package api implements the operations behind the REST admin API.
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/ack"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
+)
+
+// ListAlertsInput filters the alert list. Zero values match everything,
+// except that no States lists active and acknowledged alerts.
+type ListAlertsInput struct {
+	States   []entity.AlertState
+	Severity entity.AlertSeverity
+	Labels   map[string]string
+
+	// Fields are custom field values the alerts must all have.
+	Fields map[string]string
+
+	// Since bounds how far back resolved alerts are listed. Only used when
+	// States includes resolved.
+	Since time.Time
+
+	Limit int
+
+	// View names a saved view whose criteria are applied on top of the
+	// others. ViewOwner is the user whose own views take precedence over
+	// shared ones; empty resolves shared views only.
+	View      string
+	ViewOwner string
+}
+
+// AckAlertInput identifies an acknowledgment made through the API.
+type AckAlertInput struct {
+	AlertID   string
+	UserName  string
+	UserEmail string
+	Note      string
+	Duration  *time.Duration
+}
+
+// ResolveAlertInput identifies a manual resolution.
+type ResolveAlertInput struct {
+	AlertID string
+	By      string
+
+	// Via is where the resolution was made. Defaults to "API".
+	Via string
+
+	// RootCause and Note optionally explain the resolution. They are
+	// recorded on the alert's timeline.
+	RootCause string
+	Note      string
+}
+
+// ResolveSourceInput selects the firing alerts of a decommissioned source.
+type ResolveSourceInput struct {
+	// Source is the integration that raised the alerts, e.g. "grafana".
+	Source string
+
+	// Labels optionally narrows the alerts, such as to one Grafana
+	// instance of several.
+	Labels map[string]string
+
+	By string
+
+	// Note is added to each alert. Defaults to "Source <source>
+	// decommissioned".
+	Note string
+}
+
+// AddNoteInput is a note to attach to an alert.
+type AddNoteInput struct {
+	AlertID string
+	By      string
+	Text    string
+}
+
+// ManageAlertsUseCase lists, inspects and acts on alerts for API clients.
+type ManageAlertsUseCase struct {
+	alertRepo repository.AlertRepository
+	syncAckUC *ack.SyncAckUseCase
+	notifiers []alert.Notifier
+	timeline  *alert.Timeline
+	viewRepo  repository.SavedViewRepository
+	logger    alert.Logger
+}
+
+// NewManageAlertsUseCase creates a new ManageAlertsUseCase.
+func NewManageAlertsUseCase(
+	alertRepo repository.AlertRepository,
+	syncAckUC *ack.SyncAckUseCase,
+	notifiers []alert.Notifier,
+	logger alert.Logger,
+) *ManageAlertsUseCase {
+	return &ManageAlertsUseCase{
+		alertRepo: alertRepo,
+		syncAckUC: syncAckUC,
+		notifiers: notifiers,
+		logger:    logger,
+	}
+}
+
+// SetTimeline records resolutions and notes in the alert timeline, and
+// serves it through Timeline.
+func (uc *ManageAlertsUseCase) SetTimeline(timeline *alert.Timeline) {
+	uc.timeline = timeline
+}
+
+// SetSavedViewRepository enables listing alerts by saved view.
+func (uc *ManageAlertsUseCase) SetSavedViewRepository(viewRepo repository.SavedViewRepository) {
+	uc.viewRepo = viewRepo
+}
+
+// List returns alerts matching the input, most recently fired first.
+// Returns entity.ErrSavedViewNotFound if input.View names no visible view.
+func (uc *ManageAlertsUseCase) List(ctx context.Context, input ListAlertsInput) ([]*entity.Alert, error) {
+	var view *entity.SavedView
+	if input.View != "" {
+		if uc.viewRepo == nil {
+			return nil, fmt.Errorf("saved views are not available")
+		}
+		var err error
+		view, err = uc.viewRepo.FindByName(ctx, input.ViewOwner, input.View)
+		if err != nil {
+			return nil, fmt.Errorf("finding view: %w", err)
+		}
+		if view == nil {
+			return nil, fmt.Errorf("%w: %q", entity.ErrSavedViewNotFound, input.View)
+		}
+		input = narrowToView(input, view)
+	}
+
+	query := entity.AlertQuery{
+		States:   input.States,
+		Severity: input.Severity,
+		Labels:   input.Labels,
+		Fields:   input.Fields,
+		Limit:    input.Limit,
+	}
+	if len(query.States) == 0 {
+		query.States = []entity.AlertState{entity.StateActive, entity.StateAcked}
+	}
+	if slices.Contains(query.States, entity.StateResolved) {
+		query.ResolvedSince = input.Since
+	}
+
+	alerts, _, err := uc.alertRepo.Search(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("finding alerts: %w", err)
+	}
+	if view != nil {
+		alerts = view.Filter(alerts)
+	}
+	return alerts, nil
+}
+
+// narrowToView adds the view's criteria the input leaves open to the
+// search, so the limit counts matching alerts. Criteria that conflict with
+// the input are left to the view's filter, which then matches nothing.
+func narrowToView(input ListAlertsInput, view *entity.SavedView) ListAlertsInput {
+	if input.Severity == "" {
+		input.Severity = view.Severity
+	}
+	if len(input.States) == 0 && view.State != "" {
+		input.States = []entity.AlertState{view.State}
+	}
+	if len(view.Labels) > 0 {
+		labels := maps.Clone(view.Labels)
+		maps.Copy(labels, input.Labels)
+		input.Labels = labels
+	}
+	return input
+}
+
+// Get returns a single alert. Returns entity.ErrAlertNotFound if it does not
+// exist.
+func (uc *ManageAlertsUseCase) Get(ctx context.Context, id string) (*entity.Alert, error) {
+	a, err := uc.alertRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("finding alert: %w", err)
+	}
+	if a == nil {
+		return nil, entity.ErrAlertNotFound
+	}
+	return a, nil
+}
+
+// Timeline returns the alert's events, oldest first. Returns
+// entity.ErrAlertNotFound if the alert does not exist.
+func (uc *ManageAlertsUseCase) Timeline(ctx context.Context, id string) ([]*entity.AlertEvent, error) {
+	if _, err := uc.Get(ctx, id); err != nil {
+		return nil, err
+	}
+	return uc.timeline.Events(ctx, id)
+}
+
+// Restore brings back a deleted alert. Returns entity.ErrAlertNotFound if no
+// deleted alert has the ID.
+func (uc *ManageAlertsUseCase) Restore(ctx context.Context, id string) (*entity.Alert, error) {
+	if err := uc.alertRepo.Restore(ctx, id); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, entity.ErrAlertNotFound
+		}
+		return nil, fmt.Errorf("restoring alert: %w", err)
+	}
+
+	uc.logger.Info("alert restored via API", "alertID", id)
+	return uc.Get(ctx, id)
+}
+
+// Acknowledge acknowledges an alert and syncs the acknowledgment to the
+// connected systems. Returns entity.ErrAlertAlreadyResolved for resolved
+// alerts.
+func (uc *ManageAlertsUseCase) Acknowledge(ctx context.Context, input AckAlertInput) (*entity.Alert, error) {
+	a, err := uc.Get(ctx, input.AlertID)
+	if err != nil {
+		return nil, err
+	}
+	if a.IsResolved() {
+		return nil, entity.ErrAlertAlreadyResolved
+	}
+
+	output, err := uc.syncAckUC.Execute(ctx, ack.SyncAckInput{
+		AlertID:   input.AlertID,
+		Source:    entity.AckSourceAPI,
+		UserEmail: input.UserEmail,
+		UserName:  input.UserName,
+		Note:      input.Note,
+		Duration:  input.Duration,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("syncing ack: %w", err)
+	}
+
+	// SyncAck covers PagerDuty and Teams; Slack is not a syncer
+	uc.updateMessages(ctx, output.Alert, "slack")
+
+	return output.Alert, nil
+}
+
+// Resolve resolves an alert by hand and updates its notifications.
+// Returns entity.ErrAlertAlreadyResolved if it is already resolved.
+func (uc *ManageAlertsUseCase) Resolve(ctx context.Context, input ResolveAlertInput) (*entity.Alert, error) {
+	a, err := uc.Get(ctx, input.AlertID)
+	if err != nil {
+		return nil, err
+	}
+	if a.IsResolved() {
+		return nil, entity.ErrAlertAlreadyResolved
+	}
+
+	a.ResolveBy(input.By, time.Now().UTC())
+	if err := uc.alertRepo.Update(ctx, a); err != nil {
+		return nil, fmt.Errorf("updating alert: %w", err)
+	}
+	uc.timeline.Record(ctx, a.ID, entity.AlertEventResolved, input.By, resolutionDetail(input))
+
+	uc.logger.Info("alert resolved by hand",
+		"alertID", a.ID,
+		"by", input.By,
+		"via", input.Via,
+		"rootCause", input.RootCause,
+	)
+	// Updating the PagerDuty notification resolves its incident
+	uc.updateMessages(ctx, a)
+
+	return a, nil
+}
+
+// ResolveSource resolves every firing alert raised by a source, such as when
+// the source is decommissioned and will never send the resolves. Each alert
+// gets the note and its notifications are updated. Alerts that fail to
+// update are logged and skipped. Returns the resolved alerts; the bulk
+// action is audit logged.
+func (uc *ManageAlertsUseCase) ResolveSource(ctx context.Context, input ResolveSourceInput) ([]*entity.Alert, error) {
+	note := input.Note
+	if note == "" {
+		note = "Source " + input.Source + " decommissioned"
+	}
+	// Check the note once rather than failing on each alert
+	if err := (&entity.Alert{}).AddNote(input.By, note, time.Time{}); err != nil {
+		return nil, err
+	}
+
+	firing, err := uc.alertRepo.FindFiring(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("finding firing alerts: %w", err)
+	}
+
+	now := time.Now().UTC()
+	detail := resolutionDetail(ResolveAlertInput{Note: note})
+	resolved := make([]*entity.Alert, 0)
+	var ids []string
+	failed := 0
+	for _, a := range firing {
+		if a.Source != input.Source || !hasLabels(a, input.Labels) {
+			continue
+		}
+
+		_ = a.AddNote(input.By, note, now)
+		a.ResolveBy(input.By, now)
+		if err := uc.alertRepo.Update(ctx, a); err != nil {
+			uc.logger.Error("failed to resolve alert of source",
+				"alertID", a.ID,
+				"source", input.Source,
+				"error", err,
+			)
+			failed++
+			continue
+		}
+		uc.timeline.Record(ctx, a.ID, entity.AlertEventNoteAdded, input.By, note)
+		uc.timeline.Record(ctx, a.ID, entity.AlertEventResolved, input.By, detail)
+		uc.updateMessages(ctx, a)
+
+		resolved = append(resolved, a)
+		ids = append(ids, a.ID)
+	}
+
+	uc.logger.Info("bulk resolve audit",
+		"source", input.Source,
+		"labels", input.Labels,
+		"by", input.By,
+		"note", note,
+		"resolved", len(resolved),
+		"failed", failed,
+		"alertIDs", ids,
+	)
+	return resolved, nil
+}
+
+// AddNote attaches a note to an alert and echoes it into the Slack thread.
+// Returns entity.ErrInvalidNote for empty or overlong text.
+func (uc *ManageAlertsUseCase) AddNote(ctx context.Context, input AddNoteInput) (*entity.Alert, error) {
+	a, err := uc.Get(ctx, input.AlertID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.AddNote(input.By, input.Text, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+	if err := uc.alertRepo.Update(ctx, a); err != nil {
+		return nil, fmt.Errorf("updating alert: %w", err)
+	}
+	// The timeline posts the note in the alert's Slack thread, if enabled
+	uc.timeline.Record(ctx, a.ID, entity.AlertEventNoteAdded, input.By, input.Text)
+
+	return a, nil
+}
+
+// updateMessages refreshes existing notifications for the alert. If names
+// are given, only those notifiers are updated. Failures are logged since the
+// stored state is already authoritative.
+func (uc *ManageAlertsUseCase) updateMessages(ctx context.Context, a *entity.Alert, names ...string) {
+	for _, notifier := range uc.notifiers {
+		if len(names) > 0 && !slices.Contains(names, notifier.Name()) {
+			continue
+		}
+		messageID := a.GetExternalReference(notifier.Name())
+		if messageID == "" {
+			continue
+		}
+		if err := notifier.UpdateMessage(ctx, messageID, a); err != nil {
+			uc.logger.Error("failed to update notification",
+				"notifier", notifier.Name(),
+				"alertID", a.ID,
+				"error", err,
+			)
+		}
+	}
+}
+
+// resolutionDetail describes a manual resolution for the timeline, e.g.
+// "via Slack, root cause: capacity — added two nodes".
+func resolutionDetail(input ResolveAlertInput) string {
+	via := input.Via
+	if via == "" {
+		via = "API"
+	}
+	detail := "via " + via
+	if input.RootCause != "" {
+		detail += ", root cause: " + input.RootCause
+	}
+	if input.Note != "" {
+		detail += " — " + input.Note
+	}
+	return detail
+}
+
+// hasLabels reports whether the alert carries every label in want.
+func hasLabels(a *entity.Alert, want map[string]string) bool {
+	for k, v := range want {
+		if a.GetLabel(k) != v {
+			return false
+		}
+	}
+	return true
+}
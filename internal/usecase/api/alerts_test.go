@@ -0,0 +1,147 @@
+package api
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/memory"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
+)
+
+type updateRecorder struct {
+	name    string
+	updated []entity.AlertState
+}
+
+func (n *updateRecorder) Notify(context.Context, *entity.Alert) (entity.NotifyResult, error) {
+	return entity.NotifyResult{}, nil
+}
+
+func (n *updateRecorder) UpdateMessage(_ context.Context, _ string, a *entity.Alert) error {
+	n.updated = append(n.updated, a.State)
+	return nil
+}
+
+func (n *updateRecorder) Name() string { return n.name }
+
+func TestManageAlertsUseCase_Resolve(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	eventRepo := memory.NewAlertEventRepository()
+	pagerduty := &updateRecorder{name: "pagerduty"}
+	email := &updateRecorder{name: "email"}
+
+	uc := NewManageAlertsUseCase(alertRepo, nil, []alert.Notifier{pagerduty, email}, nopLogger{})
+	uc.SetTimeline(alert.NewTimeline(eventRepo, nopLogger{}))
+
+	a := entity.NewAlert("fp1", "HighCPU", "host-1", "", "summary", entity.SeverityCritical)
+	a.SetExternalReference("pagerduty", "dedup-1")
+	require.NoError(t, alertRepo.Save(ctx, a))
+
+	resolved, err := uc.Resolve(ctx, ResolveAlertInput{
+		AlertID:   a.ID,
+		By:        "alice",
+		Via:       "Slack",
+		RootCause: "capacity",
+		Note:      "added two nodes",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "alice", resolved.ResolvedBy())
+
+	// Only notifications that were sent are updated
+	assert.Equal(t, []entity.AlertState{entity.StateResolved}, pagerduty.updated)
+	assert.Empty(t, email.updated)
+
+	events, err := eventRepo.FindByAlertID(ctx, a.ID)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, entity.AlertEventResolved, events[0].Type)
+	assert.Equal(t, "via Slack, root cause: capacity — added two nodes", events[0].Detail)
+
+	_, err = uc.Resolve(ctx, ResolveAlertInput{AlertID: a.ID, By: "bob"})
+	assert.ErrorIs(t, err, entity.ErrAlertAlreadyResolved)
+}
+
+func TestManageAlertsUseCase_ResolveSource(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	eventRepo := memory.NewAlertEventRepository()
+	slack := &updateRecorder{name: "slack"}
+
+	uc := NewManageAlertsUseCase(alertRepo, nil, []alert.Notifier{slack}, nopLogger{})
+	uc.SetTimeline(alert.NewTimeline(eventRepo, nopLogger{}))
+
+	newAlert := func(fingerprint, source, instance string) *entity.Alert {
+		a := entity.NewAlert(fingerprint, "HighCPU", "host-1", "", "summary", entity.SeverityCritical)
+		a.Source = source
+		a.Labels = map[string]string{"grafana": instance}
+		a.SetExternalReference("slack", "ts-"+fingerprint)
+		require.NoError(t, alertRepo.Save(ctx, a))
+		return a
+	}
+	old := newAlert("fp1", "grafana", "old")
+	kept := newAlert("fp2", "grafana", "new")
+	other := newAlert("fp3", "alertmanager", "old")
+
+	resolved, err := uc.ResolveSource(ctx, ResolveSourceInput{
+		Source: "grafana",
+		Labels: map[string]string{"grafana": "old"},
+		By:     "alice",
+	})
+	require.NoError(t, err)
+	require.Len(t, resolved, 1)
+	assert.Equal(t, old.ID, resolved[0].ID)
+	assert.Equal(t, []entity.AlertState{entity.StateResolved}, slack.updated)
+
+	stored, err := alertRepo.FindByID(ctx, old.ID)
+	require.NoError(t, err)
+	assert.True(t, stored.IsResolved())
+	require.Len(t, stored.Notes, 1)
+	assert.Equal(t, "Source grafana decommissioned", stored.Notes[0].Text)
+
+	events, err := eventRepo.FindByAlertID(ctx, old.ID)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, entity.AlertEventResolved, events[1].Type)
+	assert.Equal(t, "via API — Source grafana decommissioned", events[1].Detail)
+
+	for _, id := range []string{kept.ID, other.ID} {
+		stored, err := alertRepo.FindByID(ctx, id)
+		require.NoError(t, err)
+		assert.False(t, stored.IsResolved())
+	}
+
+	// Without labels every alert of the source is resolved
+	resolved, err = uc.ResolveSource(ctx, ResolveSourceInput{Source: "grafana", By: "alice", Note: "moved to new stack"})
+	require.NoError(t, err)
+	require.Len(t, resolved, 1)
+	assert.Equal(t, "moved to new stack", resolved[0].Notes[0].Text)
+
+	_, err = uc.ResolveSource(ctx, ResolveSourceInput{Source: "grafana", By: "alice", Note: strings.Repeat("x", 5000)})
+	assert.ErrorIs(t, err, entity.ErrInvalidNote)
+}
+
+func TestManageAlertsUseCase_Restore(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	uc := NewManageAlertsUseCase(alertRepo, nil, nil, nopLogger{})
+
+	a := entity.NewAlert("fp1", "HighCPU", "host-1", "", "summary", entity.SeverityCritical)
+	require.NoError(t, alertRepo.Save(ctx, a))
+	require.NoError(t, alertRepo.Delete(ctx, a.ID))
+
+	_, err := uc.Get(ctx, a.ID)
+	assert.ErrorIs(t, err, entity.ErrAlertNotFound)
+
+	restored, err := uc.Restore(ctx, a.ID)
+	require.NoError(t, err)
+	assert.Equal(t, a.ID, restored.ID)
+
+	_, err = uc.Restore(ctx, "missing")
+	assert.ErrorIs(t, err, entity.ErrAlertNotFound)
+}
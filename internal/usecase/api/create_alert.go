@@ -0,0 +1,134 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/adapter/dto"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
+)
+
+// ManualAlertSource is the source label of manually raised alerts.
+const ManualAlertSource = "manual"
+
+// CreateAlertInput is an alert raised by an operator rather than a
+// monitoring system.
+type CreateAlertInput struct {
+	Name        string
+	Severity    entity.AlertSeverity // Defaults to warning
+	Description string
+	Labels      map[string]string
+	By          string
+
+	// Via is where the alert was raised. Defaults to "API".
+	Via string
+}
+
+// CreateAlertOutput is a raised alert and where it was sent.
+type CreateAlertOutput struct {
+	Alert             *entity.Alert
+	Silenced          bool
+	NotificationsSent []string
+}
+
+// CreateAlertUseCase raises alerts by hand, for incidents noticed by people.
+// The alerts go through the same pipeline as ingested ones, so they are
+// notified, acknowledged and escalated like any other.
+type CreateAlertUseCase struct {
+	processAlert *alert.ProcessAlertUseCase
+	alertRepo    repository.AlertRepository
+	logger       alert.Logger
+	now          func() time.Time
+}
+
+// NewCreateAlertUseCase creates a new CreateAlertUseCase.
+func NewCreateAlertUseCase(
+	processAlert *alert.ProcessAlertUseCase,
+	alertRepo repository.AlertRepository,
+	logger alert.Logger,
+) *CreateAlertUseCase {
+	return &CreateAlertUseCase{
+		processAlert: processAlert,
+		alertRepo:    alertRepo,
+		logger:       logger,
+		now:          time.Now,
+	}
+}
+
+// Execute raises a firing alert. Returns entity.ErrInvalidAlert if the name
+// is missing or the severity unknown.
+func (uc *CreateAlertUseCase) Execute(ctx context.Context, input CreateAlertInput) (*CreateAlertOutput, error) {
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return nil, fmt.Errorf("%w: name is required", entity.ErrInvalidAlert)
+	}
+	severity := input.Severity
+	switch severity {
+	case "":
+		severity = entity.SeverityWarning
+	case entity.SeverityCritical, entity.SeverityWarning, entity.SeverityInfo:
+	default:
+		return nil, fmt.Errorf("%w: severity must be critical, warning, or info", entity.ErrInvalidAlert)
+	}
+	via := input.Via
+	if via == "" {
+		via = "API"
+	}
+
+	// Every manual alert is a new incident, so its fingerprint is unique
+	labels := maps.Clone(input.Labels)
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	labels["alertname"] = name
+	labels["severity"] = string(severity)
+	labels["source"] = ManualAlertSource
+	if input.By != "" {
+		labels["created_by"] = input.By
+	}
+
+	description := strings.TrimSpace(input.Description)
+	out, err := uc.processAlert.Execute(ctx, dto.ProcessAlertInput{
+		Fingerprint: ManualAlertSource + "-" + uuid.New().String(),
+		Name:        name,
+		Instance:    labels["instance"],
+		Summary:     description,
+		Description: description,
+		Severity:    severity,
+		Status:      "firing",
+		Labels:      labels,
+		FiredAt:     uc.now().UTC(),
+		Source:      ManualAlertSource,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("processing alert: %w", err)
+	}
+
+	a, err := uc.alertRepo.FindByID(ctx, out.AlertID)
+	if err != nil {
+		return nil, fmt.Errorf("finding alert: %w", err)
+	}
+	if a == nil {
+		return nil, entity.ErrAlertNotFound
+	}
+
+	uc.logger.Info("alert raised manually",
+		"alertID", a.ID,
+		"name", name,
+		"severity", severity,
+		"by", input.By,
+		"via", via,
+	)
+	return &CreateAlertOutput{
+		Alert:             a,
+		Silenced:          out.IsSilenced,
+		NotificationsSent: out.NotificationsSent,
+	}, nil
+}
@@ -0,0 +1,72 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/memory"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
+)
+
+type notifyRecorder struct {
+	notified []*entity.Alert
+}
+
+func (n *notifyRecorder) Notify(_ context.Context, a *entity.Alert) (entity.NotifyResult, error) {
+	n.notified = append(n.notified, a)
+	return entity.NotifyResult{ReferenceID: "msg-1"}, nil
+}
+
+func (n *notifyRecorder) UpdateMessage(context.Context, string, *entity.Alert) error { return nil }
+
+func (n *notifyRecorder) Name() string { return "slack" }
+
+func TestCreateAlertUseCase(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	slack := &notifyRecorder{}
+	processAlert := alert.NewProcessAlertUseCase(alertRepo, memory.NewSilenceRepository(), []alert.Notifier{slack}, nopLogger{}, nil)
+	uc := NewCreateAlertUseCase(processAlert, alertRepo, nopLogger{})
+
+	out, err := uc.Execute(ctx, CreateAlertInput{
+		Name:        " CustomerReportedOutage ",
+		Severity:    entity.SeverityCritical,
+		Description: "checkout fails for EU customers",
+		Labels:      map[string]string{"team": "payments", "instance": "checkout"},
+		By:          "alice",
+		Via:         "Slack",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"slack"}, out.NotificationsSent)
+	assert.False(t, out.Silenced)
+
+	a := out.Alert
+	assert.Equal(t, "CustomerReportedOutage", a.Name)
+	assert.Equal(t, "checkout", a.Instance)
+	assert.Equal(t, "checkout fails for EU customers", a.Summary)
+	assert.Equal(t, entity.SeverityCritical, a.Severity)
+	assert.True(t, a.IsActive())
+	assert.Equal(t, ManualAlertSource, a.Labels["source"])
+	assert.Equal(t, "alice", a.Labels["created_by"])
+	assert.Equal(t, "payments", a.Labels["team"])
+	assert.Equal(t, "msg-1", a.GetExternalReference("slack"))
+	require.Len(t, slack.notified, 1)
+
+	// Each manual alert is a new incident
+	again, err := uc.Execute(ctx, CreateAlertInput{Name: "CustomerReportedOutage", By: "bob"})
+	require.NoError(t, err)
+	assert.NotEqual(t, a.ID, again.Alert.ID)
+	assert.Equal(t, entity.SeverityWarning, again.Alert.Severity)
+
+	for _, input := range []CreateAlertInput{
+		{Name: "  "},
+		{Name: "Outage", Severity: "high"},
+	} {
+		_, err := uc.Execute(ctx, input)
+		assert.ErrorIs(t, err, entity.ErrInvalidAlert)
+	}
+}
@@ -0,0 +1,115 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
+)
+
+// ManageDeadLettersUseCase lists, replays and discards notifications the
+// retry queue gave up on. Replayed notifications go back to the queue, which
+// delivers them with the alert's current state on its next poll.
+type ManageDeadLettersUseCase struct {
+	retryRepo repository.NotificationRetryRepository
+	logger    alert.Logger
+	now       func() time.Time
+}
+
+// NewManageDeadLettersUseCase creates a new ManageDeadLettersUseCase.
+func NewManageDeadLettersUseCase(retryRepo repository.NotificationRetryRepository, logger alert.Logger) *ManageDeadLettersUseCase {
+	return &ManageDeadLettersUseCase{
+		retryRepo: retryRepo,
+		logger:    logger,
+		now:       time.Now,
+	}
+}
+
+// List returns the dead letters, most recently failed first.
+func (uc *ManageDeadLettersUseCase) List(ctx context.Context) ([]*entity.NotificationRetry, error) {
+	deadLetters, err := uc.retryRepo.FindDead(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("finding dead letters: %w", err)
+	}
+	return deadLetters, nil
+}
+
+// Replay requeues a dead letter for immediate delivery with a fresh set of
+// attempts. Returns entity.ErrNotificationRetryNotFound if there is no dead
+// letter with the ID.
+func (uc *ManageDeadLettersUseCase) Replay(ctx context.Context, id, by string) (*entity.NotificationRetry, error) {
+	retry, err := uc.find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.requeue(ctx, retry, by); err != nil {
+		return nil, err
+	}
+	return retry, nil
+}
+
+// ReplayAll requeues every dead letter and returns how many were requeued.
+func (uc *ManageDeadLettersUseCase) ReplayAll(ctx context.Context, by string) (int, error) {
+	deadLetters, err := uc.List(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, retry := range deadLetters {
+		if err := uc.requeue(ctx, retry, by); err != nil {
+			return i, err
+		}
+	}
+	return len(deadLetters), nil
+}
+
+// Discard removes a dead letter without delivering it. Returns
+// entity.ErrNotificationRetryNotFound if there is no dead letter with the ID.
+func (uc *ManageDeadLettersUseCase) Discard(ctx context.Context, id, by string) error {
+	retry, err := uc.find(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := uc.retryRepo.Delete(ctx, retry.ID); err != nil {
+		return fmt.Errorf("deleting dead letter: %w", err)
+	}
+
+	uc.logger.Info("dead letter discarded",
+		"retryID", retry.ID,
+		"notifier", retry.Notifier,
+		"alertID", retry.AlertID,
+		"by", by,
+	)
+	return nil
+}
+
+// find loads a dead letter. Pending retries are not dead letters.
+func (uc *ManageDeadLettersUseCase) find(ctx context.Context, id string) (*entity.NotificationRetry, error) {
+	retry, err := uc.retryRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("finding dead letter: %w", err)
+	}
+	if retry == nil || !retry.IsDead() {
+		return nil, entity.ErrNotificationRetryNotFound
+	}
+	return retry, nil
+}
+
+func (uc *ManageDeadLettersUseCase) requeue(ctx context.Context, retry *entity.NotificationRetry, by string) error {
+	retry.Requeue(uc.now())
+	if err := uc.retryRepo.Save(ctx, retry); err != nil {
+		return fmt.Errorf("requeueing dead letter: %w", err)
+	}
+
+	uc.logger.Info("dead letter replayed",
+		"retryID", retry.ID,
+		"notifier", retry.Notifier,
+		"alertID", retry.AlertID,
+		"action", retry.Action,
+		"by", by,
+	)
+	return nil
+}
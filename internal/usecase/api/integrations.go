@@ -0,0 +1,72 @@
+package api
+
+import (
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/ack"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
+)
+
+// IntegrationKind is the role an integration plays.
+type IntegrationKind string
+
+const (
+	// IntegrationNotifier posts alerts.
+	IntegrationNotifier IntegrationKind = "notifier"
+
+	// IntegrationSyncer mirrors acknowledgments made elsewhere.
+	IntegrationSyncer IntegrationKind = "syncer"
+
+	// IntegrationIngestor receives alerts or acknowledgments.
+	IntegrationIngestor IntegrationKind = "ingestor"
+)
+
+// Integration is a configured notifier, ack syncer or ingestion endpoint.
+type Integration struct {
+	Name         string
+	Kind         IntegrationKind
+	Capabilities entity.NotifierCapabilities
+}
+
+// ListIntegrationsUseCase describes the integrations set up at startup, so
+// clients can adapt to what is actually configured.
+type ListIntegrationsUseCase struct {
+	integrations []Integration
+}
+
+// NewListIntegrationsUseCase creates a use case listing the notifiers and
+// syncers alerts are delivered through. Ingestors are added with
+// AddIngestor.
+func NewListIntegrationsUseCase(notifiers []alert.Notifier, syncers []ack.AckSyncer) *ListIntegrationsUseCase {
+	uc := &ListIntegrationsUseCase{}
+	for _, notifier := range notifiers {
+		uc.integrations = append(uc.integrations, Integration{
+			Name:         notifier.Name(),
+			Kind:         IntegrationNotifier,
+			Capabilities: alert.CapabilitiesOf(notifier),
+		})
+	}
+	for _, syncer := range syncers {
+		uc.integrations = append(uc.integrations, Integration{
+			Name:         syncer.Name(),
+			Kind:         IntegrationSyncer,
+			Capabilities: entity.NotifierCapabilities{Ack: syncer.SupportsAck()},
+		})
+	}
+	return uc
+}
+
+// AddIngestor adds an ingestion endpoint. acks is true if it receives
+// acknowledgments, such as Slack button clicks.
+func (uc *ListIntegrationsUseCase) AddIngestor(name string, acks bool) {
+	uc.integrations = append(uc.integrations, Integration{
+		Name:         name,
+		Kind:         IntegrationIngestor,
+		Capabilities: entity.NotifierCapabilities{Ack: acks},
+	})
+}
+
+// List returns the integrations: notifiers in delivery order, then
+// syncers, then ingestors.
+func (uc *ListIntegrationsUseCase) List() []Integration {
+	return append([]Integration(nil), uc.integrations...)
+}
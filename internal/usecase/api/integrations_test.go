@@ -0,0 +1,62 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/ack"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
+)
+
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...any) {}
+func (nopLogger) Info(string, ...any)  {}
+func (nopLogger) Warn(string, ...any)  {}
+func (nopLogger) Error(string, ...any) {}
+
+type capableNotifier struct {
+	name         string
+	capabilities entity.NotifierCapabilities
+}
+
+func (n *capableNotifier) Notify(context.Context, *entity.Alert) (entity.NotifyResult, error) {
+	return entity.NotifyResult{}, nil
+}
+
+func (n *capableNotifier) UpdateMessage(context.Context, string, *entity.Alert) error { return nil }
+
+func (n *capableNotifier) Name() string { return n.name }
+
+func (n *capableNotifier) Capabilities() entity.NotifierCapabilities { return n.capabilities }
+
+func (n *capableNotifier) Acknowledge(context.Context, *entity.Alert, *entity.AckEvent) error {
+	return nil
+}
+
+func (n *capableNotifier) SupportsAck() bool { return true }
+
+func TestListIntegrationsUseCase_List(t *testing.T) {
+	slack := &capableNotifier{name: "slack", capabilities: entity.NotifierCapabilities{Update: true, Mentions: true, Ack: true}}
+	pagerduty := &capableNotifier{name: "pagerduty", capabilities: entity.NotifierCapabilities{Update: true, Ack: true}}
+
+	uc := NewListIntegrationsUseCase(
+		[]alert.Notifier{
+			// Decorators report the wrapped notifier's capabilities
+			alert.NewRetryableNotifier(slack, alert.DefaultRetryPolicy(), nopLogger{}, nil),
+			alert.NewEscalationOnlyNotifier(pagerduty),
+		},
+		[]ack.AckSyncer{pagerduty},
+	)
+	uc.AddIngestor("alertmanager", false)
+
+	assert.Equal(t, []Integration{
+		{Name: "slack", Kind: IntegrationNotifier, Capabilities: slack.capabilities},
+		{Name: "pagerduty", Kind: IntegrationNotifier, Capabilities: pagerduty.capabilities},
+		{Name: "pagerduty", Kind: IntegrationSyncer, Capabilities: entity.NotifierCapabilities{Ack: true}},
+		{Name: "alertmanager", Kind: IntegrationIngestor},
+	}, uc.List())
+}
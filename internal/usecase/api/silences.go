@@ -0,0 +1,131 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
+)
+
+// CreateSilenceInput describes a label-matcher silence created through the
+// API.
+type CreateSilenceInput struct {
+	Matchers       []entity.LabelMatcher
+	Duration       time.Duration
+	Reason         string
+	CreatedBy      string
+	CreatedByEmail string
+
+	// Recurrence limits the silence to a repeating window (optional).
+	Recurrence *entity.SilenceRecurrence
+}
+
+// ManageSilencesUseCase creates, lists, deletes and restores silences for API
+// clients.
+type ManageSilencesUseCase struct {
+	silenceRepo repository.SilenceRepository
+	silenceSync *alert.SilenceSync
+	logger      alert.Logger
+}
+
+// NewManageSilencesUseCase creates a new ManageSilencesUseCase.
+func NewManageSilencesUseCase(silenceRepo repository.SilenceRepository, logger alert.Logger) *ManageSilencesUseCase {
+	return &ManageSilencesUseCase{
+		silenceRepo: silenceRepo,
+		logger:      logger,
+	}
+}
+
+// SetSilenceSync expires the Alertmanager copies of Slack silences deleted
+// through the API, and mirrors them again when restored.
+func (uc *ManageSilencesUseCase) SetSilenceSync(silenceSync *alert.SilenceSync) {
+	uc.silenceSync = silenceSync
+}
+
+// Create saves a silence that starts now. Returns
+// entity.ErrInvalidSilenceDuration for a non-positive duration.
+func (uc *ManageSilencesUseCase) Create(ctx context.Context, input CreateSilenceInput) (*entity.SilenceMark, error) {
+	silence, err := entity.NewSilenceMark(input.Duration, input.CreatedBy, input.CreatedByEmail, entity.AckSourceAPI)
+	if err != nil {
+		return nil, err
+	}
+	silence.WithLabelMatchers(input.Matchers).WithReason(input.Reason).WithRecurrence(input.Recurrence)
+
+	if err := uc.silenceRepo.Save(ctx, silence); err != nil {
+		return nil, fmt.Errorf("saving silence: %w", err)
+	}
+
+	uc.logger.Info("silence created via API",
+		"silenceID", silence.ID,
+		"matchers", silence.LabelMatchers(),
+		"endsAt", silence.EndAt,
+		"recurrence", input.Recurrence,
+		"by", silence.CreatedBy,
+	)
+	return silence, nil
+}
+
+// List returns the active silences, ending soonest first.
+func (uc *ManageSilencesUseCase) List(ctx context.Context) ([]*entity.SilenceMark, error) {
+	silences, err := uc.silenceRepo.FindActive(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("finding silences: %w", err)
+	}
+	sort.Slice(silences, func(i, j int) bool {
+		return silences[i].EndAt.Before(silences[j].EndAt)
+	})
+	return silences, nil
+}
+
+// Delete removes a silence. Returns entity.ErrSilenceNotFound if it does not
+// exist.
+func (uc *ManageSilencesUseCase) Delete(ctx context.Context, id string) error {
+	silence, err := uc.silenceRepo.FindByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("finding silence: %w", err)
+	}
+	if silence == nil {
+		return entity.ErrSilenceNotFound
+	}
+
+	if err := uc.silenceRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("deleting silence: %w", err)
+	}
+	uc.silenceSync.Deleted(ctx, silence)
+
+	uc.logger.Info("silence deleted via API", "silenceID", id)
+	return nil
+}
+
+// Restore brings back a deleted silence. Returns entity.ErrSilenceNotFound if
+// no deleted silence has the ID.
+func (uc *ManageSilencesUseCase) Restore(ctx context.Context, id string) (*entity.SilenceMark, error) {
+	if err := uc.silenceRepo.Restore(ctx, id); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, entity.ErrSilenceNotFound
+		}
+		return nil, fmt.Errorf("restoring silence: %w", err)
+	}
+
+	silence, err := uc.silenceRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("finding silence: %w", err)
+	}
+	if silence == nil {
+		return nil, entity.ErrSilenceNotFound
+	}
+	if !silence.IsExpired() {
+		uc.silenceSync.Created(ctx, silence)
+	}
+
+	uc.logger.Info("silence restored via API",
+		"silenceID", id,
+		"endsAt", silence.EndAt,
+	)
+	return silence, nil
+}
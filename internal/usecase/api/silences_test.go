@@ -0,0 +1,42 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/memory"
+)
+
+func TestManageSilencesUseCase_Restore(t *testing.T) {
+	ctx := context.Background()
+	uc := NewManageSilencesUseCase(memory.NewSilenceRepository(), nopLogger{})
+
+	silence, err := uc.Create(ctx, CreateSilenceInput{
+		Matchers:  []entity.LabelMatcher{{Name: "env", Type: entity.MatchEqual, Value: "prod"}},
+		Duration:  time.Hour,
+		CreatedBy: "alice",
+	})
+	require.NoError(t, err)
+	require.NoError(t, uc.Delete(ctx, silence.ID))
+
+	silences, err := uc.List(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, silences)
+
+	restored, err := uc.Restore(ctx, silence.ID)
+	require.NoError(t, err)
+	assert.Equal(t, silence.ID, restored.ID)
+
+	silences, err = uc.List(ctx)
+	require.NoError(t, err)
+	assert.Len(t, silences, 1)
+
+	// Only deleted silences can be restored
+	_, err = uc.Restore(ctx, silence.ID)
+	assert.ErrorIs(t, err, entity.ErrSilenceNotFound)
+}
@@ -0,0 +1,194 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
+)
+
+// StateExportVersion is the version of the StateExport format.
+const StateExportVersion = 1
+
+// ErrUnsupportedStateExport is returned when importing an export of another
+// format version.
+var ErrUnsupportedStateExport = errors.New("unsupported state export version")
+
+// StateExport is the live state of an instance: its firing alerts, with
+// their Slack and PagerDuty references, acknowledgments and timelines, and
+// its active silences.
+type StateExport struct {
+	Version     int                   `json:"version"`
+	ExportedAt  time.Time             `json:"exported_at"`
+	Alerts      []*entity.Alert       `json:"alerts"`
+	AckEvents   []*entity.AckEvent    `json:"ack_events"`
+	AlertEvents []*entity.AlertEvent  `json:"alert_events"`
+	Silences    []*entity.SilenceMark `json:"silences"`
+}
+
+// StateImportResult counts what an import saved and skipped.
+type StateImportResult struct {
+	Alerts          int `json:"alerts"`
+	SkippedAlerts   int `json:"skipped_alerts"`
+	AckEvents       int `json:"ack_events"`
+	AlertEvents     int `json:"alert_events"`
+	Silences        int `json:"silences"`
+	SkippedSilences int `json:"skipped_silences"`
+}
+
+// TransferStateUseCase exports an instance's live state and imports it into
+// another storage backend, so that switching backends does not orphan the
+// Slack messages and PagerDuty incidents of ongoing alerts.
+type TransferStateUseCase struct {
+	alertRepo      repository.AlertRepository
+	ackEventRepo   repository.AckEventRepository
+	alertEventRepo repository.AlertEventRepository
+	silenceRepo    repository.SilenceRepository
+	logger         alert.Logger
+	now            func() time.Time
+}
+
+// NewTransferStateUseCase creates a new TransferStateUseCase.
+func NewTransferStateUseCase(
+	alertRepo repository.AlertRepository,
+	ackEventRepo repository.AckEventRepository,
+	alertEventRepo repository.AlertEventRepository,
+	silenceRepo repository.SilenceRepository,
+	logger alert.Logger,
+) *TransferStateUseCase {
+	return &TransferStateUseCase{
+		alertRepo:      alertRepo,
+		ackEventRepo:   ackEventRepo,
+		alertEventRepo: alertEventRepo,
+		silenceRepo:    silenceRepo,
+		logger:         logger,
+		now:            time.Now,
+	}
+}
+
+// Export returns the firing alerts with their ack and timeline events, and
+// the active silences.
+func (uc *TransferStateUseCase) Export(ctx context.Context) (*StateExport, error) {
+	alerts, err := uc.alertRepo.FindFiring(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("finding firing alerts: %w", err)
+	}
+
+	export := &StateExport{
+		Version:     StateExportVersion,
+		ExportedAt:  uc.now().UTC(),
+		Alerts:      alerts,
+		AckEvents:   []*entity.AckEvent{},
+		AlertEvents: []*entity.AlertEvent{},
+	}
+	for _, a := range alerts {
+		ackEvents, err := uc.ackEventRepo.FindByAlertID(ctx, a.ID)
+		if err != nil {
+			return nil, fmt.Errorf("finding ack events of alert %s: %w", a.ID, err)
+		}
+		export.AckEvents = append(export.AckEvents, ackEvents...)
+
+		alertEvents, err := uc.alertEventRepo.FindByAlertID(ctx, a.ID)
+		if err != nil {
+			return nil, fmt.Errorf("finding timeline of alert %s: %w", a.ID, err)
+		}
+		export.AlertEvents = append(export.AlertEvents, alertEvents...)
+	}
+
+	export.Silences, err = uc.silenceRepo.FindActive(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("finding active silences: %w", err)
+	}
+	return export, nil
+}
+
+// Import saves the alerts and silences of export that the storage does not
+// have yet, so that it can be run again after a partial failure. The ack and
+// timeline events of an alert are only saved with the alert. With dryRun,
+// nothing is saved and the result counts what would be.
+func (uc *TransferStateUseCase) Import(ctx context.Context, export *StateExport, dryRun bool) (*StateImportResult, error) {
+	if export.Version != StateExportVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedStateExport, export.Version)
+	}
+
+	ackEvents := make(map[string][]*entity.AckEvent)
+	for _, event := range export.AckEvents {
+		ackEvents[event.AlertID] = append(ackEvents[event.AlertID], event)
+	}
+	alertEvents := make(map[string][]*entity.AlertEvent)
+	for _, event := range export.AlertEvents {
+		alertEvents[event.AlertID] = append(alertEvents[event.AlertID], event)
+	}
+
+	result := &StateImportResult{}
+	for _, a := range export.Alerts {
+		existing, err := uc.alertRepo.FindByID(ctx, a.ID)
+		if err != nil {
+			return result, fmt.Errorf("finding alert %s: %w", a.ID, err)
+		}
+		if existing != nil {
+			result.SkippedAlerts++
+			continue
+		}
+
+		if !dryRun {
+			if err := uc.importAlert(ctx, a, ackEvents[a.ID], alertEvents[a.ID]); err != nil {
+				return result, err
+			}
+		}
+		result.Alerts++
+		result.AckEvents += len(ackEvents[a.ID])
+		result.AlertEvents += len(alertEvents[a.ID])
+	}
+
+	for _, silence := range export.Silences {
+		existing, err := uc.silenceRepo.FindByID(ctx, silence.ID)
+		if err != nil {
+			return result, fmt.Errorf("finding silence %s: %w", silence.ID, err)
+		}
+		if existing != nil {
+			result.SkippedSilences++
+			continue
+		}
+
+		if !dryRun {
+			if err := uc.silenceRepo.Save(ctx, silence); err != nil {
+				return result, fmt.Errorf("saving silence %s: %w", silence.ID, err)
+			}
+		}
+		result.Silences++
+	}
+
+	uc.logger.Info("imported state",
+		"exportedAt", export.ExportedAt,
+		"alerts", result.Alerts,
+		"skippedAlerts", result.SkippedAlerts,
+		"silences", result.Silences,
+		"skippedSilences", result.SkippedSilences,
+		"dryRun", dryRun,
+	)
+	return result, nil
+}
+
+// importAlert saves an alert, then its events, so that an interrupted import
+// leaves no events without their alert.
+func (uc *TransferStateUseCase) importAlert(ctx context.Context, a *entity.Alert, ackEvents []*entity.AckEvent, alertEvents []*entity.AlertEvent) error {
+	if err := uc.alertRepo.Save(ctx, a); err != nil {
+		return fmt.Errorf("saving alert %s: %w", a.ID, err)
+	}
+	for _, event := range ackEvents {
+		if err := uc.ackEventRepo.Save(ctx, event); err != nil {
+			return fmt.Errorf("saving ack event %s: %w", event.ID, err)
+		}
+	}
+	for _, event := range alertEvents {
+		if err := uc.alertEventRepo.Save(ctx, event); err != nil {
+			return fmt.Errorf("saving timeline event %s: %w", event.ID, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/memory"
+)
+
+func newMemoryTransferStateUseCase() *TransferStateUseCase {
+	return NewTransferStateUseCase(
+		memory.NewAlertRepository(),
+		memory.NewAckEventRepository(),
+		memory.NewAlertEventRepository(),
+		memory.NewSilenceRepository(),
+		nopLogger{},
+	)
+}
+
+func TestTransferStateUseCase(t *testing.T) {
+	ctx := context.Background()
+	source := newMemoryTransferStateUseCase()
+
+	firing := entity.NewAlert("fp1", "HighCPU", "host-1", "", "summary", entity.SeverityCritical)
+	firing.SetExternalReference("slack", "C123:1700000000.000100")
+	firing.SetExternalReference("pagerduty", "dedup-1")
+	require.NoError(t, firing.Acknowledge("alice", time.Now()))
+	require.NoError(t, source.alertRepo.Save(ctx, firing))
+	require.NoError(t, source.ackEventRepo.Save(ctx, entity.NewAckEvent(firing.ID, entity.AckSourceSlack, "U1", "", "alice")))
+	require.NoError(t, source.alertEventRepo.Save(ctx, entity.NewAlertEvent(firing.ID, entity.AlertEventNotified, "", "slack")))
+
+	resolved := entity.NewAlert("fp2", "DiskFull", "host-2", "", "summary", entity.SeverityWarning)
+	resolved.Resolve(time.Now())
+	require.NoError(t, source.alertRepo.Save(ctx, resolved))
+
+	silence, err := entity.NewSilenceMark(time.Hour, "alice", "", entity.AckSourceSlack)
+	require.NoError(t, err)
+	silence.Instance = "host-3"
+	require.NoError(t, source.silenceRepo.Save(ctx, silence))
+
+	export, err := source.Export(ctx)
+	require.NoError(t, err)
+	require.Len(t, export.Alerts, 1, "only firing alerts are exported")
+
+	// The export travels as JSON between instances
+	data, err := json.Marshal(export)
+	require.NoError(t, err)
+	var received StateExport
+	require.NoError(t, json.Unmarshal(data, &received))
+
+	target := newMemoryTransferStateUseCase()
+
+	result, err := target.Import(ctx, &received, true)
+	require.NoError(t, err)
+	assert.Equal(t, &StateImportResult{Alerts: 1, AckEvents: 1, AlertEvents: 1, Silences: 1}, result)
+	imported, err := target.alertRepo.FindByID(ctx, firing.ID)
+	require.NoError(t, err)
+	assert.Nil(t, imported, "a dry run saves nothing")
+
+	result, err = target.Import(ctx, &received, false)
+	require.NoError(t, err)
+	assert.Equal(t, &StateImportResult{Alerts: 1, AckEvents: 1, AlertEvents: 1, Silences: 1}, result)
+
+	imported, err = target.alertRepo.FindByID(ctx, firing.ID)
+	require.NoError(t, err)
+	require.NotNil(t, imported)
+	assert.Equal(t, "C123:1700000000.000100", imported.GetExternalReference("slack"))
+	assert.Equal(t, "dedup-1", imported.GetExternalReference("pagerduty"))
+	assert.True(t, imported.IsAcked())
+
+	ackEvents, err := target.ackEventRepo.FindByAlertID(ctx, firing.ID)
+	require.NoError(t, err)
+	assert.Len(t, ackEvents, 1)
+	silences, err := target.silenceRepo.FindActive(ctx)
+	require.NoError(t, err)
+	assert.Len(t, silences, 1)
+
+	// Importing again skips what is already there
+	result, err = target.Import(ctx, &received, false)
+	require.NoError(t, err)
+	assert.Equal(t, &StateImportResult{SkippedAlerts: 1, SkippedSilences: 1}, result)
+
+	received.Version = 2
+	_, err = target.Import(ctx, &received, false)
+	assert.ErrorIs(t, err, ErrUnsupportedStateExport)
+}
@@ -0,0 +1,291 @@
+// Package incident keeps alerts in step with the incidents they are linked
+// to in incident management tools such as FireHydrant and incident.io.
+package incident
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/ack"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
+)
+
+// ErrUnknownTool is returned for a tool that is not configured.
+var ErrUnknownTool = errors.New("incident management tool not configured")
+
+// ErrMissingIncidentID is returned when linking without an incident ID.
+var ErrMissingIncidentID = errors.New("incident_id is required")
+
+// Action is what an incident update does to its linked alerts.
+type Action string
+
+const (
+	// ActionNone leaves the alerts as they are.
+	ActionNone Action = ""
+	// ActionAcknowledge acknowledges the alerts; someone works the incident.
+	ActionAcknowledge Action = "acknowledge"
+	// ActionResolve resolves the alerts.
+	ActionResolve Action = "resolve"
+)
+
+// UpdateInput is an incident update received from a tool's webhook.
+type UpdateInput struct {
+	// Tool is the tool's name, e.g. "firehydrant".
+	Tool       string
+	IncidentID string
+	Action     Action
+
+	// Status is the tool's own incident status, e.g. "mitigated", for logs.
+	Status string
+
+	UserName  string
+	UserEmail string
+}
+
+// UpdateOutput reports which alerts an update changed.
+type UpdateOutput struct {
+	AlertIDs []string
+}
+
+// LinkInput links an alert to an incident of a tool.
+type LinkInput struct {
+	AlertID    string
+	Tool       string
+	IncidentID string
+	By         string
+}
+
+// SyncUseCase links alerts to incidents and applies incident updates from
+// the tools' webhooks to the linked alerts. Changes are made under
+// alert.WithIncidentOrigin so they are not echoed back to the tool they came
+// from.
+type SyncUseCase struct {
+	alertRepo repository.AlertRepository
+	syncAckUC *ack.SyncAckUseCase
+	notifiers []alert.Notifier
+	incidents *alert.IncidentTimelines
+	timeline  *alert.Timeline
+	logger    alert.Logger
+}
+
+// NewSyncUseCase creates a new SyncUseCase for the tools of incidents.
+func NewSyncUseCase(
+	alertRepo repository.AlertRepository,
+	syncAckUC *ack.SyncAckUseCase,
+	notifiers []alert.Notifier,
+	incidents *alert.IncidentTimelines,
+	logger alert.Logger,
+) *SyncUseCase {
+	return &SyncUseCase{
+		alertRepo: alertRepo,
+		syncAckUC: syncAckUC,
+		notifiers: notifiers,
+		incidents: incidents,
+		logger:    logger,
+	}
+}
+
+// SetTimeline records links and resolutions in the alert timeline.
+func (uc *SyncUseCase) SetTimeline(timeline *alert.Timeline) {
+	uc.timeline = timeline
+}
+
+// Link links the alert to the tool's incident, replacing any earlier link
+// to the tool, and announces it on the incident's timeline. From then on
+// the alert's events are posted there. Returns ErrUnknownTool,
+// ErrMissingIncidentID or entity.ErrAlertNotFound.
+func (uc *SyncUseCase) Link(ctx context.Context, input LinkInput) (*entity.Alert, error) {
+	tool := uc.incidents.Tool(input.Tool)
+	if tool == nil {
+		return nil, ErrUnknownTool
+	}
+	if input.IncidentID == "" {
+		return nil, ErrMissingIncidentID
+	}
+	a, err := uc.find(ctx, input.AlertID)
+	if err != nil {
+		return nil, err
+	}
+
+	a.SetExternalReference(input.Tool, input.IncidentID)
+	if err := uc.alertRepo.Update(ctx, a); err != nil {
+		return nil, fmt.Errorf("updating alert: %w", err)
+	}
+	// The incident's own timeline gets the fuller PostLinked instead
+	uc.timeline.Record(alert.WithIncidentOrigin(ctx, input.Tool), a.ID, entity.AlertEventNoteAdded, input.By,
+		fmt.Sprintf("Linked to %s incident %s", displayName(input.Tool), input.IncidentID))
+	uc.incidents.PostLinked(ctx, a, tool)
+
+	uc.logger.Info("alert linked to incident",
+		"alertID", a.ID,
+		"tool", input.Tool,
+		"incidentID", input.IncidentID,
+		"by", input.By,
+	)
+	return a, nil
+}
+
+// Unlink removes the alert's link to the tool's incident. Returns
+// ErrUnknownTool or entity.ErrAlertNotFound.
+func (uc *SyncUseCase) Unlink(ctx context.Context, alertID, toolName, by string) (*entity.Alert, error) {
+	if uc.incidents.Tool(toolName) == nil {
+		return nil, ErrUnknownTool
+	}
+	a, err := uc.find(ctx, alertID)
+	if err != nil {
+		return nil, err
+	}
+	incidentID := a.GetExternalReference(toolName)
+	if incidentID == "" {
+		return a, nil
+	}
+
+	a.ClearExternalReference(toolName)
+	if err := uc.alertRepo.Update(ctx, a); err != nil {
+		return nil, fmt.Errorf("updating alert: %w", err)
+	}
+	uc.timeline.Record(ctx, a.ID, entity.AlertEventNoteAdded, by,
+		fmt.Sprintf("Unlinked from %s incident %s", displayName(toolName), incidentID))
+	return a, nil
+}
+
+// HandleUpdate applies an incident update to the firing alerts linked to
+// the incident. Alerts already in the requested state are left alone;
+// failures on one alert are logged and the others still updated.
+func (uc *SyncUseCase) HandleUpdate(ctx context.Context, input UpdateInput) (*UpdateOutput, error) {
+	output := &UpdateOutput{AlertIDs: []string{}}
+	if input.Action == ActionNone || input.IncidentID == "" {
+		return output, nil
+	}
+
+	firing, err := uc.alertRepo.FindFiring(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("finding firing alerts: %w", err)
+	}
+
+	ctx = alert.WithIncidentOrigin(ctx, input.Tool)
+	for _, a := range firing {
+		if a.GetExternalReference(input.Tool) != input.IncidentID {
+			continue
+		}
+
+		var err error
+		switch input.Action {
+		case ActionAcknowledge:
+			if a.IsAcked() {
+				continue
+			}
+			err = uc.acknowledge(ctx, a, input)
+		case ActionResolve:
+			err = uc.resolve(ctx, a, input)
+		}
+		if err != nil {
+			uc.logger.Error("failed to apply incident update to alert",
+				"alertID", a.ID,
+				"tool", input.Tool,
+				"incidentID", input.IncidentID,
+				"status", input.Status,
+				"error", err,
+			)
+			continue
+		}
+		output.AlertIDs = append(output.AlertIDs, a.ID)
+	}
+
+	uc.logger.Info("incident update applied",
+		"tool", input.Tool,
+		"incidentID", input.IncidentID,
+		"status", input.Status,
+		"action", input.Action,
+		"alerts", len(output.AlertIDs),
+	)
+	return output, nil
+}
+
+// acknowledge acknowledges a linked alert and syncs the acknowledgment.
+func (uc *SyncUseCase) acknowledge(ctx context.Context, a *entity.Alert, input UpdateInput) error {
+	userName := input.UserName
+	if userName == "" && input.UserEmail == "" {
+		userName = displayName(input.Tool)
+	}
+	output, err := uc.syncAckUC.Execute(ctx, ack.SyncAckInput{
+		AlertID:   a.ID,
+		Source:    entity.AckSource(input.Tool),
+		UserEmail: input.UserEmail,
+		UserName:  userName,
+	})
+	if err != nil {
+		return fmt.Errorf("syncing ack: %w", err)
+	}
+
+	// SyncAck covers PagerDuty and Teams; Slack is not a syncer
+	uc.updateMessages(ctx, output.Alert, "slack")
+	return nil
+}
+
+// resolve resolves a linked alert and updates its notifications.
+func (uc *SyncUseCase) resolve(ctx context.Context, a *entity.Alert, input UpdateInput) error {
+	by := input.UserEmail
+	if by == "" {
+		by = input.UserName
+	}
+	a.ResolveBy(by, time.Now().UTC())
+	if err := uc.alertRepo.Update(ctx, a); err != nil {
+		return fmt.Errorf("updating alert: %w", err)
+	}
+	uc.timeline.Record(ctx, a.ID, entity.AlertEventResolved, by, "via "+displayName(input.Tool))
+
+	// Updating the PagerDuty notification resolves its incident
+	uc.updateMessages(ctx, a)
+	return nil
+}
+
+// find loads an alert, returning entity.ErrAlertNotFound if it does not
+// exist.
+func (uc *SyncUseCase) find(ctx context.Context, id string) (*entity.Alert, error) {
+	a, err := uc.alertRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("finding alert: %w", err)
+	}
+	if a == nil {
+		return nil, entity.ErrAlertNotFound
+	}
+	return a, nil
+}
+
+// updateMessages refreshes existing notifications for the alert. If names
+// are given, only those notifiers are updated. Failures are logged.
+func (uc *SyncUseCase) updateMessages(ctx context.Context, a *entity.Alert, names ...string) {
+	for _, notifier := range uc.notifiers {
+		if len(names) > 0 && !slices.Contains(names, notifier.Name()) {
+			continue
+		}
+		messageID := a.GetExternalReference(notifier.Name())
+		if messageID == "" {
+			continue
+		}
+		if err := notifier.UpdateMessage(ctx, messageID, a); err != nil {
+			uc.logger.Error("failed to update notification",
+				"notifier", notifier.Name(),
+				"alertID", a.ID,
+				"error", err,
+			)
+		}
+	}
+}
+
+// displayName is how a tool is named in timelines.
+func displayName(tool string) string {
+	switch tool {
+	case string(entity.AckSourceFireHydrant):
+		return "FireHydrant"
+	case string(entity.AckSourceIncidentIO):
+		return "incident.io"
+	}
+	return tool
+}
@@ -0,0 +1,148 @@
+package incident
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/memory"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/ack"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
+)
+
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...any) {}
+func (nopLogger) Info(string, ...any)  {}
+func (nopLogger) Warn(string, ...any)  {}
+func (nopLogger) Error(string, ...any) {}
+
+type noTx struct{}
+
+func (noTx) BeginTx(context.Context) (repository.Transaction, error) { return nil, nil }
+
+func (noTx) WithTransaction(ctx context.Context, fn func(context.Context) error) error {
+	return fn(ctx)
+}
+
+type fakeTool struct {
+	name  string
+	posts []string
+}
+
+func (f *fakeTool) Name() string { return f.name }
+
+func (f *fakeTool) PostTimelineEvent(_ context.Context, incidentID, text string, _ time.Time) error {
+	f.posts = append(f.posts, incidentID+": "+text)
+	return nil
+}
+
+type updateRecorder struct {
+	name    string
+	updated []entity.AlertState
+}
+
+func (n *updateRecorder) Notify(context.Context, *entity.Alert) (entity.NotifyResult, error) {
+	return entity.NotifyResult{}, nil
+}
+
+func (n *updateRecorder) UpdateMessage(_ context.Context, _ string, a *entity.Alert) error {
+	n.updated = append(n.updated, a.State)
+	return nil
+}
+
+func (n *updateRecorder) Name() string { return n.name }
+
+func TestSyncUseCase(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	eventRepo := memory.NewAlertEventRepository()
+
+	firehydrant := &fakeTool{name: "firehydrant"}
+	incidentio := &fakeTool{name: "incidentio"}
+	incidents := alert.NewIncidentTimelines(alertRepo, nopLogger{})
+	allEvents := []entity.AlertEventType{entity.AlertEventAcknowledged, entity.AlertEventResolved}
+	incidents.AddTool(firehydrant, allEvents)
+	incidents.AddTool(incidentio, allEvents)
+
+	timeline := alert.NewTimeline(eventRepo, nopLogger{})
+	timeline.SetIncidentTimelines(incidents)
+
+	syncAck := ack.NewSyncAckUseCase(alertRepo, memory.NewAckEventRepository(), noTx{}, nil, nopLogger{}, nil)
+	syncAck.SetTimeline(timeline)
+
+	slack := &updateRecorder{name: "slack"}
+	uc := NewSyncUseCase(alertRepo, syncAck, []alert.Notifier{slack}, incidents, nopLogger{})
+	uc.SetTimeline(timeline)
+
+	a := entity.NewAlert("fp1", "HighCPU", "web-1", "", "CPU is high", entity.SeverityCritical)
+	a.SetExternalReference("slack", "C1:123.456")
+	require.NoError(t, alertRepo.Save(ctx, a))
+
+	_, err := uc.Link(ctx, LinkInput{AlertID: a.ID, Tool: "pagerduty", IncidentID: "1"})
+	assert.ErrorIs(t, err, ErrUnknownTool)
+	_, err = uc.Link(ctx, LinkInput{AlertID: a.ID, Tool: "firehydrant"})
+	assert.ErrorIs(t, err, ErrMissingIncidentID)
+	_, err = uc.Link(ctx, LinkInput{AlertID: "missing", Tool: "firehydrant", IncidentID: "1"})
+	assert.ErrorIs(t, err, entity.ErrAlertNotFound)
+
+	_, err = uc.Link(ctx, LinkInput{AlertID: a.ID, Tool: "firehydrant", IncidentID: "fh-1", By: "alice"})
+	require.NoError(t, err)
+	_, err = uc.Link(ctx, LinkInput{AlertID: a.ID, Tool: "incidentio", IncidentID: "io-1", By: "alice"})
+	require.NoError(t, err)
+	require.Len(t, firehydrant.posts, 1)
+	assert.Contains(t, firehydrant.posts[0], "fh-1: HighCPU on web-1: linked alert")
+
+	// Updates of other incidents leave the alert alone
+	output, err := uc.HandleUpdate(ctx, UpdateInput{Tool: "firehydrant", IncidentID: "fh-2", Action: ActionAcknowledge})
+	require.NoError(t, err)
+	assert.Empty(t, output.AlertIDs)
+
+	// An acknowledgment in FireHydrant reaches Slack and incident.io, but is
+	// not posted back to FireHydrant
+	output, err = uc.HandleUpdate(ctx, UpdateInput{
+		Tool:       "firehydrant",
+		IncidentID: "fh-1",
+		Action:     ActionAcknowledge,
+		Status:     "investigating",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{a.ID}, output.AlertIDs)
+
+	stored, err := alertRepo.FindByID(ctx, a.ID)
+	require.NoError(t, err)
+	assert.True(t, stored.IsAcked())
+	assert.Equal(t, "FireHydrant", stored.AckedBy)
+	assert.Equal(t, []entity.AlertState{entity.StateAcked}, slack.updated)
+	assert.Len(t, firehydrant.posts, 1)
+	require.Len(t, incidentio.posts, 2)
+	assert.Equal(t, "io-1: HighCPU on web-1: acknowledged by FireHydrant via firehydrant", incidentio.posts[1])
+
+	// Resolving in incident.io resolves the alert
+	output, err = uc.HandleUpdate(ctx, UpdateInput{
+		Tool:       "incidentio",
+		IncidentID: "io-1",
+		Action:     ActionResolve,
+		UserName:   "bob",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{a.ID}, output.AlertIDs)
+
+	stored, err = alertRepo.FindByID(ctx, a.ID)
+	require.NoError(t, err)
+	assert.True(t, stored.IsResolved())
+	assert.Len(t, incidentio.posts, 2)
+	require.Len(t, firehydrant.posts, 2)
+	assert.Contains(t, firehydrant.posts[1], "resolved")
+	assert.Contains(t, firehydrant.posts[1], "by bob (via incident.io)")
+
+	// Unlinking stops the posts
+	unlinked, err := uc.Unlink(ctx, a.ID, "firehydrant", "alice")
+	require.NoError(t, err)
+	assert.Empty(t, unlinked.GetExternalReference("firehydrant"))
+}
@@ -2,6 +2,7 @@ package pagerduty
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -17,6 +18,8 @@ type HandleWebhookUseCase struct {
 	alertRepo    repository.AlertRepository
 	syncAckUC    *ack.SyncAckUseCase
 	slackUpdater MessageUpdater
+	timeline     *alert.Timeline
+	users        *ack.UserDirectory
 	logger       alert.Logger
 }
 
@@ -40,6 +43,17 @@ func NewHandleWebhookUseCase(
 	}
 }
 
+// SetTimeline records resolutions from PagerDuty in the alert timeline.
+func (uc *HandleWebhookUseCase) SetTimeline(timeline *alert.Timeline) {
+	uc.timeline = timeline
+}
+
+// SetUserDirectory maps the PagerDuty users incidents are reassigned to
+// to their Slack users, so that assignments mention them.
+func (uc *HandleWebhookUseCase) SetUserDirectory(users *ack.UserDirectory) {
+	uc.users = users
+}
+
 // Execute processes a PagerDuty webhook event.
 func (uc *HandleWebhookUseCase) Execute(ctx context.Context, input dto.HandlePagerDutyWebhookInput) (*dto.HandlePagerDutyWebhookOutput, error) {
 	output := &dto.HandlePagerDutyWebhookOutput{}
@@ -69,15 +83,13 @@ func (uc *HandleWebhookUseCase) Execute(ctx context.Context, input dto.HandlePag
 		return uc.handleResolved(ctx, alertEntity, input, output)
 
 	case "incident.unacknowledged":
-		// PagerDuty unacknowledged - we don't sync this back to Slack
-		// as it's typically a timeout, not a user action
-		uc.logger.Info("incident unacknowledged (not syncing)",
-			"alertID", alertEntity.ID,
-			"incidentID", input.IncidentID,
-		)
-		output.Processed = true
-		output.Message = "unacknowledged event noted"
-		return output, nil
+		return uc.handleUnacknowledged(ctx, alertEntity, input, output)
+
+	case "incident.reassigned":
+		return uc.handleReassigned(ctx, alertEntity, input, output, entity.AlertEventAssigned)
+
+	case "incident.escalated":
+		return uc.handleReassigned(ctx, alertEntity, input, output, entity.AlertEventEscalated)
 
 	default:
 		uc.logger.Debug("ignoring PagerDuty event type",
@@ -120,22 +132,7 @@ func (uc *HandleWebhookUseCase) handleAcknowledged(
 		return nil, fmt.Errorf("syncing ack: %w", err)
 	}
 
-	// Update Slack message if we have a message ID
-	slackMessageID := alertEntity.GetExternalReference("slack")
-	if slackMessageID != "" && uc.slackUpdater != nil {
-		if err := uc.slackUpdater.UpdateMessage(ctx, slackMessageID, ackOutput.Alert); err != nil {
-			uc.logger.Error("failed to update Slack message",
-				"alertID", alertEntity.ID,
-				"slackMessageID", slackMessageID,
-				"error", err,
-			)
-		} else {
-			uc.logger.Info("updated Slack message for PagerDuty ack",
-				"alertID", alertEntity.ID,
-				"slackMessageID", slackMessageID,
-			)
-		}
-	}
+	uc.updateSlackMessage(ctx, ackOutput.Alert, input.EventType)
 
 	output.Processed = true
 	output.Message = fmt.Sprintf("acknowledged by %s", input.UserEmail)
@@ -156,34 +153,143 @@ func (uc *HandleWebhookUseCase) handleResolved(
 		return output, nil
 	}
 
-	// Resolve the alert
-	alertEntity.Resolve(time.Now().UTC())
+	// Resolve the alert, attributed to the PagerDuty user when known
+	resolvedBy := input.UserEmail
+	if resolvedBy == "" {
+		resolvedBy = input.UserName
+	}
+	alertEntity.ResolveBy(resolvedBy, time.Now().UTC())
 	if err := uc.alertRepo.Update(ctx, alertEntity); err != nil {
 		return nil, fmt.Errorf("updating alert: %w", err)
 	}
+	uc.timeline.Record(ctx, alertEntity.ID, entity.AlertEventResolved, resolvedBy, "via PagerDuty")
 
-	// Update Slack message if we have a message ID
-	slackMessageID := alertEntity.GetExternalReference("slack")
-	if slackMessageID != "" && uc.slackUpdater != nil {
-		if err := uc.slackUpdater.UpdateMessage(ctx, slackMessageID, alertEntity); err != nil {
-			uc.logger.Error("failed to update Slack message for resolution",
-				"alertID", alertEntity.ID,
-				"slackMessageID", slackMessageID,
-				"error", err,
-			)
-		} else {
-			uc.logger.Info("updated Slack message for PagerDuty resolution",
-				"alertID", alertEntity.ID,
-				"slackMessageID", slackMessageID,
-			)
+	uc.updateSlackMessage(ctx, alertEntity, input.EventType)
+
+	output.Processed = true
+	output.Message = "resolved"
+	return output, nil
+}
+
+// handleUnacknowledged processes an incident.unacknowledged event, such as
+// when an acknowledgment times out and PagerDuty pages again. The alert
+// returns to active so that Slack offers to acknowledge it again.
+func (uc *HandleWebhookUseCase) handleUnacknowledged(
+	ctx context.Context,
+	alertEntity *entity.Alert,
+	input dto.HandlePagerDutyWebhookInput,
+	output *dto.HandlePagerDutyWebhookOutput,
+) (*dto.HandlePagerDutyWebhookOutput, error) {
+	// Timeouts have no user
+	by := input.UserEmail
+	if by == "" {
+		by = input.UserName
+	}
+
+	err := alertEntity.Unacknowledge(by, time.Now().UTC())
+	if errors.Is(err, entity.ErrAlertAlreadyResolved) || errors.Is(err, entity.ErrInvalidAlertState) {
+		output.Processed = true
+		output.Message = "not acknowledged"
+		return output, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unacknowledging alert: %w", err)
+	}
+	if err := uc.alertRepo.Update(ctx, alertEntity); err != nil {
+		return nil, fmt.Errorf("updating alert: %w", err)
+	}
+	uc.timeline.Record(ctx, alertEntity.ID, entity.AlertEventUnacknowledged, by, "via PagerDuty")
+
+	uc.updateSlackMessage(ctx, alertEntity, input.EventType)
+
+	output.Processed = true
+	output.Message = "unacknowledged"
+	return output, nil
+}
+
+// handleReassigned processes an incident.reassigned or incident.escalated
+// event by assigning the alert to the incident's new assignee, mentioned in
+// Slack when the user directory knows their Slack user. eventType is the
+// timeline event recorded.
+func (uc *HandleWebhookUseCase) handleReassigned(
+	ctx context.Context,
+	alertEntity *entity.Alert,
+	input dto.HandlePagerDutyWebhookInput,
+	output *dto.HandlePagerDutyWebhookOutput,
+	eventType entity.AlertEventType,
+) (*dto.HandlePagerDutyWebhookOutput, error) {
+	if input.AssigneeID == "" && input.AssigneeEmail == "" && input.AssigneeName == "" {
+		output.Message = "no assignee"
+		return output, nil
+	}
+
+	assignee := uc.users.Resolve(ctx, entity.UserIdentity{
+		Email:           input.AssigneeEmail,
+		Name:            input.AssigneeName,
+		PagerDutyUserID: input.AssigneeID,
+	})
+	name := assignee.Name
+	if name == "" {
+		name = assignee.Email
+	}
+	if name == "" {
+		name = assignee.PagerDutyUserID
+	}
+
+	previous := alertEntity.AssignedTo
+	if err := alertEntity.Assign(name, assignee.SlackUserID, time.Now().UTC()); err != nil {
+		if errors.Is(err, entity.ErrAlertAlreadyResolved) {
+			output.Processed = true
+			output.Message = "already resolved"
+			return output, nil
 		}
+		return nil, fmt.Errorf("assigning alert: %w", err)
+	}
+	if err := uc.alertRepo.Update(ctx, alertEntity); err != nil {
+		return nil, fmt.Errorf("updating alert: %w", err)
+	}
+
+	detail := "to " + name
+	if previous != "" && previous != name {
+		detail += " (was " + previous + ")"
+	}
+	by := input.UserEmail
+	if by == "" {
+		by = input.UserName
 	}
+	uc.timeline.Record(ctx, alertEntity.ID, eventType, by, detail+" via PagerDuty")
+
+	uc.updateSlackMessage(ctx, alertEntity, input.EventType)
 
 	output.Processed = true
-	output.Message = "resolved"
+	output.Message = fmt.Sprintf("%s to %s", eventType, name)
 	return output, nil
 }
 
+// updateSlackMessage updates the alert's Slack message, if it has one, to
+// show the change from PagerDuty. Failures are logged.
+func (uc *HandleWebhookUseCase) updateSlackMessage(ctx context.Context, alertEntity *entity.Alert, eventType string) {
+	slackMessageID := alertEntity.GetExternalReference("slack")
+	if slackMessageID == "" || uc.slackUpdater == nil {
+		return
+	}
+
+	if err := uc.slackUpdater.UpdateMessage(ctx, slackMessageID, alertEntity); err != nil {
+		uc.logger.Error("failed to update Slack message",
+			"alertID", alertEntity.ID,
+			"slackMessageID", slackMessageID,
+			"eventType", eventType,
+			"error", err,
+		)
+		return
+	}
+	uc.logger.Info("updated Slack message for PagerDuty event",
+		"alertID", alertEntity.ID,
+		"slackMessageID", slackMessageID,
+		"eventType", eventType,
+	)
+}
+
 // findAlertByIncidentKey finds an alert by PagerDuty incident key.
 // The incident key typically maps to our fingerprint.
 // Uses two-tier lookup strategy: primary by incident ID, fallback to fingerprint.
@@ -0,0 +1,106 @@
+package pagerduty
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/adapter/dto"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/memory"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/ack"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
+)
+
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...any) {}
+func (nopLogger) Info(string, ...any)  {}
+func (nopLogger) Warn(string, ...any)  {}
+func (nopLogger) Error(string, ...any) {}
+
+type fakeUpdater struct {
+	updates []*entity.Alert
+}
+
+func (f *fakeUpdater) UpdateMessage(_ context.Context, _ string, a *entity.Alert) error {
+	copied := *a
+	f.updates = append(f.updates, &copied)
+	return nil
+}
+
+func TestHandleWebhook_UnacknowledgeAndReassign(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewAlertRepository()
+	events := memory.NewAlertEventRepository()
+	slack := &fakeUpdater{}
+
+	uc := NewHandleWebhookUseCase(repo, nil, slack, nopLogger{})
+	uc.SetTimeline(alert.NewTimeline(events, nopLogger{}))
+	uc.SetUserDirectory(ack.NewUserDirectory([]entity.UserIdentity{
+		{Email: "bob@example.com", Name: "Bob", SlackUserID: "U2", PagerDutyUserID: "P2"},
+	}, time.Hour, nopLogger{}))
+
+	a := entity.NewAlert("fp1", "HighCPU", "web-1", "", "summary", entity.SeverityCritical)
+	a.SetExternalReference("pagerduty", "Q1")
+	a.SetExternalReference("slack", "C1:123.456")
+	require.NoError(t, a.Acknowledge("alice@example.com", time.Now()))
+	require.NoError(t, repo.Save(ctx, a))
+
+	output, err := uc.Execute(ctx, dto.HandlePagerDutyWebhookInput{
+		EventType:  "incident.unacknowledged",
+		IncidentID: "Q1",
+	})
+	require.NoError(t, err)
+	assert.True(t, output.Processed)
+
+	stored, err := repo.FindByID(ctx, a.ID)
+	require.NoError(t, err)
+	assert.Equal(t, entity.StateActive, stored.State)
+	assert.Empty(t, stored.AckedBy)
+
+	// Unacknowledging again changes nothing
+	output, err = uc.Execute(ctx, dto.HandlePagerDutyWebhookInput{
+		EventType:  "incident.unacknowledged",
+		IncidentID: "Q1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "not acknowledged", output.Message)
+
+	// The directory finds the assignee's Slack user by their PagerDuty user
+	_, err = uc.Execute(ctx, dto.HandlePagerDutyWebhookInput{
+		EventType:  "incident.escalated",
+		IncidentID: "Q1",
+		AssigneeID: "P2",
+	})
+	require.NoError(t, err)
+
+	stored, err = repo.FindByID(ctx, a.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Bob", stored.AssignedTo)
+	assert.Equal(t, "U2", stored.AssigneeSlackID)
+
+	_, err = uc.Execute(ctx, dto.HandlePagerDutyWebhookInput{
+		EventType:     "incident.reassigned",
+		IncidentID:    "Q1",
+		AssigneeEmail: "carol@example.com",
+		AssigneeName:  "Carol",
+	})
+	require.NoError(t, err)
+
+	timeline, err := events.FindByAlertID(ctx, a.ID)
+	require.NoError(t, err)
+	require.Len(t, timeline, 3)
+	assert.Equal(t, entity.AlertEventUnacknowledged, timeline[0].Type)
+	assert.Equal(t, entity.AlertEventEscalated, timeline[1].Type)
+	assert.Equal(t, "to Bob via PagerDuty", timeline[1].Detail)
+	assert.Equal(t, entity.AlertEventAssigned, timeline[2].Type)
+	assert.Equal(t, "to Carol (was Bob) via PagerDuty", timeline[2].Detail)
+
+	require.Len(t, slack.updates, 3)
+	assert.Equal(t, entity.StateActive, slack.updates[0].State)
+	assert.Equal(t, "Carol", slack.updates[2].AssignedTo)
+}
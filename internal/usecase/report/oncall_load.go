@@ -0,0 +1,230 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/service"
+)
+
+// ErrInvalidMonth is returned for a month that is malformed or in the future.
+var ErrInvalidMonth = errors.New("month must be a past or current month in YYYY-MM format")
+
+// OnCallLoadPolicy configures how pages and acks are attributed.
+type OnCallLoadPolicy struct {
+	// TeamLabel is the alert label naming the team an alert belongs to.
+	TeamLabel string
+
+	// Location is the time zone months and working hours are evaluated in.
+	Location *time.Location
+
+	// WorkdayStart and WorkdayEnd bound working hours as offsets from
+	// midnight. Acks outside them, or on a weekend, are after hours.
+	WorkdayStart time.Duration
+	WorkdayEnd   time.Duration
+}
+
+// OnCallLoadSender delivers a person's own monthly load to them.
+type OnCallLoadSender interface {
+	SendOnCallLoad(ctx context.Context, slackUserID string, month time.Time, load *entity.PersonOnCallLoad) error
+}
+
+// OnCallLoadUseCase aggregates pages and acknowledgments per person and team
+// for a calendar month.
+type OnCallLoadUseCase struct {
+	alertRepo    repository.AlertRepository
+	ackEventRepo repository.AckEventRepository
+	matcher      *service.SubscriberMatcher
+	sender       OnCallLoadSender
+	policy       OnCallLoadPolicy
+	now          func() time.Time
+}
+
+// NewOnCallLoadUseCase creates a new OnCallLoadUseCase.
+func NewOnCallLoadUseCase(
+	alertRepo repository.AlertRepository,
+	ackEventRepo repository.AckEventRepository,
+	policy OnCallLoadPolicy,
+) *OnCallLoadUseCase {
+	if policy.Location == nil {
+		policy.Location = time.UTC
+	}
+	return &OnCallLoadUseCase{
+		alertRepo:    alertRepo,
+		ackEventRepo: ackEventRepo,
+		policy:       policy,
+		now:          time.Now,
+	}
+}
+
+// SetSubscriberMatcher attributes pages to the subscribers, including
+// on-call rotation members, an alert matched when it fired. Without it,
+// pages are only counted per team.
+func (uc *OnCallLoadUseCase) SetSubscriberMatcher(matcher *service.SubscriberMatcher) {
+	uc.matcher = matcher
+}
+
+// SetSender enables SendDirectMessages.
+func (uc *OnCallLoadUseCase) SetSender(sender OnCallLoadSender) {
+	uc.sender = sender
+}
+
+// Build computes the report for month ("2026-09"), or for the current month
+// to date when month is empty.
+// Returns ErrInvalidMonth if month is malformed or in the future.
+func (uc *OnCallLoadUseCase) Build(ctx context.Context, month string) (*entity.OnCallLoadReport, error) {
+	now := uc.now().In(uc.policy.Location)
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, uc.policy.Location)
+	if month != "" {
+		var err error
+		start, err = time.ParseInLocation("2006-01", month, uc.policy.Location)
+		if err != nil || start.After(now) {
+			return nil, ErrInvalidMonth
+		}
+	}
+	return uc.build(ctx, start, now)
+}
+
+// SendDirectMessages sends every person with a Slack user ID their own load
+// for the previous month. A failed message does not stop the others; their
+// errors are joined.
+func (uc *OnCallLoadUseCase) SendDirectMessages(ctx context.Context) error {
+	if uc.sender == nil {
+		return nil
+	}
+
+	now := uc.now().In(uc.policy.Location)
+	start := time.Date(now.Year(), now.Month()-1, 1, 0, 0, 0, 0, uc.policy.Location)
+	report, err := uc.build(ctx, start, now)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, person := range report.People {
+		if person.SlackUserID == "" {
+			continue
+		}
+		if err := uc.sender.SendOnCallLoad(ctx, person.SlackUserID, report.PeriodStart, person); err != nil {
+			errs = append(errs, fmt.Errorf("sending on-call load to %s: %w", person.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// build computes the report for the month starting at start, up to now.
+func (uc *OnCallLoadUseCase) build(ctx context.Context, start, now time.Time) (*entity.OnCallLoadReport, error) {
+	end := start.AddDate(0, 1, 0)
+	if end.After(now) {
+		end = now
+	}
+
+	// Alerts fired, acknowledged or resolved in the month are among those
+	// changed since its start
+	alerts, err := uc.alertRepo.FindChangedSince(ctx, start)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get alerts: %w", err)
+	}
+
+	report := entity.NewOnCallLoadReport(start, end)
+	for _, alert := range alerts {
+		team := alert.GetLabel(uc.policy.TeamLabel)
+
+		if inMonth(alert.FiredAt, start, end) {
+			uc.countPage(report, team, alert)
+		}
+
+		if alert.AckedAt == nil {
+			continue
+		}
+		if err := uc.countAcks(ctx, report, team, alert, start, end); err != nil {
+			return nil, err
+		}
+	}
+
+	report.Finish()
+	return report, nil
+}
+
+// countPage counts the alert for each subscriber it matched when it fired.
+func (uc *OnCallLoadUseCase) countPage(report *entity.OnCallLoadReport, team string, alert *entity.Alert) {
+	var matched []service.MatchedSubscriber
+	if uc.matcher != nil {
+		matched = uc.matcher.MatchAlertAt(alert, alert.FiredAt)
+	}
+	if len(matched) == 0 {
+		report.AddTeamPage(team)
+		return
+	}
+	for _, m := range matched {
+		report.AddPage(team, m.Subscriber.Name, m.Subscriber.SlackUserID)
+	}
+}
+
+// countAcks counts the alert's acks made in the month, once per person.
+func (uc *OnCallLoadUseCase) countAcks(
+	ctx context.Context,
+	report *entity.OnCallLoadReport,
+	team string,
+	alert *entity.Alert,
+	start, end time.Time,
+) error {
+	events, err := uc.ackEventRepo.FindByAlertID(ctx, alert.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get ack events: %w", err)
+	}
+
+	counted := make(map[string]bool, len(events))
+	for _, event := range events {
+		if !inMonth(event.CreatedAt, start, end) {
+			continue
+		}
+		name, slackUserID := uc.person(event)
+		if name == "" || counted[name] {
+			continue
+		}
+		counted[name] = true
+		report.AddAck(team, name, slackUserID, uc.afterHours(event.CreatedAt))
+	}
+	return nil
+}
+
+// person identifies who made the ack: the subscriber with the ack's Slack or
+// PagerDuty user ID, or else their email or display name.
+func (uc *OnCallLoadUseCase) person(event *entity.AckEvent) (name, slackUserID string) {
+	if uc.matcher != nil {
+		if sub, ok := uc.matcher.SubscriberForUser(event.UserID); ok {
+			return sub.Name, sub.SlackUserID
+		}
+	}
+	if event.IsFromSlack() {
+		slackUserID = event.UserID
+	}
+	switch {
+	case event.UserEmail != "":
+		return event.UserEmail, slackUserID
+	case event.UserName != "":
+		return event.UserName, slackUserID
+	default:
+		return event.UserID, slackUserID
+	}
+}
+
+// afterHours reports whether t is on a weekend or outside working hours.
+func (uc *OnCallLoadUseCase) afterHours(t time.Time) bool {
+	local := t.In(uc.policy.Location)
+	if local.Weekday() == time.Saturday || local.Weekday() == time.Sunday {
+		return true
+	}
+	sinceMidnight := time.Duration(local.Hour())*time.Hour + time.Duration(local.Minute())*time.Minute
+	return sinceMidnight < uc.policy.WorkdayStart || sinceMidnight >= uc.policy.WorkdayEnd
+}
+
+// inMonth reports whether t falls within [start, end).
+func inMonth(t, start, end time.Time) bool {
+	return !t.Before(start) && t.Before(end)
+}
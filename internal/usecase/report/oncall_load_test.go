@@ -0,0 +1,117 @@
+package report
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/service"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/config"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/memory"
+)
+
+type fakeOnCallLoadSender struct {
+	sent map[string]entity.OnCallLoad
+}
+
+func (s *fakeOnCallLoadSender) SendOnCallLoad(_ context.Context, slackUserID string, _ time.Time, load *entity.PersonOnCallLoad) error {
+	s.sent[slackUserID] = load.OnCallLoad
+	return nil
+}
+
+func TestOnCallLoadUseCase(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	ackRepo := memory.NewAckEventRepository()
+
+	matcher := service.NewSubscriberMatcher([]config.SubscriberConfig{
+		{Name: "alice", SlackUserID: "U1", PagerDutyUserID: "P1", Labels: map[string]string{"team": "infra"}},
+		{Name: "bob", SlackUserID: "U2"},
+		{Name: "carol"},
+	})
+	matcher.SetRotations([]config.OnCallConfig{{
+		Name:     "payments",
+		Labels:   map[string]string{"team": "payments"},
+		Rotation: config.RotationWeekly,
+		Start:    time.Date(2026, 8, 31, 9, 0, 0, 0, time.UTC),
+		Members:  []string{"bob", "carol"},
+	}})
+
+	uc := NewOnCallLoadUseCase(alertRepo, ackRepo, OnCallLoadPolicy{
+		TeamLabel:    "team",
+		WorkdayStart: 9 * time.Hour,
+		WorkdayEnd:   18 * time.Hour,
+	})
+	uc.SetSubscriberMatcher(matcher)
+	uc.now = func() time.Time { return time.Date(2026, 10, 5, 12, 0, 0, 0, time.UTC) }
+
+	newAlert := func(team string, firedAt time.Time) *entity.Alert {
+		alert := entity.NewAlert(team+firedAt.String(), "HighCPU", "host-1", "", "summary", entity.SeverityCritical)
+		alert.Labels["team"] = team
+		alert.FiredAt = firedAt
+		require.NoError(t, alertRepo.Save(ctx, alert))
+		return alert
+	}
+	ack := func(alert *entity.Alert, event *entity.AckEvent, at time.Time) {
+		if alert.AckedAt == nil {
+			require.NoError(t, alert.Acknowledge(event.UserName, at))
+			require.NoError(t, alertRepo.Update(ctx, alert))
+		}
+		event.CreatedAt = at
+		require.NoError(t, ackRepo.Save(ctx, event))
+	}
+
+	// Fired before the month, acked on its first morning before working hours
+	august := newAlert("infra", time.Date(2026, 8, 31, 23, 0, 0, 0, time.UTC))
+	ack(august, entity.NewAckEvent(august.ID, entity.AckSourceSlack, "U1", "", "Alice"), time.Date(2026, 9, 1, 8, 0, 0, 0, time.UTC))
+
+	// Acked at night from Slack, then synced back from PagerDuty
+	night := newAlert("infra", time.Date(2026, 9, 10, 3, 0, 0, 0, time.UTC))
+	ack(night, entity.NewAckEvent(night.ID, entity.AckSourceSlack, "U1", "", "Alice"), time.Date(2026, 9, 10, 3, 5, 0, 0, time.UTC))
+	ack(night, entity.NewAckEvent(night.ID, entity.AckSourcePagerDuty, "P1", "", "Alice"), time.Date(2026, 9, 10, 3, 6, 0, 0, time.UTC))
+
+	// Paged the rotation's members; one acked through the API by someone else
+	first := newAlert("payments", time.Date(2026, 9, 2, 10, 0, 0, 0, time.UTC))
+	ack(first, entity.NewAckEvent(first.ID, entity.AckSourceAPI, "", "dave@example.com", "dave@example.com"), time.Date(2026, 9, 2, 10, 10, 0, 0, time.UTC))
+	newAlert("payments", time.Date(2026, 9, 8, 10, 0, 0, 0, time.UTC))
+
+	report, err := uc.Build(ctx, "2026-09")
+	require.NoError(t, err)
+	assert.Equal(t, "2026-09", report.Month)
+
+	people := make(map[string]entity.OnCallLoad)
+	for _, person := range report.People {
+		people[person.Name] = person.OnCallLoad
+	}
+	assert.Equal(t, map[string]entity.OnCallLoad{
+		"alice":            {Pages: 1, Acks: 2, AfterHoursAcks: 2},
+		"bob":              {Pages: 1},
+		"carol":            {Pages: 1},
+		"dave@example.com": {Acks: 1},
+	}, people)
+
+	require.Len(t, report.Teams, 2)
+	assert.Equal(t, "infra", report.Teams[0].Team)
+	assert.Equal(t, entity.OnCallLoad{Pages: 1, Acks: 2, AfterHoursAcks: 2}, report.Teams[0].OnCallLoad)
+	assert.Equal(t, "payments", report.Teams[1].Team)
+	assert.Equal(t, entity.OnCallLoad{Pages: 2, Acks: 1}, report.Teams[1].OnCallLoad)
+	assert.Len(t, report.Teams[1].People, 3)
+
+	for _, month := range []string{"2026-11", "September"} {
+		_, err := uc.Build(ctx, month)
+		assert.ErrorIs(t, err, ErrInvalidMonth, month)
+	}
+
+	// Direct messages cover the previous month, for people with a Slack ID
+	sender := &fakeOnCallLoadSender{sent: make(map[string]entity.OnCallLoad)}
+	uc.SetSender(sender)
+	require.NoError(t, uc.SendDirectMessages(ctx))
+	assert.Equal(t, map[string]entity.OnCallLoad{
+		"U1": {Pages: 1, Acks: 2, AfterHoursAcks: 2},
+		"U2": {Pages: 1},
+	}, sender.sent)
+}
@@ -0,0 +1,86 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+)
+
+// ErrInvalidPeriod is returned for a period that is not positive.
+var ErrInvalidPeriod = errors.New("period must be positive, e.g. 24h, 7d or 4w")
+
+// ResponseTimesSender posts a response time report to a channel.
+type ResponseTimesSender interface {
+	PostResponseTimes(ctx context.Context, channelID string, report *entity.ResponseTimeReport) error
+}
+
+// ResponseTimesUseCase computes mean time to acknowledge and to resolve per
+// alert name, team and severity.
+type ResponseTimesUseCase struct {
+	alertRepo repository.AlertRepository
+	sender    ResponseTimesSender
+	teamLabel string
+	now       func() time.Time
+}
+
+// NewResponseTimesUseCase creates a new ResponseTimesUseCase. teamLabel is
+// the alert label naming the team an alert belongs to.
+func NewResponseTimesUseCase(alertRepo repository.AlertRepository, teamLabel string) *ResponseTimesUseCase {
+	return &ResponseTimesUseCase{
+		alertRepo: alertRepo,
+		teamLabel: teamLabel,
+		now:       time.Now,
+	}
+}
+
+// SetSender enables SendDigest.
+func (uc *ResponseTimesUseCase) SetSender(sender ResponseTimesSender) {
+	uc.sender = sender
+}
+
+// Build computes the report for the period ending now. Alerts count toward
+// the acknowledgments and resolutions made within the period, wherever they
+// fired.
+// Returns ErrInvalidPeriod if period is not positive.
+func (uc *ResponseTimesUseCase) Build(ctx context.Context, period time.Duration) (*entity.ResponseTimeReport, error) {
+	if period <= 0 {
+		return nil, ErrInvalidPeriod
+	}
+
+	end := uc.now()
+	start := end.Add(-period)
+
+	// Alerts fired, acknowledged or resolved in the period are among those
+	// changed since its start
+	alerts, err := uc.alertRepo.FindChangedSince(ctx, start)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get alerts: %w", err)
+	}
+
+	report := entity.NewResponseTimeReport(start, end)
+	for _, alert := range alerts {
+		report.Add(alert, alert.GetLabel(uc.teamLabel))
+	}
+	report.Finish()
+	return report, nil
+}
+
+// SendDigest posts the report for the period ending now to channelID.
+func (uc *ResponseTimesUseCase) SendDigest(ctx context.Context, channelID string, period time.Duration) error {
+	if uc.sender == nil {
+		return nil
+	}
+
+	report, err := uc.Build(ctx, period)
+	if err != nil {
+		return err
+	}
+	if err := uc.sender.PostResponseTimes(ctx, channelID, report); err != nil {
+		return fmt.Errorf("posting response times to %s: %w", channelID, err)
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package report
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/memory"
+)
+
+type fakeResponseTimesSender struct {
+	channelID string
+	report    *entity.ResponseTimeReport
+}
+
+func (s *fakeResponseTimesSender) PostResponseTimes(_ context.Context, channelID string, report *entity.ResponseTimeReport) error {
+	s.channelID = channelID
+	s.report = report
+	return nil
+}
+
+func TestResponseTimesUseCase(t *testing.T) {
+	ctx := context.Background()
+	alertRepo := memory.NewAlertRepository()
+	now := time.Date(2026, 10, 12, 9, 0, 0, 0, time.UTC)
+
+	uc := NewResponseTimesUseCase(alertRepo, "team")
+	uc.now = func() time.Time { return now }
+
+	newAlert := func(name, team string, severity entity.AlertSeverity, firedAgo time.Duration) *entity.Alert {
+		alert := entity.NewAlert(name+team+firedAgo.String(), name, "host-1", "", "summary", severity)
+		if team != "" {
+			alert.Labels["team"] = team
+		}
+		alert.FiredAt = now.Add(-firedAgo)
+		return alert
+	}
+	save := func(alert *entity.Alert, ackedAfter, resolvedAfter time.Duration) {
+		if ackedAfter > 0 {
+			require.NoError(t, alert.Acknowledge("alice", alert.FiredAt.Add(ackedAfter)))
+		}
+		if resolvedAfter > 0 {
+			alert.Resolve(alert.FiredAt.Add(resolvedAfter))
+		}
+		require.NoError(t, alertRepo.Save(ctx, alert))
+	}
+
+	// Acked after 10m and resolved after 1h
+	save(newAlert("HighCPU", "infra", entity.SeverityCritical, 48*time.Hour), 10*time.Minute, time.Hour)
+	// Acked after 20m and resolved after 3h
+	save(newAlert("HighCPU", "payments", entity.SeverityCritical, 24*time.Hour), 20*time.Minute, 3*time.Hour)
+	// Still firing, without a team
+	save(newAlert("DiskFull", "", entity.SeverityWarning, time.Hour), 0, 0)
+	// Fired before the week, resolved within it
+	save(newAlert("DiskFull", "infra", entity.SeverityWarning, 10*24*time.Hour), 0, 9*24*time.Hour)
+	// Resolved before the week
+	save(newAlert("HighCPU", "infra", entity.SeverityCritical, 30*24*time.Hour), time.Minute, time.Hour)
+
+	report, err := uc.Build(ctx, 7*24*time.Hour)
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(-7*24*time.Hour), report.PeriodStart)
+
+	assert.Equal(t, 3, report.Overall.Fired)
+	assert.Equal(t, 2, report.Overall.Acked)
+	assert.Equal(t, 3, report.Overall.Resolved)
+	assert.Equal(t, 15*time.Minute, report.Overall.MTTA)
+	assert.Equal(t, (time.Hour+3*time.Hour+9*24*time.Hour)/3, report.Overall.MTTR)
+
+	keys := func(groups []*entity.ResponseTimeGroup) []string {
+		var keys []string
+		for _, group := range groups {
+			keys = append(keys, group.Key)
+		}
+		return keys
+	}
+	assert.Equal(t, []string{"DiskFull", "HighCPU"}, keys(report.ByName))
+	assert.Equal(t, []string{"infra", "payments"}, keys(report.ByTeam))
+	assert.Equal(t, []string{"critical", "warning"}, keys(report.BySeverity))
+
+	highCPU := report.ByName[1]
+	assert.Equal(t, 2, highCPU.Fired)
+	assert.Equal(t, 15*time.Minute, highCPU.MTTA)
+	assert.Equal(t, 2*time.Hour, highCPU.MTTR)
+
+	infra := report.ByTeam[0]
+	assert.Equal(t, 1, infra.Fired)
+	assert.Equal(t, 10*time.Minute, infra.MTTA)
+	assert.Equal(t, 2, infra.Resolved)
+
+	warning := report.BySeverity[1]
+	assert.Equal(t, 0, warning.Acked)
+	assert.Equal(t, time.Duration(0), warning.MTTA)
+	assert.Equal(t, 9*24*time.Hour, warning.MTTR)
+
+	_, err = uc.Build(ctx, 0)
+	assert.ErrorIs(t, err, ErrInvalidPeriod)
+
+	// The digest covers the configured period
+	sender := &fakeResponseTimesSender{}
+	uc.SetSender(sender)
+	require.NoError(t, uc.SendDigest(ctx, "C1", 12*time.Hour))
+	assert.Equal(t, "C1", sender.channelID)
+	assert.Equal(t, 1, sender.report.Overall.Fired)
+	assert.Equal(t, 0, sender.report.Overall.Resolved)
+}
@@ -0,0 +1,140 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+)
+
+// Publisher delivers a rendered report to one destination (email, Slack channel).
+type Publisher interface {
+	PublishReport(ctx context.Context, report *entity.AlertReport) error
+	Name() string
+}
+
+// SendReportInput represents one scheduled report run.
+type SendReportInput struct {
+	// Name identifies the report in logs and message headers.
+	Name string
+
+	// ViewName is the shared saved view whose criteria select the alerts.
+	ViewName string
+
+	// Period is how far back the report looks.
+	Period time.Duration
+
+	// Publishers receive the rendered report.
+	Publishers []Publisher
+}
+
+// SendReportOutput represents the result of a report run.
+type SendReportOutput struct {
+	Report *entity.AlertReport
+}
+
+// SendReportUseCase renders a saved view as a period summary and delivers it.
+type SendReportUseCase struct {
+	alertRepo repository.AlertRepository
+	viewRepo  repository.SavedViewRepository
+	now       func() time.Time
+}
+
+// NewSendReportUseCase creates a new SendReportUseCase.
+func NewSendReportUseCase(alertRepo repository.AlertRepository, viewRepo repository.SavedViewRepository) *SendReportUseCase {
+	return &SendReportUseCase{
+		alertRepo: alertRepo,
+		viewRepo:  viewRepo,
+		now:       time.Now,
+	}
+}
+
+// Execute builds the report and publishes it to every destination.
+// A failing publisher does not stop the others; their errors are joined.
+func (uc *SendReportUseCase) Execute(ctx context.Context, input SendReportInput) (*SendReportOutput, error) {
+	report, err := uc.Build(ctx, input.Name, input.ViewName, input.Period)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []error
+	for _, p := range input.Publishers {
+		if err := p.PublishReport(ctx, report); err != nil {
+			errs = append(errs, fmt.Errorf("publishing report to %s: %w", p.Name(), err))
+		}
+	}
+
+	return &SendReportOutput{Report: report}, errors.Join(errs...)
+}
+
+// Build computes the report for the shared view named viewName over the
+// period ending now.
+// Returns entity.ErrSavedViewNotFound if no such view exists.
+func (uc *SendReportUseCase) Build(ctx context.Context, name, viewName string, period time.Duration) (*entity.AlertReport, error) {
+	// 1. Resolve the shared view
+	view, err := uc.viewRepo.FindByName(ctx, "", viewName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find view: %w", err)
+	}
+	if view == nil {
+		return nil, entity.ErrSavedViewNotFound
+	}
+
+	end := uc.now()
+	start := end.Add(-period)
+
+	// 2. Load alerts that were open or changed during the window
+	alerts, err := uc.alertRepo.FindChangedSince(ctx, start)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get alerts: %w", err)
+	}
+
+	// 3. A view's state criterion describes a point in time, so it only
+	// narrows the open summary; period counts use severity and labels.
+	periodView := view.Copy()
+	periodView.State = ""
+
+	report := entity.NewAlertReport(name, view, start, end)
+	times := entity.NewResponseTimeReport(start, end)
+	for _, alert := range alerts {
+		if !periodView.Matches(alert) {
+			continue
+		}
+
+		if alert.IsFiring() && view.Matches(alert) {
+			summarizeOpen(report.Open, alert)
+		}
+		times.Add(alert, "")
+	}
+
+	// 4. Period counts and means
+	times.Finish()
+	report.NewCount = times.Overall.Fired
+	report.AckedCount = times.Overall.Acked
+	report.ResolvedCount = times.Overall.Resolved
+	report.MTTA = times.Overall.MTTA
+	report.MTTR = times.Overall.MTTR
+	for _, group := range times.BySeverity {
+		if group.Fired > 0 {
+			report.NewBySeverity[entity.AlertSeverity(group.Key)] = group.Fired
+		}
+	}
+
+	return report, nil
+}
+
+// summarizeOpen adds a still-firing alert to the open summary.
+func summarizeOpen(summary *entity.AlertSummary, alert *entity.Alert) {
+	summary.TotalAlerts++
+	summary.AlertsBySeverity[alert.Severity]++
+	summary.AlertsByState[alert.State]++
+	if alert.Instance != "" {
+		summary.AlertsByInstance[alert.Instance]++
+	}
+	for _, tag := range alert.Tags {
+		summary.AlertsByTag[tag]++
+	}
+}
@@ -3,6 +3,8 @@ package slack
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -14,6 +16,7 @@ import (
 	slackInfra "github.com/altuslabsxyz/alert-bridge/internal/infrastructure/slack"
 	"github.com/altuslabsxyz/alert-bridge/internal/usecase/ack"
 	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/api"
 )
 
 // HandleInteractionUseCase processes Slack button clicks and other interactions.
@@ -22,13 +25,22 @@ type HandleInteractionUseCase struct {
 	silenceRepo repository.SilenceRepository
 	syncAckUC   *ack.SyncAckUseCase
 	slackClient SlackClient
+	tagAlertUC  *alert.TagAlertUseCase
+	assignUC    *alert.AssignAlertUseCase
+	resolveUC   *api.ManageAlertsUseCase
+	timeline    *alert.Timeline
+	silenceSync *alert.SilenceSync
+	actions     *slackInfra.CustomActions
 	logger      alert.Logger
 }
 
 // SlackClient defines the required Slack client operations.
 type SlackClient interface {
 	GetUserEmail(ctx context.Context, userID string) (string, error)
+	GetUserInfo(ctx context.Context, userID string) (*slackLib.User, error)
 	UpdateMessage(ctx context.Context, messageID string, alert *entity.Alert) error
+	OpenModal(ctx context.Context, triggerID string, view slackLib.ModalViewRequest) error
+	PostEphemeral(ctx context.Context, channelID, userID, text string) error
 	PostThreadReply(ctx context.Context, messageID, text string) error
 }
 
@@ -49,10 +61,51 @@ func NewHandleInteractionUseCase(
 	}
 }
 
+// SetTagAlertUseCase enables the Tag button and tag modal.
+func (uc *HandleInteractionUseCase) SetTagAlertUseCase(tagAlertUC *alert.TagAlertUseCase) {
+	uc.tagAlertUC = tagAlertUC
+}
+
+// SetAssignAlertUseCase enables the Assign to me button and the assignee
+// select.
+func (uc *HandleInteractionUseCase) SetAssignAlertUseCase(assignUC *alert.AssignAlertUseCase) {
+	uc.assignUC = assignUC
+}
+
+// SetResolveUseCase enables the Resolve button and resolve modal. It shares
+// the API's resolution, which updates every notification of the alert.
+func (uc *HandleInteractionUseCase) SetResolveUseCase(resolveUC *api.ManageAlertsUseCase) {
+	uc.resolveUC = resolveUC
+}
+
+// SetTimeline records silences in the alert timeline and enables the
+// View history button.
+func (uc *HandleInteractionUseCase) SetTimeline(timeline *alert.Timeline) {
+	uc.timeline = timeline
+}
+
+// SetSilenceSync mirrors the silences made and extended from Slack in
+// Alertmanager.
+func (uc *HandleInteractionUseCase) SetSilenceSync(silenceSync *alert.SilenceSync) {
+	uc.silenceSync = silenceSync
+}
+
+// SetCustomActions enables the custom action buttons configured for
+// alerts.
+func (uc *HandleInteractionUseCase) SetCustomActions(actions *slackInfra.CustomActions) {
+	uc.actions = actions
+}
+
 // Execute processes a Slack interaction.
 func (uc *HandleInteractionUseCase) Execute(ctx context.Context, input dto.SlackInteractionInput) (*dto.SlackInteractionOutput, error) {
-	// Parse action type from action ID
+	// Parse action type from action ID. Alert messages name their alert in
+	// their metadata, which takes precedence; the action ID covers messages
+	// posted before. Custom action IDs also carry the action name, which
+	// handleCustomAction splits off.
 	actionType, alertID := parseActionID(input.ActionID)
+	if input.MessageAlertID != "" && actionType != slackInfra.CustomActionType {
+		alertID = input.MessageAlertID
+	}
 
 	// Get user email
 	userEmail := input.UserEmail
@@ -73,6 +126,20 @@ func (uc *HandleInteractionUseCase) Execute(ctx context.Context, input dto.Slack
 		return uc.handleAck(ctx, alertID, input, userEmail)
 	case "silence":
 		return uc.handleSilence(ctx, alertID, input, userEmail)
+	case "tag":
+		return uc.handleTag(ctx, alertID, input)
+	case "resolve":
+		return uc.handleResolve(ctx, alertID, input)
+	case "assign":
+		return uc.handleAssign(ctx, alertID, input, input.UserID)
+	case "assignuser":
+		return uc.handleAssign(ctx, alertID, input, input.Value)
+	case "history":
+		return uc.handleHistory(ctx, alertID, input)
+	case slackInfra.SilenceExtendAction:
+		return uc.handleExtendSilence(ctx, alertID, input)
+	case slackInfra.CustomActionType:
+		return uc.handleCustomAction(ctx, alertID, input, userEmail)
 	default:
 		return nil, fmt.Errorf("unknown action type: %s", actionType)
 	}
@@ -140,6 +207,10 @@ func (uc *HandleInteractionUseCase) handleSilence(ctx context.Context, alertID s
 	if err := uc.silenceRepo.Save(ctx, silence); err != nil {
 		return nil, fmt.Errorf("saving silence: %w", err)
 	}
+	uc.silenceSync.Created(ctx, silence)
+	// The timeline posts the silence in the alert's Slack thread, if enabled
+	uc.timeline.Record(ctx, alertID, entity.AlertEventSilenced, input.UserName,
+		fmt.Sprintf("for %s (until %s)", formatDuration(duration), silence.EndAt.Format("Jan 2, 15:04 MST")))
 
 	// Also acknowledge the alert
 	syncInput := ack.SyncAckInput{
@@ -170,22 +241,254 @@ func (uc *HandleInteractionUseCase) handleSilence(ctx context.Context, alertID s
 		}
 	}
 
-	// Post thread reply about silence
-	silenceMsg := fmt.Sprintf("🔕 Silenced for %s by %s (until %s)",
-		formatDuration(duration),
-		input.UserName,
-		silence.EndAt.Format("Jan 2, 15:04 MST"),
-	)
-	if err := uc.slackClient.PostThreadReply(ctx, messageID, silenceMsg); err != nil {
-		uc.logger.Error("failed to post silence notification",
+	return &dto.SlackInteractionOutput{
+		Success:      true,
+		Message:      fmt.Sprintf("Silenced for %s", formatDuration(duration)),
+		SilenceID:    silence.ID,
+		SilenceEndAt: &silence.EndAt,
+	}, nil
+}
+
+// handleTag opens the tag modal for the alert.
+func (uc *HandleInteractionUseCase) handleTag(ctx context.Context, alertID string, input dto.SlackInteractionInput) (*dto.SlackInteractionOutput, error) {
+	if uc.tagAlertUC == nil {
+		return nil, fmt.Errorf("tagging is not enabled")
+	}
+
+	alertEntity, err := uc.alertRepo.FindByID(ctx, alertID)
+	if err != nil {
+		return nil, fmt.Errorf("finding alert: %w", err)
+	}
+	if alertEntity == nil {
+		return nil, entity.ErrAlertNotFound
+	}
+
+	messageID := fmt.Sprintf("%s:%s", input.ChannelID, input.MessageTS)
+	modal := slackInfra.BuildTagModal(alertID, messageID, alertEntity.Tags)
+	if err := uc.slackClient.OpenModal(ctx, input.TriggerID, modal); err != nil {
+		return nil, fmt.Errorf("opening tag modal: %w", err)
+	}
+
+	return &dto.SlackInteractionOutput{
+		Success: true,
+		Message: "Opened tag modal",
+	}, nil
+}
+
+// handleAssign assigns the alert to a Slack user, the clicker for Assign to
+// me, refreshes the message and mentions the assignee in its thread.
+func (uc *HandleInteractionUseCase) handleAssign(ctx context.Context, alertID string, input dto.SlackInteractionInput, assigneeID string) (*dto.SlackInteractionOutput, error) {
+	if uc.assignUC == nil {
+		return nil, fmt.Errorf("assignment is not enabled")
+	}
+	if assigneeID == "" {
+		return nil, fmt.Errorf("no assignee selected")
+	}
+
+	assignee := input.UserName
+	if assigneeID != input.UserID {
+		assignee = assigneeID
+		if user, err := uc.slackClient.GetUserInfo(ctx, assigneeID); err != nil {
+			uc.logger.Warn("failed to get assignee info",
+				"userID", assigneeID,
+				"error", err,
+			)
+		} else if user.Name != "" {
+			assignee = user.Name
+		}
+	}
+
+	output, err := uc.assignUC.Execute(ctx, alert.AssignAlertInput{
+		AlertID:         alertID,
+		Assignee:        assignee,
+		AssigneeSlackID: assigneeID,
+		By:              input.UserName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	messageID := fmt.Sprintf("%s:%s", input.ChannelID, input.MessageTS)
+	if err := uc.slackClient.UpdateMessage(ctx, messageID, output.Alert); err != nil {
+		uc.logger.Error("failed to update Slack message",
 			"messageID", messageID,
 			"error", err,
 		)
 	}
 
+	// Edits notify nobody, so the assignee is mentioned in a reply
+	text := fmt.Sprintf(":bust_in_silhouette: <@%s> took ownership of this alert", assigneeID)
+	if assigneeID != input.UserID {
+		text = fmt.Sprintf(":bust_in_silhouette: <@%s> was assigned this alert by <@%s>", assigneeID, input.UserID)
+	}
+	if err := uc.slackClient.PostThreadReply(ctx, messageID, text); err != nil {
+		uc.logger.Warn("failed to mention assignee",
+			"alertID", alertID,
+			"error", err,
+		)
+	}
+
+	return &dto.SlackInteractionOutput{
+		Success: true,
+		Message: fmt.Sprintf("Assigned to %s", assignee),
+	}, nil
+}
+
+// handleResolve opens the resolve modal for the alert.
+func (uc *HandleInteractionUseCase) handleResolve(ctx context.Context, alertID string, input dto.SlackInteractionInput) (*dto.SlackInteractionOutput, error) {
+	if uc.resolveUC == nil {
+		return nil, fmt.Errorf("resolving is not enabled")
+	}
+
+	alertEntity, err := uc.alertRepo.FindByID(ctx, alertID)
+	if err != nil {
+		return nil, fmt.Errorf("finding alert: %w", err)
+	}
+	if alertEntity == nil {
+		return nil, entity.ErrAlertNotFound
+	}
+
+	modal := slackInfra.BuildResolveModal(alertID, alertEntity.Name)
+	if err := uc.slackClient.OpenModal(ctx, input.TriggerID, modal); err != nil {
+		return nil, fmt.Errorf("opening resolve modal: %w", err)
+	}
+
+	return &dto.SlackInteractionOutput{
+		Success: true,
+		Message: "Opened resolve modal",
+	}, nil
+}
+
+// handleHistory opens a modal listing the alert's timeline.
+func (uc *HandleInteractionUseCase) handleHistory(ctx context.Context, alertID string, input dto.SlackInteractionInput) (*dto.SlackInteractionOutput, error) {
+	if uc.timeline == nil {
+		return nil, fmt.Errorf("alert history is not enabled")
+	}
+
+	alertEntity, err := uc.alertRepo.FindByID(ctx, alertID)
+	if err != nil {
+		return nil, fmt.Errorf("finding alert: %w", err)
+	}
+	if alertEntity == nil {
+		return nil, entity.ErrAlertNotFound
+	}
+
+	events, err := uc.timeline.Events(ctx, alertID)
+	if err != nil {
+		return nil, err
+	}
+
+	modal := slackInfra.BuildHistoryModal(alertEntity, events)
+	if err := uc.slackClient.OpenModal(ctx, input.TriggerID, modal); err != nil {
+		return nil, fmt.Errorf("opening history modal: %w", err)
+	}
+
+	return &dto.SlackInteractionOutput{
+		Success: true,
+		Message: "Opened history modal",
+	}, nil
+}
+
+// handleCustomAction runs a custom action button. The clicker is told the
+// outcome in a message only they see.
+func (uc *HandleInteractionUseCase) handleCustomAction(ctx context.Context, rest string, input dto.SlackInteractionInput, userEmail string) (*dto.SlackInteractionOutput, error) {
+	if uc.actions == nil {
+		return nil, fmt.Errorf("custom actions are not enabled")
+	}
+	alertID, name := slackInfra.ParseCustomActionID(rest)
+	if input.MessageAlertID != "" {
+		alertID = input.MessageAlertID
+	}
+
+	alertEntity, err := uc.alertRepo.FindByID(ctx, alertID)
+	if err != nil {
+		return nil, fmt.Errorf("finding alert: %w", err)
+	}
+	if alertEntity == nil {
+		return nil, entity.ErrAlertNotFound
+	}
+
+	msg, err := uc.actions.Run(ctx, name, alertEntity, input.UserID, input.UserName, userEmail)
+	if err != nil {
+		uc.logger.Error("custom action failed",
+			"action", name,
+			"alertID", alertID,
+			"userID", input.UserID,
+			"error", err,
+		)
+		msg = fmt.Sprintf("Action failed: %v", err)
+	} else if msg != "" {
+		uc.logger.Info("custom action run",
+			"action", name,
+			"alertID", alertID,
+			"by", input.UserName,
+		)
+	}
+
+	if msg != "" {
+		if postErr := uc.slackClient.PostEphemeral(ctx, input.ChannelID, input.UserID, msg); postErr != nil {
+			uc.logger.Warn("failed to report custom action outcome",
+				"action", name,
+				"error", postErr,
+			)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.SlackInteractionOutput{
+		Success: true,
+		Message: msg,
+	}, nil
+}
+
+// handleExtendSilence extends a silence from its expiry reminder, where
+// the action carries the silence ID rather than an alert ID.
+func (uc *HandleInteractionUseCase) handleExtendSilence(ctx context.Context, silenceID string, input dto.SlackInteractionInput) (*dto.SlackInteractionOutput, error) {
+	duration, err := time.ParseDuration(input.Value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid silence duration: %w", err)
+	}
+
+	silence, err := uc.silenceRepo.FindByID(ctx, silenceID)
+	if err != nil {
+		return nil, fmt.Errorf("finding silence: %w", err)
+	}
+	if silence == nil {
+		return nil, entity.ErrSilenceNotFound
+	}
+
+	msg := fmt.Sprintf("Silence `%s` already expired. Create a new one with `/silence create`.", silence.ID)
+	if !silence.IsExpired() {
+		if err := silence.Extend(duration); err != nil {
+			return nil, err
+		}
+		if err := uc.silenceRepo.Update(ctx, silence); err != nil {
+			return nil, fmt.Errorf("updating silence: %w", err)
+		}
+		uc.silenceSync.Extended(ctx, silence)
+		msg = fmt.Sprintf("Extended silence `%s` by %s, until %s.",
+			silence.ID, formatDuration(duration), silence.EndAt.Format("Jan 2, 15:04 MST"))
+
+		uc.logger.Info("silence extended",
+			"silenceID", silence.ID,
+			"duration", duration.String(),
+			"endAt", silence.EndAt,
+			"extendedBy", input.UserName,
+		)
+	}
+
+	if err := uc.slackClient.PostEphemeral(ctx, input.ChannelID, input.UserID, msg); err != nil {
+		uc.logger.Warn("failed to confirm silence extension",
+			"silenceID", silence.ID,
+			"error", err,
+		)
+	}
+
 	return &dto.SlackInteractionOutput{
 		Success:      true,
-		Message:      fmt.Sprintf("Silenced for %s", formatDuration(duration)),
+		Message:      msg,
 		SilenceID:    silence.ID,
 		SilenceEndAt: &silence.EndAt,
 	}, nil
@@ -223,9 +526,18 @@ func formatDuration(d time.Duration) string {
 func (uc *HandleInteractionUseCase) HandleModalSubmission(ctx context.Context, payload *slackLib.InteractionCallback) (*dto.SlackInteractionOutput, error) {
 	callbackID := payload.View.CallbackID
 
+	// Every modal has inputs; a submission without state is malformed
+	if payload.View.State == nil {
+		return nil, fmt.Errorf("missing state in %s modal submission", callbackID)
+	}
+
 	switch callbackID {
 	case slackInfra.SilenceModalCallbackID:
 		return uc.handleSilenceModalSubmission(ctx, payload)
+	case slackInfra.TagModalCallbackID:
+		return uc.handleTagModalSubmission(ctx, payload)
+	case slackInfra.ResolveModalCallbackID:
+		return uc.handleResolveModalSubmission(ctx, payload)
 	default:
 		return nil, fmt.Errorf("unknown modal callback: %s", callbackID)
 	}
@@ -250,29 +562,25 @@ func (uc *HandleInteractionUseCase) handleSilenceModalSubmission(ctx context.Con
 		}
 	}
 
-	// Parse matchers from multi-select blocks
-	matchers := make(map[string]string)
-	for blockID, blockValues := range values {
-		if strings.HasPrefix(blockID, slackInfra.SilenceBlockMatchers+"_") {
-			labelKey := strings.TrimPrefix(blockID, slackInfra.SilenceBlockMatchers+"_")
-			actionID := slackInfra.SilenceActionMatchers + "_" + labelKey
-			if action, ok := blockValues[actionID]; ok {
-				for _, opt := range action.SelectedOptions {
-					// Value format is "key=value"
-					parts := strings.SplitN(opt.Value, "=", 2)
-					if len(parts) == 2 {
-						matchers[parts[0]] = parts[1]
-					}
-				}
-			}
-		}
+	matchers, err := parseSilenceModalMatchers(values)
+	if err != nil {
+		return nil, err
+	}
+
+	// The email lets the creator be reminded before the silence expires
+	email, err := uc.slackClient.GetUserEmail(ctx, payload.User.ID)
+	if err != nil {
+		uc.logger.Warn("failed to get user email",
+			"userID", payload.User.ID,
+			"error", err,
+		)
 	}
 
 	// Create silence
 	silence, err := entity.NewSilenceMark(
 		duration,
 		payload.User.Name,
-		"", // email not available from modal
+		email,
 		entity.AckSourceSlack,
 	)
 	if err != nil {
@@ -283,14 +591,13 @@ func (uc *HandleInteractionUseCase) handleSilenceModalSubmission(ctx context.Con
 		silence.WithReason(reason)
 	}
 
-	if len(matchers) > 0 {
-		silence.WithMatchers(matchers)
-	}
+	silence.WithLabelMatchers(matchers)
 
 	// Save silence
 	if err := uc.silenceRepo.Save(ctx, silence); err != nil {
 		return nil, fmt.Errorf("failed to save silence: %w", err)
 	}
+	uc.silenceSync.Created(ctx, silence)
 
 	msg := fmt.Sprintf("Created silence for %s", formatDuration(duration))
 	if len(matchers) > 0 {
@@ -304,6 +611,18 @@ func (uc *HandleInteractionUseCase) handleSilenceModalSubmission(ctx context.Con
 		"createdBy", payload.User.Name,
 	)
 
+	// Confirm in the channel /silence was run from; the modal just closes
+	if channelID := payload.View.PrivateMetadata; channelID != "" {
+		confirmation := fmt.Sprintf("%s (ID `%s`). Remove it with `/silence delete %s`.", msg, silence.ID, silence.ID)
+		if err := uc.slackClient.PostEphemeral(ctx, channelID, payload.User.ID, confirmation); err != nil {
+			uc.logger.Warn("failed to confirm silence",
+				"silenceID", silence.ID,
+				"channelID", channelID,
+				"error", err,
+			)
+		}
+	}
+
 	return &dto.SlackInteractionOutput{
 		Success:      true,
 		Message:      msg,
@@ -311,3 +630,139 @@ func (uc *HandleInteractionUseCase) handleSilenceModalSubmission(ctx context.Con
 		SilenceEndAt: &silence.EndAt,
 	}, nil
 }
+
+// parseSilenceModalMatchers reads the label matchers of the silence modal:
+// the values selected per label, matched as alternatives, and the advanced
+// matchers, one per line.
+func parseSilenceModalMatchers(values map[string]map[string]slackLib.BlockAction) ([]entity.LabelMatcher, error) {
+	selected := make(map[string][]string)
+	for blockID, blockValues := range values {
+		if strings.HasPrefix(blockID, slackInfra.SilenceBlockMatchers+"_") {
+			labelKey := strings.TrimPrefix(blockID, slackInfra.SilenceBlockMatchers+"_")
+			actionID := slackInfra.SilenceActionMatchers + "_" + labelKey
+			if action, ok := blockValues[actionID]; ok {
+				for _, opt := range action.SelectedOptions {
+					// Value format is "key=value"
+					parts := strings.SplitN(opt.Value, "=", 2)
+					if len(parts) == 2 {
+						selected[parts[0]] = append(selected[parts[0]], parts[1])
+					}
+				}
+			}
+		}
+	}
+
+	var matchers []entity.LabelMatcher
+	for key, labelValues := range selected {
+		if len(labelValues) == 1 {
+			matchers = append(matchers, entity.LabelMatcher{Name: key, Type: entity.MatchEqual, Value: labelValues[0]})
+			continue
+		}
+		quoted := make([]string, len(labelValues))
+		for i, v := range labelValues {
+			quoted[i] = regexp.QuoteMeta(v)
+		}
+		sort.Strings(quoted)
+		matcher, err := entity.NewLabelMatcher(key, entity.MatchRegexp, strings.Join(quoted, "|"))
+		if err != nil {
+			return nil, err
+		}
+		matchers = append(matchers, matcher)
+	}
+
+	expressions := values[slackInfra.SilenceBlockExpressions][slackInfra.SilenceActionExpressions].Value
+	for _, line := range strings.Split(expressions, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		matcher, err := entity.ParseLabelMatcher(line)
+		if err != nil {
+			return nil, err
+		}
+		matchers = append(matchers, matcher)
+	}
+
+	if len(matchers) == 0 {
+		return nil, nil
+	}
+	for _, m := range matchers {
+		if !m.MatchesEmpty() {
+			return matchers, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: at least one matcher must not match an empty label value", entity.ErrInvalidLabelMatcher)
+}
+
+// handleTagModalSubmission applies the tags entered in the tag modal.
+func (uc *HandleInteractionUseCase) handleTagModalSubmission(ctx context.Context, payload *slackLib.InteractionCallback) (*dto.SlackInteractionOutput, error) {
+	if uc.tagAlertUC == nil {
+		return nil, fmt.Errorf("tagging is not enabled")
+	}
+
+	alertID, messageID := slackInfra.ParseTagModalMetadata(payload.View.PrivateMetadata)
+	if alertID == "" {
+		return nil, fmt.Errorf("missing alert ID in modal metadata")
+	}
+
+	tagsValue := payload.View.State.Values[slackInfra.TagBlockTags][slackInfra.TagActionTags].Value
+
+	output, err := uc.tagAlertUC.Execute(ctx, alert.TagAlertInput{
+		AlertID: alertID,
+		Tags:    slackInfra.ParseTagInput(tagsValue),
+		By:      payload.User.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Refresh the alert message so the tags show up
+	if messageID != "" {
+		if err := uc.slackClient.UpdateMessage(ctx, messageID, output.Alert); err != nil {
+			uc.logger.Error("failed to update Slack message",
+				"messageID", messageID,
+				"error", err,
+			)
+		}
+	}
+
+	return &dto.SlackInteractionOutput{
+		Success: true,
+		Message: fmt.Sprintf("Tagged alert with %d tag(s)", len(output.Alert.Tags)),
+	}, nil
+}
+
+// handleResolveModalSubmission resolves the alert with the root cause and
+// note entered in the resolve modal.
+func (uc *HandleInteractionUseCase) handleResolveModalSubmission(ctx context.Context, payload *slackLib.InteractionCallback) (*dto.SlackInteractionOutput, error) {
+	if uc.resolveUC == nil {
+		return nil, fmt.Errorf("resolving is not enabled")
+	}
+
+	alertID := payload.View.PrivateMetadata
+	if alertID == "" {
+		return nil, fmt.Errorf("missing alert ID in modal metadata")
+	}
+
+	values := payload.View.State.Values
+	rootCause := values[slackInfra.ResolveBlockRootCause][slackInfra.ResolveActionRootCause].SelectedOption.Value
+	if rootCause == "" {
+		return nil, fmt.Errorf("select a root cause")
+	}
+	note := strings.TrimSpace(values[slackInfra.ResolveBlockNote][slackInfra.ResolveActionNote].Value)
+
+	resolved, err := uc.resolveUC.Resolve(ctx, api.ResolveAlertInput{
+		AlertID:   alertID,
+		By:        payload.User.Name,
+		Via:       "Slack",
+		RootCause: rootCause,
+		Note:      note,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.SlackInteractionOutput{
+		Success: true,
+		Message: fmt.Sprintf("Resolved %s (root cause: %s)", resolved.Name, rootCause),
+	}, nil
+}
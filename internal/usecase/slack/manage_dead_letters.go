@@ -0,0 +1,104 @@
+package slack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/adapter/dto"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/api"
+)
+
+// DeadLetterResult represents the result of a dead letter operation.
+type DeadLetterResult struct {
+	Action      dto.DeadLetterAction
+	DeadLetters []*entity.NotificationRetry
+	Message     string
+}
+
+// ManageDeadLettersUseCase handles dead-lettered notifications via slash
+// commands. It shares the API's replay logic.
+type ManageDeadLettersUseCase struct {
+	deadLetters *api.ManageDeadLettersUseCase
+}
+
+// NewManageDeadLettersUseCase creates a new manage dead letters use case.
+func NewManageDeadLettersUseCase(deadLetters *api.ManageDeadLettersUseCase) *ManageDeadLettersUseCase {
+	return &ManageDeadLettersUseCase{
+		deadLetters: deadLetters,
+	}
+}
+
+// Execute performs the requested dead letter action.
+func (uc *ManageDeadLettersUseCase) Execute(ctx context.Context, req *dto.DeadLetterRequest) (*DeadLetterResult, error) {
+	switch req.Action {
+	case dto.DeadLetterActionList:
+		return uc.list(ctx)
+	case dto.DeadLetterActionReplay:
+		return uc.replay(ctx, req)
+	case dto.DeadLetterActionDiscard:
+		return uc.discard(ctx, req)
+	default:
+		return nil, fmt.Errorf("unknown action: %s", req.Action)
+	}
+}
+
+func (uc *ManageDeadLettersUseCase) list(ctx context.Context) (*DeadLetterResult, error) {
+	deadLetters, err := uc.deadLetters.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list dead letters: %w", err)
+	}
+
+	return &DeadLetterResult{
+		Action:      dto.DeadLetterActionList,
+		DeadLetters: deadLetters,
+		Message:     fmt.Sprintf("%d dead-lettered notification(s)", len(deadLetters)),
+	}, nil
+}
+
+func (uc *ManageDeadLettersUseCase) replay(ctx context.Context, req *dto.DeadLetterRequest) (*DeadLetterResult, error) {
+	if req.All {
+		n, err := uc.deadLetters.ReplayAll(ctx, req.UserName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to replay dead letters: %w", err)
+		}
+		return &DeadLetterResult{
+			Action:  dto.DeadLetterActionReplay,
+			Message: fmt.Sprintf("Requeued %d notification(s) for delivery", n),
+		}, nil
+	}
+
+	if req.ID == "" {
+		return nil, fmt.Errorf("dead letter ID or `all` is required")
+	}
+	retry, err := uc.deadLetters.Replay(ctx, req.ID, req.UserName)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotificationRetryNotFound) {
+			return nil, fmt.Errorf("dead letter %q not found", req.ID)
+		}
+		return nil, fmt.Errorf("failed to replay dead letter: %w", err)
+	}
+
+	return &DeadLetterResult{
+		Action:  dto.DeadLetterActionReplay,
+		Message: fmt.Sprintf("Requeued %s notification for alert `%s`", retry.Notifier, retry.AlertID),
+	}, nil
+}
+
+func (uc *ManageDeadLettersUseCase) discard(ctx context.Context, req *dto.DeadLetterRequest) (*DeadLetterResult, error) {
+	if req.ID == "" {
+		return nil, fmt.Errorf("dead letter ID is required")
+	}
+	if err := uc.deadLetters.Discard(ctx, req.ID, req.UserName); err != nil {
+		if errors.Is(err, entity.ErrNotificationRetryNotFound) {
+			return nil, fmt.Errorf("dead letter %q not found", req.ID)
+		}
+		return nil, fmt.Errorf("failed to discard dead letter: %w", err)
+	}
+
+	return &DeadLetterResult{
+		Action:  dto.DeadLetterActionDiscard,
+		Message: fmt.Sprintf("Discarded dead letter `%s`", req.ID),
+	}, nil
+}
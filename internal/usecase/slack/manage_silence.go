@@ -11,6 +11,7 @@ import (
 	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
 	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
 	slackInfra "github.com/altuslabsxyz/alert-bridge/internal/infrastructure/slack"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
 )
 
 // SilenceResult represents the result of a silence operation.
@@ -26,6 +27,7 @@ type SilenceResult struct {
 // SilenceModalClient defines the Slack client operations needed for modal handling.
 type SilenceModalClient interface {
 	OpenModal(ctx context.Context, triggerID string, view slackLib.ModalViewRequest) error
+	GetUserEmail(ctx context.Context, userID string) (string, error)
 	GetActiveAlertLabels(ctx context.Context, alertRepo interface {
 		GetActiveAlerts(ctx context.Context, severity string) ([]*entity.Alert, error)
 	}) (map[string][]string, error)
@@ -36,6 +38,7 @@ type ManageSilenceUseCase struct {
 	silenceRepo repository.SilenceRepository
 	alertRepo   repository.AlertRepository
 	slackClient SilenceModalClient
+	silenceSync *alert.SilenceSync
 }
 
 // NewManageSilenceUseCase creates a new manage silence use case.
@@ -51,6 +54,11 @@ func NewManageSilenceUseCase(
 	}
 }
 
+// SetSilenceSync mirrors the silences in Alertmanager.
+func (uc *ManageSilenceUseCase) SetSilenceSync(silenceSync *alert.SilenceSync) {
+	uc.silenceSync = silenceSync
+}
+
 // Execute performs the requested silence action.
 func (uc *ManageSilenceUseCase) Execute(ctx context.Context, req *dto.SilenceRequest) (*SilenceResult, error) {
 	switch req.Action {
@@ -85,7 +93,7 @@ func (uc *ManageSilenceUseCase) openModal(ctx context.Context, req *dto.SilenceR
 	}
 
 	// Build and open the modal
-	modal := slackInfra.BuildSilenceModal(labelOptions)
+	modal := slackInfra.BuildSilenceModal(labelOptions, req.Duration, req.ChannelID)
 	if err := uc.slackClient.OpenModal(ctx, req.TriggerID, modal); err != nil {
 		return nil, fmt.Errorf("failed to open modal: %w", err)
 	}
@@ -103,10 +111,16 @@ func (uc *ManageSilenceUseCase) createSilence(ctx context.Context, req *dto.Sile
 		req.Duration = 1 * time.Hour // Default duration
 	}
 
+	// The email lets the creator be reminded before the silence expires
+	email := ""
+	if uc.slackClient != nil && req.UserID != "" {
+		email, _ = uc.slackClient.GetUserEmail(ctx, req.UserID)
+	}
+
 	silence, err := entity.NewSilenceMark(
 		req.Duration,
 		req.UserName,
-		"", // email not available from Slack user ID
+		email,
 		entity.AckSourceSlack,
 	)
 	if err != nil {
@@ -125,6 +139,7 @@ func (uc *ManageSilenceUseCase) createSilence(ctx context.Context, req *dto.Sile
 	if err := uc.silenceRepo.Save(ctx, silence); err != nil {
 		return nil, fmt.Errorf("failed to save silence: %w", err)
 	}
+	uc.silenceSync.Created(ctx, silence)
 
 	// Build message with matcher info
 	msg := fmt.Sprintf("Created silence for %s", formatDuration(req.Duration))
@@ -171,6 +186,7 @@ func (uc *ManageSilenceUseCase) deleteSilence(ctx context.Context, req *dto.Sile
 	if err := uc.silenceRepo.Delete(ctx, req.SilenceID); err != nil {
 		return nil, fmt.Errorf("failed to delete silence: %w", err)
 	}
+	uc.silenceSync.Deleted(ctx, silence)
 
 	return &SilenceResult{
 		Action:  dto.SilenceActionDelete,
@@ -0,0 +1,118 @@
+package slack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/adapter/dto"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+)
+
+// ViewResult represents the result of a saved view operation.
+type ViewResult struct {
+	Action  dto.ViewAction
+	Views   []*entity.SavedView
+	Saved   *entity.SavedView
+	Message string
+}
+
+// ManageViewsUseCase handles saved view management via slash commands.
+type ManageViewsUseCase struct {
+	viewRepo repository.SavedViewRepository
+}
+
+// NewManageViewsUseCase creates a new manage views use case.
+func NewManageViewsUseCase(viewRepo repository.SavedViewRepository) *ManageViewsUseCase {
+	return &ManageViewsUseCase{
+		viewRepo: viewRepo,
+	}
+}
+
+// Execute performs the requested view action.
+func (uc *ManageViewsUseCase) Execute(ctx context.Context, req *dto.ViewRequest) (*ViewResult, error) {
+	switch req.Action {
+	case dto.ViewActionList:
+		return uc.listViews(ctx, req)
+	case dto.ViewActionSave:
+		return uc.saveView(ctx, req)
+	case dto.ViewActionDelete:
+		return uc.deleteView(ctx, req)
+	default:
+		return nil, fmt.Errorf("unknown action: %s", req.Action)
+	}
+}
+
+// listViews lists the user's views and the shared views.
+func (uc *ManageViewsUseCase) listViews(ctx context.Context, req *dto.ViewRequest) (*ViewResult, error) {
+	views, err := uc.viewRepo.FindVisible(ctx, req.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list views: %w", err)
+	}
+
+	return &ViewResult{
+		Action:  dto.ViewActionList,
+		Views:   views,
+		Message: fmt.Sprintf("%d saved view(s)", len(views)),
+	}, nil
+}
+
+// saveView creates or replaces a view.
+func (uc *ManageViewsUseCase) saveView(ctx context.Context, req *dto.ViewRequest) (*ViewResult, error) {
+	if req.Name == "" {
+		return nil, fmt.Errorf("view name is required")
+	}
+
+	view, err := entity.NewSavedView(req.Name, viewOwner(req), req.UserName)
+	if err != nil {
+		return nil, err
+	}
+	view.Severity = entity.AlertSeverity(req.Severity)
+	view.State = entity.AlertState(req.State)
+	if len(req.Labels) > 0 {
+		view.Labels = req.Labels
+	}
+
+	if err := uc.viewRepo.Save(ctx, view); err != nil {
+		return nil, fmt.Errorf("failed to save view: %w", err)
+	}
+
+	scope := "personal"
+	if view.IsShared() {
+		scope = "shared"
+	}
+
+	return &ViewResult{
+		Action:  dto.ViewActionSave,
+		Saved:   view,
+		Message: fmt.Sprintf("Saved %s view `%s`: %s", scope, view.Name, view.Describe()),
+	}, nil
+}
+
+// deleteView deletes a view.
+func (uc *ManageViewsUseCase) deleteView(ctx context.Context, req *dto.ViewRequest) (*ViewResult, error) {
+	if req.Name == "" {
+		return nil, fmt.Errorf("view name is required")
+	}
+
+	if err := uc.viewRepo.Delete(ctx, viewOwner(req), req.Name); err != nil {
+		if errors.Is(err, entity.ErrSavedViewNotFound) {
+			return nil, fmt.Errorf("view %q not found", req.Name)
+		}
+		return nil, fmt.Errorf("failed to delete view: %w", err)
+	}
+
+	return &ViewResult{
+		Action:  dto.ViewActionDelete,
+		Message: fmt.Sprintf("Deleted view `%s`", req.Name),
+	}, nil
+}
+
+// viewOwner returns the owner a request operates on: the user, or "" for shared views.
+func viewOwner(req *dto.ViewRequest) string {
+	if req.Shared {
+		return ""
+	}
+	return req.UserID
+}
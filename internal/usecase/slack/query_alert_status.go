@@ -12,6 +12,7 @@ import (
 // QueryAlertStatusUseCase handles alert status queries.
 type QueryAlertStatusUseCase struct {
 	alertRepo repository.AlertRepository
+	viewRepo  repository.SavedViewRepository
 }
 
 // NewQueryAlertStatusUseCase creates a new query alert status use case.
@@ -21,9 +22,20 @@ func NewQueryAlertStatusUseCase(alertRepo repository.AlertRepository) *QueryAler
 	}
 }
 
+// SetSavedViewRepository enables querying by saved view.
+func (uc *QueryAlertStatusUseCase) SetSavedViewRepository(viewRepo repository.SavedViewRepository) {
+	uc.viewRepo = viewRepo
+}
+
 // Execute queries active alerts optionally filtered by severity.
 // Severity parameter: "critical", "warning", "info", or "" for all severities.
 func (uc *QueryAlertStatusUseCase) Execute(ctx context.Context, severity string) ([]*entity.Alert, error) {
+	return uc.ExecuteFiltered(ctx, severity, nil)
+}
+
+// ExecuteFiltered queries active alerts filtered by severity and custom fields.
+// An alert matches if every entry in fieldFilters equals its custom field value.
+func (uc *QueryAlertStatusUseCase) ExecuteFiltered(ctx context.Context, severity string, fieldFilters map[string]string) ([]*entity.Alert, error) {
 	// Parse and validate severity
 	parsedSeverity := uc.parseSeverity(severity)
 
@@ -33,7 +45,61 @@ func (uc *QueryAlertStatusUseCase) Execute(ctx context.Context, severity string)
 		return nil, fmt.Errorf("failed to get active alerts: %w", err)
 	}
 
-	return alerts, nil
+	if len(fieldFilters) == 0 {
+		return alerts, nil
+	}
+
+	filtered := make([]*entity.Alert, 0, len(alerts))
+	for _, alert := range alerts {
+		if matchesFieldFilters(alert, fieldFilters) {
+			filtered = append(filtered, alert)
+		}
+	}
+	return filtered, nil
+}
+
+// ExecuteView queries active alerts matched by the saved view named viewName
+// (as visible to owner), further narrowed by fieldFilters.
+// Returns entity.ErrSavedViewNotFound if no such view exists.
+func (uc *QueryAlertStatusUseCase) ExecuteView(ctx context.Context, owner, viewName string, fieldFilters map[string]string) (*entity.SavedView, []*entity.Alert, error) {
+	if uc.viewRepo == nil {
+		return nil, nil, fmt.Errorf("saved views are not available")
+	}
+
+	view, err := uc.viewRepo.FindByName(ctx, owner, viewName)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find view: %w", err)
+	}
+	if view == nil {
+		return nil, nil, entity.ErrSavedViewNotFound
+	}
+
+	alerts, err := uc.ExecuteFiltered(ctx, string(view.Severity), fieldFilters)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return view, view.Filter(alerts), nil
+}
+
+// tagFilterKey filters on responder tags rather than a custom field.
+const tagFilterKey = "tag"
+
+// matchesFieldFilters reports whether the alert has every filtered custom field value.
+// The "tag" key matches against the alert's tags instead.
+func matchesFieldFilters(alert *entity.Alert, fieldFilters map[string]string) bool {
+	for name, value := range fieldFilters {
+		if name == tagFilterKey {
+			if !alert.HasTag(value) {
+				return false
+			}
+			continue
+		}
+		if alert.GetCustomField(name) != value {
+			return false
+		}
+	}
+	return true
 }
 
 // parseSeverity normalizes severity input.
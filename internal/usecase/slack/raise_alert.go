@@ -0,0 +1,61 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/adapter/dto"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/api"
+)
+
+// RaiseAlertResult represents the result of an /alert-create command.
+type RaiseAlertResult struct {
+	Alert   *entity.Alert
+	Message string
+}
+
+// RaiseAlertUseCase raises alerts by hand via slash commands. It shares the
+// API's alert creation.
+type RaiseAlertUseCase struct {
+	createAlert *api.CreateAlertUseCase
+}
+
+// NewRaiseAlertUseCase creates a new raise alert use case.
+func NewRaiseAlertUseCase(createAlert *api.CreateAlertUseCase) *RaiseAlertUseCase {
+	return &RaiseAlertUseCase{
+		createAlert: createAlert,
+	}
+}
+
+// Execute raises the requested alert.
+func (uc *RaiseAlertUseCase) Execute(ctx context.Context, req *dto.ManualAlertRequest) (*RaiseAlertResult, error) {
+	out, err := uc.createAlert.Execute(ctx, api.CreateAlertInput{
+		Name:        req.Name,
+		Severity:    req.Severity,
+		Description: req.Description,
+		Labels:      req.Labels,
+		By:          req.UserName,
+		Via:         "Slack",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to raise alert: %w", err)
+	}
+
+	a := out.Alert
+	message := fmt.Sprintf("Raised %s alert *%s* (`%s`)", a.Severity, a.Name, a.ID)
+	switch {
+	case out.Silenced:
+		message += ". It matches an active silence, so no one was notified."
+	case len(out.NotificationsSent) > 0:
+		message += fmt.Sprintf(", notified via %s.", strings.Join(out.NotificationsSent, ", "))
+	default:
+		message += "."
+	}
+
+	return &RaiseAlertResult{
+		Alert:   a,
+		Message: message,
+	}, nil
+}
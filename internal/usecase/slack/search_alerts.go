@@ -0,0 +1,58 @@
+package slack
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+)
+
+// AlertSearchPageSize is the number of alerts per /alerts page.
+const AlertSearchPageSize = 10
+
+// AlertSearchResult is one page of alerts matching a search.
+type AlertSearchResult struct {
+	Alerts []*entity.Alert
+	Total  int
+	Offset int
+}
+
+// HasPrevious reports whether pages precede this one.
+func (r *AlertSearchResult) HasPrevious() bool {
+	return r.Offset > 0
+}
+
+// HasNext reports whether pages follow this one.
+func (r *AlertSearchResult) HasNext() bool {
+	return r.Offset+len(r.Alerts) < r.Total
+}
+
+// SearchAlertsUseCase handles /alerts searches.
+type SearchAlertsUseCase struct {
+	alertRepo repository.AlertRepository
+}
+
+// NewSearchAlertsUseCase creates a new search alerts use case.
+func NewSearchAlertsUseCase(alertRepo repository.AlertRepository) *SearchAlertsUseCase {
+	return &SearchAlertsUseCase{
+		alertRepo: alertRepo,
+	}
+}
+
+// Execute returns the page of alerts matching query that starts at offset.
+func (uc *SearchAlertsUseCase) Execute(ctx context.Context, query entity.AlertQuery, offset int) (*AlertSearchResult, error) {
+	query.Offset = max(offset, 0)
+	query.Limit = AlertSearchPageSize
+
+	alerts, total, err := uc.alertRepo.Search(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search alerts: %w", err)
+	}
+
+	return &AlertSearchResult{
+		Alerts: alerts,
+		Total:  total,
+		Offset: query.Offset,
+	}, nil
+}
@@ -8,23 +8,32 @@ import (
 
 	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
 	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/report"
 )
 
+// defaultResponseTimesPeriod is the period response times cover in a
+// summary of all active alerts.
+const defaultResponseTimesPeriod = 7 * 24 * time.Hour
+
 // SummarizeAlertsUseCase computes alert summary statistics.
 type SummarizeAlertsUseCase struct {
-	alertRepo repository.AlertRepository
+	alertRepo     repository.AlertRepository
+	responseTimes *report.ResponseTimesUseCase
 }
 
 // NewSummarizeAlertsUseCase creates a new summarize alerts use case.
-func NewSummarizeAlertsUseCase(alertRepo repository.AlertRepository) *SummarizeAlertsUseCase {
+// responseTimes computes the summary's MTTA and MTTR.
+func NewSummarizeAlertsUseCase(alertRepo repository.AlertRepository, responseTimes *report.ResponseTimesUseCase) *SummarizeAlertsUseCase {
 	return &SummarizeAlertsUseCase{
-		alertRepo: alertRepo,
+		alertRepo:     alertRepo,
+		responseTimes: responseTimes,
 	}
 }
 
 // Execute computes summary statistics from non-resolved alerts within the specified period.
 // If period is 0, all active alerts are included.
 // Otherwise, only alerts fired within the period are included.
+// Response times cover the period, or the last 7 days if period is 0.
 func (uc *SummarizeAlertsUseCase) Execute(ctx context.Context, period time.Duration) (*entity.AlertSummary, error) {
 	// Fetch all active (non-resolved) alerts
 	alerts, err := uc.alertRepo.GetActiveAlerts(ctx, "")
@@ -57,6 +66,18 @@ func (uc *SummarizeAlertsUseCase) Execute(ctx context.Context, period time.Durat
 			summary.AlertsByInstance[alert.Instance]++
 		}
 
+		// Count by responder tag
+		for _, tag := range alert.Tags {
+			summary.AlertsByTag[tag]++
+		}
+
+		// Count by assignee
+		if alert.IsAssigned() {
+			summary.AlertsByAssignee[alert.AssignedTo]++
+		} else {
+			summary.UnassignedAlerts++
+		}
+
 		// Count acknowledgers (only for acknowledged alerts)
 		if alert.IsAcked() && alert.AckedBy != "" {
 			acknowledgerCounts[alert.AckedBy]++
@@ -66,6 +87,17 @@ func (uc *SummarizeAlertsUseCase) Execute(ctx context.Context, period time.Durat
 	// Convert acknowledger counts to sorted list
 	summary.TopAcknowledgers = uc.buildTopAcknowledgers(acknowledgerCounts)
 
+	// Response times include resolved alerts
+	responsePeriod := period
+	if responsePeriod == 0 {
+		responsePeriod = defaultResponseTimesPeriod
+	}
+	responseTimes, err := uc.responseTimes.Build(ctx, responsePeriod)
+	if err != nil {
+		return nil, err
+	}
+	summary.ResponseTimes = responseTimes
+
 	return summary, nil
 }
 
@@ -0,0 +1,93 @@
+package teams
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/adapter/dto"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/ack"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/alert"
+)
+
+// HandleInteractionUseCase processes actions triggered from Teams alert cards.
+type HandleInteractionUseCase struct {
+	syncAckUC   *ack.SyncAckUseCase
+	teamsClient TeamsClient
+	logger      alert.Logger
+}
+
+// TeamsClient defines the required Teams client operations.
+type TeamsClient interface {
+	UpdateMessage(ctx context.Context, messageID string, alert *entity.Alert) error
+}
+
+// NewHandleInteractionUseCase creates a new HandleInteractionUseCase.
+func NewHandleInteractionUseCase(
+	syncAckUC *ack.SyncAckUseCase,
+	teamsClient TeamsClient,
+	logger alert.Logger,
+) *HandleInteractionUseCase {
+	return &HandleInteractionUseCase{
+		syncAckUC:   syncAckUC,
+		teamsClient: teamsClient,
+		logger:      logger,
+	}
+}
+
+// Execute processes a Teams card action.
+func (uc *HandleInteractionUseCase) Execute(ctx context.Context, input dto.TeamsInteractionInput) (*dto.TeamsInteractionOutput, error) {
+	switch input.Action {
+	case "ack":
+		return uc.handleAck(ctx, input)
+	default:
+		return nil, fmt.Errorf("unknown action type: %s", input.Action)
+	}
+}
+
+// maxUserNameLength caps the name typed on the confirmation page.
+const maxUserNameLength = 64
+
+// unverifiedActor names who acknowledged through a Teams link. Anyone
+// holding the link can type any name, so the name is marked as unverified
+// rather than attributed as if it were an authenticated user.
+func unverifiedActor(name string) string {
+	name = strings.TrimSpace(name)
+	if utf8.RuneCountInString(name) > maxUserNameLength {
+		name = string([]rune(name)[:maxUserNameLength])
+	}
+	if name == "" {
+		return "unverified (via Teams link)"
+	}
+	return name + " (unverified, via Teams link)"
+}
+
+// handleAck handles the acknowledge action.
+func (uc *HandleInteractionUseCase) handleAck(ctx context.Context, input dto.TeamsInteractionInput) (*dto.TeamsInteractionOutput, error) {
+	userName := unverifiedActor(input.UserName)
+
+	output, err := uc.syncAckUC.Execute(ctx, ack.SyncAckInput{
+		AlertID:  input.AlertID,
+		Source:   entity.AckSourceTeams,
+		UserName: userName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("syncing ack: %w", err)
+	}
+
+	// SyncAck skips the source system, so refresh the Teams card here
+	messageID := output.Alert.GetExternalReference("teams")
+	if err := uc.teamsClient.UpdateMessage(ctx, messageID, output.Alert); err != nil {
+		uc.logger.Error("failed to update Teams message",
+			"alertID", output.Alert.ID,
+			"error", err,
+		)
+	}
+
+	return &dto.TeamsInteractionOutput{
+		Success: true,
+		Message: fmt.Sprintf("Alert %s acknowledged by %s", output.Alert.Name, userName),
+	}, nil
+}
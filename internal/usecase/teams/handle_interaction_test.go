@@ -0,0 +1,73 @@
+package teams
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/altuslabsxyz/alert-bridge/internal/adapter/dto"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/entity"
+	"github.com/altuslabsxyz/alert-bridge/internal/domain/repository"
+	"github.com/altuslabsxyz/alert-bridge/internal/infrastructure/persistence/memory"
+	"github.com/altuslabsxyz/alert-bridge/internal/usecase/ack"
+)
+
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...any) {}
+func (nopLogger) Info(string, ...any)  {}
+func (nopLogger) Warn(string, ...any)  {}
+func (nopLogger) Error(string, ...any) {}
+
+type noTx struct{}
+
+func (noTx) BeginTx(context.Context) (repository.Transaction, error) { return nil, nil }
+
+func (noTx) WithTransaction(ctx context.Context, fn func(context.Context) error) error {
+	return fn(ctx)
+}
+
+type teamsCards struct {
+	updated []string
+}
+
+func (c *teamsCards) UpdateMessage(_ context.Context, messageID string, _ *entity.Alert) error {
+	c.updated = append(c.updated, messageID)
+	return nil
+}
+
+func TestHandleInteractionUseCase_AckIsUnverified(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		userName string
+		want     string
+	}{
+		{"typed name", " alice ", "alice (unverified, via Teams link)"},
+		{"no name", "", "unverified (via Teams link)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			alertRepo := memory.NewAlertRepository()
+			a := entity.NewAlert("fp-1", "HighCPU", "web-1", "", "", entity.SeverityCritical)
+			a.SetExternalReference("teams", "card-1")
+			require.NoError(t, alertRepo.Save(ctx, a))
+
+			cards := &teamsCards{}
+			syncAck := ack.NewSyncAckUseCase(alertRepo, memory.NewAckEventRepository(), noTx{}, nil, nopLogger{}, nil)
+			uc := NewHandleInteractionUseCase(syncAck, cards, nopLogger{})
+
+			output, err := uc.Execute(ctx, dto.TeamsInteractionInput{Action: "ack", AlertID: a.ID, UserName: tt.userName})
+			require.NoError(t, err)
+			assert.Equal(t, "Alert HighCPU acknowledged by "+tt.want, output.Message)
+			assert.Equal(t, []string{"card-1"}, cards.updated)
+
+			stored, err := alertRepo.FindByID(ctx, a.ID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, stored.AckedBy)
+		})
+	}
+}
@@ -44,13 +44,13 @@ func NewMockNotifier(name string) *MockNotifier {
 }
 
 // Notify implements alert.Notifier
-func (m *MockNotifier) Notify(ctx context.Context, alert *entity.Alert) (string, error) {
+func (m *MockNotifier) Notify(ctx context.Context, alert *entity.Alert) (entity.NotifyResult, error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
 	if m.failNext {
 		m.failNext = false
-		return "", m.failError
+		return entity.NotifyResult{}, m.failError
 	}
 
 	m.messageIDSeq++
@@ -71,7 +71,7 @@ func (m *MockNotifier) Notify(ctx context.Context, alert *entity.Alert) (string,
 	}
 
 	m.notifications = append(m.notifications, notification)
-	return messageID, nil
+	return entity.NotifyResult{ReferenceID: messageID, Channel: m.name, DeliveredAt: notification.Timestamp}, nil
 }
 
 // UpdateMessage implements alert.Notifier